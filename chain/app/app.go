@@ -252,6 +252,8 @@ func New(
 	app.PocKeeper.SetRoyaltyKeeper(app.RoyaltyKeeper)
 	// Wire PoC → RepGov: updates originality/reputation signal on review outcomes
 	app.PocKeeper.SetRepgovKeeper(app.RepgovKeeper)
+	// Wire PoC → Staking: fraud endorsers are slashed and jailed through x/staking
+	app.PocKeeper.SetSlashingKeeper(app.StakingKeeper)
 
 	// Note: Gov hooks are automatically set by depinject via GovHooksWrapper
 	// See: x/timelock/module/depinject.go:69
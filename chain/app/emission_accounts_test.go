@@ -0,0 +1,108 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	pocmoduletypes "pos/x/poc/types"
+	tokenomicskeeper "pos/x/tokenomics/keeper"
+	tokenomicstypes "pos/x/tokenomics/types"
+)
+
+// emissionStakingKeeper satisfies the tokenomics staking keeper; epoch
+// minting never calls it
+type emissionStakingKeeper struct {
+	tokenomicstypes.StakingKeeper
+}
+
+// TestEpochEmissionsReachAppModuleAccounts mints epoch provisions through
+// real auth and bank keepers configured with this app's module account
+// permissions, so a share routed to a module account the app does not define
+// fails here instead of halting the chain
+func TestEpochEmissionsReachAppModuleAccounts(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, tokenomicstypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	tokenomicstypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	authority := authtypes.NewModuleAddress("gov").String()
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		GetMaccPerms(),
+		addresscodec.NewBech32Codec(prefix),
+		prefix,
+		authority,
+	)
+
+	blocked := make(map[string]bool)
+	for name := range BlockedAddresses() {
+		blocked[authtypes.NewModuleAddress(name).String()] = true
+	}
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		blocked,
+		authority,
+		log.NewNopLogger(),
+	)
+
+	k := tokenomicskeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[tokenomicstypes.StoreKey]),
+		log.NewNopLogger(),
+		accountKeeper,
+		bankKeeper,
+		emissionStakingKeeper{},
+		nil,
+		nil,
+		authority,
+	)
+
+	params := tokenomicstypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetCurrentSupply(ctx, params.CurrentTotalSupply))
+	require.NoError(t, k.SetTotalMinted(ctx, params.CurrentTotalSupply))
+	treasury := sdk.AccAddress([]byte("emission_treasury___"))
+	require.NoError(t, k.SetTreasuryAddress(ctx, treasury))
+
+	minted := math.ZeroInt()
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		amount, err := k.MintEpochProvisions(ctx.WithBlockHeight(epoch * int64(params.RewardStreamInterval)))
+		require.NoError(t, err)
+		require.True(t, amount.IsPositive())
+		minted = minted.Add(amount)
+	}
+
+	balance := func(addr sdk.AccAddress) math.Int {
+		return bankKeeper.GetBalance(ctx, addr, tokenomicstypes.BondDenom).Amount
+	}
+	staking := balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	poc := balance(authtypes.NewModuleAddress(pocmoduletypes.ModuleName))
+	require.True(t, staking.IsPositive())
+	require.True(t, poc.IsPositive())
+
+	// Nothing is left behind in the tokenomics account
+	require.True(t, balance(authtypes.NewModuleAddress(tokenomicstypes.ModuleName)).IsZero())
+	require.True(t, staking.Add(poc).Add(balance(treasury)).Equal(minted))
+	require.True(t, bankKeeper.GetSupply(ctx, tokenomicstypes.BondDenom).Amount.Equal(minted))
+}
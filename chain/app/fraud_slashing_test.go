@@ -0,0 +1,135 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	pockeeper "pos/x/poc/keeper"
+	pocmoduletypes "pos/x/poc/types"
+)
+
+// TestFraudProofSlashesThroughStaking submits a fraud proof against a bonded
+// validator with real auth, bank and staking keepers, wired into PoC the way
+// the app wires them, so a slash that x/staking computes as zero fails here
+func TestFraudProofSlashesThroughStaking(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, pocmoduletypes.StoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(pocmoduletypes.TStoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, tkeys, nil).WithBlockHeight(10)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	pocmoduletypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	config := sdk.GetConfig()
+	prefix := config.GetBech32AccountAddrPrefix()
+	authority := authtypes.NewModuleAddress("gov").String()
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		GetMaccPerms(),
+		addresscodec.NewBech32Codec(prefix),
+		prefix,
+		authority,
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		map[string]bool{},
+		authority,
+		log.NewNopLogger(),
+	)
+	stakingKeeper := stakingkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
+		accountKeeper,
+		bankKeeper,
+		authority,
+		addresscodec.NewBech32Codec(config.GetBech32ValidatorAddrPrefix()),
+		addresscodec.NewBech32Codec(config.GetBech32ConsensusAddrPrefix()),
+	)
+
+	k := pockeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[pocmoduletypes.StoreKey]),
+		tkeys[pocmoduletypes.TStoreKey],
+		log.NewNopLogger(),
+		authority,
+		stakingKeeper,
+		bankKeeper,
+		accountKeeper,
+	)
+	k.SetSlashingKeeper(stakingKeeper)
+
+	params := pocmoduletypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = params.RewardDenom
+	require.NoError(t, stakingKeeper.SetParams(ctx, stakingParams))
+
+	// A bonded validator with 100 power, backed by the bonded pool, and a PoC
+	// module balance to pay the challenger from
+	stake := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	valAddr := sdk.ValAddress("fraud_validator_____")
+	validator, err := stakingtypes.NewValidator(valAddr.String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = stake
+	validator.DelegatorShares = math.LegacyNewDecFromInt(stake)
+	require.NoError(t, stakingKeeper.SetValidator(ctx, validator))
+	require.NoError(t, stakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(t, stakingKeeper.SetValidatorByPowerIndex(ctx, validator))
+
+	reserve := math.NewInt(10_000_000)
+	require.NoError(t, bankKeeper.MintCoins(ctx, pocmoduletypes.ModuleName, sdk.NewCoins(sdk.NewCoin(params.RewardDenom, stake.Add(reserve)))))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, pocmoduletypes.ModuleName, stakingtypes.BondedPoolName,
+		sdk.NewCoins(sdk.NewCoin(params.RewardDenom, stake))))
+
+	// A verified contribution with an all-zero hash, approved by the validator
+	contribution := pocmoduletypes.NewContribution(1, sdk.AccAddress("fraud_contributor___").String(),
+		"code", "ipfs://QmFraud", make([]byte, 32), 0, 0)
+	contribution.Verified = true
+	contribution.AddEndorsement(pocmoduletypes.NewEndorsement(valAddr.String(), true, stake, 0))
+	require.NoError(t, k.SetContribution(ctx, contribution))
+
+	challenger := sdk.AccAddress("fraud_challenger____")
+	resp, err := pockeeper.NewMsgServerImpl(k).SubmitFraudProof(ctx, &pocmoduletypes.MsgSubmitFraudProof{
+		Challenger:     challenger.String(),
+		ContributionId: 1,
+		Validator:      valAddr.String(),
+		ProofType:      uint32(pocmoduletypes.FraudProofHashMismatch),
+		ProofData:      []byte("expected-hash"),
+	})
+	require.NoError(t, err)
+
+	// The default 1% of the validator's stake is burned and 10% of that paid out
+	slashed := pocmoduletypes.DefaultSlashFractionFraudEndorsement().MulInt(stake).TruncateInt()
+	require.True(t, slashed.IsPositive())
+	require.True(t, resp.SlashedAmount.Equal(slashed))
+	require.True(t, resp.ChallengerReward.IsPositive())
+	require.True(t, bankKeeper.GetBalance(ctx, challenger, params.RewardDenom).Amount.Equal(resp.ChallengerReward))
+
+	validator, err = stakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, validator.Tokens.Equal(stake.Sub(slashed)))
+	require.True(t, validator.Jailed)
+}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/types"
 )
 
 // ============================================================================
@@ -145,47 +148,57 @@ func TestTC_EMISSION_001_EmissionSumValidation(t *testing.T) {
 // Governance CANNOT set inflation_max above this value
 
 func TestTC_EMISSION_002_InflationCapEnforcement(t *testing.T) {
-	maxCap := math.LegacyMustNewDecFromStr("0.03") // 3%
+	maxCap := math.LegacyMustNewDecFromStr(types.MaxAnnualInflationRateHardCap)
+	require.Equal(t, "0.030000000000000000", maxCap.String(), "protocol inflation cap must be 3%")
 
 	testCases := []struct {
-		name        string
-		inflationMax string
-		shouldPass  bool
+		name         string
+		inflationMax math.LegacyDec
+		shouldPass   bool
 	}{
 		{
-			name:        "At cap (3%) - valid",
-			inflationMax: "0.03",
-			shouldPass:  true,
+			name:         "At cap (3%) - valid",
+			inflationMax: maxCap,
+			shouldPass:   true,
+		},
+		{
+			name:         "Below cap (2%) - valid",
+			inflationMax: math.LegacyMustNewDecFromStr("0.02"),
+			shouldPass:   true,
 		},
 		{
-			name:        "Below cap (2%) - valid",
-			inflationMax: "0.02",
-			shouldPass:  true,
+			name:         "Just above cap - MUST reject",
+			inflationMax: maxCap.Add(math.LegacySmallestDec()),
+			shouldPass:   false,
 		},
 		{
-			name:        "Above cap (4%) - MUST reject",
-			inflationMax: "0.04",
-			shouldPass:  false,
+			name:         "Above cap (4%) - MUST reject",
+			inflationMax: math.LegacyMustNewDecFromStr("0.04"),
+			shouldPass:   false,
 		},
 		{
-			name:        "Above cap (5%) - MUST reject",
-			inflationMax: "0.05",
-			shouldPass:  false,
+			name:         "Above cap (5%) - MUST reject",
+			inflationMax: math.LegacyMustNewDecFromStr("0.05"),
+			shouldPass:   false,
 		},
 		{
-			name:        "Minimum (0.5%) - valid",
-			inflationMax: "0.005",
-			shouldPass:  true,
+			name:         "Minimum (0.5%) - valid",
+			inflationMax: math.LegacyMustNewDecFromStr("0.005"),
+			shouldPass:   true,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			rate := math.LegacyMustNewDecFromStr(tc.inflationMax)
-			isValid := rate.LTE(maxCap)
-			if isValid != tc.shouldPass {
-				t.Errorf("Inflation cap validation failed: rate=%s, expected pass=%v, got=%v",
-					tc.inflationMax, tc.shouldPass, isValid)
+			params := types.DefaultParams()
+			params.InflationMax = tc.inflationMax
+			params.InflationRate = tc.inflationMax
+
+			err := params.Validate()
+			if tc.shouldPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrProtocolCapViolation)
 			}
 		})
 	}
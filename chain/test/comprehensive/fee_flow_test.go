@@ -0,0 +1,157 @@
+package comprehensive
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// TC-FEEFLOW-001: End-to-End Fee Flow
+// ============================================================================
+//
+// Drives fees through the real tokenomics keeper and the mock bank:
+//
+//	user → fee_collector → burn / treasury split → redirect accumulation
+//	     → treasury redirect to the four vesting targets
+//
+// Each stage is asserted against the keeper's counters and the bank balances,
+// and the whole flow must conserve supply: everything the user paid is either
+// burned, retained by the treasury, or held by a redirect target.
+
+// TestTC_FEEFLOW_001_EndToEnd collects fees in two blocks, advances to the
+// redirect interval and executes the redirect.
+func TestTC_FEEFLOW_001_EndToEnd(t *testing.T) {
+	tc := SetupTestContext(t)
+	k := tc.TokenomicsKeeper
+	ctx := tc.Ctx.WithBlockHeight(1)
+
+	initialSupply := math.NewInt(375_000_000_000_000)
+	payer := sdk.AccAddress("fee_payer___________")
+	treasury := sdk.AccAddress("treasury____________")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	tc.BankKeeper.SetSupply(sdk.NewCoins(sdk.NewCoin(TestDenom, initialSupply)))
+	tc.BankKeeper.SetBalance(ctx, payer, sdk.NewCoins(sdk.NewCoin(TestDenom, initialSupply)))
+	require.NoError(t, k.SetCurrentSupply(ctx, initialSupply))
+	require.NoError(t, k.SetTreasuryAddress(ctx, treasury))
+
+	// Redirect targets must be vesting accounts
+	targets := map[string]sdk.AccAddress{
+		"ecosystem_grants": sdk.AccAddress("ecosystem_grants____"),
+		"buy_and_burn":     sdk.AccAddress("buy_and_burn________"),
+		"insurance_fund":   sdk.AccAddress("insurance_fund______"),
+		"research_fund":    sdk.AccAddress("research_fund_______"),
+	}
+	require.NoError(t, k.SetEcosystemGrantsAddress(ctx, targets["ecosystem_grants"]))
+	require.NoError(t, k.SetBuyAndBurnAddress(ctx, targets["buy_and_burn"]))
+	require.NoError(t, k.SetInsuranceFundAddress(ctx, targets["insurance_fund"]))
+	require.NoError(t, k.SetResearchFundAddress(ctx, targets["research_fund"]))
+
+	vestingEnd := ctx.BlockTime().Add(365 * 24 * time.Hour).Unix()
+	for _, addr := range targets {
+		acc, err := vestingtypes.NewDelayedVestingAccount(
+			authtypes.NewBaseAccountWithAddress(addr),
+			sdk.NewCoins(sdk.NewInt64Coin(TestDenom, 1_000_000)),
+			vestingEnd,
+		)
+		require.NoError(t, err)
+		tc.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	// Redirect 10% of treasury inflows every 100 blocks, split 40/30/20/10
+	params := k.GetParams(ctx)
+	params.TreasuryRedirectEnabled = true
+	params.TreasuryRedirectRatio = math.LegacyMustNewDecFromStr(MaxRedirectRatio)
+	params.RedirectExecutionInterval = DefaultRedirectInterval
+	params.RedirectToEcosystemGrants = math.LegacyMustNewDecFromStr(DefaultEcosystemGrants)
+	params.RedirectToBuyAndBurn = math.LegacyMustNewDecFromStr(DefaultBuyAndBurn)
+	params.RedirectToInsuranceFund = math.LegacyMustNewDecFromStr(DefaultInsuranceFund)
+	params.RedirectToResearchFund = math.LegacyMustNewDecFromStr(DefaultResearchFund)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	collectFee := func(ctx sdk.Context, fee int64) {
+		t.Helper()
+		coins := sdk.NewCoins(sdk.NewInt64Coin(TestDenom, fee))
+		require.NoError(t, tc.BankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, coins))
+		require.NoError(t, k.ProcessBlockFees(ctx))
+	}
+
+	// Stage 1: fee with rounding dust at height 1.
+	// 10% of 1,000,000,007 truncates to 100,000,000; the dust goes to burn.
+	collectFee(ctx, 1_000_000_007)
+
+	require.Equal(t, math.NewInt(900_000_007), k.GetTotalFeesBurned(ctx))
+	require.Equal(t, math.NewInt(100_000_000), k.GetTotalFeesToTreasury(ctx))
+	require.Equal(t, math.NewInt(100_000_000), k.GetAccumulatedRedirectInflows(ctx))
+	require.Equal(t, int64(100_000_000), tc.BankKeeper.GetBalance(ctx, treasury, TestDenom).Amount.Int64())
+	require.True(t, tc.BankKeeper.GetBalance(ctx, feeCollector, TestDenom).IsZero())
+
+	// Stage 2: second fee at height 50 accumulates further inflows
+	collectFee(ctx.WithBlockHeight(50), 500_000_000)
+
+	require.Equal(t, math.NewInt(1_350_000_007), k.GetTotalFeesBurned(ctx))
+	require.Equal(t, math.NewInt(150_000_000), k.GetTotalFeesToTreasury(ctx))
+	require.Equal(t, math.NewInt(150_000_000), k.GetAccumulatedRedirectInflows(ctx))
+
+	// Stage 3: before the interval elapses the redirect is a no-op
+	result, err := k.ProcessTreasuryRedirect(ctx.WithBlockHeight(99))
+	require.NoError(t, err)
+	require.Nil(t, result)
+	require.Equal(t, math.NewInt(150_000_000), k.GetAccumulatedRedirectInflows(ctx))
+
+	// Stage 4: at the interval 10% of the accumulated inflows is redirected
+	redirectCtx := ctx.WithBlockHeight(int64(DefaultRedirectInterval))
+	result, err = k.ProcessTreasuryRedirect(redirectCtx)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, math.NewInt(150_000_000), result.TotalInflows)
+	require.Equal(t, math.NewInt(15_000_000), result.RedirectAmount)
+	require.Equal(t, math.NewInt(135_000_000), result.RetainedAmount)
+	require.Len(t, result.Allocations, 4)
+
+	expectedAllocations := map[string]int64{
+		"ecosystem_grants": 6_000_000,
+		"buy_and_burn":     4_500_000,
+		"insurance_fund":   3_000_000,
+		"research_fund":    1_500_000,
+	}
+	for _, alloc := range result.Allocations {
+		require.Equal(t, expectedAllocations[alloc.Target], alloc.Amount.Int64(), alloc.Target)
+		require.Equal(t, expectedAllocations[alloc.Target],
+			tc.BankKeeper.GetBalance(ctx, targets[alloc.Target], TestDenom).Amount.Int64(), alloc.Target)
+	}
+
+	require.True(t, k.GetAccumulatedRedirectInflows(ctx).IsZero())
+	require.Equal(t, int64(DefaultRedirectInterval), k.GetLastRedirectHeight(ctx))
+	require.Equal(t, math.NewInt(15_000_000), k.GetTotalRedirected(ctx))
+	require.Equal(t, int64(135_000_000), tc.BankKeeper.GetBalance(ctx, treasury, TestDenom).Amount.Int64())
+
+	// End-to-end conservation: paid = burned + retained + redirected
+	paid := math.NewInt(1_500_000_007)
+	burned := tc.BankKeeper.GetBurned().AmountOf(TestDenom)
+	require.Equal(t, k.GetTotalFeesBurned(ctx), burned)
+
+	held := tc.BankKeeper.GetBalance(ctx, treasury, TestDenom).Amount
+	for _, addr := range targets {
+		held = held.Add(tc.BankKeeper.GetBalance(ctx, addr, TestDenom).Amount)
+	}
+	require.Equal(t, paid, burned.Add(held))
+	require.Equal(t, initialSupply.Sub(paid), tc.BankKeeper.GetBalance(ctx, payer, TestDenom).Amount)
+
+	// Nothing is stranded in the intermediate module accounts
+	require.True(t, tc.BankKeeper.GetModuleBalance(authtypes.FeeCollectorName).AmountOf(TestDenom).IsZero())
+	require.True(t, tc.BankKeeper.GetModuleBalance(types.ModuleName).AmountOf(TestDenom).IsZero())
+
+	// Keeper supply tracks the bank supply through the burns
+	require.Equal(t, initialSupply.Sub(burned), k.GetCurrentSupply(ctx))
+	AssertSupplyConservation(t, tc.BankKeeper, initialSupply)
+	AssertNoNegativeBalances(t, tc.BankKeeper)
+}
@@ -407,3 +407,76 @@ func TestTC049_CreditNonNegative(t *testing.T) {
 	require.GreaterOrEqual(t, finalCredits, int64(0), "Credits should never be negative")
 	require.Equal(t, int64(0), finalCredits, "Credits should be 0 after maximum decay")
 }
+
+// TC-ENDORSE-001: Minimum Endorsements - Percentage Passes, Count Fails
+// Priority: P0
+// Purpose: Verify a unanimous vote from too few validators does not verify
+func TestTC_ENDORSE_001_PercentagePassesCountFails(t *testing.T) {
+	tc := SetupTestContext(t)
+
+	contributionID := "contrib-min-001"
+	contributor := sdk.AccAddress("contributor_________")
+	tc.PoCKeeper.SubmitContribution(tc.Ctx, contributionID, contributor, "QmHash")
+
+	// A single yes vote is 100%, but below the minimum of 3 validators
+	tc.PoCKeeper.Endorse(tc.Ctx, contributionID, sdk.ValAddress("val1________________"), true)
+	tc.PoCKeeper.ProcessEndorsements(tc.Ctx, contributionID)
+	require.Equal(t, "pending", tc.PoCKeeper.GetContribution(tc.Ctx, contributionID).Status,
+		"One yes vote should not verify a contribution")
+
+	// 2 of 2 is still short of the minimum
+	tc.PoCKeeper.Endorse(tc.Ctx, contributionID, sdk.ValAddress("val2________________"), true)
+	tc.PoCKeeper.ProcessEndorsements(tc.Ctx, contributionID)
+	require.Equal(t, "pending", tc.PoCKeeper.GetContribution(tc.Ctx, contributionID).Status)
+
+	// The third yes vote meets both thresholds
+	tc.PoCKeeper.Endorse(tc.Ctx, contributionID, sdk.ValAddress("val3________________"), true)
+	tc.PoCKeeper.ProcessEndorsements(tc.Ctx, contributionID)
+	require.Equal(t, "verified", tc.PoCKeeper.GetContribution(tc.Ctx, contributionID).Status)
+}
+
+// TC-ENDORSE-002: Minimum Endorsements - Count Passes, Percentage Fails
+// Priority: P0
+// Purpose: Verify enough yes votes still fail when the yes ratio is too low
+func TestTC_ENDORSE_002_CountPassesPercentageFails(t *testing.T) {
+	tc := SetupTestContext(t)
+
+	contributionID := "contrib-min-002"
+	contributor := sdk.AccAddress("contributor_________")
+	tc.PoCKeeper.SubmitContribution(tc.Ctx, contributionID, contributor, "QmHash")
+
+	// 3 yes votes meet the minimum, but 3/6 = 50% is below 66.7%
+	for i := 1; i <= 6; i++ {
+		valAddr := sdk.ValAddress([]byte("validator" + string(rune('0'+i))))
+		tc.PoCKeeper.Endorse(tc.Ctx, contributionID, valAddr, i <= 3)
+	}
+	tc.PoCKeeper.ProcessEndorsements(tc.Ctx, contributionID)
+	require.Equal(t, "rejected", tc.PoCKeeper.GetContribution(tc.Ctx, contributionID).Status,
+		"Contribution should be rejected with 50% yes votes")
+}
+
+// TC-ENDORSE-003: Minimum Endorsements - Configurable Thresholds
+// Priority: P1
+// Purpose: Verify the percentage and the minimum count are set independently
+func TestTC_ENDORSE_003_ConfigurableThresholds(t *testing.T) {
+	tc := SetupTestContext(t)
+	contributor := sdk.AccAddress("contributor_________")
+
+	// A minimum of 1 restores single-validator verification
+	tc.PoCKeeper.MinEndorsements = 1
+	tc.PoCKeeper.SubmitContribution(tc.Ctx, "contrib-min-003", contributor, "QmHash")
+	tc.PoCKeeper.Endorse(tc.Ctx, "contrib-min-003", sdk.ValAddress("val1________________"), true)
+	tc.PoCKeeper.ProcessEndorsements(tc.Ctx, "contrib-min-003")
+	require.Equal(t, "verified", tc.PoCKeeper.GetContribution(tc.Ctx, "contrib-min-003").Status)
+
+	// A 50% quorum accepts 3 of 6, as long as the minimum of 3 is met
+	tc.PoCKeeper.QuorumPct = 0.5
+	tc.PoCKeeper.MinEndorsements = 3
+	tc.PoCKeeper.SubmitContribution(tc.Ctx, "contrib-min-004", contributor, "QmHash")
+	for i := 1; i <= 6; i++ {
+		valAddr := sdk.ValAddress([]byte("validator" + string(rune('0'+i))))
+		tc.PoCKeeper.Endorse(tc.Ctx, "contrib-min-004", valAddr, i <= 3)
+	}
+	tc.PoCKeeper.ProcessEndorsements(tc.Ctx, "contrib-min-004")
+	require.Equal(t, "verified", tc.PoCKeeper.GetContribution(tc.Ctx, "contrib-min-004").Status)
+}
@@ -148,6 +148,11 @@ func (m *MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, de
 	if coins, ok := m.balances[addr.String()]; ok {
 		return sdk.NewCoin(denom, coins.AmountOf(denom))
 	}
+	// Module accounts are tracked separately; keepers read them by address
+	// too (e.g. the fee collector balance in ProcessBlockFees)
+	if coins, ok := m.moduleBalances[addr.String()]; ok {
+		return sdk.NewCoin(denom, coins.AmountOf(denom))
+	}
 	return sdk.NewCoin(denom, math.ZeroInt())
 }
 
@@ -346,8 +351,8 @@ func (m *MockStakingKeeper) SetTotalBonded(amount math.Int) {
 const (
 	TestDenom = "omniphi"
 	TestHardCap = 1_500_000_000_000_000 // 1.5B OMNI with 6 decimals (in omniphi base units)
-	TestMinInflation = 0.01             // 1%
-	TestMaxInflation = 0.05             // 5%
+	TestMinInflation = 0.005            // 0.5%
+	TestMaxInflation = 0.03             // 3% (types.MaxAnnualInflationRateHardCap)
 )
 
 // Helper functions for common test operations
@@ -417,14 +422,21 @@ type MockPoCKeeper struct {
 	credits       map[string]int64 // address -> credits
 	slashEvents   map[string]*SlashEvent
 	bankKeeper    *MockBankKeeper
+
+	// QuorumPct is the share of votes that must be yes (default 2/3)
+	QuorumPct float64
+	// MinEndorsements is the number of yes votes required on top of QuorumPct
+	MinEndorsements int
 }
 
 func NewMockPoCKeeper(bankKeeper *MockBankKeeper) *MockPoCKeeper {
 	return &MockPoCKeeper{
-		contributions: make(map[string]*Contribution),
-		credits:       make(map[string]int64),
-		slashEvents:   make(map[string]*SlashEvent),
-		bankKeeper:    bankKeeper,
+		contributions:   make(map[string]*Contribution),
+		credits:         make(map[string]int64),
+		slashEvents:     make(map[string]*SlashEvent),
+		bankKeeper:      bankKeeper,
+		QuorumPct:       0.667,
+		MinEndorsements: 3,
 	}
 }
 
@@ -470,17 +482,21 @@ func (m *MockPoCKeeper) ProcessEndorsements(ctx sdk.Context, contributionID stri
 		}
 	}
 
-	// Require ≥66.7% (2/3) yes votes
 	if totalVotes == 0 {
 		contrib.Status = "pending"
 		return
 	}
 
+	// Require ≥QuorumPct yes votes and at least MinEndorsements of them; a
+	// passing percentage from too few validators stays pending
 	yesPercent := float64(yesVotes) / float64(totalVotes)
-	if yesPercent >= 0.667 {
-		contrib.Status = "verified"
-	} else {
+	switch {
+	case yesPercent < m.QuorumPct:
 		contrib.Status = "rejected"
+	case yesVotes < m.MinEndorsements:
+		contrib.Status = "pending"
+	default:
+		contrib.Status = "verified"
 	}
 }
 
@@ -126,18 +126,21 @@ func (k Keeper) GetRequiredCScore(ctx context.Context, ctype string) (math.Int,
 // CheckIdentityRequirement verifies identity verification for contribution types that require it
 //
 // Algorithm:
-// 1. Check if identity verification is required for this ctype
+// 1. Skip if identity gating is disabled or this ctype has no identity requirement
 // 2. If required, verify with identity keeper (if available)
 // 3. Graceful fallback if x/identity module not loaded
 //
 // Security:
-// - Fail-safe: If identity module unavailable, reject submissions requiring identity
+// - Fail-safe: If identity module unavailable or errors, reject submissions requiring identity
 // - Deterministic: No external calls, all checks on-chain
 // - Gas metered: Early returns minimize cost
 //
 // Gas cost: ~3,000 gas (param read + identity check)
 func (k Keeper) CheckIdentityRequirement(ctx context.Context, contributor sdk.AccAddress, ctype string) error {
 	params := k.GetParams(ctx)
+	if !params.EnableIdentityGating {
+		return nil
+	}
 
 	// Check if this contribution type requires identity verification
 	requiresIdentity, exists := params.RequireIdentityForCtype[ctype]
@@ -146,39 +149,52 @@ func (k Keeper) CheckIdentityRequirement(ctx context.Context, contributor sdk.Ac
 		return nil
 	}
 
-	// Identity verification required - check if module is available
-	if k.identityKeeper != nil {
-		// x/identity module is available - perform verification
-		if !k.identityKeeper.IsVerified(ctx, contributor) {
-			k.Logger().Info("identity verification failed for contributor",
-				"contributor", contributor.String(),
-				"ctype", ctype,
-			)
-
-			return types.ErrIdentityNotVerified.Wrapf(
-				"contribution type '%s' requires verified identity (KYC/DID)",
-				ctype,
-			)
-		}
+	// x/identity module not available - fail-safe rejection
+	if k.identityKeeper == nil {
+		k.Logger().Warn("identity verification required but x/identity module not available",
+			"contributor", contributor.String(),
+			"ctype", ctype,
+		)
 
-		// Identity verified successfully
-		k.Logger().Debug("identity verification passed",
+		return types.ErrIdentityCheckFailed.Wrapf(
+			"contribution type '%s' requires verified identity, but identity module is not available",
+			ctype,
+		)
+	}
+
+	verified, err := k.identityKeeper.HasVerifiedIdentity(ctx, contributor)
+	if err != nil {
+		// Treat a lookup failure like an unavailable module: never admit on error
+		k.Logger().Error("identity verification lookup failed",
 			"contributor", contributor.String(),
 			"ctype", ctype,
+			"error", err,
+		)
+
+		return types.ErrIdentityCheckFailed.Wrapf(
+			"contribution type '%s' requires verified identity: %v",
+			ctype, err,
 		)
-		return nil
 	}
 
-	// x/identity module not available - fail-safe rejection
-	k.Logger().Warn("identity verification required but x/identity module not available",
+	if !verified {
+		k.Logger().Info("identity verification failed for contributor",
+			"contributor", contributor.String(),
+			"ctype", ctype,
+		)
+
+		return types.ErrIdentityNotVerified.Wrapf(
+			"contribution type '%s' requires verified identity (KYC/DID)",
+			ctype,
+		)
+	}
+
+	// Identity verified successfully
+	k.Logger().Debug("identity verification passed",
 		"contributor", contributor.String(),
 		"ctype", ctype,
 	)
-
-	return types.ErrIdentityCheckFailed.Wrapf(
-		"contribution type '%s' requires verified identity, but identity module is not available",
-		ctype,
-	)
+	return nil
 }
 
 // IsExemptAddress checks if an address is exempt from PoA verification
@@ -192,7 +208,8 @@ func (k Keeper) CheckIdentityRequirement(ctx context.Context, contributor sdk.Ac
 // - Emergency response accounts
 // - Grandfathered historical contributors
 //
-// Security: Only governance can add/remove exempt addresses via param updates
+// Security: Only governance can add/remove exempt addresses, via param updates
+// or MsgAddExemptAddress / MsgRemoveExemptAddress
 //
 // Gas cost: ~1,500 gas (param read + linear search)
 // Note: Exempt list expected to be very small (<10 addresses)
@@ -262,6 +279,11 @@ func (k Keeper) GetIdentityRequirements(ctx context.Context) map[string]bool {
 //
 // Gas cost: ~8,000 gas (comprehensive check simulation)
 func (k Keeper) CanSubmitContribution(ctx context.Context, contributor sdk.AccAddress, ctype string) (canSubmit bool, reason string) {
+	// Emergency kill-switch applies to everyone, exempt addresses included
+	if k.IsSubmissionsPaused(ctx) {
+		return false, "contribution submissions are paused"
+	}
+
 	// Check if exempt (bypass all checks)
 	if k.IsExemptAddress(ctx, contributor) {
 		return true, "contributor is exempt from access control"
@@ -286,15 +308,8 @@ func (k Keeper) CanSubmitContribution(ctx context.Context, contributor sdk.AccAd
 	}
 
 	// Check identity requirement
-	if params.EnableIdentityGating {
-		requiresIdentity, exists := params.RequireIdentityForCtype[ctype]
-		if exists && requiresIdentity {
-			// For now, always fail if identity required (module not implemented)
-			return false, fmt.Sprintf(
-				"contribution type '%s' requires verified identity (not yet available)",
-				ctype,
-			)
-		}
+	if err := k.CheckIdentityRequirement(ctx, contributor, ctype); err != nil {
+		return false, err.Error()
 	}
 
 	return true, "all requirements met"
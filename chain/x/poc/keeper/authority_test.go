@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"pos/x/poc/keeper"
 	"pos/x/poc/types"
 )
 
@@ -225,6 +228,82 @@ func TestCheckIdentityRequirement_IdentityModuleUnavailable(t *testing.T) {
 	require.Contains(t, err.Error(), "identity module is not available")
 }
 
+// mockIdentityKeeper reports a fixed set of verified addresses, or err for every lookup if set
+type mockIdentityKeeper struct {
+	verified map[string]bool
+	err      error
+}
+
+func (m *mockIdentityKeeper) HasVerifiedIdentity(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	return m.verified[addr.String()], nil
+}
+
+// setupIdentityGating requires identity for "treasury" and injects an identity
+// keeper that has verified addrs[0] only
+func setupIdentityGating(t *testing.T) (*KeeperTestFixture, *mockIdentityKeeper, []sdk.AccAddress) {
+	t.Helper()
+	f := SetupKeeperTest(t)
+	addrs := createTestAddresses(2)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.EnableIdentityGating = true
+	params.RequireIdentityForCtype = map[string]bool{
+		"treasury": true,
+	}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	ik := &mockIdentityKeeper{verified: map[string]bool{addrs[0].String(): true}}
+	f.keeper.SetIdentityKeeper(ik)
+	return f, ik, addrs
+}
+
+func TestCheckIdentityRequirement_Verified(t *testing.T) {
+	f, _, addrs := setupIdentityGating(t)
+
+	require.NoError(t, f.keeper.CheckIdentityRequirement(f.ctx, addrs[0], "treasury"))
+	require.NoError(t, f.keeper.CheckProofOfAuthority(f.ctx, addrs[0], "treasury"))
+
+	canSubmit, reason := f.keeper.CanSubmitContribution(f.ctx, addrs[0], "treasury")
+	require.True(t, canSubmit, reason)
+}
+
+func TestCheckIdentityRequirement_Unverified(t *testing.T) {
+	f, _, addrs := setupIdentityGating(t)
+
+	err := f.keeper.CheckIdentityRequirement(f.ctx, addrs[1], "treasury")
+	require.ErrorIs(t, err, types.ErrIdentityNotVerified)
+	require.ErrorIs(t, f.keeper.CheckProofOfAuthority(f.ctx, addrs[1], "treasury"), types.ErrIdentityNotVerified)
+
+	canSubmit, reason := f.keeper.CanSubmitContribution(f.ctx, addrs[1], "treasury")
+	require.False(t, canSubmit)
+	require.Contains(t, reason, "verified identity")
+
+	// Types without an identity requirement are unaffected
+	require.NoError(t, f.keeper.CheckIdentityRequirement(f.ctx, addrs[1], "code"))
+
+	// With gating disabled the requirement map is ignored
+	params := f.keeper.GetParams(f.ctx)
+	params.EnableIdentityGating = false
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.CheckIdentityRequirement(f.ctx, addrs[1], "treasury"))
+}
+
+func TestCheckIdentityRequirement_KeeperError(t *testing.T) {
+	f, ik, addrs := setupIdentityGating(t)
+	ik.err = errors.New("identity store unavailable")
+
+	// A lookup failure rejects even a verified address
+	err := f.keeper.CheckIdentityRequirement(f.ctx, addrs[0], "treasury")
+	require.ErrorIs(t, err, types.ErrIdentityCheckFailed)
+	require.Contains(t, err.Error(), "identity store unavailable")
+
+	canSubmit, _ := f.keeper.CanSubmitContribution(f.ctx, addrs[0], "treasury")
+	require.False(t, canSubmit)
+}
+
 // TestCanSubmitContribution_ReadOnlyCheck tests the read-only submission check
 func TestCanSubmitContribution_ReadOnlyCheck(t *testing.T) {
 	f := SetupKeeperTest(t)
@@ -270,6 +349,104 @@ func TestCanSubmitContribution_ReadOnlyCheck(t *testing.T) {
 	require.Contains(t, reason, "verified identity")
 }
 
+// TestQueryCanSubmit runs the TestCanSubmitContribution_ReadOnlyCheck scenarios through the query layer
+func TestQueryCanSubmit(t *testing.T) {
+	f := SetupKeeperTest(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	contributor := createTestAddresses(1)[0]
+	req := &types.QueryCanSubmitRequest{Address: contributor.String(), Ctype: "code"}
+
+	// Test 1: No restrictions
+	res, err := qs.CanSubmit(f.ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.CanSubmit)
+	require.Contains(t, res.Reason, "all requirements met")
+	require.True(t, res.RequiredCscore.IsZero())
+	require.False(t, res.RequiresIdentity)
+
+	// Test 2: Insufficient C-Score
+	params := f.keeper.GetParams(f.ctx)
+	params.EnableCscoreGating = true
+	params.MinCscoreForCtype = map[string]math.Int{
+		"code": math.NewInt(1000),
+	}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	res, err = qs.CanSubmit(f.ctx, req)
+	require.NoError(t, err)
+	require.False(t, res.CanSubmit)
+	require.Contains(t, res.Reason, "need 1000 more")
+	require.Equal(t, "1000", res.RequiredCscore.String())
+
+	// Test 3: Give sufficient C-Score
+	require.NoError(t, f.keeper.AddCreditsWithOverflowCheck(f.ctx, contributor, math.NewInt(2000)))
+
+	res, err = qs.CanSubmit(f.ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.CanSubmit)
+
+	// Test 4: Identity required but not available
+	params.EnableIdentityGating = true
+	params.RequireIdentityForCtype = map[string]bool{
+		"code": true,
+	}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	res, err = qs.CanSubmit(f.ctx, req)
+	require.NoError(t, err)
+	require.False(t, res.CanSubmit)
+	require.Contains(t, res.Reason, "verified identity")
+	require.True(t, res.RequiresIdentity)
+
+	// Malformed requests are rejected
+	_, err = qs.CanSubmit(f.ctx, &types.QueryCanSubmitRequest{Address: "invalid", Ctype: "code"})
+	require.Error(t, err)
+	_, err = qs.CanSubmit(f.ctx, &types.QueryCanSubmitRequest{Address: contributor.String()})
+	require.Error(t, err)
+}
+
+// TestQueryAccessControlConfig sets the C-Score, identity and exemption
+// configs and checks the aggregated query response
+func TestQueryAccessControlConfig(t *testing.T) {
+	f := SetupKeeperTest(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	res, err := qs.AccessControlConfig(f.ctx, &types.QueryAccessControlConfigRequest{})
+	require.NoError(t, err)
+	require.False(t, res.EnableCscoreGating)
+	require.False(t, res.EnableIdentityGating)
+	require.Empty(t, res.CscoreRequirements)
+	require.Empty(t, res.IdentityRequirements)
+
+	exempt := createTestAddresses(2)
+	params := f.keeper.GetParams(f.ctx)
+	params.EnableCscoreGating = true
+	params.MinCscoreForCtype = map[string]math.Int{
+		"code":     math.NewInt(1000),
+		"security": math.NewInt(100000),
+	}
+	params.EnableIdentityGating = true
+	params.RequireIdentityForCtype = map[string]bool{
+		"governance": true,
+	}
+	params.ExemptAddresses = []string{exempt[0].String(), exempt[1].String()}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	res, err = qs.AccessControlConfig(f.ctx, &types.QueryAccessControlConfigRequest{})
+	require.NoError(t, err)
+	require.True(t, res.EnableCscoreGating)
+	require.True(t, res.EnableIdentityGating)
+	require.Len(t, res.CscoreRequirements, 2)
+	require.Equal(t, "1000", res.CscoreRequirements["code"])
+	require.Equal(t, "100000", res.CscoreRequirements["security"])
+	require.Equal(t, map[string]bool{"governance": true}, res.IdentityRequirements)
+	require.Equal(t, params.ExemptAddresses, res.ExemptAddresses)
+
+	_, err = qs.AccessControlConfig(f.ctx, nil)
+	require.Error(t, err)
+}
+
 // TestGetCScoreRequirements tests the query helper function
 func TestGetCScoreRequirements(t *testing.T) {
 	f := SetupKeeperTest(t)
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"pos/x/poc/types"
+)
+
+// setContributionStatusIndex files a contribution under its current lifecycle
+// status and removes it from the others
+func (k Keeper) setContributionStatusIndex(ctx context.Context, contribution types.Contribution) error {
+	store := k.storeService.OpenKVStore(ctx)
+	current := types.StatusOf(contribution)
+	for _, status := range types.ContributionStatuses() {
+		key := types.GetContributionStatusIndexKey(status, contribution.Id)
+		if status == current {
+			if err := store.Set(key, []byte{}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetContributionsByStatus pages through contributions in ID order, optionally
+// restricted to one lifecycle status and/or one contributor. An empty status
+// or contributor matches all.
+func (k Keeper) GetContributionsByStatus(ctx context.Context, status types.ContributionStatus, contributor string, pageReq *query.PageRequest) ([]types.Contribution, *query.PageResponse, error) {
+	kvStore := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	var contributions []types.Contribution
+	collect := func(id uint64) (bool, error) {
+		contribution, found := k.GetContribution(ctx, id)
+		if !found {
+			return false, nil
+		}
+		if status != "" && types.StatusOf(contribution) != status {
+			return false, nil
+		}
+		contributions = append(contributions, contribution)
+		return true, nil
+	}
+	// Index keys end with the big-endian contribution ID
+	idFromKey := func(key []byte) uint64 {
+		return sdk.BigEndianToUint64(key[len(key)-8:])
+	}
+
+	var (
+		store   storetypes.KVStore
+		pageRes *query.PageResponse
+		err     error
+	)
+	switch {
+	case contributor != "":
+		// A contributor has few contributions; filter them by status
+		store = prefix.NewStore(kvStore, types.GetContributorIndexPrefix(contributor))
+		pageRes, err = query.FilteredPaginate(store, pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+			if len(key) != 8 {
+				return false, nil
+			}
+			if !accumulate {
+				contribution, found := k.GetContribution(ctx, idFromKey(key))
+				return found && (status == "" || types.StatusOf(contribution) == status), nil
+			}
+			return collect(idFromKey(key))
+		})
+	case status != "":
+		store = prefix.NewStore(kvStore, types.GetContributionStatusIndexPrefix(status))
+		pageRes, err = query.Paginate(store, pageReq, func(key, _ []byte) error {
+			_, err := collect(idFromKey(key))
+			return err
+		})
+	default:
+		store = prefix.NewStore(kvStore, types.KeyPrefixContribution)
+		pageRes, err = query.Paginate(store, pageReq, func(_, value []byte) error {
+			var contribution types.Contribution
+			if err := k.cdc.Unmarshal(value, &contribution); err != nil {
+				return err
+			}
+			contributions = append(contributions, contribution)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return contributions, pageRes, nil
+}
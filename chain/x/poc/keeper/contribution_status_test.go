@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+func summaryIDs(summaries []types.ContributionSummary) []uint64 {
+	ids := make([]uint64, 0, len(summaries))
+	for _, s := range summaries {
+		ids = append(ids, s.Id)
+	}
+	return ids
+}
+
+func TestQueryContributionsByStatus(t *testing.T) {
+	f, validators, _ := setupEndorseTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// Contribution 1 (from setupEndorseTest) and 2-3 belong to alice, 4-5 to bob
+	alice := sdk.AccAddress("contributor_________").String()
+	bob := sdk.AccAddress("contributor_bob_____").String()
+	for id, contributor := range map[uint64]string{2: alice, 3: alice, 4: bob, 5: bob} {
+		hash := make([]byte, 32)
+		hash[0] = byte(id)
+		require.NoError(t, f.keeper.SetContribution(f.ctx, types.NewContribution(id, contributor,
+			"code", "ipfs://QmStatusTest", hash, int64(id*10), time.Now().Unix())))
+	}
+
+	endorse := func(id uint64, validator sdk.AccAddress, decision bool) {
+		_, err := msgSrv.Endorse(f.ctx, &types.MsgEndorse{
+			Validator:      validator.String(),
+			ContributionId: id,
+			Decision:       decision,
+		})
+		require.NoError(t, err)
+	}
+
+	// 1 and 4 verified (300 of 400), 2 rejected (200 of 400 makes quorum
+	// unreachable), 3 and 5 pending with one vote each
+	for _, val := range validators[:3] {
+		endorse(1, val, true)
+		endorse(4, val, true)
+	}
+	endorse(2, validators[0], false)
+	endorse(2, validators[1], false)
+	endorse(3, validators[0], true)
+	endorse(5, validators[3], false)
+
+	list := func(status, contributor string) []uint64 {
+		res, err := qs.ContributionsByStatus(f.ctx, &types.QueryContributionsByStatusRequest{
+			Status:      status,
+			Contributor: contributor,
+		})
+		require.NoError(t, err)
+		return summaryIDs(res.Contributions)
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, list("", ""))
+	require.Equal(t, []uint64{1, 4}, list("verified", ""))
+	require.Equal(t, []uint64{3, 5}, list("pending", ""))
+	require.Equal(t, []uint64{2}, list("rejected", ""))
+	require.Equal(t, []uint64{1, 2, 3}, list("", alice))
+	require.Equal(t, []uint64{3}, list("pending", alice))
+	require.Equal(t, []uint64{4}, list("verified", bob))
+	require.Empty(t, list("rejected", bob))
+
+	// Each summary carries the endorsement tally and submission height
+	res, err := qs.ContributionsByStatus(f.ctx, &types.QueryContributionsByStatusRequest{Status: "rejected"})
+	require.NoError(t, err)
+	require.Len(t, res.Contributions, 1)
+	rejected := res.Contributions[0]
+	require.Equal(t, types.ContributionStatusRejected, rejected.Status)
+	require.Equal(t, "code", rejected.Ctype)
+	require.Equal(t, alice, rejected.Contributor)
+	require.Equal(t, uint32(0), rejected.Approvals)
+	require.Equal(t, uint32(2), rejected.Rejections)
+	require.Equal(t, "200", rejected.RejectionPower.String())
+	require.Equal(t, int64(20), rejected.BlockHeight)
+
+	// A status change moves the contribution between index entries
+	endorse(3, validators[1], true)
+	endorse(3, validators[2], true)
+	require.Equal(t, []uint64{1, 3, 4}, list("verified", ""))
+	require.Equal(t, []uint64{5}, list("pending", ""))
+}
+
+func TestQueryContributionsByStatus_Pagination(t *testing.T) {
+	f := SetupKeeperTest(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	contributor := testAddr1.String()
+	for id := uint64(1); id <= 5; id++ {
+		contribution := types.NewContribution(id, contributor, "code", "ipfs://QmPage", make([]byte, 32), 1, time.Now().Unix())
+		contribution.Verified = id%2 == 1
+		require.NoError(t, f.keeper.SetContribution(f.ctx, contribution))
+	}
+
+	for _, tc := range []struct {
+		status      string
+		contributor string
+		want        []uint64
+	}{
+		{"verified", "", []uint64{1, 3, 5}},
+		{"verified", contributor, []uint64{1, 3, 5}},
+		{"", "", []uint64{1, 2, 3, 4, 5}},
+	} {
+		var got []uint64
+		var nextKey []byte
+		for {
+			res, err := qs.ContributionsByStatus(f.ctx, &types.QueryContributionsByStatusRequest{
+				Status:      tc.status,
+				Contributor: tc.contributor,
+				Pagination:  &query.PageRequest{Key: nextKey, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.Contributions), 2)
+			got = append(got, summaryIDs(res.Contributions)...)
+			if len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = res.Pagination.NextKey
+		}
+		require.Equal(t, tc.want, got, "status %q contributor %q", tc.status, tc.contributor)
+	}
+}
+
+func TestQueryContributionsByStatus_InvalidRequest(t *testing.T) {
+	f := SetupKeeperTest(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.ContributionsByStatus(f.ctx, nil)
+	require.Error(t, err)
+	_, err = qs.ContributionsByStatus(f.ctx, &types.QueryContributionsByStatusRequest{Status: "accepted"})
+	require.ErrorContains(t, err, "unknown contribution status")
+	_, err = qs.ContributionsByStatus(f.ctx, &types.QueryContributionsByStatusRequest{Contributor: "not-an-address"})
+	require.Error(t, err)
+}
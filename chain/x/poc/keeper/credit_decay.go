@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// ============================================================================
+// Time-Based Credit Decay
+//
+// Credits decay linearly at the governance-configured annual rate:
+//   decayed = credits × rate × elapsed / year
+// The fraction is clamped to 1 so credits never go negative. Decay is applied
+// lazily whenever an address's credits are written or withdrawn, using the
+// time elapsed since the last application for that address.
+// ============================================================================
+
+// GetLastCreditDecayTime returns the unix time decay was last applied to an
+// address's credits, and false if it has never been applied.
+func (k Keeper) GetLastCreditDecayTime(ctx context.Context, addr string) (int64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCreditDecayTimeKey(addr))
+	if err != nil || len(bz) != 8 {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetLastCreditDecayTime stores the unix time decay was last applied to an
+// address's credits.
+func (k Keeper) SetLastCreditDecayTime(ctx context.Context, addr string, unixTime int64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCreditDecayTimeKey(addr), sdk.Uint64ToBigEndian(uint64(unixTime)))
+}
+
+// ApplyTimeDecay decays an address's credits for the time elapsed since decay
+// was last applied and returns the amount removed. The first call for an
+// address only starts its decay clock.
+func (k Keeper) ApplyTimeDecay(ctx context.Context, addr sdk.AccAddress) (math.Int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	last, found := k.GetLastCreditDecayTime(ctx, addr.String())
+	if !found {
+		return math.ZeroInt(), k.SetLastCreditDecayTime(ctx, addr.String(), now)
+	}
+	if now <= last {
+		return math.ZeroInt(), nil
+	}
+
+	credits := k.GetCredits(ctx, addr)
+	decayAmount := calculateTimeDecay(credits.Amount, k.GetCreditDecayRate(ctx), now-last)
+
+	if decayAmount.IsPositive() {
+		credits.Amount = credits.Amount.Sub(decayAmount)
+		if err := k.SetCredits(ctx, credits); err != nil {
+			return math.ZeroInt(), fmt.Errorf("failed to save decayed credits: %w", err)
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"credits_decayed",
+				sdk.NewAttribute("address", addr.String()),
+				sdk.NewAttribute("amount", decayAmount.String()),
+				sdk.NewAttribute("remaining", credits.Amount.String()),
+				sdk.NewAttribute("elapsed_seconds", fmt.Sprintf("%d", now-last)),
+			),
+		)
+	}
+
+	if err := k.SetLastCreditDecayTime(ctx, addr.String(), now); err != nil {
+		return math.ZeroInt(), err
+	}
+	return decayAmount, nil
+}
+
+// calculateTimeDecay returns credits × annualRate × elapsed / year, truncated
+// and clamped to [0, credits].
+func calculateTimeDecay(credits math.Int, annualRate math.LegacyDec, elapsedSeconds int64) math.Int {
+	if !credits.IsPositive() || annualRate.IsNil() || !annualRate.IsPositive() || elapsedSeconds <= 0 {
+		return math.ZeroInt()
+	}
+
+	fraction := annualRate.MulInt64(elapsedSeconds).QuoInt64(types.SecondsPerYear)
+	if fraction.GT(math.LegacyOneDec()) {
+		fraction = math.LegacyOneDec()
+	}
+
+	decayAmount := fraction.MulInt(credits).TruncateInt()
+	if decayAmount.GT(credits) {
+		return credits
+	}
+	return decayAmount
+}
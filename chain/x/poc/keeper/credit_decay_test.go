@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/types"
+)
+
+var decayStart = time.Unix(1_700_000_000, 0)
+
+// setupCreditDecay credits addr with amount at decayStart under the given
+// annual decay rate, which starts the address's decay clock.
+func setupCreditDecay(t *testing.T, rate string, amount int64) (*KeeperTestFixture, sdk.Context, sdk.AccAddress) {
+	t.Helper()
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithBlockTime(decayStart)
+
+	require.NoError(t, f.keeper.SetCreditDecayRate(ctx, math.LegacyMustNewDecFromStr(rate)))
+
+	addr := sdk.AccAddress("decay_contributor___")
+	require.NoError(t, f.keeper.AddCreditsWithOverflowCheck(ctx, addr, math.NewInt(amount)))
+	last, found := f.keeper.GetLastCreditDecayTime(ctx, addr.String())
+	require.True(t, found)
+	require.Equal(t, decayStart.Unix(), last)
+
+	return f, ctx, addr
+}
+
+func TestApplyTimeDecay_FullYear(t *testing.T) {
+	f, ctx, addr := setupCreditDecay(t, "0.1", 1000)
+
+	ctx = ctx.WithBlockTime(decayStart.Add(time.Duration(types.SecondsPerYear) * time.Second)).
+		WithEventManager(sdk.NewEventManager())
+	decayed, err := f.keeper.ApplyTimeDecay(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, "100", decayed.String())
+	require.Equal(t, "900", f.keeper.GetCredits(ctx, addr).Amount.String())
+	require.True(t, hasEventType(ctx, "credits_decayed"))
+
+	// Applying again at the same time is a no-op
+	decayed, err = f.keeper.ApplyTimeDecay(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, decayed.IsZero())
+	require.Equal(t, "900", f.keeper.GetCredits(ctx, addr).Amount.String())
+}
+
+func TestApplyTimeDecay_PartialYear(t *testing.T) {
+	f, ctx, addr := setupCreditDecay(t, "0.1", 1000)
+
+	// Half a year at 10%/year removes 5%
+	ctx = ctx.WithBlockTime(decayStart.Add(time.Duration(types.SecondsPerYear/2) * time.Second))
+	decayed, err := f.keeper.ApplyTimeDecay(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, "50", decayed.String())
+
+	// Adding credits settles decay first: another quarter year on 950 removes 23
+	ctx = ctx.WithBlockTime(decayStart.Add(time.Duration(types.SecondsPerYear*3/4) * time.Second))
+	require.NoError(t, f.keeper.AddCreditsWithOverflowCheck(ctx, addr, math.NewInt(100)))
+	require.Equal(t, "1027", f.keeper.GetCredits(ctx, addr).Amount.String())
+}
+
+func TestApplyTimeDecay_NeverNegative(t *testing.T) {
+	f, ctx, addr := setupCreditDecay(t, "1", 10)
+
+	// 100% per year over ten years clamps to the whole balance
+	ctx = ctx.WithBlockTime(decayStart.AddDate(10, 0, 0))
+	decayed, err := f.keeper.ApplyTimeDecay(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, "10", decayed.String())
+	require.True(t, f.keeper.GetCredits(ctx, addr).Amount.IsZero())
+
+	ctx = ctx.WithBlockTime(decayStart.AddDate(20, 0, 0))
+	decayed, err = f.keeper.ApplyTimeDecay(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, decayed.IsZero())
+	require.False(t, f.keeper.GetCredits(ctx, addr).Amount.IsNegative())
+}
+
+func TestApplyTimeDecay_DisabledByDefault(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithBlockTime(decayStart)
+	require.True(t, f.keeper.GetCreditDecayRate(ctx).IsZero())
+
+	addr := sdk.AccAddress("decay_contributor___")
+	require.NoError(t, f.keeper.AddCreditsWithOverflowCheck(ctx, addr, math.NewInt(1000)))
+
+	ctx = ctx.WithBlockTime(decayStart.AddDate(1, 0, 0))
+	decayed, err := f.keeper.ApplyTimeDecay(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, decayed.IsZero())
+	require.Equal(t, "1000", f.keeper.GetCredits(ctx, addr).Amount.String())
+
+	require.Error(t, f.keeper.SetCreditDecayRate(ctx, math.LegacyMustNewDecFromStr("1.5")))
+	require.Error(t, f.keeper.SetCreditDecayRate(ctx, math.LegacyMustNewDecFromStr("-0.1")))
+}
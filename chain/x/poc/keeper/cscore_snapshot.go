@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pos/x/poc/types"
+)
+
+// ============================================================================
+// Historical C-Score Snapshots
+//
+// Every credit balance change is recorded under (address, height) so the
+// C-Score an account held at a past height can be recovered when disputing
+// rewards or fraud proofs. Unchanged balances are not re-recorded, and on each
+// write the address's snapshots older than the retention window are pruned,
+// keeping only the newest of them so lookups at the start of the window still
+// resolve.
+// ============================================================================
+
+// recordCScoreSnapshot records addr's balance at the current height if it
+// differs from the latest snapshot, then prunes snapshots beyond the window.
+func (k Keeper) recordCScoreSnapshot(ctx context.Context, addr string, amount math.Int) error {
+	if amount.IsNil() {
+		amount = math.ZeroInt()
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	if latest, found := k.GetCScoreAt(ctx, addr, height); found && latest.Credits.Equal(amount) {
+		return nil
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCScoreSnapshotKey(addr, height), bz); err != nil {
+		return err
+	}
+
+	return k.pruneCScoreSnapshots(ctx, addr, height-k.GetCScoreSnapshotWindow(ctx))
+}
+
+// pruneCScoreSnapshots deletes addr's snapshots at or before cutoff except the
+// newest of them, which still holds the balance at the start of the window.
+func (k Keeper) pruneCScoreSnapshots(ctx context.Context, addr string, cutoff int64) error {
+	if cutoff <= 0 {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(
+		types.GetCScoreSnapshotPrefix(addr),
+		types.GetCScoreSnapshotKey(addr, cutoff+1),
+	)
+	if err != nil {
+		return err
+	}
+
+	var stale [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		stale = append(stale, append([]byte(nil), iterator.Key()...))
+	}
+	iterator.Close()
+
+	// Keep the newest snapshot at or before the cutoff
+	if len(stale) > 0 {
+		stale = stale[:len(stale)-1]
+	}
+	for _, key := range stale {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCScoreAt returns addr's C-Score snapshot at or before height
+func (k Keeper) GetCScoreAt(ctx context.Context, addr string, height int64) (types.CScoreSnapshot, bool) {
+	if height < 0 {
+		return types.CScoreSnapshot{}, false
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.ReverseIterator(
+		types.GetCScoreSnapshotPrefix(addr),
+		types.GetCScoreSnapshotKey(addr, height+1),
+	)
+	if err != nil {
+		return types.CScoreSnapshot{}, false
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.CScoreSnapshot{}, false
+	}
+	snapshot, err := k.decodeCScoreSnapshot(addr, iterator.Key(), iterator.Value())
+	if err != nil {
+		k.logger.Error("failed to decode C-Score snapshot", "address", addr, "error", err)
+		return types.CScoreSnapshot{}, false
+	}
+	return snapshot, true
+}
+
+// GetAllCScoreSnapshots returns every stored C-Score snapshot (used for genesis export)
+func (k Keeper) GetAllCScoreSnapshots(ctx context.Context) []types.CScoreSnapshot {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(
+		types.KeyPrefixCScoreSnapshot,
+		storetypes.PrefixEndBytes(types.KeyPrefixCScoreSnapshot),
+	)
+	if err != nil {
+		return nil
+	}
+	defer iterator.Close()
+
+	var snapshots []types.CScoreSnapshot
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) < len(types.KeyPrefixCScoreSnapshot)+1 {
+			continue
+		}
+		addrLen := int(key[len(types.KeyPrefixCScoreSnapshot)])
+		start := len(types.KeyPrefixCScoreSnapshot) + 1
+		if len(key) != start+addrLen+8 {
+			continue
+		}
+		snapshot, err := k.decodeCScoreSnapshot(string(key[start:start+addrLen]), key, iterator.Value())
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// SetCScoreSnapshot stores a snapshot as-is, without change detection or
+// pruning (used for genesis import)
+func (k Keeper) SetCScoreSnapshot(ctx context.Context, snapshot types.CScoreSnapshot) error {
+	bz, err := snapshot.Credits.Marshal()
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetCScoreSnapshotKey(snapshot.Address, snapshot.Height), bz)
+}
+
+func (k Keeper) decodeCScoreSnapshot(addr string, key, value []byte) (types.CScoreSnapshot, error) {
+	height := int64(sdk.BigEndianToUint64(key[len(key)-8:]))
+	var credits math.Int
+	if err := credits.Unmarshal(value); err != nil {
+		return types.CScoreSnapshot{}, err
+	}
+	return types.CScoreSnapshot{Address: addr, Height: height, Credits: credits}, nil
+}
+
+// CScoreAt returns an address's C-Score as of a past height. Not part of the
+// generated QueryServer interface.
+func (qs queryServer) CScoreAt(goCtx context.Context, req *types.QueryCScoreAtRequest) (*types.QueryCScoreAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	snapshot, found := qs.GetCScoreAt(goCtx, req.Address, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no C-Score snapshot for %s at or before height %d", req.Address, req.Height)
+	}
+
+	return &types.QueryCScoreAtResponse{Snapshot: snapshot}, nil
+}
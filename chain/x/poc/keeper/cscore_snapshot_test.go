@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+// cscoreAtQueryServer exposes the hand-written CScoreAt query, which is not
+// part of the generated types.QueryServer interface
+type cscoreAtQueryServer interface {
+	CScoreAt(context.Context, *types.QueryCScoreAtRequest) (*types.QueryCScoreAtResponse, error)
+}
+
+var snapshotAddr = sdk.AccAddress("snapshot_contributor")
+
+// setCreditsAt sets snapshotAddr's credit balance at the given height
+func setCreditsAt(t *testing.T, f *KeeperTestFixture, height, amount int64) {
+	t.Helper()
+	require.NoError(t, f.keeper.SetCredits(f.ctx.WithBlockHeight(height), types.Credits{
+		Address: snapshotAddr.String(),
+		Amount:  math.NewInt(amount),
+	}))
+}
+
+func TestCScoreAt_HistoricalLookup(t *testing.T) {
+	f := SetupKeeperTest(t)
+	setCreditsAt(t, f, 10, 100)
+	setCreditsAt(t, f, 20, 250)
+	setCreditsAt(t, f, 30, 250) // unchanged: no new snapshot
+	setCreditsAt(t, f, 40, 50)
+
+	qs, ok := keeper.NewQueryServerImpl(f.keeper).(cscoreAtQueryServer)
+	require.True(t, ok)
+
+	for _, tc := range []struct {
+		height, snapshotHeight int64
+		credits                string
+	}{
+		{10, 10, "100"},
+		{15, 10, "100"},
+		{20, 20, "250"},
+		{35, 20, "250"}, // the unchanged write at 30 did not add a snapshot
+		{40, 40, "50"},
+		{1000, 40, "50"},
+	} {
+		res, err := qs.CScoreAt(f.ctx, &types.QueryCScoreAtRequest{Address: snapshotAddr.String(), Height: tc.height})
+		require.NoError(t, err, "height %d", tc.height)
+		require.Equal(t, tc.snapshotHeight, res.Snapshot.Height, "height %d", tc.height)
+		require.Equal(t, tc.credits, res.Snapshot.Credits.String(), "height %d", tc.height)
+	}
+
+	// Nothing recorded before the first change
+	_, err := qs.CScoreAt(f.ctx, &types.QueryCScoreAtRequest{Address: snapshotAddr.String(), Height: 9})
+	require.Error(t, err)
+
+	// Credits earned through the normal path are snapshotted too
+	ctx := f.ctx.WithBlockHeight(50)
+	require.NoError(t, f.keeper.AddCreditsWithOverflowCheck(ctx, snapshotAddr, math.NewInt(25)))
+	snapshot, found := f.keeper.GetCScoreAt(ctx, snapshotAddr.String(), 50)
+	require.True(t, found)
+	require.Equal(t, "75", snapshot.Credits.String())
+}
+
+func TestCScoreAt_PrunesBeyondWindow(t *testing.T) {
+	f := SetupKeeperTest(t)
+	require.NoError(t, f.keeper.SetCScoreSnapshotWindow(f.ctx, 10))
+
+	setCreditsAt(t, f, 1, 100)
+	setCreditsAt(t, f, 5, 200)
+	setCreditsAt(t, f, 8, 300)
+
+	// Writing at 18 puts the window start at 8: snapshots at 1 and 5 are
+	// pruned, and 8 is kept as the balance at the start of the window
+	setCreditsAt(t, f, 18, 400)
+
+	_, found := f.keeper.GetCScoreAt(f.ctx, snapshotAddr.String(), 5)
+	require.False(t, found)
+
+	snapshot, found := f.keeper.GetCScoreAt(f.ctx, snapshotAddr.String(), 12)
+	require.True(t, found)
+	require.Equal(t, int64(8), snapshot.Height)
+	require.Equal(t, "300", snapshot.Credits.String())
+
+	snapshots := f.keeper.GetAllCScoreSnapshots(f.ctx)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, int64(8), snapshots[0].Height)
+	require.Equal(t, int64(18), snapshots[1].Height)
+
+	require.Error(t, f.keeper.SetCScoreSnapshotWindow(f.ctx, 0))
+}
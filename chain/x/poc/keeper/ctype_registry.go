@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// ============================================================================
+// Contribution Type Registry
+//
+// A contribution type is registered when it has an entry in the ctype weights
+// map (see IsRegisteredCtype). Its access requirements live in the
+// MinCscoreForCtype and RequireIdentityForCtype params. Governance manages all
+// three together through MsgRegisterContributionType, and MsgSubmitContribution
+// rejects types that are not registered.
+// ============================================================================
+
+// GetContributionType returns the registry entry for ctype
+func (k Keeper) GetContributionType(ctx context.Context, ctype string) (types.ContributionType, bool) {
+	weight, ok := k.GetCtypeWeights(ctx)[ctype]
+	if !ok {
+		return types.ContributionType{}, false
+	}
+	return k.contributionTypeEntry(k.GetParams(ctx), ctype, weight), true
+}
+
+// GetAllContributionTypes returns every registered contribution type, sorted by name
+func (k Keeper) GetAllContributionTypes(ctx context.Context) []types.ContributionType {
+	weights := k.GetCtypeWeights(ctx)
+	params := k.GetParams(ctx)
+
+	ctypes := make([]string, 0, len(weights))
+	for ctype := range weights {
+		ctypes = append(ctypes, ctype)
+	}
+	sort.Strings(ctypes)
+
+	entries := make([]types.ContributionType, 0, len(ctypes))
+	for _, ctype := range ctypes {
+		entries = append(entries, k.contributionTypeEntry(params, ctype, weights[ctype]))
+	}
+	return entries
+}
+
+func (k Keeper) contributionTypeEntry(params types.Params, ctype string, weight uint32) types.ContributionType {
+	minCscore, ok := params.MinCscoreForCtype[ctype]
+	if !ok || minCscore.IsNil() {
+		minCscore = math.ZeroInt()
+	}
+	return types.ContributionType{
+		Ctype:            ctype,
+		RewardWeight:     weight,
+		MinCscore:        minCscore,
+		RequiresIdentity: params.RequireIdentityForCtype[ctype],
+	}
+}
+
+// SetContributionType registers ctype or replaces its reward weight and
+// requirements. A zero MinCscore or false RequiresIdentity clears the
+// corresponding requirement.
+func (k Keeper) SetContributionType(ctx context.Context, ct types.ContributionType) error {
+	if err := ct.Validate(); err != nil {
+		return err
+	}
+
+	weights := k.GetCtypeWeights(ctx)
+	weights[ct.Ctype] = ct.RewardWeight
+	if err := k.SetCtypeWeights(ctx, weights); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	if params.MinCscoreForCtype == nil {
+		params.MinCscoreForCtype = make(map[string]math.Int)
+	}
+	if ct.MinCscore.IsPositive() {
+		params.MinCscoreForCtype[ct.Ctype] = ct.MinCscore
+	} else {
+		delete(params.MinCscoreForCtype, ct.Ctype)
+	}
+	if params.RequireIdentityForCtype == nil {
+		params.RequireIdentityForCtype = make(map[string]bool)
+	}
+	if ct.RequiresIdentity {
+		params.RequireIdentityForCtype[ct.Ctype] = true
+	} else {
+		delete(params.RequireIdentityForCtype, ct.Ctype)
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		"poc_ctype_registered",
+		sdk.NewAttribute("ctype", ct.Ctype),
+		sdk.NewAttribute("reward_weight", fmt.Sprintf("%d", ct.RewardWeight)),
+		sdk.NewAttribute("min_cscore", ct.MinCscore.String()),
+		sdk.NewAttribute("requires_identity", fmt.Sprintf("%t", ct.RequiresIdentity)),
+	))
+
+	return nil
+}
+
+// RegisterContributionType handles MsgRegisterContributionType (governance only)
+func (ms msgServer) RegisterContributionType(goCtx context.Context, msg *types.MsgRegisterContributionType) (*types.MsgRegisterContributionTypeResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetContributionType(goCtx, msg.ContributionType()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterContributionTypeResponse{}, nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+func setupCtypeRegistry(t *testing.T) (*KeeperTestFixture, sdk.Context, types.MsgServer, sdk.AccAddress) {
+	t.Helper()
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	contributor := sdk.AccAddress("contributor_________")
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", math.NewInt(10_000_000))
+	return f, ctx, msgSrv, contributor
+}
+
+func TestRegisterContributionType_NewType(t *testing.T) {
+	f, ctx, msgSrv, contributor := setupCtypeRegistry(t)
+
+	msg := newSubmitContributionMsg(contributor)
+	msg.Ctype = "security"
+	_, err := msgSrv.SubmitContribution(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidCType)
+
+	_, err = msgSrv.RegisterContributionType(ctx, &types.MsgRegisterContributionType{
+		Authority:    f.keeper.GetAuthority(),
+		Ctype:        "security",
+		RewardWeight: 300,
+		MinCscore:    math.ZeroInt(),
+	})
+	require.NoError(t, err)
+	require.True(t, hasEventType(ctx, "poc_ctype_registered"))
+
+	ct, found := f.keeper.GetContributionType(ctx, "security")
+	require.True(t, found)
+	require.Equal(t, uint32(300), ct.RewardWeight)
+	require.True(t, ct.MinCscore.IsZero())
+	require.False(t, ct.RequiresIdentity)
+	require.Equal(t, uint32(300), f.keeper.GetCtypeWeights(ctx)["security"])
+
+	_, err = msgSrv.SubmitContribution(ctx, msg)
+	require.NoError(t, err)
+
+	// Only governance may register types
+	_, err = msgSrv.RegisterContributionType(ctx, &types.MsgRegisterContributionType{
+		Authority:    contributor.String(),
+		Ctype:        "phantom",
+		RewardWeight: 100,
+		MinCscore:    math.ZeroInt(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	require.False(t, f.keeper.IsRegisteredCtype(ctx, "phantom"))
+}
+
+func TestSubmitContribution_UnregisteredType(t *testing.T) {
+	f, ctx, msgSrv, contributor := setupCtypeRegistry(t)
+
+	// A typo of a registered type is rejected before any fee is taken
+	msg := newSubmitContributionMsg(contributor)
+	msg.Ctype = "cdoe"
+	_, err := msgSrv.SubmitContribution(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidCType)
+	require.Contains(t, err.Error(), `"cdoe" is not registered`)
+
+	require.Equal(t, "10000000", f.bankKeeper.GetBalance(ctx, contributor, "omniphi").Amount.String())
+	_, found := f.keeper.GetContribution(ctx, 1)
+	require.False(t, found)
+}
+
+func TestRegisterContributionType_UpdateRequirements(t *testing.T) {
+	f, ctx, msgSrv, contributor := setupCtypeRegistry(t)
+
+	params := f.keeper.GetParams(ctx)
+	params.EnableCscoreGating = true
+	params.EnableIdentityGating = true
+	require.NoError(t, f.keeper.SetParams(ctx, params))
+
+	// Raise the bar for "code"
+	_, err := msgSrv.RegisterContributionType(ctx, &types.MsgRegisterContributionType{
+		Authority:    f.keeper.GetAuthority(),
+		Ctype:        "code",
+		RewardWeight: 250,
+		MinCscore:    math.NewInt(1000),
+	})
+	require.NoError(t, err)
+
+	ct, found := f.keeper.GetContributionType(ctx, "code")
+	require.True(t, found)
+	require.Equal(t, uint32(250), ct.RewardWeight)
+	require.Equal(t, "1000", ct.MinCscore.String())
+
+	_, err = msgSrv.SubmitContribution(ctx, newSubmitContributionMsg(contributor))
+	require.ErrorIs(t, err, types.ErrInsufficientCScore)
+
+	// Swap the C-Score requirement for an identity requirement
+	_, err = msgSrv.RegisterContributionType(ctx, &types.MsgRegisterContributionType{
+		Authority:        f.keeper.GetAuthority(),
+		Ctype:            "code",
+		RewardWeight:     250,
+		MinCscore:        math.ZeroInt(),
+		RequiresIdentity: true,
+	})
+	require.NoError(t, err)
+
+	params = f.keeper.GetParams(ctx)
+	_, hasMin := params.MinCscoreForCtype["code"]
+	require.False(t, hasMin)
+	require.True(t, params.RequireIdentityForCtype["code"])
+
+	_, err = msgSrv.SubmitContribution(ctx, newSubmitContributionMsg(contributor))
+	require.ErrorIs(t, err, types.ErrIdentityCheckFailed)
+
+	// Invalid entries are rejected
+	_, err = msgSrv.RegisterContributionType(ctx, &types.MsgRegisterContributionType{
+		Authority:    f.keeper.GetAuthority(),
+		Ctype:        "code",
+		RewardWeight: 0,
+		MinCscore:    math.ZeroInt(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCType)
+}
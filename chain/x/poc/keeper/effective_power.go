@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pos/x/poc/types"
+)
+
+// GetEffectivePower returns the stake of the validator operated by addr boosted
+// by addr's C-Score: Stake × (1 + min(PocAlpha × Credits, MaxCreditBonus)).
+// Addresses that do not operate a validator have zero stake.
+func (k Keeper) GetEffectivePower(ctx context.Context, addr sdk.AccAddress) types.EffectivePower {
+	stake := math.ZeroInt()
+	if validator, err := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(addr)); err == nil {
+		stake = validator.GetTokens()
+	}
+
+	credits := k.GetCredits(ctx, addr).Amount
+	return types.NewEffectivePower(addr.String(), stake, credits, k.GetEffectivePowerParams(ctx))
+}
+
+// EffectivePower returns an address's stake, credits, the active PocAlpha and
+// the resulting C-Score boosted voting power.
+func (qs queryServer) EffectivePower(goCtx context.Context, req *types.QueryEffectivePowerRequest) (*types.QueryEffectivePowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	return &types.QueryEffectivePowerResponse{EffectivePower: qs.GetEffectivePower(goCtx, addr)}, nil
+}
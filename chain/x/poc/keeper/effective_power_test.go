@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+var powerOperator = sdk.AccAddress("power_operator______")
+
+// queryEffectivePower credits powerOperator (a 100M-token validator in the
+// mock staking keeper) and queries its effective power
+func queryEffectivePower(t *testing.T, f *KeeperTestFixture, credits int64) types.EffectivePower {
+	t.Helper()
+	if credits > 0 {
+		require.NoError(t, f.keeper.AddCreditsWithOverflowCheck(f.ctx, powerOperator, math.NewInt(credits)))
+	}
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	res, err := qs.EffectivePower(f.ctx, &types.QueryEffectivePowerRequest{Address: powerOperator.String()})
+	require.NoError(t, err)
+	return res.EffectivePower
+}
+
+func TestEffectivePower_ZeroCredits(t *testing.T) {
+	f := SetupKeeperTest(t)
+
+	power := queryEffectivePower(t, f, 0)
+	require.Equal(t, "100000000", power.Stake.String())
+	require.True(t, power.Credits.IsZero())
+	require.True(t, power.PocAlpha.Equal(types.DefaultEffectivePowerParams().PocAlpha))
+	require.True(t, power.CreditBonus.IsZero())
+	require.Equal(t, power.Stake.String(), power.EffectivePower.String())
+}
+
+func TestEffectivePower_ModerateCredits(t *testing.T) {
+	f := SetupKeeperTest(t)
+
+	// 2,500 credits × α=0.0001 = +25%
+	power := queryEffectivePower(t, f, 2500)
+	require.Equal(t, "2500", power.Credits.String())
+	require.True(t, power.CreditBonus.Equal(math.LegacyNewDecWithPrec(25, 2)))
+	require.Equal(t, "125000000", power.EffectivePower.String())
+}
+
+func TestEffectivePower_ClampBoundary(t *testing.T) {
+	f := SetupKeeperTest(t)
+	require.NoError(t, f.keeper.SetEffectivePowerParams(f.ctx, types.EffectivePowerParams{
+		PocAlpha:       math.LegacyNewDecWithPrec(1, 4),
+		MaxCreditBonus: math.LegacyNewDecWithPrec(5, 1),
+	}))
+
+	// Exactly at the cap: 5,000 credits × 0.0001 = 0.5
+	power := queryEffectivePower(t, f, 5000)
+	require.True(t, power.CreditBonus.Equal(math.LegacyNewDecWithPrec(5, 1)))
+	require.Equal(t, "150000000", power.EffectivePower.String())
+
+	// Far past the cap the bonus stays clamped
+	power = queryEffectivePower(t, f, 995000)
+	require.Equal(t, "1000000", power.Credits.String())
+	require.True(t, power.CreditBonus.Equal(power.MaxCreditBonus))
+	require.Equal(t, "150000000", power.EffectivePower.String())
+
+	require.Error(t, f.keeper.SetEffectivePowerParams(f.ctx, types.EffectivePowerParams{
+		PocAlpha:       math.LegacyNewDecWithPrec(-1, 4),
+		MaxCreditBonus: math.LegacyOneDec(),
+	}))
+}
+
+func TestEffectivePower_NonValidator(t *testing.T) {
+	f := setupKeeperTestWithStaking(t, validatorSetStakingKeeper{validators: map[string]stakingtypes.Validator{}})
+
+	// Credits alone grant no power without stake
+	power := queryEffectivePower(t, f, 2500)
+	require.True(t, power.Stake.IsZero())
+	require.True(t, power.EffectivePower.IsZero())
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	_, err := qs.EffectivePower(f.ctx, &types.QueryEffectivePowerRequest{Address: "invalid"})
+	require.Error(t, err)
+}
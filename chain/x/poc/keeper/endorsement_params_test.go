@@ -0,0 +1,328 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+// setupUnevenEndorseTest creates one bonded validator per entry in tokens and
+// a pending contribution with ID 1
+func setupUnevenEndorseTest(t *testing.T, tokens ...int64) (*KeeperTestFixture, []sdk.AccAddress) {
+	t.Helper()
+
+	sk := validatorSetStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	validators := make([]sdk.AccAddress, 0, len(tokens))
+	for i, amount := range tokens {
+		acc := sdk.AccAddress(fmt.Sprintf("validator_%02d________", i))
+		valAddr := sdk.ValAddress(acc)
+		sk.validators[valAddr.String()] = stakingtypes.Validator{
+			OperatorAddress: valAddr.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          math.NewInt(amount),
+			DelegatorShares: math.LegacyNewDec(amount),
+		}
+		validators = append(validators, acc)
+	}
+
+	f := setupKeeperTestWithStaking(t, sk)
+
+	hash := make([]byte, 32)
+	hash[0] = 0xe3
+	require.NoError(t, f.keeper.SetContribution(f.ctx, types.NewContribution(1,
+		sdk.AccAddress("contributor_________").String(), "code", "ipfs://QmUneven", hash, 0, time.Now().Unix())))
+
+	return f, validators
+}
+
+func TestMinEndorsements_PercentagePassesCountFails(t *testing.T) {
+	// One validator holds 300 of 400 tokens, above the 268 power threshold
+	f, validators := setupUnevenEndorseTest(t, 300, 25, 25, 25, 25)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	resp, err := msgSrv.Endorse(f.ctx, endorseMsg(validators[0], true))
+	require.NoError(t, err)
+	require.False(t, resp.Verified, "one approval is below the default minimum of 3")
+
+	resp, err = msgSrv.Endorse(f.ctx, endorseMsg(validators[1], true))
+	require.NoError(t, err)
+	require.False(t, resp.Verified)
+
+	resp, err = msgSrv.Endorse(f.ctx, endorseMsg(validators[2], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+
+	contribution, _ := f.keeper.GetContribution(f.ctx, 1)
+	require.True(t, contribution.Verified)
+	require.Equal(t, uint32(3), contribution.GetApprovalCount())
+}
+
+func TestMinEndorsements_CountPassesPercentageFails(t *testing.T) {
+	f, validators := setupUnevenEndorseTest(t, 300, 25, 25, 25, 25)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	// Four approvals meet the count, but 100 of 400 is below the 268 threshold
+	for _, val := range validators[1:] {
+		resp, err := msgSrv.Endorse(f.ctx, endorseMsg(val, true))
+		require.NoError(t, err)
+		require.False(t, resp.Verified)
+	}
+
+	contribution, _ := f.keeper.GetContribution(f.ctx, 1)
+	require.False(t, contribution.Verified)
+	require.Equal(t, uint32(4), contribution.GetApprovalCount())
+	require.NotEqual(t, uint32(types.ReviewStatusRejected), contribution.ReviewStatus)
+
+	// The large validator brings the power over the threshold
+	resp, err := msgSrv.Endorse(f.ctx, endorseMsg(validators[0], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+}
+
+func TestMinEndorsements_Configurable(t *testing.T) {
+	f, validators := setupUnevenEndorseTest(t, 300, 25, 25, 25, 25)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	// With a minimum of 1, power alone decides
+	require.NoError(t, f.keeper.SetEndorsementParams(f.ctx, types.EndorsementParams{MinEndorsements: 1}))
+	resp, err := msgSrv.Endorse(f.ctx, endorseMsg(validators[0], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+
+	// Lowering the percentage lets the small validators verify, once enough of
+	// them approve
+	f, validators = setupUnevenEndorseTest(t, 300, 25, 25, 25, 25)
+	msgSrv = keeper.NewMsgServerImpl(f.keeper)
+	params := f.keeper.GetParams(f.ctx)
+	params.QuorumPct = math.LegacyNewDecWithPrec(10, 2)
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	require.NoError(t, f.keeper.SetEndorsementParams(f.ctx, types.EndorsementParams{MinEndorsements: 4}))
+
+	for _, val := range validators[1:4] {
+		resp, err := msgSrv.Endorse(f.ctx, endorseMsg(val, true))
+		require.NoError(t, err)
+		require.False(t, resp.Verified)
+	}
+	resp, err = msgSrv.Endorse(f.ctx, endorseMsg(validators[4], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+}
+
+func TestEndorsementParams_Validation(t *testing.T) {
+	f := SetupKeeperTest(t)
+
+	require.Equal(t, types.DefaultEndorsementParams(), f.keeper.GetEndorsementParams(f.ctx))
+	require.Equal(t, types.DefaultMinEndorsements, f.keeper.GetEndorsementParams(f.ctx).MinEndorsements)
+
+	require.Error(t, f.keeper.SetEndorsementParams(f.ctx, types.EndorsementParams{MinEndorsements: 0}))
+	require.Error(t, f.keeper.SetEndorsementParams(f.ctx, types.EndorsementParams{MinEndorsements: types.MaxMinEndorsements + 1}))
+	require.Equal(t, types.DefaultEndorsementParams(), f.keeper.GetEndorsementParams(f.ctx))
+
+	require.NoError(t, f.keeper.SetEndorsementParams(f.ctx, types.EndorsementParams{MinEndorsements: 5}))
+	require.Equal(t, uint32(5), f.keeper.GetEndorsementParams(f.ctx).MinEndorsements)
+}
+
+// endorseUnder runs the votes against a fresh uneven validator set (one 300
+// token validator, four 25 token validators) under the given weighting and
+// returns the resulting contribution
+func endorseUnder(t *testing.T, weighting string, votes map[int]bool) types.Contribution {
+	t.Helper()
+
+	f, validators := setupUnevenEndorseTest(t, 300, 25, 25, 25, 25)
+	require.NoError(t, f.keeper.SetEndorsementParams(f.ctx, types.EndorsementParams{
+		MinEndorsements: 1,
+		Weighting:       weighting,
+	}))
+
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+	for i := range validators {
+		decision, ok := votes[i]
+		if !ok {
+			continue
+		}
+		_, err := msgSrv.Endorse(f.ctx, endorseMsg(validators[i], decision))
+		require.NoError(t, err)
+	}
+
+	// Verification under either mode satisfies the quorum invariant
+	_, broken := keeper.QuorumCorrectnessInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+
+	contribution, found := f.keeper.GetContribution(f.ctx, 1)
+	require.True(t, found)
+	return contribution
+}
+
+func TestEndorsementWeighting_SmallValidatorsColluding(t *testing.T) {
+	// The four small validators approve: 4 of 5 validators, but 100 of 400 tokens
+	votes := map[int]bool{1: true, 2: true, 3: true, 4: true}
+
+	equal := endorseUnder(t, types.EndorsementWeightingEqual, votes)
+	require.True(t, equal.Verified, "4 of 5 validators meets ceil(67% of 5) = 4")
+
+	power := endorseUnder(t, types.EndorsementWeightingPower, votes)
+	require.False(t, power.Verified, "100 of 400 tokens is below 268")
+	require.NotEqual(t, uint32(types.ReviewStatusRejected), power.ReviewStatus)
+}
+
+func TestEndorsementWeighting_LargeValidatorApproves(t *testing.T) {
+	// The large validator and two small ones approve: 3 of 5 validators, but
+	// 350 of 400 tokens
+	votes := map[int]bool{0: true, 1: true, 2: true}
+
+	equal := endorseUnder(t, types.EndorsementWeightingEqual, votes)
+	require.False(t, equal.Verified, "3 of 5 validators is below 4")
+
+	power := endorseUnder(t, types.EndorsementWeightingPower, votes)
+	require.True(t, power.Verified)
+}
+
+func TestEndorsementWeighting_Rejection(t *testing.T) {
+	// Only the large validator rejects
+	votes := map[int]bool{0: false}
+
+	// 300 rejecting leaves at most 100 approving tokens, below 268
+	power := endorseUnder(t, types.EndorsementWeightingPower, votes)
+	require.Equal(t, uint32(types.ReviewStatusRejected), power.ReviewStatus)
+
+	// One rejection still leaves 4 validators that could approve
+	equal := endorseUnder(t, types.EndorsementWeightingEqual, votes)
+	require.NotEqual(t, uint32(types.ReviewStatusRejected), equal.ReviewStatus)
+
+	// Two rejections make 4 approvals unreachable
+	equal = endorseUnder(t, types.EndorsementWeightingEqual, map[int]bool{0: false, 1: false})
+	require.Equal(t, uint32(types.ReviewStatusRejected), equal.ReviewStatus)
+}
+
+func TestEndorsementWeighting_DefaultAndValidation(t *testing.T) {
+	require.True(t, types.DefaultEndorsementParams().WeightByPower())
+	require.Equal(t, types.EndorsementWeightingPower, types.DefaultEndorsementParams().Weighting)
+
+	// Sidecars stored before the field existed keep power weighting
+	legacy := types.EndorsementParams{MinEndorsements: 3}
+	require.NoError(t, legacy.Validate())
+	require.True(t, legacy.WeightByPower())
+
+	equal := types.EndorsementParams{MinEndorsements: 3, Weighting: types.EndorsementWeightingEqual}
+	require.NoError(t, equal.Validate())
+	require.False(t, equal.WeightByPower())
+
+	require.Error(t, types.EndorsementParams{MinEndorsements: 3, Weighting: "stake"}.Validate())
+}
+
+func TestEndorsementWeighting_SnapshotSurvivesParamChange(t *testing.T) {
+	f, validators := setupUnevenEndorseTest(t, 300, 25, 25, 25, 25)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+	_, err := msgSrv.UpdateEndorsementParams(f.ctx, &types.MsgUpdateEndorsementParams{
+		Authority:       f.keeper.GetAuthority(),
+		MinEndorsements: 1,
+		Weighting:       types.EndorsementWeightingEqual,
+	})
+	require.NoError(t, err)
+
+	// The four small validators verify under equal weighting
+	for _, val := range validators[1:] {
+		_, err := msgSrv.Endorse(f.ctx, endorseMsg(val, true))
+		require.NoError(t, err)
+	}
+	contribution, _ := f.keeper.GetContribution(f.ctx, 1)
+	require.True(t, contribution.Verified)
+	require.Equal(t, types.EndorsementWeightingEqual, contribution.QuorumWeighting)
+	require.Equal(t, "4", contribution.QuorumRequired.String())
+
+	// Switching to power weighting, where 100 of 400 tokens is short of 268,
+	// does not make the earlier verification look fraudulent
+	_, err = msgSrv.UpdateEndorsementParams(f.ctx, &types.MsgUpdateEndorsementParams{
+		Authority:       f.keeper.GetAuthority(),
+		MinEndorsements: 1,
+		Weighting:       types.EndorsementWeightingPower,
+	})
+	require.NoError(t, err)
+
+	_, broken := keeper.QuorumCorrectnessInvariant(f.keeper)(f.ctx)
+	require.False(t, broken)
+	fraud, err := f.keeper.VerifyFraudProof(f.ctx, 1, types.FraudProofInvalidQuorum, nil)
+	require.NoError(t, err)
+	require.False(t, fraud)
+
+	// A contribution whose snapshot it does not meet is still caught
+	contribution.QuorumRequired = math.NewInt(5)
+	require.NoError(t, f.keeper.SetContribution(f.ctx, contribution))
+	_, broken = keeper.QuorumCorrectnessInvariant(f.keeper)(f.ctx)
+	require.True(t, broken)
+	fraud, err = f.keeper.VerifyFraudProof(f.ctx, 1, types.FraudProofInvalidQuorum, nil)
+	require.NoError(t, err)
+	require.True(t, fraud)
+}
+
+func TestUpdateEndorsementParams_Msg(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgSrv.UpdateEndorsementParams(ctx, &types.MsgUpdateEndorsementParams{
+		Authority:       testAddr1.String(),
+		MinEndorsements: 5,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	for _, msg := range []*types.MsgUpdateEndorsementParams{
+		{Authority: f.keeper.GetAuthority(), MinEndorsements: 0},
+		{Authority: f.keeper.GetAuthority(), MinEndorsements: types.MaxMinEndorsements + 1},
+		{Authority: f.keeper.GetAuthority(), MinEndorsements: 5, Weighting: "stake"},
+	} {
+		require.Error(t, msg.ValidateBasic())
+		_, err := msgSrv.UpdateEndorsementParams(ctx, msg)
+		require.Error(t, err)
+	}
+	require.Equal(t, types.DefaultEndorsementParams(), f.keeper.GetEndorsementParams(ctx))
+
+	// An empty weighting keeps power weighting
+	_, err = msgSrv.UpdateEndorsementParams(ctx, &types.MsgUpdateEndorsementParams{
+		Authority:       f.keeper.GetAuthority(),
+		MinEndorsements: 5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.EndorsementParams{MinEndorsements: 5, Weighting: types.EndorsementWeightingPower},
+		f.keeper.GetEndorsementParams(ctx))
+	require.True(t, hasEventType(ctx, "poc_endorsement_params_updated"))
+}
+
+func TestMinEndorsements_CappedAtBondedValidators(t *testing.T) {
+	// Two bonded validators can never supply the default three approvals
+	f, validators := setupUnevenEndorseTest(t, 100, 100)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+	require.Equal(t, types.DefaultMinEndorsements, f.keeper.GetEndorsementParams(f.ctx).MinEndorsements)
+
+	resp, err := msgSrv.Endorse(f.ctx, endorseMsg(validators[0], true))
+	require.NoError(t, err)
+	require.False(t, resp.Verified, "one of two validators is below the capped minimum")
+
+	resp, err = msgSrv.Endorse(f.ctx, endorseMsg(validators[1], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified, "every bonded validator approved")
+
+	// Governance can lower the minimum instead of waiting for the set to grow
+	f, validators = setupUnevenEndorseTest(t, 100, 100)
+	msgSrv = keeper.NewMsgServerImpl(f.keeper)
+	params := f.keeper.GetParams(f.ctx)
+	params.QuorumPct = math.LegacyNewDecWithPrec(50, 2)
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+	_, err = msgSrv.UpdateEndorsementParams(f.ctx, &types.MsgUpdateEndorsementParams{
+		Authority:       f.keeper.GetAuthority(),
+		MinEndorsements: 1,
+	})
+	require.NoError(t, err)
+
+	resp, err = msgSrv.Endorse(f.ctx, endorseMsg(validators[0], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+}
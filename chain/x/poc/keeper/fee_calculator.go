@@ -24,6 +24,15 @@ import (
 // 4. ensure final_fee >= MinimumSubmissionFee
 
 // SubmissionCounterKey is the transient store key for tracking submissions per block
+//
+// Counter lifecycle, per block:
+//  1. BeginBlock: ResetBlockSubmissions clears any leftover count
+//  2. Each MsgSubmitContribution: the fee is priced from the count so far, then
+//     IncrementBlockSubmissions counts the submission
+//  3. EndBlock: EndBlockSubmissions emits the block's count and resets it
+//
+// Without the resets a busy block would keep the epoch multiplier elevated for
+// every later block wherever the transient store is not cleared on commit.
 var SubmissionCounterKey = []byte("submission_counter")
 
 // Calculate3LayerFee computes the final fee using all three layers
@@ -38,6 +47,19 @@ func (k Keeper) Calculate3LayerFee(ctx context.Context, contributor sdk.AccAddre
 	epochMultiplier math.LegacyDec,
 	cscoreDiscount math.LegacyDec,
 	err error,
+) {
+	return k.Calculate3LayerFeeForType(ctx, contributor, "")
+}
+
+// Calculate3LayerFeeForType computes the final fee for a submission of the given
+// contribution type. The C-Score discount (Layer 3) is type-scoped when
+// CScoreNamespaceParams.EnableTypeScopedDiscount is set; otherwise, or when ctype
+// is empty, it uses the contributor's global C-Score.
+func (k Keeper) Calculate3LayerFeeForType(ctx context.Context, contributor sdk.AccAddress, ctype string) (
+	finalFee sdk.Coin,
+	epochMultiplier math.LegacyDec,
+	cscoreDiscount math.LegacyDec,
+	err error,
 ) {
 	params := k.GetParams(ctx)
 
@@ -55,7 +77,7 @@ func (k Keeper) Calculate3LayerFee(ctx context.Context, contributor sdk.AccAddre
 	dynamicFee := sdk.NewCoin(baseFee.Denom, dynamicFeeAmount)
 
 	// LAYER 3: Calculate C-Score discount
-	cscoreDiscount, err = k.CalculateCScoreDiscount(ctx, contributor)
+	cscoreDiscount, err = k.CalculateCScoreDiscountForType(ctx, contributor, ctype)
 	if err != nil {
 		return sdk.Coin{}, math.LegacyDec{}, math.LegacyDec{}, fmt.Errorf("failed to calculate cscore discount: %w", err)
 	}
@@ -88,13 +110,13 @@ func (k Keeper) Calculate3LayerFee(ctx context.Context, contributor sdk.AccAddre
 
 // CalculateEpochMultiplier computes the dynamic congestion multiplier
 //
-// Formula: max(0.8, min(5.0, current_submissions / target_submissions))
+// Formula: max(min_mult, min(max_mult, current_submissions / target_submissions))
 //
 // - If block is quiet (few submissions): multiplier < 1.0 (discount)
 // - If block is at target: multiplier = 1.0 (no change)
 // - If block is congested: multiplier > 1.0 (premium)
 //
-// Bounds: [0.8, 5.0]
+// Bounds: EpochMultiplierParams, default [0.8, 5.0]
 func (k Keeper) CalculateEpochMultiplier(ctx context.Context) (math.LegacyDec, error) {
 	params := k.GetParams(ctx)
 
@@ -111,9 +133,10 @@ func (k Keeper) CalculateEpochMultiplier(ctx context.Context) (math.LegacyDec, e
 	targetDec := math.LegacyNewDec(int64(targetSubmissions))
 	rawMultiplier := currentDec.Quo(targetDec)
 
-	// Apply bounds: max(0.8, min(5.0, raw_multiplier))
-	minMultiplier := math.LegacyMustNewDecFromStr("0.8")
-	maxMultiplier := math.LegacyMustNewDecFromStr("5.0")
+	// Apply bounds: max(min_mult, min(max_mult, raw_multiplier))
+	bounds := k.GetEpochMultiplierParams(ctx)
+	minMultiplier := bounds.MinEpochMultiplier
+	maxMultiplier := bounds.MaxEpochMultiplier
 
 	// If raw < min, use min
 	if rawMultiplier.LT(minMultiplier) {
@@ -140,11 +163,34 @@ func (k Keeper) CalculateEpochMultiplier(ctx context.Context) (math.LegacyDec, e
 //
 // Returns: discount percentage as LegacyDec (0.0 - 0.9)
 func (k Keeper) CalculateCScoreDiscount(ctx context.Context, contributor sdk.AccAddress) (math.LegacyDec, error) {
-	params := k.GetParams(ctx)
+	credits := k.GetCredits(ctx, contributor)
+	return k.cscoreToDiscount(ctx, credits.Amount), nil
+}
+
+// CalculateCScoreDiscountForType computes the reputation-based discount for a
+// submission of the given contribution type.
+//
+// With namespacing disabled (default) or an empty ctype this is identical to
+// CalculateCScoreDiscount. With namespacing enabled, credits earned in ctype
+// count in full and credits earned elsewhere count at CrossCategoryFactorBps:
+//
+//	effective = type_credits + (global - type_credits) * factor
+func (k Keeper) CalculateCScoreDiscountForType(ctx context.Context, contributor sdk.AccAddress, ctype string) (math.LegacyDec, error) {
+	nsParams := k.GetCScoreNamespaceParams(ctx)
+	if !nsParams.EnableTypeScopedDiscount || ctype == "" {
+		return k.CalculateCScoreDiscount(ctx, contributor)
+	}
 
-	// Get contributor's C-Score
 	credits := k.GetCredits(ctx, contributor)
-	cscore := credits.Amount
+	typeCredits := k.GetTypeCredits(ctx, contributor.String(), ctype)
+	cscore := nsParams.EffectiveTypeScopedScore(credits.Amount, typeCredits.Credits)
+
+	return k.cscoreToDiscount(ctx, cscore), nil
+}
+
+// cscoreToDiscount maps a C-Score to a discount: min(MaxCscoreDiscount, cscore / 1000).
+func (k Keeper) cscoreToDiscount(ctx context.Context, cscore math.Int) math.LegacyDec {
+	params := k.GetParams(ctx)
 
 	// C-Score is in range 0-1000
 	// Calculate discount: cscore / 1000
@@ -155,10 +201,10 @@ func (k Keeper) CalculateCScoreDiscount(ctx context.Context, contributor sdk.Acc
 	// Cap at MaxCscoreDiscount
 	maxDiscount := params.MaxCscoreDiscount
 	if rawDiscount.GT(maxDiscount) {
-		return maxDiscount, nil
+		return maxDiscount
 	}
 
-	return rawDiscount, nil
+	return rawDiscount
 }
 
 // GetCurrentBlockSubmissions retrieves the submission count for the current block
@@ -219,17 +265,19 @@ func (k Keeper) IncrementBlockSubmissions(ctx context.Context) {
 	)
 }
 
-// ResetBlockSubmissions resets the submission counter
+// ResetBlockSubmissions resets the submission counter and the MaxPerBlock quota counter
 // Called at the beginning of each block (BeginBlocker)
-// Note: Transient store automatically resets, but we call this for logging/debugging
+// Note: Transient store automatically resets on commit; the explicit reset guarantees a
+// fresh quota for every block regardless of how the transient store is managed
 func (k Keeper) ResetBlockSubmissions(ctx context.Context) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	store := sdkCtx.TransientStore(k.tStoreKey)
 
 	previousCount := k.GetCurrentBlockSubmissions(ctx)
 
-	// Delete the counter (will start at 0 next time)
+	// Delete the counters (will start at 0 next time)
 	store.Delete(SubmissionCounterKey)
+	store.Delete(types.KeyPrefixSubmissionCount)
 
 	k.Logger().Debug("reset block submissions counter",
 		"previous_count", previousCount,
@@ -237,11 +285,38 @@ func (k Keeper) ResetBlockSubmissions(ctx context.Context) {
 	)
 }
 
+// EndBlockSubmissions emits the block's submission count as a
+// poc_block_submissions event and resets the counter so the next block's
+// epoch multiplier starts from zero submissions.
+// Called by the module EndBlocker.
+func (k Keeper) EndBlockSubmissions(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	count := k.GetCurrentBlockSubmissions(ctx)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_block_submissions",
+		sdk.NewAttribute("count", fmt.Sprintf("%d", count)),
+		sdk.NewAttribute("target", fmt.Sprintf("%d", k.GetParams(ctx).TargetSubmissionsPerBlock)),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	k.ResetBlockSubmissions(ctx)
+}
+
+// SplitSubmissionFee divides a submission fee into its burned and reward pool
+// shares under the current FeeSplitParams. The pool takes the remainder so
+// rounding never loses dust.
+func (k Keeper) SplitSubmissionFee(ctx context.Context, fee sdk.Coin) (burn, pool sdk.Coin) {
+	split := k.GetFeeSplitParams(ctx)
+	burnAmount := math.LegacyNewDecFromInt(fee.Amount).Mul(split.FeeBurnRatio).TruncateInt()
+	return sdk.NewCoin(fee.Denom, burnAmount), sdk.NewCoin(fee.Denom, fee.Amount.Sub(burnAmount))
+}
+
 // CollectAndSplit3LayerFee collects the calculated fee and splits it
 //
-// Split logic:
-// - 50% burned (sent to burn module)
-// - 50% to PoC reward pool
+// Split logic (FeeSplitParams, default 50/50):
+// - FeeBurnRatio burned (sent to burn module)
+// - FeePoolRatio to PoC reward pool
 //
 // This replaces the old CollectAndBurnSubmissionFee function
 func (k Keeper) CollectAndSplit3LayerFee(
@@ -296,22 +371,17 @@ func (k Keeper) CollectAndSplit3LayerFee(
 		return fmt.Errorf("failed to collect fee: %w", err)
 	}
 
-	// Calculate split: 50% burn, 50% pool
-	burnRatio := math.LegacyMustNewDecFromStr("0.5") // 50%
-	burnAmount := math.LegacyNewDecFromInt(fee.Amount).Mul(burnRatio).TruncateInt()
-	poolAmount := fee.Amount.Sub(burnAmount) // Remaining goes to pool
-
-	burnCoin := sdk.NewCoin(fee.Denom, burnAmount)
-	poolCoin := sdk.NewCoin(fee.Denom, poolAmount)
+	split := k.GetFeeSplitParams(ctx)
+	burnCoin, poolCoin := k.SplitSubmissionFee(ctx, fee)
 
-	// Burn 50%
+	// Burn FeeBurnRatio share
 	if !burnCoin.IsZero() {
 		if err := k.bankKeeper.BurnCoins(sdkCtx, types.ModuleName, sdk.NewCoins(burnCoin)); err != nil {
 			return fmt.Errorf("failed to burn fee: %w", err)
 		}
 	}
 
-	// Keep 50% in module account as reward pool
+	// Keep FeePoolRatio share in module account as reward pool
 	// (it stays in the module account, no transfer needed)
 
 	// Emit event with fee details
@@ -322,6 +392,8 @@ func (k Keeper) CollectAndSplit3LayerFee(
 			sdk.NewAttribute("total_fee", fee.String()),
 			sdk.NewAttribute("burned", burnCoin.String()),
 			sdk.NewAttribute("to_pool", poolCoin.String()),
+			sdk.NewAttribute("burn_ratio", split.FeeBurnRatio.String()),
+			sdk.NewAttribute("pool_ratio", split.FeePoolRatio.String()),
 			sdk.NewAttribute("epoch_multiplier", epochMultiplier.String()),
 			sdk.NewAttribute("cscore_discount", cscoreDiscount.String()),
 		),
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"pos/x/poc/keeper"
 	"pos/x/poc/types"
 )
 
@@ -580,3 +581,396 @@ func Test3LayerFee_EventEmission(t *testing.T) {
 
 	t.Logf("Fee event emitted correctly with all required attributes")
 }
+
+// Test3LayerFee_TypeScopedCScoreDiscount tests per-type C-Score namespacing for the Layer 3 discount
+func Test3LayerFee_TypeScopedCScoreDiscount(t *testing.T) {
+	f := SetupKeeperTest(t)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MaxCscoreDiscount = math.LegacyNewDecWithPrec(90, 2)
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	contributor := createTestAddresses(1)[0]
+
+	// 600 global credits: 400 earned in "security", 200 in "data"
+	require.NoError(t, f.keeper.SetCredits(f.ctx, types.Credits{
+		Address: contributor.String(),
+		Amount:  math.NewInt(600),
+	}))
+	security := types.NewTypeCredits(contributor.String(), "security")
+	security.Credits = math.NewInt(400)
+	require.NoError(t, f.keeper.SetTypeCredits(f.ctx, security))
+	data := types.NewTypeCredits(contributor.String(), "data")
+	data.Credits = math.NewInt(200)
+	require.NoError(t, f.keeper.SetTypeCredits(f.ctx, data))
+
+	require.NoError(t, f.keeper.SetCScoreNamespaceParams(f.ctx, types.CScoreNamespaceParams{
+		EnableTypeScopedDiscount: true,
+		CrossCategoryFactorBps:   5000, // off-category credits count at 50%
+	}))
+
+	tests := []struct {
+		name                    string
+		ctype                   string
+		expectedDiscountPercent int64
+	}{
+		// 400 + (600-400)*0.5 = 500 → 50%
+		{name: "same_category_credits_count_in_full", ctype: "security", expectedDiscountPercent: 50},
+		// 200 + (600-200)*0.5 = 400 → 40%
+		{name: "other_category_discounted_by_factor", ctype: "data", expectedDiscountPercent: 40},
+		// 0 + 600*0.5 = 300 → 30%
+		{name: "unseen_category_uses_cross_factor_only", ctype: "code", expectedDiscountPercent: 30},
+		// empty ctype falls back to the global score: 600 → 60%
+		{name: "empty_ctype_uses_global_score", ctype: "", expectedDiscountPercent: 60},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			discount, err := f.keeper.CalculateCScoreDiscountForType(f.ctx, contributor, tc.ctype)
+			require.NoError(t, err)
+			require.Equal(t, math.LegacyNewDecWithPrec(tc.expectedDiscountPercent, 2), discount)
+		})
+	}
+
+	// Zero cross-category factor: only same-category credits count
+	require.NoError(t, f.keeper.SetCScoreNamespaceParams(f.ctx, types.CScoreNamespaceParams{
+		EnableTypeScopedDiscount: true,
+		CrossCategoryFactorBps:   0,
+	}))
+	discount, err := f.keeper.CalculateCScoreDiscountForType(f.ctx, contributor, "code")
+	require.NoError(t, err)
+	require.True(t, discount.IsZero(), "unrelated category should get no discount with zero cross factor")
+}
+
+// Test3LayerFee_TypeScopedCScoreDiscount_GlobalFallback verifies the global single-score
+// behavior is unchanged when namespacing is off (the default).
+func Test3LayerFee_TypeScopedCScoreDiscount_GlobalFallback(t *testing.T) {
+	f := SetupKeeperTest(t)
+
+	require.False(t, f.keeper.GetCScoreNamespaceParams(f.ctx).EnableTypeScopedDiscount,
+		"type-scoped discount must be disabled by default")
+
+	contributor := createTestAddresses(1)[0]
+	require.NoError(t, f.keeper.SetCredits(f.ctx, types.Credits{
+		Address: contributor.String(),
+		Amount:  math.NewInt(500),
+	}))
+	security := types.NewTypeCredits(contributor.String(), "security")
+	security.Credits = math.NewInt(500)
+	require.NoError(t, f.keeper.SetTypeCredits(f.ctx, security))
+
+	global, err := f.keeper.CalculateCScoreDiscount(f.ctx, contributor)
+	require.NoError(t, err)
+
+	for _, ctype := range []string{"security", "data", ""} {
+		scoped, err := f.keeper.CalculateCScoreDiscountForType(f.ctx, contributor, ctype)
+		require.NoError(t, err)
+		require.Equal(t, global, scoped, "ctype %q should use the global discount when namespacing is off", ctype)
+	}
+
+	// Full fee calculation must match the legacy entry point as well
+	feeGlobal, _, _, err := f.keeper.Calculate3LayerFee(f.ctx, contributor)
+	require.NoError(t, err)
+	feeScoped, _, _, err := f.keeper.Calculate3LayerFeeForType(f.ctx, contributor, "data")
+	require.NoError(t, err)
+	require.Equal(t, feeGlobal, feeScoped)
+}
+
+// TestCScoreNamespaceParams_Validate tests bounds on the cross-category factor
+func TestCScoreNamespaceParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultCScoreNamespaceParams().Validate())
+	require.NoError(t, types.CScoreNamespaceParams{CrossCategoryFactorBps: 10000}.Validate())
+	require.Error(t, types.CScoreNamespaceParams{CrossCategoryFactorBps: 10001}.Validate())
+
+	f := SetupKeeperTest(t)
+	err := f.keeper.SetCScoreNamespaceParams(f.ctx, types.CScoreNamespaceParams{
+		EnableTypeScopedDiscount: true,
+		CrossCategoryFactorBps:   20000,
+	})
+	require.Error(t, err)
+
+	// Type credits above the global score are clamped
+	p := types.CScoreNamespaceParams{EnableTypeScopedDiscount: true, CrossCategoryFactorBps: 5000}
+	require.Equal(t, math.NewInt(100), p.EffectiveTypeScopedScore(math.NewInt(100), math.NewInt(300)))
+}
+
+// TestUpdateCScoreNamespaceParams_Msg tests the governance path for the
+// per-type C-Score namespace
+func TestUpdateCScoreNamespaceParams_Msg(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	msg := &types.MsgUpdateCScoreNamespaceParams{
+		Authority:                testAddr1.String(),
+		EnableTypeScopedDiscount: true,
+		CrossCategoryFactorBps:   5000,
+	}
+	_, err := msgSrv.UpdateCScoreNamespaceParams(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	msg.Authority = f.keeper.GetAuthority()
+	msg.CrossCategoryFactorBps = 10001
+	require.Error(t, msg.ValidateBasic())
+	_, err = msgSrv.UpdateCScoreNamespaceParams(ctx, msg)
+	require.Error(t, err)
+	require.Equal(t, types.DefaultCScoreNamespaceParams(), f.keeper.GetCScoreNamespaceParams(ctx))
+
+	msg.CrossCategoryFactorBps = 5000
+	_, err = msgSrv.UpdateCScoreNamespaceParams(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, types.CScoreNamespaceParams{EnableTypeScopedDiscount: true, CrossCategoryFactorBps: 5000},
+		f.keeper.GetCScoreNamespaceParams(ctx))
+	require.True(t, hasEventType(ctx, "poc_cscore_namespace_params_updated"))
+}
+
+// TestCScoreNamespaceParams_Genesis tests that the namespace params survive a
+// genesis export and import
+func TestCScoreNamespaceParams_Genesis(t *testing.T) {
+	f := SetupKeeperTest(t)
+	p := types.CScoreNamespaceParams{EnableTypeScopedDiscount: true, CrossCategoryFactorBps: 4000}
+	require.NoError(t, f.keeper.SetCScoreNamespaceParams(f.ctx, p))
+
+	exported := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, f.keeper.SetCScoreNamespaceParams(f.ctx, types.DefaultCScoreNamespaceParams()))
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *exported))
+	require.Equal(t, p, f.keeper.GetCScoreNamespaceParams(f.ctx))
+}
+
+// collectSplitFee collects a 10,000 omniphi fee under the given burn/pool split
+// and returns the amount burned (left the module) and kept in the pool
+func collectSplitFee(t *testing.T, burnRatio, poolRatio string) (sdk.Context, math.Int, math.Int) {
+	t.Helper()
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+
+	params := f.keeper.GetParams(ctx)
+	params.BaseSubmissionFee = sdk.NewCoin("omniphi", math.NewInt(30000))
+	params.MinimumSubmissionFee = sdk.NewCoin("omniphi", math.NewInt(3000))
+	require.NoError(t, f.keeper.SetParams(ctx, params))
+	require.NoError(t, f.keeper.SetFeeSplitParams(ctx, types.FeeSplitParams{
+		FeeBurnRatio: math.LegacyMustNewDecFromStr(burnRatio),
+		FeePoolRatio: math.LegacyMustNewDecFromStr(poolRatio),
+	}))
+
+	contributor := createTestAddresses(1)[0]
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", math.NewInt(10000))
+
+	fee := sdk.NewCoin("omniphi", math.NewInt(10000))
+	require.NoError(t, f.keeper.CollectAndSplit3LayerFee(ctx, contributor, fee, math.LegacyOneDec(), math.LegacyZeroDec()))
+
+	pool := f.bankKeeper.GetBalance(ctx, sdk.AccAddress("module_address______"), "omniphi").Amount
+	return ctx, fee.Amount.Sub(pool), pool
+}
+
+// feeEventAttribute returns the value of key on the poc_3layer_fee event
+func feeEventAttribute(ctx sdk.Context, key string) string {
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != "poc_3layer_fee" {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if attr.Key == key {
+				return attr.Value
+			}
+		}
+	}
+	return ""
+}
+
+func Test3LayerFee_ConfiguredSplit(t *testing.T) {
+	ctx, burned, pooled := collectSplitFee(t, "0.7", "0.3")
+	require.Equal(t, "7000", burned.String())
+	require.Equal(t, "3000", pooled.String())
+	require.Equal(t, "7000omniphi", feeEventAttribute(ctx, "burned"))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.7").String(), feeEventAttribute(ctx, "burn_ratio"))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3").String(), feeEventAttribute(ctx, "pool_ratio"))
+}
+
+func Test3LayerFee_FullBurnSplit(t *testing.T) {
+	ctx, burned, pooled := collectSplitFee(t, "1", "0")
+	require.Equal(t, "10000", burned.String())
+	require.True(t, pooled.IsZero())
+	require.Equal(t, "0omniphi", feeEventAttribute(ctx, "to_pool"))
+}
+
+func Test3LayerFee_SplitValidation(t *testing.T) {
+	f := SetupKeeperTest(t)
+
+	// Default stays 50/50
+	split := f.keeper.GetFeeSplitParams(f.ctx)
+	require.True(t, split.FeeBurnRatio.Equal(math.LegacyNewDecWithPrec(50, 2)))
+	require.True(t, split.FeePoolRatio.Equal(math.LegacyNewDecWithPrec(50, 2)))
+
+	for _, tc := range []struct{ burn, pool string }{
+		{"0.7", "0.2"},  // sums below one
+		{"0.7", "0.4"},  // sums above one
+		{"1.2", "-0.2"}, // sums to one but out of range
+	} {
+		err := f.keeper.SetFeeSplitParams(f.ctx, types.FeeSplitParams{
+			FeeBurnRatio: math.LegacyMustNewDecFromStr(tc.burn),
+			FeePoolRatio: math.LegacyMustNewDecFromStr(tc.pool),
+		})
+		require.Error(t, err, "burn=%s pool=%s", tc.burn, tc.pool)
+	}
+
+	// Rejected updates leave the stored split unchanged
+	require.True(t, f.keeper.GetFeeSplitParams(f.ctx).FeeBurnRatio.Equal(math.LegacyNewDecWithPrec(50, 2)))
+}
+
+// Test3LayerFee_MultiplierResetsAcrossBlocks drives submissions through the msg
+// server over several blocks and checks the EndBlock reset
+func Test3LayerFee_MultiplierResetsAcrossBlocks(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	contributor := sdk.AccAddress("contributor_________")
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", math.NewInt(100_000_000))
+
+	target := f.keeper.GetParams(f.ctx).TargetSubmissionsPerBlock
+	submitBlock := func(height int64, submissions int) sdk.Context {
+		ctx := f.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		for i := 0; i < submissions; i++ {
+			msg := newSubmitContributionMsg(contributor)
+			msg.Hash[1] = byte(height)
+			msg.Hash[2] = byte(i)
+			_, err := msgSrv.SubmitContribution(ctx, msg)
+			require.NoError(t, err)
+		}
+		return ctx
+	}
+
+	// Block 1 is congested at twice the target
+	ctx := submitBlock(1, int(target)*2)
+	multiplier, err := f.keeper.CalculateEpochMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "2.000000000000000000", multiplier.String())
+
+	f.keeper.EndBlockSubmissions(ctx)
+	require.True(t, hasEventType(ctx, "poc_block_submissions"))
+	require.Equal(t, uint32(0), f.keeper.GetCurrentBlockSubmissions(ctx))
+
+	// Block 2 is quiet: the multiplier falls back to the 0.8 floor
+	ctx = submitBlock(2, 0)
+	multiplier, err = f.keeper.CalculateEpochMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.800000000000000000", multiplier.String())
+	f.keeper.EndBlockSubmissions(ctx)
+
+	// Block 3 at target prices at 1.0 without carrying over block 1's load
+	ctx = submitBlock(3, int(target))
+	multiplier, err = f.keeper.CalculateEpochMultiplier(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "1.000000000000000000", multiplier.String())
+}
+
+func Test3LayerFee_GovernanceRaisedMultiplierCap(t *testing.T) {
+	f := SetupKeeperTest(t)
+	target := f.keeper.GetParams(f.ctx).TargetSubmissionsPerBlock
+
+	// Eight times the target load
+	for i := uint32(0); i < target*8; i++ {
+		f.keeper.IncrementBlockSubmissions(f.ctx)
+	}
+
+	multiplier, err := f.keeper.CalculateEpochMultiplier(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, "5.000000000000000000", multiplier.String(), "default cap is 5.0")
+
+	require.NoError(t, f.keeper.SetEpochMultiplierParams(f.ctx, types.EpochMultiplierParams{
+		MinEpochMultiplier: math.LegacyMustNewDecFromStr("0.5"),
+		MaxEpochMultiplier: math.LegacyNewDec(10),
+	}))
+	multiplier, err = f.keeper.CalculateEpochMultiplier(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, "8.000000000000000000", multiplier.String())
+
+	// The lowered floor applies to quiet blocks
+	f.keeper.ResetBlockSubmissions(f.ctx)
+	multiplier, err = f.keeper.CalculateEpochMultiplier(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.500000000000000000", multiplier.String())
+}
+
+func Test3LayerFee_EpochMultiplierValidation(t *testing.T) {
+	f := SetupKeeperTest(t)
+
+	for _, tc := range []struct{ min, max string }{
+		{"1.5", "1.2"}, // min > max
+		{"0", "5"},     // floor must be positive
+		{"0.8", "0.9"}, // cap below 1
+		{"0.8", "11"},  // cap above 10
+	} {
+		err := f.keeper.SetEpochMultiplierParams(f.ctx, types.EpochMultiplierParams{
+			MinEpochMultiplier: math.LegacyMustNewDecFromStr(tc.min),
+			MaxEpochMultiplier: math.LegacyMustNewDecFromStr(tc.max),
+		})
+		require.Error(t, err, "min=%s max=%s", tc.min, tc.max)
+	}
+
+	require.True(t, f.keeper.GetEpochMultiplierParams(f.ctx).MaxEpochMultiplier.Equal(math.LegacyNewDec(5)))
+}
+
+// TestQueryFeeQuote runs the Test3LayerFee_CombinedCalculation scenarios
+// through the query layer
+func TestQueryFeeQuote(t *testing.T) {
+	f := SetupKeeperTest(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := f.keeper.GetParams(f.ctx)
+	params.BaseSubmissionFee = sdk.NewCoin("omniphi", math.NewInt(30000))
+	params.TargetSubmissionsPerBlock = 5
+	params.MaxCscoreDiscount = math.LegacyMustNewDecFromStr("0.9")
+	params.MinimumSubmissionFee = sdk.NewCoin("omniphi", math.NewInt(3000))
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	tests := []struct {
+		name               string
+		cscore             int64
+		currentSubmissions uint32
+		expectedMultiplier string
+		expectedDiscount   string
+		expectedFee        int64
+	}{
+		{"no_discount_no_congestion", 0, 5, "1.0", "0.0", 30000},
+		{"max_discount_no_congestion", 1000, 5, "1.0", "0.9", 3000},
+		{"no_discount_high_congestion", 0, 25, "5.0", "0.0", 150000},
+		{"max_discount_high_congestion", 1000, 25, "5.0", "0.9", 15000},
+		{"medium_discount_medium_congestion", 500, 10, "2.0", "0.5", 30000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f.keeper.ResetBlockSubmissions(f.ctx)
+
+			contributor := createTestAddresses(1)[0]
+			require.NoError(t, f.keeper.SetCredits(f.ctx, types.Credits{
+				Address: contributor.String(),
+				Amount:  math.NewInt(tc.cscore),
+			}))
+			for i := uint32(0); i < tc.currentSubmissions; i++ {
+				f.keeper.IncrementBlockSubmissions(f.ctx)
+			}
+
+			res, err := qs.FeeQuote(f.ctx, &types.QueryFeeQuoteRequest{Address: contributor.String()})
+			require.NoError(t, err)
+			require.Equal(t, params.BaseSubmissionFee, res.BaseFee)
+			require.True(t, res.EpochMultiplier.Equal(math.LegacyMustNewDecFromStr(tc.expectedMultiplier)), "multiplier %s", res.EpochMultiplier)
+			require.True(t, res.CscoreDiscount.Equal(math.LegacyMustNewDecFromStr(tc.expectedDiscount)), "discount %s", res.CscoreDiscount)
+			require.Equal(t, sdk.NewCoin("omniphi", math.NewInt(tc.expectedFee)), res.FinalFee)
+
+			// The quote matches the fee calculation and does not count as a submission
+			finalFee, _, _, err := f.keeper.Calculate3LayerFee(f.ctx, contributor)
+			require.NoError(t, err)
+			require.Equal(t, finalFee, res.FinalFee)
+			require.Equal(t, tc.currentSubmissions, f.keeper.GetCurrentBlockSubmissions(f.ctx))
+		})
+	}
+
+	// Invalid requests
+	_, err := qs.FeeQuote(f.ctx, nil)
+	require.Error(t, err)
+	_, err = qs.FeeQuote(f.ctx, &types.QueryFeeQuoteRequest{Address: "not-an-address"})
+	require.Error(t, err)
+}
@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// ============================================================================
+// Fraud Endorsement Slashing - Challenger Path
+//
+// A challenger proves that a validator approved a fraudulent contribution.
+// The fraud proof is verified through SubmitFraudProof (which invalidates the
+// contribution), the validator is slashed by the governance-configured
+// fraction, and the challenger is paid a share of the slashed amount from the
+// PoC module account. Each (contribution, validator) pair is slashed once.
+// Invalidation slashes every approver, so the challenger who proves the fraud
+// is paid for each of them, not only for the validator named in the message.
+// ============================================================================
+
+// GetFraudSlashRecord returns the slash record for a (contribution, validator) pair
+func (k Keeper) GetFraudSlashRecord(ctx context.Context, contributionID uint64, valAddr sdk.ValAddress) (types.FraudSlashRecord, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetFraudSlashRecordKey(contributionID, valAddr))
+	if err != nil || bz == nil {
+		return types.FraudSlashRecord{}, false
+	}
+
+	var record types.FraudSlashRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		k.logger.Error("failed to unmarshal fraud slash record", "id", contributionID, "error", err)
+		return types.FraudSlashRecord{}, false
+	}
+	return record, true
+}
+
+// SetFraudSlashRecord stores a slash record
+func (k Keeper) SetFraudSlashRecord(ctx context.Context, record types.FraudSlashRecord) error {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return fmt.Errorf("invalid validator address in slash record: %w", err)
+	}
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal fraud slash record: %w", err)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetFraudSlashRecordKey(record.ContributionID, valAddr), bz)
+}
+
+// slashFraudEndorser slashes and jails one validator for approving a
+// fraudulent contribution and records the slash. Returns ErrFraudAlreadySlashed
+// if the pair was slashed before.
+func (k Keeper) slashFraudEndorser(ctx context.Context, contributionID uint64, valAddr sdk.ValAddress) (types.FraudSlashRecord, error) {
+	if k.slashingKeeper == nil {
+		return types.FraudSlashRecord{}, types.ErrEndorsementSlashFailed.Wrap("slashing keeper not available")
+	}
+	if _, found := k.GetFraudSlashRecord(ctx, contributionID, valAddr); found {
+		return types.FraudSlashRecord{}, types.ErrFraudAlreadySlashed.Wrapf(
+			"validator %s, contribution %d", valAddr, contributionID)
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.FraudSlashRecord{}, types.ErrEndorsementSlashFailed.Wrapf("validator %s: %v", valAddr, err)
+	}
+	consAddrBz, err := validator.GetConsAddr()
+	if err != nil {
+		return types.FraudSlashRecord{}, types.ErrEndorsementSlashFailed.Wrapf("validator %s consensus address: %v", valAddr, err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	slashFraction := k.GetFraudSlashParams(ctx).SlashFraction
+	consAddr := sdk.ConsAddress(consAddrBz)
+	// x/staking slashes the fraction of the power it is given, so pass the
+	// validator's current consensus power
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+
+	slashedAmt, err := k.slashingKeeper.Slash(
+		ctx,
+		consAddr,
+		sdkCtx.BlockHeight(),
+		power,
+		slashFraction,
+	)
+	if err != nil {
+		return types.FraudSlashRecord{}, types.ErrEndorsementSlashFailed.Wrapf("validator %s: %v", valAddr, err)
+	}
+
+	// Record the slash before jailing so a jail failure cannot lead to a second slash
+	record := types.FraudSlashRecord{
+		ContributionID:   contributionID,
+		Validator:        valAddr.String(),
+		SlashFraction:    slashFraction,
+		SlashedAmount:    slashedAmt,
+		ChallengerReward: math.ZeroInt(),
+		SlashedAt:        sdkCtx.BlockHeight(),
+	}
+	if err := k.SetFraudSlashRecord(ctx, record); err != nil {
+		return types.FraudSlashRecord{}, err
+	}
+
+	if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+		k.logger.Error("failed to jail fraud endorser",
+			"validator", valAddr.String(),
+			"contribution_id", contributionID,
+			"error", err)
+	}
+
+	k.logger.Warn("FRAUD ENDORSER SLASHED AND JAILED",
+		"validator", valAddr.String(),
+		"contribution_id", contributionID,
+		"slash_fraction", slashFraction.String(),
+		"slashed_amount", slashedAmt.String(),
+		"block_height", sdkCtx.BlockHeight(),
+	)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_fraud_endorser_slashed",
+		sdk.NewAttribute("validator", valAddr.String()),
+		sdk.NewAttribute("contribution_id", fmt.Sprintf("%d", contributionID)),
+		sdk.NewAttribute("slash_fraction", slashFraction.String()),
+		sdk.NewAttribute("slashed_amount", slashedAmt.String()),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return record, nil
+}
+
+// SlashFraudulentEndorser verifies a fraud proof against a contribution the
+// validator approved and slashes the validator. The challenger is paid their
+// share of the slashed amount for every approver slashed over the contribution
+// that no challenger has been paid for yet, and the paid records are returned.
+// Each reward is capped at the module balance.
+func (k Keeper) SlashFraudulentEndorser(
+	ctx context.Context,
+	challenger sdk.AccAddress,
+	contributionID uint64,
+	valAddr sdk.ValAddress,
+	proofType types.FraudProofType,
+	proofData []byte,
+) ([]types.FraudSlashRecord, error) {
+	if k.slashingKeeper == nil {
+		return nil, types.ErrEndorsementSlashFailed.Wrap("slashing keeper not available")
+	}
+
+	contribution, found := k.GetContribution(ctx, contributionID)
+	if !found {
+		return nil, types.ErrContributionNotFound.Wrapf("contribution %d", contributionID)
+	}
+
+	approved := false
+	for _, e := range contribution.Endorsements {
+		if e.Decision && e.ValAddr == valAddr.String() {
+			approved = true
+			break
+		}
+	}
+	if !approved {
+		return nil, types.ErrNotFraudEndorser.Wrapf(
+			"validator %s, contribution %d", valAddr, contributionID)
+	}
+
+	if _, slashed := k.GetFraudSlashRecord(ctx, contributionID, valAddr); slashed {
+		return nil, types.ErrFraudAlreadySlashed.Wrapf(
+			"validator %s, contribution %d", valAddr, contributionID)
+	}
+
+	// Prove the fraud unless a validated proof is already on record. A fresh
+	// proof invalidates the contribution, which slashes every approver.
+	if _, proven := k.GetFraudProof(ctx, contributionID); !proven {
+		if err := k.SubmitFraudProof(ctx, contributionID, proofType, challenger.String(), proofData); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, found := k.GetFraudSlashRecord(ctx, contributionID, valAddr); !found {
+		if _, err := k.slashFraudEndorser(ctx, contributionID, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	var records []types.FraudSlashRecord
+	for _, e := range contribution.Endorsements {
+		if !e.Decision {
+			continue
+		}
+		endorser, err := endorserValAddress(e.ValAddr)
+		if err != nil {
+			continue
+		}
+		record, found := k.GetFraudSlashRecord(ctx, contributionID, endorser)
+		if !found || record.Challenger != "" {
+			continue
+		}
+		if record, err = k.rewardFraudChallenger(ctx, challenger, record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// rewardFraudChallenger pays the challenger their share of a slash record's
+// slashed amount from the PoC module account and records the payout
+func (k Keeper) rewardFraudChallenger(ctx context.Context, challenger sdk.AccAddress, record types.FraudSlashRecord) (types.FraudSlashRecord, error) {
+	params := k.GetParams(ctx)
+	reward := k.GetFraudSlashParams(ctx).ChallengerRewardRatio.MulInt(record.SlashedAmount).TruncateInt()
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if balance := k.bankKeeper.GetBalance(ctx, moduleAddr, params.RewardDenom).Amount; reward.GT(balance) {
+		k.logger.Warn("module balance below fraud challenger reward, paying available balance",
+			"reward", reward.String(), "balance", balance.String())
+		reward = balance
+	}
+	if reward.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.RewardDenom, reward))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger, coins); err != nil {
+			return types.FraudSlashRecord{}, fmt.Errorf("failed to pay fraud challenger reward: %w", err)
+		}
+	}
+
+	record.Challenger = challenger.String()
+	record.ChallengerReward = reward
+	if err := k.SetFraudSlashRecord(ctx, record); err != nil {
+		return types.FraudSlashRecord{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		"poc_fraud_challenger_rewarded",
+		sdk.NewAttribute("challenger", challenger.String()),
+		sdk.NewAttribute("validator", record.Validator),
+		sdk.NewAttribute("contribution_id", fmt.Sprintf("%d", record.ContributionID)),
+		sdk.NewAttribute("slashed_amount", record.SlashedAmount.String()),
+		sdk.NewAttribute("reward", reward.String()),
+	))
+
+	return record, nil
+}
+
+// SubmitFraudProof handles MsgSubmitFraudProof
+func (ms msgServer) SubmitFraudProof(goCtx context.Context, msg *types.MsgSubmitFraudProof) (*types.MsgSubmitFraudProofResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	challenger, err := sdk.AccAddressFromBech32(msg.Challenger)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := ms.SlashFraudulentEndorser(goCtx, challenger, msg.ContributionId, valAddr,
+		types.FraudProofType(msg.ProofType), msg.ProofData)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.MsgSubmitFraudProofResponse{
+		SlashedAmount:    math.ZeroInt(),
+		ChallengerReward: math.ZeroInt(),
+	}
+	for _, record := range records {
+		resp.SlashedAmount = resp.SlashedAmount.Add(record.SlashedAmount)
+		resp.ChallengerReward = resp.ChallengerReward.Add(record.ChallengerReward)
+		resp.Validators = append(resp.Validators, record.Validator)
+	}
+	return resp, nil
+}
@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+// mockSlashingKeeper slashes the tokens behind the given consensus power by
+// the given fraction, as x/staking does, and records every slash and jail.
+type mockSlashingKeeper struct {
+	slashes   map[string]int
+	fractions map[string]math.LegacyDec
+	jailed    map[string]bool
+}
+
+func newMockSlashingKeeper() *mockSlashingKeeper {
+	return &mockSlashingKeeper{
+		slashes:   make(map[string]int),
+		fractions: make(map[string]math.LegacyDec),
+		jailed:    make(map[string]bool),
+	}
+}
+
+func (m *mockSlashingKeeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor math.LegacyDec) (math.Int, error) {
+	m.slashes[consAddr.String()]++
+	m.fractions[consAddr.String()] = slashFactor
+	return slashFactor.MulInt(sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)).TruncateInt(), nil
+}
+
+func (m *mockSlashingKeeper) Jail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	m.jailed[consAddr.String()] = true
+	return nil
+}
+
+var (
+	fraudValidator  = sdk.ValAddress("fraud_validator_____")
+	fraudApprover   = sdk.ValAddress("fraud_approver______")
+	fraudRejecter   = sdk.ValAddress("fraud_rejecter______")
+	fraudChallenger = sdk.AccAddress("fraud_challenger____")
+)
+
+// fraudConsPubKey derives a validator's consensus key from its operator address
+func fraudConsPubKey(valAddr sdk.ValAddress) cryptotypes.PubKey {
+	return ed25519.GenPrivKeyFromSecret(valAddr).PubKey()
+}
+
+// fraudConsAddr is the consensus address the slashing keeper is called with
+func fraudConsAddr(valAddr sdk.ValAddress) string {
+	return sdk.ConsAddress(fraudConsPubKey(valAddr).Address()).String()
+}
+
+// setupFraudSlashing stores verified contribution 1 with an all-zero hash
+// (provable by a HASH_MISMATCH proof), approved by fraudValidator and rejected
+// by fraudRejecter, and funds the module account for challenger rewards.
+// fraudApprover is a bonded validator that has not endorsed the contribution.
+func setupFraudSlashing(t *testing.T) (*KeeperTestFixture, *mockSlashingKeeper) {
+	t.Helper()
+	stakingKeeper := validatorSetStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	for _, valAddr := range []sdk.ValAddress{fraudValidator, fraudApprover, fraudRejecter} {
+		validator, err := stakingtypes.NewValidator(valAddr.String(), fraudConsPubKey(valAddr), stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = math.NewInt(100000000)
+		validator.DelegatorShares = math.LegacyNewDec(100000000)
+		stakingKeeper.validators[valAddr.String()] = validator
+	}
+	f := setupKeeperTestWithStaking(t, stakingKeeper)
+	slashing := newMockSlashingKeeper()
+	f.keeper.SetSlashingKeeper(slashing)
+
+	contribution := types.NewContribution(1, sdk.AccAddress("fraud_contributor___").String(),
+		"code", "ipfs://QmFraud", make([]byte, 32), 0, 0)
+	contribution.Verified = true
+	contribution.AddEndorsement(types.NewEndorsement(fraudValidator.String(), true, math.NewInt(100000000), 0))
+	contribution.AddEndorsement(types.NewEndorsement(fraudRejecter.String(), false, math.NewInt(100000000), 0))
+	require.NoError(t, f.keeper.SetContribution(f.ctx, contribution))
+
+	f.bankKeeper.setBalance(sdk.AccAddress("module_address______").String(), "omniphi", math.NewInt(10_000_000))
+	return f, slashing
+}
+
+func fraudProofMsg(validator sdk.ValAddress) *types.MsgSubmitFraudProof {
+	return &types.MsgSubmitFraudProof{
+		Challenger:     fraudChallenger.String(),
+		ContributionId: 1,
+		Validator:      validator.String(),
+		ProofType:      uint32(types.FraudProofHashMismatch),
+		ProofData:      []byte("expected-hash"),
+	}
+}
+
+func TestSubmitFraudProofMsg_SlashesAndRewards(t *testing.T) {
+	f, sk := setupFraudSlashing(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	resp, err := msgSrv.SubmitFraudProof(ctx, fraudProofMsg(fraudValidator))
+	require.NoError(t, err)
+
+	// Default 1% of 100M stake slashed; challenger receives 10% of that
+	consAddr := fraudConsAddr(fraudValidator)
+	require.Equal(t, 1, sk.slashes[consAddr])
+	require.True(t, sk.fractions[consAddr].Equal(types.DefaultSlashFractionFraudEndorsement()))
+	require.True(t, sk.jailed[consAddr])
+	require.Equal(t, "1000000", resp.SlashedAmount.String())
+	require.Equal(t, "100000", resp.ChallengerReward.String())
+	require.Equal(t, []string{fraudValidator.String()}, resp.Validators)
+	require.Equal(t, "100000", f.bankKeeper.GetBalance(ctx, fraudChallenger, "omniphi").Amount.String())
+
+	// The rejecting endorser is untouched and the contribution is invalidated
+	require.Zero(t, sk.slashes[fraudConsAddr(fraudRejecter)])
+	require.Equal(t, types.FinalityStatusInvalidated, f.keeper.GetContributionFinality(ctx, 1).Status)
+
+	record, found := f.keeper.GetFraudSlashRecord(ctx, 1, fraudValidator)
+	require.True(t, found)
+	require.Equal(t, fraudChallenger.String(), record.Challenger)
+	require.True(t, hasEventType(ctx, "poc_fraud_challenger_rewarded"))
+
+	// A validator that rejected the contribution cannot be slashed over it
+	_, err = msgSrv.SubmitFraudProof(ctx, fraudProofMsg(fraudRejecter))
+	require.ErrorIs(t, err, types.ErrNotFraudEndorser)
+}
+
+func TestSubmitFraudProofMsg_ConfiguredPenalty(t *testing.T) {
+	f, sk := setupFraudSlashing(t)
+	require.NoError(t, f.keeper.SetFraudSlashParams(f.ctx, types.FraudSlashParams{
+		SlashFraction:         math.LegacyNewDecWithPrec(5, 2),
+		ChallengerRewardRatio: math.LegacyNewDecWithPrec(50, 2),
+	}))
+
+	records, err := f.keeper.SlashFraudulentEndorser(f.ctx, fraudChallenger, 1, fraudValidator,
+		types.FraudProofHashMismatch, []byte("expected-hash"))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	record := records[0]
+
+	require.True(t, sk.fractions[fraudConsAddr(fraudValidator)].Equal(math.LegacyNewDecWithPrec(5, 2)))
+	require.Equal(t, "5000000", record.SlashedAmount.String())
+	require.Equal(t, "2500000", record.ChallengerReward.String())
+	require.Equal(t, "7500000", f.bankKeeper.GetBalance(f.ctx, sdk.AccAddress("module_address______"), "omniphi").Amount.String())
+}
+
+func TestSubmitFraudProofMsg_NoDoubleSlash(t *testing.T) {
+	f, sk := setupFraudSlashing(t)
+
+	_, err := f.keeper.SlashFraudulentEndorser(f.ctx, fraudChallenger, 1, fraudValidator,
+		types.FraudProofHashMismatch, []byte("expected-hash"))
+	require.NoError(t, err)
+
+	// Same pair again, from a different challenger
+	other := sdk.AccAddress("other_challenger____")
+	_, err = f.keeper.SlashFraudulentEndorser(f.ctx, other, 1, fraudValidator,
+		types.FraudProofHashMismatch, []byte("expected-hash"))
+	require.ErrorIs(t, err, types.ErrFraudAlreadySlashed)
+
+	// Re-running bulk slashing for the invalidated contribution is also a no-op
+	contribution, _ := f.keeper.GetContribution(f.ctx, 1)
+	f.keeper.SlashFraudEndorsers(f.ctx, contribution)
+
+	require.Equal(t, 1, sk.slashes[fraudConsAddr(fraudValidator)])
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, other, "omniphi").Amount.IsZero())
+	require.Equal(t, "100000", f.bankKeeper.GetBalance(f.ctx, fraudChallenger, "omniphi").Amount.String())
+}
+
+// TestSubmitFraudProofMsg_PaysForEveryApprover checks that the challenger who
+// proves the fraud is paid for every approver the invalidation slashes, and
+// that a later message naming another approver cannot be paid again
+func TestSubmitFraudProofMsg_PaysForEveryApprover(t *testing.T) {
+	f, sk := setupFraudSlashing(t)
+	contribution, _ := f.keeper.GetContribution(f.ctx, 1)
+	contribution.AddEndorsement(types.NewEndorsement(fraudApprover.String(), true, math.NewInt(100000000), 0))
+	require.NoError(t, f.keeper.SetContribution(f.ctx, contribution))
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	resp, err := msgSrv.SubmitFraudProof(f.ctx, fraudProofMsg(fraudValidator))
+	require.NoError(t, err)
+
+	require.Equal(t, 1, sk.slashes[fraudConsAddr(fraudValidator)])
+	require.Equal(t, 1, sk.slashes[fraudConsAddr(fraudApprover)])
+	require.ElementsMatch(t, []string{fraudValidator.String(), fraudApprover.String()}, resp.Validators)
+	require.Equal(t, "2000000", resp.SlashedAmount.String())
+	require.Equal(t, "200000", resp.ChallengerReward.String())
+	require.Equal(t, "200000", f.bankKeeper.GetBalance(f.ctx, fraudChallenger, "omniphi").Amount.String())
+
+	for _, valAddr := range []sdk.ValAddress{fraudValidator, fraudApprover} {
+		record, found := f.keeper.GetFraudSlashRecord(f.ctx, 1, valAddr)
+		require.True(t, found)
+		require.Equal(t, fraudChallenger.String(), record.Challenger)
+		require.Equal(t, "100000", record.ChallengerReward.String())
+	}
+
+	_, err = msgSrv.SubmitFraudProof(f.ctx, fraudProofMsg(fraudApprover))
+	require.ErrorIs(t, err, types.ErrFraudAlreadySlashed)
+	require.Equal(t, "200000", f.bankKeeper.GetBalance(f.ctx, fraudChallenger, "omniphi").Amount.String())
+}
+
+// TestSlashFraudEndorser_UsesConsensusAddress checks that the slashing keeper
+// is called with the validator's consensus address, not its operator bytes
+func TestSlashFraudEndorser_UsesConsensusAddress(t *testing.T) {
+	f, sk := setupFraudSlashing(t)
+
+	_, err := f.keeper.SlashFraudulentEndorser(f.ctx, fraudChallenger, 1, fraudValidator,
+		types.FraudProofHashMismatch, []byte("expected-hash"))
+	require.NoError(t, err)
+
+	require.Equal(t, 1, sk.slashes[fraudConsAddr(fraudValidator)])
+	require.True(t, sk.jailed[fraudConsAddr(fraudValidator)])
+	require.Zero(t, sk.slashes[sdk.ConsAddress(fraudValidator).String()])
+}
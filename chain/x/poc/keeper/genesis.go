@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pos/x/poc/types"
@@ -26,6 +27,17 @@ type ExtendedGenesisState struct {
 	ContributorStats      []types.ContributorStats             `json:"contributor_stats"`
 	CtypeWeights          map[string]uint32                    `json:"ctype_weights"`
 	MinQualityForEmission uint32                               `json:"min_quality_for_emission"`
+	CreditDecayRate       *math.LegacyDec                      `json:"credit_decay_rate,omitempty"`
+	FraudSlashParams      *types.FraudSlashParams              `json:"fraud_slash_params,omitempty"`
+	EffectivePowerParams  *types.EffectivePowerParams          `json:"effective_power_params,omitempty"`
+	FeeSplitParams        *types.FeeSplitParams                `json:"fee_split_params,omitempty"`
+	EpochMultiplierParams *types.EpochMultiplierParams         `json:"epoch_multiplier_params,omitempty"`
+	CScoreSnapshotWindow  int64                                `json:"cscore_snapshot_window,omitempty"`
+	CScoreSnapshots       []types.CScoreSnapshot               `json:"cscore_snapshots,omitempty"`
+	RejectionRefundParams *types.RejectionRefundParams         `json:"rejection_refund_params,omitempty"`
+	ContributionFees      []types.ContributionFeeRecord        `json:"contribution_fees,omitempty"`
+	EndorsementParams     *types.EndorsementParams             `json:"endorsement_params,omitempty"`
+	CScoreNamespaceParams *types.CScoreNamespaceParams         `json:"cscore_namespace_params,omitempty"`
 	// Layer 5: Utility & Impact Scoring state
 	ImpactRecords  []types.ContributionImpactRecord  `json:"impact_records,omitempty"`
 	ImpactProfiles []types.ContributorImpactProfile  `json:"impact_profiles,omitempty"`
@@ -101,6 +113,39 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 			if ext.MinQualityForEmission > 0 {
 				_ = k.SetMinQualityForEmission(ctx, ext.MinQualityForEmission)
 			}
+			if ext.CreditDecayRate != nil {
+				_ = k.SetCreditDecayRate(ctx, *ext.CreditDecayRate)
+			}
+			if ext.FraudSlashParams != nil {
+				_ = k.SetFraudSlashParams(ctx, *ext.FraudSlashParams)
+			}
+			if ext.EffectivePowerParams != nil {
+				_ = k.SetEffectivePowerParams(ctx, *ext.EffectivePowerParams)
+			}
+			if ext.FeeSplitParams != nil {
+				_ = k.SetFeeSplitParams(ctx, *ext.FeeSplitParams)
+			}
+			if ext.EpochMultiplierParams != nil {
+				_ = k.SetEpochMultiplierParams(ctx, *ext.EpochMultiplierParams)
+			}
+			if ext.CScoreSnapshotWindow > 0 {
+				_ = k.SetCScoreSnapshotWindow(ctx, ext.CScoreSnapshotWindow)
+			}
+			for _, snapshot := range ext.CScoreSnapshots {
+				_ = k.SetCScoreSnapshot(ctx, snapshot)
+			}
+			if ext.RejectionRefundParams != nil {
+				_ = k.SetRejectionRefundParams(ctx, *ext.RejectionRefundParams)
+			}
+			for _, record := range ext.ContributionFees {
+				_ = k.SetContributionFeeRecord(ctx, record)
+			}
+			if ext.EndorsementParams != nil {
+				_ = k.SetEndorsementParams(ctx, *ext.EndorsementParams)
+			}
+			if ext.CScoreNamespaceParams != nil {
+				_ = k.SetCScoreNamespaceParams(ctx, *ext.CScoreNamespaceParams)
+			}
 			// Layer 5: restore impact scoring state
 			for _, ir := range ext.ImpactRecords {
 				_ = k.SetImpactRecord(ctx, ir)
@@ -157,6 +202,14 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 
 	// Build and persist extended genesis sidecar (state not representable in proto GenesisState)
 	impactParams := k.GetImpactParams(ctx)
+	creditDecayRate := k.GetCreditDecayRate(ctx)
+	fraudSlashParams := k.GetFraudSlashParams(ctx)
+	effectivePowerParams := k.GetEffectivePowerParams(ctx)
+	feeSplitParams := k.GetFeeSplitParams(ctx)
+	epochMultiplierParams := k.GetEpochMultiplierParams(ctx)
+	rejectionRefundParams := k.GetRejectionRefundParams(ctx)
+	endorsementParams := k.GetEndorsementParams(ctx)
+	cscoreNamespaceParams := k.GetCScoreNamespaceParams(ctx)
 	ext := ExtendedGenesisState{
 		VestingSchedules:      k.GetAllVestingSchedules(ctx),
 		ARVSSchedules:         k.GetAllARVSVestingSchedules(ctx),
@@ -166,6 +219,17 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		ContributorStats:      k.GetAllContributorStats(ctx),
 		CtypeWeights:          k.GetCtypeWeights(ctx),
 		MinQualityForEmission: k.GetMinQualityForEmission(ctx),
+		CreditDecayRate:       &creditDecayRate,
+		FraudSlashParams:      &fraudSlashParams,
+		EffectivePowerParams:  &effectivePowerParams,
+		FeeSplitParams:        &feeSplitParams,
+		EpochMultiplierParams: &epochMultiplierParams,
+		CScoreSnapshotWindow:  k.GetCScoreSnapshotWindow(ctx),
+		CScoreSnapshots:       k.GetAllCScoreSnapshots(ctx),
+		RejectionRefundParams: &rejectionRefundParams,
+		ContributionFees:      k.GetAllContributionFeeRecords(ctx),
+		EndorsementParams:     &endorsementParams,
+		CScoreNamespaceParams: &cscoreNamespaceParams,
 		// Layer 5
 		ImpactRecords:  k.GetAllImpactRecords(ctx),
 		ImpactProfiles: k.GetAllImpactProfiles(ctx),
@@ -386,20 +386,19 @@ func (k Keeper) VerifyFraudProof(ctx context.Context, contributionID uint64, pro
 	}
 }
 
-// verifyInvalidQuorumProof checks if the endorsement power was < 2/3 at verification time
+// verifyInvalidQuorumProof checks if the approving weight was below the quorum
+// recorded when the contribution was verified
 func (k Keeper) verifyInvalidQuorumProof(ctx context.Context, contribution types.Contribution) (bool, error) {
 	if !contribution.Verified {
 		return false, nil // Not verified, no quorum to check
 	}
 
-	params := k.GetParams(ctx)
-	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	requiredPower, byPower, err := k.verifiedQuorum(ctx, contribution)
 	if err != nil {
 		return false, err
 	}
 
-	requiredPower := math.LegacyNewDecFromInt(totalBonded).Mul(params.QuorumPct).TruncateInt()
-	approvalPower := contribution.GetApprovalPower()
+	approvalPower, _ := endorsementWeights(contribution, byPower)
 
 	// Fraud proven if approval power < required quorum
 	return approvalPower.LT(requiredPower), nil
@@ -797,8 +796,23 @@ func (k Keeper) SetLazyDecayMarker(ctx context.Context, addr string, epoch uint6
 // If the slashing keeper is not available, this is a no-op (soft penalties still apply).
 // ============================================================================
 
+// endorserValAddress parses an endorsement's validator address, which may be
+// stored in validator or account bech32 form
+func endorserValAddress(addr string) (sdk.ValAddress, error) {
+	valAddr, err := sdk.ValAddressFromBech32(addr)
+	if err == nil {
+		return valAddr, nil
+	}
+	accAddr, accErr := sdk.AccAddressFromBech32(addr)
+	if accErr != nil {
+		return nil, err
+	}
+	return sdk.ValAddress(accAddr), nil
+}
+
 // SlashFraudEndorsers slashes and jails validators who approved a fraudulent contribution.
-// Called from InvalidateContribution() after a fraud proof succeeds.
+// Called from InvalidateContribution() after a fraud proof succeeds. Validators already
+// slashed for this contribution are skipped.
 func (k Keeper) SlashFraudEndorsers(ctx context.Context, contribution types.Contribution) {
 	if k.slashingKeeper == nil {
 		k.logger.Debug("slashing keeper not available, skipping fraud endorser slashing",
@@ -806,8 +820,6 @@ func (k Keeper) SlashFraudEndorsers(ctx context.Context, contribution types.Cont
 		return
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	slashFraction := types.DefaultSlashFractionFraudEndorsement()
 	var slashedCount int
 
 	for _, endorsement := range contribution.Endorsements {
@@ -815,37 +827,15 @@ func (k Keeper) SlashFraudEndorsers(ctx context.Context, contribution types.Cont
 			continue // Only slash approvers of fraudulent content
 		}
 
-		valAddr, err := sdk.ValAddressFromBech32(endorsement.ValAddr)
+		valAddr, err := endorserValAddress(endorsement.ValAddr)
 		if err != nil {
-			// Try AccAddress → ValAddress conversion
-			accAddr, accErr := sdk.AccAddressFromBech32(endorsement.ValAddr)
-			if accErr != nil {
-				k.logger.Debug("invalid endorser address, skipping slash",
-					"address", endorsement.ValAddr, "error", err)
-				continue
-			}
-			valAddr = sdk.ValAddress(accAddr)
-		}
-
-		consAddr := sdk.ConsAddress(valAddr)
-
-		slashedAmt, err := k.slashingKeeper.Slash(
-			ctx,
-			consAddr,
-			sdkCtx.BlockHeight(),
-			0, // power=0 lets the slashing module determine from state
-			slashFraction,
-		)
-		if err != nil {
-			k.logger.Error("failed to slash fraud endorser",
-				"validator", valAddr.String(),
-				"contribution_id", contribution.Id,
-				"error", err)
+			k.logger.Debug("invalid endorser address, skipping slash",
+				"address", endorsement.ValAddr, "error", err)
 			continue
 		}
 
-		if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
-			k.logger.Error("failed to jail fraud endorser",
+		if _, err := k.slashFraudEndorser(ctx, contribution.Id, valAddr); err != nil {
+			k.logger.Error("failed to slash fraud endorser",
 				"validator", valAddr.String(),
 				"contribution_id", contribution.Id,
 				"error", err)
@@ -853,23 +843,6 @@ func (k Keeper) SlashFraudEndorsers(ctx context.Context, contribution types.Cont
 		}
 
 		slashedCount++
-
-		k.logger.Warn("FRAUD ENDORSER SLASHED AND JAILED",
-			"validator", valAddr.String(),
-			"contribution_id", contribution.Id,
-			"slash_fraction", slashFraction.String(),
-			"slashed_amount", slashedAmt.String(),
-			"block_height", sdkCtx.BlockHeight(),
-		)
-
-		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
-			"poc_fraud_endorser_slashed",
-			sdk.NewAttribute("validator", valAddr.String()),
-			sdk.NewAttribute("contribution_id", fmt.Sprintf("%d", contribution.Id)),
-			sdk.NewAttribute("slash_fraction", slashFraction.String()),
-			sdk.NewAttribute("slashed_amount", slashedAmt.String()),
-			sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
-		))
 	}
 
 	if slashedCount > 0 {
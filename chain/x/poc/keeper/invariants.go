@@ -245,10 +245,9 @@ func QuorumCorrectnessInvariant(k Keeper) sdk.Invariant {
 			msg    string
 		)
 
-		params := k.GetParams(ctx)
-
-		// Get total bonded tokens
-		totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+		// Current threshold, for contributions verified before the quorum
+		// snapshot was recorded
+		currentRequired, totalBonded, currentByPower, err := k.quorumThreshold(ctx)
 		if err != nil {
 			return sdk.FormatInvariant(
 				types.ModuleName, "quorum-correctness",
@@ -256,22 +255,22 @@ func QuorumCorrectnessInvariant(k Keeper) sdk.Invariant {
 			), true
 		}
 
-		if totalBonded.IsZero() {
-			return sdk.FormatInvariant(
-				types.ModuleName, "quorum-correctness",
-				"no bonded tokens, skipping quorum check",
-			), false
-		}
-
-		requiredPower := math.LegacyNewDecFromInt(totalBonded).Mul(params.QuorumPct).TruncateInt()
-
 		iterErr := k.IterateContributions(ctx, func(contribution types.Contribution) bool {
 			if !contribution.Verified {
 				return false // Only check verified contributions
 			}
 
-			// Calculate approval power
-			approvalPower := contribution.GetApprovalPower()
+			// Check against the quorum recorded at verification time
+			requiredPower, byPower, ok := contribution.QuorumSnapshot()
+			if !ok {
+				if totalBonded.IsZero() {
+					return false // No bonded tokens, nothing to compare against
+				}
+				requiredPower, byPower = currentRequired, currentByPower
+			}
+
+			// Calculate approval power (validator count under equal weighting)
+			approvalPower, _ := endorsementWeights(contribution, byPower)
 
 			// Verified contributions should have met quorum
 			if approvalPower.LT(requiredPower) {
@@ -261,7 +261,8 @@ func (k Keeper) SetContribution(ctx context.Context, contribution types.Contribu
 		_ = store.Delete(pendingKey)
 	}
 
-	return nil
+	// Index by lifecycle status: present under exactly the current status
+	return k.setContributionStatusIndex(ctx, contribution)
 }
 
 // TransitionClaimStatus validates and applies a unified claim status transition.
@@ -429,7 +430,10 @@ func (k Keeper) SetCredits(ctx context.Context, credits types.Credits) error {
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(&credits)
 	key := types.GetCreditsKey(credits.Address)
-	return store.Set(key, bz)
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+	return k.recordCScoreSnapshot(ctx, credits.Address, credits.Amount)
 }
 
 // AddCredits adds credits to an address
@@ -445,6 +449,11 @@ func (k Keeper) AddCreditsWithOverflowCheck(ctx context.Context, addr sdk.AccAdd
 		return fmt.Errorf("cannot add negative or zero credits")
 	}
 
+	// Settle time-based decay on the existing balance before adding
+	if _, err := k.ApplyTimeDecay(ctx, addr); err != nil {
+		return err
+	}
+
 	existingCredits := k.GetCredits(ctx, addr)
 
 	// Compute new total
@@ -504,8 +513,10 @@ func (k Keeper) GetAllCredits(ctx context.Context) []types.Credits {
 
 // ========== Rate Limiting ==========
 
-// CheckRateLimit checks if the submission rate limit has been exceeded.
-// Uses the transient store (auto-resets each block) to avoid persistent state bloat.
+// CheckRateLimit enforces the per-block submission quota (MaxPerBlock), counting
+// this submission against it when allowed.
+// Uses the transient store (auto-resets each block, and explicitly in BeginBlock)
+// to avoid persistent state bloat.
 func (k Keeper) CheckRateLimit(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	params := k.GetParams(ctx)
@@ -522,7 +533,9 @@ func (k Keeper) CheckRateLimit(ctx context.Context) error {
 	}
 
 	if count >= params.MaxPerBlock {
-		return types.ErrRateLimitExceeded
+		return types.ErrRateLimitExceeded.Wrapf(
+			"block %d already has %d submissions (max_per_block %d); retry in a later block",
+			sdkCtx.BlockHeight(), count, params.MaxPerBlock)
 	}
 
 	// Increment count
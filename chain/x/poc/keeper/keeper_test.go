@@ -184,6 +184,11 @@ func (m mockStakingKeeper) PowerReduction(ctx context.Context) math.Int {
 }
 
 func SetupKeeperTest(t *testing.T) *KeeperTestFixture {
+	return setupKeeperTestWithStaking(t, mockStakingKeeper{})
+}
+
+// setupKeeperTestWithStaking builds the fixture around a custom staking keeper
+func setupKeeperTestWithStaking(t *testing.T, stakingKeeper types.StakingKeeper) *KeeperTestFixture {
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 	cdc := encCfg.Codec
 
@@ -218,7 +223,7 @@ func SetupKeeperTest(t *testing.T) *KeeperTestFixture {
 		tStoreKey,
 		log.NewNopLogger(),
 		authority,
-		mockStakingKeeper{},
+		stakingKeeper,
 		bankKeeper,
 		accountKeeper,
 	)
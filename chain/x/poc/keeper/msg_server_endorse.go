@@ -70,9 +70,66 @@ func (ms msgServer) Endorse(goCtx context.Context, msg *types.MsgEndorse) (*type
 		))
 	}
 
+	// A rejection can only be caused by this vote, since votes on an already
+	// rejected contribution are refused by AddEndorsement
+	if !msg.Decision {
+		if contribution, found := ms.GetContribution(goCtx, msg.ContributionId); found &&
+			contribution.ReviewStatus == uint32(types.ReviewStatusRejected) {
+			events = append(events, sdk.NewEvent(
+				"poc_rejected",
+				sdk.NewAttribute("contribution_id", fmt.Sprintf("%d", msg.ContributionId)),
+			))
+		}
+	}
+
 	ctx.EventManager().EmitEvents(events)
 
 	return &types.MsgEndorseResponse{
 		Verified: verified,
 	}, nil
 }
+
+// BatchEndorse handles MsgBatchEndorse. Each item runs through Endorse in its
+// own cache context, so a failing item is skipped and its writes discarded
+// while the other items still apply.
+func (ms msgServer) BatchEndorse(goCtx context.Context, msg *types.MsgBatchEndorse) (*types.MsgBatchEndorseResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.MsgBatchEndorseResponse{
+		Results: make([]types.BatchEndorseResult, 0, len(msg.Items)),
+	}
+
+	for i, item := range msg.Items {
+		result := types.BatchEndorseResult{ContributionId: item.ContributionId}
+
+		endorseMsg := msg.EndorseMsg(i)
+		err := endorseMsg.ValidateBasic()
+		if err == nil {
+			cacheCtx, write := ctx.CacheContext()
+			var endorseResp *types.MsgEndorseResponse
+			if endorseResp, err = ms.Endorse(cacheCtx, endorseMsg); err == nil {
+				write()
+				result.Success = true
+				result.Verified = endorseResp.Verified
+				resp.Endorsed++
+			}
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_batch_endorse",
+		sdk.NewAttribute("validator", msg.Validator),
+		sdk.NewAttribute("items", fmt.Sprintf("%d", len(msg.Items))),
+		sdk.NewAttribute("endorsed", fmt.Sprintf("%d", resp.Endorsed)),
+	))
+
+	return resp, nil
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+// validatorSetStakingKeeper serves a fixed validator set; total bonded power
+// is the sum of the bonded validators' tokens.
+type validatorSetStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+}
+
+func (m validatorSetStakingKeeper) GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	val, ok := m.validators[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return val, nil
+}
+
+func (m validatorSetStakingKeeper) GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error) {
+	vals := make([]stakingtypes.Validator, 0, len(m.validators))
+	for _, val := range m.validators {
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+func (m validatorSetStakingKeeper) TotalBondedTokens(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	for _, val := range m.validators {
+		if val.IsBonded() {
+			total = total.Add(val.Tokens)
+		}
+	}
+	return total, nil
+}
+
+func (m validatorSetStakingKeeper) PowerReduction(ctx context.Context) math.Int {
+	return math.NewInt(1000000)
+}
+
+// setupEndorseTest creates four bonded validators with 100 tokens each, one
+// unbonded validator, and a pending contribution with ID 1. With the default
+// 67% quorum, 268 of 400 bonded tokens must approve.
+func setupEndorseTest(t *testing.T) (*KeeperTestFixture, []sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+
+	sk := validatorSetStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	bonded := make([]sdk.AccAddress, 0, 4)
+	for _, name := range []string{"validator_a_________", "validator_b_________", "validator_c_________", "validator_d_________"} {
+		acc := sdk.AccAddress(name)
+		valAddr := sdk.ValAddress(acc)
+		sk.validators[valAddr.String()] = stakingtypes.Validator{
+			OperatorAddress: valAddr.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          math.NewInt(100),
+			DelegatorShares: math.LegacyNewDec(100),
+		}
+		bonded = append(bonded, acc)
+	}
+
+	unbonded := sdk.AccAddress("validator_unbonded__")
+	sk.validators[sdk.ValAddress(unbonded).String()] = stakingtypes.Validator{
+		OperatorAddress: sdk.ValAddress(unbonded).String(),
+		Status:          stakingtypes.Unbonded,
+		Tokens:          math.NewInt(100),
+		DelegatorShares: math.LegacyNewDec(100),
+	}
+
+	f := setupKeeperTestWithStaking(t, sk)
+
+	hash := make([]byte, 32)
+	hash[0] = 0xe1
+	contribution := types.NewContribution(1, sdk.AccAddress("contributor_________").String(),
+		"code", "ipfs://QmEndorseTest", hash, 0, time.Now().Unix())
+	require.NoError(t, f.keeper.SetContribution(f.ctx, contribution))
+
+	return f, bonded, unbonded
+}
+
+func endorseMsg(validator sdk.AccAddress, decision bool) *types.MsgEndorse {
+	return &types.MsgEndorse{
+		Validator:      validator.String(),
+		ContributionId: 1,
+		Decision:       decision,
+	}
+}
+
+func TestEndorse_VerifiedAtQuorum(t *testing.T) {
+	f, validators, _ := setupEndorseTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	// 200 of 400 approving is below the 268 threshold
+	for _, val := range validators[:2] {
+		resp, err := msgSrv.Endorse(ctx, endorseMsg(val, true))
+		require.NoError(t, err)
+		require.False(t, resp.Verified)
+	}
+	require.False(t, hasEventType(ctx, "poc_verified"))
+
+	// 300 of 400 reaches the 2/3 quorum
+	resp, err := msgSrv.Endorse(ctx, endorseMsg(validators[2], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+	require.True(t, hasEventType(ctx, "poc_verified"))
+
+	contribution, found := f.keeper.GetContribution(ctx, 1)
+	require.True(t, found)
+	require.True(t, contribution.Verified)
+	require.Len(t, contribution.Endorsements, 3)
+	require.Equal(t, "300", contribution.GetApprovalPower().String())
+}
+
+func TestEndorse_RejectedBelowThreshold(t *testing.T) {
+	f, validators, _ := setupEndorseTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgSrv.Endorse(ctx, endorseMsg(validators[0], true))
+	require.NoError(t, err)
+
+	// 100 rejecting still leaves 300 that could approve
+	_, err = msgSrv.Endorse(ctx, endorseMsg(validators[1], false))
+	require.NoError(t, err)
+	contribution, _ := f.keeper.GetContribution(ctx, 1)
+	require.NotEqual(t, uint32(types.ReviewStatusRejected), contribution.ReviewStatus)
+	require.False(t, hasEventType(ctx, "poc_rejected"))
+
+	// 200 rejecting leaves at most 200 approving, below the 268 threshold
+	resp, err := msgSrv.Endorse(ctx, endorseMsg(validators[2], false))
+	require.NoError(t, err)
+	require.False(t, resp.Verified)
+	require.True(t, hasEventType(ctx, "poc_rejected"))
+
+	contribution, _ = f.keeper.GetContribution(ctx, 1)
+	require.False(t, contribution.Verified)
+	require.Equal(t, uint32(types.ReviewStatusRejected), contribution.ReviewStatus)
+
+	// The outcome is final
+	_, err = msgSrv.Endorse(ctx, endorseMsg(validators[3], true))
+	require.ErrorIs(t, err, types.ErrContributionRejected)
+}
+
+func TestEndorse_DuplicateVote(t *testing.T) {
+	f, validators, _ := setupEndorseTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgSrv.Endorse(f.ctx, endorseMsg(validators[0], true))
+	require.NoError(t, err)
+
+	// Changing the decision does not allow a second vote
+	_, err = msgSrv.Endorse(f.ctx, endorseMsg(validators[0], false))
+	require.ErrorIs(t, err, types.ErrAlreadyEndorsed)
+
+	contribution, _ := f.keeper.GetContribution(f.ctx, 1)
+	require.Len(t, contribution.Endorsements, 1)
+}
+
+func TestEndorse_NonValidator(t *testing.T) {
+	f, _, unbonded := setupEndorseTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgSrv.Endorse(f.ctx, endorseMsg(sdk.AccAddress("not_a_validator_____"), true))
+	require.ErrorIs(t, err, types.ErrNotValidator)
+
+	_, err = msgSrv.Endorse(f.ctx, endorseMsg(unbonded, true))
+	require.ErrorIs(t, err, types.ErrNotValidator)
+
+	contribution, _ := f.keeper.GetContribution(f.ctx, 1)
+	require.Empty(t, contribution.Endorsements)
+}
+
+func TestBatchEndorse_MixedBatch(t *testing.T) {
+	f, validators, _ := setupEndorseTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	hash := make([]byte, 32)
+	hash[0] = 0xe2
+	require.NoError(t, f.keeper.SetContribution(ctx, types.NewContribution(2,
+		sdk.AccAddress("contributor_________").String(), "code", "ipfs://QmBatch", hash, 0, time.Now().Unix())))
+
+	resp, err := msgSrv.BatchEndorse(ctx, &types.MsgBatchEndorse{
+		Validator: validators[0].String(),
+		Items: []types.BatchEndorseItem{
+			{ContributionId: 1, Decision: true},
+			{ContributionId: 1, Decision: false}, // duplicate vote
+			{ContributionId: 99, Decision: true}, // unknown contribution
+			{ContributionId: 0, Decision: true},  // invalid id
+			{ContributionId: 2, Decision: false},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 5)
+	require.Equal(t, uint32(2), resp.Endorsed)
+
+	for i, wantSuccess := range []bool{true, false, false, false, true} {
+		require.Equal(t, wantSuccess, resp.Results[i].Success, "item %d", i)
+		require.Equal(t, wantSuccess, resp.Results[i].Error == "", "item %d", i)
+	}
+	require.Contains(t, resp.Results[1].Error, types.ErrAlreadyEndorsed.Error())
+	require.Equal(t, uint64(99), resp.Results[2].ContributionId)
+
+	// Only the valid items were applied, with the first decision kept
+	contribution, _ := f.keeper.GetContribution(ctx, 1)
+	require.Len(t, contribution.Endorsements, 1)
+	require.True(t, contribution.Endorsements[0].Decision)
+	contribution, _ = f.keeper.GetContribution(ctx, 2)
+	require.Len(t, contribution.Endorsements, 1)
+	require.False(t, contribution.Endorsements[0].Decision)
+
+	require.True(t, hasEventType(ctx, "poc_endorse"))
+	require.True(t, hasEventType(ctx, "poc_batch_endorse"))
+}
+
+func TestBatchEndorse_SizeCap(t *testing.T) {
+	validator := sdk.AccAddress("validator_a_________").String()
+
+	msg := &types.MsgBatchEndorse{Validator: validator}
+	require.Error(t, msg.ValidateBasic())
+
+	for i := 0; i < types.MaxBatchEndorseSize; i++ {
+		msg.Items = append(msg.Items, types.BatchEndorseItem{ContributionId: uint64(i + 1), Decision: true})
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Items = append(msg.Items, types.BatchEndorseItem{ContributionId: 999, Decision: true})
+	require.Error(t, msg.ValidateBasic())
+}
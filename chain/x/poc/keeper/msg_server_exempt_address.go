@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// AddExemptAddress handles MsgAddExemptAddress (governance only): it appends
+// one address to the exempt list instead of rewriting the whole list.
+func (ms msgServer) AddExemptAddress(goCtx context.Context, msg *types.MsgAddExemptAddress) (*types.MsgAddExemptAddressResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := msg.Apply(ms.GetParams(goCtx))
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.SetParams(goCtx, params); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(goCtx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_exempt_address_added",
+		sdk.NewAttribute("address", msg.Address),
+		sdk.NewAttribute("exempt_count", fmt.Sprintf("%d", len(params.ExemptAddresses))),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return &types.MsgAddExemptAddressResponse{}, nil
+}
+
+// RemoveExemptAddress handles MsgRemoveExemptAddress (governance only): it
+// drops one address from the exempt list instead of rewriting the whole list.
+func (ms msgServer) RemoveExemptAddress(goCtx context.Context, msg *types.MsgRemoveExemptAddress) (*types.MsgRemoveExemptAddressResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	params, err := msg.Apply(ms.GetParams(goCtx))
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.SetParams(goCtx, params); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(goCtx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_exempt_address_removed",
+		sdk.NewAttribute("address", msg.Address),
+		sdk.NewAttribute("exempt_count", fmt.Sprintf("%d", len(params.ExemptAddresses))),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return &types.MsgRemoveExemptAddressResponse{}, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+func TestExemptAddress_Add(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	require.False(t, f.keeper.IsExemptAddress(ctx, testAddr1))
+
+	msg := &types.MsgAddExemptAddress{Authority: f.keeper.GetAuthority(), Address: testAddr1.String()}
+	require.NoError(t, msg.ValidateBasic())
+	_, err := msgSrv.AddExemptAddress(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, f.keeper.IsExemptAddress(ctx, testAddr1))
+	require.True(t, hasEventType(ctx, "poc_exempt_address_added"))
+
+	// A second address is appended; the first stays exempt
+	_, err = msgSrv.AddExemptAddress(ctx, &types.MsgAddExemptAddress{Authority: f.keeper.GetAuthority(), Address: testAddr2.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{testAddr1.String(), testAddr2.String()}, f.keeper.GetParams(ctx).ExemptAddresses)
+}
+
+func TestExemptAddress_Remove(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	params := f.keeper.GetParams(ctx)
+	params.ExemptAddresses = []string{testAddr1.String(), testAddr2.String()}
+	require.NoError(t, f.keeper.SetParams(ctx, params))
+
+	msg := &types.MsgRemoveExemptAddress{Authority: f.keeper.GetAuthority(), Address: testAddr1.String()}
+	require.NoError(t, msg.ValidateBasic())
+	_, err := msgSrv.RemoveExemptAddress(ctx, msg)
+	require.NoError(t, err)
+	require.False(t, f.keeper.IsExemptAddress(ctx, testAddr1))
+	require.True(t, f.keeper.IsExemptAddress(ctx, testAddr2))
+	require.True(t, hasEventType(ctx, "poc_exempt_address_removed"))
+
+	// Removing an address that is not exempt fails
+	_, err = msgSrv.RemoveExemptAddress(ctx, msg)
+	require.ErrorIs(t, err, types.ErrExemptAddressNotFound)
+	require.Equal(t, []string{testAddr2.String()}, f.keeper.GetParams(ctx).ExemptAddresses)
+}
+
+func TestExemptAddress_RejectsDuplicate(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	msg := &types.MsgAddExemptAddress{Authority: f.keeper.GetAuthority(), Address: testAddr1.String()}
+	_, err := msgSrv.AddExemptAddress(ctx, msg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgSrv.AddExemptAddress(ctx, msg)
+	require.ErrorContains(t, err, "duplicate exempt address")
+	require.False(t, hasEventType(ctx, "poc_exempt_address_added"))
+	require.Equal(t, []string{testAddr1.String()}, f.keeper.GetParams(ctx).ExemptAddresses)
+}
+
+func TestExemptAddress_RejectsEmpty(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+	authority := f.keeper.GetAuthority()
+
+	add := &types.MsgAddExemptAddress{Authority: authority, Address: ""}
+	require.ErrorContains(t, add.ValidateBasic(), "empty address")
+	_, err := msgSrv.AddExemptAddress(f.ctx, add)
+	require.ErrorContains(t, err, "empty address")
+
+	remove := &types.MsgRemoveExemptAddress{Authority: authority, Address: ""}
+	require.ErrorContains(t, remove.ValidateBasic(), "empty address")
+	_, err = msgSrv.RemoveExemptAddress(f.ctx, remove)
+	require.ErrorContains(t, err, "empty address")
+
+	// Malformed addresses are rejected the same way as in Params.Validate
+	_, err = msgSrv.AddExemptAddress(f.ctx, &types.MsgAddExemptAddress{Authority: authority, Address: "not_bech32"})
+	require.ErrorContains(t, err, "invalid exempt address")
+	require.Empty(t, f.keeper.GetParams(f.ctx).ExemptAddresses)
+}
+
+func TestExemptAddress_GovernanceOnly(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgSrv.AddExemptAddress(f.ctx, &types.MsgAddExemptAddress{Authority: testAddr1.String(), Address: testAddr1.String()})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	require.False(t, f.keeper.IsExemptAddress(f.ctx, testAddr1))
+
+	_, err = msgSrv.RemoveExemptAddress(f.ctx, &types.MsgRemoveExemptAddress{Authority: testAddr1.String(), Address: testAddr1.String()})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+}
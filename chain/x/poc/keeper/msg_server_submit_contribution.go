@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pos/x/poc/types"
@@ -13,12 +14,22 @@ import (
 func (ms msgServer) SubmitContribution(goCtx context.Context, msg *types.MsgSubmitContribution) (*types.MsgSubmitContributionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Emergency kill-switch: reject all new submissions, exempt addresses included
+	if ms.IsSubmissionsPaused(goCtx) {
+		return nil, types.ErrSubmissionsPaused
+	}
+
 	// Convert contributor address
 	contributor, err := sdk.AccAddressFromBech32(msg.Contributor)
 	if err != nil {
 		return nil, fmt.Errorf("invalid contributor address: %w", err)
 	}
 
+	// Only contribution types in the governance-managed registry are accepted
+	if !ms.IsRegisteredCtype(goCtx, msg.Ctype) {
+		return nil, types.ErrInvalidCType.Wrapf("contribution type %q is not registered", msg.Ctype)
+	}
+
 	// THREE-LAYER VERIFICATION PIPELINE
 	// Layer 1: PoE (Proof of Existence) - Already validated in msg.ValidateBasic()
 	// Layer 2: PoA (Proof of Authority) - Check C-Score and identity requirements
@@ -128,7 +139,7 @@ func (ms msgServer) SubmitContribution(goCtx context.Context, msg *types.MsgSubm
 	// 1. Base Fee Model (static base)
 	// 2. Epoch-Adaptive Fee (dynamic congestion multiplier)
 	// 3. C-Score Weighted Discount (reputation-based reduction)
-	finalFee, epochMultiplier, cscoreDiscount, err := ms.Calculate3LayerFee(goCtx, contributor)
+	finalFee, epochMultiplier, cscoreDiscount, err := ms.Calculate3LayerFeeForType(goCtx, contributor, msg.Ctype)
 	if err != nil {
 		return nil, fmt.Errorf("fee calculation failed: %w", err)
 	}
@@ -176,6 +187,17 @@ func (ms msgServer) SubmitContribution(goCtx context.Context, msg *types.MsgSubm
 		return nil, err
 	}
 
+	// Record the pooled fee share so it can be partially refunded on rejection
+	_, poolCoin := ms.SplitSubmissionFee(goCtx, finalFee)
+	if err := ms.SetContributionFeeRecord(goCtx, types.ContributionFeeRecord{
+		ContributionID: id,
+		Contributor:    msg.Contributor,
+		PoolAmount:     poolCoin,
+		Refunded:       math.ZeroInt(),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record contribution fee: %w", err)
+	}
+
 	// Post-creation: register canonical claim or store duplicate record
 	if params.EnableCanonicalHashCheck && len(msg.CanonicalHash) > 0 {
 		if isDuplicate {
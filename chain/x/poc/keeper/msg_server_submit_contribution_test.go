@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+func newSubmitContributionMsg(contributor sdk.AccAddress) *types.MsgSubmitContribution {
+	hash := make([]byte, 32)
+	hash[0] = 0x5c
+	return &types.MsgSubmitContribution{
+		Contributor: contributor.String(),
+		Ctype:       "code",
+		Uri:         "ipfs://QmSubmitTest",
+		Hash:        hash,
+	}
+}
+
+func TestSubmitContribution_Success(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	contributor := sdk.AccAddress("contributor_________")
+	start := math.NewInt(1_000_000)
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", start)
+
+	fee, _, _, err := f.keeper.Calculate3LayerFeeForType(ctx, contributor, "code")
+	require.NoError(t, err)
+	require.True(t, fee.IsPositive())
+
+	resp, err := msgSrv.SubmitContribution(ctx, newSubmitContributionMsg(contributor))
+	require.NoError(t, err)
+
+	// Stored as pending: awaiting similarity analysis, not yet verified
+	contribution, found := f.keeper.GetContribution(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, contributor.String(), contribution.Contributor)
+	require.Equal(t, "code", contribution.Ctype)
+	require.False(t, contribution.Verified)
+	require.Equal(t, uint32(types.ClaimStatusAwaitingSimilarity), contribution.ClaimStatus)
+
+	// Fee collected; half burned, half kept for the reward pool
+	balance := f.bankKeeper.GetBalance(ctx, contributor, "omniphi").Amount
+	require.Equal(t, start.Sub(fee.Amount).String(), balance.String())
+	burned := fee.Amount.QuoRaw(2)
+	moduleBalance := f.bankKeeper.GetBalance(ctx, sdk.AccAddress("module_address______"), "omniphi").Amount
+	require.Equal(t, fee.Amount.Sub(burned).String(), moduleBalance.String())
+
+	require.True(t, hasEventType(ctx, "poc_submit"))
+}
+
+func TestSubmitContribution_GatedByCScore(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	contributor := sdk.AccAddress("contributor_________")
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", math.NewInt(1_000_000))
+
+	params := f.keeper.GetParams(f.ctx)
+	params.EnableCscoreGating = true
+	params.MinCscoreForCtype = map[string]math.Int{"code": math.NewInt(1000)}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	_, err := msgSrv.SubmitContribution(f.ctx, newSubmitContributionMsg(contributor))
+	require.ErrorIs(t, err, types.ErrInsufficientCScore)
+
+	// Rejected before any fee is taken or contribution stored
+	require.Equal(t, "1000000", f.bankKeeper.GetBalance(f.ctx, contributor, "omniphi").Amount.String())
+	_, found := f.keeper.GetContribution(f.ctx, 1)
+	require.False(t, found)
+}
+
+func TestSubmitContribution_InsufficientBalanceForFee(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	contributor := sdk.AccAddress("contributor_________")
+	fee, _, _, err := f.keeper.Calculate3LayerFeeForType(f.ctx, contributor, "code")
+	require.NoError(t, err)
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", fee.Amount.SubRaw(1))
+
+	_, err = msgSrv.SubmitContribution(f.ctx, newSubmitContributionMsg(contributor))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "fee collection failed")
+
+	require.Equal(t, fee.Amount.SubRaw(1).String(), f.bankKeeper.GetBalance(f.ctx, contributor, "omniphi").Amount.String())
+	_, found := f.keeper.GetContribution(f.ctx, 1)
+	require.False(t, found)
+}
+
+func TestSubmitContribution_PerBlockQuota(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	contributor := sdk.AccAddress("contributor_________")
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", math.NewInt(100_000_000))
+
+	params := f.keeper.GetParams(f.ctx)
+	params.MaxPerBlock = 3
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	submit := func(ctx sdk.Context, n byte) error {
+		msg := newSubmitContributionMsg(contributor)
+		msg.Hash[1] = n
+		_, err := msgSrv.SubmitContribution(ctx, msg)
+		return err
+	}
+
+	// Fill the quota for this block
+	ctx := f.ctx.WithBlockHeight(10)
+	for i := byte(0); i < 3; i++ {
+		require.NoError(t, submit(ctx, i))
+	}
+
+	// The next submission in the same block is rejected and nothing is stored
+	err := submit(ctx, 3)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.Contains(t, err.Error(), "max_per_block 3")
+	_, found := f.keeper.GetContribution(ctx, 4)
+	require.False(t, found)
+
+	// BeginBlock of the next block resets the quota
+	ctx = ctx.WithBlockHeight(11)
+	f.keeper.ResetBlockSubmissions(ctx)
+	require.NoError(t, submit(ctx, 3))
+	_, found = f.keeper.GetContribution(ctx, 4)
+	require.True(t, found)
+}
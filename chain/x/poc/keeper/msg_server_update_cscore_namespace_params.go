@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// UpdateCScoreNamespaceParams handles MsgUpdateCScoreNamespaceParams
+// (governance only): it turns the per-type C-Score fee discount on or off and
+// sets the weight of credits earned in other contribution types.
+func (ms msgServer) UpdateCScoreNamespaceParams(goCtx context.Context, msg *types.MsgUpdateCScoreNamespaceParams) (*types.MsgUpdateCScoreNamespaceParamsResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := ms.SetCScoreNamespaceParams(goCtx, msg.Params()); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(goCtx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_cscore_namespace_params_updated",
+		sdk.NewAttribute("enable_type_scoped_discount", fmt.Sprintf("%t", msg.EnableTypeScopedDiscount)),
+		sdk.NewAttribute("cross_category_factor_bps", fmt.Sprintf("%d", msg.CrossCategoryFactorBps)),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return &types.MsgUpdateCScoreNamespaceParamsResponse{}, nil
+}
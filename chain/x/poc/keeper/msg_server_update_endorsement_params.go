@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// UpdateEndorsementParams handles MsgUpdateEndorsementParams (governance
+// only): it replaces the minimum approval count and the vote weighting.
+// Verified contributions keep the quorum snapshot they were verified against.
+func (ms msgServer) UpdateEndorsementParams(goCtx context.Context, msg *types.MsgUpdateEndorsementParams) (*types.MsgUpdateEndorsementParamsResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	params := msg.Params()
+	if params.Weighting == "" {
+		params.Weighting = types.EndorsementWeightingPower
+	}
+	if err := ms.SetEndorsementParams(goCtx, params); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(goCtx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_endorsement_params_updated",
+		sdk.NewAttribute("min_endorsements", fmt.Sprintf("%d", params.MinEndorsements)),
+		sdk.NewAttribute("weighting", params.Weighting),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return &types.MsgUpdateEndorsementParamsResponse{}, nil
+}
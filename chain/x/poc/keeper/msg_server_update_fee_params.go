@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// UpdatePocFeeParams handles MsgUpdatePocFeeParams (governance only): it
+// replaces the 3-layer fee params and leaves the rest of Params untouched.
+func (ms msgServer) UpdatePocFeeParams(goCtx context.Context, msg *types.MsgUpdatePocFeeParams) (*types.MsgUpdatePocFeeParamsResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.SetParams(goCtx, msg.Apply(ms.GetParams(goCtx))); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(goCtx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_fee_params_updated",
+		sdk.NewAttribute("base_submission_fee", msg.BaseSubmissionFee.String()),
+		sdk.NewAttribute("target_submissions_per_block", fmt.Sprintf("%d", msg.TargetSubmissionsPerBlock)),
+		sdk.NewAttribute("max_cscore_discount", msg.MaxCscoreDiscount.String()),
+		sdk.NewAttribute("minimum_submission_fee", msg.MinimumSubmissionFee.String()),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return &types.MsgUpdatePocFeeParamsResponse{}, nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+func validFeeParamsMsg(authority string) *types.MsgUpdatePocFeeParams {
+	return &types.MsgUpdatePocFeeParams{
+		Authority:                 authority,
+		BaseSubmissionFee:         sdk.NewCoin("omniphi", math.NewInt(50000)),
+		TargetSubmissionsPerBlock: 10,
+		MaxCscoreDiscount:         math.LegacyNewDecWithPrec(75, 2),
+		MinimumSubmissionFee:      sdk.NewCoin("omniphi", math.NewInt(5000)),
+	}
+}
+
+func TestUpdatePocFeeParams_Valid(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	before := f.keeper.GetParams(ctx)
+	msg := validFeeParamsMsg(f.keeper.GetAuthority())
+	_, err := msgSrv.UpdatePocFeeParams(ctx, msg)
+	require.NoError(t, err)
+
+	after := f.keeper.GetParams(ctx)
+	require.Equal(t, msg.BaseSubmissionFee, after.BaseSubmissionFee)
+	require.Equal(t, msg.TargetSubmissionsPerBlock, after.TargetSubmissionsPerBlock)
+	require.True(t, msg.MaxCscoreDiscount.Equal(after.MaxCscoreDiscount))
+	require.Equal(t, msg.MinimumSubmissionFee, after.MinimumSubmissionFee)
+	require.True(t, hasEventType(ctx, "poc_fee_params_updated"))
+
+	// Everything else is untouched
+	require.Equal(t, before.SubmissionFee, after.SubmissionFee)
+	require.Equal(t, before.MaxPerBlock, after.MaxPerBlock)
+	require.True(t, before.QuorumPct.Equal(after.QuorumPct))
+	require.Equal(t, before.ExemptAddresses, after.ExemptAddresses)
+
+	// The new base fee drives the fee calculation
+	fee, multiplier, discount, err := f.keeper.Calculate3LayerFee(ctx, testAddr1)
+	require.NoError(t, err)
+	require.True(t, discount.IsZero())
+	expected := math.LegacyNewDecFromInt(msg.BaseSubmissionFee.Amount).Mul(multiplier).TruncateInt()
+	require.Equal(t, sdk.NewCoin("omniphi", math.MaxInt(expected, msg.MinimumSubmissionFee.Amount)), fee)
+}
+
+func TestUpdatePocFeeParams_GovernanceOnly(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	before := f.keeper.GetParams(f.ctx).BaseSubmissionFee
+	_, err := msgSrv.UpdatePocFeeParams(f.ctx, validFeeParamsMsg(testAddr1.String()))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	require.Equal(t, before, f.keeper.GetParams(f.ctx).BaseSubmissionFee)
+}
+
+func TestUpdatePocFeeParams_ValidationFailures(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(msg *types.MsgUpdatePocFeeParams)
+		errMsg string
+	}{
+		{
+			name: "minimum above base",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.MinimumSubmissionFee = sdk.NewCoin("omniphi", math.NewInt(60000))
+			},
+			errMsg: "cannot exceed base_submission_fee",
+		},
+		{
+			name: "denom mismatch",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.MinimumSubmissionFee = sdk.NewCoin("uatom", math.NewInt(5000))
+			},
+			errMsg: "must have same denom",
+		},
+		{
+			name: "zero target",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.TargetSubmissionsPerBlock = 0
+			},
+			errMsg: "must be greater than 0",
+		},
+		{
+			name: "target above 1000",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.TargetSubmissionsPerBlock = 1001
+			},
+			errMsg: "cannot exceed 1000",
+		},
+		{
+			name: "negative discount",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.MaxCscoreDiscount = math.LegacyNewDecWithPrec(-1, 2)
+			},
+			errMsg: "invalid max_cscore_discount",
+		},
+		{
+			name: "discount above 1",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.MaxCscoreDiscount = math.LegacyNewDecWithPrec(101, 2)
+			},
+			errMsg: "invalid max_cscore_discount",
+		},
+		{
+			name: "nil discount",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.MaxCscoreDiscount = math.LegacyDec{}
+			},
+			errMsg: "invalid max_cscore_discount",
+		},
+		{
+			name: "invalid base fee",
+			mutate: func(msg *types.MsgUpdatePocFeeParams) {
+				msg.BaseSubmissionFee = sdk.Coin{Denom: "", Amount: math.NewInt(50000)}
+			},
+			errMsg: "invalid base_submission_fee",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupKeeperTest(t)
+			msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+			msg := validFeeParamsMsg(f.keeper.GetAuthority())
+			tc.mutate(msg)
+			require.ErrorContains(t, msg.ValidateBasic(), tc.errMsg)
+
+			_, err := msgSrv.UpdatePocFeeParams(f.ctx, msg)
+			require.ErrorContains(t, err, tc.errMsg)
+			require.Equal(t, types.DefaultBaseSubmissionFee, f.keeper.GetParams(f.ctx).BaseSubmissionFee)
+		})
+	}
+}
@@ -16,6 +16,10 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		return nil, err
 	}
 
+	if err := ms.ValidateParamsCtypes(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := ms.SetParams(goCtx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// UpdateRejectionRefundParams handles MsgUpdateRejectionRefundParams
+// (governance only): it sets the share of the pooled fee refunded when
+// endorsers reject a contribution.
+func (ms msgServer) UpdateRejectionRefundParams(goCtx context.Context, msg *types.MsgUpdateRejectionRefundParams) (*types.MsgUpdateRejectionRefundParamsResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := ms.SetRejectionRefundParams(goCtx, msg.Params()); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(goCtx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_rejection_refund_params_updated",
+		sdk.NewAttribute("refund_ratio", msg.RefundRatio.String()),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return &types.MsgUpdateRejectionRefundParamsResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	cosmossdk_io_math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"pos/x/poc/types"
 )
 
@@ -505,6 +506,198 @@ func (k Keeper) SetMinQualityForEmission(ctx context.Context, minQuality uint32)
 	return store.Set(types.KeyMinQualityForEmission, bz)
 }
 
+// GetCreditDecayRate returns the annual credit decay rate.
+// Stored as a LegacyDec string at KeyCreditDecayRate. Falls back to DefaultCreditDecayRate when unset.
+func (k Keeper) GetCreditDecayRate(ctx context.Context) cosmossdk_io_math.LegacyDec {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyCreditDecayRate)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultCreditDecayRate
+	}
+	rate, err := cosmossdk_io_math.LegacyNewDecFromStr(string(bz))
+	if err != nil {
+		return types.DefaultCreditDecayRate
+	}
+	return rate
+}
+
+// SetCreditDecayRate stores the annual credit decay rate. Must be in [0, 1].
+func (k Keeper) SetCreditDecayRate(ctx context.Context, rate cosmossdk_io_math.LegacyDec) error {
+	if rate.IsNil() || rate.IsNegative() || rate.GT(cosmossdk_io_math.LegacyOneDec()) {
+		return fmt.Errorf("credit_decay_rate must be in [0, 1], got %s", rate)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyCreditDecayRate, []byte(rate.String()))
+}
+
+// GetFraudSlashParams returns the fraud endorsement slashing parameters.
+// Falls back to DefaultFraudSlashParams when unset.
+func (k Keeper) GetFraudSlashParams(ctx context.Context) types.FraudSlashParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyFraudSlashParams)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultFraudSlashParams()
+	}
+	var p types.FraudSlashParams
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.DefaultFraudSlashParams()
+	}
+	return p
+}
+
+// SetFraudSlashParams validates and persists the fraud endorsement slashing parameters.
+func (k Keeper) SetFraudSlashParams(ctx context.Context, p types.FraudSlashParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyFraudSlashParams, bz)
+}
+
+// GetEffectivePowerParams returns the C-Score voting power bonus parameters.
+// Falls back to DefaultEffectivePowerParams when unset.
+func (k Keeper) GetEffectivePowerParams(ctx context.Context) types.EffectivePowerParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyEffectivePowerParams)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultEffectivePowerParams()
+	}
+	var p types.EffectivePowerParams
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.DefaultEffectivePowerParams()
+	}
+	return p
+}
+
+// SetEffectivePowerParams validates and persists the C-Score voting power bonus parameters.
+func (k Keeper) SetEffectivePowerParams(ctx context.Context, p types.EffectivePowerParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyEffectivePowerParams, bz)
+}
+
+// GetFeeSplitParams returns the 3-layer fee burn/pool split.
+// Falls back to DefaultFeeSplitParams when unset.
+func (k Keeper) GetFeeSplitParams(ctx context.Context) types.FeeSplitParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyFeeSplitParams)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultFeeSplitParams()
+	}
+	var p types.FeeSplitParams
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.DefaultFeeSplitParams()
+	}
+	return p
+}
+
+// SetFeeSplitParams validates and persists the 3-layer fee burn/pool split.
+func (k Keeper) SetFeeSplitParams(ctx context.Context, p types.FeeSplitParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyFeeSplitParams, bz)
+}
+
+// GetEpochMultiplierParams returns the 3-layer fee congestion multiplier bounds.
+// Falls back to DefaultEpochMultiplierParams when unset.
+func (k Keeper) GetEpochMultiplierParams(ctx context.Context) types.EpochMultiplierParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyEpochMultiplierParams)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultEpochMultiplierParams()
+	}
+	var p types.EpochMultiplierParams
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.DefaultEpochMultiplierParams()
+	}
+	return p
+}
+
+// SetEpochMultiplierParams validates and persists the congestion multiplier bounds.
+func (k Keeper) SetEpochMultiplierParams(ctx context.Context, p types.EpochMultiplierParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyEpochMultiplierParams, bz)
+}
+
+// GetRejectionRefundParams returns the rejected contribution refund parameters.
+// Falls back to DefaultRejectionRefundParams when unset.
+func (k Keeper) GetRejectionRefundParams(ctx context.Context) types.RejectionRefundParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyRejectionRefundParams)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultRejectionRefundParams()
+	}
+	var p types.RejectionRefundParams
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.DefaultRejectionRefundParams()
+	}
+	return p
+}
+
+// SetRejectionRefundParams validates and persists the rejected contribution refund parameters.
+func (k Keeper) SetRejectionRefundParams(ctx context.Context, p types.RejectionRefundParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyRejectionRefundParams, bz)
+}
+
+// GetEndorsementParams returns the endorsement thresholds.
+// Falls back to DefaultEndorsementParams when unset.
+func (k Keeper) GetEndorsementParams(ctx context.Context) types.EndorsementParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyEndorsementParams)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultEndorsementParams()
+	}
+	var p types.EndorsementParams
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.DefaultEndorsementParams()
+	}
+	return p
+}
+
+// SetEndorsementParams validates and persists the endorsement thresholds.
+func (k Keeper) SetEndorsementParams(ctx context.Context, p types.EndorsementParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyEndorsementParams, bz)
+}
+
 // GetCtypeWeights returns the per-contribution-type reward weight multipliers (basis points).
 // Stored as a JSON map[string]uint32 at KeyCtypeWeights. Falls back to DefaultCtypeWeights
 // when the key is unset (e.g. on first boot before governance sets a custom map).
@@ -537,6 +730,23 @@ func (k Keeper) SetCtypeWeights(ctx context.Context, weights map[string]uint32)
 	return store.Set(types.KeyCtypeWeights, bz)
 }
 
+// IsRegisteredCtype reports whether ctype is a contribution type known to the
+// chain, i.e. one that has an entry in the governance-managed ctype weights map.
+func (k Keeper) IsRegisteredCtype(ctx context.Context, ctype string) bool {
+	_, ok := k.GetCtypeWeights(ctx)[ctype]
+	return ok
+}
+
+// ValidateParamsCtypes rejects params whose access-control maps reference
+// contribution types that are not registered (see IsRegisteredCtype).
+func (k Keeper) ValidateParamsCtypes(ctx context.Context, params types.Params) error {
+	registered := k.GetCtypeWeights(ctx)
+	return params.ValidateCtypes(func(ctype string) bool {
+		_, ok := registered[ctype]
+		return ok
+	})
+}
+
 // GetMaxVestingReleasesPerEpoch returns the cap on vesting schedules processed per EndBlocker call.
 // Defaults to DefaultMaxVestingReleasesPerEpoch (200) when unset.
 func (k Keeper) GetMaxVestingReleasesPerEpoch(ctx context.Context) uint32 {
@@ -558,3 +768,51 @@ func (k Keeper) SetMaxVestingReleasesPerEpoch(ctx context.Context, cap uint32) e
 	store := k.storeService.OpenKVStore(ctx)
 	return store.Set(types.KeyMaxVestingReleasesPerEpoch, bz)
 }
+
+// GetCScoreSnapshotWindow returns how many blocks of C-Score snapshot history are kept.
+// Defaults to DefaultCScoreSnapshotWindow when unset.
+func (k Keeper) GetCScoreSnapshotWindow(ctx context.Context) int64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyCScoreSnapshotWindow)
+	if err != nil || len(bz) != 8 {
+		return types.DefaultCScoreSnapshotWindow
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetCScoreSnapshotWindow persists the C-Score snapshot retention window. Must be positive.
+func (k Keeper) SetCScoreSnapshotWindow(ctx context.Context, window int64) error {
+	if window <= 0 {
+		return fmt.Errorf("cscore_snapshot_window must be positive, got %d", window)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyCScoreSnapshotWindow, sdk.Uint64ToBigEndian(uint64(window)))
+}
+
+// GetCScoreNamespaceParams returns the per-type C-Score discount settings.
+// Falls back to DefaultCScoreNamespaceParams (global single-score behavior) when unset.
+func (k Keeper) GetCScoreNamespaceParams(ctx context.Context) types.CScoreNamespaceParams {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyCScoreNamespaceParams)
+	if err != nil || len(bz) == 0 {
+		return types.DefaultCScoreNamespaceParams()
+	}
+	var p types.CScoreNamespaceParams
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.DefaultCScoreNamespaceParams()
+	}
+	return p
+}
+
+// SetCScoreNamespaceParams validates and persists the per-type C-Score discount settings.
+func (k Keeper) SetCScoreNamespaceParams(ctx context.Context, p types.CScoreNamespaceParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyCScoreNamespaceParams, bz)
+}
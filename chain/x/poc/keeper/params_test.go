@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"pos/x/poc/keeper"
 	"pos/x/poc/types"
 )
 
@@ -80,3 +81,52 @@ func TestParamsSerialization(t *testing.T) {
 		t.Logf("WARNING: ExemptAddresses is empty after serialization")
 	}
 }
+
+// TestUpdateParams_CtypeRegistry verifies that MsgUpdateParams only accepts
+// access-control maps keyed by registered contribution types.
+func TestUpdateParams_CtypeRegistry(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	// Registered types (present in the ctype weights map) are accepted
+	valid := f.keeper.GetParams(f.ctx)
+	valid.MinCscoreForCtype = map[string]math.Int{
+		"code":   math.NewInt(1000),
+		"record": math.NewInt(500),
+	}
+	valid.RequireIdentityForCtype = map[string]bool{"relay": true}
+
+	_, err := msgSrv.UpdateParams(f.ctx, &types.MsgUpdateParams{
+		Authority: f.keeper.GetAuthority(),
+		Params:    valid,
+	})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), f.keeper.GetParams(f.ctx).MinCscoreForCtype["code"])
+
+	// An unregistered type is rejected before anything is stored
+	invalid := f.keeper.GetParams(f.ctx)
+	invalid.MinCscoreForCtype = map[string]math.Int{
+		"code":    math.NewInt(2000),
+		"quantum": math.NewInt(1000),
+	}
+	_, err = msgSrv.UpdateParams(f.ctx, &types.MsgUpdateParams{
+		Authority: f.keeper.GetAuthority(),
+		Params:    invalid,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCType)
+	require.ErrorContains(t, err, `"quantum"`)
+
+	invalid = f.keeper.GetParams(f.ctx)
+	invalid.RequireIdentityForCtype = map[string]bool{"treasury": true}
+	_, err = msgSrv.UpdateParams(f.ctx, &types.MsgUpdateParams{
+		Authority: f.keeper.GetAuthority(),
+		Params:    invalid,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCType)
+	require.ErrorContains(t, err, "require_identity_for_ctype")
+
+	stored := f.keeper.GetParams(f.ctx)
+	require.Equal(t, math.NewInt(1000), stored.MinCscoreForCtype["code"])
+	require.NotContains(t, stored.MinCscoreForCtype, "quantum")
+	require.Equal(t, map[string]bool{"relay": true}, stored.RequireIdentityForCtype)
+}
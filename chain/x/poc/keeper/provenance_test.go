@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -584,13 +585,31 @@ func TestProvenance_OriginalityMultiplierFromSimilarity(t *testing.T) {
 // Query Tests
 // ============================================================================
 
+// provenanceQueryServer exposes the provenance queries, which are not part of
+// the generated types.QueryServer interface
+type provenanceQueryServer interface {
+	ProvenanceEntry(context.Context, *types.QueryProvenanceEntryRequest) (*types.QueryProvenanceEntryResponse, error)
+	ProvenanceChildren(context.Context, *types.QueryProvenanceChildrenRequest) (*types.QueryProvenanceChildrenResponse, error)
+	ProvenanceLineage(context.Context, *types.QueryProvenanceLineageRequest) (*types.QueryProvenanceLineageResponse, error)
+	ProvenanceByHash(context.Context, *types.QueryProvenanceByHashRequest) (*types.QueryProvenanceByHashResponse, error)
+	ProvenanceBySubmitter(context.Context, *types.QueryProvenanceBySubmitterRequest) (*types.QueryProvenanceBySubmitterResponse, error)
+	ProvenanceStats(context.Context, *types.QueryProvenanceStatsRequest) (*types.QueryProvenanceStatsResponse, error)
+}
+
+func newProvenanceQueryServer(t *testing.T, f *KeeperTestFixture) provenanceQueryServer {
+	t.Helper()
+	qs, ok := keeper.NewQueryServerImpl(f.keeper).(provenanceQueryServer)
+	require.True(t, ok)
+	return qs
+}
+
 func TestProvenance_QueryProvenanceEntry(t *testing.T) {
 	f := SetupKeeperTest(t)
 	enableProvenance(t, f, 10)
 
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, mkContrib(1, "a", "code", []byte("h")), nil))
 
-	qs := keeper.NewQueryServerImpl(f.keeper)
+	qs := newProvenanceQueryServer(t, f)
 	resp, err := qs.ProvenanceEntry(f.ctx, &types.QueryProvenanceEntryRequest{ClaimId: 1})
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), resp.Entry.ClaimID)
@@ -598,7 +617,7 @@ func TestProvenance_QueryProvenanceEntry(t *testing.T) {
 
 func TestProvenance_QueryProvenanceEntry_NotFound(t *testing.T) {
 	f := SetupKeeperTest(t)
-	qs := keeper.NewQueryServerImpl(f.keeper)
+	qs := newProvenanceQueryServer(t, f)
 	_, err := qs.ProvenanceEntry(f.ctx, &types.QueryProvenanceEntryRequest{ClaimId: 999})
 	require.Error(t, err)
 }
@@ -612,7 +631,7 @@ func TestProvenance_QueryProvenanceChildren(t *testing.T) {
 	c2.ParentClaimId = 1
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, c2, nil))
 
-	qs := keeper.NewQueryServerImpl(f.keeper)
+	qs := newProvenanceQueryServer(t, f)
 	resp, err := qs.ProvenanceChildren(f.ctx, &types.QueryProvenanceChildrenRequest{ParentClaimId: 1})
 	require.NoError(t, err)
 	require.Len(t, resp.ChildClaimIds, 1)
@@ -628,7 +647,7 @@ func TestProvenance_QueryProvenanceLineage(t *testing.T) {
 	c2.ParentClaimId = 1
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, c2, nil))
 
-	qs := keeper.NewQueryServerImpl(f.keeper)
+	qs := newProvenanceQueryServer(t, f)
 	resp, err := qs.ProvenanceLineage(f.ctx, &types.QueryProvenanceLineageRequest{ClaimId: 2})
 	require.NoError(t, err)
 	require.Len(t, resp.Path, 2)
@@ -645,7 +664,7 @@ func TestProvenance_QueryByHash(t *testing.T) {
 	c.CanonicalHash = hash
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, c, nil))
 
-	qs := keeper.NewQueryServerImpl(f.keeper)
+	qs := newProvenanceQueryServer(t, f)
 	resp, err := qs.ProvenanceByHash(f.ctx, &types.QueryProvenanceByHashRequest{CanonicalHash: hash})
 	require.NoError(t, err)
 	require.Len(t, resp.Entries, 1)
@@ -658,7 +677,7 @@ func TestProvenance_QueryBySubmitter(t *testing.T) {
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, mkContrib(1, "alice", "code", []byte("h1")), nil))
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, mkContrib(2, "alice", "data", []byte("h2")), nil))
 
-	qs := keeper.NewQueryServerImpl(f.keeper)
+	qs := newProvenanceQueryServer(t, f)
 	resp, err := qs.ProvenanceBySubmitter(f.ctx, &types.QueryProvenanceBySubmitterRequest{Submitter: "alice"})
 	require.NoError(t, err)
 	require.Len(t, resp.Entries, 2)
@@ -671,7 +690,7 @@ func TestProvenance_QueryStats(t *testing.T) {
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, mkContrib(1, "a", "code", []byte("h1")), nil))
 	require.NoError(t, f.keeper.RegisterProvenance(f.ctx, mkContrib(2, "b", "data", []byte("h2")), nil))
 
-	qs := keeper.NewQueryServerImpl(f.keeper)
+	qs := newProvenanceQueryServer(t, f)
 	resp, err := qs.ProvenanceStats(f.ctx, &types.QueryProvenanceStatsRequest{})
 	require.NoError(t, err)
 	require.Equal(t, uint64(2), resp.Stats.TotalEntries)
@@ -260,3 +260,111 @@ func (qs queryServer) ProvenanceStats(goCtx context.Context, req *types.QueryPro
 
 	return &types.QueryProvenanceStatsResponse{Stats: stats}, nil
 }
+
+// CanSubmit reports whether an address may submit a contribution type right
+// now, with the reason and the type's access requirements.
+func (qs queryServer) CanSubmit(goCtx context.Context, req *types.QueryCanSubmitRequest) (*types.QueryCanSubmitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Ctype == "" {
+		return nil, status.Error(codes.InvalidArgument, "ctype is required")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	canSubmit, reason := qs.CanSubmitContribution(goCtx, addr, req.Ctype)
+	requiredCscore, requiresIdentity := types.SubmitRequirements(qs.GetParams(goCtx), req.Ctype)
+
+	return &types.QueryCanSubmitResponse{
+		CanSubmit:        canSubmit,
+		Reason:           reason,
+		RequiredCscore:   requiredCscore,
+		RequiresIdentity: requiresIdentity,
+	}, nil
+}
+
+// ContributionsByStatus lists contributions with their lifecycle status and
+// endorsement tally, optionally filtered by status and contributor.
+func (qs queryServer) ContributionsByStatus(goCtx context.Context, req *types.QueryContributionsByStatusRequest) (*types.QueryContributionsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var contributionStatus types.ContributionStatus
+	if req.Status != "" {
+		var err error
+		if contributionStatus, err = types.ParseContributionStatus(req.Status); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Contributor != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Contributor); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid contributor address")
+		}
+	}
+
+	contributions, pageRes, err := qs.GetContributionsByStatus(goCtx, contributionStatus, req.Contributor, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	summaries := make([]types.ContributionSummary, 0, len(contributions))
+	for _, contribution := range contributions {
+		summaries = append(summaries, types.NewContributionSummary(contribution))
+	}
+	return &types.QueryContributionsByStatusResponse{
+		Contributions: summaries,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// FeeQuote returns the fee a submission from an address would pay in the
+// current block, broken down as Calculate3LayerFee computes it. Read-only: the
+// block submission counter is not incremented.
+func (qs queryServer) FeeQuote(goCtx context.Context, req *types.QueryFeeQuoteRequest) (*types.QueryFeeQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	finalFee, epochMultiplier, cscoreDiscount, err := qs.Calculate3LayerFeeForType(goCtx, addr, req.Ctype)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeQuoteResponse{
+		BaseFee:         qs.GetParams(goCtx).BaseSubmissionFee,
+		EpochMultiplier: epochMultiplier,
+		CscoreDiscount:  cscoreDiscount,
+		FinalFee:        finalFee,
+	}, nil
+}
+
+// AccessControlConfig returns the gating switches, the per-type C-Score and
+// identity requirements and the exempt address list.
+func (qs queryServer) AccessControlConfig(goCtx context.Context, req *types.QueryAccessControlConfigRequest) (*types.QueryAccessControlConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params := qs.GetParams(goCtx)
+	cscoreRequirements := make(map[string]string)
+	for ctype, score := range qs.GetCScoreRequirements(goCtx) {
+		cscoreRequirements[ctype] = score.String()
+	}
+	return &types.QueryAccessControlConfigResponse{
+		EnableCscoreGating:   params.EnableCscoreGating,
+		EnableIdentityGating: params.EnableIdentityGating,
+		CscoreRequirements:   cscoreRequirements,
+		IdentityRequirements: qs.GetIdentityRequirements(goCtx),
+		ExemptAddresses:      append([]string{}, params.ExemptAddresses...),
+	}, nil
+}
@@ -10,27 +10,121 @@ import (
 	"pos/x/poc/types"
 )
 
-// HasQuorum checks if a contribution has reached the required quorum
+// quorumThreshold returns the weight approvals must reach for a contribution
+// to be verified and the total weight of the bonded validator set. Under power
+// weighting the weight is bonded tokens; under equal weighting each bonded
+// validator counts once.
+func (k Keeper) quorumThreshold(ctx context.Context) (required, total math.Int, byPower bool, err error) {
+	quorumPct := k.GetParams(ctx).QuorumPct
+
+	if k.GetEndorsementParams(ctx).WeightByPower() {
+		total, err = k.stakingKeeper.TotalBondedTokens(ctx)
+		if err != nil {
+			return math.ZeroInt(), math.ZeroInt(), true, err
+		}
+		required = math.LegacyNewDecFromInt(total).Mul(quorumPct).TruncateInt()
+		return required, total, true, nil
+	}
+
+	bonded, err := k.bondedValidatorCount(ctx)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), false, err
+	}
+	total = math.NewInt(bonded)
+	// Round up: truncating 67% of 4 validators would accept 2 of them
+	required = math.LegacyNewDecFromInt(total).Mul(quorumPct).Ceil().TruncateInt()
+	return required, total, false, nil
+}
+
+// bondedValidatorCount returns the number of bonded validators, the electorate
+// under equal weighting
+func (k Keeper) bondedValidatorCount(ctx context.Context) (int64, error) {
+	validators, err := k.stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for _, val := range validators {
+		if val.IsBonded() && !val.IsJailed() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// endorsementWeights returns a contribution's approving and rejecting weight,
+// in bonded tokens or validator count to match quorumThreshold
+func endorsementWeights(c types.Contribution, byPower bool) (approvals, rejections math.Int) {
+	if byPower {
+		return c.GetApprovalPower(), c.GetRejectionPower()
+	}
+	return math.NewInt(int64(c.GetApprovalCount())), math.NewInt(int64(c.GetRejectionCount()))
+}
+
+// HasQuorum checks if a contribution has reached the required quorum: enough
+// distinct approving validators (MinEndorsements, or every bonded validator if
+// there are fewer) and enough approving weight
 func (k Keeper) HasQuorum(ctx context.Context, c types.Contribution) (bool, error) {
-	// Get total bonded tokens
-	total, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	reached, _, _, err := k.checkQuorum(ctx, c)
+	return reached, err
+}
+
+// checkQuorum is HasQuorum that also returns the required weight and the
+// weighting the contribution was checked against, for the quorum snapshot
+func (k Keeper) checkQuorum(ctx context.Context, c types.Contribution) (reached bool, requiredWeight math.Int, byPower bool, err error) {
+	minEndorsements := k.GetEndorsementParams(ctx).MinEndorsements
+	if approvalCount := c.GetApprovalCount(); approvalCount < minEndorsements {
+		// A validator set smaller than MinEndorsements could never verify
+		// anything, so the minimum is capped at the bonded validator count
+		bonded, err := k.bondedValidatorCount(ctx)
+		if err != nil {
+			return false, math.ZeroInt(), false, err
+		}
+		if int64(approvalCount) < min(int64(minEndorsements), bonded) {
+			return false, math.ZeroInt(), false, nil
+		}
+	}
+
+	requiredWeight, total, byPower, err := k.quorumThreshold(ctx)
 	if err != nil {
-		return false, err
+		return false, math.ZeroInt(), false, err
 	}
 
 	if total.IsZero() {
-		return false, nil
+		return false, math.ZeroInt(), false, nil
 	}
 
-	// Calculate approval power
-	approvalPower := c.GetApprovalPower()
+	// Check if approvals meet or exceed the threshold
+	approvals, _ := endorsementWeights(c, byPower)
+	return approvals.GTE(requiredWeight), requiredWeight, byPower, nil
+}
 
-	// Calculate required threshold
-	params := k.GetParams(ctx)
-	requiredPower := math.LegacyNewDecFromInt(total).Mul(params.QuorumPct).TruncateInt()
+// verifiedQuorum returns the required weight and weighting a verified
+// contribution was checked against. Contributions verified before the
+// snapshot was recorded fall back to the current threshold.
+func (k Keeper) verifiedQuorum(ctx context.Context, c types.Contribution) (requiredWeight math.Int, byPower bool, err error) {
+	if requiredWeight, byPower, ok := c.QuorumSnapshot(); ok {
+		return requiredWeight, byPower, nil
+	}
+	requiredWeight, _, byPower, err = k.quorumThreshold(ctx)
+	return requiredWeight, byPower, err
+}
 
-	// Check if approval power meets or exceeds threshold
-	return approvalPower.GTE(requiredPower), nil
+// HasRejectionQuorum checks if enough weight has rejected a contribution that
+// approvals can no longer reach the required quorum
+func (k Keeper) HasRejectionQuorum(ctx context.Context, c types.Contribution) (bool, error) {
+	requiredWeight, total, byPower, err := k.quorumThreshold(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if total.IsZero() {
+		return false, nil
+	}
+
+	// The remaining weight that could still approve is below the threshold
+	_, rejections := endorsementWeights(c, byPower)
+	return rejections.GT(total.Sub(requiredWeight)), nil
 }
 
 // AddEndorsement adds an endorsement to a contribution and checks for quorum
@@ -41,6 +135,11 @@ func (k Keeper) AddEndorsement(ctx context.Context, contributionID uint64, endor
 		return false, types.ErrContributionNotFound
 	}
 
+	// A rejected contribution is final; further votes cannot revive it
+	if contribution.ReviewStatus == uint32(types.ReviewStatusRejected) {
+		return false, types.ErrContributionRejected
+	}
+
 	// SECURITY FIX: Convert endorsement address to canonical validator address
 	valAddr, err := sdk.ValAddressFromBech32(endorsement.ValAddr)
 	if err != nil {
@@ -73,6 +172,11 @@ func (k Keeper) AddEndorsement(ctx context.Context, contributionID uint64, endor
 		return false, types.ErrNotValidator
 	}
 
+	// Only bonded, unjailed validators count towards total voting power
+	if !validator.IsBonded() || validator.IsJailed() {
+		return false, fmt.Errorf("%w: validator %s is not bonded", types.ErrNotValidator, valAddr)
+	}
+
 	tokens := validator.GetTokens()
 	if tokens.IsZero() {
 		return false, types.ErrZeroPower
@@ -91,21 +195,50 @@ func (k Keeper) AddEndorsement(ctx context.Context, contributionID uint64, endor
 
 	// Check if quorum is reached (only for approvals)
 	if canonicalEndorsement.Decision && !contribution.Verified {
-		hasQuorum, err := k.HasQuorum(ctx, contribution)
+		hasQuorum, requiredWeight, byPower, err := k.checkQuorum(ctx, contribution)
 		if err != nil {
 			return false, err
 		}
 
 		if hasQuorum {
 			contribution.Verified = true
+			// Fraud proofs and invariants check against the quorum in force now,
+			// not whatever the params or validator set become later
+			contribution.SetQuorumSnapshot(byPower, requiredWeight)
 			// Enqueue reward for the contributor
 			if err := k.EnqueueReward(ctx, contribution); err != nil {
 				return false, err
 			}
+			// A verified contribution can no longer be rejected and refunded
+			if err := k.DeleteContributionFeeRecord(ctx, contributionID); err != nil {
+				return false, err
+			}
 			verified = true
 		}
 	}
 
+	// Check if rejections have made quorum unreachable (only for rejections)
+	if !canonicalEndorsement.Decision && !contribution.Verified {
+		rejected, err := k.HasRejectionQuorum(ctx, contribution)
+		if err != nil {
+			return false, err
+		}
+
+		if rejected {
+			contribution.ReviewStatus = uint32(types.ReviewStatusRejected)
+			// Best-effort partial fee refund; a failed payout never blocks the vote
+			if _, err := k.RefundRejectedContribution(ctx, contributionID); err != nil {
+				k.logger.Error("failed to refund rejected contribution",
+					"contribution_id", contributionID,
+					"error", err)
+			}
+			// Rejection is final, so the fee record is no longer needed
+			if err := k.DeleteContributionFeeRecord(ctx, contributionID); err != nil {
+				return false, err
+			}
+		}
+	}
+
 	// Save updated contribution
 	if err := k.SetContribution(ctx, contribution); err != nil {
 		return false, err
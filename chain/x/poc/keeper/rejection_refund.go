@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// ============================================================================
+// Rejected Contribution Fee Refunds
+//
+// The reward pool share of each submission fee is recorded when the
+// contribution is created. If endorsers later reject the contribution and no
+// fraud proof has been accepted against it, RefundRatio of that share is paid
+// back to the contributor from the PoC module account. The burned share is
+// never refunded, and each contribution is refunded at most once. The record
+// is pruned once endorsers verify or reject the contribution, since neither
+// outcome can change afterwards.
+// ============================================================================
+
+// GetContributionFeeRecord returns the fee record for a contribution
+func (k Keeper) GetContributionFeeRecord(ctx context.Context, contributionID uint64) (types.ContributionFeeRecord, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetContributionFeeKey(contributionID))
+	if err != nil || bz == nil {
+		return types.ContributionFeeRecord{}, false
+	}
+
+	var record types.ContributionFeeRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		k.logger.Error("failed to unmarshal contribution fee record", "id", contributionID, "error", err)
+		return types.ContributionFeeRecord{}, false
+	}
+	return record, true
+}
+
+// SetContributionFeeRecord stores a contribution fee record
+func (k Keeper) SetContributionFeeRecord(ctx context.Context, record types.ContributionFeeRecord) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal contribution fee record: %w", err)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetContributionFeeKey(record.ContributionID), bz)
+}
+
+// DeleteContributionFeeRecord removes a contribution fee record
+func (k Keeper) DeleteContributionFeeRecord(ctx context.Context, contributionID uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetContributionFeeKey(contributionID))
+}
+
+// GetAllContributionFeeRecords returns every contribution fee record (used for genesis export)
+func (k Keeper) GetAllContributionFeeRecords(ctx context.Context) []types.ContributionFeeRecord {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(
+		types.KeyPrefixContributionFee,
+		storetypes.PrefixEndBytes(types.KeyPrefixContributionFee),
+	)
+	if err != nil {
+		return nil
+	}
+	defer iterator.Close()
+
+	var records []types.ContributionFeeRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ContributionFeeRecord
+		if err := json.Unmarshal(iterator.Value(), &record); err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// RefundRejectedContribution pays the contributor RefundRatio of the pooled
+// fee share of a rejected contribution. Returns zero without refunding if the
+// contribution has a fraud proof, has no fee record, was already refunded or
+// refunds are disabled. The refund is capped at the module balance.
+func (k Keeper) RefundRejectedContribution(ctx context.Context, contributionID uint64) (math.Int, error) {
+	if _, fraud := k.GetFraudProof(ctx, contributionID); fraud {
+		return math.ZeroInt(), nil
+	}
+
+	record, found := k.GetContributionFeeRecord(ctx, contributionID)
+	if !found || record.IsRefunded() {
+		return math.ZeroInt(), nil
+	}
+
+	ratio := k.GetRejectionRefundParams(ctx).RefundRatio
+	refund := ratio.MulInt(record.PoolAmount.Amount).TruncateInt()
+	if !refund.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	contributor, err := sdk.AccAddressFromBech32(record.Contributor)
+	if err != nil {
+		return math.ZeroInt(), fmt.Errorf("invalid contributor address in fee record: %w", err)
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if balance := k.bankKeeper.GetBalance(ctx, moduleAddr, record.PoolAmount.Denom).Amount; refund.GT(balance) {
+		k.logger.Warn("module balance below rejection refund, paying available balance",
+			"refund", refund.String(), "balance", balance.String())
+		refund = balance
+	}
+	if !refund.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(record.PoolAmount.Denom, refund))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contributor, coins); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to pay rejection refund: %w", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record.Refunded = refund
+	record.RefundedAt = sdkCtx.BlockHeight()
+	if err := k.SetContributionFeeRecord(ctx, record); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		"poc_rejection_refund",
+		sdk.NewAttribute("contribution_id", fmt.Sprintf("%d", contributionID)),
+		sdk.NewAttribute("contributor", record.Contributor),
+		sdk.NewAttribute("refund", coins.String()),
+		sdk.NewAttribute("refund_ratio", ratio.String()),
+	))
+
+	return refund, nil
+}
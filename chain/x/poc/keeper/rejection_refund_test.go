@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+// setupRejectionRefund builds the endorsement fixture with a 30% refund ratio,
+// a 1000omniphi pooled fee on contribution 1 and a funded module account.
+func setupRejectionRefund(t *testing.T) (*KeeperTestFixture, []sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	f, validators, _ := setupEndorseTest(t)
+
+	require.NoError(t, f.keeper.SetRejectionRefundParams(f.ctx, types.RejectionRefundParams{
+		RefundRatio: math.LegacyNewDecWithPrec(30, 2),
+	}))
+
+	contributor := sdk.AccAddress("contributor_________")
+	require.NoError(t, f.keeper.SetContributionFeeRecord(f.ctx, types.ContributionFeeRecord{
+		ContributionID: 1,
+		Contributor:    contributor.String(),
+		PoolAmount:     sdk.NewInt64Coin("omniphi", 1000),
+		Refunded:       math.ZeroInt(),
+	}))
+	f.bankKeeper.setBalance(sdk.AccAddress("module_address______").String(), "omniphi", math.NewInt(10_000))
+
+	return f, validators, contributor
+}
+
+// rejectContribution votes contribution 1 down with 200 of 400 bonded tokens
+func rejectContribution(t *testing.T, f *KeeperTestFixture, ctx sdk.Context, validators []sdk.AccAddress) {
+	t.Helper()
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+	for _, val := range validators[:2] {
+		_, err := msgSrv.Endorse(ctx, endorseMsg(val, false))
+		require.NoError(t, err)
+	}
+	contribution, _ := f.keeper.GetContribution(ctx, 1)
+	require.Equal(t, uint32(types.ReviewStatusRejected), contribution.ReviewStatus)
+}
+
+func TestRejectionRefund_PaysPoolShare(t *testing.T) {
+	f, validators, contributor := setupRejectionRefund(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+
+	rejectContribution(t, f, ctx, validators)
+
+	// 30% of the 1000 pooled fee
+	require.Equal(t, "300", f.bankKeeper.GetBalance(ctx, contributor, "omniphi").Amount.String())
+	require.True(t, hasEventType(ctx, "poc_rejection_refund"))
+
+	// Rejection is final, so the fee record is pruned
+	_, found := f.keeper.GetContributionFeeRecord(ctx, 1)
+	require.False(t, found)
+
+	// A second refund attempt pays nothing
+	refund, err := f.keeper.RefundRejectedContribution(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, refund.IsZero())
+	require.Equal(t, "300", f.bankKeeper.GetBalance(ctx, contributor, "omniphi").Amount.String())
+}
+
+func TestRejectionRefund_NoRefundWithFraudProof(t *testing.T) {
+	f, validators, contributor := setupRejectionRefund(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+
+	require.NoError(t, f.keeper.SetFraudProof(ctx, types.FraudProof{
+		ContributionID: 1,
+		ProofType:      types.FraudProofHashMismatch,
+		Challenger:     fraudChallenger.String(),
+		Validated:      true,
+	}))
+
+	rejectContribution(t, f, ctx, validators)
+
+	require.True(t, f.bankKeeper.GetBalance(ctx, contributor, "omniphi").Amount.IsZero())
+	require.False(t, hasEventType(ctx, "poc_rejection_refund"))
+	_, found := f.keeper.GetContributionFeeRecord(ctx, 1)
+	require.False(t, found)
+}
+
+func TestRejectionRefund_RefundedOnce(t *testing.T) {
+	f, _, contributor := setupRejectionRefund(t)
+
+	refund, err := f.keeper.RefundRejectedContribution(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "300", refund.String())
+
+	record, found := f.keeper.GetContributionFeeRecord(f.ctx, 1)
+	require.True(t, found)
+	require.True(t, record.IsRefunded())
+
+	refund, err = f.keeper.RefundRejectedContribution(f.ctx, 1)
+	require.NoError(t, err)
+	require.True(t, refund.IsZero())
+	require.Equal(t, "300", f.bankKeeper.GetBalance(f.ctx, contributor, "omniphi").Amount.String())
+}
+
+func TestRejectionRefund_RecordPrunedOnVerification(t *testing.T) {
+	f, validators, contributor := setupRejectionRefund(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	// Two approvals: still pending, the record is kept
+	for _, val := range validators[:2] {
+		resp, err := msgSrv.Endorse(f.ctx, endorseMsg(val, true))
+		require.NoError(t, err)
+		require.False(t, resp.Verified)
+	}
+	_, found := f.keeper.GetContributionFeeRecord(f.ctx, 1)
+	require.True(t, found)
+
+	resp, err := msgSrv.Endorse(f.ctx, endorseMsg(validators[2], true))
+	require.NoError(t, err)
+	require.True(t, resp.Verified)
+
+	_, found = f.keeper.GetContributionFeeRecord(f.ctx, 1)
+	require.False(t, found)
+	require.Empty(t, f.keeper.GetAllContributionFeeRecords(f.ctx))
+
+	// A late rejection cannot refund a verified contribution
+	_, err = msgSrv.Endorse(f.ctx, endorseMsg(validators[3], false))
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, contributor, "omniphi").Amount.IsZero())
+}
+
+func TestUpdateRejectionRefundParams_Msg(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgSrv.UpdateRejectionRefundParams(ctx, &types.MsgUpdateRejectionRefundParams{
+		Authority:   testAddr1.String(),
+		RefundRatio: math.LegacyNewDecWithPrec(50, 2),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	for _, ratio := range []string{"1.1", "-0.1"} {
+		msg := &types.MsgUpdateRejectionRefundParams{
+			Authority:   f.keeper.GetAuthority(),
+			RefundRatio: math.LegacyMustNewDecFromStr(ratio),
+		}
+		require.Error(t, msg.ValidateBasic())
+		_, err := msgSrv.UpdateRejectionRefundParams(ctx, msg)
+		require.Error(t, err)
+	}
+	require.True(t, f.keeper.GetRejectionRefundParams(ctx).RefundRatio.IsZero())
+
+	_, err = msgSrv.UpdateRejectionRefundParams(ctx, &types.MsgUpdateRejectionRefundParams{
+		Authority:   f.keeper.GetAuthority(),
+		RefundRatio: math.LegacyNewDecWithPrec(50, 2),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0.500000000000000000", f.keeper.GetRejectionRefundParams(ctx).RefundRatio.String())
+	require.True(t, hasEventType(ctx, "poc_rejection_refund_params_updated"))
+}
+
+func TestRejectionRefund_DisabledByDefault(t *testing.T) {
+	f := SetupKeeperTest(t)
+	require.True(t, f.keeper.GetRejectionRefundParams(f.ctx).RefundRatio.IsZero())
+
+	require.Error(t, f.keeper.SetRejectionRefundParams(f.ctx, types.RejectionRefundParams{
+		RefundRatio: math.LegacyMustNewDecFromStr("1.1"),
+	}))
+	require.Error(t, f.keeper.SetRejectionRefundParams(f.ctx, types.RejectionRefundParams{
+		RefundRatio: math.LegacyMustNewDecFromStr("-0.1"),
+	}))
+}
@@ -13,11 +13,16 @@ import (
 // EnqueueReward calculates and assigns credits for a verified contribution,
 // and registers the contribution ID in the pending-reward index so EndBlocker
 // can distribute token rewards efficiently (O(pending) not O(all)).
+// Credits are minted at most once per contribution; repeat calls are no-ops.
 // SECURITY FIX: CVE-2025-POC-003 - Added overflow protection for credit calculations
 func (k Keeper) EnqueueReward(ctx context.Context, c types.Contribution) error {
+	if k.HasCreditsMinted(ctx, c.Id) {
+		return nil
+	}
+
 	params := k.GetParams(ctx)
 
-	// Calculate weight based on contribution type (simple version: all weight = 1)
+	// Calculate weight based on contribution type
 	weight := k.weightFor(ctx, c)
 
 	// Calculate credits with overflow check
@@ -43,7 +48,34 @@ func (k Keeper) EnqueueReward(ctx context.Context, c types.Contribution) error {
 	// SECURITY FIX: Use safe credit addition with overflow check
 	// Note: the pending-reward index is maintained automatically by SetContribution
 	// which is called by the quorum checker immediately after EnqueueReward.
-	return k.AddCreditsWithOverflowCheck(ctx, contributor, credits)
+	if err := k.AddCreditsWithOverflowCheck(ctx, contributor, credits); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCreditsMintedKey(c.Id), []byte{}); err != nil {
+		return fmt.Errorf("failed to mark credits minted: %w", err)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"credits_minted",
+			sdk.NewAttribute("contribution_id", fmt.Sprintf("%d", c.Id)),
+			sdk.NewAttribute("contributor", c.Contributor),
+			sdk.NewAttribute("ctype", c.Ctype),
+			sdk.NewAttribute("amount", credits.String()),
+		),
+	)
+
+	return nil
+}
+
+// HasCreditsMinted reports whether verification credits were already minted
+// for the contribution.
+func (k Keeper) HasCreditsMinted(ctx context.Context, contributionID uint64) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.GetCreditsMintedKey(contributionID))
+	return err == nil && has
 }
 
 // addPendingRewardIndex writes a tombstone entry to the pending-reward index.
@@ -78,7 +110,10 @@ func (k Keeper) weightFor(ctx context.Context, c types.Contribution) math.Int {
 // WithdrawCredits converts PoC credits to coins and sends them to the contributor
 // SECURITY FIX: CVE-2025-POC-005 - Prevents re-entrancy by zeroing credits BEFORE sending
 func (k Keeper) WithdrawCredits(ctx context.Context, addr sdk.AccAddress) (math.Int, error) {
-	// STEP 1: Get current credits
+	// STEP 1: Settle time-based decay, then get current credits
+	if _, err := k.ApplyTimeDecay(ctx, addr); err != nil {
+		return math.ZeroInt(), err
+	}
 	credits := k.GetCredits(ctx, addr)
 
 	if !credits.IsPositive() {
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/types"
+)
+
+func verifiedContribution(id uint64, contributor sdk.AccAddress, ctype string) types.Contribution {
+	return types.Contribution{
+		Id:          id,
+		Contributor: contributor.String(),
+		Ctype:       ctype,
+		Uri:         "ipfs://credits",
+		Hash:        []byte("creditshash1234567890123456789012"),
+		Verified:    true,
+	}
+}
+
+func TestEnqueueReward_PerTypeAmounts(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+
+	// BaseRewardUnit is 100 in the fixture; custom weights in basis points
+	require.NoError(t, f.keeper.SetCtypeWeights(ctx, map[string]uint32{
+		"code":   500,
+		"record": 100,
+		"green":  300,
+	}))
+
+	cases := []struct {
+		ctype    string
+		expected int64
+	}{
+		{"code", 500},
+		{"record", 100},
+		{"green", 300},
+		{"unlisted", 100}, // unknown types fall back to 1x
+	}
+
+	for i, tc := range cases {
+		contributor := sdk.AccAddress([]byte("credits_" + tc.ctype + "____________")[:20])
+		require.NoError(t, f.keeper.EnqueueReward(ctx, verifiedContribution(uint64(i+1), contributor, tc.ctype)))
+		require.Equal(t, math.NewInt(tc.expected).String(), f.keeper.GetCredits(ctx, contributor).Amount.String(), tc.ctype)
+		require.True(t, f.keeper.HasCreditsMinted(ctx, uint64(i+1)))
+	}
+
+	require.True(t, hasEventType(ctx, "credits_minted"))
+}
+
+func TestEnqueueReward_MintsOncePerContribution(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+
+	contributor := sdk.AccAddress("credits_once________")
+	contribution := verifiedContribution(1, contributor, "code")
+
+	require.False(t, f.keeper.HasCreditsMinted(ctx, 1))
+	require.NoError(t, f.keeper.EnqueueReward(ctx, contribution))
+	first := f.keeper.GetCredits(ctx, contributor).Amount
+	require.True(t, first.IsPositive())
+
+	// A repeat call is a no-op: no extra credits, no second event
+	require.NoError(t, f.keeper.EnqueueReward(ctx, contribution))
+	require.Equal(t, first.String(), f.keeper.GetCredits(ctx, contributor).Amount.String())
+
+	minted := 0
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == "credits_minted" {
+			minted++
+		}
+	}
+	require.Equal(t, 1, minted)
+
+	// Another contribution from the same contributor is still credited
+	require.NoError(t, f.keeper.EnqueueReward(ctx, verifiedContribution(2, contributor, "code")))
+	require.Equal(t, first.MulRaw(2).String(), f.keeper.GetCredits(ctx, contributor).Amount.String())
+}
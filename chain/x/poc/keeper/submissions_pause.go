@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/poc/types"
+)
+
+// ============================================================================
+// Emergency Submission Kill-Switch
+//
+// During an incident (e.g. a discovered endorsement exploit) governance can
+// halt all new contribution submissions. Only MsgSubmitContribution is
+// blocked: endorsements, reviews and fraud proofs keep working so the
+// existing queue can be cleared while the switch is on.
+// ============================================================================
+
+// IsSubmissionsPaused returns true if new contribution submissions are halted
+func (k Keeper) IsSubmissionsPaused(ctx context.Context) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeySubmissionsPaused)
+	if err != nil || bz == nil {
+		return false
+	}
+	return len(bz) > 0 && bz[0] == 1
+}
+
+// SetSubmissionsPaused sets the submission kill-switch and emits a
+// poc_submissions_paused or poc_submissions_resumed event.
+func (k Keeper) SetSubmissionsPaused(ctx context.Context, paused bool, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.storeService.OpenKVStore(ctx)
+
+	var val byte
+	eventType := "poc_submissions_resumed"
+	if paused {
+		val = 1
+		eventType = "poc_submissions_paused"
+	}
+
+	if err := store.Set(types.KeySubmissionsPaused, []byte{val}); err != nil {
+		return err
+	}
+
+	k.logger.Warn("PoC submission kill-switch changed",
+		"paused", paused,
+		"reason", reason,
+		"height", sdkCtx.BlockHeight(),
+	)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute("reason", reason),
+		sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	))
+
+	return nil
+}
+
+// SetSubmissionsPaused handles MsgSetSubmissionsPaused (governance only)
+func (ms msgServer) SetSubmissionsPaused(goCtx context.Context, msg *types.MsgSetSubmissionsPaused) (*types.MsgSetSubmissionsPausedResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := ms.Keeper.SetSubmissionsPaused(goCtx, msg.Paused, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSubmissionsPausedResponse{}, nil
+}
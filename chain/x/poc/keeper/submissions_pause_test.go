@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/poc/keeper"
+	"pos/x/poc/types"
+)
+
+func newPauseTestSubmission(contributor sdk.AccAddress, marker byte) *types.MsgSubmitContribution {
+	msg := &types.MsgSubmitContribution{
+		Contributor:          contributor.String(),
+		Ctype:                "code",
+		Uri:                  "ipfs://QmPauseTest",
+		Hash:                 make([]byte, 32),
+		CanonicalHash:        make([]byte, 32),
+		CanonicalSpecVersion: 1,
+	}
+	msg.Hash[0] = marker
+	msg.CanonicalHash[0] = marker
+	return msg
+}
+
+func hasEventType(ctx sdk.Context, eventType string) bool {
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSubmissionsPaused_BlocksAndResumes(t *testing.T) {
+	f := SetupKeeperTest(t)
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	contributor := sdk.AccAddress("contributor_________")
+	f.bankKeeper.setBalance(contributor.String(), "omniphi", math.NewInt(1000000))
+
+	// Default: not paused
+	require.False(t, f.keeper.IsSubmissionsPaused(ctx))
+	_, err := msgSrv.SubmitContribution(ctx, newPauseTestSubmission(contributor, 0x01))
+	require.NoError(t, err)
+
+	// Pause
+	require.NoError(t, f.keeper.SetSubmissionsPaused(ctx, true, "endorsement exploit under investigation"))
+	require.True(t, f.keeper.IsSubmissionsPaused(ctx))
+	require.True(t, hasEventType(ctx, "poc_submissions_paused"))
+
+	_, err = msgSrv.SubmitContribution(ctx, newPauseTestSubmission(contributor, 0x02))
+	require.ErrorIs(t, err, types.ErrSubmissionsPaused)
+
+	canSubmit, reason := f.keeper.CanSubmitContribution(ctx, contributor, "code")
+	require.False(t, canSubmit)
+	require.Contains(t, reason, "paused")
+
+	// Resume
+	require.NoError(t, f.keeper.SetSubmissionsPaused(ctx, false, "fixed"))
+	require.False(t, f.keeper.IsSubmissionsPaused(ctx))
+	require.True(t, hasEventType(ctx, "poc_submissions_resumed"))
+
+	_, err = msgSrv.SubmitContribution(ctx, newPauseTestSubmission(contributor, 0x02))
+	require.NoError(t, err)
+}
+
+func TestSubmissionsPaused_OtherMessagesStillWork(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	// Existing queue: one contribution awaiting endorsement, one fraudulent
+	require.NoError(t, f.keeper.SetContribution(f.ctx, types.Contribution{
+		Id:          1,
+		Contributor: testAddr1.String(),
+		Ctype:       "code",
+		Uri:         "ipfs://pending",
+		Hash:        make([]byte, 32),
+	}))
+	require.NoError(t, f.keeper.SetContribution(f.ctx, types.Contribution{
+		Id:          2,
+		Contributor: testAddr1.String(),
+		Ctype:       "code",
+		Uri:         "ipfs://fraud",
+		Hash:        []byte{}, // Empty hash — fraud
+		Verified:    true,
+	}))
+
+	require.NoError(t, f.keeper.SetSubmissionsPaused(f.ctx, true, "incident"))
+
+	// Endorsements proceed
+	validator := sdk.AccAddress("validator___________")
+	_, err := msgSrv.Endorse(f.ctx, &types.MsgEndorse{
+		Validator:      validator.String(),
+		ContributionId: 1,
+		Decision:       true,
+	})
+	require.NoError(t, err)
+
+	// Fraud proofs proceed
+	require.NoError(t, f.keeper.SubmitFraudProof(f.ctx, 2, types.FraudProofHashMismatch, testAddr1.String(), []byte("expected")))
+	_, found := f.keeper.GetFraudProof(f.ctx, 2)
+	require.True(t, found)
+}
+
+func TestSubmissionsPaused_GovernanceOnly(t *testing.T) {
+	f := SetupKeeperTest(t)
+	msgSrv := keeper.NewMsgServerImpl(f.keeper)
+
+	_, err := msgSrv.SetSubmissionsPaused(f.ctx, &types.MsgSetSubmissionsPaused{
+		Authority: testAddr1.String(),
+		Paused:    true,
+		Reason:    "not governance",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	require.False(t, f.keeper.IsSubmissionsPaused(f.ctx))
+
+	_, err = msgSrv.SetSubmissionsPaused(f.ctx, &types.MsgSetSubmissionsPaused{
+		Authority: f.keeper.GetAuthority(),
+		Paused:    true,
+		Reason:    "incident",
+	})
+	require.NoError(t, err)
+	require.True(t, f.keeper.IsSubmissionsPaused(f.ctx))
+}
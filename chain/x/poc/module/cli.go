@@ -176,7 +176,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryContribution(),
 		GetCmdQueryContributions(),
+		GetCmdQueryContributionsByStatus(),
 		GetCmdQueryCredits(),
+		GetCmdQueryEffectivePower(),
+		GetCmdQueryCanSubmit(),
+		GetCmdQueryAccessControl(),
+		GetCmdQueryFeeQuote(),
 	)
 
 	return cmd
@@ -287,6 +292,54 @@ func GetCmdQueryContributions() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryContributionsByStatus implements the query contributions-by-status command
+func GetCmdQueryContributionsByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contributions-by-status",
+		Short: "List contributions with their lifecycle status and endorsement tally",
+		Long: `List contributions ordered by ID with their status (pending, verified or rejected)
+and endorsement tally, optionally filtered by status and contributor.
+
+Example:
+$ posd query poc contributions-by-status --status pending --contributor omni1abc...xyz`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, _ := cmd.Flags().GetString("status")
+			contributor, _ := cmd.Flags().GetString("contributor")
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryContributionsByStatusRequest{
+				Status:      status,
+				Contributor: contributor,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.ContributionsByStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("status", "", "Filter by status (pending, verified or rejected)")
+	cmd.Flags().String("contributor", "", "Filter by contributor address")
+	flags.AddPaginationFlagsToCmd(cmd, "contributions-by-status")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCredits implements the query credits command
 func GetCmdQueryCredits() *cobra.Command {
 	cmd := &cobra.Command{
@@ -314,3 +367,134 @@ func GetCmdQueryCredits() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryEffectivePower implements the query effective-power command
+func GetCmdQueryEffectivePower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-power [address]",
+		Short: "Query an address's stake boosted by its C-Score",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryEffectivePowerRequest{Address: args[0]}
+
+			res, err := queryClient.EffectivePower(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCanSubmit implements the query can-submit command
+func GetCmdQueryCanSubmit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "can-submit [address] [ctype]",
+		Short: "Check whether an address may submit a contribution type before paying the fee",
+		Long: `Check the PoC access-control requirements for a contribution type: the emergency
+submission pause, exempt addresses, the minimum C-Score and the identity requirement.
+
+Example:
+$ posd query poc can-submit omni1abc...xyz code`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryCanSubmitRequest{Address: args[0], Ctype: args[1]}
+
+			res, err := queryClient.CanSubmit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccessControl implements the query access-control command
+func GetCmdQueryAccessControl() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-control",
+		Short: "Query the PoC access-control configuration",
+		Long: `Query whether C-Score and identity gating are enabled, the per-type C-Score and
+identity requirements, and the exempt address list. Requirements are only listed
+while the corresponding gating is enabled.
+
+Example:
+$ posd query poc access-control`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAccessControlConfigRequest{}
+
+			res, err := queryClient.AccessControlConfig(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFeeQuote implements the query fee-quote command
+func GetCmdQueryFeeQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-quote [address]",
+		Short: "Quote the submission fee an address would pay in the current block",
+		Long: `Quote the 3-layer submission fee: the base fee, the congestion multiplier for the
+current block, the C-Score discount and the final fee after the minimum fee floor.
+
+Example:
+$ posd query poc fee-quote omni1abc...xyz --ctype code`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctype, _ := cmd.Flags().GetString("ctype")
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryFeeQuoteRequest{Address: args[0], Ctype: ctype}
+
+			res, err := queryClient.FeeQuote(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("ctype", "", "Contribution type, for type-scoped C-Score discounts")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -20,11 +20,12 @@ import (
 )
 
 var (
-	_ module.AppModuleBasic   = AppModule{}
-	_ module.HasGenesis       = AppModule{}
-	_ module.HasInvariants    = AppModule{}
-	_ appmodule.AppModule     = AppModule{}
-	_ appmodule.HasEndBlocker = AppModule{}
+	_ module.AppModuleBasic     = AppModule{}
+	_ module.HasGenesis         = AppModule{}
+	_ module.HasInvariants      = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the poc module.
@@ -143,6 +144,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// BeginBlock resets the per-block submission counters before any submission
+// of the new block is processed.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.ResetBlockSubmissions(ctx)
+	return nil
+}
+
 // EndBlock returns the end blocker for the poc module. It returns no validator updates.
 func (am AppModule) EndBlock(ctx context.Context) error {
 	// 1. Finalize expired review sessions (Layer 3)
@@ -174,6 +182,10 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	// 5. Clear validator cache to prevent stale data
 	am.keeper.ClearValidatorCache()
 
+	// 6. Report this block's submission count and reset the counter that
+	// drives the 3-layer fee epoch multiplier
+	am.keeper.EndBlockSubmissions(ctx)
+
 	// Note: PruneRateLimits is intentionally omitted — rate-limit counters live in the
 	// transient store and are auto-cleared at the end of each block by the Cosmos SDK.
 	return nil
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// SubmitRequirements returns the minimum C-Score and identity requirement the
+// access-control params impose on ctype. Disabled gating imposes neither.
+func SubmitRequirements(params Params, ctype string) (requiredCscore math.Int, requiresIdentity bool) {
+	requiredCscore = math.ZeroInt()
+	if params.EnableCscoreGating {
+		if score, ok := params.MinCscoreForCtype[ctype]; ok && !score.IsNil() {
+			requiredCscore = score
+		}
+	}
+	if params.EnableIdentityGating {
+		requiresIdentity = params.RequireIdentityForCtype[ctype]
+	}
+	return requiredCscore, requiresIdentity
+}
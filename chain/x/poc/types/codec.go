@@ -15,6 +15,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEndorse{}, "pos/poc/Endorse")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawPOCRewards{}, "pos/poc/WithdrawPOCRewards")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "pos/poc/UpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSubmissionsPaused{}, "pos/poc/SetSubmissionsPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterContributionType{}, "pos/poc/RegisterContributionType")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchEndorse{}, "pos/poc/BatchEndorse")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdatePocFeeParams{}, "pos/poc/UpdatePocFeeParams")
+	legacy.RegisterAminoMsg(cdc, &MsgAddExemptAddress{}, "pos/poc/AddExemptAddress")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveExemptAddress{}, "pos/poc/RemoveExemptAddress")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitFraudProof{}, "pos/poc/SubmitFraudProof")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEndorsementParams{}, "pos/poc/UpdateEndorsementParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateRejectionRefundParams{}, "pos/poc/UpdateRejectionRefundParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateCScoreNamespaceParams{}, "pos/poc/UpdateCScoreNamespaceParams")
 }
 
 // RegisterInterfaces registers the x/poc interfaces types with the interface registry
@@ -30,6 +40,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgFinalizeReview{},
 		&MsgAppealReview{},
 		&MsgResolveAppeal{},
+		&MsgSetSubmissionsPaused{},
+		&MsgRegisterContributionType{},
+		&MsgBatchEndorse{},
+		&MsgUpdatePocFeeParams{},
+		&MsgAddExemptAddress{},
+		&MsgRemoveExemptAddress{},
+		&MsgSubmitFraudProof{},
+		&MsgUpdateEndorsementParams{},
+		&MsgUpdateRejectionRefundParams{},
+		&MsgUpdateCScoreNamespaceParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
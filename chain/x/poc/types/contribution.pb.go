@@ -128,6 +128,10 @@ type Contribution struct {
 	ParentClaimId uint64 `protobuf:"varint,16,opt,name=parent_claim_id,json=parentClaimId,proto3" json:"parent_claim_id,omitempty"`
 	// claim_status is the unified pipeline status across all 5 layers (see types.ClaimStatus)
 	ClaimStatus uint32 `protobuf:"varint,17,opt,name=claim_status,json=claimStatus,proto3" json:"claim_status,omitempty"`
+	// quorum_weighting is the endorsement weighting mode in force when the contribution was verified
+	QuorumWeighting string `protobuf:"bytes,18,opt,name=quorum_weighting,json=quorumWeighting,proto3" json:"quorum_weighting,omitempty"`
+	// quorum_required is the approving weight quorum required when the contribution was verified
+	QuorumRequired cosmossdk_io_math.Int `protobuf:"bytes,19,opt,name=quorum_required,json=quorumRequired,proto3,customtype=cosmossdk.io/math.Int" json:"quorum_required"`
 }
 
 func (m *Contribution) Reset()         { *m = Contribution{} }
@@ -282,6 +286,13 @@ func (m *Contribution) GetClaimStatus() uint32 {
 	return 0
 }
 
+func (m *Contribution) GetQuorumWeighting() string {
+	if m != nil {
+		return m.QuorumWeighting
+	}
+	return ""
+}
+
 // Credits represents accumulated PoC credits for an address
 type Credits struct {
 	// address is the contributor's address
@@ -448,6 +459,31 @@ func (m *Contribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	// Field 19: quorum_required (tag = 19<<3|2 = 0x9a 0x01); unset before verification
+	if !m.QuorumRequired.IsNil() {
+		{
+			size := m.QuorumRequired.Size()
+			i -= size
+			if _, err := m.QuorumRequired.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintContribution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	// Field 18: quorum_weighting (tag = 18<<3|2 = 0x92 0x01)
+	if len(m.QuorumWeighting) > 0 {
+		i -= len(m.QuorumWeighting)
+		copy(dAtA[i:], m.QuorumWeighting)
+		i = encodeVarintContribution(dAtA, i, uint64(len(m.QuorumWeighting)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	// Field 17: claim_status (tag = 17<<3|0 = 0x88 0x01)
 	if m.ClaimStatus != 0 {
 		i = encodeVarintContribution(dAtA, i, uint64(m.ClaimStatus))
@@ -717,6 +753,16 @@ func (m *Contribution) Size() (n int) {
 	if m.ClaimStatus != 0 {
 		n += 2 + sovContribution(uint64(m.ClaimStatus))
 	}
+	// Field 18: quorum_weighting (2-byte tag + length-delimited)
+	l = len(m.QuorumWeighting)
+	if l > 0 {
+		n += 2 + l + sovContribution(uint64(l))
+	}
+	// Field 19: quorum_required (2-byte tag + length-delimited)
+	if !m.QuorumRequired.IsNil() {
+		l = m.QuorumRequired.Size()
+		n += 2 + l + sovContribution(uint64(l))
+	}
 	return n
 }
 
@@ -1335,6 +1381,72 @@ func (m *Contribution) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumWeighting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumWeighting = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumRequired", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuorumRequired.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContribution(dAtA[iNdEx:])
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ContributionStatus is the lifecycle status of a contribution as reported by
+// the ContributionsByStatus query
+type ContributionStatus string
+
+const (
+	ContributionStatusPending  ContributionStatus = "pending"  // awaiting endorsement quorum
+	ContributionStatusVerified ContributionStatus = "verified" // approved by endorsement quorum
+	ContributionStatusRejected ContributionStatus = "rejected" // rejected by endorsements or review
+)
+
+// ContributionStatuses returns every lifecycle status
+func ContributionStatuses() []ContributionStatus {
+	return []ContributionStatus{ContributionStatusPending, ContributionStatusVerified, ContributionStatusRejected}
+}
+
+// ParseContributionStatus parses a status name
+func ParseContributionStatus(s string) (ContributionStatus, error) {
+	for _, status := range ContributionStatuses() {
+		if string(status) == s {
+			return status, nil
+		}
+	}
+	return "", fmt.Errorf("unknown contribution status %q (expected pending, verified or rejected)", s)
+}
+
+// indexByte is the status's byte in the status index key
+func (s ContributionStatus) indexByte() byte {
+	switch s {
+	case ContributionStatusVerified:
+		return 1
+	case ContributionStatusRejected:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// StatusOf returns the lifecycle status of a contribution. Rejection by
+// endorsements or by human review takes precedence over verification.
+func StatusOf(c Contribution) ContributionStatus {
+	switch {
+	case c.ReviewStatus == uint32(ReviewStatusRejected), c.ClaimStatus == uint32(ClaimStatusRejected):
+		return ContributionStatusRejected
+	case c.Verified:
+		return ContributionStatusVerified
+	default:
+		return ContributionStatusPending
+	}
+}
+
+// NewContributionSummary summarizes a contribution with its endorsement tally
+func NewContributionSummary(c Contribution) ContributionSummary {
+	summary := ContributionSummary{
+		Id:             c.Id,
+		Contributor:    c.Contributor,
+		Ctype:          c.Ctype,
+		Status:         StatusOf(c),
+		ApprovalPower:  c.GetApprovalPower(),
+		RejectionPower: c.GetRejectionPower(),
+		BlockHeight:    c.BlockHeight,
+	}
+	for _, e := range c.Endorsements {
+		if e.Decision {
+			summary.Approvals++
+		} else {
+			summary.Rejections++
+		}
+	}
+	return summary
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// ============================================================================
+// Per-Type C-Score Namespace (Layer 3 fee discount extension)
+// ============================================================================
+
+// CScoreNamespaceParams controls whether the C-Score fee discount is scoped to
+// the contribution type being submitted. Stored as a JSON sidecar to avoid proto
+// field descriptor regeneration.
+//
+// When EnableTypeScopedDiscount is false (default) the discount is derived from
+// the contributor's global C-Score, exactly as before.
+//
+// When enabled, the score used for the discount is:
+//
+//	effective = type_credits + (global_credits - type_credits) * CrossCategoryFactorBps / 10000
+//
+// so credits earned in the same category count in full, while credits earned in
+// other categories only count at the configured cross-category factor.
+type CScoreNamespaceParams struct {
+	// EnableTypeScopedDiscount turns on per-type C-Score namespacing for fee discounts.
+	EnableTypeScopedDiscount bool `json:"enable_type_scoped_discount"`
+
+	// CrossCategoryFactorBps is the weight (basis points) applied to credits earned
+	// in other categories. 0 = only same-category credits count, 10000 = global behavior.
+	CrossCategoryFactorBps uint32 `json:"cross_category_factor_bps"`
+}
+
+// DefaultCScoreNamespaceParams returns defaults that preserve the global single-score behavior.
+func DefaultCScoreNamespaceParams() CScoreNamespaceParams {
+	return CScoreNamespaceParams{
+		EnableTypeScopedDiscount: false,
+		CrossCategoryFactorBps:   2500, // 25% weight for off-category credits once enabled
+	}
+}
+
+// Validate checks the namespace parameters.
+func (p CScoreNamespaceParams) Validate() error {
+	if p.CrossCategoryFactorBps > 10000 {
+		return fmt.Errorf("cross_category_factor_bps must be in [0, 10000], got %d", p.CrossCategoryFactorBps)
+	}
+	return nil
+}
+
+// EffectiveTypeScopedScore blends same-category and cross-category credits.
+// typeCredits is clamped to globalCredits so stale or inconsistent per-type
+// tracking can never inflate the result above the global score.
+func (p CScoreNamespaceParams) EffectiveTypeScopedScore(globalCredits, typeCredits math.Int) math.Int {
+	if globalCredits.IsNegative() {
+		globalCredits = math.ZeroInt()
+	}
+	if typeCredits.IsNegative() {
+		typeCredits = math.ZeroInt()
+	}
+	if typeCredits.GT(globalCredits) {
+		typeCredits = globalCredits
+	}
+
+	otherCredits := globalCredits.Sub(typeCredits)
+	crossWeighted := otherCredits.MulRaw(int64(p.CrossCategoryFactorBps)).QuoRaw(10000)
+	return typeCredits.Add(crossWeighted)
+}
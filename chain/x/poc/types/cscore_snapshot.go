@@ -0,0 +1,26 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// CScoreSnapshot is an address's credit balance as of a block height. A new
+// snapshot is written only when the balance changes, so the value applies
+// until the next snapshot.
+type CScoreSnapshot struct {
+	Address string   `json:"address"`
+	Height  int64    `json:"height"`
+	Credits math.Int `json:"credits"`
+}
+
+// QueryCScoreAtRequest is the request type for the CScoreAt query
+type QueryCScoreAtRequest struct {
+	Address string `json:"address"`
+	Height  int64  `json:"height"`
+}
+
+// QueryCScoreAtResponse is the response type for the CScoreAt query. Snapshot
+// is the latest one at or before the requested height.
+type QueryCScoreAtResponse struct {
+	Snapshot CScoreSnapshot `json:"snapshot"`
+}
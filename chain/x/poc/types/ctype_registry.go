@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// ContributionType is a registry entry for a contribution type accepted by
+// MsgSubmitContribution. It is a view over state kept elsewhere: the reward
+// weight lives in the ctype weights map and the requirements in the
+// MinCscoreForCtype / RequireIdentityForCtype params, which are enforced only
+// while the corresponding gating flag is enabled.
+type ContributionType struct {
+	Ctype            string   `json:"ctype"`
+	RewardWeight     uint32   `json:"reward_weight"` // basis points, [1, 10000]
+	MinCscore        math.Int `json:"min_cscore"`
+	RequiresIdentity bool     `json:"requires_identity"`
+}
+
+// Validate checks the entry's name, weight and C-Score requirement
+func (ct ContributionType) Validate() error {
+	if ct.Ctype == "" {
+		return errorsmod.Wrap(ErrInvalidCType, "ctype cannot be empty")
+	}
+	if len(ct.Ctype) > MaxCTypeLength {
+		return errorsmod.Wrapf(ErrInvalidCType, "ctype too long: max length is %d", MaxCTypeLength)
+	}
+	if ct.RewardWeight == 0 || ct.RewardWeight > 10000 {
+		return errorsmod.Wrapf(ErrInvalidCType, "reward weight for %q must be in [1, 10000], got %d", ct.Ctype, ct.RewardWeight)
+	}
+	if ct.MinCscore.IsNil() || ct.MinCscore.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidCType, "min C-Score for %q must be non-negative", ct.Ctype)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// ============================================================================
+// Effective Voting Power - C-Score Bonus
+//
+// Power = Stake × (1 + min(α × Credits, MaxCreditBonus))
+// ============================================================================
+
+// EffectivePowerParams configure the C-Score bonus applied to staked power.
+// Stored as a JSON sidecar.
+type EffectivePowerParams struct {
+	// PocAlpha is the bonus granted per credit (0.0001 = +0.01% per credit)
+	PocAlpha math.LegacyDec `json:"poc_alpha"`
+	// MaxCreditBonus caps α × Credits so credits cannot grant unbounded power
+	// (1.0 = at most double the stake)
+	MaxCreditBonus math.LegacyDec `json:"max_credit_bonus"`
+}
+
+// DefaultEffectivePowerParams returns the default C-Score bonus parameters
+func DefaultEffectivePowerParams() EffectivePowerParams {
+	return EffectivePowerParams{
+		PocAlpha:       math.LegacyNewDecWithPrec(1, 4),
+		MaxCreditBonus: math.LegacyOneDec(),
+	}
+}
+
+// Validate checks that alpha and the bonus cap are non-negative
+func (p EffectivePowerParams) Validate() error {
+	if p.PocAlpha.IsNil() || p.PocAlpha.IsNegative() {
+		return fmt.Errorf("poc_alpha must be non-negative, got %s", p.PocAlpha)
+	}
+	if p.MaxCreditBonus.IsNil() || p.MaxCreditBonus.IsNegative() {
+		return fmt.Errorf("max_credit_bonus must be non-negative, got %s", p.MaxCreditBonus)
+	}
+	return nil
+}
+
+// CreditBonus returns α × credits, clamped to MaxCreditBonus
+func (p EffectivePowerParams) CreditBonus(credits math.Int) math.LegacyDec {
+	if !credits.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	bonus := p.PocAlpha.MulInt(credits)
+	if bonus.GT(p.MaxCreditBonus) {
+		return p.MaxCreditBonus
+	}
+	return bonus
+}
+
+// NewEffectivePower computes Stake × (1 + CreditBonus(credits))
+func NewEffectivePower(address string, stake, credits math.Int, params EffectivePowerParams) EffectivePower {
+	bonus := params.CreditBonus(credits)
+	return EffectivePower{
+		Address:        address,
+		Stake:          stake,
+		Credits:        credits,
+		PocAlpha:       params.PocAlpha,
+		MaxCreditBonus: params.MaxCreditBonus,
+		CreditBonus:    bonus,
+		EffectivePower: math.LegacyOneDec().Add(bonus).MulInt(stake).TruncateInt(),
+	}
+}
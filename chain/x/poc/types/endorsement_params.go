@@ -0,0 +1,65 @@
+package types
+
+import "fmt"
+
+// ============================================================================
+// Endorsement Thresholds
+// ============================================================================
+
+// DefaultMinEndorsements is the default number of distinct approving
+// validators a contribution needs before it can be verified
+const DefaultMinEndorsements uint32 = 3
+
+// MaxMinEndorsements bounds MinEndorsements so governance cannot set a count
+// no realistic validator set can reach
+const MaxMinEndorsements uint32 = 100
+
+// Endorsement weighting modes
+const (
+	// EndorsementWeightingPower weights each vote by the validator's bonded
+	// tokens; QuorumPct applies to the total bonded tokens
+	EndorsementWeightingPower = "power"
+	// EndorsementWeightingEqual counts each validator once; QuorumPct applies
+	// to the number of bonded validators
+	EndorsementWeightingEqual = "equal"
+)
+
+// EndorsementParams control when endorsements verify a contribution, on top of
+// the QuorumPct threshold. Stored as a JSON sidecar.
+type EndorsementParams struct {
+	// MinEndorsements is the number of distinct validators that must approve
+	// a contribution, however much bonded power they hold. Without it a single
+	// validator holding QuorumPct of the bonded power could verify alone.
+	MinEndorsements uint32 `json:"min_endorsements"`
+	// Weighting selects how votes are tallied against QuorumPct: "power"
+	// (the default; empty in sidecars stored before the field existed) or
+	// "equal" for one validator, one vote
+	Weighting string `json:"weighting,omitempty"`
+}
+
+// DefaultEndorsementParams returns the default endorsement thresholds
+func DefaultEndorsementParams() EndorsementParams {
+	return EndorsementParams{
+		MinEndorsements: DefaultMinEndorsements,
+		Weighting:       EndorsementWeightingPower,
+	}
+}
+
+// WeightByPower reports whether votes are weighted by bonded tokens
+func (p EndorsementParams) WeightByPower() bool {
+	return p.Weighting != EndorsementWeightingEqual
+}
+
+// Validate checks that MinEndorsements is in [1, MaxMinEndorsements] and the
+// weighting mode is known
+func (p EndorsementParams) Validate() error {
+	if p.MinEndorsements == 0 || p.MinEndorsements > MaxMinEndorsements {
+		return fmt.Errorf("min_endorsements must be in [1, %d], got %d", MaxMinEndorsements, p.MinEndorsements)
+	}
+	switch p.Weighting {
+	case "", EndorsementWeightingPower, EndorsementWeightingEqual:
+		return nil
+	default:
+		return fmt.Errorf("weighting must be %q or %q, got %q", EndorsementWeightingPower, EndorsementWeightingEqual, p.Weighting)
+	}
+}
@@ -170,4 +170,16 @@ var (
 	ErrProvenanceMaxDepthExceeded  = errorsmod.Register(ModuleName, 104, "maximum provenance depth exceeded")
 	ErrProvenanceNotFound          = errorsmod.Register(ModuleName, 105, "provenance entry not found")
 	ErrInvalidProvenanceQuery      = errorsmod.Register(ModuleName, 106, "invalid provenance query parameters")
+
+	// Emergency Controls Errors (codes 107-109)
+	ErrSubmissionsPaused = errorsmod.Register(ModuleName, 107, "contribution submissions are paused")
+	ErrInvalidAuthority  = errorsmod.Register(ModuleName, 108, "signer is not the governance authority")
+
+	// Endorsement Errors (codes 110-112)
+	ErrContributionRejected = errorsmod.Register(ModuleName, 110, "contribution was rejected by validator endorsements")
+	ErrNotFraudEndorser     = errorsmod.Register(ModuleName, 111, "validator did not approve this contribution")
+	ErrFraudAlreadySlashed  = errorsmod.Register(ModuleName, 112, "validator already slashed for this contribution")
+
+	// Access Control Errors (code 113)
+	ErrExemptAddressNotFound = errorsmod.Register(ModuleName, 113, "address is not in the exempt list")
 )
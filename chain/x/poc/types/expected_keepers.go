@@ -34,15 +34,12 @@ type AccountKeeper interface {
 }
 
 // IdentityKeeper defines the expected identity keeper methods (optional - used for PoA layer)
-// This interface will be implemented by x/identity module when available
+// Implemented by the identity module and injected via Keeper.SetIdentityKeeper.
 // If not available, identity checks will fail-safe and reject submissions requiring identity
 type IdentityKeeper interface {
-	// IsVerified checks if an address has completed identity verification (KYC/DID)
-	IsVerified(ctx context.Context, addr sdk.AccAddress) bool
-
-	// GetIdentityLevel returns the verification level of an address (0 = none, 1 = basic, 2 = enhanced, etc.)
-	// This can be used for tiered identity requirements in the future
-	GetIdentityLevel(ctx context.Context, addr sdk.AccAddress) uint32
+	// HasVerifiedIdentity reports whether an address has completed identity verification (KYC/DID).
+	// An error means the verification state could not be determined.
+	HasVerifiedIdentity(ctx context.Context, addr sdk.AccAddress) (bool, error)
 }
 
 // ============================================================================
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// FeeSplitParams control how a collected 3-layer submission fee is divided
+// between burning and the PoC reward pool. Stored as a JSON sidecar.
+type FeeSplitParams struct {
+	// FeeBurnRatio is the share of the fee burned (0.5 = 50%)
+	FeeBurnRatio math.LegacyDec `json:"fee_burn_ratio"`
+	// FeePoolRatio is the share kept in the module account as reward pool
+	FeePoolRatio math.LegacyDec `json:"fee_pool_ratio"`
+}
+
+// DefaultFeeSplitParams returns the default 50% burn / 50% pool split
+func DefaultFeeSplitParams() FeeSplitParams {
+	return FeeSplitParams{
+		FeeBurnRatio: math.LegacyNewDecWithPrec(50, 2),
+		FeePoolRatio: math.LegacyNewDecWithPrec(50, 2),
+	}
+}
+
+// Validate checks that both ratios are in [0, 1] and sum to exactly 1
+func (p FeeSplitParams) Validate() error {
+	if p.FeeBurnRatio.IsNil() || p.FeeBurnRatio.IsNegative() || p.FeeBurnRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee_burn_ratio must be in [0, 1], got %s", p.FeeBurnRatio)
+	}
+	if p.FeePoolRatio.IsNil() || p.FeePoolRatio.IsNegative() || p.FeePoolRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee_pool_ratio must be in [0, 1], got %s", p.FeePoolRatio)
+	}
+	if !p.FeeBurnRatio.Add(p.FeePoolRatio).Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("fee_burn_ratio + fee_pool_ratio must equal 1, got %s + %s", p.FeeBurnRatio, p.FeePoolRatio)
+	}
+	return nil
+}
+
+// EpochMultiplierParams bound the congestion multiplier applied to the base
+// submission fee. Stored as a JSON sidecar.
+type EpochMultiplierParams struct {
+	// MinEpochMultiplier is the floor used for quiet blocks (0.8 = 20% discount)
+	MinEpochMultiplier math.LegacyDec `json:"min_epoch_multiplier"`
+	// MaxEpochMultiplier is the cap used for congested blocks
+	MaxEpochMultiplier math.LegacyDec `json:"max_epoch_multiplier"`
+}
+
+// DefaultEpochMultiplierParams returns the default [0.8, 5.0] multiplier bounds
+func DefaultEpochMultiplierParams() EpochMultiplierParams {
+	return EpochMultiplierParams{
+		MinEpochMultiplier: math.LegacyNewDecWithPrec(8, 1),
+		MaxEpochMultiplier: math.LegacyNewDec(5),
+	}
+}
+
+// Validate enforces 0 < min <= 1 <= max <= 10
+func (p EpochMultiplierParams) Validate() error {
+	if p.MinEpochMultiplier.IsNil() || p.MaxEpochMultiplier.IsNil() {
+		return fmt.Errorf("epoch multiplier bounds cannot be nil")
+	}
+	if !p.MinEpochMultiplier.IsPositive() || p.MinEpochMultiplier.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min_epoch_multiplier must be in (0, 1], got %s", p.MinEpochMultiplier)
+	}
+	if p.MaxEpochMultiplier.LT(math.LegacyOneDec()) || p.MaxEpochMultiplier.GT(math.LegacyNewDec(10)) {
+		return fmt.Errorf("max_epoch_multiplier must be in [1, 10], got %s", p.MaxEpochMultiplier)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// ============================================================================
+// Fraud Endorsement Slashing
+// ============================================================================
+
+// DefaultFraudChallengerRewardRatio is the share of a slashed endorser's stake
+// paid to the challenger who proved the fraud (10%).
+func DefaultFraudChallengerRewardRatio() math.LegacyDec {
+	return math.LegacyNewDecWithPrec(10, 2)
+}
+
+// FraudSlashParams are the governance-configured penalties for validators who
+// approved a fraudulent contribution. Stored as a JSON sidecar.
+type FraudSlashParams struct {
+	// SlashFraction is the share of the endorser's stake slashed (0.01 = 1%)
+	SlashFraction math.LegacyDec `json:"slash_fraction"`
+	// ChallengerRewardRatio is the share of the slashed amount paid to the
+	// challenger from the PoC module account
+	ChallengerRewardRatio math.LegacyDec `json:"challenger_reward_ratio"`
+}
+
+// DefaultFraudSlashParams returns the default fraud slashing parameters
+func DefaultFraudSlashParams() FraudSlashParams {
+	return FraudSlashParams{
+		SlashFraction:         DefaultSlashFractionFraudEndorsement(),
+		ChallengerRewardRatio: DefaultFraudChallengerRewardRatio(),
+	}
+}
+
+// Validate checks that both fractions are in [0, 1]
+func (p FraudSlashParams) Validate() error {
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash_fraction must be in [0, 1], got %s", p.SlashFraction)
+	}
+	if p.ChallengerRewardRatio.IsNil() || p.ChallengerRewardRatio.IsNegative() || p.ChallengerRewardRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("challenger_reward_ratio must be in [0, 1], got %s", p.ChallengerRewardRatio)
+	}
+	return nil
+}
+
+// FraudSlashRecord records that a validator was slashed for approving a
+// fraudulent contribution. At most one exists per (contribution, validator).
+type FraudSlashRecord struct {
+	ContributionID   uint64         `json:"contribution_id"`
+	Validator        string         `json:"validator"` // bech32 validator operator address
+	SlashFraction    math.LegacyDec `json:"slash_fraction"`
+	SlashedAmount    math.Int       `json:"slashed_amount"`
+	Challenger       string         `json:"challenger,omitempty"` // set once a challenger is rewarded
+	ChallengerReward math.Int       `json:"challenger_reward"`
+	SlashedAt        int64          `json:"slashed_at"` // block height
+}
@@ -61,6 +61,59 @@ func (c *Contribution) GetApprovalPower() math.Int {
 	return totalApproval
 }
 
+// GetRejectionPower calculates the total voting power that rejected this contribution
+func (c *Contribution) GetRejectionPower() math.Int {
+	totalRejection := math.ZeroInt()
+	for _, endorsement := range c.Endorsements {
+		if !endorsement.Decision {
+			totalRejection = totalRejection.Add(endorsement.Power)
+		}
+	}
+	return totalRejection
+}
+
+// GetApprovalCount returns the number of validators that approved this contribution
+func (c *Contribution) GetApprovalCount() uint32 {
+	var count uint32
+	for _, endorsement := range c.Endorsements {
+		if endorsement.Decision {
+			count++
+		}
+	}
+	return count
+}
+
+// GetRejectionCount returns the number of validators that rejected this contribution
+func (c *Contribution) GetRejectionCount() uint32 {
+	var count uint32
+	for _, endorsement := range c.Endorsements {
+		if !endorsement.Decision {
+			count++
+		}
+	}
+	return count
+}
+
+// SetQuorumSnapshot records the weighting and required approving weight a
+// contribution was verified against
+func (c *Contribution) SetQuorumSnapshot(byPower bool, required math.Int) {
+	c.QuorumWeighting = EndorsementWeightingEqual
+	if byPower {
+		c.QuorumWeighting = EndorsementWeightingPower
+	}
+	c.QuorumRequired = required
+}
+
+// QuorumSnapshot returns the weighting and required approving weight recorded
+// when the contribution was verified. ok is false for contributions verified
+// before the snapshot was recorded.
+func (c *Contribution) QuorumSnapshot() (required math.Int, byPower bool, ok bool) {
+	if c.QuorumWeighting == "" || c.QuorumRequired.IsNil() {
+		return math.ZeroInt(), false, false
+	}
+	return c.QuorumRequired, c.QuorumWeighting != EndorsementWeightingEqual, true
+}
+
 // GetTotalPower calculates the total voting power that endorsed (approve or reject)
 func (c *Contribution) GetTotalPower() math.Int {
 	total := math.ZeroInt()
@@ -272,6 +272,76 @@ var (
 	// Written when a new usage edge is recorded; consumed by EndBlocker batch pass.
 	// Key: 0x38 | claim_id (big endian uint64)
 	KeyPrefixImpactUpdateQueue = []byte{0x38}
+
+	// KeyCScoreNamespaceParams stores the JSON-encoded CScoreNamespaceParams sidecar
+	// controlling per-type C-Score fee discounts. Singleton.
+	KeyCScoreNamespaceParams = []byte{0x39}
+
+	// KeySubmissionsPaused stores the emergency kill-switch for new contribution
+	// submissions (1 = paused). Singleton.
+	KeySubmissionsPaused = []byte{0x3A}
+
+	// KeyPrefixCreditsMinted marks contributions whose verification credits have
+	// been minted, so a contribution is credited at most once.
+	// Key: prefix | contributionID (8 bytes) → empty value.
+	KeyPrefixCreditsMinted = []byte{0x3B}
+
+	// KeyCreditDecayRate stores the governance-configured annual credit decay
+	// rate as a LegacyDec string (0.10 = 10% per year). Singleton sidecar.
+	KeyCreditDecayRate = []byte{0x3C}
+
+	// KeyPrefixCreditDecayTime stores the block time (unix seconds) at which
+	// time-based decay was last applied to an address's credits.
+	// Key: prefix | address → big-endian int64.
+	KeyPrefixCreditDecayTime = []byte{0x3D}
+
+	// KeyPrefixFraudSlashRecord stores the JSON FraudSlashRecord for a validator
+	// slashed over a fraudulent contribution, preventing double slashing.
+	// Key: prefix | contributionID (8 bytes) | validator address bytes.
+	KeyPrefixFraudSlashRecord = []byte{0x3E}
+
+	// KeyFraudSlashParams stores the JSON-encoded FraudSlashParams sidecar
+	// (slash fraction and challenger reward ratio). Singleton.
+	KeyFraudSlashParams = []byte{0x3F}
+
+	// KeyEffectivePowerParams stores the JSON-encoded EffectivePowerParams
+	// sidecar (PocAlpha and the credit bonus cap). Singleton. 0x40 is taken by
+	// ContributorStatsKeyPrefix (types/reward.go).
+	KeyEffectivePowerParams = []byte{0x41}
+
+	// KeyFeeSplitParams stores the JSON-encoded FeeSplitParams sidecar (burn
+	// and reward pool shares of the 3-layer submission fee). Singleton.
+	KeyFeeSplitParams = []byte{0x42}
+
+	// KeyPrefixCScoreSnapshot stores an address's credit balance each time it
+	// changes, for historical C-Score lookups.
+	// Key: prefix | len(address) | address | height (8 bytes) → math.Int bytes.
+	KeyPrefixCScoreSnapshot = []byte{0x43}
+
+	// KeyCScoreSnapshotWindow stores the snapshot retention window in blocks
+	// (8-byte big-endian). Singleton.
+	KeyCScoreSnapshotWindow = []byte{0x44}
+
+	// KeyEpochMultiplierParams stores the JSON-encoded EpochMultiplierParams
+	// sidecar (floor and cap of the 3-layer fee congestion multiplier). Singleton.
+	KeyEpochMultiplierParams = []byte{0x45}
+
+	// KeyRejectionRefundParams stores the JSON-encoded RejectionRefundParams
+	// sidecar (share of the pooled fee refunded on rejection). Singleton.
+	KeyRejectionRefundParams = []byte{0x46}
+
+	// KeyPrefixContributionFee stores the JSON-encoded ContributionFeeRecord
+	// for each contribution. Key: prefix | contributionID (8 bytes).
+	KeyPrefixContributionFee = []byte{0x47}
+
+	// KeyPrefixContributionStatusIndex indexes contributions by lifecycle
+	// status (pending, verified, rejected) for the ContributionsByStatus query.
+	// Key: prefix | status (1 byte) | contributionID (8 bytes) → empty value.
+	KeyPrefixContributionStatusIndex = []byte{0x48}
+
+	// KeyEndorsementParams stores the JSON-encoded EndorsementParams sidecar
+	// (minimum approvals and vote weighting). Singleton.
+	KeyEndorsementParams = []byte{0x49}
 )
 
 // GetContributionKey returns the store key for a contribution by ID
@@ -295,6 +365,23 @@ func GetContributorIndexKey(contributor string, id uint64) []byte {
 	return append(key, sdk.Uint64ToBigEndian(id)...)
 }
 
+// GetContributorIndexPrefix returns the contributor index prefix covering all
+// of a contributor's contributions
+func GetContributorIndexPrefix(contributor string) []byte {
+	return append(append([]byte{}, KeyPrefixContributorIndex...), []byte(contributor)...)
+}
+
+// GetContributionStatusIndexPrefix returns the status index prefix covering
+// all contributions with the given status
+func GetContributionStatusIndexPrefix(status ContributionStatus) []byte {
+	return append(append([]byte{}, KeyPrefixContributionStatusIndex...), status.indexByte())
+}
+
+// GetContributionStatusIndexKey returns the status index key for a contribution
+func GetContributionStatusIndexKey(status ContributionStatus, id uint64) []byte {
+	return append(GetContributionStatusIndexPrefix(status), sdk.Uint64ToBigEndian(id)...)
+}
+
 // GetContributorFeeStatsKey returns the store key for contributor fee statistics
 func GetContributorFeeStatsKey(addr sdk.AccAddress) []byte {
 	return append(KeyPrefixContributorFeeStats, addr.Bytes()...)
@@ -547,6 +634,39 @@ func GetPendingRewardIndexKey(contributionID uint64) []byte {
 	return append(KeyPrefixPendingRewardIndex, sdk.Uint64ToBigEndian(contributionID)...)
 }
 
+// GetCreditsMintedKey returns the store key marking a contribution as credited.
+func GetCreditsMintedKey(contributionID uint64) []byte {
+	return append(KeyPrefixCreditsMinted, sdk.Uint64ToBigEndian(contributionID)...)
+}
+
+// GetCreditDecayTimeKey returns the store key for an address's last decay time.
+func GetCreditDecayTimeKey(addr string) []byte {
+	return append(KeyPrefixCreditDecayTime, []byte(addr)...)
+}
+
+// GetCScoreSnapshotPrefix returns the key prefix for all C-Score snapshots of an address.
+// The address is length-prefixed so one address is never a prefix of another.
+func GetCScoreSnapshotPrefix(addr string) []byte {
+	key := append(KeyPrefixCScoreSnapshot, byte(len(addr)))
+	return append(key, []byte(addr)...)
+}
+
+// GetCScoreSnapshotKey returns the store key for an address's C-Score snapshot at a height.
+func GetCScoreSnapshotKey(addr string, height int64) []byte {
+	return append(GetCScoreSnapshotPrefix(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetContributionFeeKey returns the store key for a contribution's fee record.
+func GetContributionFeeKey(contributionID uint64) []byte {
+	return append(KeyPrefixContributionFee, sdk.Uint64ToBigEndian(contributionID)...)
+}
+
+// GetFraudSlashRecordKey returns the store key for a (contribution, validator) slash record.
+func GetFraudSlashRecordKey(contributionID uint64, valAddr sdk.ValAddress) []byte {
+	key := append(KeyPrefixFraudSlashRecord, sdk.Uint64ToBigEndian(contributionID)...)
+	return append(key, valAddr.Bytes()...)
+}
+
 // ============================================================================
 // Layer 5: Utility & Impact Scoring Key Functions
 // ============================================================================
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBatchEndorse{}
+
+// MaxBatchEndorseSize bounds the number of items in one MsgBatchEndorse
+const MaxBatchEndorseSize = 50
+
+// ========== MsgBatchEndorse ==========
+
+// GetSigners returns the expected signers for MsgBatchEndorse
+func (msg *MsgBatchEndorse) GetSigners() []sdk.AccAddress {
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{validator}
+}
+
+// ValidateBasic performs basic validation of MsgBatchEndorse. Individual items
+// are validated when they are applied, so one bad item does not reject the batch.
+func (msg *MsgBatchEndorse) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if len(msg.Items) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "batch cannot be empty")
+	}
+	if len(msg.Items) > MaxBatchEndorseSize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "batch has %d items, max %d", len(msg.Items), MaxBatchEndorseSize)
+	}
+	return nil
+}
+
+// EndorseMsg returns the single MsgEndorse equivalent to item i
+func (msg *MsgBatchEndorse) EndorseMsg(i int) *MsgEndorse {
+	return &MsgEndorse{
+		Validator:      msg.Validator,
+		ContributionId: msg.Items[i].ContributionId,
+		Decision:       msg.Items[i].Decision,
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateCScoreNamespaceParams{}
+
+// ========== MsgUpdateCScoreNamespaceParams ==========
+
+// GetSigners returns the expected signers for MsgUpdateCScoreNamespaceParams
+func (msg *MsgUpdateCScoreNamespaceParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgUpdateCScoreNamespaceParams
+func (msg *MsgUpdateCScoreNamespaceParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Params().Validate()
+}
+
+// Params returns the namespace params carried by the message
+func (msg *MsgUpdateCScoreNamespaceParams) Params() CScoreNamespaceParams {
+	return CScoreNamespaceParams{
+		EnableTypeScopedDiscount: msg.EnableTypeScopedDiscount,
+		CrossCategoryFactorBps:   msg.CrossCategoryFactorBps,
+	}
+}
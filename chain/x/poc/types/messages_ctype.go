@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterContributionType{}
+
+// ========== MsgRegisterContributionType ==========
+
+// GetSigners returns the expected signers for MsgRegisterContributionType
+func (msg *MsgRegisterContributionType) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgRegisterContributionType
+func (msg *MsgRegisterContributionType) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.ContributionType().Validate()
+}
+
+// ContributionType returns the registry entry carried by the message
+func (msg *MsgRegisterContributionType) ContributionType() ContributionType {
+	return ContributionType{
+		Ctype:            msg.Ctype,
+		RewardWeight:     msg.RewardWeight,
+		MinCscore:        msg.MinCscore,
+		RequiresIdentity: msg.RequiresIdentity,
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetSubmissionsPaused{}
+
+// MaxPauseReasonLength bounds the reason recorded with a pause/resume
+const MaxPauseReasonLength = 500
+
+// ========== MsgSetSubmissionsPaused ==========
+
+// GetSigners returns the expected signers for MsgSetSubmissionsPaused
+func (msg *MsgSetSubmissionsPaused) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgSetSubmissionsPaused
+func (msg *MsgSetSubmissionsPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if len(msg.Reason) > MaxPauseReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason exceeds %d characters", MaxPauseReasonLength)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateEndorsementParams{}
+
+// ========== MsgUpdateEndorsementParams ==========
+
+// GetSigners returns the expected signers for MsgUpdateEndorsementParams
+func (msg *MsgUpdateEndorsementParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgUpdateEndorsementParams
+func (msg *MsgUpdateEndorsementParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Params().Validate()
+}
+
+// Params returns the endorsement params carried by the message
+func (msg *MsgUpdateEndorsementParams) Params() EndorsementParams {
+	return EndorsementParams{
+		MinEndorsements: msg.MinEndorsements,
+		Weighting:       msg.Weighting,
+	}
+}
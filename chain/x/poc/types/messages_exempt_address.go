@@ -0,0 +1,87 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAddExemptAddress{}
+	_ sdk.Msg = &MsgRemoveExemptAddress{}
+)
+
+// ========== MsgAddExemptAddress ==========
+
+// GetSigners returns the expected signers for MsgAddExemptAddress
+func (msg *MsgAddExemptAddress) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgAddExemptAddress
+func (msg *MsgAddExemptAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Validate()
+}
+
+// Validate checks the address with the same rules as Params.Validate
+func (msg *MsgAddExemptAddress) Validate() error {
+	return validateExemptAddresses([]string{msg.Address})
+}
+
+// Apply returns params with the address appended to ExemptAddresses. The new
+// list is validated like Params.Validate, so an address that is already
+// exempt is rejected as a duplicate.
+func (msg *MsgAddExemptAddress) Apply(params Params) (Params, error) {
+	addresses := append(append([]string{}, params.ExemptAddresses...), msg.Address)
+	if err := validateExemptAddresses(addresses); err != nil {
+		return params, err
+	}
+	params.ExemptAddresses = addresses
+	return params, nil
+}
+
+// ========== MsgRemoveExemptAddress ==========
+
+// GetSigners returns the expected signers for MsgRemoveExemptAddress
+func (msg *MsgRemoveExemptAddress) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgRemoveExemptAddress
+func (msg *MsgRemoveExemptAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Validate()
+}
+
+// Validate checks the address with the same rules as Params.Validate
+func (msg *MsgRemoveExemptAddress) Validate() error {
+	return validateExemptAddresses([]string{msg.Address})
+}
+
+// Apply returns params with the address removed from ExemptAddresses
+func (msg *MsgRemoveExemptAddress) Apply(params Params) (Params, error) {
+	addresses := make([]string, 0, len(params.ExemptAddresses))
+	for _, addr := range params.ExemptAddresses {
+		if addr != msg.Address {
+			addresses = append(addresses, addr)
+		}
+	}
+	if len(addresses) == len(params.ExemptAddresses) {
+		return params, ErrExemptAddressNotFound.Wrap(msg.Address)
+	}
+	params.ExemptAddresses = addresses
+	return params, nil
+}
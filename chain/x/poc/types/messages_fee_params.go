@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdatePocFeeParams{}
+
+// ========== MsgUpdatePocFeeParams ==========
+
+// GetSigners returns the expected signers for MsgUpdatePocFeeParams
+func (msg *MsgUpdatePocFeeParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgUpdatePocFeeParams
+func (msg *MsgUpdatePocFeeParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Validate()
+}
+
+// Validate checks the fee params with the same rules as Params.Validate
+func (msg *MsgUpdatePocFeeParams) Validate() error {
+	return ValidateFeeParams(msg.BaseSubmissionFee, msg.TargetSubmissionsPerBlock, msg.MaxCscoreDiscount, msg.MinimumSubmissionFee)
+}
+
+// Apply returns params with the message's fee params set
+func (msg *MsgUpdatePocFeeParams) Apply(params Params) Params {
+	params.BaseSubmissionFee = msg.BaseSubmissionFee
+	params.TargetSubmissionsPerBlock = msg.TargetSubmissionsPerBlock
+	params.MaxCscoreDiscount = msg.MaxCscoreDiscount
+	params.MinimumSubmissionFee = msg.MinimumSubmissionFee
+	return params
+}
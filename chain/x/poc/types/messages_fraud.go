@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitFraudProof{}
+
+// MaxFraudProofDataLength bounds the proof payload carried by MsgSubmitFraudProof
+const MaxFraudProofDataLength = 4096
+
+// ========== MsgSubmitFraudProof ==========
+
+// GetSigners returns the expected signers for MsgSubmitFraudProof
+func (msg *MsgSubmitFraudProof) GetSigners() []sdk.AccAddress {
+	challenger, err := sdk.AccAddressFromBech32(msg.Challenger)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{challenger}
+}
+
+// ValidateBasic performs basic validation of MsgSubmitFraudProof
+func (msg *MsgSubmitFraudProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Challenger); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid challenger address (%s)", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if msg.ContributionId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contribution id cannot be zero")
+	}
+	if !FraudProofType(msg.ProofType).IsValid() {
+		return ErrInvalidFraudProofType.Wrapf("proof type %d is not supported", msg.ProofType)
+	}
+	if len(msg.ProofData) > MaxFraudProofDataLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proof data exceeds %d bytes", MaxFraudProofDataLength)
+	}
+	return nil
+}
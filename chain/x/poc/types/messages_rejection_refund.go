@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateRejectionRefundParams{}
+
+// ========== MsgUpdateRejectionRefundParams ==========
+
+// GetSigners returns the expected signers for MsgUpdateRejectionRefundParams
+func (msg *MsgUpdateRejectionRefundParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation of MsgUpdateRejectionRefundParams
+func (msg *MsgUpdateRejectionRefundParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return msg.Params().Validate()
+}
+
+// Params returns the refund params carried by the message
+func (msg *MsgUpdateRejectionRefundParams) Params() RejectionRefundParams {
+	return RejectionRefundParams{RefundRatio: msg.RefundRatio}
+}
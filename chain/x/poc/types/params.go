@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -235,6 +237,17 @@ func DefaultCtypeWeights() map[string]uint32 {
 	}
 }
 
+// DefaultCreditDecayRate is the default annual credit decay rate applied by
+// ApplyTimeDecay. Zero disables time-based decay until governance sets a rate.
+var DefaultCreditDecayRate = math.LegacyZeroDec()
+
+// SecondsPerYear is the year length used to prorate the annual credit decay rate.
+const SecondsPerYear int64 = 365 * 24 * 60 * 60
+
+// DefaultCScoreSnapshotWindow is how many blocks of C-Score history are kept
+// (~81 days at 7-second blocks).
+const DefaultCScoreSnapshotWindow int64 = 1_000_000
+
 // DefaultMaxVestingReleasesPerEpoch is the cap on how many vesting schedules
 // are processed per EndBlocker call (prevents burst stalls). Default: 200.
 const DefaultMaxVestingReleasesPerEpoch uint32 = 200
@@ -456,35 +469,16 @@ func (p Params) Validate() error {
 	if err := validateCScoreRequirements(p.MinCscoreForCtype); err != nil {
 		return err
 	}
+	if err := validateIdentityRequirements(p.RequireIdentityForCtype); err != nil {
+		return err
+	}
 	if err := validateExemptAddresses(p.ExemptAddresses); err != nil {
 		return err
 	}
 
 	// Validate 3-layer fee system parameters
-	if err := validateSubmissionFee(p.BaseSubmissionFee); err != nil {
-		return fmt.Errorf("invalid base_submission_fee: %w", err)
-	}
-	if p.TargetSubmissionsPerBlock == 0 {
-		return fmt.Errorf("target_submissions_per_block must be greater than 0")
-	}
-	if p.TargetSubmissionsPerBlock > 1000 {
-		return fmt.Errorf("target_submissions_per_block cannot exceed 1000 (got %d)", p.TargetSubmissionsPerBlock)
-	}
-	if err := validateBurnRatio(p.MaxCscoreDiscount); err != nil {
-		return fmt.Errorf("invalid max_cscore_discount: %w", err)
-	}
-	if err := validateSubmissionFee(p.MinimumSubmissionFee); err != nil {
-		return fmt.Errorf("invalid minimum_submission_fee: %w", err)
-	}
-	// Ensure minimum fee is less than base fee
-	if p.MinimumSubmissionFee.Amount.GT(p.BaseSubmissionFee.Amount) {
-		return fmt.Errorf("minimum_submission_fee (%s) cannot exceed base_submission_fee (%s)",
-			p.MinimumSubmissionFee, p.BaseSubmissionFee)
-	}
-	// Ensure same denom
-	if p.BaseSubmissionFee.Denom != p.MinimumSubmissionFee.Denom {
-		return fmt.Errorf("base_submission_fee and minimum_submission_fee must have same denom (got %s and %s)",
-			p.BaseSubmissionFee.Denom, p.MinimumSubmissionFee.Denom)
+	if err := ValidateFeeParams(p.BaseSubmissionFee, p.TargetSubmissionsPerBlock, p.MaxCscoreDiscount, p.MinimumSubmissionFee); err != nil {
+		return err
 	}
 
 	// Validate canonical hash layer parameters
@@ -703,6 +697,37 @@ func validateOriginalityBands(bands []OriginalityBand) error {
 	return nil
 }
 
+// ValidateFeeParams validates the 3-layer fee system parameters: the base
+// fee, the congestion target, the maximum C-Score discount and the fee floor
+func ValidateFeeParams(baseFee sdk.Coin, targetPerBlock uint32, maxDiscount math.LegacyDec, minimumFee sdk.Coin) error {
+	if err := validateSubmissionFee(baseFee); err != nil {
+		return fmt.Errorf("invalid base_submission_fee: %w", err)
+	}
+	if targetPerBlock == 0 {
+		return fmt.Errorf("target_submissions_per_block must be greater than 0")
+	}
+	if targetPerBlock > 1000 {
+		return fmt.Errorf("target_submissions_per_block cannot exceed 1000 (got %d)", targetPerBlock)
+	}
+	if err := validateBurnRatio(maxDiscount); err != nil {
+		return fmt.Errorf("invalid max_cscore_discount: %w", err)
+	}
+	if err := validateSubmissionFee(minimumFee); err != nil {
+		return fmt.Errorf("invalid minimum_submission_fee: %w", err)
+	}
+	// Ensure minimum fee is less than base fee
+	if minimumFee.Amount.GT(baseFee.Amount) {
+		return fmt.Errorf("minimum_submission_fee (%s) cannot exceed base_submission_fee (%s)",
+			minimumFee, baseFee)
+	}
+	// Ensure same denom
+	if baseFee.Denom != minimumFee.Denom {
+		return fmt.Errorf("base_submission_fee and minimum_submission_fee must have same denom (got %s and %s)",
+			baseFee.Denom, minimumFee.Denom)
+	}
+	return nil
+}
+
 // validateSubmissionFee validates a submission fee coin
 func validateSubmissionFee(fee sdk.Coin) error {
 	if !fee.IsValid() {
@@ -785,6 +810,47 @@ func validateCScoreRequirements(requirements map[string]math.Int) error {
 	return nil
 }
 
+// validateIdentityRequirements validates the identity requirement map
+func validateIdentityRequirements(requirements map[string]bool) error {
+	for ctype := range requirements {
+		if ctype == "" {
+			return fmt.Errorf("identity requirement has empty contribution type")
+		}
+	}
+	return nil
+}
+
+// ValidateCtypes checks that every contribution type referenced by the
+// access-control maps is known to the chain. Params.Validate is stateless, so
+// the caller supplies the registry; the first unknown type (in sorted order)
+// is reported by name.
+func (p Params) ValidateCtypes(isRegistered func(ctype string) bool) error {
+	fields := []struct {
+		name   string
+		ctypes []string
+	}{
+		{"min_cscore_for_ctype", sortedCtypeKeys(p.MinCscoreForCtype)},
+		{"require_identity_for_ctype", sortedCtypeKeys(p.RequireIdentityForCtype)},
+	}
+	for _, field := range fields {
+		for _, ctype := range field.ctypes {
+			if !isRegistered(ctype) {
+				return errorsmod.Wrapf(ErrInvalidCType, "%s references unregistered contribution type %q", field.name, ctype)
+			}
+		}
+	}
+	return nil
+}
+
+func sortedCtypeKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // validateExemptAddresses validates the exempt addresses list
 func validateExemptAddresses(addresses []string) error {
 	if addresses == nil {
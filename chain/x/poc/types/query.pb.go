@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -429,11 +432,41 @@ func (m *QueryCreditsResponse) GetTier() string {
 }
 
 // QueryFeeMetricsRequest is the request type for the Query/FeeMetrics RPC method.
-type QueryFeeMetricsRequest struct{}
+type QueryFeeMetricsRequest struct {
+}
 
 func (m *QueryFeeMetricsRequest) Reset()         { *m = QueryFeeMetricsRequest{} }
 func (m *QueryFeeMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeMetricsRequest) ProtoMessage()    {}
+func (*QueryFeeMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{8}
+}
+func (m *QueryFeeMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeMetricsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeMetricsRequest.Merge(m, src)
+}
+func (m *QueryFeeMetricsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeMetricsRequest proto.InternalMessageInfo
 
 // QueryFeeMetricsResponse is the response type for the Query/FeeMetrics RPC method.
 type QueryFeeMetricsResponse struct {
@@ -443,6 +476,35 @@ type QueryFeeMetricsResponse struct {
 func (m *QueryFeeMetricsResponse) Reset()         { *m = QueryFeeMetricsResponse{} }
 func (m *QueryFeeMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeeMetricsResponse) ProtoMessage()    {}
+func (*QueryFeeMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{9}
+}
+func (m *QueryFeeMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeMetricsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeMetricsResponse.Merge(m, src)
+}
+func (m *QueryFeeMetricsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeMetricsResponse proto.InternalMessageInfo
 
 func (m *QueryFeeMetricsResponse) GetMetrics() FeeMetrics {
 	if m != nil {
@@ -459,6 +521,35 @@ type QueryContributorFeeStatsRequest struct {
 func (m *QueryContributorFeeStatsRequest) Reset()         { *m = QueryContributorFeeStatsRequest{} }
 func (m *QueryContributorFeeStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContributorFeeStatsRequest) ProtoMessage()    {}
+func (*QueryContributorFeeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{10}
+}
+func (m *QueryContributorFeeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContributorFeeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContributorFeeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContributorFeeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContributorFeeStatsRequest.Merge(m, src)
+}
+func (m *QueryContributorFeeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContributorFeeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContributorFeeStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContributorFeeStatsRequest proto.InternalMessageInfo
 
 func (m *QueryContributorFeeStatsRequest) GetAddress() string {
 	if m != nil {
@@ -475,6 +566,35 @@ type QueryContributorFeeStatsResponse struct {
 func (m *QueryContributorFeeStatsResponse) Reset()         { *m = QueryContributorFeeStatsResponse{} }
 func (m *QueryContributorFeeStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContributorFeeStatsResponse) ProtoMessage()    {}
+func (*QueryContributorFeeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{11}
+}
+func (m *QueryContributorFeeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContributorFeeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContributorFeeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContributorFeeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContributorFeeStatsResponse.Merge(m, src)
+}
+func (m *QueryContributorFeeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContributorFeeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContributorFeeStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContributorFeeStatsResponse proto.InternalMessageInfo
 
 func (m *QueryContributorFeeStatsResponse) GetStats() ContributorFeeStats {
 	if m != nil {
@@ -483,884 +603,4986 @@ func (m *QueryContributorFeeStatsResponse) GetStats() ContributorFeeStats {
 	return ContributorFeeStats{}
 }
 
-// ============================================================================
-// Provenance Registry Query Types (Layer 5)
-// ============================================================================
-
-// QueryProvenanceEntryRequest is the request type for the Query/ProvenanceEntry RPC method.
-type QueryProvenanceEntryRequest struct {
-	ClaimId uint64 `protobuf:"varint,1,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
+// EffectivePower breaks down an address's stake boosted by its C-Score:
+// effective_power = stake × (1 + credit_bonus)
+type EffectivePower struct {
+	Address        string                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Stake          cosmossdk_io_math.Int       `protobuf:"bytes,2,opt,name=stake,proto3,customtype=cosmossdk.io/math.Int" json:"stake"`
+	Credits        cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=credits,proto3,customtype=cosmossdk.io/math.Int" json:"credits"`
+	PocAlpha       cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=poc_alpha,json=pocAlpha,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"poc_alpha"`
+	MaxCreditBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_credit_bonus,json=maxCreditBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_credit_bonus"`
+	// credit_bonus is poc_alpha × credits, clamped to max_credit_bonus
+	CreditBonus    cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=credit_bonus,json=creditBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"credit_bonus"`
+	EffectivePower cosmossdk_io_math.Int       `protobuf:"bytes,7,opt,name=effective_power,json=effectivePower,proto3,customtype=cosmossdk.io/math.Int" json:"effective_power"`
 }
 
-func (m *QueryProvenanceEntryRequest) Reset()         { *m = QueryProvenanceEntryRequest{} }
-func (m *QueryProvenanceEntryRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceEntryRequest) ProtoMessage()    {}
-
-// QueryProvenanceEntryResponse is the response type for the Query/ProvenanceEntry RPC method.
-type QueryProvenanceEntryResponse struct {
-	Entry ProvenanceEntry `json:"entry"`
+func (m *EffectivePower) Reset()         { *m = EffectivePower{} }
+func (m *EffectivePower) String() string { return proto.CompactTextString(m) }
+func (*EffectivePower) ProtoMessage()    {}
+func (*EffectivePower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{12}
 }
-
-func (m *QueryProvenanceEntryResponse) Reset()         { *m = QueryProvenanceEntryResponse{} }
-func (m *QueryProvenanceEntryResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceEntryResponse) ProtoMessage()    {}
-
-// QueryProvenanceChildrenRequest is the request type for the Query/ProvenanceChildren RPC method.
-type QueryProvenanceChildrenRequest struct {
-	ParentClaimId uint64 `protobuf:"varint,1,opt,name=parent_claim_id,json=parentClaimId,proto3" json:"parent_claim_id,omitempty"`
+func (m *EffectivePower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryProvenanceChildrenRequest) Reset()         { *m = QueryProvenanceChildrenRequest{} }
-func (m *QueryProvenanceChildrenRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceChildrenRequest) ProtoMessage()    {}
-
-// QueryProvenanceChildrenResponse is the response type for the Query/ProvenanceChildren RPC method.
-type QueryProvenanceChildrenResponse struct {
-	ChildClaimIds []uint64          `json:"child_claim_ids"`
-	Entries       []ProvenanceEntry `json:"entries"`
+func (m *EffectivePower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectivePower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (m *QueryProvenanceChildrenResponse) Reset()         { *m = QueryProvenanceChildrenResponse{} }
-func (m *QueryProvenanceChildrenResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceChildrenResponse) ProtoMessage()    {}
-
-// QueryProvenanceLineageRequest is the request type for the Query/ProvenanceLineage RPC method.
-type QueryProvenanceLineageRequest struct {
-	ClaimId uint64 `protobuf:"varint,1,opt,name=claim_id,json=claimId,proto3" json:"claim_id,omitempty"`
+func (m *EffectivePower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectivePower.Merge(m, src)
 }
-
-func (m *QueryProvenanceLineageRequest) Reset()         { *m = QueryProvenanceLineageRequest{} }
-func (m *QueryProvenanceLineageRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceLineageRequest) ProtoMessage()    {}
-
-// QueryProvenanceLineageResponse is the response type for the Query/ProvenanceLineage RPC method.
-type QueryProvenanceLineageResponse struct {
-	Path []ProvenanceEntry `json:"path"`
+func (m *EffectivePower) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryProvenanceLineageResponse) Reset()         { *m = QueryProvenanceLineageResponse{} }
-func (m *QueryProvenanceLineageResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceLineageResponse) ProtoMessage()    {}
-
-// QueryProvenanceByHashRequest is the request type for the Query/ProvenanceByHash RPC method.
-type QueryProvenanceByHashRequest struct {
-	CanonicalHash []byte `protobuf:"bytes,1,opt,name=canonical_hash,json=canonicalHash,proto3" json:"canonical_hash,omitempty"`
+func (m *EffectivePower) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectivePower.DiscardUnknown(m)
 }
 
-func (m *QueryProvenanceByHashRequest) Reset()         { *m = QueryProvenanceByHashRequest{} }
-func (m *QueryProvenanceByHashRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceByHashRequest) ProtoMessage()    {}
+var xxx_messageInfo_EffectivePower proto.InternalMessageInfo
 
-// QueryProvenanceByHashResponse is the response type for the Query/ProvenanceByHash RPC method.
-type QueryProvenanceByHashResponse struct {
-	Entries []ProvenanceEntry `json:"entries"`
+func (m *EffectivePower) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryProvenanceByHashResponse) Reset()         { *m = QueryProvenanceByHashResponse{} }
-func (m *QueryProvenanceByHashResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceByHashResponse) ProtoMessage()    {}
+// QueryEffectivePowerRequest is the request type for the Query/EffectivePower RPC method.
+type QueryEffectivePowerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
 
-// QueryProvenanceBySubmitterRequest is the request type for the Query/ProvenanceBySubmitter RPC method.
-type QueryProvenanceBySubmitterRequest struct {
-	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+func (m *QueryEffectivePowerRequest) Reset()         { *m = QueryEffectivePowerRequest{} }
+func (m *QueryEffectivePowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectivePowerRequest) ProtoMessage()    {}
+func (*QueryEffectivePowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{13}
+}
+func (m *QueryEffectivePowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectivePowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectivePowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectivePowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectivePowerRequest.Merge(m, src)
+}
+func (m *QueryEffectivePowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectivePowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectivePowerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProvenanceBySubmitterRequest) Reset()         { *m = QueryProvenanceBySubmitterRequest{} }
-func (m *QueryProvenanceBySubmitterRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceBySubmitterRequest) ProtoMessage()    {}
+var xxx_messageInfo_QueryEffectivePowerRequest proto.InternalMessageInfo
 
-// QueryProvenanceBySubmitterResponse is the response type for the Query/ProvenanceBySubmitter RPC method.
-type QueryProvenanceBySubmitterResponse struct {
-	Entries []ProvenanceEntry `json:"entries"`
+func (m *QueryEffectivePowerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryProvenanceBySubmitterResponse) Reset() {
-	*m = QueryProvenanceBySubmitterResponse{}
+// QueryEffectivePowerResponse is the response type for the Query/EffectivePower RPC method.
+type QueryEffectivePowerResponse struct {
+	EffectivePower EffectivePower `protobuf:"bytes,1,opt,name=effective_power,json=effectivePower,proto3" json:"effective_power"`
 }
-func (m *QueryProvenanceBySubmitterResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceBySubmitterResponse) ProtoMessage()    {}
-
-// QueryProvenanceStatsRequest is the request type for the Query/ProvenanceStats RPC method.
-type QueryProvenanceStatsRequest struct{}
-
-func (m *QueryProvenanceStatsRequest) Reset()         { *m = QueryProvenanceStatsRequest{} }
-func (m *QueryProvenanceStatsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceStatsRequest) ProtoMessage()    {}
 
-// QueryProvenanceStatsResponse is the response type for the Query/ProvenanceStats RPC method.
-type QueryProvenanceStatsResponse struct {
-	Stats ProvenanceStats `json:"stats"`
+func (m *QueryEffectivePowerResponse) Reset()         { *m = QueryEffectivePowerResponse{} }
+func (m *QueryEffectivePowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectivePowerResponse) ProtoMessage()    {}
+func (*QueryEffectivePowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{14}
+}
+func (m *QueryEffectivePowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectivePowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectivePowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectivePowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectivePowerResponse.Merge(m, src)
+}
+func (m *QueryEffectivePowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectivePowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectivePowerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProvenanceStatsResponse) Reset()         { *m = QueryProvenanceStatsResponse{} }
-func (m *QueryProvenanceStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProvenanceStatsResponse) ProtoMessage()    {}
+var xxx_messageInfo_QueryEffectivePowerResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pos.poc.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pos.poc.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryContributionRequest)(nil), "pos.poc.v1.QueryContributionRequest")
-	proto.RegisterType((*QueryContributionResponse)(nil), "pos.poc.v1.QueryContributionResponse")
-	proto.RegisterType((*QueryContributionsRequest)(nil), "pos.poc.v1.QueryContributionsRequest")
-	proto.RegisterType((*QueryContributionsResponse)(nil), "pos.poc.v1.QueryContributionsResponse")
-	proto.RegisterType((*QueryCreditsRequest)(nil), "pos.poc.v1.QueryCreditsRequest")
-	proto.RegisterType((*QueryCreditsResponse)(nil), "pos.poc.v1.QueryCreditsResponse")
+func (m *QueryEffectivePowerResponse) GetEffectivePower() EffectivePower {
+	if m != nil {
+		return m.EffectivePower
+	}
+	return EffectivePower{}
 }
 
-func init() { proto.RegisterFile("pos/poc/v1/query.proto", fileDescriptor_447ecebb6b2e58d5) }
-
-var fileDescriptor_447ecebb6b2e58d5 = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xd3, 0xb4, 0xa5, 0xd3, 0x16, 0xc4, 0x34, 0x02, 0xd7, 0x50, 0x37, 0x58, 0xb4, 0x94,
-	0x1e, 0xbc, 0x4a, 0x2b, 0x5e, 0x20, 0x45, 0x70, 0xe1, 0x50, 0x7c, 0xe4, 0x52, 0x39, 0xf6, 0x62,
-	0xad, 0x44, 0xbc, 0xae, 0xd7, 0x89, 0xa8, 0xaa, 0x1e, 0xe8, 0x13, 0x20, 0xf1, 0x12, 0x48, 0x5c,
-	0xb8, 0xf1, 0x0a, 0x3d, 0x56, 0xe2, 0xc2, 0x09, 0xa1, 0x04, 0x89, 0x0b, 0x0f, 0x81, 0xbc, 0xbb,
-	0x49, 0x6c, 0x25, 0x69, 0xb8, 0x58, 0xbb, 0x3b, 0xdf, 0xcc, 0x7c, 0xdf, 0xfc, 0x18, 0xee, 0x25,
-	0x5c, 0x90, 0x84, 0x07, 0xa4, 0xd7, 0x24, 0xa7, 0x5d, 0x9a, 0x9e, 0xb9, 0x49, 0xca, 0x33, 0x8e,
-	0x90, 0x70, 0xe1, 0x26, 0x3c, 0x70, 0x7b, 0x4d, 0xeb, 0xae, 0xdf, 0x61, 0x31, 0x27, 0xf2, 0xab,
-	0xcc, 0x56, 0x3d, 0xe2, 0x11, 0x97, 0x47, 0x92, 0x9f, 0xf4, 0xeb, 0xc3, 0x88, 0xf3, 0xe8, 0x1d,
-	0x25, 0x7e, 0xc2, 0x88, 0x1f, 0xc7, 0x3c, 0xf3, 0x33, 0xc6, 0x63, 0xa1, 0xad, 0xfb, 0x01, 0x17,
-	0x1d, 0x2e, 0x48, 0xdb, 0x17, 0x54, 0xe5, 0x22, 0xbd, 0x66, 0x9b, 0x66, 0x7e, 0x93, 0x24, 0x7e,
-	0xc4, 0x62, 0x09, 0xd6, 0xd8, 0xfb, 0x05, 0x5a, 0x89, 0x9f, 0xfa, 0x9d, 0x61, 0x90, 0xad, 0x82,
-	0x21, 0xe0, 0x71, 0x96, 0xb2, 0x76, 0x77, 0xec, 0xe7, 0xd4, 0x01, 0x5f, 0xe7, 0x91, 0x8f, 0xa5,
-	0x8f, 0x47, 0x4f, 0xbb, 0x54, 0x64, 0xce, 0x2b, 0xd8, 0x28, 0xbd, 0x8a, 0x84, 0xc7, 0x82, 0xe2,
-	0x33, 0x58, 0x52, 0xb1, 0x4d, 0xa3, 0x61, 0xec, 0xad, 0x1e, 0xa0, 0x3b, 0x16, 0xed, 0x2a, 0x6c,
-	0x6b, 0xe5, 0xea, 0xe7, 0x76, 0xe5, 0xf3, 0x9f, 0xaf, 0xfb, 0x86, 0xa7, 0xc1, 0xce, 0x3e, 0x98,
-	0x32, 0xda, 0x51, 0x21, 0xbd, 0xce, 0x84, 0xb7, 0xa1, 0xca, 0x42, 0x19, 0xae, 0xe6, 0x55, 0x59,
-	0xe8, 0x9c, 0xc0, 0xe6, 0x14, 0xac, 0xce, 0xdf, 0x82, 0xb5, 0xa2, 0x04, 0xcd, 0xc2, 0x2c, 0xb2,
-	0x28, 0xfa, 0xb5, 0x6a, 0x39, 0x17, 0xaf, 0xe4, 0xe3, 0x7c, 0x33, 0xa6, 0x64, 0x18, 0x0a, 0xc7,
-	0x06, 0xac, 0x8e, 0xd0, 0x3c, 0x95, 0x09, 0x56, 0xbc, 0xe2, 0x13, 0xd6, 0x61, 0x31, 0xc8, 0xce,
-	0x12, 0x6a, 0x56, 0xa5, 0x4d, 0x5d, 0xd0, 0x82, 0x5b, 0x3d, 0x9a, 0xb2, 0xb7, 0x8c, 0x86, 0xe6,
-	0x42, 0xc3, 0xd8, 0x5b, 0xf4, 0x46, 0x77, 0x7c, 0x01, 0x30, 0x6e, 0x97, 0x59, 0x93, 0x9c, 0x77,
-	0x5d, 0xd5, 0x5b, 0x37, 0xef, 0xad, 0xab, 0xe6, 0x48, 0xf7, 0xd6, 0x3d, 0xf6, 0x23, 0xaa, 0xf9,
-	0x78, 0x05, 0x4f, 0xe7, 0x8b, 0x01, 0xd6, 0x34, 0xe6, 0xba, 0x38, 0xcf, 0x61, 0xbd, 0x28, 0x34,
-	0xef, 0xd1, 0xc2, 0x7f, 0x54, 0xa7, 0xec, 0x84, 0x2f, 0x4b, 0x64, 0xab, 0x92, 0xec, 0x93, 0xb9,
-	0x64, 0x15, 0x85, 0x12, 0x5b, 0xa2, 0x47, 0xe8, 0x28, 0xa5, 0x21, 0xcb, 0x46, 0x05, 0x36, 0x61,
-	0xd9, 0x0f, 0xc3, 0x94, 0x0a, 0xa1, 0x8b, 0x3b, 0xbc, 0x3a, 0x27, 0x50, 0x2f, 0x3b, 0x68, 0x5d,
-	0x87, 0xb0, 0x1c, 0xa8, 0x27, 0xdd, 0xef, 0x8d, 0x92, 0x22, 0x65, 0xd2, 0x62, 0x86, 0x48, 0x44,
-	0xa8, 0x65, 0x8c, 0xa6, 0xba, 0x49, 0xf2, 0x7c, 0xf0, 0x77, 0x01, 0x16, 0x65, 0x06, 0xa4, 0xb0,
-	0xa4, 0xa6, 0x15, 0xed, 0x62, 0xac, 0xc9, 0x45, 0xb0, 0xb6, 0x67, 0xda, 0x15, 0x3b, 0xc7, 0xba,
-	0xfc, 0xfe, 0xfb, 0x53, 0xb5, 0x8e, 0x48, 0x26, 0x16, 0x10, 0x2f, 0x0d, 0x58, 0x2b, 0x56, 0x1c,
-	0x1f, 0x4f, 0x44, 0x9b, 0xb2, 0x12, 0xd6, 0xce, 0x1c, 0x94, 0xce, 0xbc, 0x23, 0x33, 0x6f, 0xe3,
-	0x16, 0x99, 0xb1, 0xe1, 0xe4, 0x9c, 0x85, 0x17, 0xf8, 0xc1, 0x80, 0xf5, 0xd2, 0xc0, 0xe0, 0xcd,
-	0xf1, 0x47, 0xd2, 0x77, 0xe7, 0xc1, 0x34, 0x8f, 0x47, 0x92, 0xc7, 0x03, 0xdc, 0x9c, 0xc5, 0x43,
-	0xa0, 0x80, 0x65, 0xdd, 0x27, 0x9c, 0x2c, 0x68, 0x79, 0x40, 0xac, 0xc6, 0x6c, 0xc0, 0x8d, 0xc2,
-	0x15, 0x88, 0x9c, 0xeb, 0x71, 0xba, 0x68, 0x3d, 0xbd, 0xea, 0xdb, 0xc6, 0x75, 0xdf, 0x36, 0x7e,
-	0xf5, 0x6d, 0xe3, 0xe3, 0xc0, 0xae, 0x5c, 0x0f, 0xec, 0xca, 0x8f, 0x81, 0x5d, 0x79, 0x73, 0x27,
-	0xf7, 0x7b, 0x2f, 0x3d, 0xf3, 0xe5, 0x15, 0xed, 0x25, 0xf9, 0x2f, 0x3c, 0xfc, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0x81, 0x1c, 0x63, 0x1d, 0xdc, 0x05, 0x00, 0x00,
+// QueryCanSubmitRequest is the request type for the Query/CanSubmit RPC method.
+type QueryCanSubmitRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Ctype   string `protobuf:"bytes,2,opt,name=ctype,proto3" json:"ctype,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryCanSubmitRequest) Reset()         { *m = QueryCanSubmitRequest{} }
+func (m *QueryCanSubmitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanSubmitRequest) ProtoMessage()    {}
+func (*QueryCanSubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{15}
+}
+func (m *QueryCanSubmitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanSubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanSubmitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanSubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanSubmitRequest.Merge(m, src)
+}
+func (m *QueryCanSubmitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanSubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanSubmitRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryCanSubmitRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Contribution queries a contribution by ID
-	Contribution(ctx context.Context, in *QueryContributionRequest, opts ...grpc.CallOption) (*QueryContributionResponse, error)
-	// Contributions queries all contributions with optional filtering
-	Contributions(ctx context.Context, in *QueryContributionsRequest, opts ...grpc.CallOption) (*QueryContributionsResponse, error)
-	// Credits queries the credit balance for an address
-	Credits(ctx context.Context, in *QueryCreditsRequest, opts ...grpc.CallOption) (*QueryCreditsResponse, error)
-	// FeeMetrics queries cumulative fee burn statistics
-	FeeMetrics(ctx context.Context, in *QueryFeeMetricsRequest, opts ...grpc.CallOption) (*QueryFeeMetricsResponse, error)
-	// ContributorFeeStats queries fee statistics for a specific contributor
-	ContributorFeeStats(ctx context.Context, in *QueryContributorFeeStatsRequest, opts ...grpc.CallOption) (*QueryContributorFeeStatsResponse, error)
+func (m *QueryCanSubmitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryCanSubmitRequest) GetCtype() string {
+	if m != nil {
+		return m.Ctype
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryCanSubmitResponse is the response type for the Query/CanSubmit RPC method.
+type QueryCanSubmitResponse struct {
+	CanSubmit bool   `protobuf:"varint,1,opt,name=can_submit,json=canSubmit,proto3" json:"can_submit,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// required_cscore is the minimum C-Score for the type (zero if unrestricted)
+	RequiredCscore   cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=required_cscore,json=requiredCscore,proto3,customtype=cosmossdk.io/math.Int" json:"required_cscore"`
+	RequiresIdentity bool                  `protobuf:"varint,4,opt,name=requires_identity,json=requiresIdentity,proto3" json:"requires_identity,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCanSubmitResponse) Reset()         { *m = QueryCanSubmitResponse{} }
+func (m *QueryCanSubmitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanSubmitResponse) ProtoMessage()    {}
+func (*QueryCanSubmitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{16}
+}
+func (m *QueryCanSubmitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanSubmitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanSubmitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCanSubmitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanSubmitResponse.Merge(m, src)
+}
+func (m *QueryCanSubmitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanSubmitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanSubmitResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Contribution(ctx context.Context, in *QueryContributionRequest, opts ...grpc.CallOption) (*QueryContributionResponse, error) {
-	out := new(QueryContributionResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Contribution", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCanSubmitResponse proto.InternalMessageInfo
+
+func (m *QueryCanSubmitResponse) GetCanSubmit() bool {
+	if m != nil {
+		return m.CanSubmit
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) Contributions(ctx context.Context, in *QueryContributionsRequest, opts ...grpc.CallOption) (*QueryContributionsResponse, error) {
-	out := new(QueryContributionsResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Contributions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCanSubmitResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Credits(ctx context.Context, in *QueryCreditsRequest, opts ...grpc.CallOption) (*QueryCreditsResponse, error) {
-	out := new(QueryCreditsResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Credits", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCanSubmitResponse) GetRequiresIdentity() bool {
+	if m != nil {
+		return m.RequiresIdentity
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) FeeMetrics(ctx context.Context, in *QueryFeeMetricsRequest, opts ...grpc.CallOption) (*QueryFeeMetricsResponse, error) {
-	out := new(QueryFeeMetricsResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/FeeMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAccessControlConfigRequest is the request type for the Query/AccessControlConfig RPC method.
+type QueryAccessControlConfigRequest struct {
 }
 
-func (c *queryClient) ContributorFeeStats(ctx context.Context, in *QueryContributorFeeStatsRequest, opts ...grpc.CallOption) (*QueryContributorFeeStatsResponse, error) {
-	out := new(QueryContributorFeeStatsResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/ContributorFeeStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Contribution queries a contribution by ID
-	Contribution(context.Context, *QueryContributionRequest) (*QueryContributionResponse, error)
-	// Contributions queries all contributions with optional filtering
-	Contributions(context.Context, *QueryContributionsRequest) (*QueryContributionsResponse, error)
-	// Credits queries the credit balance for an address
-	Credits(context.Context, *QueryCreditsRequest) (*QueryCreditsResponse, error)
-	// FeeMetrics queries cumulative fee burn statistics
-	FeeMetrics(context.Context, *QueryFeeMetricsRequest) (*QueryFeeMetricsResponse, error)
-	// ContributorFeeStats queries fee statistics for a specific contributor
-	ContributorFeeStats(context.Context, *QueryContributorFeeStatsRequest) (*QueryContributorFeeStatsResponse, error)
-	// ProvenanceEntry queries a single provenance entry by claim ID
-	ProvenanceEntry(context.Context, *QueryProvenanceEntryRequest) (*QueryProvenanceEntryResponse, error)
-	// ProvenanceChildren queries all child claims of a parent
-	ProvenanceChildren(context.Context, *QueryProvenanceChildrenRequest) (*QueryProvenanceChildrenResponse, error)
-	// ProvenanceLineage computes the full lineage path from root to a claim
-	ProvenanceLineage(context.Context, *QueryProvenanceLineageRequest) (*QueryProvenanceLineageResponse, error)
-	// ProvenanceByHash queries claims by canonical hash
-	ProvenanceByHash(context.Context, *QueryProvenanceByHashRequest) (*QueryProvenanceByHashResponse, error)
-	// ProvenanceBySubmitter queries claims by submitter address
-	ProvenanceBySubmitter(context.Context, *QueryProvenanceBySubmitterRequest) (*QueryProvenanceBySubmitterResponse, error)
-	// ProvenanceStats queries aggregate provenance registry statistics
-	ProvenanceStats(context.Context, *QueryProvenanceStatsRequest) (*QueryProvenanceStatsResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAccessControlConfigRequest) Reset()         { *m = QueryAccessControlConfigRequest{} }
+func (m *QueryAccessControlConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlConfigRequest) ProtoMessage()    {}
+func (*QueryAccessControlConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{17}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAccessControlConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Contribution(ctx context.Context, req *QueryContributionRequest) (*QueryContributionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Contribution not implemented")
+func (m *QueryAccessControlConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Contributions(ctx context.Context, req *QueryContributionsRequest) (*QueryContributionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Contributions not implemented")
+func (m *QueryAccessControlConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlConfigRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Credits(ctx context.Context, req *QueryCreditsRequest) (*QueryCreditsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Credits not implemented")
+func (m *QueryAccessControlConfigRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) FeeMetrics(ctx context.Context, req *QueryFeeMetricsRequest) (*QueryFeeMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeMetrics not implemented")
+func (m *QueryAccessControlConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlConfigRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ContributorFeeStats(ctx context.Context, req *QueryContributorFeeStatsRequest) (*QueryContributorFeeStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContributorFeeStats not implemented")
+
+var xxx_messageInfo_QueryAccessControlConfigRequest proto.InternalMessageInfo
+
+// QueryAccessControlConfigResponse is the response type for the
+// Query/AccessControlConfig RPC method. The requirement maps are empty while
+// the corresponding gating is disabled.
+type QueryAccessControlConfigResponse struct {
+	EnableCscoreGating   bool `protobuf:"varint,1,opt,name=enable_cscore_gating,json=enableCscoreGating,proto3" json:"enable_cscore_gating,omitempty"`
+	EnableIdentityGating bool `protobuf:"varint,2,opt,name=enable_identity_gating,json=enableIdentityGating,proto3" json:"enable_identity_gating,omitempty"`
+	// cscore_requirements maps ctype to its minimum C-Score (a decimal integer)
+	CscoreRequirements map[string]string `protobuf:"bytes,3,rep,name=cscore_requirements,json=cscoreRequirements,proto3" json:"cscore_requirements,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// identity_requirements maps ctype to whether it requires a verified identity
+	IdentityRequirements map[string]bool `protobuf:"bytes,4,rep,name=identity_requirements,json=identityRequirements,proto3" json:"identity_requirements,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ExemptAddresses      []string        `protobuf:"bytes,5,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
 }
-func (*UnimplementedQueryServer) ProvenanceEntry(ctx context.Context, req *QueryProvenanceEntryRequest) (*QueryProvenanceEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProvenanceEntry not implemented")
+
+func (m *QueryAccessControlConfigResponse) Reset()         { *m = QueryAccessControlConfigResponse{} }
+func (m *QueryAccessControlConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccessControlConfigResponse) ProtoMessage()    {}
+func (*QueryAccessControlConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{18}
 }
-func (*UnimplementedQueryServer) ProvenanceChildren(ctx context.Context, req *QueryProvenanceChildrenRequest) (*QueryProvenanceChildrenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProvenanceChildren not implemented")
+func (m *QueryAccessControlConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ProvenanceLineage(ctx context.Context, req *QueryProvenanceLineageRequest) (*QueryProvenanceLineageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProvenanceLineage not implemented")
+func (m *QueryAccessControlConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccessControlConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ProvenanceByHash(ctx context.Context, req *QueryProvenanceByHashRequest) (*QueryProvenanceByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProvenanceByHash not implemented")
+func (m *QueryAccessControlConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccessControlConfigResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ProvenanceBySubmitter(ctx context.Context, req *QueryProvenanceBySubmitterRequest) (*QueryProvenanceBySubmitterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProvenanceBySubmitter not implemented")
+func (m *QueryAccessControlConfigResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ProvenanceStats(ctx context.Context, req *QueryProvenanceStatsRequest) (*QueryProvenanceStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProvenanceStats not implemented")
+func (m *QueryAccessControlConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccessControlConfigResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAccessControlConfigResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAccessControlConfigResponse) GetEnableCscoreGating() bool {
+	if m != nil {
+		return m.EnableCscoreGating
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Contribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContributionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Contribution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Query/Contribution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Contribution(ctx, req.(*QueryContributionRequest))
+func (m *QueryAccessControlConfigResponse) GetEnableIdentityGating() bool {
+	if m != nil {
+		return m.EnableIdentityGating
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Contributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContributionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Contributions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Query/Contributions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Contributions(ctx, req.(*QueryContributionsRequest))
+func (m *QueryAccessControlConfigResponse) GetCscoreRequirements() map[string]string {
+	if m != nil {
+		return m.CscoreRequirements
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Credits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCreditsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Credits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Query/Credits",
+func (m *QueryAccessControlConfigResponse) GetIdentityRequirements() map[string]bool {
+	if m != nil {
+		return m.IdentityRequirements
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Credits(ctx, req.(*QueryCreditsRequest))
+	return nil
+}
+
+func (m *QueryAccessControlConfigResponse) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pos.poc.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Contribution",
-			Handler:    _Query_Contribution_Handler,
-		},
-		{
-			MethodName: "Contributions",
-			Handler:    _Query_Contributions_Handler,
-		},
-		{
-			MethodName: "Credits",
-			Handler:    _Query_Credits_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pos/poc/v1/query.proto",
+// QueryFeeQuoteRequest is the request type for the Query/FeeQuote RPC method.
+type QueryFeeQuoteRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// ctype is optional; when set, a type-scoped C-Score discount applies if enabled
+	Ctype string `protobuf:"bytes,2,opt,name=ctype,proto3" json:"ctype,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeQuoteRequest) Reset()         { *m = QueryFeeQuoteRequest{} }
+func (m *QueryFeeQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeQuoteRequest) ProtoMessage()    {}
+func (*QueryFeeQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{19}
+}
+func (m *QueryFeeQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeQuoteRequest.Merge(m, src)
+}
+func (m *QueryFeeQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeQuoteRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryFeeQuoteRequest proto.InternalMessageInfo
+
+func (m *QueryFeeQuoteRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeQuoteRequest) GetCtype() string {
+	if m != nil {
+		return m.Ctype
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeQuoteResponse is the response type for the Query/FeeQuote RPC
+// method: the 3-layer fee a submission from the address would pay in the
+// current block.
+type QueryFeeQuoteResponse struct {
+	BaseFee types.Coin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+	// epoch_multiplier is the congestion multiplier for the current block
+	EpochMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=epoch_multiplier,json=epochMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_multiplier"`
+	// cscore_discount is the fraction of the fee waived, in [0, max_cscore_discount]
+	CscoreDiscount cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=cscore_discount,json=cscoreDiscount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cscore_discount"`
+	// final_fee is the fee after the discount and the minimum fee floor
+	FinalFee types.Coin `protobuf:"bytes,4,opt,name=final_fee,json=finalFee,proto3" json:"final_fee"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeeQuoteResponse) Reset()         { *m = QueryFeeQuoteResponse{} }
+func (m *QueryFeeQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeQuoteResponse) ProtoMessage()    {}
+func (*QueryFeeQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{20}
+}
+func (m *QueryFeeQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeQuoteResponse.Merge(m, src)
+}
+func (m *QueryFeeQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeQuoteResponse.DiscardUnknown(m)
 }
 
-func (m *QueryContributionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeQuoteResponse proto.InternalMessageInfo
+
+func (m *QueryFeeQuoteResponse) GetBaseFee() types.Coin {
+	if m != nil {
+		return m.BaseFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryContributionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeQuoteResponse) GetFinalFee() types.Coin {
+	if m != nil {
+		return m.FinalFee
+	}
+	return types.Coin{}
+}
+
+// ContributionSummary is one contribution as listed by the
+// Query/ContributionsByStatus RPC method
+type ContributionSummary struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Contributor string `protobuf:"bytes,2,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	Ctype       string `protobuf:"bytes,3,opt,name=ctype,proto3" json:"ctype,omitempty"`
+	// status is pending, verified or rejected
+	Status         ContributionStatus    `protobuf:"bytes,4,opt,name=status,proto3,casttype=ContributionStatus" json:"status,omitempty"`
+	Approvals      uint32                `protobuf:"varint,5,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	Rejections     uint32                `protobuf:"varint,6,opt,name=rejections,proto3" json:"rejections,omitempty"`
+	ApprovalPower  cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=approval_power,json=approvalPower,proto3,customtype=cosmossdk.io/math.Int" json:"approval_power"`
+	RejectionPower cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=rejection_power,json=rejectionPower,proto3,customtype=cosmossdk.io/math.Int" json:"rejection_power"`
+	// block_height is the submission height
+	BlockHeight int64 `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *ContributionSummary) Reset()         { *m = ContributionSummary{} }
+func (m *ContributionSummary) String() string { return proto.CompactTextString(m) }
+func (*ContributionSummary) ProtoMessage()    {}
+func (*ContributionSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{21}
+}
+func (m *ContributionSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryContributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *ContributionSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContributionSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ContributionSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContributionSummary.Merge(m, src)
+}
+func (m *ContributionSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContributionSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContributionSummary.DiscardUnknown(m)
 }
 
-func (m *QueryContributionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContributionSummary proto.InternalMessageInfo
+
+func (m *ContributionSummary) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryContributionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContributionSummary) GetContributor() string {
+	if m != nil {
+		return m.Contributor
+	}
+	return ""
 }
 
-func (m *QueryContributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Contribution.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ContributionSummary) GetCtype() string {
+	if m != nil {
+		return m.Ctype
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryContributionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContributionSummary) GetStatus() ContributionStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryContributionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContributionSummary) GetApprovals() uint32 {
+	if m != nil {
+		return m.Approvals
+	}
+	return 0
 }
 
-func (m *QueryContributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Verified != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Verified))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Ctype) > 0 {
-		i -= len(m.Ctype)
-		copy(dAtA[i:], m.Ctype)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ctype)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Contributor) > 0 {
-		i -= len(m.Contributor)
-		copy(dAtA[i:], m.Contributor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contributor)))
-		i--
-		dAtA[i] = 0xa
+func (m *ContributionSummary) GetRejections() uint32 {
+	if m != nil {
+		return m.Rejections
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryContributionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContributionSummary) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryContributionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContributionsByStatusRequest is the request type for the
+// Query/ContributionsByStatus RPC method. Status and contributor are
+// optional filters.
+type QueryContributionsByStatusRequest struct {
+	Status      string             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Contributor string             `protobuf:"bytes,2,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryContributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Contributions) > 0 {
-		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContributionsByStatusRequest) Reset()         { *m = QueryContributionsByStatusRequest{} }
+func (m *QueryContributionsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContributionsByStatusRequest) ProtoMessage()    {}
+func (*QueryContributionsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{22}
+}
+func (m *QueryContributionsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContributionsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContributionsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCreditsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContributionsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContributionsByStatusRequest.Merge(m, src)
+}
+func (m *QueryContributionsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContributionsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContributionsByStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryContributionsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryContributionsByStatusRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *QueryCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryContributionsByStatusRequest) GetContributor() string {
+	if m != nil {
+		return m.Contributor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCreditsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContributionsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContributionsByStatusResponse is the response type for the
+// Query/ContributionsByStatus RPC method, ordered by contribution ID
+type QueryContributionsByStatusResponse struct {
+	Contributions []ContributionSummary `protobuf:"bytes,1,rep,name=contributions,proto3" json:"contributions"`
+	Pagination    *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tier) > 0 {
-		i -= len(m.Tier)
-		copy(dAtA[i:], m.Tier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tier)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Credits.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryContributionsByStatusResponse) Reset()         { *m = QueryContributionsByStatusResponse{} }
+func (m *QueryContributionsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContributionsByStatusResponse) ProtoMessage()    {}
+func (*QueryContributionsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_447ecebb6b2e58d5, []int{23}
+}
+func (m *QueryContributionsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContributionsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContributionsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryContributionsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContributionsByStatusResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryContributionsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContributionsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContributionsByStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryContributionsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryContributionsByStatusResponse) GetContributions() []ContributionSummary {
+	if m != nil {
+		return m.Contributions
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryContributionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryContributionsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryContributionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Contribution.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pos.poc.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pos.poc.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryContributionRequest)(nil), "pos.poc.v1.QueryContributionRequest")
+	proto.RegisterType((*QueryContributionResponse)(nil), "pos.poc.v1.QueryContributionResponse")
+	proto.RegisterType((*QueryContributionsRequest)(nil), "pos.poc.v1.QueryContributionsRequest")
+	proto.RegisterType((*QueryContributionsResponse)(nil), "pos.poc.v1.QueryContributionsResponse")
+	proto.RegisterType((*QueryCreditsRequest)(nil), "pos.poc.v1.QueryCreditsRequest")
+	proto.RegisterType((*QueryCreditsResponse)(nil), "pos.poc.v1.QueryCreditsResponse")
+	proto.RegisterType((*QueryFeeMetricsRequest)(nil), "pos.poc.v1.QueryFeeMetricsRequest")
+	proto.RegisterType((*QueryFeeMetricsResponse)(nil), "pos.poc.v1.QueryFeeMetricsResponse")
+	proto.RegisterType((*QueryContributorFeeStatsRequest)(nil), "pos.poc.v1.QueryContributorFeeStatsRequest")
+	proto.RegisterType((*QueryContributorFeeStatsResponse)(nil), "pos.poc.v1.QueryContributorFeeStatsResponse")
+	proto.RegisterType((*EffectivePower)(nil), "pos.poc.v1.EffectivePower")
+	proto.RegisterType((*QueryEffectivePowerRequest)(nil), "pos.poc.v1.QueryEffectivePowerRequest")
+	proto.RegisterType((*QueryEffectivePowerResponse)(nil), "pos.poc.v1.QueryEffectivePowerResponse")
+	proto.RegisterType((*QueryCanSubmitRequest)(nil), "pos.poc.v1.QueryCanSubmitRequest")
+	proto.RegisterType((*QueryCanSubmitResponse)(nil), "pos.poc.v1.QueryCanSubmitResponse")
+	proto.RegisterType((*QueryAccessControlConfigRequest)(nil), "pos.poc.v1.QueryAccessControlConfigRequest")
+	proto.RegisterType((*QueryAccessControlConfigResponse)(nil), "pos.poc.v1.QueryAccessControlConfigResponse")
+	proto.RegisterMapType((map[string]string)(nil), "pos.poc.v1.QueryAccessControlConfigResponse.CscoreRequirementsEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "pos.poc.v1.QueryAccessControlConfigResponse.IdentityRequirementsEntry")
+	proto.RegisterType((*QueryFeeQuoteRequest)(nil), "pos.poc.v1.QueryFeeQuoteRequest")
+	proto.RegisterType((*QueryFeeQuoteResponse)(nil), "pos.poc.v1.QueryFeeQuoteResponse")
+	proto.RegisterType((*ContributionSummary)(nil), "pos.poc.v1.ContributionSummary")
+	proto.RegisterType((*QueryContributionsByStatusRequest)(nil), "pos.poc.v1.QueryContributionsByStatusRequest")
+	proto.RegisterType((*QueryContributionsByStatusResponse)(nil), "pos.poc.v1.QueryContributionsByStatusResponse")
 }
 
-func (m *QueryContributionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contributor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func init() { proto.RegisterFile("pos/poc/v1/query.proto", fileDescriptor_447ecebb6b2e58d5) }
+
+var fileDescriptor_447ecebb6b2e58d5 = []byte{
+	// 1731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x41, 0x6f, 0xdb, 0xd8,
+	0x11, 0x0e, 0x25, 0xd9, 0x96, 0xc6, 0x89, 0xed, 0x7d, 0xb6, 0x15, 0x99, 0x49, 0x24, 0x99, 0xdb,
+	0x6c, 0xbc, 0xf1, 0x46, 0x5c, 0x6f, 0xda, 0x45, 0xd1, 0x3d, 0x14, 0x96, 0x6d, 0xb9, 0x01, 0x76,
+	0x0b, 0x87, 0xe9, 0x69, 0x5b, 0x80, 0xa0, 0xa8, 0x67, 0x99, 0xb5, 0xc4, 0x47, 0x93, 0x94, 0x6a,
+	0xc1, 0xc8, 0xa1, 0x8b, 0x5e, 0x0b, 0x2c, 0xd0, 0x1e, 0x7a, 0x2f, 0x16, 0x28, 0xd0, 0x4b, 0x0f,
+	0x05, 0x8a, 0x9e, 0x7a, 0xdd, 0xe3, 0x22, 0x45, 0x81, 0xa2, 0x40, 0x83, 0x22, 0x29, 0xd0, 0xff,
+	0xd0, 0x53, 0xc1, 0xf7, 0x86, 0x12, 0x29, 0x52, 0x92, 0x2d, 0xa0, 0x17, 0x43, 0x7c, 0x33, 0xf3,
+	0xcd, 0xf7, 0x66, 0xe6, 0xbd, 0x37, 0x63, 0x28, 0x3a, 0xcc, 0x53, 0x1d, 0x66, 0xaa, 0xfd, 0x3d,
+	0xf5, 0xa2, 0x47, 0xdd, 0x41, 0xcd, 0x71, 0x99, 0xcf, 0x08, 0x38, 0xcc, 0xab, 0x39, 0xcc, 0xac,
+	0xf5, 0xf7, 0xe4, 0x77, 0x8c, 0xae, 0x65, 0x33, 0x95, 0xff, 0x15, 0x62, 0x79, 0xcb, 0x64, 0x5e,
+	0x97, 0x79, 0x3a, 0xff, 0x52, 0xc5, 0x07, 0x8a, 0x36, 0xda, 0xac, 0xcd, 0xc4, 0x7a, 0xf0, 0x0b,
+	0x57, 0xef, 0xb7, 0x19, 0x6b, 0x77, 0xa8, 0x6a, 0x38, 0x96, 0x6a, 0xd8, 0x36, 0xf3, 0x0d, 0xdf,
+	0x62, 0x76, 0x68, 0xf3, 0x58, 0x20, 0xa8, 0x4d, 0xc3, 0xa3, 0x82, 0x86, 0xda, 0xdf, 0x6b, 0x52,
+	0xdf, 0xd8, 0x53, 0x1d, 0xa3, 0x6d, 0xd9, 0x5c, 0x19, 0x75, 0xcb, 0x51, 0xdd, 0x50, 0xcb, 0x64,
+	0x56, 0x28, 0xbf, 0x1b, 0xd9, 0x91, 0x63, 0xb8, 0x46, 0x37, 0x74, 0xf2, 0x20, 0x22, 0x30, 0x99,
+	0xed, 0xbb, 0x56, 0xb3, 0x17, 0xc1, 0xbd, 0x1f, 0x11, 0x9f, 0x52, 0xaa, 0x77, 0xa9, 0xef, 0x5a,
+	0x26, 0x1a, 0x2b, 0x1b, 0x40, 0x9e, 0x07, 0xbc, 0x4e, 0x38, 0xa2, 0x46, 0x2f, 0x7a, 0xd4, 0xf3,
+	0x95, 0x4f, 0x61, 0x3d, 0xb6, 0xea, 0x39, 0xcc, 0xf6, 0x28, 0xf9, 0x0e, 0x2c, 0x0a, 0xcf, 0x25,
+	0xa9, 0x2a, 0xed, 0x2c, 0x7f, 0x44, 0x6a, 0xa3, 0x68, 0xd6, 0x84, 0x6e, 0xbd, 0xf0, 0xf5, 0xeb,
+	0xca, 0xad, 0xdf, 0xfd, 0xe7, 0x0f, 0x8f, 0x25, 0x0d, 0x95, 0x95, 0xc7, 0x50, 0xe2, 0x68, 0x07,
+	0x11, 0x72, 0xe8, 0x89, 0xac, 0x40, 0xc6, 0x6a, 0x71, 0xb8, 0x9c, 0x96, 0xb1, 0x5a, 0x8a, 0x0e,
+	0x5b, 0x29, 0xba, 0xe8, 0xbf, 0x0e, 0xb7, 0xa3, 0x1b, 0x44, 0x16, 0xa5, 0x28, 0x8b, 0xa8, 0x5d,
+	0x3d, 0x17, 0x70, 0xd1, 0x62, 0x36, 0xca, 0x9f, 0xa4, 0x14, 0x0f, 0xe1, 0xc6, 0x49, 0x15, 0x96,
+	0x87, 0xda, 0xcc, 0xe5, 0x0e, 0x0a, 0x5a, 0x74, 0x89, 0x6c, 0xc0, 0x82, 0xe9, 0x0f, 0x1c, 0x5a,
+	0xca, 0x70, 0x99, 0xf8, 0x20, 0x32, 0xe4, 0xfb, 0xd4, 0xb5, 0x4e, 0x2d, 0xda, 0x2a, 0x65, 0xab,
+	0xd2, 0xce, 0x82, 0x36, 0xfc, 0x26, 0x0d, 0x80, 0x51, 0xb2, 0x4b, 0x39, 0xce, 0xf9, 0xbd, 0x1a,
+	0xd6, 0x56, 0x90, 0xed, 0x9a, 0x28, 0x50, 0xcc, 0x79, 0xed, 0xc4, 0x68, 0x53, 0xe4, 0xa3, 0x45,
+	0x2c, 0x95, 0xdf, 0x4b, 0x20, 0xa7, 0x31, 0xc7, 0xe0, 0x1c, 0xc2, 0x9d, 0xe8, 0x46, 0x83, 0x1c,
+	0x65, 0xaf, 0x11, 0x9d, 0xb8, 0x11, 0x39, 0x8e, 0x91, 0xcd, 0x70, 0xb2, 0x8f, 0x66, 0x92, 0x15,
+	0x14, 0x62, 0x6c, 0x55, 0x2c, 0xa1, 0x03, 0x97, 0xb6, 0x2c, 0x7f, 0x18, 0xe0, 0x12, 0x2c, 0x19,
+	0xad, 0x96, 0x4b, 0x3d, 0x0f, 0x83, 0x1b, 0x7e, 0x2a, 0x3a, 0x6c, 0xc4, 0x0d, 0x70, 0x5f, 0x4f,
+	0x61, 0xc9, 0x14, 0x4b, 0x98, 0xef, 0xf5, 0xd8, 0x8e, 0x84, 0x08, 0x37, 0x13, 0x6a, 0x12, 0x02,
+	0x39, 0xdf, 0xa2, 0x2e, 0x26, 0x89, 0xff, 0x56, 0x4a, 0x50, 0xe4, 0x0e, 0x1a, 0x94, 0x7e, 0x26,
+	0xce, 0x40, 0x58, 0xee, 0xcf, 0xe1, 0x6e, 0x42, 0x82, 0xde, 0x3f, 0x86, 0x25, 0x3c, 0x30, 0xe8,
+	0xbd, 0x18, 0xf5, 0x3e, 0x32, 0x08, 0x09, 0xa0, 0xb2, 0xf2, 0x09, 0x54, 0xe2, 0xb9, 0x62, 0x6e,
+	0x83, 0xd2, 0x17, 0xbe, 0x71, 0xbd, 0x50, 0x54, 0x27, 0x1b, 0x23, 0xb1, 0x4f, 0x60, 0xc1, 0x0b,
+	0x16, 0x90, 0x56, 0x25, 0x35, 0xcd, 0x23, 0x3b, 0xe4, 0x27, 0x6c, 0x94, 0x5f, 0xe6, 0x60, 0xe5,
+	0xe8, 0xf4, 0x94, 0x9a, 0xbe, 0xd5, 0xa7, 0x27, 0xec, 0x67, 0xd4, 0x9d, 0xcc, 0x86, 0xec, 0x73,
+	0x4f, 0xe7, 0x58, 0xf1, 0xf5, 0xdd, 0x00, 0xe8, 0x1f, 0xaf, 0x2b, 0x9b, 0xa2, 0x28, 0xbc, 0xd6,
+	0x79, 0xcd, 0x62, 0x6a, 0xd7, 0xf0, 0xcf, 0x6a, 0xcf, 0x6c, 0xff, 0xd5, 0x1f, 0x9f, 0x80, 0x10,
+	0x04, 0x5f, 0x9a, 0xb0, 0x24, 0x47, 0xa3, 0x1c, 0x66, 0x6f, 0x0e, 0x32, 0xcc, 0xea, 0x0f, 0xa1,
+	0xe0, 0x30, 0x53, 0x37, 0x3a, 0xce, 0x99, 0xc1, 0x0f, 0x52, 0xa1, 0xbe, 0x87, 0x40, 0xf7, 0x92,
+	0x40, 0x9f, 0xd2, 0xb6, 0x61, 0x0e, 0x0e, 0xa9, 0x19, 0x81, 0x3b, 0xa4, 0xa6, 0x96, 0x77, 0x98,
+	0xb9, 0x1f, 0x40, 0x90, 0x1f, 0xc3, 0x5a, 0xd7, 0xb8, 0xd4, 0x05, 0xbc, 0xde, 0x64, 0x76, 0xcf,
+	0x2b, 0x2d, 0xcc, 0x0b, 0xbb, 0xd2, 0x35, 0x2e, 0x45, 0x35, 0xd6, 0x03, 0x20, 0xf2, 0x23, 0xb8,
+	0x1d, 0x03, 0x5e, 0x9c, 0x17, 0x78, 0xd9, 0x8c, 0xa1, 0xae, 0xd2, 0x30, 0x71, 0xba, 0x13, 0x64,
+	0xae, 0xb4, 0x74, 0xf3, 0x88, 0xae, 0xd0, 0x58, 0xf2, 0x95, 0x8f, 0xf1, 0x66, 0x89, 0xd7, 0xc4,
+	0xec, 0x42, 0x3d, 0x83, 0x7b, 0xa9, 0x76, 0x58, 0xa3, 0xcf, 0x92, 0x64, 0x45, 0xb5, 0xca, 0xd1,
+	0x6a, 0x8d, 0x1b, 0x63, 0xa1, 0x8e, 0x33, 0x3c, 0x86, 0x4d, 0x71, 0x24, 0x0c, 0xfb, 0x45, 0xaf,
+	0xd9, 0xb5, 0xfc, 0x99, 0xe4, 0xd2, 0x6f, 0x6a, 0xe5, 0x95, 0x04, 0xc5, 0x71, 0x24, 0xa4, 0xfb,
+	0x00, 0xc0, 0x34, 0x6c, 0xdd, 0xe3, 0xab, 0x1c, 0x2d, 0xaf, 0x15, 0xcc, 0x50, 0x8d, 0x14, 0x61,
+	0xd1, 0xa5, 0x86, 0x87, 0xd7, 0x62, 0x41, 0xc3, 0xaf, 0x20, 0x25, 0x2e, 0xbd, 0xe8, 0x59, 0x2e,
+	0x6d, 0xe9, 0xa6, 0x67, 0x32, 0x97, 0xce, 0x53, 0xe4, 0x2b, 0x21, 0xc6, 0x01, 0x87, 0x20, 0xbb,
+	0xf0, 0x0e, 0xae, 0x78, 0xba, 0xd5, 0xa2, 0xb6, 0x6f, 0xf9, 0x03, 0x5e, 0xf3, 0x79, 0x6d, 0x2d,
+	0x14, 0x3c, 0xc3, 0x75, 0x65, 0x1b, 0x6f, 0x9b, 0x7d, 0xd3, 0xa4, 0x9e, 0xc7, 0x8f, 0x3f, 0xeb,
+	0x1c, 0x30, 0xfb, 0xd4, 0x6a, 0x87, 0x77, 0xdc, 0x9f, 0x73, 0x50, 0x9d, 0xac, 0x83, 0x11, 0xf8,
+	0x10, 0x36, 0xa8, 0x6d, 0x34, 0x3b, 0x14, 0x37, 0xa2, 0xb7, 0x0d, 0xdf, 0xb2, 0xdb, 0x18, 0x0b,
+	0x22, 0x64, 0x82, 0xe0, 0x31, 0x97, 0x90, 0x6f, 0x43, 0x11, 0x2d, 0x42, 0x92, 0xa1, 0x4d, 0x86,
+	0xdb, 0x20, 0x5e, 0xc8, 0x14, 0xad, 0x7a, 0xb0, 0x8e, 0x0e, 0x70, 0x2b, 0x5d, 0x6a, 0xf3, 0xbb,
+	0x21, 0x78, 0xb1, 0x0e, 0xa3, 0xc5, 0x31, 0x8b, 0x72, 0x4d, 0xb0, 0xd1, 0x22, 0x30, 0x47, 0xb6,
+	0xef, 0x0e, 0x34, 0x62, 0x26, 0x04, 0xe4, 0x0a, 0x36, 0x87, 0x2c, 0x63, 0x8e, 0x73, 0xdc, 0x71,
+	0xe3, 0x46, 0x8e, 0xc3, 0x2d, 0x25, 0x5d, 0x6f, 0x58, 0x29, 0x22, 0xf2, 0x3e, 0xac, 0xd1, 0x4b,
+	0xda, 0x75, 0x7c, 0x1d, 0x0b, 0x94, 0x06, 0x97, 0x4d, 0x76, 0xa7, 0xa0, 0xad, 0x8a, 0xf5, 0xfd,
+	0x70, 0x59, 0x3e, 0x82, 0xbb, 0x13, 0xb6, 0x45, 0xd6, 0x20, 0x7b, 0x4e, 0x07, 0x58, 0xea, 0xc1,
+	0xcf, 0xa0, 0xcc, 0xfb, 0x46, 0xa7, 0x37, 0x2c, 0x73, 0xfe, 0xf1, 0xbd, 0xcc, 0x77, 0x25, 0xf9,
+	0x18, 0xb6, 0x26, 0x92, 0x9c, 0x05, 0x94, 0x8f, 0x00, 0x29, 0x0d, 0x7c, 0x9a, 0x1b, 0x94, 0x3e,
+	0xef, 0x31, 0x9f, 0xce, 0x7b, 0xf6, 0xfe, 0x96, 0x81, 0xcd, 0x31, 0x20, 0x2c, 0xbc, 0xef, 0x43,
+	0x3e, 0x68, 0x2e, 0xf4, 0x53, 0x4a, 0xf1, 0x8a, 0xd8, 0x8a, 0x35, 0x1d, 0x61, 0xbb, 0x71, 0xc0,
+	0x2c, 0x3b, 0xda, 0x62, 0x2e, 0x05, 0xd2, 0x06, 0xa5, 0xe4, 0x27, 0xb0, 0x46, 0x1d, 0x66, 0x9e,
+	0xe9, 0xdd, 0x5e, 0xc7, 0xb7, 0x9c, 0xce, 0xf0, 0xf1, 0x9f, 0xe7, 0xc6, 0x5d, 0xe5, 0x50, 0x9f,
+	0x0d, 0x91, 0xc8, 0xe7, 0xb0, 0x8a, 0xf5, 0xda, 0xb2, 0x3c, 0x93, 0xf5, 0x6c, 0xbf, 0x94, 0x9d,
+	0x17, 0x7c, 0x45, 0x20, 0x1d, 0x22, 0x10, 0xd9, 0x87, 0xc2, 0xa9, 0x65, 0x1b, 0x1d, 0xbe, 0xf7,
+	0xdc, 0x0d, 0xf6, 0x9e, 0xe7, 0x66, 0x0d, 0x4a, 0x95, 0xdf, 0x64, 0x61, 0x3d, 0xda, 0xda, 0xbd,
+	0xe8, 0x75, 0xbb, 0x86, 0x3b, 0x18, 0x6f, 0xae, 0xc7, 0xbb, 0xdb, 0xcc, 0x94, 0xee, 0x36, 0x1b,
+	0xed, 0x6e, 0x6b, 0xb0, 0xe8, 0xf9, 0x86, 0xdf, 0xf3, 0xf0, 0xd1, 0x2d, 0xfe, 0xf7, 0x75, 0x85,
+	0xc4, 0x1c, 0x72, 0xa9, 0x86, 0x5a, 0xe4, 0x3e, 0x14, 0x0c, 0xc7, 0x71, 0x59, 0xdf, 0xe8, 0x88,
+	0x07, 0xf5, 0x8e, 0x36, 0x5a, 0x20, 0x65, 0x00, 0x97, 0xfe, 0x94, 0x9a, 0xa2, 0x4b, 0x5d, 0xe4,
+	0xe2, 0xc8, 0x0a, 0xd1, 0x60, 0x25, 0x54, 0x9e, 0xff, 0x85, 0xbb, 0x13, 0x42, 0x88, 0xee, 0x86,
+	0xdf, 0xd1, 0xe8, 0x01, 0x41, 0xf3, 0x73, 0xdd, 0xd1, 0x88, 0x21, 0x50, 0xb7, 0xe1, 0x76, 0xb3,
+	0xc3, 0xcc, 0x73, 0xfd, 0x8c, 0x5a, 0xed, 0x33, 0xbf, 0x54, 0xa8, 0x4a, 0x3b, 0x59, 0x6d, 0x99,
+	0xaf, 0xfd, 0x80, 0x2f, 0x29, 0x5f, 0x49, 0xb0, 0x9d, 0x6c, 0xda, 0xeb, 0x03, 0x8c, 0x18, 0x1e,
+	0xa4, 0xe2, 0x30, 0xc0, 0xe2, 0x1c, 0x85, 0x81, 0x9c, 0x9d, 0xb0, 0xf8, 0x70, 0x91, 0x9d, 0x7b,
+	0xb8, 0xf8, 0x8b, 0x04, 0xca, 0x34, 0x9e, 0x78, 0x4e, 0x4f, 0xd2, 0x87, 0x8c, 0xca, 0xa4, 0x21,
+	0x03, 0x2b, 0x31, 0x5a, 0xb6, 0xff, 0xa7, 0x81, 0xe3, 0xa3, 0x7f, 0x2e, 0xc3, 0x02, 0xdf, 0x01,
+	0xa1, 0xb0, 0x28, 0x86, 0x51, 0x52, 0x4e, 0xdc, 0xe8, 0xb1, 0x39, 0x57, 0xae, 0x4c, 0x94, 0x0b,
+	0x07, 0x8a, 0xfc, 0xc5, 0x5f, 0xff, 0xfd, 0xab, 0xcc, 0x06, 0x21, 0x6a, 0x62, 0xfa, 0x26, 0x5f,
+	0x48, 0x70, 0x3b, 0xba, 0x57, 0xf2, 0xad, 0x04, 0x5a, 0xca, 0xc4, 0x2b, 0x3f, 0x9c, 0xa1, 0x85,
+	0x9e, 0x1f, 0x72, 0xcf, 0x15, 0xf2, 0x40, 0x9d, 0x30, 0xde, 0xab, 0x57, 0x56, 0xeb, 0x25, 0xf9,
+	0xb9, 0x04, 0x77, 0x62, 0x29, 0x23, 0xd3, 0xf1, 0x87, 0x5b, 0x7f, 0x6f, 0x96, 0x1a, 0xf2, 0xd8,
+	0xe6, 0x3c, 0xee, 0x91, 0xad, 0x49, 0x3c, 0x3c, 0xe2, 0xc1, 0x12, 0x8e, 0x61, 0x24, 0x19, 0xd0,
+	0xf8, 0xfc, 0x27, 0x57, 0x27, 0x2b, 0x4c, 0xdd, 0xb8, 0x50, 0x52, 0xaf, 0xf0, 0x81, 0x79, 0x49,
+	0xfa, 0x00, 0xa3, 0xe9, 0x8b, 0x28, 0x09, 0xd8, 0xc4, 0x94, 0x27, 0xbf, 0x3b, 0x55, 0x07, 0xbd,
+	0x57, 0xb8, 0xf7, 0x2d, 0x72, 0x57, 0x4d, 0xff, 0xb7, 0x09, 0xf9, 0x4a, 0x82, 0xf5, 0x94, 0xf9,
+	0x8a, 0xec, 0x4e, 0x8e, 0x67, 0x62, 0xf4, 0x93, 0x3f, 0xb8, 0x9e, 0x32, 0x72, 0x7a, 0xca, 0x39,
+	0x3d, 0x21, 0xbb, 0xa9, 0x29, 0x60, 0x6e, 0xf0, 0x72, 0xe8, 0x7c, 0xb0, 0x8b, 0xc4, 0xe7, 0x4b,
+	0x29, 0x31, 0xe2, 0x25, 0x53, 0x9e, 0xda, 0xef, 0xcb, 0x8f, 0x66, 0xea, 0x21, 0xb1, 0x27, 0x9c,
+	0xd8, 0x23, 0xf2, 0x30, 0x4a, 0x6c, 0xac, 0xe3, 0x8f, 0x50, 0xfa, 0x85, 0x04, 0x85, 0x61, 0xd7,
+	0x4d, 0xb6, 0x93, 0x31, 0x18, 0xeb, 0xed, 0x65, 0x65, 0x9a, 0x0a, 0x72, 0xf8, 0x90, 0x73, 0x78,
+	0x4c, 0x76, 0x62, 0xc1, 0x19, 0xb6, 0xf1, 0x23, 0xf7, 0xea, 0x15, 0x7f, 0xcc, 0x5e, 0x92, 0x5f,
+	0x4b, 0xb0, 0x9e, 0xd2, 0xd8, 0xa5, 0x64, 0x70, 0x72, 0x3b, 0x2d, 0x7f, 0x70, 0x3d, 0x65, 0x24,
+	0xa9, 0x70, 0x92, 0xf7, 0x89, 0x1c, 0x25, 0x69, 0x70, 0x03, 0xdd, 0x14, 0x16, 0xe4, 0x12, 0xf2,
+	0x61, 0x5b, 0x44, 0xaa, 0x69, 0xa5, 0x1a, 0x6d, 0xbd, 0xe4, 0xed, 0x29, 0x1a, 0xe8, 0xf4, 0x11,
+	0x77, 0xba, 0x4d, 0x2a, 0xe3, 0xa5, 0x7c, 0x11, 0xa8, 0x45, 0xf2, 0xf2, 0x5b, 0x09, 0x36, 0x53,
+	0xaf, 0x7d, 0xf2, 0x64, 0xfa, 0x25, 0x31, 0xf6, 0x8c, 0xc9, 0xb5, 0xeb, 0xaa, 0x23, 0xc3, 0x5d,
+	0xce, 0xf0, 0x21, 0x79, 0x77, 0xe2, 0xdd, 0xa2, 0x37, 0x07, 0xba, 0x78, 0x0b, 0xeb, 0xef, 0x7f,
+	0xfd, 0xa6, 0x2c, 0x7d, 0xf3, 0xa6, 0x2c, 0xfd, 0xeb, 0x4d, 0x59, 0xfa, 0xf2, 0x6d, 0xf9, 0xd6,
+	0x37, 0x6f, 0xcb, 0xb7, 0xfe, 0xfe, 0xb6, 0x7c, 0xeb, 0xf3, 0xd5, 0xc0, 0xfa, 0x92, 0xdb, 0x07,
+	0x19, 0xf6, 0x9a, 0x8b, 0xfc, 0x7f, 0x9b, 0x4f, 0xff, 0x37, 0x00, 0x9e, 0x74, 0x2b, 0x9c, 0x05,
+	0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Contribution queries a contribution by ID
+	Contribution(ctx context.Context, in *QueryContributionRequest, opts ...grpc.CallOption) (*QueryContributionResponse, error)
+	// Contributions queries all contributions with optional filtering
+	Contributions(ctx context.Context, in *QueryContributionsRequest, opts ...grpc.CallOption) (*QueryContributionsResponse, error)
+	// Credits queries the credit balance for an address
+	Credits(ctx context.Context, in *QueryCreditsRequest, opts ...grpc.CallOption) (*QueryCreditsResponse, error)
+	// FeeMetrics queries the cumulative fee burn statistics
+	FeeMetrics(ctx context.Context, in *QueryFeeMetricsRequest, opts ...grpc.CallOption) (*QueryFeeMetricsResponse, error)
+	// ContributorFeeStats queries fee statistics for a specific contributor
+	ContributorFeeStats(ctx context.Context, in *QueryContributorFeeStatsRequest, opts ...grpc.CallOption) (*QueryContributorFeeStatsResponse, error)
+	// EffectivePower queries an address's stake boosted by its C-Score
+	EffectivePower(ctx context.Context, in *QueryEffectivePowerRequest, opts ...grpc.CallOption) (*QueryEffectivePowerResponse, error)
+	// CanSubmit reports whether an address may submit a contribution type
+	// right now, so clients can pre-check a submission before paying the fee
+	CanSubmit(ctx context.Context, in *QueryCanSubmitRequest, opts ...grpc.CallOption) (*QueryCanSubmitResponse, error)
+	// AccessControlConfig queries the gating switches, the per-type C-Score and
+	// identity requirements and the exempt address list
+	AccessControlConfig(ctx context.Context, in *QueryAccessControlConfigRequest, opts ...grpc.CallOption) (*QueryAccessControlConfigResponse, error)
+	// FeeQuote queries the fee a submission from an address would pay in the
+	// current block, without incrementing the block submission counter
+	FeeQuote(ctx context.Context, in *QueryFeeQuoteRequest, opts ...grpc.CallOption) (*QueryFeeQuoteResponse, error)
+	// ContributionsByStatus lists contributions with their lifecycle status and
+	// endorsement tally, optionally filtered by status and contributor
+	ContributionsByStatus(ctx context.Context, in *QueryContributionsByStatusRequest, opts ...grpc.CallOption) (*QueryContributionsByStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Ctype)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Contribution(ctx context.Context, in *QueryContributionRequest, opts ...grpc.CallOption) (*QueryContributionResponse, error) {
+	out := new(QueryContributionResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Contribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Verified != 0 {
-		n += 1 + sovQuery(uint64(m.Verified))
+	return out, nil
+}
+
+func (c *queryClient) Contributions(ctx context.Context, in *QueryContributionsRequest, opts ...grpc.CallOption) (*QueryContributionsResponse, error) {
+	out := new(QueryContributionsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Contributions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Credits(ctx context.Context, in *QueryCreditsRequest, opts ...grpc.CallOption) (*QueryCreditsResponse, error) {
+	out := new(QueryCreditsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/Credits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryContributionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FeeMetrics(ctx context.Context, in *QueryFeeMetricsRequest, opts ...grpc.CallOption) (*QueryFeeMetricsResponse, error) {
+	out := new(QueryFeeMetricsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/FeeMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Contributions) > 0 {
-		for _, e := range m.Contributions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) ContributorFeeStats(ctx context.Context, in *QueryContributorFeeStatsRequest, opts ...grpc.CallOption) (*QueryContributorFeeStatsResponse, error) {
+	out := new(QueryContributorFeeStatsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/ContributorFeeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) EffectivePower(ctx context.Context, in *QueryEffectivePowerRequest, opts ...grpc.CallOption) (*QueryEffectivePowerResponse, error) {
+	out := new(QueryEffectivePowerResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/EffectivePower", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCreditsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CanSubmit(ctx context.Context, in *QueryCanSubmitRequest, opts ...grpc.CallOption) (*QueryCanSubmitResponse, error) {
+	out := new(QueryCanSubmitResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/CanSubmit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AccessControlConfig(ctx context.Context, in *QueryAccessControlConfigRequest, opts ...grpc.CallOption) (*QueryAccessControlConfigResponse, error) {
+	out := new(QueryAccessControlConfigResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/AccessControlConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCreditsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FeeQuote(ctx context.Context, in *QueryFeeQuoteRequest, opts ...grpc.CallOption) (*QueryFeeQuoteResponse, error) {
+	out := new(QueryFeeQuoteResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/FeeQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Credits.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.Tier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ContributionsByStatus(ctx context.Context, in *QueryContributionsByStatusRequest, opts ...grpc.CallOption) (*QueryContributionsByStatusResponse, error) {
+	out := new(QueryContributionsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Query/ContributionsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Contribution queries a contribution by ID
+	Contribution(context.Context, *QueryContributionRequest) (*QueryContributionResponse, error)
+	// Contributions queries all contributions with optional filtering
+	Contributions(context.Context, *QueryContributionsRequest) (*QueryContributionsResponse, error)
+	// Credits queries the credit balance for an address
+	Credits(context.Context, *QueryCreditsRequest) (*QueryCreditsResponse, error)
+	// FeeMetrics queries the cumulative fee burn statistics
+	FeeMetrics(context.Context, *QueryFeeMetricsRequest) (*QueryFeeMetricsResponse, error)
+	// ContributorFeeStats queries fee statistics for a specific contributor
+	ContributorFeeStats(context.Context, *QueryContributorFeeStatsRequest) (*QueryContributorFeeStatsResponse, error)
+	// EffectivePower queries an address's stake boosted by its C-Score
+	EffectivePower(context.Context, *QueryEffectivePowerRequest) (*QueryEffectivePowerResponse, error)
+	// CanSubmit reports whether an address may submit a contribution type
+	// right now, so clients can pre-check a submission before paying the fee
+	CanSubmit(context.Context, *QueryCanSubmitRequest) (*QueryCanSubmitResponse, error)
+	// AccessControlConfig queries the gating switches, the per-type C-Score and
+	// identity requirements and the exempt address list
+	AccessControlConfig(context.Context, *QueryAccessControlConfigRequest) (*QueryAccessControlConfigResponse, error)
+	// FeeQuote queries the fee a submission from an address would pay in the
+	// current block, without incrementing the block submission counter
+	FeeQuote(context.Context, *QueryFeeQuoteRequest) (*QueryFeeQuoteResponse, error)
+	// ContributionsByStatus lists contributions with their lifecycle status and
+	// endorsement tally, optionally filtered by status and contributor
+	ContributionsByStatus(context.Context, *QueryContributionsByStatusRequest) (*QueryContributionsByStatusResponse, error)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Contribution(ctx context.Context, req *QueryContributionRequest) (*QueryContributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contribution not implemented")
+}
+func (*UnimplementedQueryServer) Contributions(ctx context.Context, req *QueryContributionsRequest) (*QueryContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contributions not implemented")
+}
+func (*UnimplementedQueryServer) Credits(ctx context.Context, req *QueryCreditsRequest) (*QueryCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Credits not implemented")
+}
+func (*UnimplementedQueryServer) FeeMetrics(ctx context.Context, req *QueryFeeMetricsRequest) (*QueryFeeMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeMetrics not implemented")
+}
+func (*UnimplementedQueryServer) ContributorFeeStats(ctx context.Context, req *QueryContributorFeeStatsRequest) (*QueryContributorFeeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContributorFeeStats not implemented")
+}
+func (*UnimplementedQueryServer) EffectivePower(ctx context.Context, req *QueryEffectivePowerRequest) (*QueryEffectivePowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectivePower not implemented")
+}
+func (*UnimplementedQueryServer) CanSubmit(ctx context.Context, req *QueryCanSubmitRequest) (*QueryCanSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanSubmit not implemented")
+}
+func (*UnimplementedQueryServer) AccessControlConfig(ctx context.Context, req *QueryAccessControlConfigRequest) (*QueryAccessControlConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccessControlConfig not implemented")
+}
+func (*UnimplementedQueryServer) FeeQuote(ctx context.Context, req *QueryFeeQuoteRequest) (*QueryFeeQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeQuote not implemented")
+}
+func (*UnimplementedQueryServer) ContributionsByStatus(ctx context.Context, req *QueryContributionsByStatusRequest) (*QueryContributionsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContributionsByStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Contribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Contribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/Contribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contribution(ctx, req.(*QueryContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Contributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Contributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/Contributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contributions(ctx, req.(*QueryContributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Credits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Credits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/Credits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Credits(ctx, req.(*QueryCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/FeeMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeMetrics(ctx, req.(*QueryFeeMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContributorFeeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContributorFeeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContributorFeeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/ContributorFeeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContributorFeeStats(ctx, req.(*QueryContributorFeeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectivePower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectivePowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectivePower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/EffectivePower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectivePower(ctx, req.(*QueryEffectivePowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/CanSubmit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanSubmit(ctx, req.(*QueryCanSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccessControlConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccessControlConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccessControlConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/AccessControlConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccessControlConfig(ctx, req.(*QueryAccessControlConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/FeeQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeQuote(ctx, req.(*QueryFeeQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContributionsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContributionsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContributionsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Query/ContributionsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContributionsByStatus(ctx, req.(*QueryContributionsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.poc.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Contribution",
+			Handler:    _Query_Contribution_Handler,
+		},
+		{
+			MethodName: "Contributions",
+			Handler:    _Query_Contributions_Handler,
+		},
+		{
+			MethodName: "Credits",
+			Handler:    _Query_Credits_Handler,
+		},
+		{
+			MethodName: "FeeMetrics",
+			Handler:    _Query_FeeMetrics_Handler,
+		},
+		{
+			MethodName: "ContributorFeeStats",
+			Handler:    _Query_ContributorFeeStats_Handler,
+		},
+		{
+			MethodName: "EffectivePower",
+			Handler:    _Query_EffectivePower_Handler,
+		},
+		{
+			MethodName: "CanSubmit",
+			Handler:    _Query_CanSubmit_Handler,
+		},
+		{
+			MethodName: "AccessControlConfig",
+			Handler:    _Query_AccessControlConfig_Handler,
+		},
+		{
+			MethodName: "FeeQuote",
+			Handler:    _Query_FeeQuote_Handler,
+		},
+		{
+			MethodName: "ContributionsByStatus",
+			Handler:    _Query_ContributionsByStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pos/poc/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Contribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Verified != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Verified))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ctype) > 0 {
+		i -= len(m.Ctype)
+		copy(dAtA[i:], m.Ctype)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ctype)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Credits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributorFeeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributorFeeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributorFeeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributorFeeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributorFeeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributorFeeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectivePower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectivePower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectivePower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EffectivePower.Size()
+		i -= size
+		if _, err := m.EffectivePower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CreditBonus.Size()
+		i -= size
+		if _, err := m.CreditBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxCreditBonus.Size()
+		i -= size
+		if _, err := m.MaxCreditBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PocAlpha.Size()
+		i -= size
+		if _, err := m.PocAlpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Credits.Size()
+		i -= size
+		if _, err := m.Credits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectivePowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectivePowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectivePowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectivePowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectivePowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectivePowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EffectivePower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanSubmitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ctype) > 0 {
+		i -= len(m.Ctype)
+		copy(dAtA[i:], m.Ctype)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ctype)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanSubmitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiresIdentity {
+		i--
+		if m.RequiresIdentity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RequiredCscore.Size()
+		i -= size
+		if _, err := m.RequiredCscore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CanSubmit {
+		i--
+		if m.CanSubmit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessControlConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccessControlConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccessControlConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccessControlConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IdentityRequirements) > 0 {
+		for k := range m.IdentityRequirements {
+			v := m.IdentityRequirements[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CscoreRequirements) > 0 {
+		for k := range m.CscoreRequirements {
+			v := m.CscoreRequirements[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EnableIdentityGating {
+		i--
+		if m.EnableIdentityGating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnableCscoreGating {
+		i--
+		if m.EnableCscoreGating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ctype) > 0 {
+		i -= len(m.Ctype)
+		copy(dAtA[i:], m.Ctype)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ctype)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CscoreDiscount.Size()
+		i -= size
+		if _, err := m.CscoreDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EpochMultiplier.Size()
+		i -= size
+		if _, err := m.EpochMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContributionSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContributionSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContributionSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.RejectionPower.Size()
+		i -= size
+		if _, err := m.RejectionPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ApprovalPower.Size()
+		i -= size
+		if _, err := m.ApprovalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Rejections != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rejections))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Approvals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ctype) > 0 {
+		i -= len(m.Ctype)
+		copy(dAtA[i:], m.Ctype)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ctype)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributionsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributionsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributionsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContributionsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContributionsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContributionsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryContributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ctype)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Verified != 0 {
+		n += 1 + sovQuery(uint64(m.Verified))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Credits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metrics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContributorFeeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContributorFeeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EffectivePower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Credits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PocAlpha.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxCreditBonus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CreditBonus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectivePower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEffectivePowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectivePowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EffectivePower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCanSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ctype)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanSubmit {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RequiredCscore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RequiresIdentity {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAccessControlConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccessControlConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableCscoreGating {
+		n += 2
+	}
+	if m.EnableIdentityGating {
+		n += 2
+	}
+	if len(m.CscoreRequirements) > 0 {
+		for k, v := range m.CscoreRequirements {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	if len(m.IdentityRequirements) > 0 {
+		for k, v := range m.IdentityRequirements {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ctype)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CscoreDiscount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FinalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ContributionSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ctype)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Approvals != 0 {
+		n += 1 + sovQuery(uint64(m.Approvals))
+	}
+	if m.Rejections != 0 {
+		n += 1 + sovQuery(uint64(m.Rejections))
+	}
+	l = m.ApprovalPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RejectionPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryContributionsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContributionsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ctype", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ctype = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			m.Verified = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verified |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributions = append(m.Contributions, Contribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContributorFeeStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContributorFeeStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContributorFeeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContributorFeeStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContributorFeeStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContributorFeeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectivePower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectivePower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectivePower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PocAlpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreditBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCreditBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreditBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectivePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectivePowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectivePowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectivePowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectivePowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectivePowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectivePowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectivePower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCanSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ctype", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ctype = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCanSubmitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanSubmitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSubmit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanSubmit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCscore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredCscore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresIdentity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresIdentity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessControlConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccessControlConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccessControlConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccessControlConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableCscoreGating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableCscoreGating = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableIdentityGating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableIdentityGating = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CscoreRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CscoreRequirements == nil {
+				m.CscoreRequirements = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CscoreRequirements[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.IdentityRequirements == nil {
+				m.IdentityRequirements = make(map[string]bool)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.IdentityRequirements[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1382,7 +5604,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeQuoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,17 +5627,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeQuoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1425,24 +5647,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ctype", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Ctype = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1465,7 +5718,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContributionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,17 +5741,17 @@ func (m *QueryContributionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContributionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1508,64 +5761,96 @@ func (m *QueryContributionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryContributionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.EpochMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CscoreDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CscoreDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContributionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1592,7 +5877,7 @@ func (m *QueryContributionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1617,7 +5902,7 @@ func (m *QueryContributionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ContributionSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1636,17 +5921,36 @@ func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContributionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContributionSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContributionSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
 			}
@@ -1678,7 +5982,7 @@ func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Contributor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Ctype", wireType)
 			}
@@ -1710,11 +6014,43 @@ func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Ctype = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ContributionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
-			m.Verified = 0
+			m.Approvals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1724,16 +6060,35 @@ func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Verified |= int32(b&0x7F) << shift
+				m.Approvals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			m.Rejections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rejections |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1743,28 +6098,79 @@ func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if err := m.ApprovalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RejectionPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1786,7 +6192,7 @@ func (m *QueryContributionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContributionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContributionsByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1809,17 +6215,17 @@ func (m *QueryContributionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContributionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContributionsByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContributionsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1829,31 +6235,29 @@ func (m *QueryContributionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contributions = append(m.Contributions, Contribution{})
-			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1863,83 +6267,29 @@ func (m *QueryContributionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contributor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCreditsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1949,23 +6299,27 @@ func (m *QueryCreditsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1988,7 +6342,7 @@ func (m *QueryCreditsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCreditsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContributionsByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2011,15 +6365,15 @@ func (m *QueryCreditsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCreditsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContributionsByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContributionsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2046,15 +6400,16 @@ func (m *QueryCreditsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Credits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contributions = append(m.Contributions, ContributionSummary{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2064,23 +6419,27 @@ func (m *QueryCreditsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tier = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
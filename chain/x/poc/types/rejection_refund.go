@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ============================================================================
+// Rejected Contribution Fee Refunds
+// ============================================================================
+
+// RejectionRefundParams control the partial fee refund paid when endorsers
+// reject a contribution and no fraud proof exists. Stored as a JSON sidecar.
+type RejectionRefundParams struct {
+	// RefundRatio is the share of the fee's reward pool portion returned to
+	// the contributor (0.5 = 50%). The burned portion is never refunded.
+	// Zero disables refunds.
+	RefundRatio math.LegacyDec `json:"refund_ratio"`
+}
+
+// DefaultRejectionRefundParams returns the default refund parameters.
+// Refunds are disabled until governance sets a ratio.
+func DefaultRejectionRefundParams() RejectionRefundParams {
+	return RejectionRefundParams{
+		RefundRatio: math.LegacyZeroDec(),
+	}
+}
+
+// Validate checks that the refund ratio is in [0, 1]
+func (p RejectionRefundParams) Validate() error {
+	if p.RefundRatio.IsNil() || p.RefundRatio.IsNegative() || p.RefundRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("refund_ratio must be in [0, 1], got %s", p.RefundRatio)
+	}
+	return nil
+}
+
+// ContributionFeeRecord records the non-burned part of a contribution's
+// submission fee and whether it has been partially refunded. Refunded is set
+// once, so a contribution is refunded at most once.
+type ContributionFeeRecord struct {
+	ContributionID uint64   `json:"contribution_id"`
+	Contributor    string   `json:"contributor"`
+	PoolAmount     sdk.Coin `json:"pool_amount"`           // fee share kept in the reward pool
+	Refunded       math.Int `json:"refunded"`              // amount returned to the contributor
+	RefundedAt     int64    `json:"refunded_at,omitempty"` // block height of the refund
+}
+
+// IsRefunded returns true if the fee has already been refunded
+func (r ContributionFeeRecord) IsRefunded() bool {
+	return !r.Refunded.IsNil() && r.Refunded.IsPositive()
+}
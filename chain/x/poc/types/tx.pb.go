@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -445,29 +446,26 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-// MsgSubmitSimilarityCommitment submits an oracle-signed similarity commitment for a contribution
-type MsgSubmitSimilarityCommitment struct {
-	// submitter is the address submitting this commitment (must be an allowlisted oracle)
-	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
-	// contribution_id is the contribution being analyzed
-	ContributionID uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
-	// compact_data_json is the JSON-encoded SimilarityCompactData
-	CompactDataJson []byte `protobuf:"bytes,3,opt,name=compact_data_json,json=compactDataJson,proto3" json:"compact_data_json,omitempty"`
-	// oracle_signature_compact is the oracle's signature over compact_data_json
-	OracleSignatureCompact []byte `protobuf:"bytes,4,opt,name=oracle_signature_compact,json=oracleSignatureCompact,proto3" json:"oracle_signature_compact,omitempty"`
-	// commitment_hash_full is the SHA-256 hash of the full similarity vector
-	CommitmentHashFull []byte `protobuf:"bytes,5,opt,name=commitment_hash_full,json=commitmentHashFull,proto3" json:"commitment_hash_full,omitempty"`
-	// oracle_signature_full is the oracle's signature over commitment_hash_full
-	OracleSignatureFull []byte `protobuf:"bytes,6,opt,name=oracle_signature_full,json=oracleSignatureFull,proto3" json:"oracle_signature_full,omitempty"`
+// MsgSetSubmissionsPaused turns the contribution submission kill-switch on or
+// off. Governance only.
+type MsgSetSubmissionsPaused struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Paused    bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgSubmitSimilarityCommitment) Reset()         { *m = MsgSubmitSimilarityCommitment{} }
-func (m *MsgSubmitSimilarityCommitment) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSimilarityCommitment) ProtoMessage()    {}
-func (m *MsgSubmitSimilarityCommitment) XXX_Unmarshal(b []byte) error { return m.Unmarshal(b) }
-func (m *MsgSubmitSimilarityCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSubmissionsPaused) Reset()         { *m = MsgSetSubmissionsPaused{} }
+func (m *MsgSetSubmissionsPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSubmissionsPaused) ProtoMessage()    {}
+func (*MsgSetSubmissionsPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{8}
+}
+func (m *MsgSetSubmissionsPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSubmissionsPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitSimilarityCommitment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSubmissionsPaused.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -477,234 +475,55 @@ func (m *MsgSubmitSimilarityCommitment) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitSimilarityCommitment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitSimilarityCommitment.Merge(m, src)
+func (m *MsgSetSubmissionsPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSubmissionsPaused.Merge(m, src)
 }
-func (m *MsgSubmitSimilarityCommitment) XXX_Size() int  { return m.Size() }
-func (m *MsgSubmitSimilarityCommitment) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitSimilarityCommitment.DiscardUnknown(m)
+func (m *MsgSetSubmissionsPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSubmissionsPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSubmissionsPaused.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitSimilarityCommitment proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSubmissionsPaused proto.InternalMessageInfo
 
-func (m *MsgSubmitSimilarityCommitment) GetSubmitter() string {
-	if m != nil { return m.Submitter }
+func (m *MsgSetSubmissionsPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
 	return ""
 }
-func (m *MsgSubmitSimilarityCommitment) GetContributionID() uint64 {
-	if m != nil { return m.ContributionID }
-	return 0
-}
-func (m *MsgSubmitSimilarityCommitment) GetCompactDataJson() []byte {
-	if m != nil { return m.CompactDataJson }
-	return nil
-}
-func (m *MsgSubmitSimilarityCommitment) GetOracleSignatureCompact() []byte {
-	if m != nil { return m.OracleSignatureCompact }
-	return nil
-}
-func (m *MsgSubmitSimilarityCommitment) GetCommitmentHashFull() []byte {
-	if m != nil { return m.CommitmentHashFull }
-	return nil
-}
-func (m *MsgSubmitSimilarityCommitment) GetOracleSignatureFull() []byte {
-	if m != nil { return m.OracleSignatureFull }
-	return nil
-}
 
-// Marshal/Unmarshal for MsgSubmitSimilarityCommitment
-func (m *MsgSubmitSimilarityCommitment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil { return nil, err }
-	return dAtA[:n], nil
-}
-func (m *MsgSubmitSimilarityCommitment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-func (m *MsgSubmitSimilarityCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if len(m.OracleSignatureFull) > 0 {
-		i -= len(m.OracleSignatureFull)
-		copy(dAtA[i:], m.OracleSignatureFull)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleSignatureFull)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.CommitmentHashFull) > 0 {
-		i -= len(m.CommitmentHashFull)
-		copy(dAtA[i:], m.CommitmentHashFull)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CommitmentHashFull)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.OracleSignatureCompact) > 0 {
-		i -= len(m.OracleSignatureCompact)
-		copy(dAtA[i:], m.OracleSignatureCompact)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleSignatureCompact)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CompactDataJson) > 0 {
-		i -= len(m.CompactDataJson)
-		copy(dAtA[i:], m.CompactDataJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CompactDataJson)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ContributionID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContributionID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetSubmissionsPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MsgSubmitSimilarityCommitment) Size() (n int) {
-	if m == nil { return 0 }
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
-	if m.ContributionID != 0 { n += 1 + sovTx(uint64(m.ContributionID)) }
-	l = len(m.CompactDataJson)
-	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
-	l = len(m.OracleSignatureCompact)
-	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
-	l = len(m.CommitmentHashFull)
-	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
-	l = len(m.OracleSignatureFull)
-	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
-	return n
+	return false
 }
-func (m *MsgSubmitSimilarityCommitment) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 { return ErrIntOverflowTx }
-			if iNdEx >= l { return io.ErrUnexpectedEOF }
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 { break }
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 2 {
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 { return ErrIntOverflowTx }
-				if iNdEx >= l { return io.ErrUnexpectedEOF }
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 { break }
-			}
-			if byteLen < 0 { return ErrInvalidLengthTx }
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 { return ErrInvalidLengthTx }
-			if postIndex > l { return io.ErrUnexpectedEOF }
-			switch fieldNum {
-			case 1:
-				m.Submitter = string(dAtA[iNdEx:postIndex])
-			case 3:
-				m.CompactDataJson = append(m.CompactDataJson[:0], dAtA[iNdEx:postIndex]...)
-			case 4:
-				m.OracleSignatureCompact = append(m.OracleSignatureCompact[:0], dAtA[iNdEx:postIndex]...)
-			case 5:
-				m.CommitmentHashFull = append(m.CommitmentHashFull[:0], dAtA[iNdEx:postIndex]...)
-			case 6:
-				m.OracleSignatureFull = append(m.OracleSignatureFull[:0], dAtA[iNdEx:postIndex]...)
-			default:
-				iNdEx = preIndex
-				skippy, err := skipTx(dAtA[iNdEx:])
-				if err != nil { return err }
-				if (skippy < 0) || (iNdEx+skippy) < 0 { return ErrInvalidLengthTx }
-				if (iNdEx + skippy) > l { return io.ErrUnexpectedEOF }
-				iNdEx = preIndex + skippy
-				continue
-			}
-			iNdEx = postIndex
-		} else if wireType == 0 {
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 { return ErrIntOverflowTx }
-				if iNdEx >= l { return io.ErrUnexpectedEOF }
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 { break }
-			}
-			switch fieldNum {
-			case 2:
-				m.ContributionID = v
-			default:
-				iNdEx = preIndex
-				skippy, err := skipTx(dAtA[iNdEx:])
-				if err != nil { return err }
-				if (skippy < 0) || (iNdEx+skippy) < 0 { return ErrInvalidLengthTx }
-				if (iNdEx + skippy) > l { return io.ErrUnexpectedEOF }
-				iNdEx = preIndex + skippy
-				continue
-			}
-		} else {
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil { return err }
-			if (skippy < 0) || (iNdEx+skippy) < 0 { return ErrInvalidLengthTx }
-			if (iNdEx + skippy) > l { return io.ErrUnexpectedEOF }
-			iNdEx = preIndex + skippy
-		}
+
+func (m *MsgSetSubmissionsPaused) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if iNdEx > l { return io.ErrUnexpectedEOF }
-	return nil
+	return ""
 }
 
-// MsgSubmitSimilarityCommitmentResponse is the response for MsgSubmitSimilarityCommitment
-type MsgSubmitSimilarityCommitmentResponse struct {
-	IsDerivative bool `protobuf:"varint,1,opt,name=is_derivative,json=isDerivative,proto3" json:"is_derivative,omitempty"`
+// MsgSetSubmissionsPausedResponse is the response for MsgSetSubmissionsPaused
+type MsgSetSubmissionsPausedResponse struct {
 }
 
-func (m *MsgSubmitSimilarityCommitmentResponse) Reset()         { *m = MsgSubmitSimilarityCommitmentResponse{} }
-func (m *MsgSubmitSimilarityCommitmentResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSimilarityCommitmentResponse) ProtoMessage()    {}
-func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Unmarshal(b []byte) error { return nil }
-func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) { return nil, nil }
-func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Merge(src proto.Message) {}
-func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Size() int  { return 0 }
-func (m *MsgSubmitSimilarityCommitmentResponse) XXX_DiscardUnknown() {}
-func (m *MsgSubmitSimilarityCommitmentResponse) Marshal() (dAtA []byte, err error) { return nil, nil }
-func (m *MsgSubmitSimilarityCommitmentResponse) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
-func (m *MsgSubmitSimilarityCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) { return len(dAtA), nil }
-func (m *MsgSubmitSimilarityCommitmentResponse) Size() (n int) { return 0 }
-func (m *MsgSubmitSimilarityCommitmentResponse) Unmarshal(dAtA []byte) error { return nil }
-
-// MsgStartReview initiates a human review session for a contribution
-type MsgStartReview struct {
-	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ContributionId uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+func (m *MsgSetSubmissionsPausedResponse) Reset()         { *m = MsgSetSubmissionsPausedResponse{} }
+func (m *MsgSetSubmissionsPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSubmissionsPausedResponse) ProtoMessage()    {}
+func (*MsgSetSubmissionsPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{9}
 }
-
-func (m *MsgStartReview) Reset()         { *m = MsgStartReview{} }
-func (m *MsgStartReview) String() string { return proto.CompactTextString(m) }
-func (*MsgStartReview) ProtoMessage()    {}
-func (m *MsgStartReview) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetSubmissionsPausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgStartReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSubmissionsPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgStartReview.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSubmissionsPausedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -714,93 +533,42 @@ func (m *MsgStartReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgStartReview) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgStartReview.Merge(m, src)
+func (m *MsgSetSubmissionsPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSubmissionsPausedResponse.Merge(m, src)
 }
-func (m *MsgStartReview) XXX_Size() int {
+func (m *MsgSetSubmissionsPausedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgStartReview) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgStartReview.DiscardUnknown(m)
+func (m *MsgSetSubmissionsPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSubmissionsPausedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgStartReview proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSubmissionsPausedResponse proto.InternalMessageInfo
 
-func (m *MsgStartReview) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
-
-func (m *MsgStartReview) GetContributionId() uint64 {
-	if m != nil {
-		return m.ContributionId
-	}
-	return 0
-}
-
-type MsgStartReviewResponse struct {
-	ReviewersAssigned []string `protobuf:"bytes,1,rep,name=reviewers_assigned,json=reviewersAssigned,proto3" json:"reviewers_assigned,omitempty"`
-}
-
-func (m *MsgStartReviewResponse) Reset()         { *m = MsgStartReviewResponse{} }
-func (m *MsgStartReviewResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgStartReviewResponse) ProtoMessage()    {}
-func (m *MsgStartReviewResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgStartReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgStartReviewResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *MsgStartReviewResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgStartReviewResponse.Merge(m, src)
-}
-func (m *MsgStartReviewResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgStartReviewResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgStartReviewResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgStartReviewResponse proto.InternalMessageInfo
-
-func (m *MsgStartReviewResponse) GetReviewersAssigned() []string {
-	if m != nil {
-		return m.ReviewersAssigned
-	}
-	return nil
+// MsgRegisterContributionType registers a contribution type, or replaces the
+// reward weight and access requirements of an existing one. Governance only.
+type MsgRegisterContributionType struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Ctype     string `protobuf:"bytes,2,opt,name=ctype,proto3" json:"ctype,omitempty"`
+	// reward_weight is in basis points, [1, 10000]
+	RewardWeight uint32 `protobuf:"varint,3,opt,name=reward_weight,json=rewardWeight,proto3" json:"reward_weight,omitempty"`
+	// min_cscore is zero for no C-Score requirement
+	MinCscore        cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_cscore,json=minCscore,proto3,customtype=cosmossdk.io/math.Int" json:"min_cscore"`
+	RequiresIdentity bool                  `protobuf:"varint,5,opt,name=requires_identity,json=requiresIdentity,proto3" json:"requires_identity,omitempty"`
 }
 
-// MsgCastReviewVote allows an assigned reviewer to cast their vote
-type MsgCastReviewVote struct {
-	Reviewer            string `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
-	ContributionId      uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
-	Decision            uint32 `protobuf:"varint,3,opt,name=decision,proto3" json:"decision,omitempty"`
-	OriginalityOverride uint32 `protobuf:"varint,4,opt,name=originality_override,json=originalityOverride,proto3" json:"originality_override,omitempty"`
-	QualityScore        uint32 `protobuf:"varint,5,opt,name=quality_score,json=qualityScore,proto3" json:"quality_score,omitempty"`
-	NotesPointer        string `protobuf:"bytes,6,opt,name=notes_pointer,json=notesPointer,proto3" json:"notes_pointer,omitempty"`
-	ParentClaimOverride uint64 `protobuf:"varint,7,opt,name=parent_claim_override,json=parentClaimOverride,proto3" json:"parent_claim_override,omitempty"`
+func (m *MsgRegisterContributionType) Reset()         { *m = MsgRegisterContributionType{} }
+func (m *MsgRegisterContributionType) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterContributionType) ProtoMessage()    {}
+func (*MsgRegisterContributionType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{10}
 }
-
-func (m *MsgCastReviewVote) Reset()         { *m = MsgCastReviewVote{} }
-func (m *MsgCastReviewVote) String() string { return proto.CompactTextString(m) }
-func (*MsgCastReviewVote) ProtoMessage()    {}
-func (m *MsgCastReviewVote) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterContributionType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCastReviewVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterContributionType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCastReviewVote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterContributionType.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -810,78 +578,62 @@ func (m *MsgCastReviewVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgCastReviewVote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCastReviewVote.Merge(m, src)
+func (m *MsgRegisterContributionType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterContributionType.Merge(m, src)
 }
-func (m *MsgCastReviewVote) XXX_Size() int {
+func (m *MsgRegisterContributionType) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCastReviewVote) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCastReviewVote.DiscardUnknown(m)
+func (m *MsgRegisterContributionType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterContributionType.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCastReviewVote proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterContributionType proto.InternalMessageInfo
 
-func (m *MsgCastReviewVote) GetReviewer() string {
+func (m *MsgRegisterContributionType) GetAuthority() string {
 	if m != nil {
-		return m.Reviewer
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgCastReviewVote) GetContributionId() uint64 {
-	if m != nil {
-		return m.ContributionId
-	}
-	return 0
-}
-
-func (m *MsgCastReviewVote) GetDecision() uint32 {
+func (m *MsgRegisterContributionType) GetCtype() string {
 	if m != nil {
-		return m.Decision
+		return m.Ctype
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgCastReviewVote) GetOriginalityOverride() uint32 {
+func (m *MsgRegisterContributionType) GetRewardWeight() uint32 {
 	if m != nil {
-		return m.OriginalityOverride
+		return m.RewardWeight
 	}
 	return 0
 }
 
-func (m *MsgCastReviewVote) GetQualityScore() uint32 {
+func (m *MsgRegisterContributionType) GetRequiresIdentity() bool {
 	if m != nil {
-		return m.QualityScore
+		return m.RequiresIdentity
 	}
-	return 0
+	return false
 }
 
-func (m *MsgCastReviewVote) GetNotesPointer() string {
-	if m != nil {
-		return m.NotesPointer
-	}
-	return ""
+// MsgRegisterContributionTypeResponse is the response for MsgRegisterContributionType
+type MsgRegisterContributionTypeResponse struct {
 }
 
-func (m *MsgCastReviewVote) GetParentClaimOverride() uint64 {
-	if m != nil {
-		return m.ParentClaimOverride
-	}
-	return 0
+func (m *MsgRegisterContributionTypeResponse) Reset()         { *m = MsgRegisterContributionTypeResponse{} }
+func (m *MsgRegisterContributionTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterContributionTypeResponse) ProtoMessage()    {}
+func (*MsgRegisterContributionTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{11}
 }
-
-type MsgCastReviewVoteResponse struct{}
-
-func (m *MsgCastReviewVoteResponse) Reset()         { *m = MsgCastReviewVoteResponse{} }
-func (m *MsgCastReviewVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCastReviewVoteResponse) ProtoMessage()    {}
-func (m *MsgCastReviewVoteResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterContributionTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCastReviewVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterContributionTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCastReviewVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterContributionTypeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -891,33 +643,36 @@ func (m *MsgCastReviewVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCastReviewVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCastReviewVoteResponse.Merge(m, src)
+func (m *MsgRegisterContributionTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterContributionTypeResponse.Merge(m, src)
 }
-func (m *MsgCastReviewVoteResponse) XXX_Size() int {
+func (m *MsgRegisterContributionTypeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCastReviewVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCastReviewVoteResponse.DiscardUnknown(m)
+func (m *MsgRegisterContributionTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterContributionTypeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCastReviewVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterContributionTypeResponse proto.InternalMessageInfo
 
-// MsgFinalizeReview finalizes a review session (authority or automated via EndBlocker)
-type MsgFinalizeReview struct {
-	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ContributionId uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+// BatchEndorseItem is one vote in a MsgBatchEndorse
+type BatchEndorseItem struct {
+	ContributionId uint64 `protobuf:"varint,1,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	Decision       bool   `protobuf:"varint,2,opt,name=decision,proto3" json:"decision,omitempty"`
 }
 
-func (m *MsgFinalizeReview) Reset()         { *m = MsgFinalizeReview{} }
-func (m *MsgFinalizeReview) String() string { return proto.CompactTextString(m) }
-func (*MsgFinalizeReview) ProtoMessage()    {}
-func (m *MsgFinalizeReview) XXX_Unmarshal(b []byte) error {
+func (m *BatchEndorseItem) Reset()         { *m = BatchEndorseItem{} }
+func (m *BatchEndorseItem) String() string { return proto.CompactTextString(m) }
+func (*BatchEndorseItem) ProtoMessage()    {}
+func (*BatchEndorseItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{12}
+}
+func (m *BatchEndorseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFinalizeReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchEndorseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFinalizeReview.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchEndorseItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -927,46 +682,52 @@ func (m *MsgFinalizeReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgFinalizeReview) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFinalizeReview.Merge(m, src)
+func (m *BatchEndorseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEndorseItem.Merge(m, src)
 }
-func (m *MsgFinalizeReview) XXX_Size() int {
+func (m *BatchEndorseItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFinalizeReview) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFinalizeReview.DiscardUnknown(m)
+func (m *BatchEndorseItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEndorseItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFinalizeReview proto.InternalMessageInfo
+var xxx_messageInfo_BatchEndorseItem proto.InternalMessageInfo
 
-func (m *MsgFinalizeReview) GetAuthority() string {
+func (m *BatchEndorseItem) GetContributionId() uint64 {
 	if m != nil {
-		return m.Authority
+		return m.ContributionId
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgFinalizeReview) GetContributionId() uint64 {
+func (m *BatchEndorseItem) GetDecision() bool {
 	if m != nil {
-		return m.ContributionId
+		return m.Decision
 	}
-	return 0
+	return false
 }
 
-type MsgFinalizeReviewResponse struct {
-	FinalDecision uint32 `protobuf:"varint,1,opt,name=final_decision,json=finalDecision,proto3" json:"final_decision,omitempty"`
-	Accepted      bool   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+// MsgBatchEndorse endorses several contributions in one transaction. Each item
+// is applied as if it were its own MsgEndorse: an item that fails is skipped
+// and its error recorded, without affecting the other items.
+type MsgBatchEndorse struct {
+	Validator string             `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Items     []BatchEndorseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
 }
 
-func (m *MsgFinalizeReviewResponse) Reset()         { *m = MsgFinalizeReviewResponse{} }
-func (m *MsgFinalizeReviewResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgFinalizeReviewResponse) ProtoMessage()    {}
-func (m *MsgFinalizeReviewResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchEndorse) Reset()         { *m = MsgBatchEndorse{} }
+func (m *MsgBatchEndorse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchEndorse) ProtoMessage()    {}
+func (*MsgBatchEndorse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{13}
+}
+func (m *MsgBatchEndorse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFinalizeReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchEndorse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFinalizeReviewResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchEndorse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -976,48 +737,54 @@ func (m *MsgFinalizeReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgFinalizeReviewResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFinalizeReviewResponse.Merge(m, src)
+func (m *MsgBatchEndorse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchEndorse.Merge(m, src)
 }
-func (m *MsgFinalizeReviewResponse) XXX_Size() int {
+func (m *MsgBatchEndorse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFinalizeReviewResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFinalizeReviewResponse.DiscardUnknown(m)
+func (m *MsgBatchEndorse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchEndorse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFinalizeReviewResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchEndorse proto.InternalMessageInfo
 
-func (m *MsgFinalizeReviewResponse) GetFinalDecision() uint32 {
+func (m *MsgBatchEndorse) GetValidator() string {
 	if m != nil {
-		return m.FinalDecision
+		return m.Validator
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgFinalizeReviewResponse) GetAccepted() bool {
+func (m *MsgBatchEndorse) GetItems() []BatchEndorseItem {
 	if m != nil {
-		return m.Accepted
+		return m.Items
 	}
-	return false
+	return nil
 }
 
-// MsgAppealReview files an appeal against a review decision
-type MsgAppealReview struct {
-	Appellant      string `protobuf:"bytes,1,opt,name=appellant,proto3" json:"appellant,omitempty"`
-	ContributionId uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
-	Reason         string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+// BatchEndorseResult is the outcome of one BatchEndorseItem
+type BatchEndorseResult struct {
+	ContributionId uint64 `protobuf:"varint,1,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	Success        bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// verified is set when the vote brought the contribution to quorum
+	Verified bool `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	// error is set when the item was skipped
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *MsgAppealReview) Reset()         { *m = MsgAppealReview{} }
-func (m *MsgAppealReview) String() string { return proto.CompactTextString(m) }
-func (*MsgAppealReview) ProtoMessage()    {}
-func (m *MsgAppealReview) XXX_Unmarshal(b []byte) error {
+func (m *BatchEndorseResult) Reset()         { *m = BatchEndorseResult{} }
+func (m *BatchEndorseResult) String() string { return proto.CompactTextString(m) }
+func (*BatchEndorseResult) ProtoMessage()    {}
+func (*BatchEndorseResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{14}
+}
+func (m *BatchEndorseResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAppealReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchEndorseResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAppealReview.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchEndorseResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1027,52 +794,65 @@ func (m *MsgAppealReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgAppealReview) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAppealReview.Merge(m, src)
+func (m *BatchEndorseResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEndorseResult.Merge(m, src)
 }
-func (m *MsgAppealReview) XXX_Size() int {
+func (m *BatchEndorseResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAppealReview) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAppealReview.DiscardUnknown(m)
+func (m *BatchEndorseResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEndorseResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAppealReview proto.InternalMessageInfo
+var xxx_messageInfo_BatchEndorseResult proto.InternalMessageInfo
 
-func (m *MsgAppealReview) GetAppellant() string {
+func (m *BatchEndorseResult) GetContributionId() uint64 {
 	if m != nil {
-		return m.Appellant
+		return m.ContributionId
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgAppealReview) GetContributionId() uint64 {
+func (m *BatchEndorseResult) GetSuccess() bool {
 	if m != nil {
-		return m.ContributionId
+		return m.Success
 	}
-	return 0
+	return false
 }
 
-func (m *MsgAppealReview) GetReason() string {
+func (m *BatchEndorseResult) GetVerified() bool {
 	if m != nil {
-		return m.Reason
+		return m.Verified
+	}
+	return false
+}
+
+func (m *BatchEndorseResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
 	return ""
 }
 
-type MsgAppealReviewResponse struct {
-	AppealId uint64 `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+// MsgBatchEndorseResponse is the response for MsgBatchEndorse. Results are in
+// the same order as the message items.
+type MsgBatchEndorseResponse struct {
+	Results  []BatchEndorseResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	Endorsed uint32               `protobuf:"varint,2,opt,name=endorsed,proto3" json:"endorsed,omitempty"`
 }
 
-func (m *MsgAppealReviewResponse) Reset()         { *m = MsgAppealReviewResponse{} }
-func (m *MsgAppealReviewResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAppealReviewResponse) ProtoMessage()    {}
-func (m *MsgAppealReviewResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchEndorseResponse) Reset()         { *m = MsgBatchEndorseResponse{} }
+func (m *MsgBatchEndorseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchEndorseResponse) ProtoMessage()    {}
+func (*MsgBatchEndorseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{15}
+}
+func (m *MsgBatchEndorseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAppealReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchEndorseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAppealReviewResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchEndorseResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1082,42 +862,54 @@ func (m *MsgAppealReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgAppealReviewResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAppealReviewResponse.Merge(m, src)
+func (m *MsgBatchEndorseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchEndorseResponse.Merge(m, src)
 }
-func (m *MsgAppealReviewResponse) XXX_Size() int {
+func (m *MsgBatchEndorseResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAppealReviewResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAppealReviewResponse.DiscardUnknown(m)
+func (m *MsgBatchEndorseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchEndorseResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAppealReviewResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchEndorseResponse proto.InternalMessageInfo
 
-func (m *MsgAppealReviewResponse) GetAppealId() uint64 {
+func (m *MsgBatchEndorseResponse) GetResults() []BatchEndorseResult {
 	if m != nil {
-		return m.AppealId
+		return m.Results
+	}
+	return nil
+}
+
+func (m *MsgBatchEndorseResponse) GetEndorsed() uint32 {
+	if m != nil {
+		return m.Endorsed
 	}
 	return 0
 }
 
-// MsgResolveAppeal resolves an appeal (governance only)
-type MsgResolveAppeal struct {
-	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	AppealId      uint64 `protobuf:"varint,2,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
-	Upheld        bool   `protobuf:"varint,3,opt,name=upheld,proto3" json:"upheld,omitempty"`
-	ResolverNotes string `protobuf:"bytes,4,opt,name=resolver_notes,json=resolverNotes,proto3" json:"resolver_notes,omitempty"`
+// MsgUpdatePocFeeParams replaces the 3-layer submission fee parameters,
+// leaving every other param unchanged. Governance only.
+type MsgUpdatePocFeeParams struct {
+	Authority                 string                      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	BaseSubmissionFee         types.Coin                  `protobuf:"bytes,2,opt,name=base_submission_fee,json=baseSubmissionFee,proto3" json:"base_submission_fee"`
+	TargetSubmissionsPerBlock uint32                      `protobuf:"varint,3,opt,name=target_submissions_per_block,json=targetSubmissionsPerBlock,proto3" json:"target_submissions_per_block,omitempty"`
+	MaxCscoreDiscount         cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_cscore_discount,json=maxCscoreDiscount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_cscore_discount"`
+	MinimumSubmissionFee      types.Coin                  `protobuf:"bytes,5,opt,name=minimum_submission_fee,json=minimumSubmissionFee,proto3" json:"minimum_submission_fee"`
 }
 
-func (m *MsgResolveAppeal) Reset()         { *m = MsgResolveAppeal{} }
-func (m *MsgResolveAppeal) String() string { return proto.CompactTextString(m) }
-func (*MsgResolveAppeal) ProtoMessage()    {}
-func (m *MsgResolveAppeal) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdatePocFeeParams) Reset()         { *m = MsgUpdatePocFeeParams{} }
+func (m *MsgUpdatePocFeeParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePocFeeParams) ProtoMessage()    {}
+func (*MsgUpdatePocFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{16}
+}
+func (m *MsgUpdatePocFeeParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgResolveAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdatePocFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgResolveAppeal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdatePocFeeParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1127,57 +919,62 @@ func (m *MsgResolveAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgResolveAppeal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgResolveAppeal.Merge(m, src)
+func (m *MsgUpdatePocFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePocFeeParams.Merge(m, src)
 }
-func (m *MsgResolveAppeal) XXX_Size() int {
+func (m *MsgUpdatePocFeeParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgResolveAppeal) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgResolveAppeal.DiscardUnknown(m)
+func (m *MsgUpdatePocFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePocFeeParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgResolveAppeal proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdatePocFeeParams proto.InternalMessageInfo
 
-func (m *MsgResolveAppeal) GetAuthority() string {
+func (m *MsgUpdatePocFeeParams) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgResolveAppeal) GetAppealId() uint64 {
+func (m *MsgUpdatePocFeeParams) GetBaseSubmissionFee() types.Coin {
 	if m != nil {
-		return m.AppealId
+		return m.BaseSubmissionFee
 	}
-	return 0
+	return types.Coin{}
 }
 
-func (m *MsgResolveAppeal) GetUpheld() bool {
+func (m *MsgUpdatePocFeeParams) GetTargetSubmissionsPerBlock() uint32 {
 	if m != nil {
-		return m.Upheld
+		return m.TargetSubmissionsPerBlock
 	}
-	return false
+	return 0
 }
 
-func (m *MsgResolveAppeal) GetResolverNotes() string {
+func (m *MsgUpdatePocFeeParams) GetMinimumSubmissionFee() types.Coin {
 	if m != nil {
-		return m.ResolverNotes
+		return m.MinimumSubmissionFee
 	}
-	return ""
+	return types.Coin{}
 }
 
-type MsgResolveAppealResponse struct{}
+// MsgUpdatePocFeeParamsResponse is the response for MsgUpdatePocFeeParams
+type MsgUpdatePocFeeParamsResponse struct {
+}
 
-func (m *MsgResolveAppealResponse) Reset()         { *m = MsgResolveAppealResponse{} }
-func (m *MsgResolveAppealResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgResolveAppealResponse) ProtoMessage()    {}
-func (m *MsgResolveAppealResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdatePocFeeParamsResponse) Reset()         { *m = MsgUpdatePocFeeParamsResponse{} }
+func (m *MsgUpdatePocFeeParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePocFeeParamsResponse) ProtoMessage()    {}
+func (*MsgUpdatePocFeeParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{17}
+}
+func (m *MsgUpdatePocFeeParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgResolveAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdatePocFeeParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgResolveAppealResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdatePocFeeParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1187,846 +984,6266 @@ func (m *MsgResolveAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgResolveAppealResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgResolveAppealResponse.Merge(m, src)
+func (m *MsgUpdatePocFeeParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePocFeeParamsResponse.Merge(m, src)
 }
-func (m *MsgResolveAppealResponse) XXX_Size() int {
+func (m *MsgUpdatePocFeeParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgResolveAppealResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgResolveAppealResponse.DiscardUnknown(m)
+func (m *MsgUpdatePocFeeParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePocFeeParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgResolveAppealResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdatePocFeeParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSubmitContribution)(nil), "pos.poc.v1.MsgSubmitContribution")
-	proto.RegisterType((*MsgSubmitContributionResponse)(nil), "pos.poc.v1.MsgSubmitContributionResponse")
-	proto.RegisterType((*MsgEndorse)(nil), "pos.poc.v1.MsgEndorse")
-	proto.RegisterType((*MsgEndorseResponse)(nil), "pos.poc.v1.MsgEndorseResponse")
-	proto.RegisterType((*MsgWithdrawPOCRewards)(nil), "pos.poc.v1.MsgWithdrawPOCRewards")
-	proto.RegisterType((*MsgWithdrawPOCRewardsResponse)(nil), "pos.poc.v1.MsgWithdrawPOCRewardsResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "pos.poc.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pos.poc.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgSubmitSimilarityCommitment)(nil), "pos.poc.v1.MsgSubmitSimilarityCommitment")
-	proto.RegisterType((*MsgSubmitSimilarityCommitmentResponse)(nil), "pos.poc.v1.MsgSubmitSimilarityCommitmentResponse")
-	proto.RegisterType((*MsgStartReview)(nil), "pos.poc.v1.MsgStartReview")
-	proto.RegisterType((*MsgStartReviewResponse)(nil), "pos.poc.v1.MsgStartReviewResponse")
-	proto.RegisterType((*MsgCastReviewVote)(nil), "pos.poc.v1.MsgCastReviewVote")
-	proto.RegisterType((*MsgCastReviewVoteResponse)(nil), "pos.poc.v1.MsgCastReviewVoteResponse")
-	proto.RegisterType((*MsgFinalizeReview)(nil), "pos.poc.v1.MsgFinalizeReview")
-	proto.RegisterType((*MsgFinalizeReviewResponse)(nil), "pos.poc.v1.MsgFinalizeReviewResponse")
-	proto.RegisterType((*MsgAppealReview)(nil), "pos.poc.v1.MsgAppealReview")
-	proto.RegisterType((*MsgAppealReviewResponse)(nil), "pos.poc.v1.MsgAppealReviewResponse")
-	proto.RegisterType((*MsgResolveAppeal)(nil), "pos.poc.v1.MsgResolveAppeal")
-	proto.RegisterType((*MsgResolveAppealResponse)(nil), "pos.poc.v1.MsgResolveAppealResponse")
+// MsgAddExemptAddress adds one address to Params.ExemptAddresses, leaving the
+// rest of the list unchanged. Governance only.
+type MsgAddExemptAddress struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("pos/poc/v1/tx.proto", fileDescriptor_fef83dba41b82242) }
-
-var fileDescriptor_fef83dba41b82242 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x8c, 0x93, 0xdb, 0x4a, 0xf3, 0x40,
-	0x10, 0xc7, 0xf9, 0x3e, 0xc1, 0xc2, 0x7a, 0xa2, 0xab, 0x28, 0xae, 0x07, 0xf0, 0x74, 0xe1, 0x4d,
-	0x42, 0xf5, 0x09, 0x6a, 0xd0, 0xbb, 0x62, 0x49, 0xb0, 0x8a, 0x37, 0xb2, 0x69, 0x96, 0xb8, 0x90,
-	0xcd, 0x2c, 0xbb, 0xdb, 0xf4, 0xf0, 0x04, 0x3e, 0xb6, 0x34, 0x49, 0xd3, 0x1c, 0x6a, 0xf5, 0x26,
-	0x64, 0xe7, 0xf7, 0x9f, 0xff, 0x0c, 0x33, 0x0c, 0xda, 0x97, 0xa0, 0x6d, 0x09, 0x43, 0x3b, 0xe9,
-	0xd8, 0x66, 0x62, 0x49, 0x05, 0x06, 0x30, 0x92, 0xa0, 0x2d, 0x09, 0x43, 0x2b, 0xe9, 0x90, 0x36,
-	0x15, 0x3c, 0x06, 0x3b, 0xfd, 0x66, 0x98, 0x1c, 0x0d, 0x41, 0x0b, 0xd0, 0xb6, 0xd0, 0xe1, 0x3c,
-	0x4d, 0xe8, 0x30, 0x07, 0xc7, 0x19, 0xf8, 0x48, 0x5f, 0x76, 0xf6, 0xc8, 0xd1, 0x41, 0x08, 0x21,
-	0x64, 0xf1, 0xf9, 0xdf, 0xc2, 0xa9, 0x54, 0x5d, 0x52, 0x45, 0x45, 0x2e, 0xbf, 0xfb, 0x6a, 0xa1,
-	0x8d, 0x9e, 0x0e, 0xb1, 0x8f, 0xb0, 0x37, 0xf2, 0x05, 0x37, 0x0e, 0xc4, 0x46, 0x71, 0x7f, 0x64,
-	0x38, 0xc4, 0xf8, 0xc2, 0x5a, 0x36, 0x68, 0xf5, 0x74, 0xd8, 0x94, 0x90, 0xdb, 0x5f, 0x25, 0x2e,
-	0xd3, 0x12, 0x62, 0xcd, 0x70, 0x17, 0xb5, 0x1e, 0xe3, 0x00, 0x94, 0x66, 0xf8, 0xb0, 0x96, 0x95,
-	0xc7, 0xc9, 0xf9, 0xea, 0x78, 0x61, 0xe1, 0x23, 0xfc, 0xca, 0xcd, 0x67, 0xa0, 0xe8, 0xb8, 0xff,
-	0xec, 0xb8, 0x6c, 0x4c, 0x55, 0xa0, 0x1b, 0x6d, 0x36, 0x25, 0x8d, 0x36, 0x9b, 0x92, 0xa2, 0x46,
-	0x1f, 0x6d, 0xbf, 0xc8, 0x80, 0x1a, 0xd6, 0x4f, 0x07, 0x85, 0x4f, 0x6a, 0xa9, 0x65, 0x48, 0xae,
-	0xd6, 0xc0, 0xc2, 0x71, 0x86, 0x48, 0x36, 0x16, 0x8f, 0x0b, 0x1e, 0x51, 0xc5, 0xcd, 0xd4, 0x01,
-	0x21, 0xb8, 0x11, 0x2c, 0x36, 0x78, 0xf5, 0x04, 0x57, 0x49, 0x49, 0xe7, 0xcf, 0xd2, 0xa2, 0x76,
-	0x0f, 0x6d, 0x79, 0x86, 0x2a, 0xe3, 0xb2, 0x84, 0xb3, 0x31, 0x26, 0x75, 0x87, 0x25, 0x23, 0x97,
-	0x3f, 0xb3, 0xc2, 0x6e, 0x80, 0x76, 0x1d, 0xaa, 0xf3, 0xe8, 0x00, 0x0c, 0xc3, 0x67, 0xb5, 0xac,
-	0x2a, 0x26, 0x37, 0x6b, 0x71, 0xd9, 0xf7, 0x89, 0xc7, 0x34, 0xe2, 0x33, 0x96, 0x77, 0x5a, 0xf7,
-	0xad, 0xe2, 0x86, 0x6f, 0x15, 0x97, 0x97, 0xd9, 0x95, 0x92, 0xd1, 0x28, 0x77, 0xad, 0x2f, 0xb3,
-	0x0c, 0x1b, 0xcb, 0x2c, 0xc3, 0xc2, 0xd1, 0x43, 0x3b, 0x2e, 0xd3, 0x10, 0x25, 0x2c, 0xc3, 0xf8,
-	0xb4, 0x96, 0x55, 0xa1, 0xe4, 0x7a, 0x1d, 0x5d, 0x98, 0x92, 0xff, 0x6f, 0xff, 0x1e, 0xda, 0xef,
-	0x7b, 0xf3, 0x2b, 0x9d, 0xa4, 0x77, 0x6a, 0xa6, 0x92, 0x69, 0x7f, 0x33, 0x3d, 0xd2, 0xfb, 0xef,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xff, 0x8e, 0xb6, 0x3d, 0x04, 0x00, 0x00,
+func (m *MsgAddExemptAddress) Reset()         { *m = MsgAddExemptAddress{} }
+func (m *MsgAddExemptAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgAddExemptAddress) ProtoMessage()    {}
+func (*MsgAddExemptAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{18}
+}
+func (m *MsgAddExemptAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddExemptAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddExemptAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddExemptAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddExemptAddress.Merge(m, src)
+}
+func (m *MsgAddExemptAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddExemptAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddExemptAddress.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgAddExemptAddress proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgAddExemptAddress) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SubmitContribution creates a new contribution submission
-	SubmitContribution(ctx context.Context, in *MsgSubmitContribution, opts ...grpc.CallOption) (*MsgSubmitContributionResponse, error)
-	// Endorse allows a validator to endorse or reject a contribution
-	Endorse(ctx context.Context, in *MsgEndorse, opts ...grpc.CallOption) (*MsgEndorseResponse, error)
-	// WithdrawPOCRewards withdraws accumulated PoC credits as coins
-	WithdrawPOCRewards(ctx context.Context, in *MsgWithdrawPOCRewards, opts ...grpc.CallOption) (*MsgWithdrawPOCRewardsResponse, error)
-	// UpdateParams updates the module parameters (governance only)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// SubmitSimilarityCommitment submits an oracle-signed similarity commitment
-	SubmitSimilarityCommitment(ctx context.Context, in *MsgSubmitSimilarityCommitment, opts ...grpc.CallOption) (*MsgSubmitSimilarityCommitmentResponse, error)
-	// StartReview initiates a human review session for a contribution
-	StartReview(ctx context.Context, in *MsgStartReview, opts ...grpc.CallOption) (*MsgStartReviewResponse, error)
-	// CastReviewVote allows an assigned reviewer to cast their vote
-	CastReviewVote(ctx context.Context, in *MsgCastReviewVote, opts ...grpc.CallOption) (*MsgCastReviewVoteResponse, error)
-	// FinalizeReview finalizes a review session
-	FinalizeReview(ctx context.Context, in *MsgFinalizeReview, opts ...grpc.CallOption) (*MsgFinalizeReviewResponse, error)
-	// AppealReview files an appeal against a review decision
-	AppealReview(ctx context.Context, in *MsgAppealReview, opts ...grpc.CallOption) (*MsgAppealReviewResponse, error)
-	// ResolveAppeal resolves an appeal (governance only)
-	ResolveAppeal(ctx context.Context, in *MsgResolveAppeal, opts ...grpc.CallOption) (*MsgResolveAppealResponse, error)
+func (m *MsgAddExemptAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgAddExemptAddressResponse is the response for MsgAddExemptAddress
+type MsgAddExemptAddressResponse struct {
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddExemptAddressResponse) Reset()         { *m = MsgAddExemptAddressResponse{} }
+func (m *MsgAddExemptAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddExemptAddressResponse) ProtoMessage()    {}
+func (*MsgAddExemptAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{19}
+}
+func (m *MsgAddExemptAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddExemptAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddExemptAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddExemptAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddExemptAddressResponse.Merge(m, src)
+}
+func (m *MsgAddExemptAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddExemptAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddExemptAddressResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitContribution(ctx context.Context, in *MsgSubmitContribution, opts ...grpc.CallOption) (*MsgSubmitContributionResponse, error) {
-	out := new(MsgSubmitContributionResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/SubmitContribution", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddExemptAddressResponse proto.InternalMessageInfo
+
+// MsgRemoveExemptAddress removes one address from Params.ExemptAddresses,
+// leaving the rest of the list unchanged. Governance only.
+type MsgRemoveExemptAddress struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRemoveExemptAddress) Reset()         { *m = MsgRemoveExemptAddress{} }
+func (m *MsgRemoveExemptAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveExemptAddress) ProtoMessage()    {}
+func (*MsgRemoveExemptAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{20}
+}
+func (m *MsgRemoveExemptAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveExemptAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveExemptAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveExemptAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveExemptAddress.Merge(m, src)
+}
+func (m *MsgRemoveExemptAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveExemptAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveExemptAddress.DiscardUnknown(m)
 }
 
-func (c *msgClient) Endorse(ctx context.Context, in *MsgEndorse, opts ...grpc.CallOption) (*MsgEndorseResponse, error) {
-	out := new(MsgEndorseResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/Endorse", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveExemptAddress proto.InternalMessageInfo
+
+func (m *MsgRemoveExemptAddress) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WithdrawPOCRewards(ctx context.Context, in *MsgWithdrawPOCRewards, opts ...grpc.CallOption) (*MsgWithdrawPOCRewardsResponse, error) {
-	out := new(MsgWithdrawPOCRewardsResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/WithdrawPOCRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveExemptAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgRemoveExemptAddressResponse is the response for MsgRemoveExemptAddress
+type MsgRemoveExemptAddressResponse struct {
+}
+
+func (m *MsgRemoveExemptAddressResponse) Reset()         { *m = MsgRemoveExemptAddressResponse{} }
+func (m *MsgRemoveExemptAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveExemptAddressResponse) ProtoMessage()    {}
+func (*MsgRemoveExemptAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{21}
+}
+func (m *MsgRemoveExemptAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveExemptAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveExemptAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveExemptAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveExemptAddressResponse.Merge(m, src)
+}
+func (m *MsgRemoveExemptAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveExemptAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveExemptAddressResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitSimilarityCommitment(ctx context.Context, in *MsgSubmitSimilarityCommitment, opts ...grpc.CallOption) (*MsgSubmitSimilarityCommitmentResponse, error) {
-	out := new(MsgSubmitSimilarityCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/SubmitSimilarityCommitment", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveExemptAddressResponse proto.InternalMessageInfo
+
+// MsgSubmitFraudProof proves that a validator approved a fraudulent
+// contribution. If the proof verifies, the contribution is invalidated, every
+// approving validator is slashed and the challenger receives a share of each
+// slashed amount.
+type MsgSubmitFraudProof struct {
+	Challenger     string `protobuf:"bytes,1,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	ContributionId uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	// validator is the bech32 validator operator address of the endorser
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	ProofType uint32 `protobuf:"varint,4,opt,name=proof_type,json=proofType,proto3" json:"proof_type,omitempty"`
+	ProofData []byte `protobuf:"bytes,5,opt,name=proof_data,json=proofData,proto3" json:"proof_data,omitempty"`
+}
+
+func (m *MsgSubmitFraudProof) Reset()         { *m = MsgSubmitFraudProof{} }
+func (m *MsgSubmitFraudProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudProof) ProtoMessage()    {}
+func (*MsgSubmitFraudProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{22}
+}
+func (m *MsgSubmitFraudProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraudProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitFraudProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudProof.Merge(m, src)
+}
+func (m *MsgSubmitFraudProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraudProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudProof.DiscardUnknown(m)
 }
 
-func (c *msgClient) StartReview(ctx context.Context, in *MsgStartReview, opts ...grpc.CallOption) (*MsgStartReviewResponse, error) {
-	out := new(MsgStartReviewResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/StartReview", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitFraudProof proto.InternalMessageInfo
+
+func (m *MsgSubmitFraudProof) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CastReviewVote(ctx context.Context, in *MsgCastReviewVote, opts ...grpc.CallOption) (*MsgCastReviewVoteResponse, error) {
-	out := new(MsgCastReviewVoteResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/CastReviewVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetContributionId() uint64 {
+	if m != nil {
+		return m.ContributionId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) FinalizeReview(ctx context.Context, in *MsgFinalizeReview, opts ...grpc.CallOption) (*MsgFinalizeReviewResponse, error) {
-	out := new(MsgFinalizeReviewResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/FinalizeReview", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AppealReview(ctx context.Context, in *MsgAppealReview, opts ...grpc.CallOption) (*MsgAppealReviewResponse, error) {
-	out := new(MsgAppealReviewResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/AppealReview", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetProofType() uint32 {
+	if m != nil {
+		return m.ProofType
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ResolveAppeal(ctx context.Context, in *MsgResolveAppeal, opts ...grpc.CallOption) (*MsgResolveAppealResponse, error) {
-	out := new(MsgResolveAppealResponse)
-	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/ResolveAppeal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetProofData() []byte {
+	if m != nil {
+		return m.ProofData
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SubmitContribution creates a new contribution submission
-	SubmitContribution(context.Context, *MsgSubmitContribution) (*MsgSubmitContributionResponse, error)
-	// Endorse allows a validator to endorse or reject a contribution
-	Endorse(context.Context, *MsgEndorse) (*MsgEndorseResponse, error)
-	// WithdrawPOCRewards withdraws accumulated PoC credits as coins
-	WithdrawPOCRewards(context.Context, *MsgWithdrawPOCRewards) (*MsgWithdrawPOCRewardsResponse, error)
-	// UpdateParams updates the module parameters (governance only)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// SubmitSimilarityCommitment submits an oracle-signed similarity commitment
-	SubmitSimilarityCommitment(context.Context, *MsgSubmitSimilarityCommitment) (*MsgSubmitSimilarityCommitmentResponse, error)
-	// StartReview initiates a human review session for a contribution
-	StartReview(context.Context, *MsgStartReview) (*MsgStartReviewResponse, error)
-	// CastReviewVote allows an assigned reviewer to cast their vote
-	CastReviewVote(context.Context, *MsgCastReviewVote) (*MsgCastReviewVoteResponse, error)
-	// FinalizeReview finalizes a review session
-	FinalizeReview(context.Context, *MsgFinalizeReview) (*MsgFinalizeReviewResponse, error)
-	// AppealReview files an appeal against a review decision
-	AppealReview(context.Context, *MsgAppealReview) (*MsgAppealReviewResponse, error)
-	// ResolveAppeal resolves an appeal (governance only)
-	ResolveAppeal(context.Context, *MsgResolveAppeal) (*MsgResolveAppealResponse, error)
+// MsgSubmitFraudProofResponse is the response for MsgSubmitFraudProof. The
+// amounts are totals over every validator the challenger was paid for.
+type MsgSubmitFraudProofResponse struct {
+	SlashedAmount    cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_amount"`
+	ChallengerReward cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=challenger_reward,json=challengerReward,proto3,customtype=cosmossdk.io/math.Int" json:"challenger_reward"`
+	// validators lists the approving validators the challenger was paid for
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSubmitFraudProofResponse) Reset()         { *m = MsgSubmitFraudProofResponse{} }
+func (m *MsgSubmitFraudProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudProofResponse) ProtoMessage()    {}
+func (*MsgSubmitFraudProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{23}
 }
-
-func (*UnimplementedMsgServer) SubmitContribution(ctx context.Context, req *MsgSubmitContribution) (*MsgSubmitContributionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitContribution not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Endorse(ctx context.Context, req *MsgEndorse) (*MsgEndorseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Endorse not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawPOCRewards(ctx context.Context, req *MsgWithdrawPOCRewards) (*MsgWithdrawPOCRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPOCRewards not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudProofResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SubmitSimilarityCommitment(ctx context.Context, req *MsgSubmitSimilarityCommitment) (*MsgSubmitSimilarityCommitmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSimilarityCommitment not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudProofResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) StartReview(ctx context.Context, req *MsgStartReview) (*MsgStartReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartReview not implemented")
+
+var xxx_messageInfo_MsgSubmitFraudProofResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitFraudProofResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) CastReviewVote(ctx context.Context, req *MsgCastReviewVote) (*MsgCastReviewVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CastReviewVote not implemented")
+
+// MsgUpdateEndorsementParams replaces the endorsement thresholds applied on
+// top of QuorumPct. Contributions already verified keep the quorum they were
+// verified against. Governance only.
+type MsgUpdateEndorsementParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// min_endorsements is the number of distinct validators that must approve
+	MinEndorsements uint32 `protobuf:"varint,2,opt,name=min_endorsements,json=minEndorsements,proto3" json:"min_endorsements,omitempty"`
+	// weighting is "power" (bonded tokens) or "equal" (one validator, one vote)
+	Weighting string `protobuf:"bytes,3,opt,name=weighting,proto3" json:"weighting,omitempty"`
 }
-func (*UnimplementedMsgServer) FinalizeReview(ctx context.Context, req *MsgFinalizeReview) (*MsgFinalizeReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalizeReview not implemented")
+
+func (m *MsgUpdateEndorsementParams) Reset()         { *m = MsgUpdateEndorsementParams{} }
+func (m *MsgUpdateEndorsementParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEndorsementParams) ProtoMessage()    {}
+func (*MsgUpdateEndorsementParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{24}
 }
-func (*UnimplementedMsgServer) AppealReview(ctx context.Context, req *MsgAppealReview) (*MsgAppealReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
+func (m *MsgUpdateEndorsementParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ResolveAppeal(ctx context.Context, req *MsgResolveAppeal) (*MsgResolveAppealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveAppeal not implemented")
+func (m *MsgUpdateEndorsementParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEndorsementParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUpdateEndorsementParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEndorsementParams.Merge(m, src)
+}
+func (m *MsgUpdateEndorsementParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEndorsementParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEndorsementParams.DiscardUnknown(m)
 }
 
-func _Msg_SubmitContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitContribution)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitContribution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/SubmitContribution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitContribution(ctx, req.(*MsgSubmitContribution))
+var xxx_messageInfo_MsgUpdateEndorsementParams proto.InternalMessageInfo
+
+func (m *MsgUpdateEndorsementParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Endorse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEndorse)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Endorse(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/Endorse",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Endorse(ctx, req.(*MsgEndorse))
+func (m *MsgUpdateEndorsementParams) GetMinEndorsements() uint32 {
+	if m != nil {
+		return m.MinEndorsements
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_WithdrawPOCRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawPOCRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawPOCRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/WithdrawPOCRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawPOCRewards(ctx, req.(*MsgWithdrawPOCRewards))
+func (m *MsgUpdateEndorsementParams) GetWeighting() string {
+	if m != nil {
+		return m.Weighting
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateEndorsementParamsResponse is the response for MsgUpdateEndorsementParams
+type MsgUpdateEndorsementParamsResponse struct {
 }
 
-func _Msg_SubmitSimilarityCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSimilarityCommitment)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSimilarityCommitment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/SubmitSimilarityCommitment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSimilarityCommitment(ctx, req.(*MsgSubmitSimilarityCommitment))
+func (m *MsgUpdateEndorsementParamsResponse) Reset()         { *m = MsgUpdateEndorsementParamsResponse{} }
+func (m *MsgUpdateEndorsementParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEndorsementParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateEndorsementParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{25}
+}
+func (m *MsgUpdateEndorsementParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEndorsementParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEndorsementParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateEndorsementParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEndorsementParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateEndorsementParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEndorsementParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEndorsementParamsResponse.DiscardUnknown(m)
 }
 
-func _Msg_StartReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartReview)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartReview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/StartReview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartReview(ctx, req.(*MsgStartReview))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateEndorsementParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateRejectionRefundParams sets the rejected contribution refund ratio.
+// Governance only.
+type MsgUpdateRejectionRefundParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// refund_ratio is the share of the fee's reward pool portion returned to
+	// the contributor, in [0, 1]. Zero disables refunds.
+	RefundRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=refund_ratio,json=refundRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"refund_ratio"`
 }
 
-func _Msg_CastReviewVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCastReviewVote)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CastReviewVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/CastReviewVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CastReviewVote(ctx, req.(*MsgCastReviewVote))
+func (m *MsgUpdateRejectionRefundParams) Reset()         { *m = MsgUpdateRejectionRefundParams{} }
+func (m *MsgUpdateRejectionRefundParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRejectionRefundParams) ProtoMessage()    {}
+func (*MsgUpdateRejectionRefundParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{26}
+}
+func (m *MsgUpdateRejectionRefundParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRejectionRefundParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRejectionRefundParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateRejectionRefundParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRejectionRefundParams.Merge(m, src)
+}
+func (m *MsgUpdateRejectionRefundParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRejectionRefundParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRejectionRefundParams.DiscardUnknown(m)
 }
 
-func _Msg_FinalizeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinalizeReview)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FinalizeReview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/FinalizeReview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FinalizeReview(ctx, req.(*MsgFinalizeReview))
+var xxx_messageInfo_MsgUpdateRejectionRefundParams proto.InternalMessageInfo
+
+func (m *MsgUpdateRejectionRefundParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AppealReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAppealReview)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AppealReview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/AppealReview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AppealReview(ctx, req.(*MsgAppealReview))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateRejectionRefundParamsResponse is the response for MsgUpdateRejectionRefundParams
+type MsgUpdateRejectionRefundParamsResponse struct {
 }
 
-func _Msg_ResolveAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResolveAppeal)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateRejectionRefundParamsResponse) Reset() {
+	*m = MsgUpdateRejectionRefundParamsResponse{}
+}
+func (m *MsgUpdateRejectionRefundParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRejectionRefundParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateRejectionRefundParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{27}
+}
+func (m *MsgUpdateRejectionRefundParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRejectionRefundParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRejectionRefundParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResolveAppeal(ctx, in)
+}
+func (m *MsgUpdateRejectionRefundParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRejectionRefundParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateRejectionRefundParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRejectionRefundParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRejectionRefundParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRejectionRefundParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateCScoreNamespaceParams sets the per-type C-Score namespace used for
+// the fee discount. Governance only.
+type MsgUpdateCScoreNamespaceParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// enable_type_scoped_discount derives the discount from credits earned in
+	// the submitted contribution type instead of the global C-Score
+	EnableTypeScopedDiscount bool `protobuf:"varint,2,opt,name=enable_type_scoped_discount,json=enableTypeScopedDiscount,proto3" json:"enable_type_scoped_discount,omitempty"`
+	// cross_category_factor_bps is the weight, in basis points, of credits
+	// earned in other contribution types
+	CrossCategoryFactorBps uint32 `protobuf:"varint,3,opt,name=cross_category_factor_bps,json=crossCategoryFactorBps,proto3" json:"cross_category_factor_bps,omitempty"`
+}
+
+func (m *MsgUpdateCScoreNamespaceParams) Reset()         { *m = MsgUpdateCScoreNamespaceParams{} }
+func (m *MsgUpdateCScoreNamespaceParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCScoreNamespaceParams) ProtoMessage()    {}
+func (*MsgUpdateCScoreNamespaceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{28}
+}
+func (m *MsgUpdateCScoreNamespaceParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCScoreNamespaceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCScoreNamespaceParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.poc.v1.Msg/ResolveAppeal",
+}
+func (m *MsgUpdateCScoreNamespaceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCScoreNamespaceParams.Merge(m, src)
+}
+func (m *MsgUpdateCScoreNamespaceParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCScoreNamespaceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCScoreNamespaceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCScoreNamespaceParams proto.InternalMessageInfo
+
+func (m *MsgUpdateCScoreNamespaceParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResolveAppeal(ctx, req.(*MsgResolveAppeal))
+	return ""
+}
+
+func (m *MsgUpdateCScoreNamespaceParams) GetEnableTypeScopedDiscount() bool {
+	if m != nil {
+		return m.EnableTypeScopedDiscount
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pos.poc.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitContribution",
-			Handler:    _Msg_SubmitContribution_Handler,
-		},
-		{
-			MethodName: "Endorse",
-			Handler:    _Msg_Endorse_Handler,
-		},
-		{
-			MethodName: "WithdrawPOCRewards",
-			Handler:    _Msg_WithdrawPOCRewards_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "SubmitSimilarityCommitment",
-			Handler:    _Msg_SubmitSimilarityCommitment_Handler,
-		},
-		{
-			MethodName: "StartReview",
-			Handler:    _Msg_StartReview_Handler,
-		},
-		{
-			MethodName: "CastReviewVote",
-			Handler:    _Msg_CastReviewVote_Handler,
-		},
-		{
-			MethodName: "FinalizeReview",
-			Handler:    _Msg_FinalizeReview_Handler,
-		},
-		{
-			MethodName: "AppealReview",
-			Handler:    _Msg_AppealReview_Handler,
-		},
-		{
-			MethodName: "ResolveAppeal",
-			Handler:    _Msg_ResolveAppeal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pos/poc/v1/tx.proto",
+func (m *MsgUpdateCScoreNamespaceParams) GetCrossCategoryFactorBps() uint32 {
+	if m != nil {
+		return m.CrossCategoryFactorBps
+	}
+	return 0
 }
 
-func (m *MsgSubmitContribution) Marshal() (dAtA []byte, err error) {
+// MsgUpdateCScoreNamespaceParamsResponse is the response for MsgUpdateCScoreNamespaceParams
+type MsgUpdateCScoreNamespaceParamsResponse struct {
+}
+
+func (m *MsgUpdateCScoreNamespaceParamsResponse) Reset() {
+	*m = MsgUpdateCScoreNamespaceParamsResponse{}
+}
+func (m *MsgUpdateCScoreNamespaceParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCScoreNamespaceParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateCScoreNamespaceParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef83dba41b82242, []int{29}
+}
+func (m *MsgUpdateCScoreNamespaceParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCScoreNamespaceParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCScoreNamespaceParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCScoreNamespaceParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCScoreNamespaceParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateCScoreNamespaceParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCScoreNamespaceParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCScoreNamespaceParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCScoreNamespaceParamsResponse proto.InternalMessageInfo
+
+// MsgSubmitSimilarityCommitment submits an oracle-signed similarity commitment for a contribution
+type MsgSubmitSimilarityCommitment struct {
+	// submitter is the address submitting this commitment (must be an allowlisted oracle)
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// contribution_id is the contribution being analyzed
+	ContributionID uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	// compact_data_json is the JSON-encoded SimilarityCompactData
+	CompactDataJson []byte `protobuf:"bytes,3,opt,name=compact_data_json,json=compactDataJson,proto3" json:"compact_data_json,omitempty"`
+	// oracle_signature_compact is the oracle's signature over compact_data_json
+	OracleSignatureCompact []byte `protobuf:"bytes,4,opt,name=oracle_signature_compact,json=oracleSignatureCompact,proto3" json:"oracle_signature_compact,omitempty"`
+	// commitment_hash_full is the SHA-256 hash of the full similarity vector
+	CommitmentHashFull []byte `protobuf:"bytes,5,opt,name=commitment_hash_full,json=commitmentHashFull,proto3" json:"commitment_hash_full,omitempty"`
+	// oracle_signature_full is the oracle's signature over commitment_hash_full
+	OracleSignatureFull []byte `protobuf:"bytes,6,opt,name=oracle_signature_full,json=oracleSignatureFull,proto3" json:"oracle_signature_full,omitempty"`
+}
+
+func (m *MsgSubmitSimilarityCommitment) Reset()         { *m = MsgSubmitSimilarityCommitment{} }
+func (m *MsgSubmitSimilarityCommitment) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSimilarityCommitment) ProtoMessage()    {}
+func (m *MsgSubmitSimilarityCommitment) XXX_Unmarshal(b []byte) error { return m.Unmarshal(b) }
+func (m *MsgSubmitSimilarityCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSimilarityCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSimilarityCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSimilarityCommitment.Merge(m, src)
+}
+func (m *MsgSubmitSimilarityCommitment) XXX_Size() int  { return m.Size() }
+func (m *MsgSubmitSimilarityCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSimilarityCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitSimilarityCommitment proto.InternalMessageInfo
+
+func (m *MsgSubmitSimilarityCommitment) GetSubmitter() string {
+	if m != nil { return m.Submitter }
+	return ""
+}
+func (m *MsgSubmitSimilarityCommitment) GetContributionID() uint64 {
+	if m != nil { return m.ContributionID }
+	return 0
+}
+func (m *MsgSubmitSimilarityCommitment) GetCompactDataJson() []byte {
+	if m != nil { return m.CompactDataJson }
+	return nil
+}
+func (m *MsgSubmitSimilarityCommitment) GetOracleSignatureCompact() []byte {
+	if m != nil { return m.OracleSignatureCompact }
+	return nil
+}
+func (m *MsgSubmitSimilarityCommitment) GetCommitmentHashFull() []byte {
+	if m != nil { return m.CommitmentHashFull }
+	return nil
+}
+func (m *MsgSubmitSimilarityCommitment) GetOracleSignatureFull() []byte {
+	if m != nil { return m.OracleSignatureFull }
+	return nil
+}
+
+// Marshal/Unmarshal for MsgSubmitSimilarityCommitment
+func (m *MsgSubmitSimilarityCommitment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
+	if err != nil { return nil, err }
 	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitContribution) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitSimilarityCommitment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-
-func (m *MsgSubmitContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitSimilarityCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CanonicalSpecVersion != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CanonicalSpecVersion))
+	if len(m.OracleSignatureFull) > 0 {
+		i -= len(m.OracleSignatureFull)
+		copy(dAtA[i:], m.OracleSignatureFull)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleSignatureFull)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
-	if len(m.CanonicalHash) > 0 {
-		i -= len(m.CanonicalHash)
-		copy(dAtA[i:], m.CanonicalHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CanonicalHash)))
+	if len(m.CommitmentHashFull) > 0 {
+		i -= len(m.CommitmentHashFull)
+		copy(dAtA[i:], m.CommitmentHashFull)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CommitmentHashFull)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+	if len(m.OracleSignatureCompact) > 0 {
+		i -= len(m.OracleSignatureCompact)
+		copy(dAtA[i:], m.OracleSignatureCompact)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleSignatureCompact)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+	if len(m.CompactDataJson) > 0 {
+		i -= len(m.CompactDataJson)
+		copy(dAtA[i:], m.CompactDataJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompactDataJson)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Ctype) > 0 {
-		i -= len(m.Ctype)
-		copy(dAtA[i:], m.Ctype)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ctype)))
+	if m.ContributionID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionID))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Contributor) > 0 {
-		i -= len(m.Contributor)
-		copy(dAtA[i:], m.Contributor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contributor)))
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *MsgSubmitContributionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitContributionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitContributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *MsgSubmitSimilarityCommitment) Size() (n int) {
+	if m == nil { return 0 }
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	l = len(m.Submitter)
+	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
+	if m.ContributionID != 0 { n += 1 + sovTx(uint64(m.ContributionID)) }
+	l = len(m.CompactDataJson)
+	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
+	l = len(m.OracleSignatureCompact)
+	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
+	l = len(m.CommitmentHashFull)
+	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
+	l = len(m.OracleSignatureFull)
+	if l > 0 { n += 1 + l + sovTx(uint64(l)) }
+	return n
+}
+func (m *MsgSubmitSimilarityCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 { return ErrIntOverflowTx }
+			if iNdEx >= l { return io.ErrUnexpectedEOF }
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 { break }
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 2 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 { return ErrIntOverflowTx }
+				if iNdEx >= l { return io.ErrUnexpectedEOF }
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 { break }
+			}
+			if byteLen < 0 { return ErrInvalidLengthTx }
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 { return ErrInvalidLengthTx }
+			if postIndex > l { return io.ErrUnexpectedEOF }
+			switch fieldNum {
+			case 1:
+				m.Submitter = string(dAtA[iNdEx:postIndex])
+			case 3:
+				m.CompactDataJson = append(m.CompactDataJson[:0], dAtA[iNdEx:postIndex]...)
+			case 4:
+				m.OracleSignatureCompact = append(m.OracleSignatureCompact[:0], dAtA[iNdEx:postIndex]...)
+			case 5:
+				m.CommitmentHashFull = append(m.CommitmentHashFull[:0], dAtA[iNdEx:postIndex]...)
+			case 6:
+				m.OracleSignatureFull = append(m.OracleSignatureFull[:0], dAtA[iNdEx:postIndex]...)
+			default:
+				iNdEx = preIndex
+				skippy, err := skipTx(dAtA[iNdEx:])
+				if err != nil { return err }
+				if (skippy < 0) || (iNdEx+skippy) < 0 { return ErrInvalidLengthTx }
+				if (iNdEx + skippy) > l { return io.ErrUnexpectedEOF }
+				iNdEx = preIndex + skippy
+				continue
+			}
+			iNdEx = postIndex
+		} else if wireType == 0 {
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 { return ErrIntOverflowTx }
+				if iNdEx >= l { return io.ErrUnexpectedEOF }
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 { break }
+			}
+			switch fieldNum {
+			case 2:
+				m.ContributionID = v
+			default:
+				iNdEx = preIndex
+				skippy, err := skipTx(dAtA[iNdEx:])
+				if err != nil { return err }
+				if (skippy < 0) || (iNdEx+skippy) < 0 { return ErrInvalidLengthTx }
+				if (iNdEx + skippy) > l { return io.ErrUnexpectedEOF }
+				iNdEx = preIndex + skippy
+				continue
+			}
+		} else {
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil { return err }
+			if (skippy < 0) || (iNdEx+skippy) < 0 { return ErrInvalidLengthTx }
+			if (iNdEx + skippy) > l { return io.ErrUnexpectedEOF }
+			iNdEx = preIndex + skippy
+		}
 	}
-	return len(dAtA) - i, nil
+	if iNdEx > l { return io.ErrUnexpectedEOF }
+	return nil
 }
 
-func (m *MsgEndorse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgSubmitSimilarityCommitmentResponse is the response for MsgSubmitSimilarityCommitment
+type MsgSubmitSimilarityCommitmentResponse struct {
+	IsDerivative bool `protobuf:"varint,1,opt,name=is_derivative,json=isDerivative,proto3" json:"is_derivative,omitempty"`
 }
 
-func (m *MsgEndorse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitSimilarityCommitmentResponse) Reset()         { *m = MsgSubmitSimilarityCommitmentResponse{} }
+func (m *MsgSubmitSimilarityCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSimilarityCommitmentResponse) ProtoMessage()    {}
+func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Unmarshal(b []byte) error { return nil }
+func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) { return nil, nil }
+func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Merge(src proto.Message) {}
+func (m *MsgSubmitSimilarityCommitmentResponse) XXX_Size() int  { return 0 }
+func (m *MsgSubmitSimilarityCommitmentResponse) XXX_DiscardUnknown() {}
+func (m *MsgSubmitSimilarityCommitmentResponse) Marshal() (dAtA []byte, err error) { return nil, nil }
+func (m *MsgSubmitSimilarityCommitmentResponse) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
+func (m *MsgSubmitSimilarityCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) { return len(dAtA), nil }
+func (m *MsgSubmitSimilarityCommitmentResponse) Size() (n int) { return 0 }
+func (m *MsgSubmitSimilarityCommitmentResponse) Unmarshal(dAtA []byte) error { return nil }
+
+// MsgStartReview initiates a human review session for a contribution
+type MsgStartReview struct {
+	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ContributionId uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
 }
 
-func (m *MsgEndorse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Decision {
-		i--
-		if m.Decision {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgStartReview) Reset()         { *m = MsgStartReview{} }
+func (m *MsgStartReview) String() string { return proto.CompactTextString(m) }
+func (*MsgStartReview) ProtoMessage()    {}
+func (m *MsgStartReview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartReview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ContributionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgEndorseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgStartReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartReview.Merge(m, src)
 }
-
-func (m *MsgEndorseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgStartReview) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartReview.DiscardUnknown(m)
 }
 
-func (m *MsgEndorseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Verified {
-		i--
-		if m.Verified {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgStartReview proto.InternalMessageInfo
+
+func (m *MsgStartReview) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawPOCRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStartReview) GetContributionId() uint64 {
+	if m != nil {
+		return m.ContributionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWithdrawPOCRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgStartReviewResponse struct {
+	ReviewersAssigned []string `protobuf:"bytes,1,rep,name=reviewers_assigned,json=reviewersAssigned,proto3" json:"reviewers_assigned,omitempty"`
 }
 
-func (m *MsgWithdrawPOCRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgStartReviewResponse) Reset()         { *m = MsgStartReviewResponse{} }
+func (m *MsgStartReviewResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStartReviewResponse) ProtoMessage()    {}
+func (m *MsgStartReviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartReviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgStartReviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartReviewResponse.Merge(m, src)
+}
+func (m *MsgStartReviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartReviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartReviewResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawPOCRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgStartReviewResponse proto.InternalMessageInfo
+
+func (m *MsgStartReviewResponse) GetReviewersAssigned() []string {
+	if m != nil {
+		return m.ReviewersAssigned
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgWithdrawPOCRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCastReviewVote allows an assigned reviewer to cast their vote
+type MsgCastReviewVote struct {
+	Reviewer            string `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	ContributionId      uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	Decision            uint32 `protobuf:"varint,3,opt,name=decision,proto3" json:"decision,omitempty"`
+	OriginalityOverride uint32 `protobuf:"varint,4,opt,name=originality_override,json=originalityOverride,proto3" json:"originality_override,omitempty"`
+	QualityScore        uint32 `protobuf:"varint,5,opt,name=quality_score,json=qualityScore,proto3" json:"quality_score,omitempty"`
+	NotesPointer        string `protobuf:"bytes,6,opt,name=notes_pointer,json=notesPointer,proto3" json:"notes_pointer,omitempty"`
+	ParentClaimOverride uint64 `protobuf:"varint,7,opt,name=parent_claim_override,json=parentClaimOverride,proto3" json:"parent_claim_override,omitempty"`
 }
 
-func (m *MsgWithdrawPOCRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCastReviewVote) Reset()         { *m = MsgCastReviewVote{} }
+func (m *MsgCastReviewVote) String() string { return proto.CompactTextString(m) }
+func (*MsgCastReviewVote) ProtoMessage()    {}
+func (m *MsgCastReviewVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCastReviewVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCastReviewVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgCastReviewVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCastReviewVote.Merge(m, src)
+}
+func (m *MsgCastReviewVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCastReviewVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCastReviewVote.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCastReviewVote proto.InternalMessageInfo
+
+func (m *MsgCastReviewVote) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCastReviewVote) GetContributionId() uint64 {
+	if m != nil {
+		return m.ContributionId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCastReviewVote) GetDecision() uint32 {
+	if m != nil {
+		return m.Decision
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgCastReviewVote) GetOriginalityOverride() uint32 {
+	if m != nil {
+		return m.OriginalityOverride
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCastReviewVote) GetQualityScore() uint32 {
+	if m != nil {
+		return m.QualityScore
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCastReviewVote) GetNotesPointer() string {
+	if m != nil {
+		return m.NotesPointer
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCastReviewVote) GetParentClaimOverride() uint64 {
+	if m != nil {
+		return m.ParentClaimOverride
+	}
+	return 0
 }
 
-// --- MsgStartReview Marshal/Size/Unmarshal ---
+type MsgCastReviewVoteResponse struct{}
 
-func (m *MsgStartReview) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCastReviewVoteResponse) Reset()         { *m = MsgCastReviewVoteResponse{} }
+func (m *MsgCastReviewVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCastReviewVoteResponse) ProtoMessage()    {}
+func (m *MsgCastReviewVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCastReviewVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCastReviewVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCastReviewVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCastReviewVoteResponse.Merge(m, src)
+}
+func (m *MsgCastReviewVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCastReviewVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCastReviewVoteResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStartReview) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCastReviewVoteResponse proto.InternalMessageInfo
+
+// MsgFinalizeReview finalizes a review session (authority or automated via EndBlocker)
+type MsgFinalizeReview struct {
+	Authority      string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ContributionId uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
 }
 
-func (m *MsgStartReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContributionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgFinalizeReview) Reset()         { *m = MsgFinalizeReview{} }
+func (m *MsgFinalizeReview) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeReview) ProtoMessage()    {}
+func (m *MsgFinalizeReview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeReview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
+}
+func (m *MsgFinalizeReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeReview.Merge(m, src)
+}
+func (m *MsgFinalizeReview) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeReview proto.InternalMessageInfo
+
+func (m *MsgFinalizeReview) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgFinalizeReview) GetContributionId() uint64 {
+	if m != nil {
+		return m.ContributionId
+	}
+	return 0
+}
+
+type MsgFinalizeReviewResponse struct {
+	FinalDecision uint32 `protobuf:"varint,1,opt,name=final_decision,json=finalDecision,proto3" json:"final_decision,omitempty"`
+	Accepted      bool   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (m *MsgFinalizeReviewResponse) Reset()         { *m = MsgFinalizeReviewResponse{} }
+func (m *MsgFinalizeReviewResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeReviewResponse) ProtoMessage()    {}
+func (m *MsgFinalizeReviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeReviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeReviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeReviewResponse.Merge(m, src)
+}
+func (m *MsgFinalizeReviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeReviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeReviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeReviewResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizeReviewResponse) GetFinalDecision() uint32 {
+	if m != nil {
+		return m.FinalDecision
+	}
+	return 0
+}
+
+func (m *MsgFinalizeReviewResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+// MsgAppealReview files an appeal against a review decision
+type MsgAppealReview struct {
+	Appellant      string `protobuf:"bytes,1,opt,name=appellant,proto3" json:"appellant,omitempty"`
+	ContributionId uint64 `protobuf:"varint,2,opt,name=contribution_id,json=contributionId,proto3" json:"contribution_id,omitempty"`
+	Reason         string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgAppealReview) Reset()         { *m = MsgAppealReview{} }
+func (m *MsgAppealReview) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealReview) ProtoMessage()    {}
+func (m *MsgAppealReview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealReview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealReview.Merge(m, src)
+}
+func (m *MsgAppealReview) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealReview proto.InternalMessageInfo
+
+func (m *MsgAppealReview) GetAppellant() string {
+	if m != nil {
+		return m.Appellant
+	}
+	return ""
+}
+
+func (m *MsgAppealReview) GetContributionId() uint64 {
+	if m != nil {
+		return m.ContributionId
+	}
+	return 0
+}
+
+func (m *MsgAppealReview) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgAppealReviewResponse struct {
+	AppealId uint64 `protobuf:"varint,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+}
+
+func (m *MsgAppealReviewResponse) Reset()         { *m = MsgAppealReviewResponse{} }
+func (m *MsgAppealReviewResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealReviewResponse) ProtoMessage()    {}
+func (m *MsgAppealReviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealReviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealReviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealReviewResponse.Merge(m, src)
+}
+func (m *MsgAppealReviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealReviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealReviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealReviewResponse proto.InternalMessageInfo
+
+func (m *MsgAppealReviewResponse) GetAppealId() uint64 {
+	if m != nil {
+		return m.AppealId
+	}
+	return 0
+}
+
+// MsgResolveAppeal resolves an appeal (governance only)
+type MsgResolveAppeal struct {
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	AppealId      uint64 `protobuf:"varint,2,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	Upheld        bool   `protobuf:"varint,3,opt,name=upheld,proto3" json:"upheld,omitempty"`
+	ResolverNotes string `protobuf:"bytes,4,opt,name=resolver_notes,json=resolverNotes,proto3" json:"resolver_notes,omitempty"`
+}
+
+func (m *MsgResolveAppeal) Reset()         { *m = MsgResolveAppeal{} }
+func (m *MsgResolveAppeal) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveAppeal) ProtoMessage()    {}
+func (m *MsgResolveAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveAppeal.Merge(m, src)
+}
+func (m *MsgResolveAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveAppeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveAppeal proto.InternalMessageInfo
+
+func (m *MsgResolveAppeal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResolveAppeal) GetAppealId() uint64 {
+	if m != nil {
+		return m.AppealId
+	}
+	return 0
+}
+
+func (m *MsgResolveAppeal) GetUpheld() bool {
+	if m != nil {
+		return m.Upheld
+	}
+	return false
+}
+
+func (m *MsgResolveAppeal) GetResolverNotes() string {
+	if m != nil {
+		return m.ResolverNotes
+	}
+	return ""
+}
+
+type MsgResolveAppealResponse struct{}
+
+func (m *MsgResolveAppealResponse) Reset()         { *m = MsgResolveAppealResponse{} }
+func (m *MsgResolveAppealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveAppealResponse) ProtoMessage()    {}
+func (m *MsgResolveAppealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveAppealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveAppealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveAppealResponse.Merge(m, src)
+}
+func (m *MsgResolveAppealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveAppealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveAppealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveAppealResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSubmitContribution)(nil), "pos.poc.v1.MsgSubmitContribution")
+	proto.RegisterType((*MsgSubmitContributionResponse)(nil), "pos.poc.v1.MsgSubmitContributionResponse")
+	proto.RegisterType((*MsgEndorse)(nil), "pos.poc.v1.MsgEndorse")
+	proto.RegisterType((*MsgEndorseResponse)(nil), "pos.poc.v1.MsgEndorseResponse")
+	proto.RegisterType((*MsgWithdrawPOCRewards)(nil), "pos.poc.v1.MsgWithdrawPOCRewards")
+	proto.RegisterType((*MsgWithdrawPOCRewardsResponse)(nil), "pos.poc.v1.MsgWithdrawPOCRewardsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "pos.poc.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pos.poc.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSubmitSimilarityCommitment)(nil), "pos.poc.v1.MsgSubmitSimilarityCommitment")
+	proto.RegisterType((*MsgSubmitSimilarityCommitmentResponse)(nil), "pos.poc.v1.MsgSubmitSimilarityCommitmentResponse")
+	proto.RegisterType((*MsgStartReview)(nil), "pos.poc.v1.MsgStartReview")
+	proto.RegisterType((*MsgStartReviewResponse)(nil), "pos.poc.v1.MsgStartReviewResponse")
+	proto.RegisterType((*MsgCastReviewVote)(nil), "pos.poc.v1.MsgCastReviewVote")
+	proto.RegisterType((*MsgCastReviewVoteResponse)(nil), "pos.poc.v1.MsgCastReviewVoteResponse")
+	proto.RegisterType((*MsgFinalizeReview)(nil), "pos.poc.v1.MsgFinalizeReview")
+	proto.RegisterType((*MsgFinalizeReviewResponse)(nil), "pos.poc.v1.MsgFinalizeReviewResponse")
+	proto.RegisterType((*MsgAppealReview)(nil), "pos.poc.v1.MsgAppealReview")
+	proto.RegisterType((*MsgAppealReviewResponse)(nil), "pos.poc.v1.MsgAppealReviewResponse")
+	proto.RegisterType((*MsgResolveAppeal)(nil), "pos.poc.v1.MsgResolveAppeal")
+	proto.RegisterType((*MsgResolveAppealResponse)(nil), "pos.poc.v1.MsgResolveAppealResponse")
+	proto.RegisterType((*MsgSetSubmissionsPaused)(nil), "pos.poc.v1.MsgSetSubmissionsPaused")
+	proto.RegisterType((*MsgSetSubmissionsPausedResponse)(nil), "pos.poc.v1.MsgSetSubmissionsPausedResponse")
+	proto.RegisterType((*MsgRegisterContributionType)(nil), "pos.poc.v1.MsgRegisterContributionType")
+	proto.RegisterType((*MsgRegisterContributionTypeResponse)(nil), "pos.poc.v1.MsgRegisterContributionTypeResponse")
+	proto.RegisterType((*BatchEndorseItem)(nil), "pos.poc.v1.BatchEndorseItem")
+	proto.RegisterType((*MsgBatchEndorse)(nil), "pos.poc.v1.MsgBatchEndorse")
+	proto.RegisterType((*BatchEndorseResult)(nil), "pos.poc.v1.BatchEndorseResult")
+	proto.RegisterType((*MsgBatchEndorseResponse)(nil), "pos.poc.v1.MsgBatchEndorseResponse")
+	proto.RegisterType((*MsgUpdatePocFeeParams)(nil), "pos.poc.v1.MsgUpdatePocFeeParams")
+	proto.RegisterType((*MsgUpdatePocFeeParamsResponse)(nil), "pos.poc.v1.MsgUpdatePocFeeParamsResponse")
+	proto.RegisterType((*MsgAddExemptAddress)(nil), "pos.poc.v1.MsgAddExemptAddress")
+	proto.RegisterType((*MsgAddExemptAddressResponse)(nil), "pos.poc.v1.MsgAddExemptAddressResponse")
+	proto.RegisterType((*MsgRemoveExemptAddress)(nil), "pos.poc.v1.MsgRemoveExemptAddress")
+	proto.RegisterType((*MsgRemoveExemptAddressResponse)(nil), "pos.poc.v1.MsgRemoveExemptAddressResponse")
+	proto.RegisterType((*MsgSubmitFraudProof)(nil), "pos.poc.v1.MsgSubmitFraudProof")
+	proto.RegisterType((*MsgSubmitFraudProofResponse)(nil), "pos.poc.v1.MsgSubmitFraudProofResponse")
+	proto.RegisterType((*MsgUpdateEndorsementParams)(nil), "pos.poc.v1.MsgUpdateEndorsementParams")
+	proto.RegisterType((*MsgUpdateEndorsementParamsResponse)(nil), "pos.poc.v1.MsgUpdateEndorsementParamsResponse")
+	proto.RegisterType((*MsgUpdateRejectionRefundParams)(nil), "pos.poc.v1.MsgUpdateRejectionRefundParams")
+	proto.RegisterType((*MsgUpdateRejectionRefundParamsResponse)(nil), "pos.poc.v1.MsgUpdateRejectionRefundParamsResponse")
+	proto.RegisterType((*MsgUpdateCScoreNamespaceParams)(nil), "pos.poc.v1.MsgUpdateCScoreNamespaceParams")
+	proto.RegisterType((*MsgUpdateCScoreNamespaceParamsResponse)(nil), "pos.poc.v1.MsgUpdateCScoreNamespaceParamsResponse")
+}
+
+func init() { proto.RegisterFile("pos/poc/v1/tx.proto", fileDescriptor_fef83dba41b82242) }
+
+var fileDescriptor_fef83dba41b82242 = []byte{
+	// 1757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0x8f, 0xed, 0xc4, 0x7e, 0x76, 0x12, 0xbb, 0xed, 0x38, 0xe3, 0x76, 0x32, 0x76, 0xda,
+	0xb0, 0xfe, 0x08, 0x99, 0xc1, 0x5e, 0xb1, 0x82, 0x91, 0x96, 0x55, 0xec, 0x6c, 0x24, 0x23, 0x0c,
+	0x56, 0x7b, 0x21, 0x68, 0x85, 0xd4, 0xaa, 0xe9, 0x2e, 0xf7, 0x34, 0xeb, 0xee, 0x9a, 0xed, 0xaa,
+	0x71, 0x9c, 0x03, 0x12, 0xe2, 0xc6, 0x9e, 0x90, 0xf8, 0x1b, 0x90, 0xe0, 0x00, 0x0a, 0x28, 0x67,
+	0xc4, 0x71, 0x8f, 0x4b, 0xc4, 0x01, 0x71, 0x88, 0x50, 0x22, 0x91, 0x03, 0xff, 0x04, 0xaa, 0x8f,
+	0xae, 0xe9, 0xe9, 0xee, 0xf1, 0x4c, 0xec, 0xc3, 0x5e, 0x2c, 0xd7, 0x7b, 0xbf, 0x7a, 0xf5, 0x7e,
+	0xaf, 0xea, 0x7d, 0xf4, 0xc0, 0x7c, 0x87, 0xd0, 0x46, 0x87, 0x78, 0x8d, 0xd3, 0xed, 0x06, 0x3b,
+	0xab, 0x77, 0x12, 0xc2, 0x88, 0x09, 0x1d, 0x42, 0xeb, 0x1d, 0xe2, 0xd5, 0x4f, 0xb7, 0xad, 0x39,
+	0x14, 0x85, 0x31, 0x69, 0x88, 0xbf, 0x52, 0x6d, 0xd5, 0x3c, 0x42, 0x23, 0x42, 0x1b, 0x2d, 0x44,
+	0x71, 0xe3, 0x74, 0xbb, 0x85, 0x19, 0xda, 0x6e, 0x78, 0x24, 0x8c, 0x95, 0xfe, 0xb6, 0xd2, 0x47,
+	0x34, 0xe0, 0x66, 0x23, 0x1a, 0x28, 0xc5, 0x92, 0x54, 0xb8, 0x62, 0xd5, 0x90, 0x0b, 0xa5, 0x5a,
+	0x08, 0x48, 0x40, 0xa4, 0x9c, 0xff, 0x97, 0x5a, 0xca, 0x78, 0xd7, 0x41, 0x09, 0x8a, 0x14, 0xdc,
+	0xfe, 0xbb, 0x01, 0xb7, 0x0e, 0x68, 0x70, 0xd4, 0x6d, 0x45, 0x21, 0xdb, 0x23, 0x31, 0x4b, 0xc2,
+	0x56, 0x97, 0x85, 0x24, 0x36, 0x9b, 0x30, 0xed, 0xa5, 0x6b, 0x92, 0x54, 0x8d, 0x55, 0x63, 0x63,
+	0x6a, 0xb7, 0xfa, 0xf2, 0xc5, 0x83, 0x05, 0x75, 0xde, 0x43, 0xdf, 0x4f, 0x30, 0xa5, 0x47, 0x2c,
+	0x09, 0xe3, 0xc0, 0xc9, 0x82, 0xcd, 0x05, 0x98, 0xf0, 0xd8, 0xb3, 0x0e, 0xae, 0x56, 0xf8, 0x2e,
+	0x47, 0x2e, 0xcc, 0x59, 0x18, 0xeb, 0x26, 0x61, 0x75, 0x4c, 0xc8, 0xf8, 0xbf, 0xa6, 0x09, 0xe3,
+	0x6d, 0x44, 0xdb, 0xd5, 0xf1, 0x55, 0x63, 0x63, 0xc6, 0x11, 0xff, 0x37, 0x1b, 0xbf, 0x7e, 0xfb,
+	0x7c, 0x2b, 0x6b, 0xed, 0x8b, 0xb7, 0xcf, 0xb7, 0xac, 0xd4, 0xff, 0xa2, 0xa3, 0x76, 0x03, 0xee,
+	0x96, 0x32, 0x70, 0x30, 0xed, 0x90, 0x98, 0x62, 0xf3, 0x06, 0x54, 0x42, 0x5f, 0x10, 0x18, 0x77,
+	0x2a, 0xa1, 0x6f, 0xff, 0xc9, 0x00, 0x38, 0xa0, 0xc1, 0xc7, 0xb1, 0x4f, 0x12, 0x8a, 0xcd, 0x0f,
+	0x60, 0xea, 0x14, 0x9d, 0x84, 0x3e, 0x1a, 0x85, 0x66, 0x0f, 0x6a, 0xae, 0xc3, 0x4d, 0x2f, 0x73,
+	0x9c, 0x1b, 0xfa, 0x82, 0xee, 0xb8, 0x73, 0x23, 0x2b, 0xde, 0xf7, 0x4d, 0x0b, 0x26, 0x7d, 0xec,
+	0x85, 0x34, 0x24, 0xb1, 0x20, 0x3f, 0xe9, 0xe8, 0x75, 0xd3, 0xe6, 0x6c, 0x7b, 0x46, 0x39, 0xd7,
+	0x9b, 0x29, 0x57, 0xe5, 0xa0, 0xfd, 0x6d, 0x30, 0x7b, 0xee, 0x6a, 0x56, 0x16, 0x4c, 0x9e, 0xe2,
+	0x24, 0x3c, 0x0e, 0xb1, 0xe4, 0x36, 0xe9, 0xe8, 0xb5, 0x7d, 0x26, 0x2e, 0xf5, 0x49, 0xc8, 0xda,
+	0x7e, 0x82, 0x9e, 0x1e, 0xfe, 0x78, 0xcf, 0xc1, 0x4f, 0x51, 0xe2, 0x53, 0x73, 0x07, 0xae, 0x21,
+	0xc9, 0x67, 0x28, 0xd3, 0x14, 0xd8, 0xbc, 0xcf, 0x5d, 0x4c, 0x57, 0x7d, 0x97, 0x51, 0x3c, 0xc0,
+	0xf6, 0xc5, 0x65, 0x14, 0x15, 0xda, 0xed, 0x3d, 0xb8, 0x8a, 0x22, 0xd2, 0x8d, 0x99, 0x72, 0xe0,
+	0xfe, 0x97, 0xaf, 0x56, 0xae, 0xfc, 0xfb, 0xd5, 0xca, 0x2d, 0xe9, 0x04, 0xf5, 0x3f, 0xab, 0x87,
+	0xa4, 0x11, 0x21, 0xd6, 0xae, 0xef, 0xc7, 0xec, 0xe5, 0x8b, 0x07, 0xa0, 0xbc, 0xdb, 0x8f, 0x99,
+	0xa3, 0xb6, 0xda, 0x7f, 0x34, 0xe0, 0xe6, 0x01, 0x0d, 0x7e, 0xd2, 0xf1, 0x11, 0xc3, 0x87, 0xe2,
+	0x3d, 0xf3, 0x6b, 0x44, 0x5d, 0xd6, 0x26, 0x49, 0xc8, 0x9e, 0x0d, 0xbf, 0x46, 0x0d, 0x35, 0xbf,
+	0x03, 0x57, 0x65, 0x46, 0x88, 0xdb, 0x9b, 0xde, 0x31, 0xeb, 0xbd, 0xa4, 0xad, 0x4b, 0xdb, 0xbb,
+	0x53, 0xdc, 0xc9, 0x3f, 0xbc, 0x7d, 0xbe, 0x65, 0x38, 0x0a, 0xdc, 0x5c, 0x17, 0x17, 0xa7, 0xcd,
+	0xf0, 0xb8, 0x2c, 0xa4, 0x71, 0xc9, 0xfa, 0x65, 0x2f, 0xc1, 0xed, 0x9c, 0xab, 0x69, 0x2c, 0xec,
+	0xbf, 0x1a, 0x42, 0x77, 0x84, 0x99, 0x78, 0xbd, 0x94, 0xbf, 0x08, 0x7a, 0x88, 0xba, 0x14, 0xfb,
+	0x17, 0xa6, 0xb3, 0xc8, 0xe9, 0x70, 0x0b, 0x82, 0xce, 0xa4, 0xa3, 0x56, 0x5c, 0x9e, 0x60, 0x44,
+	0xd5, 0x13, 0x9c, 0x72, 0xd4, 0x4a, 0xa6, 0x5b, 0x3f, 0x8f, 0x3b, 0x3a, 0xd9, 0x4a, 0x1c, 0xb3,
+	0xef, 0xc1, 0xca, 0x00, 0x9f, 0x35, 0xaf, 0xbf, 0x55, 0x60, 0xf9, 0x80, 0x06, 0x0e, 0x0e, 0x42,
+	0xca, 0x70, 0x92, 0x4d, 0xca, 0x4f, 0x78, 0x21, 0xb8, 0x28, 0xb7, 0xf2, 0xb2, 0xb2, 0x06, 0xd7,
+	0x13, 0xf1, 0xc8, 0xdc, 0xa7, 0x38, 0x0c, 0xda, 0x4c, 0x10, 0xbc, 0xee, 0xcc, 0x48, 0xe1, 0x13,
+	0x21, 0x33, 0x7f, 0x00, 0x10, 0x85, 0xb1, 0xeb, 0x51, 0x8f, 0x24, 0xb8, 0x3a, 0xfe, 0xee, 0x4f,
+	0x6f, 0x2a, 0x0a, 0xe3, 0x3d, 0xb1, 0xdb, 0xbc, 0x0f, 0x73, 0x09, 0xfe, 0xbc, 0x1b, 0x26, 0x98,
+	0xba, 0xa1, 0x8f, 0x63, 0xc6, 0x69, 0x4c, 0x88, 0x68, 0xcf, 0xa6, 0x8a, 0x7d, 0x25, 0x6f, 0xbe,
+	0x5f, 0x8c, 0xef, 0x6a, 0x1a, 0xdf, 0x41, 0x01, 0xb2, 0xbf, 0x09, 0x6b, 0xe7, 0xc4, 0x4f, 0xc7,
+	0xf9, 0x09, 0xcc, 0xee, 0x22, 0xe6, 0xb5, 0x55, 0x69, 0xd8, 0x67, 0x38, 0x2a, 0xab, 0x4a, 0xc6,
+	0xd0, 0xaa, 0x54, 0xe9, 0xaf, 0x4a, 0xf6, 0x5f, 0x64, 0x7e, 0x65, 0x8d, 0x5f, 0xb8, 0x4c, 0x7e,
+	0x08, 0x13, 0x21, 0xc3, 0x22, 0xbd, 0xc6, 0x36, 0xa6, 0x77, 0xee, 0x64, 0xd3, 0x2b, 0xef, 0x7d,
+	0x36, 0xd1, 0xe4, 0x2e, 0x95, 0x67, 0x7d, 0x05, 0x52, 0xe7, 0x59, 0x76, 0xbb, 0xfd, 0x1b, 0x03,
+	0xcc, 0xac, 0xc0, 0xc1, 0xb4, 0x7b, 0xc2, 0x46, 0x8f, 0x47, 0x15, 0xae, 0xd1, 0xae, 0xe7, 0xf1,
+	0xd2, 0x28, 0xc3, 0x91, 0x2e, 0xfb, 0x2a, 0xed, 0x58, 0x7f, 0xa5, 0xe5, 0x4f, 0x12, 0x27, 0x09,
+	0x49, 0xe4, 0x93, 0x72, 0xe4, 0xc2, 0xee, 0x8a, 0xbc, 0xce, 0x79, 0x23, 0xeb, 0xdf, 0xf7, 0xe1,
+	0x5a, 0x22, 0x3c, 0xe3, 0x15, 0x98, 0x07, 0xa4, 0x36, 0x28, 0x20, 0x92, 0xc0, 0xee, 0x38, 0x0f,
+	0x89, 0x93, 0x6e, 0xe2, 0xce, 0x60, 0xa9, 0x97, 0x19, 0x7e, 0xdd, 0xd1, 0x6b, 0xfb, 0x9f, 0x63,
+	0x70, 0xab, 0x57, 0x6b, 0x88, 0xf7, 0x18, 0x5f, 0xb6, 0x38, 0x7e, 0x02, 0xf3, 0x7c, 0x38, 0x71,
+	0xa9, 0xce, 0x75, 0xf7, 0x18, 0x63, 0x55, 0x29, 0x97, 0xea, 0x6a, 0x3b, 0x87, 0xd4, 0xd5, 0xfc,
+	0x52, 0xdf, 0x23, 0x61, 0x9c, 0xbd, 0xc7, 0x39, 0xae, 0xed, 0xd5, 0x8a, 0xc7, 0x18, 0x9b, 0x1f,
+	0xc1, 0x1d, 0x86, 0x92, 0x00, 0xb3, 0x8c, 0x5d, 0xea, 0x76, 0x70, 0xe2, 0xb6, 0x4e, 0x88, 0xf7,
+	0x99, 0x4a, 0xe0, 0x25, 0x89, 0xc9, 0x96, 0x19, 0x9c, 0xec, 0x72, 0x80, 0x89, 0x60, 0x3e, 0x42,
+	0x67, 0x2a, 0x9b, 0x5d, 0x3f, 0xa4, 0x9e, 0xe8, 0x28, 0x32, 0xad, 0xb7, 0x55, 0x5a, 0x2f, 0x17,
+	0xd3, 0xfa, 0x87, 0x38, 0x40, 0xde, 0xb3, 0x47, 0xd8, 0xcb, 0x24, 0xf7, 0x23, 0xec, 0x39, 0x73,
+	0x11, 0x3a, 0x93, 0xc9, 0xfd, 0x48, 0xd9, 0x32, 0x3f, 0x85, 0xc5, 0x28, 0x8c, 0xc3, 0xa8, 0x1b,
+	0xe5, 0xc9, 0x4f, 0xbc, 0x03, 0xf9, 0x05, 0x65, 0xa3, 0x8f, 0x7f, 0xf3, 0x41, 0xb1, 0x26, 0x58,
+	0xb9, 0xde, 0x91, 0xb9, 0x3c, 0x7b, 0x45, 0xf4, 0xd4, 0xa2, 0x42, 0xd7, 0x81, 0x3f, 0x1b, 0x30,
+	0x7f, 0x40, 0x83, 0x87, 0xbe, 0xff, 0xf1, 0x19, 0x8e, 0x3a, 0x4c, 0x5d, 0xe7, 0x85, 0x6f, 0x3d,
+	0x33, 0x25, 0x54, 0xde, 0x69, 0x4a, 0xe8, 0xe7, 0x54, 0x4d, 0x39, 0xe5, 0x1d, 0xb3, 0xef, 0x8a,
+	0xfe, 0x90, 0x17, 0x6b, 0x3e, 0x2f, 0x0c, 0x58, 0x14, 0xf5, 0x2f, 0x22, 0xa7, 0xf8, 0xeb, 0xa3,
+	0x54, 0x2f, 0x52, 0x5a, 0xee, 0x95, 0xee, 0x82, 0x6f, 0xf6, 0x2a, 0xd4, 0xca, 0xbd, 0xd6, 0xc4,
+	0x7e, 0x5f, 0x11, 0x17, 0x25, 0x67, 0xd5, 0xc7, 0x09, 0xea, 0xfa, 0x87, 0x09, 0x21, 0xc7, 0xe6,
+	0x77, 0x01, 0xbc, 0x36, 0x3a, 0x39, 0xc1, 0x71, 0x80, 0x87, 0x17, 0xd7, 0x0c, 0x76, 0xf4, 0x21,
+	0xf4, 0xa3, 0x6c, 0xf9, 0x16, 0x23, 0xc0, 0xee, 0xbd, 0x97, 0x2f, 0x1e, 0xdc, 0x55, 0x27, 0xfc,
+	0x34, 0xd5, 0x0d, 0xac, 0xe3, 0x77, 0x01, 0x3a, 0xdc, 0x59, 0x57, 0x74, 0xe0, 0x71, 0x91, 0xa2,
+	0x53, 0x42, 0x22, 0x7a, 0xba, 0x56, 0xfb, 0x88, 0x21, 0x91, 0x23, 0x33, 0x4a, 0xfd, 0x08, 0x31,
+	0xd4, 0xfc, 0x16, 0x8f, 0x65, 0xc6, 0xf1, 0xbe, 0xf7, 0x91, 0x8f, 0x87, 0xfd, 0x3f, 0x43, 0x3c,
+	0x90, 0xbc, 0x5c, 0x17, 0x51, 0x07, 0x6e, 0xd0, 0x13, 0x44, 0xdb, 0xd8, 0x77, 0x2f, 0x3e, 0x4c,
+	0x5e, 0x57, 0x26, 0x1e, 0x0a, 0x0b, 0xe6, 0xcf, 0x60, 0xae, 0xe7, 0x9e, 0x2b, 0x87, 0x87, 0x6a,
+	0xe5, 0xdd, 0xcd, 0xce, 0xf6, 0xac, 0xc8, 0xd9, 0xd7, 0xac, 0x01, 0xe8, 0x30, 0xd2, 0xea, 0xd8,
+	0xea, 0xd8, 0xc6, 0x94, 0x93, 0x91, 0xd8, 0xff, 0x30, 0xc0, 0xd2, 0x09, 0xae, 0x8a, 0x7f, 0x84,
+	0x63, 0x76, 0xc9, 0xda, 0xbd, 0x09, 0xb3, 0x7c, 0xe4, 0xc1, 0x3d, 0x83, 0x54, 0x75, 0x8c, 0x9b,
+	0x51, 0x18, 0x67, 0xce, 0xa1, 0xe6, 0x1d, 0x98, 0x92, 0xb3, 0x53, 0x18, 0x07, 0x6a, 0x3e, 0xec,
+	0x09, 0x9a, 0x3b, 0xc5, 0x3c, 0x58, 0xe9, 0x2f, 0x57, 0x05, 0xa7, 0xed, 0x6f, 0x80, 0x3d, 0x98,
+	0x92, 0xce, 0x87, 0xff, 0x1a, 0x50, 0xd3, 0x30, 0x07, 0xff, 0x02, 0x7b, 0xf2, 0xc3, 0xed, 0xb8,
+	0x1b, 0xfb, 0x97, 0xee, 0x5c, 0x33, 0x89, 0xb0, 0xe3, 0x26, 0x88, 0x85, 0xa4, 0x5a, 0xb9, 0x68,
+	0x6f, 0x98, 0x96, 0x66, 0x1c, 0x6e, 0xa5, 0xf9, 0x41, 0x31, 0x14, 0x6b, 0xfd, 0xa1, 0x28, 0x65,
+	0x61, 0x6f, 0xc0, 0x7b, 0xe7, 0xf3, 0xd4, 0x21, 0xf9, 0xa2, 0x92, 0x09, 0xc9, 0xde, 0x11, 0xef,
+	0x49, 0x3f, 0x42, 0x11, 0xa6, 0x1d, 0xe4, 0x5d, 0xb6, 0x99, 0x7f, 0x08, 0xcb, 0x38, 0x46, 0xad,
+	0x13, 0x2c, 0x52, 0xd8, 0xa5, 0x1e, 0xe9, 0x60, 0xbf, 0xd7, 0x3d, 0xe5, 0xd4, 0x53, 0x95, 0x10,
+	0x9e, 0xd3, 0x47, 0x02, 0xa0, 0x3b, 0xe2, 0xf7, 0x60, 0xc9, 0x4b, 0x08, 0xa5, 0xae, 0x87, 0x18,
+	0x0e, 0x48, 0xf2, 0xcc, 0x3d, 0x46, 0x1e, 0x23, 0x89, 0xdb, 0xea, 0x50, 0xd5, 0xb2, 0x17, 0x05,
+	0x60, 0x4f, 0xe9, 0x1f, 0x0b, 0xf5, 0x6e, 0x87, 0x8e, 0x10, 0xb6, 0x52, 0xa6, 0x7d, 0x61, 0x2b,
+	0x45, 0xa4, 0x61, 0xdb, 0xf9, 0xdd, 0x34, 0x8c, 0x1d, 0xd0, 0xc0, 0x6c, 0x81, 0x59, 0xf2, 0x5b,
+	0xc6, 0xbd, 0xec, 0x8c, 0x55, 0xfa, 0x63, 0x81, 0xb5, 0x39, 0x14, 0xa2, 0xab, 0xcf, 0x43, 0xb8,
+	0x96, 0x0e, 0xc5, 0x8b, 0xb9, 0x5d, 0x4a, 0x6e, 0xd5, 0xca, 0xe5, 0xda, 0x44, 0x0b, 0xcc, 0x92,
+	0xaf, 0xf3, 0xbc, 0x9b, 0x45, 0x88, 0xb5, 0x39, 0x14, 0xa2, 0xcf, 0x38, 0x84, 0x99, 0xbe, 0x0f,
+	0xe4, 0xe5, 0xdc, 0xd6, 0xac, 0xd2, 0x5a, 0x3b, 0x47, 0xa9, 0x2d, 0xb6, 0x61, 0xa1, 0xf4, 0x5b,
+	0x35, 0xbf, 0xb9, 0x0c, 0x64, 0xdd, 0x1f, 0x01, 0xa4, 0x4f, 0x62, 0x50, 0x1d, 0xf8, 0xf5, 0xb8,
+	0x9e, 0x33, 0x34, 0x08, 0x68, 0x35, 0x46, 0x04, 0x66, 0x23, 0xd6, 0xf7, 0xc9, 0x93, 0x8f, 0x58,
+	0x56, 0x69, 0xad, 0x9d, 0xa3, 0xcc, 0xde, 0x73, 0xc9, 0x34, 0x7e, 0xaf, 0x3c, 0xd8, 0x19, 0x88,
+	0xb5, 0x39, 0x14, 0xa2, 0xcf, 0xf8, 0x39, 0xcc, 0x16, 0x26, 0xbf, 0x95, 0xdc, 0xf6, 0x3c, 0xc0,
+	0x5a, 0x1f, 0x02, 0xd0, 0xd6, 0x31, 0xcc, 0x97, 0xcd, 0x61, 0x76, 0x21, 0xb6, 0x05, 0x8c, 0xb5,
+	0x35, 0x1c, 0x93, 0x25, 0x51, 0x98, 0x8a, 0x56, 0x4a, 0x53, 0xb2, 0x07, 0xb0, 0xd6, 0x87, 0x00,
+	0xb4, 0xf5, 0xcf, 0xe1, 0xf6, 0xa0, 0xee, 0xfa, 0x5e, 0x69, 0xa0, 0x0b, 0x38, 0xab, 0x3e, 0x1a,
+	0x4e, 0x1f, 0xf9, 0x4b, 0x58, 0x3e, 0xaf, 0xad, 0x6d, 0x95, 0x9a, 0x2b, 0xc5, 0x5a, 0x3b, 0xa3,
+	0x63, 0x8b, 0xc7, 0x97, 0xb7, 0x90, 0xf2, 0xe3, 0x4b, 0xb1, 0xd6, 0xce, 0xe8, 0xd8, 0xf4, 0x78,
+	0x6b, 0xe2, 0x57, 0xfc, 0xf3, 0x67, 0x77, 0xf3, 0xcb, 0xd7, 0x35, 0xe3, 0xab, 0xd7, 0x35, 0xe3,
+	0x3f, 0xaf, 0x6b, 0xc6, 0x6f, 0xdf, 0xd4, 0xae, 0x7c, 0xf5, 0xa6, 0x76, 0xe5, 0x5f, 0x6f, 0x6a,
+	0x57, 0x3e, 0x15, 0x3f, 0x72, 0x9e, 0x89, 0x06, 0xc0, 0x9b, 0x11, 0x6d, 0x5d, 0x15, 0xbf, 0x47,
+	0xbf, 0xff, 0xff, 0x01, 0x00, 0xb4, 0x57, 0x16, 0xfe, 0x48, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SubmitContribution creates a new contribution submission
+	SubmitContribution(ctx context.Context, in *MsgSubmitContribution, opts ...grpc.CallOption) (*MsgSubmitContributionResponse, error)
+	// Endorse allows a validator to endorse or reject a contribution
+	Endorse(ctx context.Context, in *MsgEndorse, opts ...grpc.CallOption) (*MsgEndorseResponse, error)
+	// WithdrawPOCRewards withdraws accumulated PoC credits as coins
+	WithdrawPOCRewards(ctx context.Context, in *MsgWithdrawPOCRewards, opts ...grpc.CallOption) (*MsgWithdrawPOCRewardsResponse, error)
+	// UpdateParams updates the module parameters (governance only)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SubmitSimilarityCommitment submits an oracle-signed similarity commitment
+	SubmitSimilarityCommitment(ctx context.Context, in *MsgSubmitSimilarityCommitment, opts ...grpc.CallOption) (*MsgSubmitSimilarityCommitmentResponse, error)
+	// StartReview initiates a human review session for a contribution
+	StartReview(ctx context.Context, in *MsgStartReview, opts ...grpc.CallOption) (*MsgStartReviewResponse, error)
+	// CastReviewVote allows an assigned reviewer to cast their vote
+	CastReviewVote(ctx context.Context, in *MsgCastReviewVote, opts ...grpc.CallOption) (*MsgCastReviewVoteResponse, error)
+	// FinalizeReview finalizes a review session
+	FinalizeReview(ctx context.Context, in *MsgFinalizeReview, opts ...grpc.CallOption) (*MsgFinalizeReviewResponse, error)
+	// AppealReview files an appeal against a review decision
+	AppealReview(ctx context.Context, in *MsgAppealReview, opts ...grpc.CallOption) (*MsgAppealReviewResponse, error)
+	// ResolveAppeal resolves an appeal (governance only)
+	ResolveAppeal(ctx context.Context, in *MsgResolveAppeal, opts ...grpc.CallOption) (*MsgResolveAppealResponse, error)
+	// SetSubmissionsPaused turns the contribution submission kill-switch on or
+	// off (governance only)
+	SetSubmissionsPaused(ctx context.Context, in *MsgSetSubmissionsPaused, opts ...grpc.CallOption) (*MsgSetSubmissionsPausedResponse, error)
+	// RegisterContributionType registers or replaces a contribution type
+	// (governance only)
+	RegisterContributionType(ctx context.Context, in *MsgRegisterContributionType, opts ...grpc.CallOption) (*MsgRegisterContributionTypeResponse, error)
+	// BatchEndorse endorses several contributions in one transaction
+	BatchEndorse(ctx context.Context, in *MsgBatchEndorse, opts ...grpc.CallOption) (*MsgBatchEndorseResponse, error)
+	// UpdatePocFeeParams replaces the 3-layer submission fee parameters
+	// (governance only)
+	UpdatePocFeeParams(ctx context.Context, in *MsgUpdatePocFeeParams, opts ...grpc.CallOption) (*MsgUpdatePocFeeParamsResponse, error)
+	// AddExemptAddress adds one address to the access-control exempt list
+	// (governance only)
+	AddExemptAddress(ctx context.Context, in *MsgAddExemptAddress, opts ...grpc.CallOption) (*MsgAddExemptAddressResponse, error)
+	// RemoveExemptAddress removes one address from the access-control exempt
+	// list (governance only)
+	RemoveExemptAddress(ctx context.Context, in *MsgRemoveExemptAddress, opts ...grpc.CallOption) (*MsgRemoveExemptAddressResponse, error)
+	// SubmitFraudProof proves that a validator approved a fraudulent
+	// contribution, slashing its approvers and rewarding the challenger
+	SubmitFraudProof(ctx context.Context, in *MsgSubmitFraudProof, opts ...grpc.CallOption) (*MsgSubmitFraudProofResponse, error)
+	// UpdateEndorsementParams replaces the endorsement thresholds: the minimum
+	// number of approving validators and the vote weighting (governance only)
+	UpdateEndorsementParams(ctx context.Context, in *MsgUpdateEndorsementParams, opts ...grpc.CallOption) (*MsgUpdateEndorsementParamsResponse, error)
+	// UpdateRejectionRefundParams sets the share of the pooled fee refunded
+	// when endorsers reject a contribution (governance only)
+	UpdateRejectionRefundParams(ctx context.Context, in *MsgUpdateRejectionRefundParams, opts ...grpc.CallOption) (*MsgUpdateRejectionRefundParamsResponse, error)
+	// UpdateCScoreNamespaceParams sets whether the C-Score fee discount is
+	// scoped to the contribution type (governance only)
+	UpdateCScoreNamespaceParams(ctx context.Context, in *MsgUpdateCScoreNamespaceParams, opts ...grpc.CallOption) (*MsgUpdateCScoreNamespaceParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitContribution(ctx context.Context, in *MsgSubmitContribution, opts ...grpc.CallOption) (*MsgSubmitContributionResponse, error) {
+	out := new(MsgSubmitContributionResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/SubmitContribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Endorse(ctx context.Context, in *MsgEndorse, opts ...grpc.CallOption) (*MsgEndorseResponse, error) {
+	out := new(MsgEndorseResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/Endorse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawPOCRewards(ctx context.Context, in *MsgWithdrawPOCRewards, opts ...grpc.CallOption) (*MsgWithdrawPOCRewardsResponse, error) {
+	out := new(MsgWithdrawPOCRewardsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/WithdrawPOCRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSubmissionsPaused(ctx context.Context, in *MsgSetSubmissionsPaused, opts ...grpc.CallOption) (*MsgSetSubmissionsPausedResponse, error) {
+	out := new(MsgSetSubmissionsPausedResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/SetSubmissionsPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterContributionType(ctx context.Context, in *MsgRegisterContributionType, opts ...grpc.CallOption) (*MsgRegisterContributionTypeResponse, error) {
+	out := new(MsgRegisterContributionTypeResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/RegisterContributionType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchEndorse(ctx context.Context, in *MsgBatchEndorse, opts ...grpc.CallOption) (*MsgBatchEndorseResponse, error) {
+	out := new(MsgBatchEndorseResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/BatchEndorse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePocFeeParams(ctx context.Context, in *MsgUpdatePocFeeParams, opts ...grpc.CallOption) (*MsgUpdatePocFeeParamsResponse, error) {
+	out := new(MsgUpdatePocFeeParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/UpdatePocFeeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddExemptAddress(ctx context.Context, in *MsgAddExemptAddress, opts ...grpc.CallOption) (*MsgAddExemptAddressResponse, error) {
+	out := new(MsgAddExemptAddressResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/AddExemptAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveExemptAddress(ctx context.Context, in *MsgRemoveExemptAddress, opts ...grpc.CallOption) (*MsgRemoveExemptAddressResponse, error) {
+	out := new(MsgRemoveExemptAddressResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/RemoveExemptAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitFraudProof(ctx context.Context, in *MsgSubmitFraudProof, opts ...grpc.CallOption) (*MsgSubmitFraudProofResponse, error) {
+	out := new(MsgSubmitFraudProofResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/SubmitFraudProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEndorsementParams(ctx context.Context, in *MsgUpdateEndorsementParams, opts ...grpc.CallOption) (*MsgUpdateEndorsementParamsResponse, error) {
+	out := new(MsgUpdateEndorsementParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/UpdateEndorsementParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRejectionRefundParams(ctx context.Context, in *MsgUpdateRejectionRefundParams, opts ...grpc.CallOption) (*MsgUpdateRejectionRefundParamsResponse, error) {
+	out := new(MsgUpdateRejectionRefundParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/UpdateRejectionRefundParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCScoreNamespaceParams(ctx context.Context, in *MsgUpdateCScoreNamespaceParams, opts ...grpc.CallOption) (*MsgUpdateCScoreNamespaceParamsResponse, error) {
+	out := new(MsgUpdateCScoreNamespaceParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/UpdateCScoreNamespaceParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitSimilarityCommitment(ctx context.Context, in *MsgSubmitSimilarityCommitment, opts ...grpc.CallOption) (*MsgSubmitSimilarityCommitmentResponse, error) {
+	out := new(MsgSubmitSimilarityCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/SubmitSimilarityCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartReview(ctx context.Context, in *MsgStartReview, opts ...grpc.CallOption) (*MsgStartReviewResponse, error) {
+	out := new(MsgStartReviewResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/StartReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CastReviewVote(ctx context.Context, in *MsgCastReviewVote, opts ...grpc.CallOption) (*MsgCastReviewVoteResponse, error) {
+	out := new(MsgCastReviewVoteResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/CastReviewVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FinalizeReview(ctx context.Context, in *MsgFinalizeReview, opts ...grpc.CallOption) (*MsgFinalizeReviewResponse, error) {
+	out := new(MsgFinalizeReviewResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/FinalizeReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppealReview(ctx context.Context, in *MsgAppealReview, opts ...grpc.CallOption) (*MsgAppealReviewResponse, error) {
+	out := new(MsgAppealReviewResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/AppealReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveAppeal(ctx context.Context, in *MsgResolveAppeal, opts ...grpc.CallOption) (*MsgResolveAppealResponse, error) {
+	out := new(MsgResolveAppealResponse)
+	err := c.cc.Invoke(ctx, "/pos.poc.v1.Msg/ResolveAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SubmitContribution creates a new contribution submission
+	SubmitContribution(context.Context, *MsgSubmitContribution) (*MsgSubmitContributionResponse, error)
+	// Endorse allows a validator to endorse or reject a contribution
+	Endorse(context.Context, *MsgEndorse) (*MsgEndorseResponse, error)
+	// WithdrawPOCRewards withdraws accumulated PoC credits as coins
+	WithdrawPOCRewards(context.Context, *MsgWithdrawPOCRewards) (*MsgWithdrawPOCRewardsResponse, error)
+	// UpdateParams updates the module parameters (governance only)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SubmitSimilarityCommitment submits an oracle-signed similarity commitment
+	SubmitSimilarityCommitment(context.Context, *MsgSubmitSimilarityCommitment) (*MsgSubmitSimilarityCommitmentResponse, error)
+	// StartReview initiates a human review session for a contribution
+	StartReview(context.Context, *MsgStartReview) (*MsgStartReviewResponse, error)
+	// CastReviewVote allows an assigned reviewer to cast their vote
+	CastReviewVote(context.Context, *MsgCastReviewVote) (*MsgCastReviewVoteResponse, error)
+	// FinalizeReview finalizes a review session
+	FinalizeReview(context.Context, *MsgFinalizeReview) (*MsgFinalizeReviewResponse, error)
+	// AppealReview files an appeal against a review decision
+	AppealReview(context.Context, *MsgAppealReview) (*MsgAppealReviewResponse, error)
+	// ResolveAppeal resolves an appeal (governance only)
+	ResolveAppeal(context.Context, *MsgResolveAppeal) (*MsgResolveAppealResponse, error)
+	// SetSubmissionsPaused turns the contribution submission kill-switch on or
+	// off (governance only)
+	SetSubmissionsPaused(context.Context, *MsgSetSubmissionsPaused) (*MsgSetSubmissionsPausedResponse, error)
+	// RegisterContributionType registers or replaces a contribution type
+	// (governance only)
+	RegisterContributionType(context.Context, *MsgRegisterContributionType) (*MsgRegisterContributionTypeResponse, error)
+	// BatchEndorse endorses several contributions in one transaction
+	BatchEndorse(context.Context, *MsgBatchEndorse) (*MsgBatchEndorseResponse, error)
+	// UpdatePocFeeParams replaces the 3-layer submission fee parameters
+	// (governance only)
+	UpdatePocFeeParams(context.Context, *MsgUpdatePocFeeParams) (*MsgUpdatePocFeeParamsResponse, error)
+	// AddExemptAddress adds one address to the access-control exempt list
+	// (governance only)
+	AddExemptAddress(context.Context, *MsgAddExemptAddress) (*MsgAddExemptAddressResponse, error)
+	// RemoveExemptAddress removes one address from the access-control exempt
+	// list (governance only)
+	RemoveExemptAddress(context.Context, *MsgRemoveExemptAddress) (*MsgRemoveExemptAddressResponse, error)
+	// SubmitFraudProof proves that a validator approved a fraudulent
+	// contribution, slashing its approvers and rewarding the challenger
+	SubmitFraudProof(context.Context, *MsgSubmitFraudProof) (*MsgSubmitFraudProofResponse, error)
+	// UpdateEndorsementParams replaces the endorsement thresholds: the minimum
+	// number of approving validators and the vote weighting (governance only)
+	UpdateEndorsementParams(context.Context, *MsgUpdateEndorsementParams) (*MsgUpdateEndorsementParamsResponse, error)
+	// UpdateRejectionRefundParams sets the share of the pooled fee refunded
+	// when endorsers reject a contribution (governance only)
+	UpdateRejectionRefundParams(context.Context, *MsgUpdateRejectionRefundParams) (*MsgUpdateRejectionRefundParamsResponse, error)
+	// UpdateCScoreNamespaceParams sets whether the C-Score fee discount is
+	// scoped to the contribution type (governance only)
+	UpdateCScoreNamespaceParams(context.Context, *MsgUpdateCScoreNamespaceParams) (*MsgUpdateCScoreNamespaceParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitContribution(ctx context.Context, req *MsgSubmitContribution) (*MsgSubmitContributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitContribution not implemented")
+}
+func (*UnimplementedMsgServer) Endorse(ctx context.Context, req *MsgEndorse) (*MsgEndorseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Endorse not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawPOCRewards(ctx context.Context, req *MsgWithdrawPOCRewards) (*MsgWithdrawPOCRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPOCRewards not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func (*UnimplementedMsgServer) SetSubmissionsPaused(ctx context.Context, req *MsgSetSubmissionsPaused) (*MsgSetSubmissionsPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubmissionsPaused not implemented")
+}
+
+func (*UnimplementedMsgServer) RegisterContributionType(ctx context.Context, req *MsgRegisterContributionType) (*MsgRegisterContributionTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterContributionType not implemented")
+}
+
+func (*UnimplementedMsgServer) BatchEndorse(ctx context.Context, req *MsgBatchEndorse) (*MsgBatchEndorseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchEndorse not implemented")
+}
+
+func (*UnimplementedMsgServer) UpdatePocFeeParams(ctx context.Context, req *MsgUpdatePocFeeParams) (*MsgUpdatePocFeeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePocFeeParams not implemented")
+}
+
+func (*UnimplementedMsgServer) AddExemptAddress(ctx context.Context, req *MsgAddExemptAddress) (*MsgAddExemptAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExemptAddress not implemented")
+}
+func (*UnimplementedMsgServer) RemoveExemptAddress(ctx context.Context, req *MsgRemoveExemptAddress) (*MsgRemoveExemptAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExemptAddress not implemented")
+}
+
+func (*UnimplementedMsgServer) SubmitFraudProof(ctx context.Context, req *MsgSubmitFraudProof) (*MsgSubmitFraudProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFraudProof not implemented")
+}
+
+func (*UnimplementedMsgServer) UpdateEndorsementParams(ctx context.Context, req *MsgUpdateEndorsementParams) (*MsgUpdateEndorsementParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEndorsementParams not implemented")
+}
+
+func (*UnimplementedMsgServer) UpdateRejectionRefundParams(ctx context.Context, req *MsgUpdateRejectionRefundParams) (*MsgUpdateRejectionRefundParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRejectionRefundParams not implemented")
+}
+
+func (*UnimplementedMsgServer) UpdateCScoreNamespaceParams(ctx context.Context, req *MsgUpdateCScoreNamespaceParams) (*MsgUpdateCScoreNamespaceParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCScoreNamespaceParams not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSimilarityCommitment(ctx context.Context, req *MsgSubmitSimilarityCommitment) (*MsgSubmitSimilarityCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSimilarityCommitment not implemented")
+}
+func (*UnimplementedMsgServer) StartReview(ctx context.Context, req *MsgStartReview) (*MsgStartReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReview not implemented")
+}
+func (*UnimplementedMsgServer) CastReviewVote(ctx context.Context, req *MsgCastReviewVote) (*MsgCastReviewVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastReviewVote not implemented")
+}
+func (*UnimplementedMsgServer) FinalizeReview(ctx context.Context, req *MsgFinalizeReview) (*MsgFinalizeReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeReview not implemented")
+}
+func (*UnimplementedMsgServer) AppealReview(ctx context.Context, req *MsgAppealReview) (*MsgAppealReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealReview not implemented")
+}
+func (*UnimplementedMsgServer) ResolveAppeal(ctx context.Context, req *MsgResolveAppeal) (*MsgResolveAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAppeal not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitContribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitContribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitContribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/SubmitContribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitContribution(ctx, req.(*MsgSubmitContribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Endorse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEndorse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Endorse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/Endorse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Endorse(ctx, req.(*MsgEndorse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawPOCRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPOCRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawPOCRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/WithdrawPOCRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawPOCRewards(ctx, req.(*MsgWithdrawPOCRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSubmissionsPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSubmissionsPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSubmissionsPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/SetSubmissionsPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSubmissionsPaused(ctx, req.(*MsgSetSubmissionsPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterContributionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterContributionType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterContributionType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/RegisterContributionType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterContributionType(ctx, req.(*MsgRegisterContributionType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchEndorse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchEndorse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchEndorse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/BatchEndorse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchEndorse(ctx, req.(*MsgBatchEndorse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePocFeeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePocFeeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePocFeeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/UpdatePocFeeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePocFeeParams(ctx, req.(*MsgUpdatePocFeeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddExemptAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddExemptAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddExemptAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/AddExemptAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddExemptAddress(ctx, req.(*MsgAddExemptAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveExemptAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveExemptAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveExemptAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/RemoveExemptAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveExemptAddress(ctx, req.(*MsgRemoveExemptAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFraudProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFraudProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFraudProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/SubmitFraudProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFraudProof(ctx, req.(*MsgSubmitFraudProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEndorsementParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEndorsementParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEndorsementParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/UpdateEndorsementParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEndorsementParams(ctx, req.(*MsgUpdateEndorsementParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRejectionRefundParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRejectionRefundParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRejectionRefundParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/UpdateRejectionRefundParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRejectionRefundParams(ctx, req.(*MsgUpdateRejectionRefundParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCScoreNamespaceParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCScoreNamespaceParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCScoreNamespaceParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/UpdateCScoreNamespaceParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCScoreNamespaceParams(ctx, req.(*MsgUpdateCScoreNamespaceParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSimilarityCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSimilarityCommitment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSimilarityCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/SubmitSimilarityCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSimilarityCommitment(ctx, req.(*MsgSubmitSimilarityCommitment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/StartReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartReview(ctx, req.(*MsgStartReview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CastReviewVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCastReviewVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CastReviewVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/CastReviewVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CastReviewVote(ctx, req.(*MsgCastReviewVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FinalizeReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/FinalizeReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeReview(ctx, req.(*MsgFinalizeReview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppealReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealReview)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/AppealReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealReview(ctx, req.(*MsgAppealReview))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveAppeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.poc.v1.Msg/ResolveAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveAppeal(ctx, req.(*MsgResolveAppeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.poc.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitContribution",
+			Handler:    _Msg_SubmitContribution_Handler,
+		},
+		{
+			MethodName: "Endorse",
+			Handler:    _Msg_Endorse_Handler,
+		},
+		{
+			MethodName: "WithdrawPOCRewards",
+			Handler:    _Msg_WithdrawPOCRewards_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SubmitSimilarityCommitment",
+			Handler:    _Msg_SubmitSimilarityCommitment_Handler,
+		},
+		{
+			MethodName: "StartReview",
+			Handler:    _Msg_StartReview_Handler,
+		},
+		{
+			MethodName: "CastReviewVote",
+			Handler:    _Msg_CastReviewVote_Handler,
+		},
+		{
+			MethodName: "FinalizeReview",
+			Handler:    _Msg_FinalizeReview_Handler,
+		},
+		{
+			MethodName: "AppealReview",
+			Handler:    _Msg_AppealReview_Handler,
+		},
+		{
+			MethodName: "ResolveAppeal",
+			Handler:    _Msg_ResolveAppeal_Handler,
+		},
+		{
+			MethodName: "SetSubmissionsPaused",
+			Handler:    _Msg_SetSubmissionsPaused_Handler,
+		},
+		{
+			MethodName: "RegisterContributionType",
+			Handler:    _Msg_RegisterContributionType_Handler,
+		},
+		{
+			MethodName: "BatchEndorse",
+			Handler:    _Msg_BatchEndorse_Handler,
+		},
+		{
+			MethodName: "UpdatePocFeeParams",
+			Handler:    _Msg_UpdatePocFeeParams_Handler,
+		},
+		{
+			MethodName: "AddExemptAddress",
+			Handler:    _Msg_AddExemptAddress_Handler,
+		},
+		{
+			MethodName: "RemoveExemptAddress",
+			Handler:    _Msg_RemoveExemptAddress_Handler,
+		},
+		{
+			MethodName: "SubmitFraudProof",
+			Handler:    _Msg_SubmitFraudProof_Handler,
+		},
+		{
+			MethodName: "UpdateEndorsementParams",
+			Handler:    _Msg_UpdateEndorsementParams_Handler,
+		},
+		{
+			MethodName: "UpdateRejectionRefundParams",
+			Handler:    _Msg_UpdateRejectionRefundParams_Handler,
+		},
+		{
+			MethodName: "UpdateCScoreNamespaceParams",
+			Handler:    _Msg_UpdateCScoreNamespaceParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pos/poc/v1/tx.proto",
+}
+
+func (m *MsgSubmitContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanonicalSpecVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CanonicalSpecVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CanonicalHash) > 0 {
+		i -= len(m.CanonicalHash)
+		copy(dAtA[i:], m.CanonicalHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CanonicalHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ctype) > 0 {
+		i -= len(m.Ctype)
+		copy(dAtA[i:], m.Ctype)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ctype)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitContributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitContributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitContributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEndorse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEndorse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEndorse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decision {
+		i--
+		if m.Decision {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEndorseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEndorseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEndorseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPOCRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPOCRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPOCRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPOCRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPOCRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPOCRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSubmissionsPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSubmissionsPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSubmissionsPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSubmissionsPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSubmissionsPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSubmissionsPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterContributionType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterContributionType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterContributionType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiresIdentity {
+		i--
+		if m.RequiresIdentity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinCscore.Size()
+		i -= size
+		if _, err := m.MinCscore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RewardWeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ctype) > 0 {
+		i -= len(m.Ctype)
+		copy(dAtA[i:], m.Ctype)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ctype)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterContributionTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterContributionTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterContributionTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchEndorseItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchEndorseItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchEndorseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decision {
+		i--
+		if m.Decision {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchEndorse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchEndorse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchEndorse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchEndorseResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchEndorseResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchEndorseResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchEndorseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchEndorseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchEndorseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Endorsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Endorsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePocFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePocFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePocFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinimumSubmissionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxCscoreDiscount.Size()
+		i -= size
+		if _, err := m.MaxCscoreDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TargetSubmissionsPerBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetSubmissionsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.BaseSubmissionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePocFeeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePocFeeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePocFeeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddExemptAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddExemptAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddExemptAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddExemptAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddExemptAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddExemptAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveExemptAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveExemptAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveExemptAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveExemptAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveExemptAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveExemptAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofData) > 0 {
+		i -= len(m.ProofData)
+		copy(dAtA[i:], m.ProofData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofData)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProofType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ChallengerReward.Size()
+		i -= size
+		if _, err := m.ChallengerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEndorsementParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEndorsementParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEndorsementParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weighting) > 0 {
+		i -= len(m.Weighting)
+		copy(dAtA[i:], m.Weighting)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Weighting)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinEndorsements != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinEndorsements))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEndorsementParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEndorsementParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEndorsementParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRejectionRefundParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRejectionRefundParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRejectionRefundParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefundRatio.Size()
+		i -= size
+		if _, err := m.RefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRejectionRefundParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRejectionRefundParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRejectionRefundParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCScoreNamespaceParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCScoreNamespaceParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCScoreNamespaceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CrossCategoryFactorBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CrossCategoryFactorBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EnableTypeScopedDiscount {
+		i--
+		if m.EnableTypeScopedDiscount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCScoreNamespaceParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCScoreNamespaceParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCScoreNamespaceParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+// --- MsgStartReview Marshal/Size/Unmarshal ---
+
+func (m *MsgStartReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	return n
+}
+
+func (m *MsgStartReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			}
+			m.ContributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgStartReviewResponse Marshal/Size/Unmarshal ---
+
+func (m *MsgStartReviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartReviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReviewersAssigned) > 0 {
+		for iNdEx := len(m.ReviewersAssigned) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReviewersAssigned[iNdEx])
+			copy(dAtA[i:], m.ReviewersAssigned[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReviewersAssigned[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartReviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReviewersAssigned) > 0 {
+		for _, s := range m.ReviewersAssigned {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStartReviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartReviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewersAssigned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReviewersAssigned = append(m.ReviewersAssigned, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgCastReviewVote Marshal/Size/Unmarshal ---
+
+func (m *MsgCastReviewVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCastReviewVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCastReviewVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParentClaimOverride != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ParentClaimOverride))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.NotesPointer) > 0 {
+		i -= len(m.NotesPointer)
+		copy(dAtA[i:], m.NotesPointer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NotesPointer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.QualityScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QualityScore))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OriginalityOverride != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OriginalityOverride))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reviewer) > 0 {
+		i -= len(m.Reviewer)
+		copy(dAtA[i:], m.Reviewer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reviewer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCastReviewVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reviewer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovTx(uint64(m.Decision))
+	}
+	if m.OriginalityOverride != 0 {
+		n += 1 + sovTx(uint64(m.OriginalityOverride))
+	}
+	if m.QualityScore != 0 {
+		n += 1 + sovTx(uint64(m.QualityScore))
+	}
+	l = len(m.NotesPointer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ParentClaimOverride != 0 {
+		n += 1 + sovTx(uint64(m.ParentClaimOverride))
+	}
+	return n
+}
+
+func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCastReviewVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCastReviewVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			}
+			m.ContributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalityOverride", wireType)
+			}
+			m.OriginalityOverride = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalityOverride |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QualityScore", wireType)
+			}
+			m.QualityScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QualityScore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotesPointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotesPointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentClaimOverride", wireType)
+			}
+			m.ParentClaimOverride = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentClaimOverride |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgCastReviewVoteResponse Marshal/Size/Unmarshal ---
+
+func (m *MsgCastReviewVoteResponse) Marshal() (dAtA []byte, err error)                { return nil, nil }
+func (m *MsgCastReviewVoteResponse) MarshalTo(dAtA []byte) (int, error)                { return 0, nil }
+func (m *MsgCastReviewVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)     { return len(dAtA), nil }
+func (m *MsgCastReviewVoteResponse) Size() (n int)                                     { return 0 }
+func (m *MsgCastReviewVoteResponse) Unmarshal(dAtA []byte) error                       { return nil }
+
+// --- MsgFinalizeReview Marshal/Size/Unmarshal ---
+
+func (m *MsgFinalizeReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	return n
+}
+
+func (m *MsgFinalizeReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			}
+			m.ContributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgFinalizeReviewResponse Marshal/Size/Unmarshal ---
+
+func (m *MsgFinalizeReviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizeReviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FinalDecision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FinalDecision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizeReviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalDecision != 0 {
+		n += 1 + sovTx(uint64(m.FinalDecision))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgFinalizeReviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeReviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalDecision", wireType)
+			}
+			m.FinalDecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalDecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgAppealReview Marshal/Size/Unmarshal ---
+
+func (m *MsgAppealReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Appellant) > 0 {
+		i -= len(m.Appellant)
+		copy(dAtA[i:], m.Appellant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Appellant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Appellant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealReview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealReview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appellant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appellant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			}
+			m.ContributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgAppealReviewResponse Marshal/Size/Unmarshal ---
+
+func (m *MsgAppealReviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealReviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppealId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealReviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppealId != 0 {
+		n += 1 + sovTx(uint64(m.AppealId))
+	}
+	return n
+}
+
+func (m *MsgAppealReviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealReviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealId", wireType)
+			}
+			m.AppealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgResolveAppeal Marshal/Size/Unmarshal ---
+
+func (m *MsgResolveAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResolverNotes) > 0 {
+		i -= len(m.ResolverNotes)
+		copy(dAtA[i:], m.ResolverNotes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResolverNotes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Upheld {
+		i--
+		if m.Upheld {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppealId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AppealId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AppealId != 0 {
+		n += 1 + sovTx(uint64(m.AppealId))
+	}
+	if m.Upheld {
+		n += 2
+	}
+	l = len(m.ResolverNotes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveAppeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveAppeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealId", wireType)
+			}
+			m.AppealId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upheld", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upheld = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolverNotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolverNotes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// --- MsgResolveAppealResponse Marshal/Size/Unmarshal ---
+
+func (m *MsgResolveAppealResponse) Marshal() (dAtA []byte, err error)                { return nil, nil }
+func (m *MsgResolveAppealResponse) MarshalTo(dAtA []byte) (int, error)                { return 0, nil }
+func (m *MsgResolveAppealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)     { return len(dAtA), nil }
+func (m *MsgResolveAppealResponse) Size() (n int)                                     { return 0 }
+func (m *MsgResolveAppealResponse) Unmarshal(dAtA []byte) error                       { return nil }
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ctype)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CanonicalHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CanonicalSpecVersion != 0 {
+		n += 1 + sovTx(uint64(m.CanonicalSpecVersion))
+	}
+	return n
+}
+
+func (m *MsgSubmitContributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgEndorse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	if m.Decision {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgEndorseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgWithdrawPOCRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawPOCRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSubmissionsPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSubmissionsPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterContributionType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ctype)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardWeight != 0 {
+		n += 1 + sovTx(uint64(m.RewardWeight))
+	}
+	l = m.MinCscore.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RequiresIdentity {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterContributionTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchEndorseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	if m.Decision {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBatchEndorse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchEndorseResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchEndorseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Endorsed != 0 {
+		n += 1 + sovTx(uint64(m.Endorsed))
+	}
+	return n
+}
+
+func (m *MsgUpdatePocFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BaseSubmissionFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TargetSubmissionsPerBlock != 0 {
+		n += 1 + sovTx(uint64(m.TargetSubmissionsPerBlock))
+	}
+	l = m.MaxCscoreDiscount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinimumSubmissionFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePocFeeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddExemptAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddExemptAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveExemptAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveExemptAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFraudProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContributionId != 0 {
+		n += 1 + sovTx(uint64(m.ContributionId))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProofType != 0 {
+		n += 1 + sovTx(uint64(m.ProofType))
+	}
+	l = len(m.ProofData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFraudProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ChallengerReward.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateEndorsementParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinEndorsements != 0 {
+		n += 1 + sovTx(uint64(m.MinEndorsements))
+	}
+	l = len(m.Weighting)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateEndorsementParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRejectionRefundParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RefundRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRejectionRefundParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCScoreNamespaceParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EnableTypeScopedDiscount {
+		n += 2
+	}
+	if m.CrossCategoryFactorBps != 0 {
+		n += 1 + sovTx(uint64(m.CrossCategoryFactorBps))
+	}
+	return n
+}
+
+func (m *MsgUpdateCScoreNamespaceParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ctype", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ctype = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalHash = append(m.CanonicalHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalHash == nil {
+				m.CanonicalHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalSpecVersion", wireType)
+			}
+			m.CanonicalSpecVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalSpecVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitContributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitContributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitContributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEndorse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEndorse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEndorse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			}
+			m.ContributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decision = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEndorseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEndorseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEndorseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPOCRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPOCRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPOCRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPOCRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPOCRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPOCRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgStartReview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContributionId != 0 {
-		n += 1 + sovTx(uint64(m.ContributionId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func (m *MsgStartReview) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSubmissionsPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2049,10 +7266,10 @@ func (m *MsgStartReview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartReview: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSubmissionsPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartReview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSubmissionsPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2089,9 +7306,9 @@ func (m *MsgStartReview) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			m.ContributionId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2101,11 +7318,44 @@ func (m *MsgStartReview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContributionId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2121,62 +7371,13 @@ func (m *MsgStartReview) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-
-// --- MsgStartReviewResponse Marshal/Size/Unmarshal ---
-
-func (m *MsgStartReviewResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgStartReviewResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgStartReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReviewersAssigned) > 0 {
-		for iNdEx := len(m.ReviewersAssigned) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReviewersAssigned[iNdEx])
-			copy(dAtA[i:], m.ReviewersAssigned[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ReviewersAssigned[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgStartReviewResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ReviewersAssigned) > 0 {
-		for _, s := range m.ReviewersAssigned {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *MsgStartReviewResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSubmissionsPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2199,44 +7400,12 @@ func (m *MsgStartReviewResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartReviewResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSubmissionsPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSubmissionsPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReviewersAssigned", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReviewersAssigned = append(m.ReviewersAssigned, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2252,109 +7421,14 @@ func (m *MsgStartReviewResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-// --- MsgCastReviewVote Marshal/Size/Unmarshal ---
-
-func (m *MsgCastReviewVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCastReviewVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCastReviewVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ParentClaimOverride != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ParentClaimOverride))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.NotesPointer) > 0 {
-		i -= len(m.NotesPointer)
-		copy(dAtA[i:], m.NotesPointer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NotesPointer)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.QualityScore != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.QualityScore))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.OriginalityOverride != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OriginalityOverride))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Decision != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decision))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ContributionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Reviewer) > 0 {
-		i -= len(m.Reviewer)
-		copy(dAtA[i:], m.Reviewer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reviewer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCastReviewVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Reviewer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContributionId != 0 {
-		n += 1 + sovTx(uint64(m.ContributionId))
-	}
-	if m.Decision != 0 {
-		n += 1 + sovTx(uint64(m.Decision))
-	}
-	if m.OriginalityOverride != 0 {
-		n += 1 + sovTx(uint64(m.OriginalityOverride))
-	}
-	if m.QualityScore != 0 {
-		n += 1 + sovTx(uint64(m.QualityScore))
-	}
-	l = len(m.NotesPointer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ParentClaimOverride != 0 {
-		n += 1 + sovTx(uint64(m.ParentClaimOverride))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterContributionType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,15 +7451,15 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCastReviewVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterContributionType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCastReviewVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterContributionType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2413,13 +7487,13 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reviewer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ctype", wireType)
 			}
-			m.ContributionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2429,16 +7503,29 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContributionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ctype = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
 			}
-			m.Decision = 0
+			m.RewardWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2448,16 +7535,16 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decision |= uint32(b&0x7F) << shift
+				m.RewardWeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalityOverride", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCscore", wireType)
 			}
-			m.OriginalityOverride = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2467,16 +7554,31 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OriginalityOverride |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCscore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QualityScore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresIdentity", wireType)
 			}
-			m.QualityScore = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2486,16 +7588,118 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QualityScore |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotesPointer", wireType)
+			m.RequiresIdentity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterContributionTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterContributionTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterContributionTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BatchEndorseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchEndorseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchEndorseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			}
+			m.ContributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2505,29 +7709,16 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NotesPointer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentClaimOverride", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
 			}
-			m.ParentClaimOverride = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2537,11 +7728,12 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParentClaimOverride |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Decision = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2557,74 +7749,13 @@ func (m *MsgCastReviewVote) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-
-// --- MsgCastReviewVoteResponse Marshal/Size/Unmarshal ---
-
-func (m *MsgCastReviewVoteResponse) Marshal() (dAtA []byte, err error)                { return nil, nil }
-func (m *MsgCastReviewVoteResponse) MarshalTo(dAtA []byte) (int, error)                { return 0, nil }
-func (m *MsgCastReviewVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)     { return len(dAtA), nil }
-func (m *MsgCastReviewVoteResponse) Size() (n int)                                     { return 0 }
-func (m *MsgCastReviewVoteResponse) Unmarshal(dAtA []byte) error                       { return nil }
-
-// --- MsgFinalizeReview Marshal/Size/Unmarshal ---
-
-func (m *MsgFinalizeReview) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFinalizeReview) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgFinalizeReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContributionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgFinalizeReview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContributionId != 0 {
-		n += 1 + sovTx(uint64(m.ContributionId))
-	}
-	return n
-}
-
-func (m *MsgFinalizeReview) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchEndorse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2647,15 +7778,15 @@ func (m *MsgFinalizeReview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeReview: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchEndorse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeReview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchEndorse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2683,13 +7814,13 @@ func (m *MsgFinalizeReview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			m.ContributionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2699,11 +7830,26 @@ func (m *MsgFinalizeReview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContributionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, BatchEndorseItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2719,68 +7865,13 @@ func (m *MsgFinalizeReview) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-
-// --- MsgFinalizeReviewResponse Marshal/Size/Unmarshal ---
-
-func (m *MsgFinalizeReviewResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFinalizeReviewResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgFinalizeReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Accepted {
-		i--
-		if m.Accepted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.FinalDecision != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FinalDecision))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgFinalizeReviewResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalDecision != 0 {
-		n += 1 + sovTx(uint64(m.FinalDecision))
-	}
-	if m.Accepted {
-		n += 2
-	}
-	return n
-}
-
-func (m *MsgFinalizeReviewResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchEndorseResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2803,17 +7894,17 @@ func (m *MsgFinalizeReviewResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeReviewResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchEndorseResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchEndorseResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalDecision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
 			}
-			m.FinalDecision = 0
+			m.ContributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2823,14 +7914,14 @@ func (m *MsgFinalizeReviewResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalDecision |= uint32(b&0x7F) << shift
+				m.ContributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2847,7 +7938,59 @@ func (m *MsgFinalizeReviewResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2863,77 +8006,117 @@ func (m *MsgFinalizeReviewResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-
-// --- MsgAppealReview Marshal/Size/Unmarshal ---
-
-func (m *MsgAppealReview) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAppealReview) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAppealReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ContributionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContributionId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Appellant) > 0 {
-		i -= len(m.Appellant)
-		copy(dAtA[i:], m.Appellant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Appellant)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBatchEndorseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchEndorseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchEndorseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchEndorseResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endorsed", wireType)
+			}
+			m.Endorsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Endorsed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgAppealReview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Appellant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContributionId != 0 {
-		n += 1 + sovTx(uint64(m.ContributionId))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func (m *MsgAppealReview) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePocFeeParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2956,15 +8139,15 @@ func (m *MsgAppealReview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAppealReview: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePocFeeParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAppealReview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePocFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Appellant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2992,13 +8175,13 @@ func (m *MsgAppealReview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Appellant = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseSubmissionFee", wireType)
 			}
-			m.ContributionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3008,14 +8191,47 @@ func (m *MsgAppealReview) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContributionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseSubmissionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSubmissionsPerBlock", wireType)
+			}
+			m.TargetSubmissionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetSubmissionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCscoreDiscount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3043,105 +8259,15 @@ func (m *MsgAppealReview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MaxCscoreDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-// --- MsgAppealReviewResponse Marshal/Size/Unmarshal ---
-
-func (m *MsgAppealReviewResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAppealReviewResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAppealReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AppealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppealId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAppealReviewResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AppealId != 0 {
-		n += 1 + sovTx(uint64(m.AppealId))
-	}
-	return n
-}
-
-func (m *MsgAppealReviewResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAppealReviewResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAppealReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppealId", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumSubmissionFee", wireType)
 			}
-			m.AppealId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3151,11 +8277,25 @@ func (m *MsgAppealReviewResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppealId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumSubmissionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3168,93 +8308,67 @@ func (m *MsgAppealReviewResponse) Unmarshal(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-// --- MsgResolveAppeal Marshal/Size/Unmarshal ---
-
-func (m *MsgResolveAppeal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgResolveAppeal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgResolveAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ResolverNotes) > 0 {
-		i -= len(m.ResolverNotes)
-		copy(dAtA[i:], m.ResolverNotes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResolverNotes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Upheld {
-		i--
-		if m.Upheld {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AppealId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AppealId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgResolveAppeal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AppealId != 0 {
-		n += 1 + sovTx(uint64(m.AppealId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.Upheld {
-		n += 2
+	return nil
+}
+func (m *MsgUpdatePocFeeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePocFeeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePocFeeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.ResolverNotes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
 
-func (m *MsgResolveAppeal) Unmarshal(dAtA []byte) error {
+func (m *MsgAddExemptAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3277,10 +8391,10 @@ func (m *MsgResolveAppeal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResolveAppeal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddExemptAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResolveAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddExemptAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3316,47 +8430,8 @@ func (m *MsgResolveAppeal) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppealId", wireType)
-			}
-			m.AppealId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AppealId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upheld", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Upheld = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolverNotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3384,7 +8459,7 @@ func (m *MsgResolveAppeal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResolverNotes = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3394,168 +8469,70 @@ func (m *MsgResolveAppeal) Unmarshal(dAtA []byte) error {
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-// --- MsgResolveAppealResponse Marshal/Size/Unmarshal ---
-
-func (m *MsgResolveAppealResponse) Marshal() (dAtA []byte, err error)                { return nil, nil }
-func (m *MsgResolveAppealResponse) MarshalTo(dAtA []byte) (int, error)                { return 0, nil }
-func (m *MsgResolveAppealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)     { return len(dAtA), nil }
-func (m *MsgResolveAppealResponse) Size() (n int)                                     { return 0 }
-func (m *MsgResolveAppealResponse) Unmarshal(dAtA []byte) error                       { return nil }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSubmitContribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contributor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Ctype)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CanonicalHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CanonicalSpecVersion != 0 {
-		n += 1 + sovTx(uint64(m.CanonicalSpecVersion))
-	}
-	return n
-}
-
-func (m *MsgSubmitContributionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	return n
-}
-
-func (m *MsgEndorse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContributionId != 0 {
-		n += 1 + sovTx(uint64(m.ContributionId))
-	}
-	if m.Decision {
-		n += 2
-	}
-	return n
-}
-
-func (m *MsgEndorseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Verified {
-		n += 2
-	}
-	return n
-}
-
-func (m *MsgWithdrawPOCRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgWithdrawPOCRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgAddExemptAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddExemptAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddExemptAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveExemptAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3578,15 +8555,15 @@ func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitContribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveExemptAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveExemptAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3614,11 +8591,11 @@ func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contributor = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ctype", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3646,11 +8623,112 @@ func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ctype = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveExemptAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveExemptAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveExemptAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgSubmitFraudProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFraudProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFraudProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3678,13 +8756,32 @@ func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Challenger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+			}
+			m.ContributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3694,29 +8791,46 @@ func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofType", wireType)
+			}
+			m.ProofType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofData", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3743,30 +8857,11 @@ func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanonicalHash = append(m.CanonicalHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CanonicalHash == nil {
-				m.CanonicalHash = []byte{}
+			m.ProofData = append(m.ProofData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofData == nil {
+				m.ProofData = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalSpecVersion", wireType)
-			}
-			m.CanonicalSpecVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CanonicalSpecVersion |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3788,7 +8883,7 @@ func (m *MsgSubmitContribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitContributionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitFraudProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3811,17 +8906,17 @@ func (m *MsgSubmitContributionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitContributionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitFraudProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitContributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitFraudProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3831,11 +8926,92 @@ func (m *MsgSubmitContributionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChallengerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3857,7 +9033,8 @@ func (m *MsgSubmitContributionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEndorse) Unmarshal(dAtA []byte) error {
+
+func (m *MsgUpdateEndorsementParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3880,15 +9057,15 @@ func (m *MsgEndorse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEndorse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEndorsementParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEndorse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEndorsementParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3916,13 +9093,13 @@ func (m *MsgEndorse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContributionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEndorsements", wireType)
 			}
-			m.ContributionId = 0
+			m.MinEndorsements = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3932,16 +9109,16 @@ func (m *MsgEndorse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContributionId |= uint64(b&0x7F) << shift
+				m.MinEndorsements |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3951,12 +9128,24 @@ func (m *MsgEndorse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Decision = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weighting = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3978,7 +9167,7 @@ func (m *MsgEndorse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEndorseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEndorsementParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4001,32 +9190,12 @@ func (m *MsgEndorseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEndorseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEndorsementParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEndorseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEndorsementParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4048,7 +9217,8 @@ func (m *MsgEndorseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPOCRewards) Unmarshal(dAtA []byte) error {
+
+func (m *MsgUpdateRejectionRefundParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4071,15 +9241,15 @@ func (m *MsgWithdrawPOCRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPOCRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRejectionRefundParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPOCRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRejectionRefundParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4107,7 +9277,41 @@ func (m *MsgWithdrawPOCRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4130,7 +9334,7 @@ func (m *MsgWithdrawPOCRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPOCRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRejectionRefundParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4153,46 +9357,12 @@ func (m *MsgWithdrawPOCRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPOCRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRejectionRefundParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPOCRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRejectionRefundParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4214,7 +9384,8 @@ func (m *MsgWithdrawPOCRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+
+func (m *MsgUpdateCScoreNamespaceParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4237,10 +9408,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCScoreNamespaceParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCScoreNamespaceParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4276,10 +9447,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableTypeScopedDiscount", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4289,25 +9460,31 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EnableTypeScopedDiscount = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossCategoryFactorBps", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CrossCategoryFactorBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrossCategoryFactorBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4329,7 +9506,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCScoreNamespaceParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4352,10 +9529,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCScoreNamespaceParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCScoreNamespaceParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,8 +26,16 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdQueryParams(),
 		CmdQueryOperation(),
+		CmdQueryOperationByHash(),
+		CmdQueryOperations(),
 		CmdQueryQueuedOperations(),
 		CmdQueryExecutableOperations(),
+		CmdQueryDeferredOperations(),
+		CmdQueryEmergencyEligibleOperations(),
+		CmdQueryOperationCountdown(),
+		CmdQueryOperationsByProposal(),
+		CmdQueryPendingProposals(),
+		CmdQueryDelayPreview(),
 	)
 
 	return cmd
@@ -91,6 +100,79 @@ func CmdQueryOperation() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryOperationByHash queries a timelock operation by its hash
+func CmdQueryOperationByHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operation-by-hash [hash]",
+		Short: "Query a timelock operation by its hex-encoded hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OperationByHash(context.Background(), &types.QueryOperationByHashRequest{
+				Hash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryOperations queries timelock operations with an optional status filter
+func CmdQueryOperations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operations",
+		Short: "Query all timelock operations, optionally filtered by status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusArg, err := cmd.Flags().GetString("status")
+			if err != nil {
+				return err
+			}
+			status, err := parseOperationStatus(statusArg)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Operations(context.Background(), &types.QueryOperationsRequest{
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("status", "", "Filter by status (queued, executed, cancelled, expired, failed, handler_missing)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "operations")
+	return cmd
+}
+
 // CmdQueryQueuedOperations queries all queued timelock operations
 func CmdQueryQueuedOperations() *cobra.Command {
 	cmd := &cobra.Command{
@@ -103,8 +185,15 @@ func CmdQueryQueuedOperations() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.QueuedOperations(context.Background(), &types.QueryQueuedOperationsRequest{})
+			res, err := queryClient.QueuedOperations(context.Background(), &types.QueryQueuedOperationsRequest{
+				Pagination: pageReq,
+			})
 			if err != nil {
 				return err
 			}
@@ -114,6 +203,7 @@ func CmdQueryQueuedOperations() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued")
 	return cmd
 }
 
@@ -129,8 +219,78 @@ func CmdQueryExecutableOperations() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ExecutableOperations(context.Background(), &types.QueryExecutableOperationsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executable")
+	return cmd
+}
+
+// CmdQueryOperationsByProposal queries all timelock operations of a governance proposal
+func CmdQueryOperationsByProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operations-by-proposal [proposal-id]",
+		Short: "Query all timelock operations queued for a governance proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal ID: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OperationsByProposal(context.Background(), &types.QueryOperationsByProposalRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPendingProposals queries the proposals waiting to be queued in the timelock
+func CmdQueryPendingProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-proposals",
+		Short: "Query passed governance proposals not yet queued in the timelock",
+		Long: `List the IDs of proposals that passed governance and were marked for the
+timelock, but whose operations the timelock EndBlocker has not queued yet.
+Proposals normally leave this list in the block after they pass.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ExecutableOperations(context.Background(), &types.QueryExecutableOperationsRequest{})
+			res, err := queryClient.PendingProposals(context.Background(), &types.QueryPendingProposalsRequest{})
 			if err != nil {
 				return err
 			}
@@ -142,3 +302,23 @@ func CmdQueryExecutableOperations() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// parseOperationStatus maps a short status name ("queued") or the full enum
+// name ("OPERATION_STATUS_QUEUED") to an OperationStatus. An empty string
+// means no filter.
+func parseOperationStatus(s string) (types.OperationStatus, error) {
+	if s == "" {
+		return types.OperationStatusUnspecified, nil
+	}
+
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "OPERATION_STATUS_") {
+		name = "OPERATION_STATUS_" + name
+	}
+
+	v, ok := types.OperationStatus_value[name]
+	if !ok || v == int32(types.OperationStatusUnspecified) {
+		return types.OperationStatusUnspecified, fmt.Errorf("invalid operation status: %s", s)
+	}
+	return types.OperationStatus(v), nil
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"pos/x/timelock/types"
+)
+
+// CmdQueryOperationCountdown reports the time left until an operation becomes
+// executable, expires and becomes emergency-eligible
+func CmdQueryOperationCountdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "countdown [operation-id]",
+		Short: "Query the seconds until a timelock operation is executable, expires and is emergency-eligible",
+		Long: `Show, for one operation, the seconds until its executable time, its expiry
+and emergency eligibility, measured against the latest block time. Times
+already passed are reported as zero, with flags indicating whether the
+operation is currently executable, expired or emergency-eligible.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operationID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid operation ID: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OperationCountdown(context.Background(), &types.QueryOperationCountdownRequest{
+				OperationId: operationID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
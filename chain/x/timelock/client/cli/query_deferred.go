@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"pos/x/timelock/types"
+)
+
+// CmdQueryDeferredOperations lists ready operations waiting on the per-block
+// execution cap
+func CmdQueryDeferredOperations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deferred",
+		Short: "Query ready operations deferred by the per-block execution cap, oldest first",
+		Long: `List queued operations whose delay has passed but which have not executed
+yet, ordered by executable time (oldest first), together with the backlog size
+and the minimum number of blocks needed to drain it at the per-block cap.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DeferredOperations(context.Background(), &types.QueryDeferredOperationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"pos/x/timelock/types"
+)
+
+// CmdQueryDelayPreview previews the timelock delay a governance proposal would
+// receive, from either a proposal JSON file or a list of message type URLs.
+func CmdQueryDelayPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delay-preview [proposal-file | msg-type-url...]",
+		Short: "Preview the timelock delay and earliest execution time of a proposal",
+		Long: `Show the timelock delay a proposal would receive if it passed at the next
+governance EndBlock: its track, the track-adjusted delay, the longest matching
+per-message-type delay, the effective delay and the earliest execution time.
+
+Pass either the proposal JSON file used with "tx gov submit-proposal", or the
+message type URLs directly. The preview assumes the current block time and
+does not count against the treasury outflow or param mutation windows.
+
+Example:
+$ posd query timelock delay-preview proposal.json
+$ posd query timelock delay-preview /cosmos.bank.v1beta1.MsgSend /pos.timelock.v1.MsgUpdateParams`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgTypeURLs := args
+			if len(args) == 1 && !strings.HasPrefix(args[0], "/") {
+				msgTypeURLs, err = proposalMsgTypeURLs(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DelayPreview(context.Background(), &types.QueryDelayPreviewRequest{
+				MsgTypeUrls: msgTypeURLs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// proposalMsgTypeURLs reads the "@type" of each message in a governance
+// proposal JSON file
+func proposalMsgTypeURLs(path string) ([]string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposal struct {
+		Messages []struct {
+			Type string `json:"@type"`
+		} `json:"messages"`
+	}
+	if err := json.Unmarshal(bz, &proposal); err != nil {
+		return nil, fmt.Errorf("invalid proposal file: %w", err)
+	}
+	if len(proposal.Messages) == 0 {
+		return nil, fmt.Errorf("proposal file %s has no messages", path)
+	}
+
+	msgTypeURLs := make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		if msg.Type == "" {
+			return nil, fmt.Errorf("proposal message %d has no @type", i)
+		}
+		msgTypeURLs[i] = msg.Type
+	}
+	return msgTypeURLs, nil
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"pos/x/timelock/types"
+)
+
+// CmdQueryEmergencyEligibleOperations lists queued operations that have passed
+// the emergency delay
+func CmdQueryEmergencyEligibleOperations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-eligible",
+		Short: "Query queued operations guardians can emergency-execute now",
+		Long: `List queued operations that have passed the emergency delay and have not
+expired, in ID order, together with the time left until each becomes
+executable through the normal timelock path.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EmergencyEligibleOperations(context.Background(), &types.QueryEmergencyEligibleOperationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
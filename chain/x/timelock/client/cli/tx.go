@@ -27,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 		CmdCancelOperation(),
 		CmdEmergencyExecute(),
 		CmdUpdateGuardian(),
+		CmdSetExecutionPaused(),
 	)
 
 	return cmd
@@ -169,3 +170,43 @@ Example:
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdSetExecutionPaused creates a command to pause or resume timelock execution
+func CmdSetExecutionPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-paused [true|false]",
+		Short: "Pause or resume all timelock execution (guardian only)",
+		Long: `Pause or resume execution of all timelock operations. With a multi-guardian
+threshold each guardian's transaction counts as one approval and the change
+applies once the threshold is reached.
+
+Example:
+  posd tx timelock set-paused true --from guardian`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid paused value: %w", err)
+			}
+
+			msg := &types.MsgSetExecutionPaused{
+				Guardian: clientCtx.GetFromAddress().String(),
+				Paused:   paused,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
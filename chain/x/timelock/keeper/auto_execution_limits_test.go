@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// gasHungryRouter consumes gasPerMsg before delegating to testRouter.
+type gasHungryRouter struct {
+	testRouter
+	gasPerMsg uint64
+}
+
+func (r gasHungryRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	next := r.testRouter.Handler(msg)
+	return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		ctx.GasMeter().ConsumeGas(r.gasPerMsg, "test")
+		return next(ctx, req)
+	}
+}
+
+func TestAutoExecutionLimits_MaxOperationsPerBlockParam(t *testing.T) {
+	keeper, ctx := storeOperations(t, 6, 1)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxOperationsPerBlock = 2
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	requireQueuedIDs(t, keeper, ctx, 3, 4, 5, 6)
+
+	res, err := keeper.GetDeferredOperations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.Count)
+	require.Equal(t, uint64(2), res.MaxPerBlock)
+	require.Equal(t, uint64(2), res.BlocksToDrain)
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	requireQueuedIDs(t, keeper, ctx, 5, 6)
+}
+
+func TestAutoExecutionLimits_MaxAutoExecutionGasParam(t *testing.T) {
+	keeper, ctx, testKey := setupTimelockKeeper(t, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return gasHungryRouter{testRouter: testRouter{storeKey: testKey}, gasPerMsg: 500_000}
+	})
+	queueTestOperation(t, keeper, ctx, 1, "upos", 0)
+	queueTestOperation(t, keeper, ctx, 2, "upos", 0)
+
+	// The default limit covers the handler's gas
+	require.NoError(t, keeper.ExecuteOperation(ctx, 1, keeper.GetAuthority()))
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAutoExecutionGas = types.MinAutoExecutionGas
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	err = keeper.ExecuteOperation(ctx, 2, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrMessageExecutionFailed)
+
+	op, err := keeper.GetOperation(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusFailed, op.Status)
+
+	// Only the first operation's message ran
+	store := ctx.KVStore(testKey)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1}, store.Get([]byte("counter")))
+}
+
+func TestParams_EffectiveAutoExecutionLimits(t *testing.T) {
+	var params types.Params
+	require.Equal(t, types.DefaultMaxAutoExecutionGas, params.EffectiveMaxAutoExecutionGas())
+	require.Equal(t, types.DefaultMaxOperationsPerBlock, params.EffectiveMaxOperationsPerBlock())
+
+	params.MaxAutoExecutionGas = 5_000_000
+	params.MaxOperationsPerBlock = 20
+	require.Equal(t, uint64(5_000_000), params.EffectiveMaxAutoExecutionGas())
+	require.Equal(t, uint64(20), params.EffectiveMaxOperationsPerBlock())
+}
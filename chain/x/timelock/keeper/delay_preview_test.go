@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+func TestDelayPreview_SingleMessageType(t *testing.T) {
+	keeper, ctx := setupMessageTypeDelays(t)
+	qs := NewQueryServerImpl(keeper)
+
+	windowBefore, err := keeper.GetTreasuryOutflowWindow(ctx)
+	require.NoError(t, err)
+
+	res, err := qs.DelayPreview(ctx, &types.QueryDelayPreviewRequest{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+	})
+	require.NoError(t, err)
+	require.Equal(t, string(types.TrackTreasury), res.Track)
+	require.Equal(t, sendDelaySeconds, res.MessageTypeDelaySeconds)
+	require.Equal(t, sendDelaySeconds, res.DelaySeconds)
+	require.Equal(t, ctx.BlockTime().Unix()+int64(sendDelaySeconds), res.EarliestExecutableAtUnix)
+
+	// The preview does not count against the treasury outflow window
+	windowAfter, err := keeper.GetTreasuryOutflowWindow(ctx)
+	require.NoError(t, err)
+	require.Equal(t, windowBefore, windowAfter)
+
+	// Queuing the same proposal in this block lands on the previewed time
+	op, err := keeper.QueueOperation(ctx, 1, []sdk.Msg{testSend(1)}, keeper.GetAuthority())
+	require.NoError(t, err)
+	require.Equal(t, res.EarliestExecutableAtUnix, op.ExecutableAtUnix)
+}
+
+func TestDelayPreview_MixedMessageTypes(t *testing.T) {
+	keeper, ctx := setupMessageTypeDelays(t)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	multiSendURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	updateParamsURL := sdk.MsgTypeURL(&types.MsgUpdateParams{})
+
+	// The slowest message type sets the delay
+	res, err := keeper.PreviewQueueDelay(ctx, []string{sendURL, multiSendURL, sendURL})
+	require.NoError(t, err)
+	require.Equal(t, multiSendDelaySeconds, res.MessageTypeDelaySeconds)
+	require.Equal(t, multiSendDelaySeconds, res.DelaySeconds)
+	require.Equal(t, ctx.BlockTime().Unix()+int64(multiSendDelaySeconds), res.EarliestExecutableAtUnix)
+
+	// An unconfigured type alongside a configured one keeps the configured minimum
+	res, err = keeper.PreviewQueueDelay(ctx, []string{updateParamsURL, sendURL})
+	require.NoError(t, err)
+	require.Equal(t, sendDelaySeconds, res.DelaySeconds)
+
+	// With no configured type the adaptive delay applies, without recording
+	// the proposal as a param mutation
+	countBefore, err := keeper.GetParamMutationCount(ctx)
+	require.NoError(t, err)
+	res, err = keeper.PreviewQueueDelay(ctx, []string{updateParamsURL})
+	require.NoError(t, err)
+	require.Equal(t, string(types.TrackParamChange), res.Track)
+	require.Zero(t, res.MessageTypeDelaySeconds)
+	require.Equal(t, res.AdaptiveDelaySeconds, res.DelaySeconds)
+	countAfter, err := keeper.GetParamMutationCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, countBefore, countAfter)
+
+	_, err = keeper.PreviewQueueDelay(ctx, nil)
+	require.ErrorIs(t, err, types.ErrNoMessages)
+}
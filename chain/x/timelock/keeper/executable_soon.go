@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/timelock/types"
+)
+
+// EmitExecutableSoonWarnings emits an operation_executable_soon event for
+// each queued operation whose executable time is within the configured warning
+// window. Each operation is announced at most once; WarnedOperationIDs records
+// which have been, and entries are dropped when the operation leaves QUEUED.
+//
+// Operations that are already executable when first seen (for example when the
+// delay was shorter than one block interval past the window) are not announced.
+func (k Keeper) EmitExecutableSoonWarnings(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ExecutableSoonWarningSeconds == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	window := params.ExecutableSoonWarningDuration()
+
+	return k.walkQueuedOperations(ctx, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
+		executableAt := op.ExecutableTime()
+		if now.Before(executableAt.Add(-window)) || !now.Before(executableAt) {
+			return false, nil
+		}
+
+		warned, err := k.WarnedOperationIDs.Has(ctx, id)
+		if err != nil || warned {
+			return false, err
+		}
+		if err := k.WarnedOperationIDs.Set(ctx, id); err != nil {
+			return false, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"operation_executable_soon",
+				sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
+				sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", op.ProposalId)),
+				sdk.NewAttribute("executable_at", executableAt.String()),
+				sdk.NewAttribute("seconds_until_executable", fmt.Sprintf("%d", int64(executableAt.Sub(now).Seconds()))),
+			),
+		)
+		return false, nil
+	})
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	n := 0
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
+
+func TestExecutableSoonWarning_FiresOncePerOperation(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	start := ctx.BlockTime()
+
+	// Executable in 2h; the default window warns 1h ahead
+	queueTestOperation(t, keeper, ctx, 1, "upos", 7200)
+
+	for _, elapsed := range []time.Duration{0, 30 * time.Minute, 59 * time.Minute} {
+		require.NoError(t, keeper.EmitExecutableSoonWarnings(ctx.WithBlockTime(start.Add(elapsed))))
+	}
+	require.Zero(t, countEvents(ctx, "operation_executable_soon"))
+
+	// Crossing the window fires the warning; later blocks do not repeat it
+	for _, elapsed := range []time.Duration{61 * time.Minute, 90 * time.Minute, 119 * time.Minute} {
+		require.NoError(t, keeper.EmitExecutableSoonWarnings(ctx.WithBlockTime(start.Add(elapsed))))
+	}
+	require.Equal(t, 1, countEvents(ctx, "operation_executable_soon"))
+
+	has, err := keeper.WarnedOperationIDs.Has(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Leaving QUEUED drops the marker
+	require.NoError(t, keeper.ExecuteOperation(ctx.WithBlockTime(start.Add(2*time.Hour)), 1, keeper.GetAuthority()))
+	has, err = keeper.WarnedOperationIDs.Has(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestExecutableSoonWarning_DisabledAndAlreadyExecutable(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	start := ctx.BlockTime()
+
+	// Already executable when first seen: nothing to warn about
+	queueTestOperation(t, keeper, ctx, 1, "upos", 0)
+	queueTestOperation(t, keeper, ctx, 2, "upos", 7200)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.ExecutableSoonWarningSeconds = 0
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	require.NoError(t, keeper.EmitExecutableSoonWarnings(ctx.WithBlockTime(start.Add(90*time.Minute))))
+	require.Zero(t, countEvents(ctx, "operation_executable_soon"))
+
+	params.ExecutableSoonWarningSeconds = types.DefaultExecutableSoonWarningSeconds
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	require.NoError(t, keeper.EmitExecutableSoonWarnings(ctx.WithBlockTime(start.Add(90*time.Minute))))
+	require.Equal(t, 1, countEvents(ctx, "operation_executable_soon"))
+
+	ev := ctx.EventManager().Events()[0]
+	opID, ok := ev.GetAttribute("operation_id")
+	require.True(t, ok)
+	require.Equal(t, "2", opID.Value)
+}
@@ -77,7 +77,7 @@ func (r testRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
 	return r.handler()
 }
 
-func setupTimelockKeeper(t *testing.T, routerFactory func(*storetypes.KVStoreKey) baseapp.MessageRouter) (Keeper, sdk.Context, *storetypes.KVStoreKey) {
+func setupTimelockKeeper(t testing.TB, routerFactory func(*storetypes.KVStoreKey) baseapp.MessageRouter) (Keeper, sdk.Context, *storetypes.KVStoreKey) {
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 
@@ -192,3 +192,43 @@ func TestTimelock_AtomicExecutionRevertsOnFailure(t *testing.T) {
 	store := ctx.KVStore(testKey)
 	require.Nil(t, store.Get([]byte("counter")))
 }
+
+func TestTimelock_AutoExecuteRecordsFailureDetails(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	queueTestOperation(t, keeper, ctx, 1, "fail", 0)
+
+	ctx = ctx.WithBlockHeight(42).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusFailed, stored.Status)
+	require.Contains(t, stored.ExecutionError, "forced failure")
+	require.Equal(t, int64(42), stored.FailedAtHeight)
+	require.Equal(t, ctx.BlockTime().Unix(), stored.ExecutedAtUnix)
+
+	var attrs map[string]string
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == "operation_auto_execute_failed" {
+			attrs = make(map[string]string)
+			for _, attr := range ev.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+		}
+	}
+	require.NotNil(t, attrs)
+	require.Equal(t, stored.ExecutionError, attrs["error"])
+	require.Equal(t, "42", attrs["failed_at_height"])
+
+	// The details survive the store round trip and are served by the queries
+	qs := NewQueryServerImpl(keeper)
+	res, err := qs.Operation(ctx, &types.QueryOperationRequest{OperationId: 1})
+	require.NoError(t, err)
+	require.Equal(t, stored.ExecutionError, res.Operation.ExecutionError)
+	require.Equal(t, int64(42), res.Operation.FailedAtHeight)
+
+	failed, err := qs.Operations(ctx, &types.QueryOperationsRequest{Status: types.OperationStatusFailed})
+	require.NoError(t, err)
+	require.Len(t, failed.Operations, 1)
+	require.Equal(t, int64(42), failed.Operations[0].FailedAtHeight)
+}
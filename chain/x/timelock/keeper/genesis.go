@@ -23,14 +23,14 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		return fmt.Errorf("failed to set params: %w", err)
 	}
 
-	// Set next operation ID
-	if data.NextOperationId > 0 {
-		// Initialize the sequence to the next operation ID
-		for i := uint64(1); i < data.NextOperationId; i++ {
-			if _, err := k.NextOperationID.Next(ctx); err != nil {
-				return fmt.Errorf("failed to initialize operation ID sequence: %w", err)
-			}
-		}
+	// Set next operation ID. The sequence holds the ID the next queued
+	// operation receives; operation IDs start at 1.
+	nextID := data.NextOperationId
+	if nextID == 0 {
+		nextID = 1
+	}
+	if err := k.NextOperationID.Set(ctx, nextID); err != nil {
+		return fmt.Errorf("failed to initialize operation ID sequence: %w", err)
 	}
 
 	// Import operations
@@ -41,9 +41,17 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		}
 	}
 
+	// Import proposals still awaiting timelock processing
+	for _, proposalID := range data.PendingProposalIds {
+		if err := k.MarkProposalForTimelock(ctx, proposalID); err != nil {
+			return fmt.Errorf("failed to mark pending proposal %d: %w", proposalID, err)
+		}
+	}
+
 	k.logger.Info("timelock genesis initialized",
 		"height", sdkCtx.BlockHeight(),
 		"operations_count", len(data.Operations),
+		"pending_proposals", len(data.PendingProposalIds),
 		"guardian", data.Params.Guardian,
 	)
 
@@ -73,10 +81,16 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		nextID = 1 // Default if not set
 	}
 
+	pendingProposals, err := k.GetPendingProposals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export pending proposals: %w", err)
+	}
+
 	return &types.GenesisState{
-		Params:          params,
-		Operations:      operations,
-		NextOperationId: nextID,
+		Params:             params,
+		Operations:         operations,
+		NextOperationId:    nextID,
+		PendingProposalIds: pendingProposals,
 	}, nil
 }
 
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/timelock/types"
+)
+
+// ApproveCancel records a guardian's approval to cancel an operation. Once
+// GuardianThreshold distinct guardians have approved, the operation is
+// cancelled and true is returned. With a threshold of one the first approval
+// cancels immediately, matching CancelOperation.
+func (k Keeper) ApproveCancel(ctx context.Context, operationID uint64, guardian string, reason string) (bool, error) {
+	if err := types.ValidateCancelReason(reason); err != nil {
+		return false, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !params.IsGuardian(guardian) {
+		return false, types.ErrNotGuardian
+	}
+
+	op, err := k.GetOperation(ctx, operationID)
+	if err != nil {
+		return false, err
+	}
+	if err := k.checkCancellable(op, guardian, true); err != nil {
+		return false, err
+	}
+
+	approvers, err := k.recordGuardianApproval(ctx, k.CancelApprovals, "cancel", operationID, guardian, params)
+	if err != nil {
+		return false, err
+	}
+	if uint64(len(approvers)) < params.EffectiveGuardianThreshold() {
+		return false, nil
+	}
+
+	if err := k.cancelOperation(ctx, op, guardian, reason, approvers); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ApproveEmergency records a guardian's approval to emergency-execute an
+// operation. Once GuardianThreshold distinct guardians have approved, the
+// operation is executed and true is returned. If the threshold is reached
+// before the emergency delay has passed, the final approval fails with
+// ErrEmergencyNotEligible and may be resubmitted later.
+func (k Keeper) ApproveEmergency(ctx context.Context, operationID uint64, guardian string, justification string) (bool, error) {
+	// If guard integration is enabled, guard is the sole executor.
+	if k.guardKeeper != nil && k.guardKeeper.IsTimelockIntegrationEnabled(ctx) {
+		return false, types.ErrExecutionDisabled
+	}
+
+	if err := types.ValidateJustification(justification); err != nil {
+		return false, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !params.IsGuardian(guardian) {
+		return false, types.ErrNotGuardian
+	}
+
+	op, err := k.GetOperation(ctx, operationID)
+	if err != nil {
+		return false, err
+	}
+	if !op.IsQueued() {
+		return false, types.ErrOperationNotQueued
+	}
+
+	approvers, err := k.recordGuardianApproval(ctx, k.EmergencyApprovals, "emergency", operationID, guardian, params)
+	if err != nil {
+		return false, err
+	}
+	if uint64(len(approvers)) < params.EffectiveGuardianThreshold() {
+		return false, nil
+	}
+
+	if err := k.emergencyExecute(ctx, operationID, guardian, justification, params); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// recordGuardianApproval stores guardian's approval in approvals and returns
+// the approving guardians that are still members of the guardian set.
+func (k Keeper) recordGuardianApproval(
+	ctx context.Context,
+	approvals collections.Map[collections.Pair[uint64, string], bool],
+	action string,
+	operationID uint64,
+	guardian string,
+	params types.Params,
+) ([]string, error) {
+	key := collections.Join(operationID, guardian)
+	approved, err := approvals.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if approved {
+		return nil, fmt.Errorf("%w: %s already approved %s of operation %d",
+			types.ErrDuplicateGuardianApproval, guardian, action, operationID)
+	}
+	if err := approvals.Set(ctx, key, true); err != nil {
+		return nil, err
+	}
+
+	// Approvals from guardians removed since they approved no longer count
+	var approvers []string
+	rng := collections.NewPrefixedPairRange[uint64, string](operationID)
+	err = approvals.Walk(ctx, rng, func(key collections.Pair[uint64, string], _ bool) (bool, error) {
+		if params.IsGuardian(key.K2()) {
+			approvers = append(approvers, key.K2())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	k.logger.Warn("guardian approval recorded",
+		"action", action,
+		"operation_id", operationID,
+		"guardian", guardian,
+		"approvals", len(approvers),
+		"threshold", params.EffectiveGuardianThreshold(),
+	)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"guardian_approval",
+			sdk.NewAttribute("action", action),
+			sdk.NewAttribute("operation_id", fmt.Sprintf("%d", operationID)),
+			sdk.NewAttribute("guardian", guardian),
+			sdk.NewAttribute("approvals", fmt.Sprintf("%d", len(approvers))),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", params.EffectiveGuardianThreshold())),
+		),
+	)
+
+	return approvers, nil
+}
+
+// clearGuardianApprovals removes all cancel and emergency approvals for an
+// operation. Called by SetOperation once the operation reaches a terminal status.
+func (k Keeper) clearGuardianApprovals(ctx context.Context, operationID uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, string](operationID)
+	if err := k.CancelApprovals.Clear(ctx, rng); err != nil {
+		return err
+	}
+	return k.EmergencyApprovals.Clear(ctx, rng)
+}
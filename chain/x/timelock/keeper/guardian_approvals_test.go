@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+var (
+	guardianOne   = sdk.AccAddress("guardian_one________").String()
+	guardianTwo   = sdk.AccAddress("guardian_two________").String()
+	guardianThree = sdk.AccAddress("guardian_three______").String()
+)
+
+// setupGuardianSet configures a 2-of-3 guardian set.
+func setupGuardianSet(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+
+	keeper, ctx := storeOperations(t, 0, 1)
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.Guardian = guardianOne
+	params.Guardians = []string{guardianTwo, guardianThree}
+	params.GuardianThreshold = 2
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	return keeper, ctx.WithEventManager(sdk.NewEventManager())
+}
+
+func TestGuardianApprovals_CancelBelowThreshold(t *testing.T) {
+	keeper, ctx := setupGuardianSet(t)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	// A single guardian can no longer cancel directly
+	err := keeper.CancelOperation(ctx, 1, guardianOne, "malicious parameter change")
+	require.ErrorIs(t, err, types.ErrGuardianThresholdNotMet)
+
+	cancelled, err := keeper.ApproveCancel(ctx, 1, guardianOne, "malicious parameter change")
+	require.NoError(t, err)
+	require.False(t, cancelled)
+
+	op, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, op.IsQueued())
+	require.False(t, hasEvent(ctx, "operation_cancelled"))
+
+	// Governance is not subject to the threshold
+	require.NoError(t, keeper.CancelOperation(ctx, 1, keeper.GetAuthority(), "superseded by a later proposal"))
+}
+
+func TestGuardianApprovals_CancelAtThreshold(t *testing.T) {
+	keeper, ctx := setupGuardianSet(t)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	_, err := keeper.ApproveCancel(ctx, 1, guardianOne, "malicious parameter change")
+	require.NoError(t, err)
+
+	cancelled, err := keeper.ApproveCancel(ctx, 1, guardianThree, "malicious parameter change")
+	require.NoError(t, err)
+	require.True(t, cancelled)
+
+	op, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusCancelled, op.Status)
+	require.Equal(t, 1, countEvents(ctx, "operation_cancelled"))
+
+	// Approvals are dropped once the operation is terminal
+	has, err := keeper.CancelApprovals.Has(ctx, collections.Join(uint64(1), guardianOne))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestGuardianApprovals_RejectsDuplicateAndNonGuardian(t *testing.T) {
+	keeper, ctx := setupGuardianSet(t)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	_, err := keeper.ApproveCancel(ctx, 1, guardianTwo, "malicious parameter change")
+	require.NoError(t, err)
+
+	// The same guardian approving again does not reach the threshold
+	_, err = keeper.ApproveCancel(ctx, 1, guardianTwo, "malicious parameter change")
+	require.ErrorIs(t, err, types.ErrDuplicateGuardianApproval)
+
+	_, err = keeper.ApproveCancel(ctx, 1, sdk.AccAddress("outsider____________").String(), "malicious parameter change")
+	require.ErrorIs(t, err, types.ErrNotGuardian)
+
+	op, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, op.IsQueued())
+}
+
+func TestGuardianApprovals_EmergencyExecute(t *testing.T) {
+	keeper, ctx := setupGuardianSet(t)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	// Past the 6h emergency delay but well before the 24h delay
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(7 * time.Hour))
+	const justification = "critical vulnerability requires immediate patch"
+
+	err := keeper.EmergencyExecute(ctx, 1, guardianTwo, justification)
+	require.ErrorIs(t, err, types.ErrGuardianThresholdNotMet)
+
+	executed, err := keeper.ApproveEmergency(ctx, 1, guardianTwo, justification)
+	require.NoError(t, err)
+	require.False(t, executed)
+
+	_, err = keeper.ApproveEmergency(ctx, 1, guardianTwo, justification)
+	require.ErrorIs(t, err, types.ErrDuplicateGuardianApproval)
+
+	executed, err = keeper.ApproveEmergency(ctx, 1, guardianOne, justification)
+	require.NoError(t, err)
+	require.True(t, executed)
+
+	op, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusExecuted, op.Status)
+	require.True(t, hasEvent(ctx, "emergency_execution"))
+}
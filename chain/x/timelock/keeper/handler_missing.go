@@ -0,0 +1,146 @@
+package keeper
+
+// handler_missing.go — operations orphaned by an upgrade
+//
+// If an upgrade removes a message type, operations queued before the upgrade
+// can no longer execute: msgRouter.Handler returns nil. Such operations are
+// moved to OPERATION_STATUS_HANDLER_MISSING (not FAILED) so operators can tell
+// them apart from ordinary execution failures, and an event is emitted.
+//
+// Governance may enable auto-cancellation after a grace period via
+// MsgUpdateHandlerMissingPolicy. The policy is stored as JSON in the raw KV
+// store, like the AST v2 track state.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/timelock/types"
+)
+
+// handlerMissingCancelReason is recorded on operations cancelled by the policy
+const handlerMissingCancelReason = "auto-cancelled: message handler missing after upgrade"
+
+// GetHandlerMissingPolicy returns the stored policy, or the default if unset.
+func (k Keeper) GetHandlerMissingPolicy(ctx context.Context) (types.HandlerMissingPolicy, error) {
+	store := k.storeKey.OpenKVStore(ctx)
+	bz, err := store.Get(types.HandlerMissingPolicyKey)
+	if err != nil {
+		return types.HandlerMissingPolicy{}, err
+	}
+	if bz == nil {
+		return types.DefaultHandlerMissingPolicy(), nil
+	}
+	var p types.HandlerMissingPolicy
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return types.HandlerMissingPolicy{}, err
+	}
+	return p, nil
+}
+
+// SetHandlerMissingPolicy validates and persists the policy.
+func (k Keeper) SetHandlerMissingPolicy(ctx context.Context, p types.HandlerMissingPolicy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	store := k.storeKey.OpenKVStore(ctx)
+	bz, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.HandlerMissingPolicyKey, bz)
+}
+
+// markHandlerMissing records that op cannot execute because a handler is gone.
+// Returns true if execErr was a missing-handler error and op was updated.
+func (k Keeper) markHandlerMissing(ctx context.Context, op *types.QueuedOperation, now time.Time, execErr error) bool {
+	if !errors.Is(execErr, types.ErrHandlerMissing) {
+		return false
+	}
+
+	op.MarkHandlerMissing(now, execErr)
+	if err := k.SetOperation(ctx, op); err != nil {
+		k.logger.Error("failed to update operation after missing handler",
+			"operation_id", op.Id, "error", err)
+	}
+
+	k.logger.Error("operation orphaned: message handler missing (removed by upgrade?)",
+		"operation_id", op.Id,
+		"proposal_id", op.ProposalId,
+		"error", execErr,
+	)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"operation_handler_missing",
+			sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", op.ProposalId)),
+			sdk.NewAttribute("error", execErr.Error()),
+		),
+	)
+	return true
+}
+
+// CancelOrphanedOperations cancels HANDLER_MISSING operations whose grace
+// period has elapsed. No-op unless the policy enables auto-cancel.
+// Called from EndBlock.
+func (k Keeper) CancelOrphanedOperations(ctx context.Context) error {
+	policy, err := k.GetHandlerMissingPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if !policy.AutoCancelEnabled {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	// Only operations in the handler-missing index are visited; cancelling
+	// removes them from it, so they are collected before any write
+	var toCancel []types.QueuedOperation
+	err = k.HandlerMissingIDs.Walk(ctx, nil, func(id uint64) (bool, error) {
+		op, err := k.Operations.Get(ctx, id)
+		if err != nil {
+			return true, fmt.Errorf("handler-missing operation %d: %w", id, err)
+		}
+		if now.Unix() >= op.ExecutedAtUnix+int64(policy.GracePeriodSeconds) {
+			toCancel = append(toCancel, op)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range toCancel {
+		op := &toCancel[i]
+		op.MarkCancelled(now, handlerMissingCancelReason)
+		if err := k.SetOperation(ctx, op); err != nil {
+			return err
+		}
+
+		k.logger.Warn("orphaned operation auto-cancelled",
+			"operation_id", op.Id,
+			"proposal_id", op.ProposalId,
+			"grace_period_seconds", policy.GracePeriodSeconds,
+		)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"operation_handler_missing_cancelled",
+				sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
+				sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", op.ProposalId)),
+				sdk.NewAttribute("reason", handlerMissingCancelReason),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// upgradedRouter simulates a router after an upgrade removed some message types
+type upgradedRouter struct {
+	testRouter
+	removed map[string]bool
+}
+
+func (r upgradedRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+}
+
+func (r upgradedRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	if r.removed[typeURL] {
+		return nil
+	}
+	return r.testRouter.HandlerByTypeURL(typeURL)
+}
+
+func setupOrphanedOperation(t *testing.T) (Keeper, sdk.Context, *storetypes.KVStoreKey, *types.QueuedOperation) {
+	t.Helper()
+
+	keeper, ctx, testKey := setupTimelockKeeper(t, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return upgradedRouter{
+			testRouter: testRouter{storeKey: testKey},
+			removed:    map[string]bool{sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): true},
+		}
+	})
+
+	// The first message still has a handler; it must not be executed either
+	send := &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress("from_______________").String(),
+		ToAddress:   sdk.AccAddress("to________________").String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("upos", 1)),
+	}
+	multiSend := &banktypes.MsgMultiSend{}
+
+	op, err := types.NewQueuedOperation(1, 1, []sdk.Msg{send, multiSend}, keeper.GetAuthority(), ctx.BlockTime(), 0, 3600, keeper.cdc)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetOperation(ctx, op))
+
+	return keeper, ctx, testKey, op
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestHandlerMissing_ExecuteMarksDistinctStatus(t *testing.T) {
+	keeper, ctx, testKey, op := setupOrphanedOperation(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	err := keeper.ExecuteOperation(ctx, op.Id, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrHandlerMissing)
+
+	stored, err := keeper.GetOperation(ctx, op.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusHandlerMissing, stored.Status)
+	require.Contains(t, stored.ExecutionError, "MsgMultiSend")
+	require.True(t, hasEvent(ctx, "operation_handler_missing"))
+
+	// No message ran, including the one whose handler still exists
+	require.Nil(t, ctx.KVStore(testKey).Get([]byte("counter")))
+}
+
+func TestHandlerMissing_AutoExecuteMarksDistinctStatus(t *testing.T) {
+	keeper, ctx, _, op := setupOrphanedOperation(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+
+	stored, err := keeper.GetOperation(ctx, op.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusHandlerMissing, stored.Status)
+	require.True(t, hasEvent(ctx, "operation_handler_missing"))
+	require.False(t, hasEvent(ctx, "operation_auto_execute_failed"))
+}
+
+func TestHandlerMissing_NoAutoCancelByDefault(t *testing.T) {
+	keeper, ctx, _, op := setupOrphanedOperation(t)
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(365 * 24 * time.Hour))
+	require.NoError(t, keeper.CancelOrphanedOperations(later))
+
+	stored, err := keeper.GetOperation(later, op.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusHandlerMissing, stored.Status)
+}
+
+func TestHandlerMissing_AutoCancelAfterGracePeriod(t *testing.T) {
+	keeper, ctx, _, op := setupOrphanedOperation(t)
+	require.NoError(t, keeper.SetHandlerMissingPolicy(ctx, types.HandlerMissingPolicy{
+		AutoCancelEnabled:  true,
+		GracePeriodSeconds: 3600,
+	}))
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	indexed, err := keeper.HandlerMissingIDs.Has(ctx, op.Id)
+	require.NoError(t, err)
+	require.True(t, indexed)
+
+	// Still inside the grace period
+	early := ctx.WithBlockTime(ctx.BlockTime().Add(59 * time.Minute))
+	require.NoError(t, keeper.CancelOrphanedOperations(early))
+	stored, err := keeper.GetOperation(early, op.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusHandlerMissing, stored.Status)
+
+	// Grace period elapsed
+	late := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.CancelOrphanedOperations(late))
+	stored, err = keeper.GetOperation(late, op.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusCancelled, stored.Status)
+	require.Equal(t, handlerMissingCancelReason, stored.CancelReason)
+	require.True(t, hasEvent(late, "operation_handler_missing_cancelled"))
+
+	// Cancelled operations leave the handler-missing index
+	indexed, err = keeper.HandlerMissingIDs.Has(late, op.Id)
+	require.NoError(t, err)
+	require.False(t, indexed)
+}
+
+func TestHandlerMissingPolicy_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultHandlerMissingPolicy().Validate())
+
+	p := types.HandlerMissingPolicy{AutoCancelEnabled: true, GracePeriodSeconds: types.MaxHandlerMissingGraceSeconds + 1}
+	require.ErrorIs(t, p.Validate(), types.ErrInvalidHandlerMissingPolicy)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"pos/x/timelock/types"
@@ -40,16 +41,28 @@ type Keeper struct {
 	// Gov keeper reference for accessing proposals (set after initialization)
 	govKeeper GovKeeperI
 
+	// haltWithoutGovKeeper makes EndBlock fail (halting the chain) when
+	// proposals are pending but no gov keeper is set. Defaults to true.
+	haltWithoutGovKeeper bool
+
 	// Guard keeper reference for notifying guard module of queued proposals
 	guardKeeper types.GuardKeeperI
 
 	// Collections for type-safe state management
-	Schema           collections.Schema
-	Params           collections.Item[types.Params]
-	Operations       collections.Map[uint64, types.QueuedOperation]
-	OperationsByHash collections.Map[string, uint64]
-	NextOperationID  collections.Sequence
-	PendingProposals collections.Map[uint64, bool] // Proposals pending timelock processing
+	Schema               collections.Schema
+	Params               collections.Item[types.Params]
+	Operations           collections.Map[uint64, types.QueuedOperation]
+	OperationsByHash     collections.Map[string, uint64]
+	OperationsByProposal collections.Map[collections.Pair[uint64, uint64], bool] // (proposal ID, operation ID) index
+	QueuedOperationIDs   collections.KeySet[uint64]                              // IDs of operations in QUEUED status
+	HandlerMissingIDs    collections.KeySet[uint64]                              // IDs of operations in HANDLER_MISSING status
+	WarnedOperationIDs   collections.KeySet[uint64]                              // Queued operations already announced as executable soon
+	CancelApprovals      collections.Map[collections.Pair[uint64, string], bool] // (operation ID, guardian) cancel approvals
+	EmergencyApprovals   collections.Map[collections.Pair[uint64, string], bool] // (operation ID, guardian) emergency-execute approvals
+	GraceExtensions      collections.Map[uint64, uint64]                         // operation ID -> total seconds added to its grace period
+	PauseApprovals       collections.KeySet[collections.Pair[bool, string]]      // (paused, guardian) approvals to change the execution pause
+	NextOperationID      collections.Sequence
+	PendingProposals     collections.Map[uint64, bool] // Proposals pending timelock processing
 }
 
 // NewKeeper creates a new timelock keeper
@@ -69,6 +82,8 @@ func NewKeeper(
 		authority: authority,
 		msgRouter: msgRouter,
 
+		haltWithoutGovKeeper: true,
+
 		Params: collections.NewItem(
 			sb,
 			collections.NewPrefix(types.ParamsKey),
@@ -89,6 +104,58 @@ func NewKeeper(
 			collections.StringKey,
 			collections.Uint64Value,
 		),
+		OperationsByProposal: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.OperationByProposalKeyPrefix),
+			"operations_by_proposal",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			collections.BoolValue,
+		),
+		QueuedOperationIDs: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.QueuedOperationIDsKeyPrefix),
+			"queued_operation_ids",
+			collections.Uint64Key,
+		),
+		HandlerMissingIDs: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.HandlerMissingOperationIDsKeyPrefix),
+			"handler_missing_operation_ids",
+			collections.Uint64Key,
+		),
+		WarnedOperationIDs: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.WarnedOperationIDsKeyPrefix),
+			"warned_operation_ids",
+			collections.Uint64Key,
+		),
+		CancelApprovals: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.CancelApprovalsKeyPrefix),
+			"cancel_approvals",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			collections.BoolValue,
+		),
+		EmergencyApprovals: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.EmergencyApprovalsKeyPrefix),
+			"emergency_approvals",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			collections.BoolValue,
+		),
+		GraceExtensions: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.GraceExtensionsKeyPrefix),
+			"grace_extensions",
+			collections.Uint64Key,
+			collections.Uint64Value,
+		),
+		PauseApprovals: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.PauseApprovalsKeyPrefix),
+			"pause_approvals",
+			collections.PairKeyCodec(collections.BoolKey, collections.StringKey),
+		),
 		NextOperationID: collections.NewSequence(
 			sb,
 			collections.NewPrefix(types.NextOperationIDKey),
@@ -128,6 +195,15 @@ func (k *Keeper) SetGovKeeper(govKeeper GovKeeperI) {
 	k.govKeeper = govKeeper
 }
 
+// SetHaltWithoutGovKeeper controls what ProcessPendingProposals does when
+// proposals are pending but no gov keeper is set. With halt true (the default)
+// it returns an error, halting the chain before gov can execute the proposals
+// without a timelock. With halt false it logs an error and emits an event each
+// block, leaving the proposals pending; only use this on test networks.
+func (k *Keeper) SetHaltWithoutGovKeeper(halt bool) {
+	k.haltWithoutGovKeeper = halt
+}
+
 // SetGuardKeeper sets the guard keeper reference for proposal notifications.
 // This must be called after keeper initialization in app.go.
 func (k *Keeper) SetGuardKeeper(gk types.GuardKeeperI) {
@@ -169,13 +245,13 @@ func (k Keeper) GetGuardian(ctx context.Context) (string, error) {
 	return params.Guardian, nil
 }
 
-// IsGuardian checks if the given address is the guardian
+// IsGuardian checks if the given address is a member of the guardian set
 func (k Keeper) IsGuardian(ctx context.Context, addr string) (bool, error) {
-	guardian, err := k.GetGuardian(ctx)
+	params, err := k.GetParams(ctx)
 	if err != nil {
 		return false, err
 	}
-	return guardian == addr, nil
+	return params.IsGuardian(addr), nil
 }
 
 // ----------------------------------------------------------------------------
@@ -225,6 +301,32 @@ func (k Keeper) SetOperation(ctx context.Context, op *types.QueuedOperation) err
 		return err
 	}
 
+	// Store proposal index
+	if err := k.OperationsByProposal.Set(ctx, collections.Join(op.ProposalId, op.Id), true); err != nil {
+		return err
+	}
+
+	// Every status transition goes through here, so the status indexes are
+	// kept in step with op.Status
+	if op.IsHandlerMissing() {
+		if err := k.HandlerMissingIDs.Set(ctx, op.Id); err != nil {
+			return err
+		}
+	} else if err := k.HandlerMissingIDs.Remove(ctx, op.Id); err != nil {
+		return err
+	}
+	if op.Status == types.OperationStatusQueued {
+		return k.QueuedOperationIDs.Set(ctx, op.Id)
+	}
+	if err := k.QueuedOperationIDs.Remove(ctx, op.Id); err != nil {
+		return err
+	}
+	if err := k.WarnedOperationIDs.Remove(ctx, op.Id); err != nil {
+		return err
+	}
+	if op.Status.IsTerminal() {
+		return k.clearGuardianApprovals(ctx, op.Id)
+	}
 	return nil
 }
 
@@ -257,8 +359,10 @@ func (k Keeper) QueueOperation(
 	if len(messages) == 0 {
 		return nil, types.ErrNoMessages
 	}
-
-	// --- AST v2: Track resolution and paused-gate check ---
+	// Reject unroutable or malformed messages now rather than after the delay
+	if err := k.validateMessages(messages); err != nil {
+		return nil, err
+	}
 
 	// Extract type URLs for classification (does not require proto decode)
 	msgTypeURLs := make([]string, len(messages))
@@ -266,6 +370,116 @@ func (k Keeper) QueueOperation(
 		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
 	}
 
+	delay, err := k.computeQueueDelay(ctx, params, proposalID, msgTypeURLs)
+	if err != nil {
+		return nil, err
+	}
+	track, adaptiveDelay := delay.Track, delay.Delay
+
+	// Get next operation ID
+	opID, err := k.GetNextOperationID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the operation using the adaptive delay
+	op, err := types.NewQueuedOperation(
+		opID,
+		proposalID,
+		messages,
+		executor,
+		sdkCtx.BlockTime(),
+		adaptiveDelay,
+		params.GracePeriodSeconds,
+		k.cdc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for duplicate hash
+	hashStr := hex.EncodeToString(op.OperationHash)
+	exists, err := k.OperationsByHash.Has(ctx, hashStr)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, types.ErrOperationAlreadyExists
+	}
+
+	// Store the operation
+	if err := k.SetOperation(ctx, op); err != nil {
+		return nil, err
+	}
+
+	// --- AST v2: Persist immutable track record ---
+	trackRecord := types.OperationTrackRecord{
+		OperationID:          opID,
+		TrackName:            track.Name,
+		ComputedDelaySeconds: adaptiveDelay,
+	}
+	if err := k.SetOperationTrackRecord(ctx, trackRecord); err != nil {
+		// Non-fatal: operation is already stored. Log and continue.
+		k.logger.Error("failed to store operation track record (non-fatal)",
+			"operation_id", opID, "error", err)
+	}
+
+	k.logger.Info("operation queued",
+		"operation_id", op.Id,
+		"proposal_id", proposalID,
+		"track", track.Name,
+		"track_multiplier", track.Multiplier,
+		"adaptive_delay_seconds", adaptiveDelay,
+		"executable_at", op.ExecutableTime(),
+		"expires_at", op.ExpiresTime(),
+		"hash", hashStr,
+	)
+
+	// Emit enriched event (backward-compatible: all original attributes preserved)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"operation_queued",
+			sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute("executable_at", op.ExecutableTime().String()),
+			sdk.NewAttribute("expires_at", op.ExpiresTime().String()),
+			sdk.NewAttribute("operation_hash", hashStr),
+			// AST v2 additions
+			sdk.NewAttribute("track", track.Name),
+			sdk.NewAttribute("track_multiplier", fmt.Sprintf("%d", track.Multiplier)),
+			sdk.NewAttribute("adaptive_delay_seconds", fmt.Sprintf("%d", adaptiveDelay)),
+		),
+	)
+
+	return op, nil
+}
+
+// queueDelay is the delay an operation incurs when it is queued, along with
+// the factors that produced it
+type queueDelay struct {
+	Track types.Track
+	// AdaptiveDelay is the multi-factor delay before per-message-type minimums
+	AdaptiveDelay        uint64
+	MessageTypeDelay     uint64
+	Delay                uint64
+	CumulativeEscalate   bool
+	MutationFreqExceeded bool
+}
+
+// computeQueueDelay resolves the track for the given message types and
+// computes the delay of an operation queued now. It records the proposal in
+// the treasury outflow and param mutation windows, so PreviewQueueDelay runs
+// it on a discarded cache context.
+func (k Keeper) computeQueueDelay(
+	ctx context.Context,
+	params types.Params,
+	proposalID uint64,
+	msgTypeURLs []string,
+) (queueDelay, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// --- AST v2: Track resolution and paused-gate check ---
+
 	track, err := k.TrackForProposal(ctx, msgTypeURLs)
 	if err != nil {
 		// Non-fatal: fall back to TRACK_OTHER and log
@@ -279,7 +493,7 @@ func (k Keeper) QueueOperation(
 
 	// Gate: paused track blocks new queuing
 	if track.Paused {
-		return nil, fmt.Errorf("%w: track %s is paused", types.ErrTrackPaused, track.Name)
+		return queueDelay{}, fmt.Errorf("%w: track %s is paused", types.ErrTrackPaused, track.Name)
 	}
 
 	// --- AST v2: Cumulative treasury outflow detection ---
@@ -342,91 +556,65 @@ func (k Keeper) QueueOperation(
 		mutationFreqExceeded,
 	)
 
+	// Per-message-type minimums: the operation waits at least as long as the
+	// slowest of its message types requires.
+	messageTypeDelay := params.MessageTypeDelay(msgTypeURLs)
+	delay := adaptiveDelay
+	if messageTypeDelay > delay {
+		delay = messageTypeDelay
+	}
+
 	k.logger.Info("adaptive delay computed for proposal",
 		"proposal_id", proposalID,
 		"track", track.Name,
 		"base_delay_seconds", params.MinDelaySeconds,
-		"adaptive_delay_seconds", adaptiveDelay,
+		"message_type_delay_seconds", messageTypeDelay,
+		"adaptive_delay_seconds", delay,
 		"cumulative_escalate", cumulativeEscalate,
 		"mutation_freq_exceeded", mutationFreqExceeded,
 	)
 
-	// Get next operation ID
-	opID, err := k.GetNextOperationID(ctx)
-	if err != nil {
-		return nil, err
-	}
+	return queueDelay{
+		Track:                track,
+		AdaptiveDelay:        adaptiveDelay,
+		MessageTypeDelay:     messageTypeDelay,
+		Delay:                delay,
+		CumulativeEscalate:   cumulativeEscalate,
+		MutationFreqExceeded: mutationFreqExceeded,
+	}, nil
+}
 
-	// Create the operation using the adaptive delay
-	op, err := types.NewQueuedOperation(
-		opID,
-		proposalID,
-		messages,
-		executor,
-		sdkCtx.BlockTime(),
-		adaptiveDelay,
-		params.GracePeriodSeconds,
-		k.cdc,
-	)
-	if err != nil {
-		return nil, err
+// PreviewQueueDelay returns the delay a proposal with the given message types
+// would receive if it passed and were queued at the current block, without
+// recording it in the treasury outflow or param mutation windows.
+func (k Keeper) PreviewQueueDelay(ctx context.Context, msgTypeURLs []string) (types.QueryDelayPreviewResponse, error) {
+	if len(msgTypeURLs) == 0 {
+		return types.QueryDelayPreviewResponse{}, types.ErrNoMessages
 	}
 
-	// Check for duplicate hash
-	hashStr := hex.EncodeToString(op.OperationHash)
-	exists, err := k.OperationsByHash.Has(ctx, hashStr)
+	params, err := k.GetParams(ctx)
 	if err != nil {
-		return nil, err
+		return types.QueryDelayPreviewResponse{}, err
 	}
-	if exists {
-		return nil, types.ErrOperationAlreadyExists
-	}
-
-	// Store the operation
-	if err := k.SetOperation(ctx, op); err != nil {
-		return nil, err
-	}
-
-	// --- AST v2: Persist immutable track record ---
-	trackRecord := types.OperationTrackRecord{
-		OperationID:          opID,
-		TrackName:            track.Name,
-		ComputedDelaySeconds: adaptiveDelay,
-	}
-	if err := k.SetOperationTrackRecord(ctx, trackRecord); err != nil {
-		// Non-fatal: operation is already stored. Log and continue.
-		k.logger.Error("failed to store operation track record (non-fatal)",
-			"operation_id", opID, "error", err)
-	}
-
-	k.logger.Info("operation queued",
-		"operation_id", op.Id,
-		"proposal_id", proposalID,
-		"track", track.Name,
-		"track_multiplier", track.Multiplier,
-		"adaptive_delay_seconds", adaptiveDelay,
-		"executable_at", op.ExecutableTime(),
-		"expires_at", op.ExpiresTime(),
-		"hash", hashStr,
-	)
-
-	// Emit enriched event (backward-compatible: all original attributes preserved)
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"operation_queued",
-			sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
-			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", proposalID)),
-			sdk.NewAttribute("executable_at", op.ExecutableTime().String()),
-			sdk.NewAttribute("expires_at", op.ExpiresTime().String()),
-			sdk.NewAttribute("operation_hash", hashStr),
-			// AST v2 additions
-			sdk.NewAttribute("track", track.Name),
-			sdk.NewAttribute("track_multiplier", fmt.Sprintf("%d", track.Multiplier)),
-			sdk.NewAttribute("adaptive_delay_seconds", fmt.Sprintf("%d", adaptiveDelay)),
-		),
-	)
 
-	return op, nil
+	// Queuing records the proposal in the escalation windows; run it on a
+	// cache context and discard the writes.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+	delay, err := k.computeQueueDelay(cacheCtx, params, 0, msgTypeURLs)
+	if err != nil {
+		return types.QueryDelayPreviewResponse{}, err
+	}
+
+	return types.QueryDelayPreviewResponse{
+		Track:                    delay.Track.Name,
+		AdaptiveDelaySeconds:     delay.AdaptiveDelay,
+		MessageTypeDelaySeconds:  delay.MessageTypeDelay,
+		DelaySeconds:             delay.Delay,
+		EarliestExecutableAtUnix: sdkCtx.BlockTime().Unix() + int64(delay.Delay),
+		CumulativeEscalate:       delay.CumulativeEscalate,
+		MutationFreqExceeded:     delay.MutationFreqExceeded,
+	}, nil
 }
 
 // ExecuteOperation executes a queued operation
@@ -445,6 +633,10 @@ func (k Keeper) ExecuteOperation(ctx context.Context, operationID uint64, execut
 		return err
 	}
 
+	if k.IsExecutionPaused(ctx) && !k.clearsExecutionPause(*op) {
+		return types.ErrExecutionPaused
+	}
+
 	// Prevent double execution if guard already executed this proposal.
 	if k.guardKeeper != nil && k.guardKeeper.HasExecutionMarker(ctx, op.ProposalId) {
 		return types.ErrOperationAlreadyExecuted
@@ -490,8 +682,11 @@ func (k Keeper) ExecuteOperation(ctx context.Context, operationID uint64, execut
 	}
 
 	// Execute the messages
-	if err := k.executeMessages(ctx, op); err != nil {
-		op.MarkFailed(now, err)
+	if _, err := k.executeMessages(ctx, op); err != nil {
+		if k.markHandlerMissing(ctx, op, now, err) {
+			return err
+		}
+		op.MarkFailed(now, sdkCtx.BlockHeight(), err)
 		if setErr := k.SetOperation(ctx, op); setErr != nil {
 			k.logger.Error("failed to update operation after execution failure",
 				"operation_id", op.Id, "error", setErr)
@@ -526,37 +721,55 @@ func (k Keeper) ExecuteOperation(ctx context.Context, operationID uint64, execut
 
 // CancelOperation cancels a queued operation
 func (k Keeper) CancelOperation(ctx context.Context, operationID uint64, canceller string, reason string) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
 	// Validate cancellation reason
 	if err := types.ValidateCancelReason(reason); err != nil {
 		return err
 	}
 
 	// Verify canceller is guardian or governance
-	isGuardian, err := k.IsGuardian(ctx, canceller)
+	params, err := k.GetParams(ctx)
 	if err != nil {
 		return err
 	}
+	isGuardian := params.IsGuardian(canceller) && canceller != k.authority
 	if !isGuardian && canceller != k.authority {
 		return types.ErrNotGuardian
 	}
 
+	// With a multi-guardian threshold, guardians cancel through ApproveCancel
+	if isGuardian && params.EffectiveGuardianThreshold() > 1 {
+		return fmt.Errorf("%w: %d guardian approvals required, use ApproveCancel",
+			types.ErrGuardianThresholdNotMet, params.EffectiveGuardianThreshold())
+	}
+
 	// Get the operation
 	op, err := k.GetOperation(ctx, operationID)
 	if err != nil {
 		return err
 	}
 
-	// Check status
-	if !op.IsQueued() {
+	if err := k.checkCancellable(op, canceller, isGuardian); err != nil {
+		return err
+	}
+
+	var guardians []string
+	if isGuardian {
+		guardians = []string{canceller}
+	}
+	return k.cancelOperation(ctx, op, canceller, reason, guardians)
+}
+
+// checkCancellable verifies that op may be cancelled by canceller.
+func (k Keeper) checkCancellable(op *types.QueuedOperation, canceller string, isGuardian bool) error {
+	// Check status. Operations orphaned by an upgrade may also be cancelled.
+	if !op.IsQueued() && !op.IsHandlerMissing() {
 		return types.ErrOperationNotQueued
 	}
 
 	// SECURITY: Prevent guardian from canceling operations that modify guardian role or timelock params.
 	// This prevents the guardian from making themselves irremovable by canceling governance proposals
 	// that would replace or remove them.
-	if isGuardian && canceller != k.authority {
+	if isGuardian {
 		for _, anyMsg := range op.Messages {
 			if anyMsg.TypeUrl == "/pos.timelock.v1.MsgUpdateGuardian" ||
 				anyMsg.TypeUrl == "/pos.timelock.v1.MsgUpdateParams" {
@@ -570,6 +783,14 @@ func (k Keeper) CancelOperation(ctx context.Context, operationID uint64, cancell
 		}
 	}
 
+	return nil
+}
+
+// cancelOperation marks op cancelled. guardians lists the guardians who acted
+// (empty for governance) and are subject to cancel-frequency tracking.
+func (k Keeper) cancelOperation(ctx context.Context, op *types.QueuedOperation, canceller string, reason string, guardians []string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
 	// Mark as cancelled
 	op.MarkCancelled(sdkCtx.BlockTime(), reason)
 	if err := k.SetOperation(ctx, op); err != nil {
@@ -597,8 +818,8 @@ func (k Keeper) CancelOperation(ctx context.Context, operationID uint64, cancell
 	// SECURITY: Track guardian cancellation frequency and auto-revoke if excessive.
 	// This prevents a guardian from DoS-ing governance by spamming cancels on
 	// non-protected operations.
-	if isGuardian && canceller != k.authority {
-		k.trackGuardianCancel(ctx, canceller)
+	for _, guardian := range guardians {
+		k.trackGuardianCancel(ctx, guardian)
 	}
 
 	return nil
@@ -606,9 +827,6 @@ func (k Keeper) CancelOperation(ctx context.Context, operationID uint64, cancell
 
 // EmergencyExecute executes an operation with reduced delay (guardian only)
 func (k Keeper) EmergencyExecute(ctx context.Context, operationID uint64, guardian string, justification string) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	now := sdkCtx.BlockTime()
-
 	// If guard integration is enabled, guard is the sole executor.
 	if k.guardKeeper != nil && k.guardKeeper.IsTimelockIntegrationEnabled(ctx) {
 		return types.ErrExecutionDisabled
@@ -620,18 +838,31 @@ func (k Keeper) EmergencyExecute(ctx context.Context, operationID uint64, guardi
 	}
 
 	// Verify guardian
-	isGuardian, err := k.IsGuardian(ctx, guardian)
+	params, err := k.GetParams(ctx)
 	if err != nil {
 		return err
 	}
-	if !isGuardian {
+	if !params.IsGuardian(guardian) {
 		return types.ErrNotGuardian
 	}
 
-	// Get params for emergency delay
-	params, err := k.GetParams(ctx)
-	if err != nil {
-		return err
+	// With a multi-guardian threshold, guardians act through ApproveEmergency
+	if params.EffectiveGuardianThreshold() > 1 {
+		return fmt.Errorf("%w: %d guardian approvals required, use ApproveEmergency",
+			types.ErrGuardianThresholdNotMet, params.EffectiveGuardianThreshold())
+	}
+
+	return k.emergencyExecute(ctx, operationID, guardian, justification, params)
+}
+
+// emergencyExecute performs an emergency execution once the guardian
+// requirements have been satisfied.
+func (k Keeper) emergencyExecute(ctx context.Context, operationID uint64, guardian string, justification string, params types.Params) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	if params.Paused {
+		return types.ErrExecutionPaused
 	}
 
 	// Get the operation
@@ -678,8 +909,11 @@ func (k Keeper) EmergencyExecute(ctx context.Context, operationID uint64, guardi
 	}
 
 	// Execute the messages
-	if err := k.executeMessages(ctx, op); err != nil {
-		op.MarkFailed(now, err)
+	if _, err := k.executeMessages(ctx, op); err != nil {
+		if k.markHandlerMissing(ctx, op, now, err) {
+			return err
+		}
+		op.MarkFailed(now, sdkCtx.BlockHeight(), err)
 		if setErr := k.SetOperation(ctx, op); setErr != nil {
 			k.logger.Error("failed to update operation after emergency execution failure",
 				"operation_id", op.Id, "error", setErr)
@@ -714,50 +948,59 @@ func (k Keeper) EmergencyExecute(ctx context.Context, operationID uint64, guardi
 	return nil
 }
 
-// executeMessages executes all messages in an operation
-// MaxAutoExecutionGas is the maximum gas allowed for timelock auto-execution
-// per operation during EndBlock. This prevents governance proposals with
-// expensive operations from consuming excessive block gas.
-// 2M gas is sufficient for parameter changes, token transfers, and validator
-// operations while preventing abuse.
-const MaxAutoExecutionGas uint64 = 2_000_000
-
-func (k Keeper) executeMessages(ctx context.Context, op *types.QueuedOperation) error {
+// executeMessages executes all messages in an operation, limited to the
+// max_auto_execution_gas param. This prevents governance proposals with
+// expensive operations from consuming excessive block gas. Returns the gas
+// consumed, including by messages that failed.
+func (k Keeper) executeMessages(ctx context.Context, op *types.QueuedOperation) (uint64, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	gasLimit := params.EffectiveMaxAutoExecutionGas()
+
 	// SECURITY: Create a gas-limited context for auto-execution.
 	// This prevents governance operations from consuming unlimited gas
 	// during EndBlock, which could slow block production or be used
 	// as a resource exhaustion vector.
-	gasLimitedCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(MaxAutoExecutionGas))
+	gasLimitedCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
 
 	// Get messages from operation
 	msgs, err := op.GetSDKMessages(k.cdc)
 	if err != nil {
-		return fmt.Errorf("failed to unpack messages: %w", err)
+		return 0, fmt.Errorf("failed to unpack messages: %w", err)
 	}
 
 	// SECURITY: Limit number of messages per operation to prevent
 	// batched operations from bypassing per-message gas limits
 	const maxMessagesPerOperation = 10
 	if len(msgs) > maxMessagesPerOperation {
-		return fmt.Errorf("operation contains %d messages, exceeding limit of %d",
+		return 0, fmt.Errorf("operation contains %d messages, exceeding limit of %d",
 			len(msgs), maxMessagesPerOperation)
 	}
 
+	// Resolve every handler before executing anything, so an operation
+	// orphaned by an upgrade is detected as such rather than failing midway.
+	handlers := make([]baseapp.MsgServiceHandler, len(msgs))
+	for i, msg := range msgs {
+		handlers[i] = k.msgRouter.Handler(msg)
+		if handlers[i] == nil {
+			return 0, fmt.Errorf("%w: message %d (%s)", types.ErrHandlerMissing, i, sdk.MsgTypeURL(msg))
+		}
+	}
+
 	// Execute each message with gas metering and atomicity
 	cacheCtx, writeCache := gasLimitedCtx.CacheContext()
 	var events sdk.Events
 
 	for i, msg := range msgs {
-		handler := k.msgRouter.Handler(msg)
-		if handler == nil {
-			return fmt.Errorf("no handler for message %d (%s)", i, sdk.MsgTypeURL(msg))
-		}
+		handler := handlers[i]
 
 		res, err := safeExecuteHandler(cacheCtx, msg, handler)
 		if err != nil {
-			return fmt.Errorf("message %d (%s) execution failed: %w", i, sdk.MsgTypeURL(msg), err)
+			return cacheCtx.GasMeter().GasConsumedToLimit(), fmt.Errorf("message %d (%s) execution failed: %w", i, sdk.MsgTypeURL(msg), err)
 		}
 
 		events = append(events, res.GetEvents()...)
@@ -778,9 +1021,27 @@ func (k Keeper) executeMessages(ctx context.Context, op *types.QueuedOperation)
 		"operation_id", op.Id,
 		"total_messages", len(msgs),
 		"total_gas_used", gasLimitedCtx.GasMeter().GasConsumed(),
-		"gas_limit", MaxAutoExecutionGas,
+		"gas_limit", gasLimit,
 	)
 
+	return gasLimitedCtx.GasMeter().GasConsumedToLimit(), nil
+}
+
+// validateMessages is a dry run of the checks executeMessages depends on: every
+// message must have a registered handler and pass its stateless validation.
+// Execution still performs the full checks, since handlers may be removed by an
+// upgrade while the operation is queued.
+func (k Keeper) validateMessages(msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if k.msgRouter.Handler(msg) == nil {
+			return fmt.Errorf("%w: message %d (%s)", types.ErrHandlerMissing, i, sdk.MsgTypeURL(msg))
+		}
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return fmt.Errorf("%w: message %d (%s): %v", types.ErrInvalidOperationMessage, i, sdk.MsgTypeURL(msg), err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -798,60 +1059,208 @@ func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler func(sdk.Context,
 // Query Helpers
 // ----------------------------------------------------------------------------
 
-// GetQueuedOperations returns all operations in QUEUED status
-func (k Keeper) GetQueuedOperations(ctx context.Context) ([]*types.QueuedOperation, error) {
-	var ops []*types.QueuedOperation
+// GetQueuedOperations returns one page of operations in QUEUED status.
+// A nil pageReq returns the first page with the SDK default limit. Pages are
+// read from the queued index, so executed, cancelled and expired operations
+// are never visited.
+func (k Keeper) GetQueuedOperations(ctx context.Context, pageReq *query.PageRequest) ([]types.QueuedOperation, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		k.QueuedOperationIDs,
+		pageReq,
+		func(id uint64, _ collections.NoValue) (types.QueuedOperation, error) {
+			return k.Operations.Get(ctx, id)
+		},
+	)
+}
 
-	err := k.Operations.Walk(ctx, nil, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
-		if op.Status == types.OperationStatusQueued {
-			opCopy := op
-			ops = append(ops, &opCopy)
+// GetExecutableOperations returns one page of operations ready for execution.
+// Only queued operations can be executable, so only the queued index is scanned.
+func (k Keeper) GetExecutableOperations(ctx context.Context, pageReq *query.PageRequest) ([]types.QueuedOperation, *query.PageResponse, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	return query.CollectionFilteredPaginate(
+		ctx,
+		k.QueuedOperationIDs,
+		pageReq,
+		func(id uint64, _ collections.NoValue) (bool, error) {
+			op, err := k.Operations.Get(ctx, id)
+			if err != nil {
+				return false, err
+			}
+			return op.IsExecutable(now), nil
+		},
+		func(id uint64, _ collections.NoValue) (types.QueuedOperation, error) {
+			return k.Operations.Get(ctx, id)
+		},
+	)
+}
+
+// GetOperationsByProposal returns one page of operations for a proposal.
+// Uses the proposal index, so the cost is proportional to the proposal's
+// operations rather than to every operation ever stored.
+func (k Keeper) GetOperationsByProposal(ctx context.Context, proposalID uint64, pageReq *query.PageRequest) ([]types.QueuedOperation, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		k.OperationsByProposal,
+		pageReq,
+		func(key collections.Pair[uint64, uint64], _ bool) (types.QueuedOperation, error) {
+			return k.Operations.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](proposalID),
+	)
+}
+
+// GetDeferredOperations returns the queued operations that are executable now
+// but have not run yet, oldest executable time first. Only the queued index
+// is scanned, so the cost is bounded by the number of queued operations.
+func (k Keeper) GetDeferredOperations(ctx context.Context) (types.QueryDeferredOperationsResponse, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.QueryDeferredOperationsResponse{}, err
+	}
+
+	var ops []types.QueuedOperation
+	err = k.walkQueuedOperations(ctx, func(_ uint64, op types.QueuedOperation) (bool, error) {
+		if op.IsExecutable(now) {
+			ops = append(ops, op)
 		}
 		return false, nil
 	})
 	if err != nil {
-		return nil, err
+		return types.QueryDeferredOperationsResponse{}, err
 	}
 
-	return ops, nil
+	return types.NewDeferredOperationsResponse(ops, params.EffectiveMaxOperationsPerBlock()), nil
 }
 
-// GetExecutableOperations returns all operations ready for execution
-func (k Keeper) GetExecutableOperations(ctx context.Context) ([]*types.QueuedOperation, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	now := sdkCtx.BlockTime()
-	var ops []*types.QueuedOperation
+// GetEmergencyEligibleOperations returns the queued operations that have
+// passed the emergency delay and have not expired, in ID order, so guardians
+// can see which operations they may emergency-execute.
+func (k Keeper) GetEmergencyEligibleOperations(ctx context.Context) (types.QueryEmergencyEligibleOperationsResponse, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
 
-	err := k.Operations.Walk(ctx, nil, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
-		if op.IsExecutable(now) {
-			opCopy := op
-			ops = append(ops, &opCopy)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.QueryEmergencyEligibleOperationsResponse{}, err
+	}
+
+	var ops []types.QueuedOperation
+	err = k.walkQueuedOperations(ctx, func(_ uint64, op types.QueuedOperation) (bool, error) {
+		if op.CanEmergencyExecute(now, params.EmergencyDelaySeconds) {
+			ops = append(ops, op)
 		}
 		return false, nil
 	})
 	if err != nil {
-		return nil, err
+		return types.QueryEmergencyEligibleOperationsResponse{}, err
 	}
 
-	return ops, nil
+	return types.NewEmergencyEligibleOperationsResponse(ops, now, params.EmergencyDelaySeconds), nil
 }
 
-// GetOperationsByProposal returns all operations for a proposal
-func (k Keeper) GetOperationsByProposal(ctx context.Context, proposalID uint64) ([]*types.QueuedOperation, error) {
-	var ops []*types.QueuedOperation
+// GetOperationCountdown returns the time left until an operation becomes
+// executable, expires and becomes emergency-eligible, at the current block time.
+func (k Keeper) GetOperationCountdown(ctx context.Context, operationID uint64) (types.QueryOperationCountdownResponse, error) {
+	op, err := k.GetOperation(ctx, operationID)
+	if err != nil {
+		return types.QueryOperationCountdownResponse{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.QueryOperationCountdownResponse{}, err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	return types.NewOperationCountdown(*op, now, params.EmergencyDelaySeconds), nil
+}
 
-	err := k.Operations.Walk(ctx, nil, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
-		if op.ProposalId == proposalID {
-			opCopy := op
-			ops = append(ops, &opCopy)
+// paginateOperations pages through the operations store, keeping only
+// operations that match the filter. Iteration stops once the page is full,
+// and the returned NextKey resumes from there.
+func (k Keeper) paginateOperations(
+	ctx context.Context,
+	pageReq *query.PageRequest,
+	match func(op types.QueuedOperation) bool,
+) ([]types.QueuedOperation, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(
+		ctx,
+		k.Operations,
+		pageReq,
+		func(_ uint64, op types.QueuedOperation) (bool, error) {
+			return match(op), nil
+		},
+		func(_ uint64, op types.QueuedOperation) (types.QueuedOperation, error) {
+			return op, nil
+		},
+	)
+}
+
+// RebuildOperationIndexes repopulates the proposal, queued-status and
+// handler-missing indexes from the operations store. Operations written by SetOperation are indexed
+// already; this is for state created before the indexes existed and is meant
+// to be run once from an upgrade handler.
+func (k Keeper) RebuildOperationIndexes(ctx context.Context) error {
+	if err := k.OperationsByProposal.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.QueuedOperationIDs.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.HandlerMissingIDs.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	return k.Operations.Walk(ctx, nil, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
+		if err := k.OperationsByProposal.Set(ctx, collections.Join(op.ProposalId, id), true); err != nil {
+			return true, err
+		}
+		if op.Status == types.OperationStatusQueued {
+			if err := k.QueuedOperationIDs.Set(ctx, id); err != nil {
+				return true, err
+			}
+		}
+		if op.IsHandlerMissing() {
+			if err := k.HandlerMissingIDs.Set(ctx, id); err != nil {
+				return true, err
+			}
 		}
 		return false, nil
 	})
+}
+
+// getQueuedOperationIDs returns the IDs in the queued index. IDs are collected
+// up front because processing an operation removes it from the index, and the
+// index must not be written while it is being iterated.
+func (k Keeper) getQueuedOperationIDs(ctx context.Context) ([]uint64, error) {
+	iter, err := k.QueuedOperationIDs.Iterate(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
+	return iter.Keys()
+}
 
-	return ops, nil
+// walkQueuedOperations calls fn for every operation in the queued index, in
+// ID order, stopping when fn returns stop or an error
+func (k Keeper) walkQueuedOperations(ctx context.Context, fn func(id uint64, op types.QueuedOperation) (stop bool, err error)) error {
+	ids, err := k.getQueuedOperationIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		op, err := k.Operations.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("queued operation %d: %w", id, err)
+		}
+		stop, err := fn(id, op)
+		if err != nil || stop {
+			return err
+		}
+	}
+	return nil
 }
 
 // MarkExpiredOperations marks all expired operations
@@ -859,12 +1268,16 @@ func (k Keeper) MarkExpiredOperations(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	now := sdkCtx.BlockTime()
 
-	return k.Operations.Walk(ctx, nil, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
+	var expiredCount int
+	defer func() { incrOperationOutcome(outcomeExpired, expiredCount) }()
+
+	return k.walkQueuedOperations(ctx, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
 		if op.Status == types.OperationStatusQueued && op.IsExpired(now) {
 			op.MarkExpired()
 			if err := k.SetOperation(ctx, &op); err != nil {
 				return false, err
 			}
+			expiredCount++
 
 			k.logger.Info("operation expired",
 				"operation_id", op.Id,
@@ -911,11 +1324,6 @@ func (k Keeper) ClearPendingProposal(ctx context.Context, proposalID uint64) err
 // If a proposal cannot be queued in timelock, it must be marked as FAILED in the gov module
 // to prevent the gov module from executing it immediately, bypassing the timelock.
 func (k Keeper) ProcessPendingProposals(ctx context.Context) error {
-	if k.govKeeper == nil {
-		// Gov keeper not set yet, skip processing
-		return nil
-	}
-
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// Get all pending proposals
@@ -924,6 +1332,15 @@ func (k Keeper) ProcessPendingProposals(ctx context.Context) error {
 		return fmt.Errorf("failed to get pending proposals: %w", err)
 	}
 
+	// Without a gov keeper the proposals can be neither queued nor marked
+	// failed, so gov could execute them without a timelock. Never skip silently.
+	if k.govKeeper == nil {
+		if len(proposalIDs) == 0 {
+			return nil
+		}
+		return k.handleMissingGovKeeper(sdkCtx, proposalIDs)
+	}
+
 	var criticalErrors []error
 
 	for _, proposalID := range proposalIDs {
@@ -973,6 +1390,30 @@ func (k Keeper) ProcessPendingProposals(ctx context.Context) error {
 	return nil
 }
 
+// handleMissingGovKeeper reports proposals that cannot be processed because
+// no gov keeper is set, and halts unless SetHaltWithoutGovKeeper(false) was
+// called. The proposals stay pending either way.
+func (k Keeper) handleMissingGovKeeper(sdkCtx sdk.Context, proposalIDs []uint64) error {
+	k.logger.Error("CRITICAL: proposals pending timelock but gov keeper is not set",
+		"pending_proposals", proposalIDs,
+		"halt", k.haltWithoutGovKeeper,
+	)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"timelock_gov_keeper_missing",
+			sdk.NewAttribute("pending_proposals", fmt.Sprintf("%v", proposalIDs)),
+			sdk.NewAttribute("halt", fmt.Sprintf("%v", k.haltWithoutGovKeeper)),
+		),
+	)
+
+	if k.haltWithoutGovKeeper {
+		return fmt.Errorf("%w: %d proposals pending timelock processing",
+			types.ErrGovKeeperNotSet, len(proposalIDs))
+	}
+	return nil
+}
+
 // markProposalFailed marks a proposal as failed to prevent gov module execution
 func (k Keeper) markProposalFailed(ctx context.Context, proposalID uint64) error {
 	proposal, err := k.govKeeper.GetProposal(ctx, proposalID)
@@ -1091,18 +1532,14 @@ func (k Keeper) processProposal(ctx context.Context, sdkCtx sdk.Context, proposa
 	return nil
 }
 
-// MaxOperationsPerBlock limits the number of timelock operations that can be
-// auto-executed in a single EndBlock. This prevents a burst of queued governance
-// proposals from consuming excessive block time. Remaining operations will be
-// executed in subsequent blocks.
-const MaxOperationsPerBlock = 5
-
 // AutoExecuteReadyOperations executes all operations that have passed their timelock delay.
 // This runs in EndBlocker and solves the execution deadlock where module accounts cannot sign.
 // Operations are executed automatically by the keeper itself, not requiring a signed message.
 //
-// SECURITY: Limited to MaxOperationsPerBlock per block to prevent governance-driven
-// resource exhaustion. Each operation is individually gas-capped by executeMessages.
+// SECURITY: Limited to the max_operations_per_block param to prevent governance-driven
+// resource exhaustion from a burst of queued proposals. Remaining operations are
+// executed in subsequent blocks. Each operation is individually gas-capped by
+// executeMessages.
 func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	now := sdkCtx.BlockTime()
@@ -1113,14 +1550,29 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 		return nil
 	}
 
-	var executedCount, failedCount, skippedCount int
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.Paused {
+		k.logger.Debug("auto-execution paused: only operations clearing the pause run")
+	}
+	maxPerBlock := int(params.EffectiveMaxOperationsPerBlock())
+
+	var executedCount, failedCount, skippedCount, expiredCount int
+	var gasUsed uint64
 
-	err := k.Operations.Walk(ctx, nil, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
+	err = k.walkQueuedOperations(ctx, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
 		// Only process queued operations that are ready for execution
 		if op.Status != types.OperationStatusQueued {
 			return false, nil
 		}
 
+		// While paused only an operation lifting the pause may run
+		if params.Paused && !k.clearsExecutionPause(op) {
+			return false, nil
+		}
+
 		// Check if expired first
 		if op.IsExpired(now) {
 			op.MarkExpired()
@@ -1129,6 +1581,8 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 					"operation_id", op.Id,
 					"error", err,
 				)
+			} else {
+				expiredCount++
 			}
 			return false, nil
 		}
@@ -1141,7 +1595,7 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 		// SECURITY: Enforce per-block execution cap to prevent governance-driven
 		// resource exhaustion from many queued operations executing in one block.
 		// Remaining operations will execute in subsequent blocks.
-		if executedCount+failedCount >= MaxOperationsPerBlock {
+		if executedCount+failedCount >= maxPerBlock {
 			skippedCount++
 			return false, nil
 		}
@@ -1152,7 +1606,7 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 				"operation_id", op.Id,
 				"proposal_id", op.ProposalId,
 			)
-			op.MarkFailed(now, types.ErrOperationHashMismatch)
+			op.MarkFailed(now, sdkCtx.BlockHeight(), types.ErrOperationHashMismatch)
 			if err := k.SetOperation(ctx, &op); err != nil {
 				k.logger.Error("failed to update operation after hash failure",
 					"operation_id", op.Id, "error", err)
@@ -1166,7 +1620,7 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 				"operation_id", op.Id,
 				"proposal_id", op.ProposalId,
 			)
-			op.MarkFailed(now, types.ErrOperationAlreadyExecuted)
+			op.MarkFailed(now, sdkCtx.BlockHeight(), types.ErrOperationAlreadyExecuted)
 			if err := k.SetOperation(ctx, &op); err != nil {
 				k.logger.Error("failed to update operation after guard execution check",
 					"operation_id", op.Id, "error", err)
@@ -1197,13 +1651,19 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 		)
 
 		// Execute the messages
-		if err := k.executeMessages(ctx, &op); err != nil {
+		opGasUsed, err := k.executeMessages(ctx, &op)
+		gasUsed += opGasUsed
+		if err != nil {
+			if k.markHandlerMissing(ctx, &op, now, err) {
+				failedCount++
+				return false, nil
+			}
 			k.logger.Error("auto-execution failed",
 				"operation_id", op.Id,
 				"proposal_id", op.ProposalId,
 				"error", err,
 			)
-			op.MarkFailed(now, err)
+			op.MarkFailed(now, sdkCtx.BlockHeight(), err)
 			if setErr := k.SetOperation(ctx, &op); setErr != nil {
 				k.logger.Error("failed to update operation after execution failure",
 					"operation_id", op.Id, "error", setErr)
@@ -1217,6 +1677,7 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 					sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
 					sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", op.ProposalId)),
 					sdk.NewAttribute("error", err.Error()),
+					sdk.NewAttribute("failed_at_height", fmt.Sprintf("%d", op.FailedAtHeight)),
 				),
 			)
 			return false, nil
@@ -1255,10 +1716,18 @@ func (k Keeper) AutoExecuteReadyOperations(ctx context.Context) error {
 			"executed", executedCount,
 			"failed", failedCount,
 			"deferred_to_next_block", skippedCount,
-			"per_block_limit", MaxOperationsPerBlock,
+			"per_block_limit", maxPerBlock,
 		)
 	}
 
+	autoExecuteStats{
+		executed: executedCount,
+		failed:   failedCount,
+		expired:  expiredCount,
+		deferred: skippedCount,
+		gasUsed:  gasUsed,
+	}.emit()
+
 	return err
 }
 
@@ -1294,15 +1763,29 @@ func (k Keeper) trackGuardianCancel(ctx context.Context, guardian string) {
 	k.setGuardianCancelCount(ctx, guardian, count)
 
 	if count >= types.MaxGuardianCancelsPerWindow {
-		// Auto-revoke: clear guardian address
+		// Auto-revoke: remove the guardian from the guardian set
 		params, err := k.GetParams(ctx)
 		if err != nil {
 			k.logger.Error("failed to get params for guardian auto-revoke", "error", err)
 			return
 		}
 
-		oldGuardian := params.Guardian
-		params.Guardian = ""
+		oldGuardian := guardian
+		if params.Guardian == guardian {
+			params.Guardian = ""
+		}
+		var remaining []string
+		for _, g := range params.Guardians {
+			if g != guardian {
+				remaining = append(remaining, g)
+			}
+		}
+		params.Guardians = remaining
+
+		// Keep the threshold satisfiable by the remaining guardians
+		if setSize := uint64(len(params.GuardianSet())); params.GuardianThreshold > 1 && params.GuardianThreshold > setSize {
+			params.GuardianThreshold = setSize
+		}
 
 		// Use Params.Set directly to bypass validation (empty guardian is valid)
 		if err := k.Params.Set(ctx, params); err != nil {
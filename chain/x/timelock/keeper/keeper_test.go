@@ -117,6 +117,156 @@ func (s *TimelockTestSuite) TestParams() {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid message type delays",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MessageTypeDelays: map[string]uint64{
+					"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade": 7 * 24 * 3600,
+					"/cosmos.bank.v1beta1.MsgSend":               24 * 3600,
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "message type delay without type URL prefix",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MessageTypeDelays:     map[string]uint64{"cosmos.bank.v1beta1.MsgSend": 2 * 24 * 3600},
+			},
+			expectError: true,
+			errorMsg:    "invalid message type delay",
+		},
+		{
+			name: "message type delay below min_delay",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MessageTypeDelays:     map[string]uint64{"/cosmos.bank.v1beta1.MsgSend": 3600},
+			},
+			expectError: true,
+			errorMsg:    "invalid message type delay",
+		},
+		{
+			name: "message type delay above max_delay",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MessageTypeDelays:     map[string]uint64{"/cosmos.bank.v1beta1.MsgSend": 20 * 24 * 3600},
+			},
+			expectError: true,
+			errorMsg:    "invalid message type delay",
+		},
+		{
+			name: "executable-soon warning longer than max_delay",
+			params: types.Params{
+				MinDelaySeconds:              24 * 3600,
+				MaxDelaySeconds:              14 * 24 * 3600,
+				GracePeriodSeconds:           7 * 24 * 3600,
+				EmergencyDelaySeconds:        6 * 3600,
+				ExecutableSoonWarningSeconds: 15 * 24 * 3600,
+			},
+			expectError: true,
+			errorMsg:    "executable-soon warning window",
+		},
+		{
+			name: "valid guardian set with threshold",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				Guardian:              sdk.AccAddress("guardian_one________").String(),
+				Guardians:             []string{sdk.AccAddress("guardian_two________").String()},
+				GuardianThreshold:     2,
+			},
+			expectError: false,
+		},
+		{
+			name: "guardian threshold exceeds guardian set",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				Guardian:              sdk.AccAddress("guardian_one________").String(),
+				Guardians:             []string{sdk.AccAddress("guardian_two________").String()},
+				GuardianThreshold:     3,
+			},
+			expectError: true,
+			errorMsg:    "invalid guardian threshold",
+		},
+		{
+			name: "duplicate guardian",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				Guardian:              sdk.AccAddress("guardian_one________").String(),
+				Guardians:             []string{sdk.AccAddress("guardian_one________").String()},
+			},
+			expectError: true,
+			errorMsg:    "duplicate guardian",
+		},
+		{
+			name: "valid auto-execution limits",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MaxAutoExecutionGas:   10_000_000,
+				MaxOperationsPerBlock: 1,
+			},
+			expectError: false,
+		},
+		{
+			name: "auto-execution gas below minimum",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MaxAutoExecutionGas:   50_000,
+			},
+			expectError: true,
+			errorMsg:    "max_auto_execution_gas",
+		},
+		{
+			name: "auto-execution gas above maximum",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MaxAutoExecutionGas:   20_000_000,
+			},
+			expectError: true,
+			errorMsg:    "max_auto_execution_gas",
+		},
+		{
+			name: "operations per block above maximum",
+			params: types.Params{
+				MinDelaySeconds:       24 * 3600,
+				MaxDelaySeconds:       14 * 24 * 3600,
+				GracePeriodSeconds:    7 * 24 * 3600,
+				EmergencyDelaySeconds: 6 * 3600,
+				MaxOperationsPerBlock: 51,
+			},
+			expectError: true,
+			errorMsg:    "max_operations_per_block",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+const (
+	sendDelaySeconds      uint64 = 6 * 24 * 3600
+	multiSendDelaySeconds uint64 = 10 * 24 * 3600
+)
+
+// setupMessageTypeDelays configures per-type delays for MsgSend and
+// MsgMultiSend, both longer than any adaptive delay of the default params.
+func setupMessageTypeDelays(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+
+	keeper, ctx, _ := setupTimelockKeeper(t, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return testRouter{storeKey: testKey}
+	})
+
+	params := types.DefaultParams()
+	params.MessageTypeDelays = map[string]uint64{
+		sdk.MsgTypeURL(&banktypes.MsgSend{}):      sendDelaySeconds,
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): multiSendDelaySeconds,
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	return keeper, ctx
+}
+
+func testSend(amount int64) *banktypes.MsgSend {
+	return &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress("from_______________").String(),
+		ToAddress:   sdk.AccAddress("to________________").String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("upos", amount)),
+	}
+}
+
+func testMultiSend() *banktypes.MsgMultiSend {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("upos", 1))
+	return &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(sdk.AccAddress("from_______________"), coins)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(sdk.AccAddress("to________________"), coins)},
+	}
+}
+
+func queuedDelay(op *types.QueuedOperation) time.Duration {
+	return op.ExecutableTime().Sub(op.QueuedTime())
+}
+
+func TestQueueOperation_MixedTypesUseLongestDelay(t *testing.T) {
+	keeper, ctx := setupMessageTypeDelays(t)
+
+	op, err := keeper.QueueOperation(ctx, 1, []sdk.Msg{testSend(1), testMultiSend(), testSend(2)}, keeper.GetAuthority())
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(multiSendDelaySeconds)*time.Second, queuedDelay(op))
+
+	record, err := keeper.GetOperationTrackRecord(ctx, op.Id)
+	require.NoError(t, err)
+	require.Equal(t, multiSendDelaySeconds, record.ComputedDelaySeconds)
+
+	op, err = keeper.QueueOperation(ctx, 2, []sdk.Msg{testSend(3)}, keeper.GetAuthority())
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(sendDelaySeconds)*time.Second, queuedDelay(op))
+}
+
+func TestQueueOperation_UnconfiguredTypesKeepAdaptiveDelay(t *testing.T) {
+	keeper, ctx := setupMessageTypeDelays(t)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MessageTypeDelays = nil
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	op, err := keeper.QueueOperation(ctx, 1, []sdk.Msg{testSend(1)}, keeper.GetAuthority())
+	require.NoError(t, err)
+	delay := queuedDelay(op)
+	require.GreaterOrEqual(t, delay, params.MinDelayDuration())
+	require.Less(t, delay, time.Duration(sendDelaySeconds)*time.Second)
+}
+
+func TestParams_MessageTypeDelay(t *testing.T) {
+	params := types.DefaultParams()
+	params.MessageTypeDelays = map[string]uint64{
+		"/cosmos.bank.v1beta1.MsgSend":      2 * 24 * 3600,
+		"/cosmos.bank.v1beta1.MsgMultiSend": 4 * 24 * 3600,
+	}
+
+	require.Equal(t, uint64(4*24*3600), params.MessageTypeDelay([]string{
+		"/cosmos.bank.v1beta1.MsgSend",
+		"/cosmos.bank.v1beta1.MsgMultiSend",
+	}))
+	require.Equal(t, uint64(2*24*3600), params.MessageTypeDelay([]string{"/cosmos.bank.v1beta1.MsgSend"}))
+	require.Zero(t, params.MessageTypeDelay([]string{"/cosmos.gov.v1.MsgVote"}))
+	require.Zero(t, params.MessageTypeDelay(nil))
+}
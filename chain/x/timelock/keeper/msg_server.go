@@ -64,8 +64,17 @@ func (ms msgServer) CancelOperation(ctx context.Context, msg *types.MsgCancelOpe
 		"reason", msg.Reason,
 	)
 
-	// Cancel the operation
-	if err := ms.Keeper.CancelOperation(ctx, msg.OperationId, msg.Authority, msg.Reason); err != nil {
+	// Cancel the operation. With a multi-guardian threshold each guardian's
+	// message counts as one approval.
+	requiresApprovals, err := ms.requiresGuardianApprovals(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	if requiresApprovals {
+		if _, err := ms.Keeper.ApproveCancel(ctx, msg.OperationId, msg.Authority, msg.Reason); err != nil {
+			return nil, err
+		}
+	} else if err := ms.Keeper.CancelOperation(ctx, msg.OperationId, msg.Authority, msg.Reason); err != nil {
 		return nil, err
 	}
 
@@ -105,8 +114,19 @@ func (ms msgServer) EmergencyExecute(ctx context.Context, msg *types.MsgEmergenc
 		"justification", msg.Justification,
 	)
 
-	// Emergency execute the operation
-	if err := ms.Keeper.EmergencyExecute(ctx, msg.OperationId, msg.Authority, msg.Justification); err != nil {
+	// Emergency execute the operation. With a multi-guardian threshold each
+	// guardian's message counts as one approval; Success reports whether the
+	// operation was executed.
+	requiresApprovals, err := ms.requiresGuardianApprovals(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	executed := true
+	if requiresApprovals {
+		if executed, err = ms.Keeper.ApproveEmergency(ctx, msg.OperationId, msg.Authority, msg.Justification); err != nil {
+			return nil, err
+		}
+	} else if err := ms.Keeper.EmergencyExecute(ctx, msg.OperationId, msg.Authority, msg.Justification); err != nil {
 		return nil, err
 	}
 
@@ -122,10 +142,23 @@ func (ms msgServer) EmergencyExecute(ctx context.Context, msg *types.MsgEmergenc
 	))
 
 	return &types.MsgEmergencyExecuteResponse{
-		Success: true,
+		Success: executed,
 	}, nil
 }
 
+// requiresGuardianApprovals reports whether a guardian action by signer must
+// be collected as an approval rather than performed directly.
+func (ms msgServer) requiresGuardianApprovals(ctx context.Context, signer string) (bool, error) {
+	if signer == ms.Keeper.GetAuthority() {
+		return false, nil
+	}
+	params, err := ms.Keeper.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.EffectiveGuardianThreshold() > 1, nil
+}
+
 // UpdateParams updates the module parameters (governance only)
 func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if msg == nil {
@@ -158,6 +191,11 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 	if err := ms.Keeper.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
+	if oldParams.Paused != msg.Params.Paused {
+		if err := ms.Keeper.PauseApprovals.Clear(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
 
 	// Emit event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
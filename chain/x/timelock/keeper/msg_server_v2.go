@@ -1,10 +1,13 @@
 package keeper
 
-// msg_server_v2.go — AST v2 message handlers: MsgFreezeTrack, MsgUpdateTrack
+// msg_server_v2.go — AST v2 message handlers: MsgFreezeTrack, MsgUpdateTrack,
+// MsgUpdateHandlerMissingPolicy, MsgSetExecutionPaused
 //
-// Both messages are governance-only.  The guardian is explicitly blocked from
-// calling FreezeTrack to prevent a single key holder from halting governance
-// execution indefinitely.
+// All messages except MsgSetExecutionPaused are governance-only.  The guardian
+// is explicitly blocked from calling FreezeTrack to prevent a single key holder
+// from halting governance execution indefinitely; the guardians' execution
+// pause needs the guardian threshold and can always be lifted by governance
+// through MsgUpdateParams, which executes even while paused.
 
 import (
 	"context"
@@ -98,3 +101,56 @@ func (ms msgServer) UpdateTrack(ctx context.Context, msg *types.MsgUpdateTrack)
 
 	return &types.MsgUpdateTrackResponse{}, nil
 }
+
+// UpdateHandlerMissingPolicy handles MsgUpdateHandlerMissingPolicy (governance-only).
+//
+// Controls whether operations orphaned by an upgrade are auto-cancelled and
+// after what grace period.
+func (ms msgServer) UpdateHandlerMissingPolicy(ctx context.Context, msg *types.MsgUpdateHandlerMissingPolicy) (*types.MsgUpdateHandlerMissingPolicyResponse, error) {
+	// SECURITY: Governance-only.
+	if msg.Authority != ms.GetAuthority() {
+		return nil, fmt.Errorf("%w: UpdateHandlerMissingPolicy requires governance authority, got %s",
+			types.ErrUnauthorized, msg.Authority)
+	}
+
+	if err := ms.Keeper.SetHandlerMissingPolicy(ctx, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ms.logger.Info("handler-missing policy updated by governance",
+		"auto_cancel_enabled", msg.Policy.AutoCancelEnabled,
+		"grace_period_seconds", msg.Policy.GracePeriodSeconds,
+	)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"timelock_handler_missing_policy_updated",
+			sdk.NewAttribute("auto_cancel_enabled", fmt.Sprintf("%v", msg.Policy.AutoCancelEnabled)),
+			sdk.NewAttribute("grace_period_seconds", fmt.Sprintf("%d", msg.Policy.GracePeriodSeconds)),
+		),
+	)
+
+	return &types.MsgUpdateHandlerMissingPolicyResponse{}, nil
+}
+
+// SetExecutionPaused handles MsgSetExecutionPaused (guardian-only).
+//
+// Pausing stops all operation execution without cancelling anything;
+// unpausing lets ready operations execute again from the next EndBlock. With
+// a multi-guardian threshold each guardian's message counts as one approval.
+func (ms msgServer) SetExecutionPaused(ctx context.Context, msg *types.MsgSetExecutionPaused) (*types.MsgSetExecutionPausedResponse, error) {
+	requiresApprovals, err := ms.requiresGuardianApprovals(ctx, msg.Guardian)
+	if err != nil {
+		return nil, err
+	}
+	applied := true
+	if requiresApprovals {
+		if applied, err = ms.Keeper.ApproveExecutionPause(ctx, msg.Guardian, msg.Paused); err != nil {
+			return nil, err
+		}
+	} else if err := ms.Keeper.SetExecutionPaused(ctx, msg.Guardian, msg.Paused); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetExecutionPausedResponse{Applied: applied}, nil
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// storeOperations stores n queued operations spread over proposals of
+// perProposal operations each (ids 1..n, proposal = (id-1)/perProposal + 1).
+func storeOperations(tb testing.TB, n, perProposal uint64) (Keeper, sdk.Context) {
+	tb.Helper()
+
+	keeper, ctx, _ := setupTimelockKeeper(tb, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return testRouter{storeKey: testKey}
+	})
+
+	msg := &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress("from_______________").String(),
+		ToAddress:   sdk.AccAddress("to________________").String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("upos", 1)),
+	}
+
+	for id := uint64(1); id <= n; id++ {
+		op, err := types.NewQueuedOperation(id, (id-1)/perProposal+1, []sdk.Msg{msg}, keeper.GetAuthority(), ctx.BlockTime(), 0, 3600, keeper.cdc)
+		require.NoError(tb, err)
+		require.NoError(tb, keeper.SetOperation(ctx, op))
+	}
+
+	return keeper, ctx
+}
+
+func TestGetOperationsByProposal_UsesIndex(t *testing.T) {
+	keeper, ctx := storeOperations(t, 20, 5)
+
+	ops, pageRes, err := keeper.GetOperationsByProposal(ctx, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11, 12, 13, 14, 15}, operationIDs(ops))
+	require.Equal(t, uint64(5), pageRes.Total)
+
+	// Cursor-based paging stays within the proposal
+	ops, pageRes, err = keeper.GetOperationsByProposal(ctx, 3, &query.PageRequest{Limit: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{11, 12, 13}, operationIDs(ops))
+	require.NotEmpty(t, pageRes.NextKey)
+
+	ops, pageRes, err = keeper.GetOperationsByProposal(ctx, 3, &query.PageRequest{Key: pageRes.NextKey, Limit: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{14, 15}, operationIDs(ops))
+	require.Empty(t, pageRes.NextKey)
+
+	ops, _, err = keeper.GetOperationsByProposal(ctx, 99, nil)
+	require.NoError(t, err)
+	require.Empty(t, ops)
+}
+
+func TestGetQueuedOperations_NextKey(t *testing.T) {
+	keeper, ctx := storeOperations(t, 10, 5)
+
+	// Retire a few operations so the filter has to skip them
+	for _, id := range []uint64{2, 3, 7} {
+		op, err := keeper.GetOperation(ctx, id)
+		require.NoError(t, err)
+		op.MarkCancelled(ctx.BlockTime(), "test")
+		require.NoError(t, keeper.SetOperation(ctx, op))
+	}
+
+	var (
+		seen []uint64
+		key  []byte
+	)
+	for {
+		ops, pageRes, err := keeper.GetQueuedOperations(ctx, &query.PageRequest{Key: key, Limit: 3})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(ops), 3)
+		seen = append(seen, operationIDs(ops)...)
+		if len(pageRes.NextKey) == 0 {
+			break
+		}
+		key = pageRes.NextKey
+	}
+	require.Equal(t, []uint64{1, 4, 5, 6, 8, 9, 10}, seen)
+
+	// The total counts queued operations only
+	_, pageRes, err := keeper.GetQueuedOperations(ctx, &query.PageRequest{Limit: 3, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), pageRes.Total)
+
+	// Filtering the operations query by QUEUED status reads the same index
+	res, err := NewQueryServerImpl(keeper).Operations(ctx, &types.QueryOperationsRequest{
+		Status:     types.OperationStatusQueued,
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 4, 5}, operationIDs(res.Operations))
+	require.Equal(t, uint64(7), res.Pagination.Total)
+
+	ops, _, err := keeper.GetExecutableOperations(ctx, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 4}, operationIDs(ops))
+}
+
+func TestRebuildOperationIndexes(t *testing.T) {
+	keeper, ctx := storeOperations(t, 6, 3)
+
+	// Simulate state written before the indexes existed, plus stale entries
+	require.NoError(t, keeper.OperationsByProposal.Clear(ctx, nil))
+	require.NoError(t, keeper.OperationsByProposal.Set(ctx, collections.Join(uint64(7), uint64(99)), true))
+	require.NoError(t, keeper.QueuedOperationIDs.Clear(ctx, nil))
+	require.NoError(t, keeper.QueuedOperationIDs.Set(ctx, 99))
+
+	ops, _, err := keeper.GetOperationsByProposal(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Empty(t, ops)
+
+	require.NoError(t, keeper.RebuildOperationIndexes(ctx))
+
+	ops, _, err = keeper.GetOperationsByProposal(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 5, 6}, operationIDs(ops))
+
+	has, err := keeper.OperationsByProposal.Has(ctx, collections.Join(uint64(7), uint64(99)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	ids, err := keeper.getQueuedOperationIDs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, ids)
+}
+
+// ----------------------------------------------------------------------------
+// Benchmarks: 10k stored operations, 10 per proposal
+// ----------------------------------------------------------------------------
+
+const benchOperations = 10_000
+
+func BenchmarkOperationsByProposal(b *testing.B) {
+	keeper, ctx := storeOperations(b, benchOperations, 10)
+	proposalID := uint64(benchOperations / 20)
+
+	b.Run("index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ops, _, err := keeper.GetOperationsByProposal(ctx, proposalID, nil)
+			if err != nil || len(ops) != 10 {
+				b.Fatalf("got %d ops, err %v", len(ops), err)
+			}
+		}
+	})
+
+	// Previous implementation: scan every stored operation
+	b.Run("full_scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var ops []types.QueuedOperation
+			err := keeper.Operations.Walk(ctx, nil, func(_ uint64, op types.QueuedOperation) (bool, error) {
+				if op.ProposalId == proposalID {
+					ops = append(ops, op)
+				}
+				return false, nil
+			})
+			if err != nil || len(ops) != 10 {
+				b.Fatalf("got %d ops, err %v", len(ops), err)
+			}
+		}
+	})
+}
+
+// BenchmarkQueuedOperations pages through a mostly completed backlog: one
+// operation in a hundred is still queued
+func BenchmarkQueuedOperations(b *testing.B) {
+	keeper, ctx := storeOperations(b, benchOperations, 10)
+	for id := uint64(1); id <= benchOperations; id++ {
+		if id%100 == 0 {
+			continue
+		}
+		op, err := keeper.GetOperation(ctx, id)
+		require.NoError(b, err)
+		op.MarkExecuted(ctx.BlockTime())
+		require.NoError(b, keeper.SetOperation(ctx, op))
+	}
+	queued := benchOperations / 100
+
+	b.Run("page_100", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ops, _, err := keeper.GetQueuedOperations(ctx, &query.PageRequest{Limit: 100, CountTotal: true})
+			if err != nil || len(ops) != queued {
+				b.Fatalf("got %d ops, err %v", len(ops), err)
+			}
+		}
+	})
+
+	// Previous implementation: filter every stored operation by status
+	b.Run("filtered_scan", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ops, _, err := keeper.paginateOperations(ctx, &query.PageRequest{Limit: 100, CountTotal: true}, func(op types.QueuedOperation) bool {
+				return op.Status == types.OperationStatusQueued
+			})
+			if err != nil || len(ops) != queued {
+				b.Fatalf("got %d ops, err %v", len(ops), err)
+			}
+		}
+	})
+}
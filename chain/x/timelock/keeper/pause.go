@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/timelock/types"
+)
+
+// IsExecutionPaused returns true while the guardians have paused all timelock
+// execution
+func (k Keeper) IsExecutionPaused(ctx context.Context) bool {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false
+	}
+	return params.Paused
+}
+
+// SetExecutionPaused pauses or resumes all operation execution. While paused,
+// EndBlock auto-execution, ExecuteOperation and emergency execution do
+// nothing except run an operation that clears the pause through
+// MsgUpdateParams, so governance can always lift it; operations stay queued
+// and can still be cancelled. With a multi-guardian threshold guardians act
+// through ApproveExecutionPause instead.
+func (k Keeper) SetExecutionPaused(ctx context.Context, guardian string, paused bool) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsGuardian(guardian) {
+		return types.ErrNotGuardian
+	}
+	if params.EffectiveGuardianThreshold() > 1 {
+		return fmt.Errorf("%w: %d guardian approvals required, use ApproveExecutionPause",
+			types.ErrGuardianThresholdNotMet, params.EffectiveGuardianThreshold())
+	}
+	if params.Paused == paused {
+		return nil
+	}
+
+	return k.setExecutionPaused(ctx, params, guardian, paused)
+}
+
+// ApproveExecutionPause records a guardian's approval to pause or resume
+// execution. Once GuardianThreshold distinct guardians have approved the same
+// state it is applied and true is returned. Approving the current state is a
+// no-op that reports true.
+func (k Keeper) ApproveExecutionPause(ctx context.Context, guardian string, paused bool) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !params.IsGuardian(guardian) {
+		return false, types.ErrNotGuardian
+	}
+	if params.Paused == paused {
+		return true, nil
+	}
+
+	action := pauseAction(paused)
+	key := collections.Join(paused, guardian)
+	approved, err := k.PauseApprovals.Has(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if approved {
+		return false, fmt.Errorf("%w: %s already approved %s of execution",
+			types.ErrDuplicateGuardianApproval, guardian, action)
+	}
+	if err := k.PauseApprovals.Set(ctx, key); err != nil {
+		return false, err
+	}
+
+	// Approvals from guardians removed since they approved no longer count
+	var approvals uint64
+	rng := collections.NewPrefixedPairRange[bool, string](paused)
+	err = k.PauseApprovals.Walk(ctx, rng, func(key collections.Pair[bool, string]) (bool, error) {
+		if params.IsGuardian(key.K2()) {
+			approvals++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	threshold := params.EffectiveGuardianThreshold()
+	k.logger.Warn("guardian approval recorded",
+		"action", action,
+		"guardian", guardian,
+		"approvals", approvals,
+		"threshold", threshold,
+	)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"guardian_approval",
+			sdk.NewAttribute("action", action),
+			sdk.NewAttribute("guardian", guardian),
+			sdk.NewAttribute("approvals", fmt.Sprintf("%d", approvals)),
+			sdk.NewAttribute("threshold", fmt.Sprintf("%d", threshold)),
+		),
+	)
+
+	if approvals < threshold {
+		return false, nil
+	}
+	if err := k.setExecutionPaused(ctx, params, guardian, paused); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// setExecutionPaused stores the new pause state and drops the approvals
+// collected for either state
+func (k Keeper) setExecutionPaused(ctx context.Context, params types.Params, guardian string, paused bool) error {
+	params.Paused = paused
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+	if err := k.PauseApprovals.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	eventType := "timelock_execution_unpaused"
+	if paused {
+		eventType = "timelock_execution_paused"
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.logger.Warn("timelock execution pause changed",
+		"paused", paused,
+		"guardian", guardian,
+		"height", sdkCtx.BlockHeight(),
+	)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute("guardian", guardian),
+			sdk.NewAttribute("height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+		),
+	)
+
+	return nil
+}
+
+// clearsExecutionPause reports whether every message in op is a timelock
+// MsgUpdateParams that leaves execution unpaused. Such an operation runs
+// while execution is paused.
+func (k Keeper) clearsExecutionPause(op types.QueuedOperation) bool {
+	if len(op.Messages) == 0 {
+		return false
+	}
+	for _, anyMsg := range op.Messages {
+		if anyMsg == nil || anyMsg.TypeUrl != sdk.MsgTypeURL(&types.MsgUpdateParams{}) {
+			return false
+		}
+		var msg types.MsgUpdateParams
+		if err := k.cdc.Unmarshal(anyMsg.Value, &msg); err != nil || msg.Params.Paused {
+			return false
+		}
+	}
+	return true
+}
+
+func pauseAction(paused bool) string {
+	if paused {
+		return "pause"
+	}
+	return "unpause"
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// setupPausable configures guardianOne as the single guardian
+func setupPausable(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+
+	keeper, ctx := storeOperations(t, 0, 1)
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.Guardian = guardianOne
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	return keeper, ctx.WithEventManager(sdk.NewEventManager())
+}
+
+func TestExecutionPause_BlocksExecutionUntilUnpaused(t *testing.T) {
+	keeper, ctx := setupPausable(t)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 0)
+	queueTestOperation(t, keeper, ctx, 2, "upos", 0)
+
+	msgServer := &msgServer{Keeper: keeper}
+	_, err := msgServer.SetExecutionPaused(ctx, &types.MsgSetExecutionPaused{Guardian: guardianOne, Paused: true})
+	require.NoError(t, err)
+	require.True(t, keeper.IsExecutionPaused(ctx))
+	require.True(t, hasEvent(ctx, "timelock_execution_paused"))
+
+	// Neither EndBlock nor a manual execution runs anything
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	err = keeper.ExecuteOperation(ctx, 2, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrExecutionPaused)
+	requireQueuedIDs(t, keeper, ctx, 1, 2)
+
+	// Cancellation still works while paused
+	require.NoError(t, keeper.CancelOperation(ctx, 2, keeper.GetAuthority(), "superseded by a later proposal"))
+	requireQueuedIDs(t, keeper, ctx, 1)
+
+	_, err = msgServer.SetExecutionPaused(ctx, &types.MsgSetExecutionPaused{Guardian: guardianOne, Paused: false})
+	require.NoError(t, err)
+	require.False(t, keeper.IsExecutionPaused(ctx))
+	require.True(t, hasEvent(ctx, "timelock_execution_unpaused"))
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	op, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusExecuted, op.Status)
+}
+
+func TestExecutionPause_BlocksEmergencyExecution(t *testing.T) {
+	keeper, ctx := setupPausable(t)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+	require.NoError(t, keeper.SetExecutionPaused(ctx, guardianOne, true))
+
+	emergencyCtx := ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultEmergencyDelay))
+	err := keeper.EmergencyExecute(emergencyCtx, 1, guardianOne, "critical vulnerability requires immediate patch")
+	require.ErrorIs(t, err, types.ErrExecutionPaused)
+}
+
+func TestExecutionPause_GuardianOnly(t *testing.T) {
+	keeper, ctx := setupPausable(t)
+
+	err := keeper.SetExecutionPaused(ctx, sdk.AccAddress("not_guardian________").String(), true)
+	require.ErrorIs(t, err, types.ErrNotGuardian)
+	require.False(t, keeper.IsExecutionPaused(ctx))
+
+	// Setting the current state again is a no-op
+	require.NoError(t, keeper.SetExecutionPaused(ctx, guardianOne, false))
+	require.False(t, hasEvent(ctx, "timelock_execution_unpaused"))
+}
+
+func TestExecutionPause_RequiresGuardianThreshold(t *testing.T) {
+	keeper, ctx := setupGuardianSet(t)
+	msgServer := &msgServer{Keeper: keeper}
+
+	// A single guardian can no longer pause directly
+	err := keeper.SetExecutionPaused(ctx, guardianOne, true)
+	require.ErrorIs(t, err, types.ErrGuardianThresholdNotMet)
+
+	res, err := msgServer.SetExecutionPaused(ctx, &types.MsgSetExecutionPaused{Guardian: guardianOne, Paused: true})
+	require.NoError(t, err)
+	require.False(t, res.Applied)
+	require.False(t, keeper.IsExecutionPaused(ctx))
+
+	_, err = msgServer.SetExecutionPaused(ctx, &types.MsgSetExecutionPaused{Guardian: guardianOne, Paused: true})
+	require.ErrorIs(t, err, types.ErrDuplicateGuardianApproval)
+
+	res, err = msgServer.SetExecutionPaused(ctx, &types.MsgSetExecutionPaused{Guardian: guardianThree, Paused: true})
+	require.NoError(t, err)
+	require.True(t, res.Applied)
+	require.True(t, keeper.IsExecutionPaused(ctx))
+	require.True(t, hasEvent(ctx, "timelock_execution_paused"))
+
+	// Approvals are consumed once the state changes
+	has, err := keeper.PauseApprovals.Has(ctx, collections.Join(true, guardianOne))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestExecutionPause_UpdateParamsClearingPauseStillExecutes(t *testing.T) {
+	keeper, ctx := setupPausable(t)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 0)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	unpause := &types.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params}
+	op, err := types.NewQueuedOperation(2, 2, []sdk.Msg{unpause}, keeper.GetAuthority(), ctx.BlockTime(), 0, 3600, keeper.cdc)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetOperation(ctx, op))
+
+	// An operation that would keep execution paused gets no exemption
+	stillPaused := &types.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params}
+	stillPaused.Params.Paused = true
+	op, err = types.NewQueuedOperation(3, 3, []sdk.Msg{stillPaused}, keeper.GetAuthority(), ctx.BlockTime(), 0, 3600, keeper.cdc)
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetOperation(ctx, op))
+
+	require.NoError(t, keeper.SetExecutionPaused(ctx, guardianOne, true))
+	err = keeper.ExecuteOperation(ctx, 3, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrExecutionPaused)
+
+	// Only the operation lifting the pause runs
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	requireQueuedIDs(t, keeper, ctx, 1, 3)
+	executed, err := keeper.GetOperation(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusExecuted, executed.Status)
+}
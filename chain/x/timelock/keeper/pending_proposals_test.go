@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+func TestProcessPendingProposals_HaltsWithoutGovKeeper(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	// Nothing pending: no gov keeper is needed
+	require.NoError(t, keeper.ProcessPendingProposals(ctx))
+	require.False(t, hasEvent(ctx, "timelock_gov_keeper_missing"))
+
+	require.NoError(t, keeper.MarkProposalForTimelock(ctx, 4))
+	err := keeper.ProcessPendingProposals(ctx)
+	require.ErrorIs(t, err, types.ErrGovKeeperNotSet)
+	require.True(t, hasEvent(ctx, "timelock_gov_keeper_missing"))
+
+	// The proposal is still pending for when the gov keeper is wired in
+	pending, err := keeper.GetPendingProposals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, pending)
+}
+
+func TestProcessPendingProposals_ReportsWithoutGovKeeperWhenNotHalting(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.SetHaltWithoutGovKeeper(false)
+
+	require.NoError(t, keeper.MarkProposalForTimelock(ctx, 4))
+	require.NoError(t, keeper.ProcessPendingProposals(ctx))
+	require.True(t, hasEvent(ctx, "timelock_gov_keeper_missing"))
+
+	pending, err := keeper.GetPendingProposals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, pending)
+}
@@ -59,42 +59,25 @@ func (qs queryServer) Operations(ctx context.Context, req *types.QueryOperations
 		return nil, fmt.Errorf("request is nil")
 	}
 
-	var ops []types.QueuedOperation
-
-	err := qs.Keeper.Operations.Walk(ctx, nil, func(id uint64, op types.QueuedOperation) (stop bool, err error) {
-		// Apply status filter if specified
-		if req.Status != types.OperationStatusUnspecified && op.Status != req.Status {
-			return false, nil
-		}
-		ops = append(ops, op)
-		return false, nil
-	})
+	var (
+		ops     []types.QueuedOperation
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.Status == types.OperationStatusQueued {
+		ops, pageRes, err = qs.Keeper.GetQueuedOperations(ctx, req.Pagination)
+	} else {
+		ops, pageRes, err = qs.paginateOperations(ctx, req.Pagination, func(op types.QueuedOperation) bool {
+			return req.Status == types.OperationStatusUnspecified || op.Status == req.Status
+		})
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Apply pagination (simplified - in production use collections pagination)
-	start, end := 0, len(ops)
-	if req.Pagination != nil {
-		if req.Pagination.Offset > 0 {
-			start = int(req.Pagination.Offset)
-		}
-		if req.Pagination.Limit > 0 && start+int(req.Pagination.Limit) < end {
-			end = start + int(req.Pagination.Limit)
-		}
-	}
-	if start > len(ops) {
-		start = len(ops)
-	}
-	if end > len(ops) {
-		end = len(ops)
-	}
-
 	return &types.QueryOperationsResponse{
-		Operations: ops[start:end],
-		Pagination: &query.PageResponse{
-			Total: uint64(len(ops)),
-		},
+		Operations: ops,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -104,22 +87,14 @@ func (qs queryServer) QueuedOperations(ctx context.Context, req *types.QueryQueu
 		return nil, fmt.Errorf("request is nil")
 	}
 
-	ops, err := qs.Keeper.GetQueuedOperations(ctx)
+	ops, pageRes, err := qs.Keeper.GetQueuedOperations(ctx, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to non-pointer slice
-	result := make([]types.QueuedOperation, len(ops))
-	for i, op := range ops {
-		result[i] = *op
-	}
-
 	return &types.QueryQueuedOperationsResponse{
-		Operations: result,
-		Pagination: &query.PageResponse{
-			Total: uint64(len(ops)),
-		},
+		Operations: ops,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -129,22 +104,14 @@ func (qs queryServer) ExecutableOperations(ctx context.Context, req *types.Query
 		return nil, fmt.Errorf("request is nil")
 	}
 
-	ops, err := qs.Keeper.GetExecutableOperations(ctx)
+	ops, pageRes, err := qs.Keeper.GetExecutableOperations(ctx, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to non-pointer slice
-	result := make([]types.QueuedOperation, len(ops))
-	for i, op := range ops {
-		result[i] = *op
-	}
-
 	return &types.QueryExecutableOperationsResponse{
-		Operations: result,
-		Pagination: &query.PageResponse{
-			Total: uint64(len(ops)),
-		},
+		Operations: ops,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -176,18 +143,87 @@ func (qs queryServer) OperationsByProposal(ctx context.Context, req *types.Query
 		return nil, fmt.Errorf("request is nil")
 	}
 
-	ops, err := qs.Keeper.GetOperationsByProposal(ctx, req.ProposalId)
+	// The request carries no pagination; a proposal only has as many
+	// operations as it has messages, so return them all.
+	ops, _, err := qs.Keeper.GetOperationsByProposal(ctx, req.ProposalId, &query.PageRequest{Limit: query.PaginationMaxLimit})
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to non-pointer slice
-	result := make([]types.QueuedOperation, len(ops))
-	for i, op := range ops {
-		result[i] = *op
+	return &types.QueryOperationsByProposalResponse{
+		Operations: ops,
+	}, nil
+}
+
+// PendingProposals returns the IDs of passed proposals the gov hook has marked
+// for timelock but EndBlock has not yet queued, in ascending order
+func (qs queryServer) PendingProposals(ctx context.Context, req *types.QueryPendingProposalsRequest) (*types.QueryPendingProposalsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request is nil")
+	}
+
+	proposalIDs, err := qs.Keeper.GetPendingProposals(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryOperationsByProposalResponse{
-		Operations: result,
+	return &types.QueryPendingProposalsResponse{
+		ProposalIds: proposalIDs,
 	}, nil
 }
+
+// DeferredOperations returns ready operations still waiting to run because of
+// the per-block execution cap
+func (qs queryServer) DeferredOperations(ctx context.Context, req *types.QueryDeferredOperationsRequest) (*types.QueryDeferredOperationsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request is nil")
+	}
+
+	res, err := qs.Keeper.GetDeferredOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// EmergencyEligibleOperations returns queued operations guardians can
+// emergency-execute now
+func (qs queryServer) EmergencyEligibleOperations(ctx context.Context, req *types.QueryEmergencyEligibleOperationsRequest) (*types.QueryEmergencyEligibleOperationsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request is nil")
+	}
+
+	res, err := qs.Keeper.GetEmergencyEligibleOperations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// OperationCountdown returns the seconds until an operation becomes
+// executable, expires and becomes emergency-eligible
+func (qs queryServer) OperationCountdown(ctx context.Context, req *types.QueryOperationCountdownRequest) (*types.QueryOperationCountdownResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request is nil")
+	}
+
+	res, err := qs.Keeper.GetOperationCountdown(ctx, req.OperationId)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DelayPreview returns the timelock delay a proposal with the given message
+// types would receive if it passed and were queued at the current block
+func (qs queryServer) DelayPreview(ctx context.Context, req *types.QueryDelayPreviewRequest) (*types.QueryDelayPreviewResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request is nil")
+	}
+
+	res, err := qs.Keeper.PreviewQueueDelay(ctx, req.MsgTypeUrls)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// setupQueryOperations stores five operations across two proposals:
+// ids 1-3 queued (id 3 not yet executable), id 4 executed, id 5 cancelled.
+func setupQueryOperations(t *testing.T) (Keeper, sdk.Context, types.QueryServer) {
+	t.Helper()
+
+	keeper, ctx, _ := setupTimelockKeeper(t, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return testRouter{storeKey: testKey}
+	})
+
+	for id := uint64(1); id <= 5; id++ {
+		msg := &banktypes.MsgSend{
+			FromAddress: sdk.AccAddress("from_______________").String(),
+			ToAddress:   sdk.AccAddress("to________________").String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("upos", int64(id))),
+		}
+
+		proposalID := uint64(1)
+		if id > 2 {
+			proposalID = 2
+		}
+		var delay uint64
+		if id == 3 {
+			delay = 3600
+		}
+
+		op, err := types.NewQueuedOperation(id, proposalID, []sdk.Msg{msg}, keeper.GetAuthority(), ctx.BlockTime(), delay, 3600, keeper.cdc)
+		require.NoError(t, err)
+
+		switch id {
+		case 4:
+			op.Status = types.OperationStatusExecuted
+		case 5:
+			op.Status = types.OperationStatusCancelled
+		}
+		require.NoError(t, keeper.SetOperation(ctx, op))
+	}
+
+	return keeper, ctx, NewQueryServerImpl(keeper)
+}
+
+func operationIDs(ops []types.QueuedOperation) []uint64 {
+	ids := make([]uint64, len(ops))
+	for i, op := range ops {
+		ids[i] = op.Id
+	}
+	return ids
+}
+
+func TestQueryOperation(t *testing.T) {
+	_, ctx, qs := setupQueryOperations(t)
+
+	res, err := qs.Operation(ctx, &types.QueryOperationRequest{OperationId: 4})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.Operation.Id)
+	require.Equal(t, types.OperationStatusExecuted, res.Operation.Status)
+
+	_, err = qs.Operation(ctx, &types.QueryOperationRequest{OperationId: 99})
+	require.Error(t, err)
+
+	_, err = qs.Operation(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryOperationByHash(t *testing.T) {
+	keeper, ctx, qs := setupQueryOperations(t)
+
+	op, err := keeper.GetOperation(ctx, 2)
+	require.NoError(t, err)
+
+	res, err := qs.OperationByHash(ctx, &types.QueryOperationByHashRequest{
+		Hash: hex.EncodeToString(op.OperationHash),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Operation.Id)
+
+	_, err = qs.OperationByHash(ctx, &types.QueryOperationByHashRequest{Hash: "not-hex"})
+	require.Error(t, err)
+
+	_, err = qs.OperationByHash(ctx, &types.QueryOperationByHashRequest{Hash: hex.EncodeToString(make([]byte, 32))})
+	require.Error(t, err)
+}
+
+func TestQueryOperationsByProposal(t *testing.T) {
+	_, ctx, qs := setupQueryOperations(t)
+
+	res, err := qs.OperationsByProposal(ctx, &types.QueryOperationsByProposalRequest{ProposalId: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5}, operationIDs(res.Operations))
+
+	res, err = qs.OperationsByProposal(ctx, &types.QueryOperationsByProposalRequest{ProposalId: 7})
+	require.NoError(t, err)
+	require.Empty(t, res.Operations)
+}
+
+func TestQueryPendingProposals(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	qs := queryServer{Keeper: keeper}
+
+	res, err := qs.PendingProposals(ctx, &types.QueryPendingProposalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.ProposalIds)
+
+	for _, proposalID := range []uint64{9, 3, 5} {
+		require.NoError(t, keeper.MarkProposalForTimelock(ctx, proposalID))
+	}
+	res, err = qs.PendingProposals(ctx, &types.QueryPendingProposalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 5, 9}, res.ProposalIds)
+
+	require.NoError(t, keeper.ClearPendingProposal(ctx, 5))
+	res, err = qs.PendingProposals(ctx, &types.QueryPendingProposalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 9}, res.ProposalIds)
+
+	_, err = qs.PendingProposals(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryQueuedOperations_Paginated(t *testing.T) {
+	_, ctx, qs := setupQueryOperations(t)
+
+	// Without a page request everything queued is returned with a total
+	res, err := qs.QueuedOperations(ctx, &types.QueryQueuedOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, operationIDs(res.Operations))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	// First page
+	res, err = qs.QueuedOperations(ctx, &types.QueryQueuedOperationsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, operationIDs(res.Operations))
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	// Second page continues from the key
+	res, err = qs.QueuedOperations(ctx, &types.QueryQueuedOperationsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, operationIDs(res.Operations))
+	require.Empty(t, res.Pagination.NextKey)
+
+	// Offset-based paging
+	res, err = qs.QueuedOperations(ctx, &types.QueryQueuedOperationsRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, operationIDs(res.Operations))
+}
+
+func TestQueryExecutableOperations(t *testing.T) {
+	_, ctx, qs := setupQueryOperations(t)
+
+	// Operation 3 is still inside its delay
+	res, err := qs.ExecutableOperations(ctx, &types.QueryExecutableOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, operationIDs(res.Operations))
+}
+
+func TestQueryOperations_FilterByStatus(t *testing.T) {
+	_, ctx, qs := setupQueryOperations(t)
+
+	res, err := qs.Operations(ctx, &types.QueryOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, operationIDs(res.Operations))
+	require.Equal(t, uint64(5), res.Pagination.Total)
+
+	res, err = qs.Operations(ctx, &types.QueryOperationsRequest{Status: types.OperationStatusExecuted})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, operationIDs(res.Operations))
+
+	res, err = qs.Operations(ctx, &types.QueryOperationsRequest{
+		Status:     types.OperationStatusQueued,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, operationIDs(res.Operations))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = qs.Operations(ctx, &types.QueryOperationsRequest{Status: types.OperationStatusFailed})
+	require.NoError(t, err)
+	require.Empty(t, res.Operations)
+}
+
+func TestQueryDeferredOperations_ListsBacklogByExecutableTime(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	qs := queryServer{Keeper: keeper}
+
+	// Three more ready operations than the cap. The highest IDs became
+	// executable earliest, in reverse ID order.
+	total := uint64(types.DefaultMaxOperationsPerBlock + 3)
+	for id := uint64(1); id <= total; id++ {
+		queuedAt := ctx.BlockTime().Add(-time.Duration(id) * time.Minute)
+		queueTestOperation(t, keeper, ctx.WithBlockTime(queuedAt), id, "upos", 0)
+	}
+
+	res, err := qs.DeferredOperations(ctx, &types.QueryDeferredOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, total, res.Count)
+	require.Equal(t, uint64(2), res.BlocksToDrain)
+
+	// EndBlock runs the first DefaultMaxOperationsPerBlock; the rest are deferred
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+
+	res, err = qs.DeferredOperations(ctx, &types.QueryDeferredOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Count)
+	require.Equal(t, uint64(types.DefaultMaxOperationsPerBlock), res.MaxPerBlock)
+	require.Equal(t, uint64(1), res.BlocksToDrain)
+	require.Equal(t, []uint64{total, total - 1, total - 2}, operationIDs(res.Operations))
+
+	// Operations still inside their delay are not part of the backlog
+	queueTestOperation(t, keeper, ctx, total+1, "upos", 3600)
+	res, err = qs.DeferredOperations(ctx, &types.QueryDeferredOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Count)
+}
+
+func TestQueryEmergencyEligibleOperations_FiltersByAge(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	qs := queryServer{Keeper: keeper}
+	now := ctx.BlockTime()
+
+	// 24h delay and 1h grace; the default emergency delay is 6h
+	queueAgo := func(id uint64, age time.Duration) {
+		queueTestOperation(t, keeper, ctx.WithBlockTime(now.Add(-age)), id, "upos", 24*3600)
+	}
+	queueAgo(1, time.Hour)                // inside the emergency delay
+	queueAgo(2, 7*time.Hour)              // eligible, 17h before normal execution
+	queueAgo(3, 24*time.Hour+time.Hour/2) // eligible, already executable
+	queueAgo(4, 26*time.Hour)             // expired
+	queueAgo(5, 10*time.Hour)             // cancelled below
+	require.NoError(t, keeper.CancelOperation(ctx, 5, keeper.GetAuthority(), "superseded by a later proposal"))
+
+	res, err := qs.EmergencyEligibleOperations(ctx, &types.QueryEmergencyEligibleOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Count)
+	require.Equal(t, types.DefaultEmergencyDelaySeconds, res.EmergencyDelaySeconds)
+	require.Len(t, res.Operations, 2)
+
+	require.Equal(t, uint64(2), res.Operations[0].Operation.Id)
+	require.Equal(t, uint64(17*3600), res.Operations[0].SecondsUntilExecutable)
+	require.Equal(t, uint64(3), res.Operations[1].Operation.Id)
+	require.Zero(t, res.Operations[1].SecondsUntilExecutable)
+
+	// Operation 1 becomes eligible once the emergency delay has passed
+	res, err = qs.EmergencyEligibleOperations(ctx.WithBlockTime(now.Add(5*time.Hour)), &types.QueryEmergencyEligibleOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Operations[0].Operation.Id)
+	require.Equal(t, uint64(18*3600), res.Operations[0].SecondsUntilExecutable)
+
+	_, err = qs.EmergencyEligibleOperations(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryOperationCountdown_AtBlockTimes(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	qs := queryServer{Keeper: keeper}
+	queuedAt := ctx.BlockTime()
+
+	// 24h delay, 1h grace, default 6h emergency delay
+	queueTestOperation(t, keeper, ctx, 1, "upos", 24*3600)
+	countdownAt := func(elapsed time.Duration) *types.QueryOperationCountdownResponse {
+		res, err := qs.OperationCountdown(ctx.WithBlockTime(queuedAt.Add(elapsed)), &types.QueryOperationCountdownRequest{OperationId: 1})
+		require.NoError(t, err)
+		return res
+	}
+
+	res := countdownAt(0)
+	require.Equal(t, uint64(24*3600), res.SecondsUntilExecutable)
+	require.Equal(t, uint64(25*3600), res.SecondsUntilExpiry)
+	require.Equal(t, uint64(6*3600), res.SecondsUntilEmergencyEligible)
+	require.False(t, res.Executable)
+	require.False(t, res.Expired)
+	require.False(t, res.EmergencyEligible)
+	require.Equal(t, types.OperationStatusQueued.String(), res.Status)
+
+	// Past the emergency delay
+	res = countdownAt(7 * time.Hour)
+	require.Equal(t, uint64(17*3600), res.SecondsUntilExecutable)
+	require.Zero(t, res.SecondsUntilEmergencyEligible)
+	require.True(t, res.EmergencyEligible)
+	require.False(t, res.Executable)
+
+	// Past the executable time, inside the grace period
+	res = countdownAt(24*time.Hour + 30*time.Minute)
+	require.Zero(t, res.SecondsUntilExecutable)
+	require.Equal(t, uint64(1800), res.SecondsUntilExpiry)
+	require.True(t, res.Executable)
+	require.False(t, res.Expired)
+
+	// Past expiry: everything is zero and nothing can run
+	res = countdownAt(26 * time.Hour)
+	require.Zero(t, res.SecondsUntilExecutable)
+	require.Zero(t, res.SecondsUntilExpiry)
+	require.Zero(t, res.SecondsUntilEmergencyEligible)
+	require.True(t, res.Expired)
+	require.False(t, res.Executable)
+	require.False(t, res.EmergencyEligible)
+
+	_, err := qs.OperationCountdown(ctx, &types.QueryOperationCountdownRequest{OperationId: 99})
+	require.ErrorIs(t, err, types.ErrOperationNotFound)
+	_, err = qs.OperationCountdown(ctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// setupUnroutableMultiSend returns a keeper whose router has no MsgMultiSend handler.
+func setupUnroutableMultiSend(t *testing.T) (Keeper, sdk.Context) {
+	t.Helper()
+
+	keeper, ctx, _ := setupTimelockKeeper(t, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return upgradedRouter{
+			testRouter: testRouter{storeKey: testKey},
+			removed:    map[string]bool{sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): true},
+		}
+	})
+	return keeper, ctx
+}
+
+func requireNothingQueued(t *testing.T, keeper Keeper, ctx sdk.Context, proposalID uint64) {
+	t.Helper()
+
+	ops, _, err := keeper.GetOperationsByProposal(ctx, proposalID, nil)
+	require.NoError(t, err)
+	require.Empty(t, ops)
+	requireQueuedIDs(t, keeper, ctx)
+}
+
+func TestQueueOperation_RejectsUnroutableMessage(t *testing.T) {
+	keeper, ctx := setupUnroutableMultiSend(t)
+
+	_, err := keeper.QueueOperation(ctx, 1, []sdk.Msg{testSend(1), testMultiSend()}, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrHandlerMissing)
+	require.Contains(t, err.Error(), "MsgMultiSend")
+	requireNothingQueued(t, keeper, ctx, 1)
+
+	// Routable messages still queue
+	op, err := keeper.QueueOperation(ctx, 2, []sdk.Msg{testSend(1)}, keeper.GetAuthority())
+	require.NoError(t, err)
+	require.True(t, op.IsQueued())
+}
+
+func TestQueueOperation_RejectsInvalidMessage(t *testing.T) {
+	keeper, ctx, _ := setupTimelockKeeper(t, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return testRouter{storeKey: testKey}
+	})
+
+	invalid := &types.MsgExecuteOperation{
+		Executor:    keeper.GetAuthority(),
+		OperationId: 0,
+	}
+	_, err := keeper.QueueOperation(ctx, 1, []sdk.Msg{testSend(1), invalid}, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrInvalidOperationMessage)
+	requireNothingQueued(t, keeper, ctx, 1)
+}
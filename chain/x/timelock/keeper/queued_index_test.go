@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// queueTestOperation stores a queued single-send operation. Sends in the
+// "fail" denom are rejected by testRouter.
+func queueTestOperation(tb testing.TB, keeper Keeper, ctx sdk.Context, id uint64, denom string, delaySeconds uint64) *types.QueuedOperation {
+	tb.Helper()
+
+	msg := &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress("from_______________").String(),
+		ToAddress:   sdk.AccAddress("to________________").String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 1)),
+	}
+	op, err := types.NewQueuedOperation(id, id, []sdk.Msg{msg}, keeper.GetAuthority(), ctx.BlockTime(), delaySeconds, 3600, keeper.cdc)
+	require.NoError(tb, err)
+	require.NoError(tb, keeper.SetOperation(ctx, op))
+	return op
+}
+
+func requireQueuedIDs(t *testing.T, keeper Keeper, ctx sdk.Context, expected ...uint64) {
+	t.Helper()
+	ids, err := keeper.getQueuedOperationIDs(ctx)
+	require.NoError(t, err)
+	if len(expected) == 0 {
+		require.Empty(t, ids)
+		return
+	}
+	require.Equal(t, expected, ids)
+}
+
+func TestQueuedIndex_TracksStatusTransitions(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+
+	queueTestOperation(t, keeper, ctx, 1, "upos", 0) // executed
+	queueTestOperation(t, keeper, ctx, 2, "upos", 0) // cancelled
+	queueTestOperation(t, keeper, ctx, 3, "fail", 0) // failed
+	queueTestOperation(t, keeper, ctx, 4, "upos", 0) // expired
+	queueTestOperation(t, keeper, ctx, 5, "upos", 7200)
+	requireQueuedIDs(t, keeper, ctx, 1, 2, 3, 4, 5)
+
+	require.NoError(t, keeper.ExecuteOperation(ctx, 1, keeper.GetAuthority()))
+	requireQueuedIDs(t, keeper, ctx, 2, 3, 4, 5)
+
+	require.NoError(t, keeper.CancelOperation(ctx, 2, keeper.GetAuthority(), "superseded by a later proposal"))
+	requireQueuedIDs(t, keeper, ctx, 3, 4, 5)
+
+	require.Error(t, keeper.ExecuteOperation(ctx, 3, keeper.GetAuthority()))
+	op, err := keeper.GetOperation(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusFailed, op.Status)
+	requireQueuedIDs(t, keeper, ctx, 4, 5)
+
+	// Past op 4's grace period but still inside op 5's delay + grace
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	require.NoError(t, keeper.MarkExpiredOperations(ctx))
+	op, err = keeper.GetOperation(ctx, 4)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusExpired, op.Status)
+	requireQueuedIDs(t, keeper, ctx, 5)
+}
+
+func TestQueuedIndex_HandlerMissingLeavesIndex(t *testing.T) {
+	keeper, ctx, _, op := setupOrphanedOperation(t)
+	requireQueuedIDs(t, keeper, ctx, op.Id)
+
+	require.ErrorIs(t, keeper.ExecuteOperation(ctx, op.Id, keeper.GetAuthority()), types.ErrHandlerMissing)
+	requireQueuedIDs(t, keeper, ctx)
+}
+
+func TestQueuedIndex_AutoExecuteSkipsCompletedBacklog(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+
+	// A backlog of completed operations that are not in the index
+	for id := uint64(1); id <= 20; id++ {
+		op := queueTestOperation(t, keeper, ctx, id, "upos", 0)
+		op.MarkExecuted(ctx.BlockTime())
+		require.NoError(t, keeper.SetOperation(ctx, op))
+	}
+	queueTestOperation(t, keeper, ctx, 21, "upos", 0)
+	queueTestOperation(t, keeper, ctx, 22, "upos", 3600)
+	requireQueuedIDs(t, keeper, ctx, 21, 22)
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+
+	op, err := keeper.GetOperation(ctx, 21)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusExecuted, op.Status)
+	requireQueuedIDs(t, keeper, ctx, 22)
+}
+
+// BenchmarkAutoExecuteReadyOperations compares the EndBlock pass over the
+// queued index with the previous full scan, with 10k completed operations and
+// a handful of queued ones that are not yet executable.
+func BenchmarkAutoExecuteReadyOperations(b *testing.B) {
+	keeper, ctx := storeOperations(b, 0, 1)
+
+	for id := uint64(1); id <= benchOperations; id++ {
+		op := queueTestOperation(b, keeper, ctx, id, "upos", 0)
+		op.MarkExecuted(ctx.BlockTime())
+		require.NoError(b, keeper.SetOperation(ctx, op))
+	}
+	for id := uint64(benchOperations + 1); id <= benchOperations+10; id++ {
+		queueTestOperation(b, keeper, ctx, id, "upos", 3600)
+	}
+
+	b.Run("queued_index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := keeper.AutoExecuteReadyOperations(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	// Previous implementation: walk every operation and skip non-queued ones
+	b.Run("full_scan", func(b *testing.B) {
+		now := ctx.BlockTime()
+		for i := 0; i < b.N; i++ {
+			err := keeper.Operations.Walk(ctx, nil, func(_ uint64, op types.QueuedOperation) (bool, error) {
+				if op.Status != types.OperationStatusQueued {
+					return false, nil
+				}
+				_ = op.IsExecutable(now)
+				return false, nil
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
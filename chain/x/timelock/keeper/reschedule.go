@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/timelock/types"
+)
+
+// ExtendDelay reschedules a queued operation to a later executable time
+// (governance only). The grace period keeps its length and is recomputed from
+// the new time; the operation hash is unchanged. The executable time can only
+// move later, and never beyond AbsoluteMaxDelaySeconds after queueing.
+func (k Keeper) ExtendDelay(ctx context.Context, operationID uint64, newExecutableTime time.Time, authority string) error {
+	if authority != k.authority {
+		return fmt.Errorf("%w: expected %s, got %s", types.ErrUnauthorized, k.authority, authority)
+	}
+
+	op, err := k.GetOperation(ctx, operationID)
+	if err != nil {
+		return err
+	}
+	if !op.IsQueued() {
+		return types.ErrOperationNotQueued
+	}
+
+	oldExecutableAt := op.ExecutableTime()
+	if !newExecutableTime.After(oldExecutableAt) {
+		return fmt.Errorf("%w: %v is not after %v",
+			types.ErrInvalidExecutableTime, newExecutableTime.UTC(), oldExecutableAt.UTC())
+	}
+	if delay := newExecutableTime.Unix() - op.QueuedAtUnix; uint64(delay) > types.AbsoluteMaxDelaySeconds {
+		return fmt.Errorf("%w: rescheduled delay %d seconds exceeds %d seconds",
+			types.ErrMaxDelayTooLong, delay, types.AbsoluteMaxDelaySeconds)
+	}
+
+	op.Reschedule(newExecutableTime)
+	if err := k.SetOperation(ctx, op); err != nil {
+		return err
+	}
+
+	// The executable-soon warning applies to the new time
+	if err := k.WarnedOperationIDs.Remove(ctx, op.Id); err != nil {
+		return err
+	}
+
+	k.logger.Info("operation rescheduled",
+		"operation_id", op.Id,
+		"proposal_id", op.ProposalId,
+		"old_executable_at", oldExecutableAt,
+		"new_executable_at", op.ExecutableTime(),
+	)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"operation_rescheduled",
+			sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", op.ProposalId)),
+			sdk.NewAttribute("old_executable_at", oldExecutableAt.String()),
+			sdk.NewAttribute("new_executable_at", op.ExecutableTime().String()),
+			sdk.NewAttribute("expires_at", op.ExpiresTime().String()),
+		),
+	)
+
+	return nil
+}
+
+// ExtendGracePeriod moves a queued operation's expiry later by
+// extensionSeconds (governance only), so an operation that could not be
+// executed in time (e.g. during a chain halt) does not need a new proposal.
+// The executable time and hash are unchanged. Extensions to one operation may
+// total at most MaxGraceExtensionSeconds, and an expired operation cannot be
+// extended.
+func (k Keeper) ExtendGracePeriod(ctx context.Context, operationID uint64, extensionSeconds uint64, authority string) error {
+	if authority != k.authority {
+		return fmt.Errorf("%w: expected %s, got %s", types.ErrUnauthorized, k.authority, authority)
+	}
+	if extensionSeconds == 0 {
+		return fmt.Errorf("%w: grace extension must be positive", types.ErrInvalidDelay)
+	}
+
+	op, err := k.GetOperation(ctx, operationID)
+	if err != nil {
+		return err
+	}
+	if !op.IsQueued() {
+		return types.ErrOperationNotQueued
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if op.IsExpired(sdkCtx.BlockTime()) {
+		return fmt.Errorf("%w: operation %d expired at %v",
+			types.ErrOperationExpired, op.Id, op.ExpiresTime().UTC())
+	}
+
+	extended, err := k.GraceExtensions.Get(ctx, op.Id)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if extended+extensionSeconds > types.MaxGraceExtensionSeconds {
+		return fmt.Errorf("%w: %d seconds already extended, %d more would exceed %d seconds",
+			types.ErrGraceExtensionExceeded, extended, extensionSeconds, types.MaxGraceExtensionSeconds)
+	}
+
+	oldExpiresAt := op.ExpiresTime()
+	op.ExtendGracePeriod(extensionSeconds)
+	if err := k.SetOperation(ctx, op); err != nil {
+		return err
+	}
+	if err := k.GraceExtensions.Set(ctx, op.Id, extended+extensionSeconds); err != nil {
+		return err
+	}
+
+	k.logger.Info("operation grace period extended",
+		"operation_id", op.Id,
+		"proposal_id", op.ProposalId,
+		"old_expires_at", oldExpiresAt,
+		"new_expires_at", op.ExpiresTime(),
+		"total_extension_seconds", extended+extensionSeconds,
+	)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"operation_grace_extended",
+			sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", op.ProposalId)),
+			sdk.NewAttribute("old_expires_at", oldExpiresAt.String()),
+			sdk.NewAttribute("new_expires_at", op.ExpiresTime().String()),
+			sdk.NewAttribute("total_extension_seconds", fmt.Sprintf("%d", extended+extensionSeconds)),
+		),
+	)
+
+	return nil
+}
+
+// RetryOperation re-queues a FAILED operation (governance only), for failures
+// caused by transient conditions such as an insufficient balance. The operation
+// becomes executable MinDelaySeconds after the current block time, with a fresh
+// grace period; its messages and hash are unchanged. Each operation can be
+// retried at most MaxOperationRetries times.
+func (k Keeper) RetryOperation(ctx context.Context, operationID uint64, authority string) error {
+	if authority != k.authority {
+		return fmt.Errorf("%w: expected %s, got %s", types.ErrUnauthorized, k.authority, authority)
+	}
+
+	op, err := k.GetOperation(ctx, operationID)
+	if err != nil {
+		return err
+	}
+	if op.Status != types.OperationStatusFailed {
+		return fmt.Errorf("%w: operation %d is %s", types.ErrOperationNotFailed, op.Id, op.Status)
+	}
+	if op.RetryCount >= types.MaxOperationRetries {
+		return fmt.Errorf("%w: operation %d retried %d times",
+			types.ErrRetryLimitReached, op.Id, op.RetryCount)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	previousError := op.ExecutionError
+	op.Requeue(sdkCtx.BlockTime(), params.MinDelaySeconds, params.GracePeriodSeconds)
+	if err := k.SetOperation(ctx, op); err != nil {
+		return err
+	}
+
+	k.logger.Info("failed operation re-queued",
+		"operation_id", op.Id,
+		"proposal_id", op.ProposalId,
+		"retry_count", op.RetryCount,
+		"executable_at", op.ExecutableTime(),
+		"previous_error", previousError,
+	)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"operation_retried",
+			sdk.NewAttribute("operation_id", fmt.Sprintf("%d", op.Id)),
+			sdk.NewAttribute("proposal_id", fmt.Sprintf("%d", op.ProposalId)),
+			sdk.NewAttribute("retry_count", fmt.Sprintf("%d", op.RetryCount)),
+			sdk.NewAttribute("executable_at", op.ExecutableTime().String()),
+			sdk.NewAttribute("expires_at", op.ExpiresTime().String()),
+			sdk.NewAttribute("previous_error", previousError),
+		),
+	)
+
+	return nil
+}
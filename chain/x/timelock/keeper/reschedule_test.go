@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+func TestExtendDelay_PushesExecutableTimeLater(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	op := queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+	require.NoError(t, keeper.WarnedOperationIDs.Set(ctx, 1))
+
+	newExecutableAt := op.ExecutableTime().Add(48 * time.Hour)
+	require.NoError(t, keeper.ExtendDelay(ctx, 1, newExecutableAt, keeper.GetAuthority()))
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, newExecutableAt.Unix(), stored.ExecutableAtUnix)
+	require.Equal(t, newExecutableAt.Unix()+3600, stored.ExpiresAtUnix)
+	require.Equal(t, op.OperationHash, stored.OperationHash)
+	require.True(t, stored.VerifyHash())
+	require.True(t, stored.IsQueued())
+	require.True(t, hasEvent(ctx, "operation_rescheduled"))
+
+	// The executable-soon warning re-arms for the new time
+	warned, err := keeper.WarnedOperationIDs.Has(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, warned)
+
+	// Not executable at the original time any more
+	err = keeper.ExecuteOperation(ctx.WithBlockTime(op.ExecutableTime()), 1, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotExecutable)
+	require.NoError(t, keeper.ExecuteOperation(ctx.WithBlockTime(newExecutableAt), 1, keeper.GetAuthority()))
+}
+
+func TestExtendDelay_RejectsEarlierOrUnchangedTime(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	op := queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	for _, newExecutableAt := range []time.Time{
+		op.ExecutableTime().Add(-time.Hour),
+		op.ExecutableTime(),
+	} {
+		err := keeper.ExtendDelay(ctx, 1, newExecutableAt, keeper.GetAuthority())
+		require.ErrorIs(t, err, types.ErrInvalidExecutableTime)
+	}
+
+	// Bounded by the absolute maximum delay from the queue time
+	tooLate := op.QueuedTime().Add(time.Duration(types.AbsoluteMaxDelaySeconds+1) * time.Second)
+	err := keeper.ExtendDelay(ctx, 1, tooLate, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrMaxDelayTooLong)
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, op.ExecutableAtUnix, stored.ExecutableAtUnix)
+	require.Equal(t, op.ExpiresAtUnix, stored.ExpiresAtUnix)
+}
+
+func TestExtendDelay_Guards(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	op := queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+	later := op.ExecutableTime().Add(time.Hour)
+
+	// Governance only
+	err := keeper.ExtendDelay(ctx, 1, later, sdk.AccAddress("not_gov_____________").String())
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// Only queued operations can be rescheduled
+	require.NoError(t, keeper.CancelOperation(ctx, 1, keeper.GetAuthority(), "superseded by a later proposal"))
+	err = keeper.ExtendDelay(ctx, 1, later, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotQueued)
+
+	err = keeper.ExtendDelay(ctx, 99, later, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotFound)
+}
+
+func TestExtendGracePeriod_PushesExpiryLater(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	op := queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	require.NoError(t, keeper.ExtendGracePeriod(ctx, 1, 2*3600, keeper.GetAuthority()))
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, op.ExecutableAtUnix, stored.ExecutableAtUnix)
+	require.Equal(t, op.ExpiresAtUnix+2*3600, stored.ExpiresAtUnix)
+	require.Equal(t, op.OperationHash, stored.OperationHash)
+	require.True(t, stored.VerifyHash())
+	require.True(t, hasEvent(ctx, "operation_grace_extended"))
+
+	// Still executable after the original expiry
+	require.NoError(t, keeper.ExecuteOperation(ctx.WithBlockTime(op.ExpiresTime()), 1, keeper.GetAuthority()))
+}
+
+func TestExtendGracePeriod_BoundedByMaxTotalExtension(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	op := queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	require.NoError(t, keeper.ExtendGracePeriod(ctx, 1, types.MaxGraceExtensionSeconds-3600, keeper.GetAuthority()))
+
+	// The bound is on the total across extensions
+	err := keeper.ExtendGracePeriod(ctx, 1, 3601, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrGraceExtensionExceeded)
+	require.NoError(t, keeper.ExtendGracePeriod(ctx, 1, 3600, keeper.GetAuthority()))
+	err = keeper.ExtendGracePeriod(ctx, 1, 1, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrGraceExtensionExceeded)
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, op.ExpiresAtUnix+int64(types.MaxGraceExtensionSeconds), stored.ExpiresAtUnix)
+}
+
+func TestExtendGracePeriod_Guards(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	op := queueTestOperation(t, keeper, ctx, 1, "upos", 86400)
+
+	// Governance only, and the extension must be positive
+	err := keeper.ExtendGracePeriod(ctx, 1, 3600, sdk.AccAddress("not_gov_____________").String())
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	err = keeper.ExtendGracePeriod(ctx, 1, 0, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrInvalidDelay)
+
+	// An operation past its expiry cannot be revived
+	err = keeper.ExtendGracePeriod(ctx.WithBlockTime(op.ExpiresTime()), 1, 3600, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationExpired)
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, op.ExpiresAtUnix, stored.ExpiresAtUnix)
+
+	// Nor can one already marked expired
+	stored.MarkExpired()
+	require.NoError(t, keeper.SetOperation(ctx, stored))
+	err = keeper.ExtendGracePeriod(ctx, 1, 3600, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotQueued)
+}
+
+func TestRetryOperation_RequeuesFailedOperation(t *testing.T) {
+	keeper, ctx, testKey := setupTimelockKeeper(t, func(testKey *storetypes.KVStoreKey) baseapp.MessageRouter {
+		return gasHungryRouter{testRouter: testRouter{storeKey: testKey}, gasPerMsg: 500_000}
+	})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	op := queueTestOperation(t, keeper, ctx, 1, "upos", 0)
+
+	// A gas limit too low for the handler makes the first attempt fail
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAutoExecutionGas = types.MinAutoExecutionGas
+	require.NoError(t, keeper.SetParams(ctx, params))
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+
+	failed, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusFailed, failed.Status)
+
+	// Governance raises the limit and retries
+	params.MaxAutoExecutionGas = types.DefaultMaxAutoExecutionGas
+	require.NoError(t, keeper.SetParams(ctx, params))
+	require.NoError(t, keeper.RetryOperation(ctx, 1, keeper.GetAuthority()))
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, stored.IsQueued())
+	require.Equal(t, uint32(1), stored.RetryCount)
+	require.Equal(t, ctx.BlockTime().Unix()+int64(params.MinDelaySeconds), stored.ExecutableAtUnix)
+	require.Equal(t, stored.ExecutableAtUnix+int64(params.GracePeriodSeconds), stored.ExpiresAtUnix)
+	require.Empty(t, stored.ExecutionError)
+	require.Zero(t, stored.FailedAtHeight)
+	require.Equal(t, op.Messages, stored.Messages)
+	require.Equal(t, op.OperationHash, stored.OperationHash)
+	require.True(t, stored.VerifyHash())
+	require.True(t, hasEvent(ctx, "operation_retried"))
+	requireQueuedIDs(t, keeper, ctx, 1)
+
+	// Not executable again until the fresh delay has passed
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	stored, err = keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, stored.IsQueued())
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx.WithBlockTime(stored.ExecutableTime())))
+	stored, err = keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusExecuted, stored.Status)
+	require.NotNil(t, ctx.KVStore(testKey).Get([]byte("counter")))
+}
+
+func TestRetryOperation_RetryCap(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	queueTestOperation(t, keeper, ctx, 1, "fail", 0)
+
+	for i := uint32(0); i < types.MaxOperationRetries; i++ {
+		require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+		require.NoError(t, keeper.RetryOperation(ctx, 1, keeper.GetAuthority()))
+
+		stored, err := keeper.GetOperation(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, i+1, stored.RetryCount)
+		ctx = ctx.WithBlockTime(stored.ExecutableTime())
+	}
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+	err := keeper.RetryOperation(ctx, 1, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrRetryLimitReached)
+
+	stored, err := keeper.GetOperation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OperationStatusFailed, stored.Status)
+}
+
+func TestRetryOperation_Guards(t *testing.T) {
+	keeper, ctx := storeOperations(t, 0, 1)
+	queueTestOperation(t, keeper, ctx, 1, "upos", 0)
+	queueTestOperation(t, keeper, ctx, 2, "upos", 0)
+	queueTestOperation(t, keeper, ctx, 3, "fail", 0)
+
+	// Queued, executed and cancelled operations cannot be retried
+	err := keeper.RetryOperation(ctx, 1, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotFailed)
+
+	require.NoError(t, keeper.ExecuteOperation(ctx, 1, keeper.GetAuthority()))
+	err = keeper.RetryOperation(ctx, 1, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotFailed)
+
+	require.NoError(t, keeper.CancelOperation(ctx, 2, keeper.GetAuthority(), "superseded by a later proposal"))
+	err = keeper.RetryOperation(ctx, 2, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotFailed)
+
+	// Governance only
+	require.ErrorIs(t, keeper.ExecuteOperation(ctx, 3, keeper.GetAuthority()), types.ErrMessageExecutionFailed)
+	err = keeper.RetryOperation(ctx, 3, sdk.AccAddress("not_gov_____________").String())
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	err = keeper.RetryOperation(ctx, 99, keeper.GetAuthority())
+	require.ErrorIs(t, err, types.ErrOperationNotFound)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+
+	"pos/x/timelock/types"
+)
+
+// EndBlock telemetry. The operations counter accumulates every outcome, from
+// both AutoExecuteReadyOperations and MarkExpiredOperations; the gauges hold
+// the last auto-execution batch.
+const (
+	outcomeExecuted = "executed"
+	outcomeFailed   = "failed"
+	outcomeExpired  = "expired"
+	outcomeDeferred = "deferred"
+
+	metricLabelOutcome = "outcome"
+)
+
+var (
+	// metricKeyOperations counts operations by outcome (label "outcome")
+	metricKeyOperations = []string{types.ModuleName, "end_block", "operations"}
+	// metricKeyBatchOperations is the per-outcome count of the last auto-execution batch
+	metricKeyBatchOperations = []string{types.ModuleName, "end_block", "batch_operations"}
+	// metricKeyGasUsed counts gas consumed by auto-executed operations
+	metricKeyGasUsed = []string{types.ModuleName, "end_block", "gas_used"}
+	// metricKeyBatchGasUsed is the gas consumed by the last auto-execution batch
+	metricKeyBatchGasUsed = []string{types.ModuleName, "end_block", "batch_gas_used"}
+)
+
+// autoExecuteStats tallies one AutoExecuteReadyOperations run
+type autoExecuteStats struct {
+	executed, failed, expired, deferred int
+	gasUsed                             uint64
+}
+
+// emit records the run's counters and batch gauges
+func (s autoExecuteStats) emit() {
+	for _, outcome := range []struct {
+		name  string
+		count int
+	}{
+		{outcomeExecuted, s.executed},
+		{outcomeFailed, s.failed},
+		{outcomeExpired, s.expired},
+		{outcomeDeferred, s.deferred},
+	} {
+		incrOperationOutcome(outcome.name, outcome.count)
+		telemetry.SetGaugeWithLabels(metricKeyBatchOperations, float32(outcome.count), outcomeLabels(outcome.name))
+	}
+
+	if s.gasUsed > 0 {
+		telemetry.IncrCounter(float32(s.gasUsed), metricKeyGasUsed...)
+	}
+	telemetry.SetGauge(float32(s.gasUsed), metricKeyBatchGasUsed...)
+}
+
+// incrOperationOutcome adds count operations to the outcome counter
+func incrOperationOutcome(outcome string, count int) {
+	if count == 0 {
+		return
+	}
+	telemetry.IncrCounterWithLabels(metricKeyOperations, float32(count), outcomeLabels(outcome))
+}
+
+func outcomeLabels(outcome string) []metrics.Label {
+	return []metrics.Label{telemetry.NewLabel(metricLabelOutcome, outcome)}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// useInmemMetrics routes telemetry to an in-memory sink for the test
+func useInmemMetrics(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	telemetry.EnableTelemetry()
+
+	t.Cleanup(func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	})
+	return sink
+}
+
+// counterSum totals a counter across all sink intervals
+func counterSum(sink *metrics.InmemSink, key string) float64 {
+	var sum float64
+	for _, interval := range sink.Data() {
+		if counter, ok := interval.Counters[key]; ok {
+			sum += counter.Sum
+		}
+	}
+	return sum
+}
+
+// gaugeValue returns the latest value of a gauge
+func gaugeValue(sink *metrics.InmemSink, key string) (float32, bool) {
+	data := sink.Data()
+	for i := len(data) - 1; i >= 0; i-- {
+		if gauge, ok := data[i].Gauges[key]; ok {
+			return gauge.Value, true
+		}
+	}
+	return 0, false
+}
+
+func TestTelemetry_EndBlockOutcomes(t *testing.T) {
+	sink := useInmemMetrics(t)
+	keeper, ctx := storeOperations(t, 0, 1)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxOperationsPerBlock = 2
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	queueTestOperation(t, keeper, ctx, 1, "upos", 0) // executed
+	queueTestOperation(t, keeper, ctx, 2, "fail", 0) // failed
+	expired := queueTestOperation(t, keeper, ctx, 3, "upos", 0)
+	expired.ExpiresAtUnix = expired.ExecutableAtUnix // expired on arrival
+	require.NoError(t, keeper.SetOperation(ctx, expired))
+	queueTestOperation(t, keeper, ctx, 4, "upos", 0) // over the per-block cap
+
+	require.NoError(t, keeper.AutoExecuteReadyOperations(ctx))
+
+	require.Equal(t, float64(1), counterSum(sink, "timelock.end_block.operations;outcome=executed"))
+	require.Equal(t, float64(1), counterSum(sink, "timelock.end_block.operations;outcome=failed"))
+	require.Equal(t, float64(1), counterSum(sink, "timelock.end_block.operations;outcome=expired"))
+	require.Equal(t, float64(1), counterSum(sink, "timelock.end_block.operations;outcome=deferred"))
+
+	deferred, ok := gaugeValue(sink, "timelock.end_block.batch_operations;outcome=deferred")
+	require.True(t, ok)
+	require.Equal(t, float32(1), deferred)
+
+	batchGas, ok := gaugeValue(sink, "timelock.end_block.batch_gas_used")
+	require.True(t, ok)
+	require.Positive(t, batchGas)
+	require.Equal(t, float64(batchGas), counterSum(sink, "timelock.end_block.gas_used"))
+
+	// The deferred operation expires unexecuted once its grace period passes
+	require.NoError(t, keeper.MarkExpiredOperations(ctx.WithBlockTime(ctx.BlockTime().Add(2*time.Hour))))
+	require.Equal(t, float64(2), counterSum(sink, "timelock.end_block.operations;outcome=expired"))
+}
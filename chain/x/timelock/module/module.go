@@ -158,6 +158,12 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		return fmt.Errorf("timelock: failed to process pending proposals: %w", err)
 	}
 
+	// Announce operations about to leave their delay so governance
+	// participants and guardians get a heads-up before execution
+	if err := am.keeper.EmitExecutableSoonWarnings(ctx); err != nil {
+		am.keeper.Logger().Error("failed to emit executable-soon warnings", "error", err)
+	}
+
 	// Auto-execute operations that have passed their timelock delay
 	// This solves the execution deadlock where module accounts cannot sign transactions
 	if err := am.keeper.AutoExecuteReadyOperations(ctx); err != nil {
@@ -169,5 +175,10 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	if err := am.keeper.MarkExpiredOperations(ctx); err != nil {
 		am.keeper.Logger().Error("failed to mark expired operations", "error", err)
 	}
+
+	// Cancel operations orphaned by an upgrade, if governance enabled it
+	if err := am.keeper.CancelOrphanedOperations(ctx); err != nil {
+		am.keeper.Logger().Error("failed to cancel orphaned operations", "error", err)
+	}
 	return nil
 }
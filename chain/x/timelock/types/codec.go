@@ -16,6 +16,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgEmergencyExecute{}, "pos/x/timelock/MsgEmergencyExecute")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "pos/x/timelock/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGuardian{}, "pos/x/timelock/MsgUpdateGuardian")
+	legacy.RegisterAminoMsg(cdc, &MsgSetExecutionPaused{}, "pos/x/timelock/MsgSetExecutionPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateHandlerMissingPolicy{}, "pos/x/timelock/MsgUpdateHandlerMissingPolicy")
 }
 
 // RegisterInterfaces registers the x/timelock interfaces types with the interface registry
@@ -26,6 +28,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgEmergencyExecute{},
 		&MsgUpdateParams{},
 		&MsgUpdateGuardian{},
+		&MsgSetExecutionPaused{},
+		&MsgUpdateHandlerMissingPolicy{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
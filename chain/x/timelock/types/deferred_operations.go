@@ -0,0 +1,29 @@
+package types
+
+import (
+	"sort"
+)
+
+// NewDeferredOperationsResponse orders ops by executable time and computes
+// the backlog figures. ops is sorted in place.
+func NewDeferredOperationsResponse(ops []QueuedOperation, maxPerBlock uint64) QueryDeferredOperationsResponse {
+	sort.SliceStable(ops, func(i, j int) bool {
+		if ops[i].ExecutableAtUnix != ops[j].ExecutableAtUnix {
+			return ops[i].ExecutableAtUnix < ops[j].ExecutableAtUnix
+		}
+		return ops[i].Id < ops[j].Id
+	})
+
+	count := uint64(len(ops))
+	var blocks uint64
+	if maxPerBlock > 0 {
+		blocks = (count + maxPerBlock - 1) / maxPerBlock
+	}
+
+	return QueryDeferredOperationsResponse{
+		Operations:    ops,
+		Count:         count,
+		MaxPerBlock:   maxPerBlock,
+		BlocksToDrain: blocks,
+	}
+}
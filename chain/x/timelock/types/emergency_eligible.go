@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+)
+
+// NewEmergencyEligibleOperationsResponse keeps the operations in ops that can
+// be emergency-executed at now and records the time left until each becomes
+// executable normally.
+func NewEmergencyEligibleOperationsResponse(ops []QueuedOperation, now time.Time, emergencyDelaySeconds uint64) QueryEmergencyEligibleOperationsResponse {
+	eligible := make([]EmergencyEligibleOperation, 0, len(ops))
+	for i := range ops {
+		if !ops[i].CanEmergencyExecute(now, emergencyDelaySeconds) {
+			continue
+		}
+		var remaining uint64
+		if ops[i].ExecutableAtUnix > now.Unix() {
+			remaining = uint64(ops[i].ExecutableAtUnix - now.Unix())
+		}
+		eligible = append(eligible, EmergencyEligibleOperation{
+			Operation:              ops[i],
+			SecondsUntilExecutable: remaining,
+		})
+	}
+
+	return QueryEmergencyEligibleOperationsResponse{
+		Operations:            eligible,
+		Count:                 uint64(len(eligible)),
+		EmergencyDelaySeconds: emergencyDelaySeconds,
+	}
+}
@@ -140,4 +140,70 @@ var (
 
 	// ErrFreezeTooLong is returned when freeze_until_height is too far in the future.
 	ErrFreezeTooLong = errors.Register(ModuleName, 3041, "freeze_until_height exceeds maximum allowed freeze duration")
+
+	// --- Execution lifecycle errors (range 3042+) ---
+
+	// ErrHandlerMissing is returned when an operation message has no registered
+	// handler, typically because an upgrade removed the message type.
+	ErrHandlerMissing = errors.Register(ModuleName, 3042, "no message handler registered for operation message")
+
+	// ErrInvalidHandlerMissingPolicy is returned when the orphaned-operation policy is invalid.
+	ErrInvalidHandlerMissingPolicy = errors.Register(ModuleName, 3043, "invalid handler-missing policy")
+
+	// ErrInvalidMessageTypeDelay is returned when a per-message-type delay entry is invalid.
+	ErrInvalidMessageTypeDelay = errors.Register(ModuleName, 3044, "invalid message type delay")
+
+	// ErrInvalidWarningWindow is returned when the executable-soon warning window is invalid.
+	ErrInvalidWarningWindow = errors.Register(ModuleName, 3045, "invalid executable-soon warning window")
+
+	// --- Guardian set errors (range 3046+) ---
+
+	// ErrInvalidGuardianThreshold is returned when the guardian threshold exceeds the guardian set size.
+	ErrInvalidGuardianThreshold = errors.Register(ModuleName, 3046, "invalid guardian threshold")
+
+	// ErrGuardianThresholdNotMet is returned when a single guardian attempts to act
+	// directly while the guardian threshold requires multiple approvals.
+	ErrGuardianThresholdNotMet = errors.Register(ModuleName, 3047, "guardian approval threshold not met")
+
+	// ErrDuplicateGuardianApproval is returned when a guardian approves the same action twice.
+	ErrDuplicateGuardianApproval = errors.Register(ModuleName, 3048, "guardian has already approved this action")
+
+	// ErrInvalidExecutableTime is returned when rescheduling would not move the
+	// executable time later.
+	ErrInvalidExecutableTime = errors.Register(ModuleName, 3049, "new executable time must be later than the current executable time")
+
+	// ErrInvalidOperationMessage is returned when a message fails stateless
+	// validation at queue time.
+	ErrInvalidOperationMessage = errors.Register(ModuleName, 3050, "invalid operation message")
+
+	// ErrInvalidAutoExecutionLimit is returned when the auto-execution gas limit
+	// or per-block cap is out of range.
+	ErrInvalidAutoExecutionLimit = errors.Register(ModuleName, 3051, "invalid auto-execution limit")
+
+	// ErrOperationNotFailed is returned when retrying an operation that is not FAILED.
+	ErrOperationNotFailed = errors.Register(ModuleName, 3052, "operation is not failed")
+
+	// ErrRetryLimitReached is returned when an operation has already been retried
+	// MaxOperationRetries times.
+	ErrRetryLimitReached = errors.Register(ModuleName, 3053, "operation retry limit reached")
+
+	// ErrInvalidOperationStatus is returned when an imported operation has an
+	// unknown or unspecified status.
+	ErrInvalidOperationStatus = errors.Register(ModuleName, 3054, "invalid operation status")
+
+	// ErrGracePeriodBelowMinDelay is returned when the grace period is shorter
+	// than the minimum delay.
+	ErrGracePeriodBelowMinDelay = errors.Register(ModuleName, 3055, "grace_period must be at least min_delay")
+
+	// ErrGraceExtensionExceeded is returned when extending an operation's grace
+	// period would exceed MaxGraceExtensionSeconds in total.
+	ErrGraceExtensionExceeded = errors.Register(ModuleName, 3056, "grace period extension exceeds maximum")
+
+	// ErrExecutionPaused is returned when executing an operation while the
+	// guardian has paused all timelock execution.
+	ErrExecutionPaused = errors.Register(ModuleName, 3057, "timelock execution is paused")
+
+	// ErrGovKeeperNotSet is returned when proposals are pending timelock
+	// processing but the gov keeper was never wired in.
+	ErrGovKeeperNotSet = errors.Register(ModuleName, 3058, "gov keeper not set")
 )
@@ -46,5 +46,14 @@ func (gs *GenesisState) Validate() error {
 			gs.NextOperationId, maxID)
 	}
 
+	// Pending proposals are a set
+	seenProposals := make(map[uint64]bool, len(gs.PendingProposalIds))
+	for _, proposalID := range gs.PendingProposalIds {
+		if seenProposals[proposalID] {
+			return fmt.Errorf("duplicate pending proposal ID %d", proposalID)
+		}
+		seenProposals[proposalID] = true
+	}
+
 	return nil
 }
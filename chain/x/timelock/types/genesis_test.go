@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/timelock/types"
+)
+
+// genesisTestOperation returns a valid queued operation with the given ID
+func genesisTestOperation(t *testing.T, id uint64) types.QueuedOperation {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress("from_______________").String(),
+		ToAddress:   sdk.AccAddress("to________________").String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("upos", 1)),
+	}
+	op, err := types.NewQueuedOperation(id, id, []sdk.Msg{msg}, validTestAuthority(),
+		time.Unix(1_700_000_000, 0), types.DefaultMinDelaySeconds, types.DefaultGracePeriodSeconds, cdc)
+	require.NoError(t, err)
+	return *op
+}
+
+func TestGenesisValidate_Default(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+}
+
+func TestGenesisValidate_WithOperations(t *testing.T) {
+	gs := types.DefaultGenesisState()
+	gs.Operations = []types.QueuedOperation{genesisTestOperation(t, 1), genesisTestOperation(t, 2)}
+	gs.NextOperationId = 3
+	require.NoError(t, gs.Validate())
+}
+
+func TestGenesisValidate_Malformed(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(gs *types.GenesisState)
+		err    error
+	}{
+		{
+			name:   "zero min delay",
+			mutate: func(gs *types.GenesisState) { gs.Params.MinDelaySeconds = 0 },
+			err:    types.ErrMinDelayTooShort,
+		},
+		{
+			name: "grace period shorter than min delay",
+			mutate: func(gs *types.GenesisState) {
+				gs.Params.GracePeriodSeconds = gs.Params.MinDelaySeconds - 1
+			},
+			err: types.ErrGracePeriodBelowMinDelay,
+		},
+		{
+			name: "emergency delay exceeds min delay",
+			mutate: func(gs *types.GenesisState) {
+				gs.Params.EmergencyDelaySeconds = gs.Params.MinDelaySeconds + 1
+			},
+			err: types.ErrEmergencyExceedsMin,
+		},
+		{
+			name:   "operation hash does not match messages",
+			mutate: func(gs *types.GenesisState) { gs.Operations[0].OperationHash[0] ^= 0xff },
+			err:    types.ErrOperationHashMismatch,
+		},
+		{
+			name:   "unknown operation status",
+			mutate: func(gs *types.GenesisState) { gs.Operations[0].Status = types.OperationStatus(99) },
+			err:    types.ErrInvalidOperationStatus,
+		},
+		{
+			name: "unspecified operation status",
+			mutate: func(gs *types.GenesisState) {
+				gs.Operations[0].Status = types.OperationStatusUnspecified
+			},
+			err: types.ErrInvalidOperationStatus,
+		},
+		{
+			name: "executable before queued",
+			mutate: func(gs *types.GenesisState) {
+				gs.Operations[0].ExecutableAtUnix = gs.Operations[0].QueuedAtUnix - 1
+			},
+			err: types.ErrInvalidDelay,
+		},
+		{
+			name: "expires before executable",
+			mutate: func(gs *types.GenesisState) {
+				gs.Operations[0].ExpiresAtUnix = gs.Operations[0].ExecutableAtUnix - 1
+			},
+			err: types.ErrGracePeriodInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.Operations = []types.QueuedOperation{genesisTestOperation(t, 1)}
+			gs.NextOperationId = 2
+			tc.mutate(gs)
+
+			require.ErrorIs(t, gs.Validate(), tc.err)
+		})
+	}
+}
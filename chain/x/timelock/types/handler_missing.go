@@ -0,0 +1,29 @@
+package types
+
+import "fmt"
+
+// DefaultHandlerMissingGraceSeconds is how long an operation may sit in
+// HANDLER_MISSING before the auto-cancel policy (when enabled) cancels it.
+const DefaultHandlerMissingGraceSeconds uint64 = 7 * 24 * 60 * 60
+
+// MaxHandlerMissingGraceSeconds bounds the grace period (90 days).
+const MaxHandlerMissingGraceSeconds uint64 = 90 * 24 * 60 * 60
+
+// DefaultHandlerMissingPolicy returns the policy used before governance sets one.
+// Auto-cancel is off so that an upgrade can restore the handler without the
+// operation being lost.
+func DefaultHandlerMissingPolicy() HandlerMissingPolicy {
+	return HandlerMissingPolicy{
+		AutoCancelEnabled:  false,
+		GracePeriodSeconds: DefaultHandlerMissingGraceSeconds,
+	}
+}
+
+// Validate checks the policy bounds
+func (p HandlerMissingPolicy) Validate() error {
+	if p.GracePeriodSeconds > MaxHandlerMissingGraceSeconds {
+		return fmt.Errorf("%w: grace_period_seconds %d exceeds maximum %d",
+			ErrInvalidHandlerMissingPolicy, p.GracePeriodSeconds, MaxHandlerMissingGraceSeconds)
+	}
+	return nil
+}
@@ -30,7 +30,8 @@ var (
 	// OperationByHashKeyPrefix is the prefix for operation lookup by hash
 	OperationByHashKeyPrefix = []byte{0x03}
 
-	// OperationByProposalKeyPrefix is the prefix for operation lookup by proposal
+	// OperationByProposalKeyPrefix is the prefix for operation lookup by proposal.
+	// Key: OperationByProposalKeyPrefix | BigEndian(proposalID) | BigEndian(operationID)
 	OperationByProposalKeyPrefix = []byte{0x04}
 
 	// OperationByStatusKeyPrefix is the prefix for operation lookup by status
@@ -65,6 +66,43 @@ var (
 	// ParamChangeFreqKeyPrefix counts governance parameter mutation events.
 	// Key: ParamChangeFreqKeyPrefix | BigEndian(windowStartBlock)
 	ParamChangeFreqKeyPrefix = []byte{0x23}
+
+	// HandlerMissingPolicyKey stores the JSON HandlerMissingPolicy governing
+	// auto-cancellation of operations orphaned by an upgrade.
+	HandlerMissingPolicyKey = []byte{0x24}
+
+	// QueuedOperationIDsKeyPrefix indexes the IDs of operations in QUEUED
+	// status so EndBlock does not scan completed operations.
+	// Key: QueuedOperationIDsKeyPrefix | BigEndian(operationID)
+	QueuedOperationIDsKeyPrefix = []byte{0x25}
+
+	// WarnedOperationIDsKeyPrefix records queued operations for which the
+	// operation_executable_soon event has already been emitted.
+	// Key: WarnedOperationIDsKeyPrefix | BigEndian(operationID)
+	WarnedOperationIDsKeyPrefix = []byte{0x26}
+
+	// CancelApprovalsKeyPrefix records guardian approvals to cancel an operation.
+	// Key: CancelApprovalsKeyPrefix | BigEndian(operationID) | guardian
+	CancelApprovalsKeyPrefix = []byte{0x27}
+
+	// EmergencyApprovalsKeyPrefix records guardian approvals to emergency-execute an operation.
+	// Key: EmergencyApprovalsKeyPrefix | BigEndian(operationID) | guardian
+	EmergencyApprovalsKeyPrefix = []byte{0x28}
+
+	// GraceExtensionsKeyPrefix records the total seconds governance has added
+	// to an operation's grace period.
+	// Key: GraceExtensionsKeyPrefix | BigEndian(operationID)
+	GraceExtensionsKeyPrefix = []byte{0x29}
+
+	// PauseApprovalsKeyPrefix records guardian approvals to pause or resume execution.
+	// Key: PauseApprovalsKeyPrefix | paused | guardian
+	PauseApprovalsKeyPrefix = []byte{0x2A}
+
+	// HandlerMissingOperationIDsKeyPrefix indexes the IDs of operations in
+	// HANDLER_MISSING status so the auto-cancel policy does not scan every
+	// operation.
+	// Key: HandlerMissingOperationIDsKeyPrefix | BigEndian(operationID)
+	HandlerMissingOperationIDsKeyPrefix = []byte{0x2B}
 )
 
 // GetOperationKey returns the store key for an operation
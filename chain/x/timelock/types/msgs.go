@@ -11,6 +11,9 @@ const (
 	TypeMsgEmergencyExecute = "emergency_execute"
 	TypeMsgUpdateParams     = "update_params"
 	TypeMsgUpdateGuardian   = "update_guardian"
+
+	TypeMsgSetExecutionPaused         = "set_execution_paused"
+	TypeMsgUpdateHandlerMissingPolicy = "update_handler_missing_policy"
 )
 
 // Route implements sdk.Msg
@@ -131,6 +134,46 @@ func (msg MsgUpdateGuardian) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// Route implements sdk.Msg
+func (msg MsgSetExecutionPaused) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSetExecutionPaused) Type() string { return TypeMsgSetExecutionPaused }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetExecutionPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Guardian); err != nil {
+		return ErrInvalidGuardian
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetExecutionPaused) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Guardian)
+	return []sdk.AccAddress{addr}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateHandlerMissingPolicy) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateHandlerMissingPolicy) Type() string { return TypeMsgUpdateHandlerMissingPolicy }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateHandlerMissingPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrUnauthorized
+	}
+	return msg.Policy.Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateHandlerMissingPolicy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
 // Ensure messages implement sdk.Msg
 var (
 	_ sdk.Msg = &MsgExecuteOperation{}
@@ -138,4 +181,6 @@ var (
 	_ sdk.Msg = &MsgEmergencyExecute{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgUpdateGuardian{}
+	_ sdk.Msg = &MsgSetExecutionPaused{}
+	_ sdk.Msg = &MsgUpdateHandlerMissingPolicy{}
 )
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -209,19 +210,64 @@ func (op *QueuedOperation) MarkExpired() {
 	op.Status = OperationStatusExpired
 }
 
-// MarkFailed marks the operation as failed with error
-func (op *QueuedOperation) MarkFailed(executedAt time.Time, err error) {
+// MarkFailed marks the operation as failed with error, recording the time and
+// block height of the failed attempt
+func (op *QueuedOperation) MarkFailed(executedAt time.Time, height int64, err error) {
 	op.Status = OperationStatusFailed
 	op.ExecutedAtUnix = executedAt.Unix()
+	op.FailedAtHeight = height
 	if err != nil {
 		op.ExecutionError = err.Error()
 	}
 }
 
-// Validate validates the operation
+// MarkHandlerMissing marks the operation as blocked because a message handler
+// is no longer registered. ExecutedAtUnix records when this was detected.
+func (op *QueuedOperation) MarkHandlerMissing(detectedAt time.Time, err error) {
+	op.Status = OperationStatusHandlerMissing
+	op.ExecutedAtUnix = detectedAt.Unix()
+	if err != nil {
+		op.ExecutionError = err.Error()
+	}
+}
+
+// Requeue returns a failed operation to QUEUED with a fresh delay and grace
+// period counted from now, clearing the failure details and counting the retry.
+// The queued time, and so the operation hash, is unchanged.
+func (op *QueuedOperation) Requeue(now time.Time, delaySeconds, gracePeriodSeconds uint64) {
+	op.Status = OperationStatusQueued
+	op.ExecutableAtUnix = now.Unix() + int64(delaySeconds)
+	op.ExpiresAtUnix = op.ExecutableAtUnix + int64(gracePeriodSeconds)
+	op.ExecutedAtUnix = 0
+	op.ExecutionError = ""
+	op.FailedAtHeight = 0
+	op.RetryCount++
+}
+
+// Reschedule moves the executable time to executableAt, keeping the length of
+// the grace period. The operation hash does not cover these fields.
+func (op *QueuedOperation) Reschedule(executableAt time.Time) {
+	gracePeriod := op.ExpiresAtUnix - op.ExecutableAtUnix
+	op.ExecutableAtUnix = executableAt.Unix()
+	op.ExpiresAtUnix = op.ExecutableAtUnix + gracePeriod
+}
+
+// ExtendGracePeriod moves the expiry later by extensionSeconds. The executable
+// time and the operation hash are unchanged.
+func (op *QueuedOperation) ExtendGracePeriod(extensionSeconds uint64) {
+	op.ExpiresAtUnix += int64(extensionSeconds)
+}
+
+// IsHandlerMissing returns true if the operation is blocked on a missing handler
+func (op *QueuedOperation) IsHandlerMissing() bool {
+	return op.Status == OperationStatusHandlerMissing
+}
+
+// Validate validates the operation: the stored hash must match its messages,
+// the status must be a known enum value and queued <= executable <= expires.
 func (op *QueuedOperation) Validate() error {
 	if op.Id == 0 {
-		return ErrInvalidOperationHash
+		return fmt.Errorf("%w: operation ID must be positive", ErrInvalidOperationHash)
 	}
 
 	if len(op.Messages) == 0 {
@@ -233,15 +279,21 @@ func (op *QueuedOperation) Validate() error {
 	}
 
 	if !op.VerifyHash() {
-		return ErrOperationHashMismatch
+		return fmt.Errorf("%w: stored hash %X does not match messages", ErrOperationHashMismatch, op.OperationHash)
+	}
+
+	if _, ok := OperationStatus_name[int32(op.Status)]; !ok || op.Status == OperationStatusUnspecified {
+		return fmt.Errorf("%w: %d", ErrInvalidOperationStatus, op.Status)
 	}
 
 	if op.ExecutableAtUnix < op.QueuedAtUnix {
-		return ErrInvalidDelay
+		return fmt.Errorf("%w: executable_at (%d) is before queued_at (%d)",
+			ErrInvalidDelay, op.ExecutableAtUnix, op.QueuedAtUnix)
 	}
 
 	if op.ExpiresAtUnix < op.ExecutableAtUnix {
-		return ErrGracePeriodInvalid
+		return fmt.Errorf("%w: expires_at (%d) is before executable_at (%d)",
+			ErrGracePeriodInvalid, op.ExpiresAtUnix, op.ExecutableAtUnix)
 	}
 
 	return nil
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"time"
+)
+
+// NewOperationCountdown computes the countdown for op at now
+func NewOperationCountdown(op QueuedOperation, now time.Time, emergencyDelaySeconds uint64) QueryOperationCountdownResponse {
+	nowUnix := now.Unix()
+	secondsUntil := func(unix int64) uint64 {
+		if unix <= nowUnix {
+			return 0
+		}
+		return uint64(unix - nowUnix)
+	}
+
+	return QueryOperationCountdownResponse{
+		OperationId:                   op.Id,
+		Status:                        op.Status.String(),
+		SecondsUntilExecutable:        secondsUntil(op.ExecutableAtUnix),
+		SecondsUntilExpiry:            secondsUntil(op.ExpiresAtUnix),
+		SecondsUntilEmergencyEligible: secondsUntil(op.QueuedAtUnix + int64(emergencyDelaySeconds)),
+		Executable:                    op.IsExecutable(now),
+		Expired:                       op.Status == OperationStatusExpired || op.IsExpired(now),
+		EmergencyEligible:             op.CanEmergencyExecute(now, emergencyDelaySeconds),
+	}
+}
@@ -2,7 +2,11 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Security constants - absolute minimums that cannot be overridden
@@ -32,6 +36,10 @@ const (
 	// SECURITY: Matches AbsoluteMinDelaySeconds to ensure minimum community review window
 	DefaultEmergencyDelaySeconds uint64 = 21600
 
+	// DefaultExecutableSoonWarningSeconds is how long before an operation
+	// becomes executable the operation_executable_soon event fires (1 hour)
+	DefaultExecutableSoonWarningSeconds uint64 = 3600
+
 	// Legacy time.Duration constants for backward compatibility in tests
 	AbsoluteMinDelay       = 6 * time.Hour
 	AbsoluteMaxDelay       = 30 * 24 * time.Hour
@@ -47,6 +55,33 @@ const (
 	// MaxCancelReasonLength is the maximum length for cancellation reason
 	MaxCancelReasonLength = 500
 
+	// DefaultMaxOperationsPerBlock limits how many operations EndBlock
+	// auto-executes per block; further ready operations are deferred to later blocks.
+	DefaultMaxOperationsPerBlock uint64 = 5
+
+	// MinOperationsPerBlock and MaxOperationsPerBlockLimit bound the
+	// max_operations_per_block param.
+	MinOperationsPerBlock      uint64 = 1
+	MaxOperationsPerBlockLimit uint64 = 50
+
+	// DefaultMaxAutoExecutionGas is the gas limit for executing one operation's
+	// messages. 2M gas is sufficient for parameter changes, token transfers, and
+	// validator operations while preventing abuse.
+	DefaultMaxAutoExecutionGas uint64 = 2_000_000
+
+	// MinAutoExecutionGas and MaxAutoExecutionGasLimit bound the
+	// max_auto_execution_gas param.
+	MinAutoExecutionGas      uint64 = 100_000
+	MaxAutoExecutionGasLimit uint64 = 10_000_000
+
+	// MaxOperationRetries is how many times governance may re-queue a failed
+	// operation before a new proposal is required.
+	MaxOperationRetries uint32 = 3
+
+	// MaxGraceExtensionSeconds bounds the total time governance may add to one
+	// operation's grace period (7 days), e.g. after a chain halt.
+	MaxGraceExtensionSeconds uint64 = 7 * 24 * 3600
+
 	// MinJustificationLength is the minimum length for emergency justification
 	MinJustificationLength = 20
 
@@ -117,6 +152,10 @@ const (
 	OperationStatusCancelled   = OperationStatus_OPERATION_STATUS_CANCELLED
 	OperationStatusExpired     = OperationStatus_OPERATION_STATUS_EXPIRED
 	OperationStatusFailed      = OperationStatus_OPERATION_STATUS_FAILED
+
+	// OperationStatusHandlerMissing is not terminal: the operation may still
+	// be cancelled (manually or by the HandlerMissingPolicy auto-cancel).
+	OperationStatusHandlerMissing = OperationStatus_OPERATION_STATUS_HANDLER_MISSING
 )
 
 // IsTerminal returns true if the status is a terminal state
@@ -135,6 +174,10 @@ func DefaultParams() Params {
 		GracePeriodSeconds:    DefaultGracePeriodSeconds,
 		EmergencyDelaySeconds: DefaultEmergencyDelaySeconds,
 		Guardian:              "", // Must be set during genesis or via governance
+
+		ExecutableSoonWarningSeconds: DefaultExecutableSoonWarningSeconds,
+		MaxAutoExecutionGas:          DefaultMaxAutoExecutionGas,
+		MaxOperationsPerBlock:        DefaultMaxOperationsPerBlock,
 	}
 }
 
@@ -152,6 +195,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.validateMessageTypeDelays(); err != nil {
+		return err
+	}
+
+	if p.ExecutableSoonWarningSeconds > p.MaxDelaySeconds {
+		return fmt.Errorf("%w: %v seconds exceeds max_delay (%v seconds)",
+			ErrInvalidWarningWindow, p.ExecutableSoonWarningSeconds, p.MaxDelaySeconds)
+	}
+
+	if err := p.validateGuardians(); err != nil {
+		return err
+	}
+
+	if err := p.validateAutoExecutionLimits(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -185,6 +245,12 @@ func (p Params) validateGracePeriod() error {
 			ErrGracePeriodInvalid, p.GracePeriodSeconds, AbsoluteMinGracePeriodSeconds)
 	}
 
+	// An operation should stay executable for at least as long as it waited
+	if p.GracePeriodSeconds < p.MinDelaySeconds {
+		return fmt.Errorf("%w: grace_period (%v seconds) < min_delay (%v seconds)",
+			ErrGracePeriodBelowMinDelay, p.GracePeriodSeconds, p.MinDelaySeconds)
+	}
+
 	return nil
 }
 
@@ -205,6 +271,137 @@ func (p Params) validateEmergencyDelay() error {
 	return nil
 }
 
+// validateMessageTypeDelays validates the per-message-type delays. Each delay
+// must lie within the global delay bounds; entries below min_delay would have
+// no effect since the effective delay never drops under the global default.
+func (p Params) validateMessageTypeDelays() error {
+	typeURLs := make([]string, 0, len(p.MessageTypeDelays))
+	for typeURL := range p.MessageTypeDelays {
+		typeURLs = append(typeURLs, typeURL)
+	}
+	sort.Strings(typeURLs) // deterministic error reporting
+
+	for _, typeURL := range typeURLs {
+		delay := p.MessageTypeDelays[typeURL]
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("%w: type URL %q must be of the form /package.Msg",
+				ErrInvalidMessageTypeDelay, typeURL)
+		}
+		if delay < p.MinDelaySeconds || delay > p.MaxDelaySeconds {
+			return fmt.Errorf("%w: %s delay %v seconds outside [%v, %v]",
+				ErrInvalidMessageTypeDelay, typeURL, delay, p.MinDelaySeconds, p.MaxDelaySeconds)
+		}
+	}
+
+	return nil
+}
+
+// validateGuardians validates the guardian set and threshold. The legacy
+// guardian field is not address-checked here to keep existing genesis files
+// valid; entries in guardians must be well-formed and unique.
+func (p Params) validateGuardians() error {
+	seen := make(map[string]bool, len(p.Guardians)+1)
+	if p.Guardian != "" {
+		seen[p.Guardian] = true
+	}
+	for _, guardian := range p.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return fmt.Errorf("%w: %q: %v", ErrInvalidGuardian, guardian, err)
+		}
+		if seen[guardian] {
+			return fmt.Errorf("%w: duplicate guardian %s", ErrInvalidGuardian, guardian)
+		}
+		seen[guardian] = true
+	}
+
+	if p.GuardianThreshold > 1 && p.GuardianThreshold > uint64(len(seen)) {
+		return fmt.Errorf("%w: threshold %d exceeds guardian set size %d",
+			ErrInvalidGuardianThreshold, p.GuardianThreshold, len(seen))
+	}
+
+	return nil
+}
+
+// validateAutoExecutionLimits validates the auto-execution gas limit and
+// per-block cap. Zero is accepted for params stored before these fields existed
+// and falls back to the defaults.
+func (p Params) validateAutoExecutionLimits() error {
+	if p.MaxAutoExecutionGas != 0 &&
+		(p.MaxAutoExecutionGas < MinAutoExecutionGas || p.MaxAutoExecutionGas > MaxAutoExecutionGasLimit) {
+		return fmt.Errorf("%w: max_auto_execution_gas %d outside [%d, %d]",
+			ErrInvalidAutoExecutionLimit, p.MaxAutoExecutionGas, MinAutoExecutionGas, MaxAutoExecutionGasLimit)
+	}
+
+	if p.MaxOperationsPerBlock != 0 &&
+		(p.MaxOperationsPerBlock < MinOperationsPerBlock || p.MaxOperationsPerBlock > MaxOperationsPerBlockLimit) {
+		return fmt.Errorf("%w: max_operations_per_block %d outside [%d, %d]",
+			ErrInvalidAutoExecutionLimit, p.MaxOperationsPerBlock, MinOperationsPerBlock, MaxOperationsPerBlockLimit)
+	}
+
+	return nil
+}
+
+// EffectiveMaxAutoExecutionGas returns the per-operation execution gas limit.
+// An unset value means DefaultMaxAutoExecutionGas.
+func (p Params) EffectiveMaxAutoExecutionGas() uint64 {
+	if p.MaxAutoExecutionGas == 0 {
+		return DefaultMaxAutoExecutionGas
+	}
+	return p.MaxAutoExecutionGas
+}
+
+// EffectiveMaxOperationsPerBlock returns the per-block auto-execution cap.
+// An unset value means DefaultMaxOperationsPerBlock.
+func (p Params) EffectiveMaxOperationsPerBlock() uint64 {
+	if p.MaxOperationsPerBlock == 0 {
+		return DefaultMaxOperationsPerBlock
+	}
+	return p.MaxOperationsPerBlock
+}
+
+// GuardianSet returns the guardian addresses: guardian (if set) followed by guardians.
+func (p Params) GuardianSet() []string {
+	set := make([]string, 0, len(p.Guardians)+1)
+	if p.Guardian != "" {
+		set = append(set, p.Guardian)
+	}
+	return append(set, p.Guardians...)
+}
+
+// IsGuardian returns true if addr is a member of the guardian set.
+func (p Params) IsGuardian(addr string) bool {
+	if addr == "" {
+		return false
+	}
+	for _, guardian := range p.GuardianSet() {
+		if guardian == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// EffectiveGuardianThreshold returns the number of distinct guardian approvals
+// required for a guardian action. An unset threshold means a single guardian.
+func (p Params) EffectiveGuardianThreshold() uint64 {
+	if p.GuardianThreshold == 0 {
+		return 1
+	}
+	return p.GuardianThreshold
+}
+
+// MessageTypeDelay returns the longest per-type delay configured for any of
+// the given message type URLs, or 0 if none of them has one.
+func (p Params) MessageTypeDelay(msgTypeURLs []string) uint64 {
+	var delay uint64
+	for _, typeURL := range msgTypeURLs {
+		if d, ok := p.MessageTypeDelays[typeURL]; ok && d > delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
 // ValidateCancelReason validates the cancellation reason
 func ValidateCancelReason(reason string) error {
 	if len(reason) < MinCancelReasonLength {
@@ -252,3 +449,8 @@ func (p Params) GracePeriodDuration() time.Duration {
 func (p Params) EmergencyDelayDuration() time.Duration {
 	return time.Duration(p.EmergencyDelaySeconds) * time.Second
 }
+
+// ExecutableSoonWarningDuration returns the executable-soon warning window as a time.Duration
+func (p Params) ExecutableSoonWarningDuration() time.Duration {
+	return time.Duration(p.ExecutableSoonWarningSeconds) * time.Second
+}
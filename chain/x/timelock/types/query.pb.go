@@ -687,1108 +687,3581 @@ func (m *QueryOperationsByProposalResponse) GetOperations() []QueuedOperation {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pos.timelock.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pos.timelock.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryOperationRequest)(nil), "pos.timelock.v1.QueryOperationRequest")
-	proto.RegisterType((*QueryOperationResponse)(nil), "pos.timelock.v1.QueryOperationResponse")
-	proto.RegisterType((*QueryOperationsRequest)(nil), "pos.timelock.v1.QueryOperationsRequest")
-	proto.RegisterType((*QueryOperationsResponse)(nil), "pos.timelock.v1.QueryOperationsResponse")
-	proto.RegisterType((*QueryQueuedOperationsRequest)(nil), "pos.timelock.v1.QueryQueuedOperationsRequest")
-	proto.RegisterType((*QueryQueuedOperationsResponse)(nil), "pos.timelock.v1.QueryQueuedOperationsResponse")
-	proto.RegisterType((*QueryExecutableOperationsRequest)(nil), "pos.timelock.v1.QueryExecutableOperationsRequest")
-	proto.RegisterType((*QueryExecutableOperationsResponse)(nil), "pos.timelock.v1.QueryExecutableOperationsResponse")
-	proto.RegisterType((*QueryOperationByHashRequest)(nil), "pos.timelock.v1.QueryOperationByHashRequest")
-	proto.RegisterType((*QueryOperationByHashResponse)(nil), "pos.timelock.v1.QueryOperationByHashResponse")
-	proto.RegisterType((*QueryOperationsByProposalRequest)(nil), "pos.timelock.v1.QueryOperationsByProposalRequest")
-	proto.RegisterType((*QueryOperationsByProposalResponse)(nil), "pos.timelock.v1.QueryOperationsByProposalResponse")
+// QueryPendingProposalsRequest is the request for Query/PendingProposals
+type QueryPendingProposalsRequest struct {
 }
 
-func init() { proto.RegisterFile("pos/timelock/v1/query.proto", fileDescriptor_2252cf5c78c94c12) }
-
-var fileDescriptor_2252cf5c78c94c12 = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x4f, 0x13, 0x5f,
-	0x14, 0xed, 0xe3, 0xc7, 0xaf, 0x91, 0x8b, 0x11, 0x73, 0xad, 0x82, 0x03, 0x14, 0x18, 0x0c, 0xe0,
-	0x1f, 0x66, 0x32, 0x55, 0x13, 0xc3, 0xc2, 0x45, 0x8d, 0x28, 0x89, 0x89, 0x50, 0x37, 0xc6, 0x85,
-	0xe4, 0x95, 0x3e, 0x4b, 0xa5, 0xf4, 0x0d, 0x7d, 0x53, 0x42, 0x43, 0xd8, 0x18, 0xf7, 0x1a, 0xdd,
-	0xb9, 0xd3, 0xa5, 0x2b, 0x16, 0x6e, 0xfc, 0x06, 0x2c, 0x49, 0xdc, 0xb8, 0x32, 0x06, 0xfc, 0x20,
-	0x66, 0xde, 0xbc, 0xce, 0xb4, 0xd3, 0x69, 0xa7, 0x1a, 0x4c, 0xd8, 0x34, 0x93, 0xdb, 0x73, 0xef,
-	0x3d, 0xf7, 0xdc, 0xf7, 0xce, 0x0c, 0x8c, 0xda, 0x5c, 0x98, 0x4e, 0x69, 0x93, 0x95, 0xf9, 0xda,
-	0x86, 0xb9, 0x6d, 0x99, 0x5b, 0x35, 0x56, 0xad, 0x1b, 0x76, 0x95, 0x3b, 0x1c, 0x87, 0x6c, 0x2e,
-	0x8c, 0xc6, 0x9f, 0xc6, 0xb6, 0xa5, 0x8d, 0x15, 0x39, 0x2f, 0x96, 0x99, 0x49, 0xed, 0x92, 0x49,
-	0x2b, 0x15, 0xee, 0x50, 0xa7, 0xc4, 0x2b, 0xc2, 0x83, 0x6b, 0xd7, 0xd6, 0xb8, 0xd8, 0xe4, 0xc2,
-	0xcc, 0x53, 0xc1, 0xbc, 0x3a, 0xe6, 0xb6, 0x95, 0x67, 0x0e, 0xb5, 0x4c, 0x9b, 0x16, 0x4b, 0x15,
-	0x09, 0x56, 0xd8, 0x54, 0x91, 0x17, 0xb9, 0x7c, 0x34, 0xdd, 0x27, 0x15, 0x6d, 0x63, 0xe3, 0xd4,
-	0x6d, 0xa6, 0xca, 0xeb, 0x29, 0xc0, 0x15, 0xb7, 0xe8, 0x32, 0xad, 0xd2, 0x4d, 0x91, 0x63, 0x5b,
-	0x35, 0x26, 0x1c, 0xfd, 0x11, 0x5c, 0x68, 0x89, 0x0a, 0x9b, 0x57, 0x04, 0xc3, 0xdb, 0x90, 0xb4,
-	0x65, 0x64, 0x84, 0x4c, 0x92, 0xb9, 0xc1, 0xcc, 0xb0, 0x11, 0x9a, 0xc5, 0xf0, 0x12, 0xb2, 0xfd,
-	0x07, 0x3f, 0x26, 0x12, 0x39, 0x05, 0xd6, 0x17, 0xe0, 0xa2, 0xac, 0xf6, 0xd8, 0x66, 0x55, 0x49,
-	0x57, 0xb5, 0xc1, 0x29, 0x38, 0xcb, 0x1b, 0xb1, 0xd5, 0x52, 0x41, 0x56, 0xed, 0xcf, 0x0d, 0xfa,
-	0xb1, 0xa5, 0x82, 0xfe, 0x14, 0x2e, 0x85, 0x73, 0x15, 0x99, 0xbb, 0x30, 0xe0, 0x03, 0x15, 0x9f,
-	0xc9, 0x36, 0x3e, 0x2b, 0x35, 0x56, 0x63, 0x85, 0x20, 0x39, 0x48, 0xd1, 0x3f, 0x90, 0x70, 0xe9,
-	0xc6, 0xf8, 0x78, 0x07, 0x92, 0xc2, 0xa1, 0x4e, 0xcd, 0x9b, 0xf3, 0x5c, 0x44, 0x5d, 0x3f, 0xe7,
-	0x89, 0xc4, 0xe5, 0x14, 0x1e, 0x17, 0x01, 0x82, 0xad, 0x8c, 0xf4, 0x49, 0x56, 0x33, 0x86, 0xb7,
-	0x42, 0xc3, 0x5d, 0xa1, 0xe1, 0x1d, 0x05, 0xb5, 0x42, 0x63, 0x99, 0x16, 0x99, 0xea, 0x9a, 0x6b,
-	0xca, 0xd4, 0x3f, 0x13, 0x18, 0x6e, 0x23, 0xa7, 0x06, 0x5f, 0x04, 0xf0, 0xa7, 0x70, 0x19, 0xfe,
-	0xd7, 0xcb, 0xe4, 0x6a, 0x25, 0x4d, 0x99, 0xf8, 0x20, 0x82, 0xeb, 0x6c, 0x2c, 0x57, 0x8f, 0x44,
-	0x0b, 0xd9, 0x17, 0x30, 0x26, 0xb9, 0x86, 0x5a, 0xfa, 0x72, 0xb6, 0x8a, 0x42, 0xfe, 0x5a, 0x94,
-	0x7d, 0x02, 0xe3, 0x1d, 0x1a, 0x9d, 0x56, 0x69, 0x5e, 0xc2, 0xa4, 0x64, 0x7c, 0x7f, 0x87, 0xad,
-	0xd5, 0x1c, 0x9a, 0x2f, 0xb3, 0x7f, 0x27, 0xcf, 0x17, 0x02, 0x53, 0x5d, 0x9a, 0x9d, 0x56, 0x89,
-	0x2c, 0x18, 0x6d, 0x3d, 0xe9, 0xd9, 0xfa, 0x43, 0x2a, 0xd6, 0x1b, 0xea, 0x20, 0xf4, 0xaf, 0x53,
-	0xb1, 0x2e, 0x75, 0x19, 0xc8, 0xc9, 0x67, 0xfd, 0xb9, 0x3a, 0x70, 0x6d, 0x29, 0x27, 0x64, 0x0d,
-	0xf7, 0xd4, 0xd6, 0x02, 0xf9, 0xb2, 0xf5, 0xe5, 0x2a, 0xb7, 0xb9, 0xa0, 0xe5, 0x06, 0xaf, 0x09,
-	0x18, 0xb4, 0x55, 0x28, 0xb0, 0x2e, 0x68, 0x84, 0x96, 0x0a, 0xfa, 0x86, 0xda, 0x46, 0x74, 0x91,
-	0x93, 0xdd, 0x46, 0x66, 0xff, 0x0c, 0xfc, 0x2f, 0xbb, 0xa1, 0x03, 0x49, 0xcf, 0x84, 0x71, 0x3a,
-	0xaa, 0x4e, 0xc8, 0xe9, 0xb5, 0x2b, 0xdd, 0x41, 0x1e, 0x4d, 0x7d, 0xe2, 0xd5, 0xb7, 0x5f, 0xef,
-	0xfb, 0x2e, 0xe3, 0xb0, 0x19, 0x7e, 0x97, 0x78, 0x16, 0x8f, 0x6f, 0x08, 0x0c, 0xf8, 0xfc, 0x70,
-	0x26, 0xba, 0x68, 0xd8, 0xff, 0xb5, 0xd9, 0x58, 0x9c, 0xea, 0x6f, 0xc9, 0xfe, 0xd7, 0xf1, 0x6a,
-	0x5b, 0x7f, 0x5f, 0x03, 0x73, 0xb7, 0xf9, 0x55, 0xb2, 0x87, 0xaf, 0x09, 0x40, 0x20, 0x3d, 0xc6,
-	0xb5, 0xf2, 0x05, 0x99, 0x8b, 0x07, 0x2a, 0x52, 0xd3, 0x92, 0xd4, 0x38, 0x8e, 0x76, 0x26, 0x25,
-	0xf0, 0x1d, 0x81, 0xf3, 0x61, 0xbb, 0xc2, 0xf9, 0xe8, 0x1e, 0x1d, 0xfc, 0x53, 0x33, 0x7a, 0x85,
-	0xc7, 0x6e, 0x6b, 0x4b, 0xa6, 0xe0, 0x27, 0x02, 0xa9, 0x28, 0x93, 0x40, 0x2b, 0xba, 0x53, 0x17,
-	0xf7, 0xd2, 0x32, 0x7f, 0x92, 0x12, 0xab, 0x1c, 0xf3, 0xd3, 0xf0, 0x23, 0x81, 0xa1, 0xd0, 0x05,
-	0xc7, 0x1b, 0x31, 0xcb, 0x69, 0xb1, 0x0e, 0x6d, 0xbe, 0x47, 0x74, 0xef, 0x87, 0x6c, 0x35, 0x5f,
-	0x5f, 0x75, 0x1d, 0xc8, 0xdc, 0x75, 0x7f, 0xf7, 0xf0, 0x2b, 0x81, 0x54, 0xd4, 0xfd, 0xee, 0x24,
-	0x64, 0x17, 0x43, 0xe9, 0x24, 0x64, 0x37, 0xfb, 0xd0, 0x17, 0x24, 0xe5, 0x5b, 0x98, 0x69, 0xbf,
-	0x97, 0x0a, 0x6a, 0xee, 0x36, 0xb9, 0xd4, 0x5e, 0xd3, 0xc9, 0xcc, 0x1a, 0x07, 0x47, 0x69, 0x72,
-	0x78, 0x94, 0x26, 0x3f, 0x8f, 0xd2, 0xe4, 0xed, 0x71, 0x3a, 0x71, 0x78, 0x9c, 0x4e, 0x7c, 0x3f,
-	0x4e, 0x27, 0x9e, 0xa5, 0xdc, 0x62, 0x3b, 0x41, 0x39, 0xf9, 0xbd, 0x98, 0x4f, 0xca, 0x0f, 0xc6,
-	0x9b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xad, 0x81, 0x71, 0xae, 0xdd, 0x0a, 0x00, 0x00,
+func (m *QueryPendingProposalsRequest) Reset()         { *m = QueryPendingProposalsRequest{} }
+func (m *QueryPendingProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingProposalsRequest) ProtoMessage()    {}
+func (*QueryPendingProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{14}
+}
+func (m *QueryPendingProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingProposalsRequest.Merge(m, src)
+}
+func (m *QueryPendingProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingProposalsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPendingProposalsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the module parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Operation returns a specific operation by ID
-	Operation(ctx context.Context, in *QueryOperationRequest, opts ...grpc.CallOption) (*QueryOperationResponse, error)
-	// Operations returns all operations with optional status filter
-	Operations(ctx context.Context, in *QueryOperationsRequest, opts ...grpc.CallOption) (*QueryOperationsResponse, error)
-	// QueuedOperations returns all operations in QUEUED status
-	QueuedOperations(ctx context.Context, in *QueryQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryQueuedOperationsResponse, error)
-	// ExecutableOperations returns operations ready for execution
-	ExecutableOperations(ctx context.Context, in *QueryExecutableOperationsRequest, opts ...grpc.CallOption) (*QueryExecutableOperationsResponse, error)
-	// OperationByHash returns an operation by its hash
-	OperationByHash(ctx context.Context, in *QueryOperationByHashRequest, opts ...grpc.CallOption) (*QueryOperationByHashResponse, error)
-	// OperationsByProposal returns all operations for a governance proposal
-	OperationsByProposal(ctx context.Context, in *QueryOperationsByProposalRequest, opts ...grpc.CallOption) (*QueryOperationsByProposalResponse, error)
+// QueryPendingProposalsResponse is the response for Query/PendingProposals
+type QueryPendingProposalsResponse struct {
+	ProposalIds []uint64 `protobuf:"varint,1,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingProposalsResponse) Reset()         { *m = QueryPendingProposalsResponse{} }
+func (m *QueryPendingProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingProposalsResponse) ProtoMessage()    {}
+func (*QueryPendingProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{15}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingProposalsResponse.Merge(m, src)
+}
+func (m *QueryPendingProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingProposalsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Operation(ctx context.Context, in *QueryOperationRequest, opts ...grpc.CallOption) (*QueryOperationResponse, error) {
-	out := new(QueryOperationResponse)
-	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/Operation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingProposalsResponse) GetProposalIds() []uint64 {
+	if m != nil {
+		return m.ProposalIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Operations(ctx context.Context, in *QueryOperationsRequest, opts ...grpc.CallOption) (*QueryOperationsResponse, error) {
-	out := new(QueryOperationsResponse)
-	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/Operations", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryDeferredOperationsRequest is the request for Query/DeferredOperations
+type QueryDeferredOperationsRequest struct {
+}
+
+func (m *QueryDeferredOperationsRequest) Reset()         { *m = QueryDeferredOperationsRequest{} }
+func (m *QueryDeferredOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredOperationsRequest) ProtoMessage()    {}
+func (*QueryDeferredOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{16}
+}
+func (m *QueryDeferredOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeferredOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredOperationsRequest.Merge(m, src)
+}
+func (m *QueryDeferredOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredOperationsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueuedOperations(ctx context.Context, in *QueryQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryQueuedOperationsResponse, error) {
-	out := new(QueryQueuedOperationsResponse)
-	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/QueuedOperations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeferredOperationsRequest proto.InternalMessageInfo
+
+// QueryDeferredOperationsResponse is the response for Query/DeferredOperations
+type QueryDeferredOperationsResponse struct {
+	// operations are ordered by executable time, oldest first (ties by ID)
+	Operations []QueuedOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
+	Count      uint64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// max_per_block is the auto-execution cap the backlog drains at
+	MaxPerBlock uint64 `protobuf:"varint,3,opt,name=max_per_block,json=maxPerBlock,proto3" json:"max_per_block,omitempty"`
+	// blocks_to_drain is the minimum number of blocks needed to run the backlog
+	BlocksToDrain uint64 `protobuf:"varint,4,opt,name=blocks_to_drain,json=blocksToDrain,proto3" json:"blocks_to_drain,omitempty"`
+}
+
+func (m *QueryDeferredOperationsResponse) Reset()         { *m = QueryDeferredOperationsResponse{} }
+func (m *QueryDeferredOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredOperationsResponse) ProtoMessage()    {}
+func (*QueryDeferredOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{17}
+}
+func (m *QueryDeferredOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDeferredOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredOperationsResponse.Merge(m, src)
+}
+func (m *QueryDeferredOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredOperationsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ExecutableOperations(ctx context.Context, in *QueryExecutableOperationsRequest, opts ...grpc.CallOption) (*QueryExecutableOperationsResponse, error) {
-	out := new(QueryExecutableOperationsResponse)
-	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/ExecutableOperations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeferredOperationsResponse proto.InternalMessageInfo
+
+func (m *QueryDeferredOperationsResponse) GetOperations() []QueuedOperation {
+	if m != nil {
+		return m.Operations
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) OperationByHash(ctx context.Context, in *QueryOperationByHashRequest, opts ...grpc.CallOption) (*QueryOperationByHashResponse, error) {
-	out := new(QueryOperationByHashResponse)
-	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/OperationByHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeferredOperationsResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) OperationsByProposal(ctx context.Context, in *QueryOperationsByProposalRequest, opts ...grpc.CallOption) (*QueryOperationsByProposalResponse, error) {
-	out := new(QueryOperationsByProposalResponse)
-	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/OperationsByProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDeferredOperationsResponse) GetMaxPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPerBlock
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the module parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Operation returns a specific operation by ID
-	Operation(context.Context, *QueryOperationRequest) (*QueryOperationResponse, error)
-	// Operations returns all operations with optional status filter
-	Operations(context.Context, *QueryOperationsRequest) (*QueryOperationsResponse, error)
-	// QueuedOperations returns all operations in QUEUED status
-	QueuedOperations(context.Context, *QueryQueuedOperationsRequest) (*QueryQueuedOperationsResponse, error)
-	// ExecutableOperations returns operations ready for execution
-	ExecutableOperations(context.Context, *QueryExecutableOperationsRequest) (*QueryExecutableOperationsResponse, error)
-	// OperationByHash returns an operation by its hash
-	OperationByHash(context.Context, *QueryOperationByHashRequest) (*QueryOperationByHashResponse, error)
-	// OperationsByProposal returns all operations for a governance proposal
-	OperationsByProposal(context.Context, *QueryOperationsByProposalRequest) (*QueryOperationsByProposalResponse, error)
+func (m *QueryDeferredOperationsResponse) GetBlocksToDrain() uint64 {
+	if m != nil {
+		return m.BlocksToDrain
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryEmergencyEligibleOperationsRequest is the request for Query/EmergencyEligibleOperations
+type QueryEmergencyEligibleOperationsRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryEmergencyEligibleOperationsRequest) Reset() {
+	*m = QueryEmergencyEligibleOperationsRequest{}
 }
-func (*UnimplementedQueryServer) Operation(ctx context.Context, req *QueryOperationRequest) (*QueryOperationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Operation not implemented")
+func (m *QueryEmergencyEligibleOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyEligibleOperationsRequest) ProtoMessage()    {}
+func (*QueryEmergencyEligibleOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{18}
 }
-func (*UnimplementedQueryServer) Operations(ctx context.Context, req *QueryOperationsRequest) (*QueryOperationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Operations not implemented")
+func (m *QueryEmergencyEligibleOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueuedOperations(ctx context.Context, req *QueryQueuedOperationsRequest) (*QueryQueuedOperationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueuedOperations not implemented")
+func (m *QueryEmergencyEligibleOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencyEligibleOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ExecutableOperations(ctx context.Context, req *QueryExecutableOperationsRequest) (*QueryExecutableOperationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecutableOperations not implemented")
+func (m *QueryEmergencyEligibleOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyEligibleOperationsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OperationByHash(ctx context.Context, req *QueryOperationByHashRequest) (*QueryOperationByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperationByHash not implemented")
+func (m *QueryEmergencyEligibleOperationsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) OperationsByProposal(ctx context.Context, req *QueryOperationsByProposalRequest) (*QueryOperationsByProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperationsByProposal not implemented")
+func (m *QueryEmergencyEligibleOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyEligibleOperationsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryEmergencyEligibleOperationsRequest proto.InternalMessageInfo
+
+// EmergencyEligibleOperation is a queued operation that has passed the
+// emergency delay
+type EmergencyEligibleOperation struct {
+	Operation QueuedOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation"`
+	// seconds_until_executable is the time until normal execution (0 if already executable)
+	SecondsUntilExecutable uint64 `protobuf:"varint,2,opt,name=seconds_until_executable,json=secondsUntilExecutable,proto3" json:"seconds_until_executable,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.timelock.v1.Query/Params",
+func (m *EmergencyEligibleOperation) Reset()         { *m = EmergencyEligibleOperation{} }
+func (m *EmergencyEligibleOperation) String() string { return proto.CompactTextString(m) }
+func (*EmergencyEligibleOperation) ProtoMessage()    {}
+func (*EmergencyEligibleOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{19}
+}
+func (m *EmergencyEligibleOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyEligibleOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyEligibleOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *EmergencyEligibleOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyEligibleOperation.Merge(m, src)
+}
+func (m *EmergencyEligibleOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyEligibleOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyEligibleOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyEligibleOperation proto.InternalMessageInfo
+
+func (m *EmergencyEligibleOperation) GetOperation() QueuedOperation {
+	if m != nil {
+		return m.Operation
 	}
-	return interceptor(ctx, in, info, handler)
+	return QueuedOperation{}
 }
 
-func _Query_Operation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *EmergencyEligibleOperation) GetSecondsUntilExecutable() uint64 {
+	if m != nil {
+		return m.SecondsUntilExecutable
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Operation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.timelock.v1.Query/Operation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Operation(ctx, req.(*QueryOperationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Operations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Operations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.timelock.v1.Query/Operations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Operations(ctx, req.(*QueryOperationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEmergencyEligibleOperationsResponse is the response for Query/EmergencyEligibleOperations
+type QueryEmergencyEligibleOperationsResponse struct {
+	// operations are in ID order
+	Operations            []EmergencyEligibleOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
+	Count                 uint64                       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	EmergencyDelaySeconds uint64                       `protobuf:"varint,3,opt,name=emergency_delay_seconds,json=emergencyDelaySeconds,proto3" json:"emergency_delay_seconds,omitempty"`
 }
 
-func _Query_QueuedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryQueuedOperationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueuedOperations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.timelock.v1.Query/QueuedOperations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueuedOperations(ctx, req.(*QueryQueuedOperationsRequest))
+func (m *QueryEmergencyEligibleOperationsResponse) Reset() {
+	*m = QueryEmergencyEligibleOperationsResponse{}
+}
+func (m *QueryEmergencyEligibleOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyEligibleOperationsResponse) ProtoMessage()    {}
+func (*QueryEmergencyEligibleOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{20}
+}
+func (m *QueryEmergencyEligibleOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmergencyEligibleOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencyEligibleOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEmergencyEligibleOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyEligibleOperationsResponse.Merge(m, src)
+}
+func (m *QueryEmergencyEligibleOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmergencyEligibleOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyEligibleOperationsResponse.DiscardUnknown(m)
 }
 
-func _Query_ExecutableOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExecutableOperationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExecutableOperations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.timelock.v1.Query/ExecutableOperations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExecutableOperations(ctx, req.(*QueryExecutableOperationsRequest))
+var xxx_messageInfo_QueryEmergencyEligibleOperationsResponse proto.InternalMessageInfo
+
+func (m *QueryEmergencyEligibleOperationsResponse) GetOperations() []EmergencyEligibleOperation {
+	if m != nil {
+		return m.Operations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OperationByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperationByHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperationByHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.timelock.v1.Query/OperationByHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperationByHash(ctx, req.(*QueryOperationByHashRequest))
+func (m *QueryEmergencyEligibleOperationsResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_OperationsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperationsByProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OperationsByProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pos.timelock.v1.Query/OperationsByProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OperationsByProposal(ctx, req.(*QueryOperationsByProposalRequest))
+func (m *QueryEmergencyEligibleOperationsResponse) GetEmergencyDelaySeconds() uint64 {
+	if m != nil {
+		return m.EmergencyDelaySeconds
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pos.timelock.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Operation",
-			Handler:    _Query_Operation_Handler,
-		},
-		{
-			MethodName: "Operations",
-			Handler:    _Query_Operations_Handler,
-		},
-		{
-			MethodName: "QueuedOperations",
-			Handler:    _Query_QueuedOperations_Handler,
-		},
-		{
-			MethodName: "ExecutableOperations",
-			Handler:    _Query_ExecutableOperations_Handler,
-		},
-		{
-			MethodName: "OperationByHash",
-			Handler:    _Query_OperationByHash_Handler,
-		},
-		{
-			MethodName: "OperationsByProposal",
-			Handler:    _Query_OperationsByProposal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pos/timelock/v1/query.proto",
+// QueryOperationCountdownRequest is the request for Query/OperationCountdown
+type QueryOperationCountdownRequest struct {
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOperationCountdownRequest) Reset()         { *m = QueryOperationCountdownRequest{} }
+func (m *QueryOperationCountdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperationCountdownRequest) ProtoMessage()    {}
+func (*QueryOperationCountdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{21}
+}
+func (m *QueryOperationCountdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperationCountdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperationCountdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOperationCountdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperationCountdownRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryOperationCountdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperationCountdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperationCountdownRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOperationCountdownRequest proto.InternalMessageInfo
+
+func (m *QueryOperationCountdownRequest) GetOperationId() uint64 {
+	if m != nil {
+		return m.OperationId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOperationCountdownResponse is the response for Query/OperationCountdown.
+// Times are measured against the current block time; times already passed are zero.
+type QueryOperationCountdownResponse struct {
+	OperationId                   uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Status                        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SecondsUntilExecutable        uint64 `protobuf:"varint,3,opt,name=seconds_until_executable,json=secondsUntilExecutable,proto3" json:"seconds_until_executable,omitempty"`
+	SecondsUntilExpiry            uint64 `protobuf:"varint,4,opt,name=seconds_until_expiry,json=secondsUntilExpiry,proto3" json:"seconds_until_expiry,omitempty"`
+	SecondsUntilEmergencyEligible uint64 `protobuf:"varint,5,opt,name=seconds_until_emergency_eligible,json=secondsUntilEmergencyEligible,proto3" json:"seconds_until_emergency_eligible,omitempty"`
+	// executable is true while a queued operation is inside its execution window
+	Executable bool `protobuf:"varint,6,opt,name=executable,proto3" json:"executable,omitempty"`
+	// expired is true once the grace period has ended or the operation was marked expired
+	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	// emergency_eligible is true while guardians may emergency-execute the operation
+	EmergencyEligible bool `protobuf:"varint,8,opt,name=emergency_eligible,json=emergencyEligible,proto3" json:"emergency_eligible,omitempty"`
+}
+
+func (m *QueryOperationCountdownResponse) Reset()         { *m = QueryOperationCountdownResponse{} }
+func (m *QueryOperationCountdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperationCountdownResponse) ProtoMessage()    {}
+func (*QueryOperationCountdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{22}
+}
+func (m *QueryOperationCountdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryOperationCountdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperationCountdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryOperationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryOperationCountdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperationCountdownResponse.Merge(m, src)
+}
+func (m *QueryOperationCountdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperationCountdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperationCountdownResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOperationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryOperationCountdownResponse proto.InternalMessageInfo
+
+func (m *QueryOperationCountdownResponse) GetOperationId() uint64 {
+	if m != nil {
+		return m.OperationId
+	}
+	return 0
 }
 
-func (m *QueryOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OperationId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OperationId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryOperationCountdownResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryOperationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOperationCountdownResponse) GetSecondsUntilExecutable() uint64 {
+	if m != nil {
+		return m.SecondsUntilExecutable
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOperationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Operation != nil {
-		{
-			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOperationCountdownResponse) GetSecondsUntilExpiry() uint64 {
+	if m != nil {
+		return m.SecondsUntilExpiry
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryOperationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOperationCountdownResponse) GetSecondsUntilEmergencyEligible() uint64 {
+	if m != nil {
+		return m.SecondsUntilEmergencyEligible
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOperationCountdownResponse) GetExecutable() bool {
+	if m != nil {
+		return m.Executable
+	}
+	return false
 }
 
-func (m *QueryOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryOperationCountdownResponse) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryOperationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOperationCountdownResponse) GetEmergencyEligible() bool {
+	if m != nil {
+		return m.EmergencyEligible
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelayPreviewRequest is the request for Query/DelayPreview
+type QueryDelayPreviewRequest struct {
+	// msg_type_urls are the type URLs of the proposal's messages
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
 }
 
-func (m *QueryOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDelayPreviewRequest) Reset()         { *m = QueryDelayPreviewRequest{} }
+func (m *QueryDelayPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayPreviewRequest) ProtoMessage()    {}
+func (*QueryDelayPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{23}
+}
+func (m *QueryDelayPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelayPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayPreviewRequest.Merge(m, src)
+}
+func (m *QueryDelayPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayPreviewRequest.DiscardUnknown(m)
 }
 
-func (m *QueryQueuedOperationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelayPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryDelayPreviewRequest) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryQueuedOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelayPreviewResponse is the response for Query/DelayPreview
+type QueryDelayPreviewResponse struct {
+	// track is the execution track the messages classify into
+	Track string `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
+	// adaptive_delay_seconds is the track-adjusted delay before per-message-type minimums
+	AdaptiveDelaySeconds uint64 `protobuf:"varint,2,opt,name=adaptive_delay_seconds,json=adaptiveDelaySeconds,proto3" json:"adaptive_delay_seconds,omitempty"`
+	// message_type_delay_seconds is the longest message_type_delays entry matching the messages
+	MessageTypeDelaySeconds uint64 `protobuf:"varint,3,opt,name=message_type_delay_seconds,json=messageTypeDelaySeconds,proto3" json:"message_type_delay_seconds,omitempty"`
+	// delay_seconds is the effective delay, the larger of the two above
+	DelaySeconds uint64 `protobuf:"varint,4,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	// earliest_executable_at_unix is the current block time plus delay_seconds
+	EarliestExecutableAtUnix int64 `protobuf:"varint,5,opt,name=earliest_executable_at_unix,json=earliestExecutableAtUnix,proto3" json:"earliest_executable_at_unix,omitempty"`
+	// cumulative_escalate is true if queuing would cross the 24h treasury outflow threshold
+	CumulativeEscalate bool `protobuf:"varint,6,opt,name=cumulative_escalate,json=cumulativeEscalate,proto3" json:"cumulative_escalate,omitempty"`
+	// mutation_freq_exceeded is true if queuing would cross the param mutation frequency threshold
+	MutationFreqExceeded bool `protobuf:"varint,7,opt,name=mutation_freq_exceeded,json=mutationFreqExceeded,proto3" json:"mutation_freq_exceeded,omitempty"`
+}
+
+func (m *QueryDelayPreviewResponse) Reset()         { *m = QueryDelayPreviewResponse{} }
+func (m *QueryDelayPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayPreviewResponse) ProtoMessage()    {}
+func (*QueryDelayPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2252cf5c78c94c12, []int{24}
+}
+func (m *QueryDelayPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryQueuedOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelayPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryQueuedOperationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDelayPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayPreviewResponse.Merge(m, src)
 }
-
-func (m *QueryQueuedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelayPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayPreviewResponse.DiscardUnknown(m)
 }
 
-func (m *QueryQueuedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryDelayPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryDelayPreviewResponse) GetTrack() string {
+	if m != nil {
+		return m.Track
 	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *QueryDelayPreviewResponse) GetAdaptiveDelaySeconds() uint64 {
+	if m != nil {
+		return m.AdaptiveDelaySeconds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryExecutableOperationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelayPreviewResponse) GetMessageTypeDelaySeconds() uint64 {
+	if m != nil {
+		return m.MessageTypeDelaySeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryExecutableOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelayPreviewResponse) GetDelaySeconds() uint64 {
+	if m != nil {
+		return m.DelaySeconds
+	}
+	return 0
 }
 
-func (m *QueryExecutableOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDelayPreviewResponse) GetEarliestExecutableAtUnix() int64 {
+	if m != nil {
+		return m.EarliestExecutableAtUnix
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryExecutableOperationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelayPreviewResponse) GetCumulativeEscalate() bool {
+	if m != nil {
+		return m.CumulativeEscalate
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryExecutableOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelayPreviewResponse) GetMutationFreqExceeded() bool {
+	if m != nil {
+		return m.MutationFreqExceeded
+	}
+	return false
 }
 
-func (m *QueryExecutableOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pos.timelock.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pos.timelock.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryOperationRequest)(nil), "pos.timelock.v1.QueryOperationRequest")
+	proto.RegisterType((*QueryOperationResponse)(nil), "pos.timelock.v1.QueryOperationResponse")
+	proto.RegisterType((*QueryOperationsRequest)(nil), "pos.timelock.v1.QueryOperationsRequest")
+	proto.RegisterType((*QueryOperationsResponse)(nil), "pos.timelock.v1.QueryOperationsResponse")
+	proto.RegisterType((*QueryQueuedOperationsRequest)(nil), "pos.timelock.v1.QueryQueuedOperationsRequest")
+	proto.RegisterType((*QueryQueuedOperationsResponse)(nil), "pos.timelock.v1.QueryQueuedOperationsResponse")
+	proto.RegisterType((*QueryExecutableOperationsRequest)(nil), "pos.timelock.v1.QueryExecutableOperationsRequest")
+	proto.RegisterType((*QueryExecutableOperationsResponse)(nil), "pos.timelock.v1.QueryExecutableOperationsResponse")
+	proto.RegisterType((*QueryOperationByHashRequest)(nil), "pos.timelock.v1.QueryOperationByHashRequest")
+	proto.RegisterType((*QueryOperationByHashResponse)(nil), "pos.timelock.v1.QueryOperationByHashResponse")
+	proto.RegisterType((*QueryOperationsByProposalRequest)(nil), "pos.timelock.v1.QueryOperationsByProposalRequest")
+	proto.RegisterType((*QueryOperationsByProposalResponse)(nil), "pos.timelock.v1.QueryOperationsByProposalResponse")
+	proto.RegisterType((*QueryPendingProposalsRequest)(nil), "pos.timelock.v1.QueryPendingProposalsRequest")
+	proto.RegisterType((*QueryPendingProposalsResponse)(nil), "pos.timelock.v1.QueryPendingProposalsResponse")
+	proto.RegisterType((*QueryDeferredOperationsRequest)(nil), "pos.timelock.v1.QueryDeferredOperationsRequest")
+	proto.RegisterType((*QueryDeferredOperationsResponse)(nil), "pos.timelock.v1.QueryDeferredOperationsResponse")
+	proto.RegisterType((*QueryEmergencyEligibleOperationsRequest)(nil), "pos.timelock.v1.QueryEmergencyEligibleOperationsRequest")
+	proto.RegisterType((*EmergencyEligibleOperation)(nil), "pos.timelock.v1.EmergencyEligibleOperation")
+	proto.RegisterType((*QueryEmergencyEligibleOperationsResponse)(nil), "pos.timelock.v1.QueryEmergencyEligibleOperationsResponse")
+	proto.RegisterType((*QueryOperationCountdownRequest)(nil), "pos.timelock.v1.QueryOperationCountdownRequest")
+	proto.RegisterType((*QueryOperationCountdownResponse)(nil), "pos.timelock.v1.QueryOperationCountdownResponse")
+	proto.RegisterType((*QueryDelayPreviewRequest)(nil), "pos.timelock.v1.QueryDelayPreviewRequest")
+	proto.RegisterType((*QueryDelayPreviewResponse)(nil), "pos.timelock.v1.QueryDelayPreviewResponse")
 }
 
-func (m *QueryOperationByHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("pos/timelock/v1/query.proto", fileDescriptor_2252cf5c78c94c12) }
+
+var fileDescriptor_2252cf5c78c94c12 = []byte{
+	// 1430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x3b, 0x6c, 0x14, 0x57,
+	0x17, 0xf6, 0xf8, 0x05, 0x3e, 0x36, 0x8f, 0xff, 0xb0, 0xd8, 0xcb, 0x18, 0xaf, 0xd7, 0x63, 0x7e,
+	0x63, 0x20, 0xec, 0xb0, 0x06, 0x22, 0x02, 0x0a, 0x52, 0x8c, 0x0d, 0x41, 0x8a, 0x84, 0x59, 0x40,
+	0x8a, 0x52, 0x64, 0x74, 0xbd, 0x73, 0x59, 0x26, 0xec, 0xce, 0x8c, 0xe7, 0xce, 0x9a, 0x5d, 0x21,
+	0x9a, 0x28, 0x6d, 0x94, 0x28, 0xe9, 0x50, 0x52, 0x24, 0x65, 0xaa, 0x14, 0x34, 0xa9, 0xd2, 0x22,
+	0xa5, 0x41, 0x4a, 0x93, 0x2a, 0x8a, 0x20, 0x7d, 0xba, 0x14, 0xa9, 0xa2, 0xb9, 0x73, 0xe7, 0xb1,
+	0xf3, 0xd8, 0x5d, 0x10, 0x91, 0x68, 0xac, 0x9d, 0x7b, 0x5e, 0xdf, 0x3d, 0xdf, 0xb9, 0x77, 0xbe,
+	0x31, 0xcc, 0xdb, 0x16, 0x53, 0x5d, 0xa3, 0x45, 0x9b, 0x56, 0xfd, 0xbe, 0xba, 0x5b, 0x55, 0x77,
+	0xda, 0xd4, 0xe9, 0x56, 0x6c, 0xc7, 0x72, 0x2d, 0x3c, 0x60, 0x5b, 0xac, 0x12, 0x18, 0x2b, 0xbb,
+	0x55, 0xf9, 0x68, 0xc3, 0xb2, 0x1a, 0x4d, 0xaa, 0x12, 0xdb, 0x50, 0x89, 0x69, 0x5a, 0x2e, 0x71,
+	0x0d, 0xcb, 0x64, 0xbe, 0xbb, 0x7c, 0xb2, 0x6e, 0xb1, 0x96, 0xc5, 0xd4, 0x6d, 0xc2, 0xa8, 0x9f,
+	0x47, 0xdd, 0xad, 0x6e, 0x53, 0x97, 0x54, 0x55, 0x9b, 0x34, 0x0c, 0x93, 0x3b, 0x0b, 0xdf, 0x42,
+	0xc3, 0x6a, 0x58, 0xfc, 0xa7, 0xea, 0xfd, 0x12, 0xab, 0x29, 0x34, 0x6e, 0xd7, 0xa6, 0x22, 0xbd,
+	0x52, 0x00, 0xbc, 0xe9, 0x25, 0xdd, 0x22, 0x0e, 0x69, 0xb1, 0x1a, 0xdd, 0x69, 0x53, 0xe6, 0x2a,
+	0x1f, 0xc0, 0xa1, 0x9e, 0x55, 0x66, 0x5b, 0x26, 0xa3, 0x78, 0x1e, 0x26, 0x6d, 0xbe, 0x52, 0x94,
+	0xca, 0xd2, 0xea, 0xf4, 0xda, 0x5c, 0x25, 0xb1, 0x97, 0x8a, 0x1f, 0xb0, 0x3e, 0xfe, 0xf4, 0xf7,
+	0xc5, 0x91, 0x9a, 0x70, 0x56, 0x2e, 0xc2, 0x61, 0x9e, 0xed, 0x86, 0x4d, 0x1d, 0x0e, 0x57, 0x94,
+	0xc1, 0x25, 0x98, 0xb1, 0x82, 0x35, 0xcd, 0xd0, 0x79, 0xd6, 0xf1, 0xda, 0x74, 0xb8, 0x76, 0x5d,
+	0x57, 0x3e, 0x84, 0xd9, 0x64, 0xac, 0x00, 0x73, 0x19, 0xa6, 0x42, 0x47, 0x81, 0xa7, 0x9c, 0xc2,
+	0x73, 0xb3, 0x4d, 0xdb, 0x54, 0x8f, 0x82, 0xa3, 0x10, 0xe5, 0xb1, 0x94, 0x4c, 0x1d, 0x6c, 0x1f,
+	0x2f, 0xc0, 0x24, 0x73, 0x89, 0xdb, 0xf6, 0xf7, 0xb9, 0x3f, 0x23, 0x6f, 0x18, 0x73, 0x8b, 0xfb,
+	0xd5, 0x84, 0x3f, 0x5e, 0x05, 0x88, 0x58, 0x29, 0x8e, 0x72, 0x54, 0x2b, 0x15, 0x9f, 0xc2, 0x8a,
+	0x47, 0x61, 0xc5, 0x1f, 0x05, 0x41, 0x61, 0x65, 0x8b, 0x34, 0xa8, 0xa8, 0x5a, 0x8b, 0x45, 0x2a,
+	0x3f, 0x48, 0x30, 0x97, 0x02, 0x27, 0x36, 0x7e, 0x15, 0x20, 0xdc, 0x85, 0x87, 0x70, 0x6c, 0x98,
+	0x9d, 0x0b, 0x4a, 0x62, 0x91, 0x78, 0x2d, 0x03, 0xeb, 0xf1, 0x81, 0x58, 0x7d, 0x10, 0x3d, 0x60,
+	0xef, 0xc2, 0x51, 0x8e, 0x35, 0x51, 0x32, 0x6c, 0x67, 0x6f, 0x53, 0xa4, 0x57, 0x6e, 0xca, 0x8f,
+	0x12, 0x2c, 0xe4, 0x14, 0x7a, 0x53, 0x5b, 0xf3, 0x09, 0x94, 0x39, 0xe2, 0xcd, 0x0e, 0xad, 0xb7,
+	0x5d, 0xb2, 0xdd, 0xa4, 0xff, 0x5d, 0x7b, 0x9e, 0x48, 0xb0, 0xd4, 0xa7, 0xd8, 0x9b, 0xda, 0xa2,
+	0x2a, 0xcc, 0xf7, 0x4e, 0xfa, 0x7a, 0xf7, 0x7d, 0xc2, 0xee, 0x05, 0xdd, 0x41, 0x18, 0xbf, 0x47,
+	0xd8, 0x3d, 0xde, 0x97, 0xa9, 0x1a, 0xff, 0xad, 0x7c, 0x0c, 0x47, 0xb3, 0x43, 0x5e, 0xd3, 0xd5,
+	0x70, 0x45, 0xb0, 0x16, 0x1a, 0xd9, 0x7a, 0x77, 0xcb, 0xb1, 0x6c, 0x8b, 0x91, 0x66, 0x80, 0x6b,
+	0x11, 0xa6, 0x6d, 0xb1, 0x14, 0x5d, 0x5d, 0x10, 0x2c, 0x5d, 0xd7, 0x95, 0xfb, 0xb0, 0xd4, 0x27,
+	0xc9, 0xeb, 0x65, 0x43, 0x29, 0x89, 0x8e, 0x6c, 0x51, 0x53, 0x37, 0xcc, 0x46, 0x50, 0x27, 0xbc,
+	0xd0, 0xd7, 0x61, 0x21, 0xc7, 0x2e, 0x80, 0x2c, 0xc1, 0x4c, 0x6c, 0x3b, 0x3e, 0x94, 0xf1, 0xda,
+	0x74, 0xb4, 0x1f, 0xa6, 0x94, 0xa1, 0xc4, 0x73, 0x6c, 0xd0, 0xbb, 0xd4, 0x71, 0x32, 0x0e, 0xba,
+	0xf2, 0x8b, 0x04, 0x8b, 0xb9, 0x2e, 0xaf, 0x79, 0xfe, 0x0a, 0x30, 0x51, 0xb7, 0xda, 0xa6, 0xcb,
+	0x47, 0x6f, 0xbc, 0xe6, 0x3f, 0xa0, 0x02, 0xfb, 0x5a, 0xa4, 0xa3, 0xd9, 0xd4, 0xd1, 0xb6, 0xbd,
+	0x5c, 0xc5, 0x31, 0xff, 0x95, 0xd2, 0x22, 0x9d, 0x2d, 0xea, 0xac, 0x7b, 0x4b, 0xb8, 0x02, 0x07,
+	0xb8, 0x8d, 0x69, 0xae, 0xa5, 0xe9, 0x0e, 0x31, 0xcc, 0xe2, 0x38, 0xf7, 0xda, 0xe7, 0x2f, 0xdf,
+	0xb6, 0x36, 0xbc, 0x45, 0xe5, 0x04, 0x1c, 0xf7, 0x8f, 0x53, 0x8b, 0x3a, 0x0d, 0x6a, 0xd6, 0xbb,
+	0x9b, 0x4d, 0xa3, 0x61, 0x64, 0x1d, 0x61, 0xe5, 0x1b, 0x09, 0xe4, 0x7c, 0x37, 0xdc, 0x78, 0x85,
+	0x79, 0x14, 0x5b, 0x8e, 0x02, 0xf1, 0x02, 0x14, 0x19, 0xad, 0x5b, 0xa6, 0xce, 0xb4, 0xb6, 0xe9,
+	0x1a, 0x4d, 0x8d, 0x86, 0xe7, 0x5c, 0x34, 0x61, 0x56, 0xd8, 0xef, 0x78, 0xe6, 0xe8, 0x16, 0xf0,
+	0x78, 0x59, 0x1d, 0xbc, 0x15, 0x41, 0xd0, 0xcd, 0x0c, 0x82, 0x4e, 0xa5, 0xd0, 0xe6, 0x67, 0x1a,
+	0x9a, 0xab, 0xb7, 0x61, 0x8e, 0x06, 0x59, 0x34, 0x9d, 0x36, 0x49, 0x57, 0x13, 0xf8, 0x05, 0x6b,
+	0x87, 0x43, 0xf3, 0x86, 0x67, 0xbd, 0xe5, 0x1b, 0x95, 0x2b, 0x62, 0x0e, 0xc3, 0x8a, 0x57, 0xbc,
+	0x74, 0xba, 0xf5, 0xe0, 0x65, 0x74, 0xc5, 0x3f, 0xa3, 0xb0, 0x98, 0x9b, 0x25, 0x3a, 0x13, 0x03,
+	0xd2, 0xe0, 0x6c, 0xa8, 0x14, 0x46, 0xf9, 0xfd, 0x24, 0x9e, 0xfa, 0x72, 0x35, 0xd6, 0x8f, 0x2b,
+	0x3c, 0x03, 0x85, 0x64, 0xa4, 0x6d, 0x38, 0x5d, 0x31, 0xa2, 0xd8, 0x1b, 0xe5, 0x59, 0xf0, 0x1a,
+	0x94, 0x13, 0x11, 0x61, 0x57, 0xa9, 0x20, 0xa7, 0x38, 0xc1, 0xa3, 0x17, 0x7a, 0xa2, 0x93, 0x0c,
+	0x62, 0x09, 0x20, 0x06, 0x73, 0xb2, 0x2c, 0xad, 0xee, 0xad, 0xc5, 0x56, 0xb0, 0x08, 0x7b, 0x38,
+	0x18, 0xaa, 0x17, 0xf7, 0x70, 0x63, 0xf0, 0x88, 0xa7, 0x01, 0x33, 0x8a, 0xee, 0xe5, 0x4e, 0xff,
+	0xa3, 0xc9, 0x42, 0xca, 0x65, 0x28, 0x8a, 0x6b, 0xa2, 0x49, 0xba, 0x5b, 0x0e, 0xdd, 0x35, 0xe8,
+	0x83, 0x80, 0x3b, 0xef, 0x04, 0xb3, 0x86, 0xe6, 0x69, 0x54, 0xad, 0xed, 0x34, 0xfd, 0x09, 0x9c,
+	0xaa, 0x4d, 0xb7, 0x58, 0xe3, 0x76, 0xd7, 0xa6, 0x77, 0x9c, 0x26, 0x53, 0xfe, 0x1a, 0x85, 0x23,
+	0x19, 0x09, 0x04, 0x6d, 0x05, 0x98, 0x70, 0x1d, 0x52, 0xbf, 0x2f, 0x5e, 0x19, 0xfe, 0x03, 0x9e,
+	0x83, 0x59, 0xa2, 0x13, 0xdb, 0x35, 0x76, 0x69, 0x62, 0xd8, 0xfc, 0xa1, 0x2c, 0x04, 0xd6, 0xf8,
+	0xac, 0xe1, 0x25, 0x90, 0x5b, 0x94, 0x31, 0xd2, 0xa0, 0x3e, 0xa2, 0xac, 0x31, 0x9d, 0x13, 0x1e,
+	0x1e, 0xbc, 0x9e, 0xe0, 0x65, 0xd8, 0xd7, 0xeb, 0xef, 0x73, 0x38, 0xa3, 0xc7, 0x9d, 0xde, 0x85,
+	0x79, 0x4a, 0x9c, 0xa6, 0x41, 0x99, 0x1b, 0x1b, 0x12, 0x8d, 0xb8, 0x5a, 0xdb, 0x34, 0x3a, 0x9c,
+	0xb8, 0xb1, 0x5a, 0x31, 0x70, 0x89, 0x06, 0xe5, 0x3d, 0xf7, 0x8e, 0x69, 0x74, 0x50, 0x85, 0x43,
+	0xf5, 0x76, 0xab, 0xdd, 0x24, 0x7c, 0x63, 0x94, 0xd5, 0x49, 0x93, 0xb8, 0x01, 0x79, 0x18, 0x99,
+	0x36, 0x85, 0xc5, 0xeb, 0x43, 0xab, 0xed, 0x7f, 0x62, 0x68, 0x77, 0x1d, 0xba, 0xa3, 0xd1, 0x4e,
+	0x9d, 0x52, 0x3d, 0xe4, 0xb4, 0x10, 0x58, 0xaf, 0x3a, 0x74, 0x67, 0x53, 0xd8, 0xd6, 0xfe, 0xde,
+	0x0f, 0x13, 0xbc, 0xe3, 0xe8, 0xc2, 0xa4, 0x2f, 0xf2, 0x71, 0x39, 0xeb, 0x0a, 0x4b, 0x7c, 0x49,
+	0xc8, 0xc7, 0xfa, 0x3b, 0xf9, 0x94, 0x29, 0x8b, 0x9f, 0xfe, 0xfa, 0xe7, 0xd7, 0xa3, 0x47, 0x70,
+	0x4e, 0x4d, 0x7e, 0xab, 0xf8, 0x9f, 0x10, 0xf8, 0x85, 0x04, 0x53, 0xd1, 0x7d, 0xba, 0x92, 0x9d,
+	0x34, 0xf9, 0x7d, 0x21, 0x1f, 0x1f, 0xe8, 0x27, 0xea, 0x57, 0x79, 0xfd, 0x53, 0x78, 0x22, 0x55,
+	0x3f, 0x3c, 0xec, 0xea, 0xc3, 0xf8, 0x5d, 0xf0, 0x08, 0x3f, 0x93, 0x00, 0x6e, 0x44, 0x57, 0xdc,
+	0xa0, 0x52, 0x61, 0x43, 0x56, 0x07, 0x3b, 0x0a, 0x50, 0xcb, 0x1c, 0xd4, 0x02, 0xce, 0xe7, 0x83,
+	0x62, 0xf8, 0x95, 0x04, 0x07, 0x93, 0x72, 0x18, 0x4f, 0x67, 0xd7, 0xc8, 0xd1, 0xe7, 0x72, 0x65,
+	0x58, 0xf7, 0x81, 0x6c, 0xed, 0xf0, 0x10, 0xfc, 0x5e, 0x82, 0x42, 0x96, 0x08, 0xc5, 0x6a, 0x76,
+	0xa5, 0x3e, 0xea, 0x58, 0x5e, 0x7b, 0x99, 0x90, 0x81, 0x9d, 0x8b, 0xdd, 0x66, 0xdf, 0x49, 0x70,
+	0x20, 0x21, 0x20, 0xf1, 0xad, 0x01, 0xe4, 0xf4, 0x48, 0x53, 0xf9, 0xf4, 0x90, 0xde, 0xc3, 0x0f,
+	0x99, 0xb6, 0xdd, 0xd5, 0x3c, 0x85, 0xab, 0x3e, 0xf4, 0xfe, 0x3e, 0xc2, 0x9f, 0x24, 0x28, 0x64,
+	0xe9, 0xc7, 0xbc, 0x46, 0xf6, 0x11, 0xac, 0xf2, 0xda, 0xcb, 0x84, 0x08, 0xc8, 0x17, 0x39, 0xe4,
+	0x73, 0xb8, 0x96, 0x3e, 0x97, 0xc2, 0x55, 0x7d, 0x18, 0x93, 0x8d, 0x8f, 0xe2, 0x93, 0xf9, 0xad,
+	0x04, 0x07, 0x93, 0x72, 0x33, 0x6f, 0x32, 0x73, 0x64, 0xab, 0x5c, 0x19, 0xd6, 0x5d, 0xe0, 0x3d,
+	0xc9, 0xf1, 0x1e, 0x43, 0x25, 0x8d, 0xd7, 0x0f, 0xd1, 0xec, 0x10, 0xca, 0x63, 0x09, 0x30, 0xad,
+	0x53, 0x51, 0xcd, 0x2e, 0x99, 0x2b, 0x7a, 0xe5, 0x33, 0xc3, 0x07, 0x08, 0x94, 0x4b, 0x1c, 0xe5,
+	0x3c, 0x1e, 0x49, 0xa1, 0xd4, 0x45, 0x10, 0xfe, 0x2c, 0xc1, 0x7c, 0x1f, 0xb1, 0x86, 0x17, 0x72,
+	0x4e, 0xc5, 0x40, 0xa9, 0x2a, 0xbf, 0xf3, 0x0a, 0x91, 0x02, 0xf7, 0x29, 0x8e, 0xfb, 0xff, 0xb8,
+	0x9c, 0x3e, 0x56, 0xa9, 0x97, 0x3f, 0x3e, 0x91, 0x00, 0xd3, 0xda, 0x2a, 0xaf, 0xbd, 0xb9, 0x5a,
+	0x4e, 0x3e, 0x33, 0x7c, 0x80, 0x80, 0x79, 0x89, 0xc3, 0x3c, 0x8f, 0x67, 0x87, 0xbe, 0xcc, 0xd5,
+	0x7a, 0x88, 0xef, 0x73, 0x09, 0x66, 0xe2, 0xaa, 0x02, 0x4f, 0xe4, 0xd1, 0x9b, 0x92, 0x2e, 0xf2,
+	0xc9, 0x61, 0x5c, 0x05, 0xc8, 0x15, 0x0e, 0xb2, 0x8c, 0xa5, 0x8c, 0x19, 0xf0, 0x24, 0x83, 0xed,
+	0xfb, 0xaf, 0x57, 0x9e, 0x3e, 0x2f, 0x49, 0xcf, 0x9e, 0x97, 0xa4, 0x3f, 0x9e, 0x97, 0xa4, 0x2f,
+	0x5f, 0x94, 0x46, 0x9e, 0xbd, 0x28, 0x8d, 0xfc, 0xf6, 0xa2, 0x34, 0xf2, 0x51, 0xc1, 0x0b, 0xec,
+	0x44, 0xa1, 0xfc, 0xbf, 0x7a, 0xdb, 0x93, 0xfc, 0xdf, 0x7a, 0x67, 0xff, 0x1d, 0x00, 0x07, 0xcb,
+	0x4d, 0x62, 0x83, 0x14, 0x00, 0x00,
 }
 
-func (m *QueryOperationByHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Operation returns a specific operation by ID
+	Operation(ctx context.Context, in *QueryOperationRequest, opts ...grpc.CallOption) (*QueryOperationResponse, error)
+	// Operations returns all operations with optional status filter
+	Operations(ctx context.Context, in *QueryOperationsRequest, opts ...grpc.CallOption) (*QueryOperationsResponse, error)
+	// QueuedOperations returns all operations in QUEUED status
+	QueuedOperations(ctx context.Context, in *QueryQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryQueuedOperationsResponse, error)
+	// ExecutableOperations returns operations ready for execution
+	ExecutableOperations(ctx context.Context, in *QueryExecutableOperationsRequest, opts ...grpc.CallOption) (*QueryExecutableOperationsResponse, error)
+	// OperationByHash returns an operation by its hash
+	OperationByHash(ctx context.Context, in *QueryOperationByHashRequest, opts ...grpc.CallOption) (*QueryOperationByHashResponse, error)
+	// OperationsByProposal returns all operations for a governance proposal
+	OperationsByProposal(ctx context.Context, in *QueryOperationsByProposalRequest, opts ...grpc.CallOption) (*QueryOperationsByProposalResponse, error)
+	// PendingProposals returns the IDs of passed proposals waiting to be queued
+	PendingProposals(ctx context.Context, in *QueryPendingProposalsRequest, opts ...grpc.CallOption) (*QueryPendingProposalsResponse, error)
+	// DeferredOperations returns ready operations still waiting to run because
+	// of the per-block execution cap, oldest first
+	DeferredOperations(ctx context.Context, in *QueryDeferredOperationsRequest, opts ...grpc.CallOption) (*QueryDeferredOperationsResponse, error)
+	// EmergencyEligibleOperations returns queued operations guardians can
+	// emergency-execute now
+	EmergencyEligibleOperations(ctx context.Context, in *QueryEmergencyEligibleOperationsRequest, opts ...grpc.CallOption) (*QueryEmergencyEligibleOperationsResponse, error)
+	// OperationCountdown returns the seconds until an operation becomes
+	// executable, expires and becomes emergency-eligible
+	OperationCountdown(ctx context.Context, in *QueryOperationCountdownRequest, opts ...grpc.CallOption) (*QueryOperationCountdownResponse, error)
+	// DelayPreview returns the timelock delay a proposal with the given message
+	// types would receive if it passed and were queued at the current block
+	DelayPreview(ctx context.Context, in *QueryDelayPreviewRequest, opts ...grpc.CallOption) (*QueryDelayPreviewResponse, error)
 }
 
-func (m *QueryOperationByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryOperationByHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperationByHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Operation(ctx context.Context, in *QueryOperationRequest, opts ...grpc.CallOption) (*QueryOperationResponse, error) {
+	out := new(QueryOperationResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/Operation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperationByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Operation != nil {
-		{
-			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Operations(ctx context.Context, in *QueryOperationsRequest, opts ...grpc.CallOption) (*QueryOperationsResponse, error) {
+	out := new(QueryOperationsResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/Operations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOperationsByProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueuedOperations(ctx context.Context, in *QueryQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryQueuedOperationsResponse, error) {
+	out := new(QueryQueuedOperationsResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/QueuedOperations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperationsByProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ExecutableOperations(ctx context.Context, in *QueryExecutableOperationsRequest, opts ...grpc.CallOption) (*QueryExecutableOperationsResponse, error) {
+	out := new(QueryExecutableOperationsResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/ExecutableOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperationsByProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) OperationByHash(ctx context.Context, in *QueryOperationByHashRequest, opts ...grpc.CallOption) (*QueryOperationByHashResponse, error) {
+	out := new(QueryOperationByHashResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/OperationByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOperationsByProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OperationsByProposal(ctx context.Context, in *QueryOperationsByProposalRequest, opts ...grpc.CallOption) (*QueryOperationsByProposalResponse, error) {
+	out := new(QueryOperationsByProposalResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/OperationsByProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperationsByProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingProposals(ctx context.Context, in *QueryPendingProposalsRequest, opts ...grpc.CallOption) (*QueryPendingProposalsResponse, error) {
+	out := new(QueryPendingProposalsResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/PendingProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperationsByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) DeferredOperations(ctx context.Context, in *QueryDeferredOperationsRequest, opts ...grpc.CallOption) (*QueryDeferredOperationsResponse, error) {
+	out := new(QueryDeferredOperationsResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/DeferredOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) EmergencyEligibleOperations(ctx context.Context, in *QueryEmergencyEligibleOperationsRequest, opts ...grpc.CallOption) (*QueryEmergencyEligibleOperationsResponse, error) {
+	out := new(QueryEmergencyEligibleOperationsResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/EmergencyEligibleOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) OperationCountdown(ctx context.Context, in *QueryOperationCountdownRequest, opts ...grpc.CallOption) (*QueryOperationCountdownResponse, error) {
+	out := new(QueryOperationCountdownResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/OperationCountdown", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DelayPreview(ctx context.Context, in *QueryDelayPreviewRequest, opts ...grpc.CallOption) (*QueryDelayPreviewResponse, error) {
+	out := new(QueryDelayPreviewResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Query/DelayPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryOperationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OperationId != 0 {
-		n += 1 + sovQuery(uint64(m.OperationId))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Operation returns a specific operation by ID
+	Operation(context.Context, *QueryOperationRequest) (*QueryOperationResponse, error)
+	// Operations returns all operations with optional status filter
+	Operations(context.Context, *QueryOperationsRequest) (*QueryOperationsResponse, error)
+	// QueuedOperations returns all operations in QUEUED status
+	QueuedOperations(context.Context, *QueryQueuedOperationsRequest) (*QueryQueuedOperationsResponse, error)
+	// ExecutableOperations returns operations ready for execution
+	ExecutableOperations(context.Context, *QueryExecutableOperationsRequest) (*QueryExecutableOperationsResponse, error)
+	// OperationByHash returns an operation by its hash
+	OperationByHash(context.Context, *QueryOperationByHashRequest) (*QueryOperationByHashResponse, error)
+	// OperationsByProposal returns all operations for a governance proposal
+	OperationsByProposal(context.Context, *QueryOperationsByProposalRequest) (*QueryOperationsByProposalResponse, error)
+	// PendingProposals returns the IDs of passed proposals waiting to be queued
+	PendingProposals(context.Context, *QueryPendingProposalsRequest) (*QueryPendingProposalsResponse, error)
+	// DeferredOperations returns ready operations still waiting to run because
+	// of the per-block execution cap, oldest first
+	DeferredOperations(context.Context, *QueryDeferredOperationsRequest) (*QueryDeferredOperationsResponse, error)
+	// EmergencyEligibleOperations returns queued operations guardians can
+	// emergency-execute now
+	EmergencyEligibleOperations(context.Context, *QueryEmergencyEligibleOperationsRequest) (*QueryEmergencyEligibleOperationsResponse, error)
+	// OperationCountdown returns the seconds until an operation becomes
+	// executable, expires and becomes emergency-eligible
+	OperationCountdown(context.Context, *QueryOperationCountdownRequest) (*QueryOperationCountdownResponse, error)
+	// DelayPreview returns the timelock delay a proposal with the given message
+	// types would receive if it passed and were queued at the current block
+	DelayPreview(context.Context, *QueryDelayPreviewRequest) (*QueryDelayPreviewResponse, error)
 }
 
-func (m *QueryOperationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Operation != nil {
-		l = m.Operation.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryOperationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Operation(ctx context.Context, req *QueryOperationRequest) (*QueryOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Operation not implemented")
+}
+func (*UnimplementedQueryServer) Operations(ctx context.Context, req *QueryOperationsRequest) (*QueryOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Operations not implemented")
+}
+func (*UnimplementedQueryServer) QueuedOperations(ctx context.Context, req *QueryQueuedOperationsRequest) (*QueryQueuedOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedOperations not implemented")
+}
+func (*UnimplementedQueryServer) ExecutableOperations(ctx context.Context, req *QueryExecutableOperationsRequest) (*QueryExecutableOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutableOperations not implemented")
+}
+func (*UnimplementedQueryServer) OperationByHash(ctx context.Context, req *QueryOperationByHashRequest) (*QueryOperationByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationByHash not implemented")
+}
+func (*UnimplementedQueryServer) OperationsByProposal(ctx context.Context, req *QueryOperationsByProposalRequest) (*QueryOperationsByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationsByProposal not implemented")
+}
+func (*UnimplementedQueryServer) PendingProposals(ctx context.Context, req *QueryPendingProposalsRequest) (*QueryPendingProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingProposals not implemented")
+}
+func (*UnimplementedQueryServer) DeferredOperations(ctx context.Context, req *QueryDeferredOperationsRequest) (*QueryDeferredOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredOperations not implemented")
+}
+func (*UnimplementedQueryServer) EmergencyEligibleOperations(ctx context.Context, req *QueryEmergencyEligibleOperationsRequest) (*QueryEmergencyEligibleOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyEligibleOperations not implemented")
+}
+func (*UnimplementedQueryServer) OperationCountdown(ctx context.Context, req *QueryOperationCountdownRequest) (*QueryOperationCountdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationCountdown not implemented")
+}
+func (*UnimplementedQueryServer) DelayPreview(ctx context.Context, req *QueryDelayPreviewRequest) (*QueryDelayPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayPreview not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Operation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Operation(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/Operation",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Operation(ctx, req.(*QueryOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryQueuedOperationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Operations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Operations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/Operations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Operations(ctx, req.(*QueryOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryQueuedOperationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueuedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedOperations(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/QueuedOperations",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedOperations(ctx, req.(*QueryQueuedOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryExecutableOperationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExecutableOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutableOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutableOperations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/ExecutableOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutableOperations(ctx, req.(*QueryExecutableOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryExecutableOperationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OperationByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperationByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).OperationByHash(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/OperationByHash",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperationByHash(ctx, req.(*QueryOperationByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperationByHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OperationsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperationsByProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OperationsByProposal(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryOperationByHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/OperationsByProposal",
 	}
-	var l int
-	_ = l
-	if m.Operation != nil {
-		l = m.Operation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperationsByProposal(ctx, req.(*QueryOperationsByProposalRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperationsByProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingProposals(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/PendingProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingProposals(ctx, req.(*QueryPendingProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperationsByProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DeferredOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeferredOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeferredOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/DeferredOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeferredOperations(ctx, req.(*QueryDeferredOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmergencyEligibleOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmergencyEligibleOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmergencyEligibleOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/EmergencyEligibleOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmergencyEligibleOperations(ctx, req.(*QueryEmergencyEligibleOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperationCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperationCountdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperationCountdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/OperationCountdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperationCountdown(ctx, req.(*QueryOperationCountdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Query/DelayPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayPreview(ctx, req.(*QueryDelayPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pos.timelock.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Operation",
+			Handler:    _Query_Operation_Handler,
+		},
+		{
+			MethodName: "Operations",
+			Handler:    _Query_Operations_Handler,
+		},
+		{
+			MethodName: "QueuedOperations",
+			Handler:    _Query_QueuedOperations_Handler,
+		},
+		{
+			MethodName: "ExecutableOperations",
+			Handler:    _Query_ExecutableOperations_Handler,
+		},
+		{
+			MethodName: "OperationByHash",
+			Handler:    _Query_OperationByHash_Handler,
+		},
+		{
+			MethodName: "OperationsByProposal",
+			Handler:    _Query_OperationsByProposal_Handler,
+		},
+		{
+			MethodName: "PendingProposals",
+			Handler:    _Query_PendingProposals_Handler,
+		},
+		{
+			MethodName: "DeferredOperations",
+			Handler:    _Query_DeferredOperations_Handler,
+		},
+		{
+			MethodName: "EmergencyEligibleOperations",
+			Handler:    _Query_EmergencyEligibleOperations_Handler,
+		},
+		{
+			MethodName: "OperationCountdown",
+			Handler:    _Query_OperationCountdown_Handler,
+		},
+		{
+			MethodName: "DelayPreview",
+			Handler:    _Query_DelayPreview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pos/timelock/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutableOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutableOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutableOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutableOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutableOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutableOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationsByProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationsByProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationsByProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationsByProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationsByProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationsByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalIds) > 0 {
+		dAtA11 := make([]byte, len(m.ProposalIds)*10)
+		var j10 int
+		for _, num := range m.ProposalIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksToDrain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksToDrain))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyEligibleOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyEligibleOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyEligibleOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EmergencyEligibleOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencyEligibleOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyEligibleOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondsUntilExecutable != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsUntilExecutable))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyEligibleOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyEligibleOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyEligibleOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmergencyDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EmergencyDelaySeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationCountdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationCountdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationCountdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperationCountdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperationCountdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperationCountdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmergencyEligible {
+		i--
+		if m.EmergencyEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Executable {
+		i--
+		if m.Executable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SecondsUntilEmergencyEligible != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsUntilEmergencyEligible))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SecondsUntilExpiry != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsUntilExpiry))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SecondsUntilExecutable != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsUntilExecutable))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OperationId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MutationFreqExceeded {
+		i--
+		if m.MutationFreqExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CumulativeEscalate {
+		i--
+		if m.CumulativeEscalate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EarliestExecutableAtUnix != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EarliestExecutableAtUnix))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelaySeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MessageTypeDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MessageTypeDelaySeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AdaptiveDelaySeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AdaptiveDelaySeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Track) > 0 {
+		i -= len(m.Track)
+		copy(dAtA[i:], m.Track)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Track)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovQuery(uint64(m.OperationId))
+	}
+	return n
+}
+
+func (m *QueryOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutableOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutableOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperationByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperationByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperationsByProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryOperationsByProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposalIds) > 0 {
+		l = 0
+		for _, e := range m.ProposalIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryDeferredOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeferredOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.MaxPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPerBlock))
+	}
+	if m.BlocksToDrain != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksToDrain))
+	}
+	return n
+}
+
+func (m *QueryEmergencyEligibleOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EmergencyEligibleOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Operation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SecondsUntilExecutable != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsUntilExecutable))
+	}
+	return n
+}
+
+func (m *QueryEmergencyEligibleOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.EmergencyDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.EmergencyDelaySeconds))
+	}
+	return n
+}
+
+func (m *QueryOperationCountdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovQuery(uint64(m.OperationId))
+	}
+	return n
+}
+
+func (m *QueryOperationCountdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovQuery(uint64(m.OperationId))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SecondsUntilExecutable != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsUntilExecutable))
+	}
+	if m.SecondsUntilExpiry != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsUntilExpiry))
+	}
+	if m.SecondsUntilEmergencyEligible != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsUntilEmergencyEligible))
+	}
+	if m.Executable {
+		n += 2
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.EmergencyEligible {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDelayPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelayPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Track)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AdaptiveDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.AdaptiveDelaySeconds))
+	}
+	if m.MessageTypeDelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.MessageTypeDelaySeconds))
+	}
+	if m.DelaySeconds != 0 {
+		n += 1 + sovQuery(uint64(m.DelaySeconds))
+	}
+	if m.EarliestExecutableAtUnix != 0 {
+		n += 1 + sovQuery(uint64(m.EarliestExecutableAtUnix))
+	}
+	if m.CumulativeEscalate {
+		n += 2
+	}
+	if m.MutationFreqExceeded {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			m.OperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &QueuedOperation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OperationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, QueuedOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, QueuedOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutableOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutableOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutableOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutableOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutableOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutableOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, QueuedOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperationByHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1811,12 +4284,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperationByHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperationByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1838,7 +4343,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperationByHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1861,15 +4366,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperationByHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperationByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1896,7 +4401,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Operation == nil {
+				m.Operation = &QueuedOperation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1921,7 +4429,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperationsByProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1944,17 +4452,17 @@ func (m *QueryOperationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperationsByProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperationsByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.OperationId = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1964,7 +4472,7 @@ func (m *QueryOperationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperationId |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1990,7 +4498,7 @@ func (m *QueryOperationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperationsByProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2013,15 +4521,15 @@ func (m *QueryOperationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperationsByProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperationsByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2048,10 +4556,8 @@ func (m *QueryOperationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Operation == nil {
-				m.Operation = &QueuedOperation{}
-			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operations = append(m.Operations, QueuedOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2076,7 +4582,7 @@ func (m *QueryOperationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,67 +4605,188 @@ func (m *QueryOperationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.ProposalIds = append(m.ProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= OperationStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
 				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposalIds) == 0 {
+					m.ProposalIds = make([]uint64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposalIds = append(m.ProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeferredOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2181,7 +4808,7 @@ func (m *QueryOperationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeferredOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2204,10 +4831,10 @@ func (m *QueryOperationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeferredOperationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeferredOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2243,12 +4870,50 @@ func (m *QueryOperationsResponse) Unmarshal(dAtA []byte) error {
 			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerBlock", wireType)
+			}
+			m.MaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksToDrain", wireType)
+			}
+			m.BlocksToDrain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2258,28 +4923,61 @@ func (m *QueryOperationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlocksToDrain |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmergencyEligibleOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmergencyEligibleOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmergencyEligibleOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2301,7 +4999,7 @@ func (m *QueryOperationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQueuedOperationsRequest) Unmarshal(dAtA []byte) error {
+func (m *EmergencyEligibleOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2324,15 +5022,15 @@ func (m *QueryQueuedOperationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQueuedOperationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmergencyEligibleOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQueuedOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmergencyEligibleOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2359,13 +5057,29 @@ func (m *QueryQueuedOperationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsUntilExecutable", wireType)
+			}
+			m.SecondsUntilExecutable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsUntilExecutable |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2387,7 +5101,7 @@ func (m *QueryQueuedOperationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryQueuedOperationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEmergencyEligibleOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2410,10 +5124,10 @@ func (m *QueryQueuedOperationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryQueuedOperationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmergencyEligibleOperationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryQueuedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmergencyEligibleOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2445,16 +5159,16 @@ func (m *QueryQueuedOperationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operations = append(m.Operations, QueuedOperation{})
+			m.Operations = append(m.Operations, EmergencyEligibleOperation{})
 			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2464,28 +5178,30 @@ func (m *QueryQueuedOperationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyDelaySeconds", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EmergencyDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmergencyDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2507,7 +5223,7 @@ func (m *QueryQueuedOperationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExecutableOperationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOperationCountdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2530,17 +5246,17 @@ func (m *QueryExecutableOperationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExecutableOperationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperationCountdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExecutableOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperationCountdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
 			}
-			var msglen int
+			m.OperationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2550,28 +5266,11 @@ func (m *QueryExecutableOperationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OperationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2593,7 +5292,7 @@ func (m *QueryExecutableOperationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExecutableOperationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperationCountdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2616,17 +5315,125 @@ func (m *QueryExecutableOperationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExecutableOperationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperationCountdownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExecutableOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperationCountdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			m.OperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsUntilExecutable", wireType)
+			}
+			m.SecondsUntilExecutable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsUntilExecutable |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsUntilExpiry", wireType)
+			}
+			m.SecondsUntilExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsUntilExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsUntilEmergencyEligible", wireType)
+			}
+			m.SecondsUntilEmergencyEligible = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsUntilEmergencyEligible |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executable", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2636,31 +5443,17 @@ func (m *QueryExecutableOperationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Operations = append(m.Operations, QueuedOperation{})
-			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			m.Executable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2670,28 +5463,32 @@ func (m *QueryExecutableOperationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Expired = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyEligible", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.EmergencyEligible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2713,7 +5510,7 @@ func (m *QueryExecutableOperationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationByHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayPreviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2736,15 +5533,15 @@ func (m *QueryOperationByHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationByHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayPreviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2772,7 +5569,7 @@ func (m *QueryOperationByHashRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2795,7 +5592,7 @@ func (m *QueryOperationByHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperationByHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelayPreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,17 +5615,17 @@ func (m *QueryOperationByHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationByHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelayPreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelayPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Track", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2838,83 +5635,29 @@ func (m *QueryOperationByHashResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Operation == nil {
-				m.Operation = &QueuedOperation{}
-			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Track = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOperationsByProposalRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationsByProposalRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationsByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveDelaySeconds", wireType)
 			}
-			m.ProposalId = 0
+			m.AdaptiveDelaySeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2924,66 +5667,54 @@ func (m *QueryOperationsByProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.AdaptiveDelaySeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypeDelaySeconds", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOperationsByProposalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.MessageTypeDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageTypeDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperationsByProposalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperationsByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestExecutableAtUnix", wireType)
 			}
-			var msglen int
+			m.EarliestExecutableAtUnix = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2993,26 +5724,51 @@ func (m *QueryOperationsByProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EarliestExecutableAtUnix |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeEscalate", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CumulativeEscalate = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutationFreqExceeded", wireType)
 			}
-			m.Operations = append(m.Operations, QueuedOperation{})
-			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.MutationFreqExceeded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
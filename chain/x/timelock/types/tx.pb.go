@@ -546,6 +546,203 @@ func (m *MsgUpdateGuardianResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGuardianResponse proto.InternalMessageInfo
 
+// MsgSetExecutionPaused pauses or resumes all timelock execution. While paused
+// no operation executes (auto, manual or emergency) except one that only
+// clears the pause through MsgUpdateParams; queued operations can still be
+// cancelled.
+type MsgSetExecutionPaused struct {
+	// guardian must be a member of the guardian set
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// paused is the new pause state
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetExecutionPaused) Reset()         { *m = MsgSetExecutionPaused{} }
+func (m *MsgSetExecutionPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExecutionPaused) ProtoMessage()    {}
+func (*MsgSetExecutionPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0113457def845e79, []int{10}
+}
+func (m *MsgSetExecutionPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExecutionPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExecutionPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExecutionPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExecutionPaused.Merge(m, src)
+}
+func (m *MsgSetExecutionPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExecutionPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExecutionPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExecutionPaused proto.InternalMessageInfo
+
+func (m *MsgSetExecutionPaused) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *MsgSetExecutionPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// MsgSetExecutionPausedResponse is the response for MsgSetExecutionPaused
+type MsgSetExecutionPausedResponse struct {
+	// applied is false while the change is still collecting guardian approvals
+	Applied bool `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (m *MsgSetExecutionPausedResponse) Reset()         { *m = MsgSetExecutionPausedResponse{} }
+func (m *MsgSetExecutionPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExecutionPausedResponse) ProtoMessage()    {}
+func (*MsgSetExecutionPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0113457def845e79, []int{11}
+}
+func (m *MsgSetExecutionPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExecutionPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExecutionPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExecutionPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExecutionPausedResponse.Merge(m, src)
+}
+func (m *MsgSetExecutionPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExecutionPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExecutionPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExecutionPausedResponse proto.InternalMessageInfo
+
+func (m *MsgSetExecutionPausedResponse) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+// MsgUpdateHandlerMissingPolicy sets how operations orphaned by an upgrade
+// (no registered message handler) are handled
+type MsgUpdateHandlerMissingPolicy struct {
+	// authority must be the governance module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// policy is the full new policy
+	Policy HandlerMissingPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgUpdateHandlerMissingPolicy) Reset()         { *m = MsgUpdateHandlerMissingPolicy{} }
+func (m *MsgUpdateHandlerMissingPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHandlerMissingPolicy) ProtoMessage()    {}
+func (*MsgUpdateHandlerMissingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0113457def845e79, []int{12}
+}
+func (m *MsgUpdateHandlerMissingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHandlerMissingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHandlerMissingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateHandlerMissingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHandlerMissingPolicy.Merge(m, src)
+}
+func (m *MsgUpdateHandlerMissingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHandlerMissingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHandlerMissingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateHandlerMissingPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateHandlerMissingPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateHandlerMissingPolicy) GetPolicy() HandlerMissingPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return HandlerMissingPolicy{}
+}
+
+// MsgUpdateHandlerMissingPolicyResponse is the response for MsgUpdateHandlerMissingPolicy
+type MsgUpdateHandlerMissingPolicyResponse struct {
+}
+
+func (m *MsgUpdateHandlerMissingPolicyResponse) Reset()         { *m = MsgUpdateHandlerMissingPolicyResponse{} }
+func (m *MsgUpdateHandlerMissingPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateHandlerMissingPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateHandlerMissingPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0113457def845e79, []int{13}
+}
+func (m *MsgUpdateHandlerMissingPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateHandlerMissingPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateHandlerMissingPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateHandlerMissingPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateHandlerMissingPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateHandlerMissingPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateHandlerMissingPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateHandlerMissingPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateHandlerMissingPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgExecuteOperation)(nil), "pos.timelock.v1.MsgExecuteOperation")
 	proto.RegisterType((*MsgExecuteOperationResponse)(nil), "pos.timelock.v1.MsgExecuteOperationResponse")
@@ -557,54 +754,68 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pos.timelock.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateGuardian)(nil), "pos.timelock.v1.MsgUpdateGuardian")
 	proto.RegisterType((*MsgUpdateGuardianResponse)(nil), "pos.timelock.v1.MsgUpdateGuardianResponse")
+	proto.RegisterType((*MsgSetExecutionPaused)(nil), "pos.timelock.v1.MsgSetExecutionPaused")
+	proto.RegisterType((*MsgSetExecutionPausedResponse)(nil), "pos.timelock.v1.MsgSetExecutionPausedResponse")
+	proto.RegisterType((*MsgUpdateHandlerMissingPolicy)(nil), "pos.timelock.v1.MsgUpdateHandlerMissingPolicy")
+	proto.RegisterType((*MsgUpdateHandlerMissingPolicyResponse)(nil), "pos.timelock.v1.MsgUpdateHandlerMissingPolicyResponse")
 }
 
 func init() { proto.RegisterFile("pos/timelock/v1/tx.proto", fileDescriptor_0113457def845e79) }
 
 var fileDescriptor_0113457def845e79 = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0x8e, 0x9b, 0x7e, 0xfd, 0xda, 0x6b, 0xa0, 0xd4, 0x54, 0xd4, 0x71, 0x2b, 0x37, 0x98, 0x0e,
-	0x51, 0x00, 0x5b, 0x6d, 0x81, 0x21, 0x4c, 0x14, 0x21, 0xc4, 0x10, 0x01, 0x46, 0x2c, 0x5d, 0x82,
-	0x6b, 0x5f, 0x0e, 0x43, 0xec, 0xb3, 0x7c, 0x76, 0x9b, 0x6c, 0x88, 0x91, 0x89, 0xdf, 0xc0, 0x2f,
-	0x08, 0x12, 0x5b, 0xff, 0x40, 0x25, 0x96, 0x8a, 0x89, 0x09, 0xa1, 0x64, 0xc8, 0xcf, 0x00, 0xd9,
-	0x3e, 0x3b, 0xcd, 0xd9, 0x21, 0x51, 0x11, 0x4b, 0x94, 0x7b, 0xde, 0xe7, 0xde, 0x7b, 0x9f, 0xe7,
-	0x7d, 0xef, 0x0c, 0x04, 0x17, 0x13, 0xd5, 0xb7, 0x6c, 0xd8, 0xc6, 0xc6, 0x5b, 0xf5, 0x68, 0x47,
-	0xf5, 0x3b, 0x8a, 0xeb, 0x61, 0x1f, 0xf3, 0x2b, 0x2e, 0x26, 0x4a, 0x12, 0x51, 0x8e, 0x76, 0xc4,
-	0x32, 0xc2, 0x18, 0xb5, 0xa1, 0x1a, 0x85, 0x0f, 0x83, 0x96, 0xaa, 0x3b, 0xdd, 0x98, 0x2b, 0xae,
-	0x1b, 0x98, 0xd8, 0x98, 0xa8, 0x36, 0x41, 0x61, 0x0e, 0x9b, 0x20, 0x1a, 0x28, 0xc7, 0x81, 0x66,
-	0xb4, 0x52, 0xe3, 0x05, 0x0d, 0xad, 0xea, 0xb6, 0xe5, 0x60, 0x35, 0xfa, 0xa5, 0xd0, 0x1a, 0xc2,
-	0x08, 0xc7, 0xd4, 0xf0, 0x1f, 0x45, 0x37, 0x32, 0x25, 0x76, 0x5d, 0x48, 0xb3, 0xc8, 0x9f, 0x38,
-	0x70, 0xb5, 0x41, 0xd0, 0xa3, 0x0e, 0x34, 0x02, 0x1f, 0x3e, 0x75, 0xa1, 0xa7, 0xfb, 0x16, 0x76,
-	0xf8, 0x3b, 0x60, 0x11, 0x46, 0x18, 0xf6, 0x04, 0xae, 0xc2, 0x55, 0x97, 0xf6, 0x85, 0x6f, 0x5f,
-	0x6e, 0xaf, 0xd1, 0x0a, 0x1e, 0x98, 0xa6, 0x07, 0x09, 0x79, 0xe1, 0x7b, 0x96, 0x83, 0xb4, 0x94,
-	0xc9, 0x5f, 0x07, 0x25, 0x9c, 0xa4, 0x68, 0x5a, 0xa6, 0x30, 0x57, 0xe1, 0xaa, 0xf3, 0xda, 0x72,
-	0x8a, 0x3d, 0x31, 0xeb, 0xbb, 0xef, 0x87, 0xbd, 0x5a, 0xba, 0xe3, 0xc3, 0xb0, 0x57, 0xab, 0x8c,
-	0xd5, 0x97, 0x53, 0x8c, 0xfc, 0x1c, 0x6c, 0xe4, 0xc0, 0x1a, 0x24, 0x2e, 0x76, 0x08, 0xe4, 0x05,
-	0xf0, 0x3f, 0x09, 0x0c, 0x03, 0x12, 0x12, 0x95, 0xba, 0xa8, 0x25, 0xcb, 0x30, 0xe2, 0x41, 0x12,
-	0xb4, 0x7d, 0x22, 0xcc, 0x55, 0x8a, 0xd5, 0x92, 0x96, 0x2c, 0xe5, 0x13, 0x0e, 0xf0, 0x0d, 0x82,
-	0x1e, 0xea, 0x8e, 0x01, 0xdb, 0x23, 0xd9, 0xf7, 0xc0, 0x92, 0x1e, 0xf8, 0xaf, 0xb1, 0x67, 0xf9,
-	0xdd, 0xa9, 0xba, 0x47, 0xd4, 0x19, 0x84, 0xf3, 0xd7, 0xc0, 0x82, 0x07, 0x75, 0x82, 0x1d, 0xa1,
-	0x18, 0xe6, 0xd5, 0xe8, 0x2a, 0x36, 0x64, 0x94, 0x2a, 0x74, 0x64, 0x8b, 0x75, 0x84, 0x29, 0x53,
-	0xde, 0x04, 0x62, 0x16, 0x4d, 0xfc, 0x90, 0xbf, 0xd2, 0x9e, 0xda, 0xd0, 0x43, 0xd0, 0x31, 0xba,
-	0xd4, 0xb8, 0x7f, 0x29, 0x6e, 0x1b, 0x5c, 0x7a, 0x13, 0x10, 0xdf, 0x6a, 0x59, 0x46, 0x04, 0x51,
-	0x8d, 0xe3, 0x60, 0x7d, 0x2f, 0x2b, 0x35, 0xdb, 0x7c, 0xa6, 0xea, 0xa4, 0xf9, 0x0c, 0xfc, 0x57,
-	0xcd, 0xff, 0xcc, 0x81, 0x95, 0x06, 0x41, 0x2f, 0x5d, 0x53, 0xf7, 0xe1, 0x33, 0xdd, 0xd3, 0x6d,
-	0x72, 0x61, 0x73, 0xee, 0x82, 0x05, 0x37, 0xca, 0x10, 0xd9, 0xb2, 0xbc, 0xbb, 0xae, 0x30, 0xf7,
-	0x5e, 0x89, 0x0f, 0xd8, 0x9f, 0x3f, 0xfd, 0xb1, 0x55, 0xd0, 0x28, 0xb9, 0xae, 0x66, 0xad, 0xd8,
-	0x64, 0xad, 0x38, 0x5f, 0x9f, 0x5c, 0x06, 0xeb, 0x0c, 0x94, 0xf6, 0xfb, 0x84, 0x03, 0xab, 0x69,
-	0xec, 0x71, 0xa0, 0x7b, 0xa6, 0xa5, 0x5f, 0x7c, 0x94, 0xef, 0x83, 0x92, 0x03, 0x8f, 0x9b, 0x88,
-	0xe6, 0x89, 0x64, 0xfd, 0x69, 0xeb, 0xb2, 0x03, 0x8f, 0x93, 0x43, 0xeb, 0x3b, 0x59, 0x59, 0x52,
-	0xbe, 0xac, 0x64, 0x8b, 0xbc, 0x01, 0xca, 0x19, 0x30, 0x91, 0xb6, 0xfb, 0xab, 0x08, 0x8a, 0x0d,
-	0x82, 0xf8, 0x16, 0xb8, 0x92, 0x79, 0xa2, 0xb6, 0x33, 0x4e, 0xe7, 0x3c, 0x12, 0xe2, 0xad, 0x59,
-	0x58, 0xe9, 0x34, 0x19, 0x60, 0x85, 0x7d, 0x12, 0x6e, 0xe4, 0x25, 0x60, 0x48, 0xe2, 0xcd, 0x19,
-	0x48, 0xe9, 0x21, 0xa1, 0x18, 0xf6, 0x6e, 0xe6, 0x8b, 0x61, 0x58, 0x13, 0xc4, 0x4c, 0xba, 0x1a,
-	0x07, 0xa0, 0x34, 0x36, 0xe2, 0x95, 0xbc, 0xdd, 0xe7, 0x19, 0x62, 0x75, 0x1a, 0x23, 0xcd, 0xfd,
-	0x0a, 0x5c, 0x66, 0xe6, 0x4d, 0x9e, 0xbc, 0x37, 0xe1, 0x88, 0xb5, 0xe9, 0x9c, 0xe4, 0x04, 0xf1,
-	0xbf, 0x77, 0xc3, 0x5e, 0x8d, 0xdb, 0x57, 0x4e, 0xfb, 0x12, 0x77, 0xd6, 0x97, 0xb8, 0x9f, 0x7d,
-	0x89, 0xfb, 0x38, 0x90, 0x0a, 0x67, 0x03, 0xa9, 0xf0, 0x7d, 0x20, 0x15, 0x0e, 0xd6, 0xc2, 0xc1,
-	0xea, 0x8c, 0x46, 0x2b, 0xfa, 0xac, 0x1d, 0x2e, 0x44, 0xdf, 0xb5, 0xbd, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x20, 0x60, 0x81, 0x99, 0x07, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x4f, 0xd4, 0x50,
+	0x10, 0xdf, 0xf2, 0x67, 0x85, 0x61, 0x15, 0xa9, 0x28, 0xdd, 0x02, 0xcb, 0x5a, 0x41, 0x37, 0x2b,
+	0xb6, 0xe1, 0x8f, 0x24, 0xae, 0xf1, 0xe0, 0x1a, 0x23, 0x1e, 0x36, 0x62, 0x89, 0x17, 0x2e, 0x58,
+	0xda, 0x47, 0xad, 0x76, 0xfb, 0x9a, 0xbe, 0x16, 0xd8, 0x9b, 0x31, 0x9e, 0x3c, 0x79, 0xf7, 0xe6,
+	0xc9, 0x23, 0x26, 0xde, 0xf8, 0x02, 0x24, 0x5e, 0x88, 0x27, 0x4f, 0x86, 0xc0, 0x81, 0x2f, 0xe0,
+	0x07, 0x30, 0xed, 0xbe, 0x76, 0xa1, 0x2d, 0xec, 0x8a, 0xf1, 0x42, 0x98, 0x99, 0xdf, 0xcc, 0xfc,
+	0x66, 0xe6, 0xcd, 0x6c, 0x81, 0xb3, 0x31, 0x91, 0x5c, 0xa3, 0x8e, 0x4c, 0xac, 0xbe, 0x91, 0x36,
+	0x66, 0x24, 0x77, 0x4b, 0xb4, 0x1d, 0xec, 0x62, 0x76, 0xd0, 0xc6, 0x44, 0x0c, 0x2d, 0xe2, 0xc6,
+	0x0c, 0x9f, 0xd7, 0x31, 0xd6, 0x4d, 0x24, 0x05, 0xe6, 0x35, 0x6f, 0x5d, 0x52, 0xac, 0x46, 0x13,
+	0xcb, 0x8f, 0xa8, 0x98, 0xd4, 0x31, 0x91, 0xea, 0x44, 0xf7, 0x63, 0xd4, 0x89, 0x4e, 0x0d, 0xf9,
+	0xa6, 0x61, 0x35, 0x90, 0xa4, 0xa6, 0x40, 0x4d, 0x43, 0x4a, 0xdd, 0xb0, 0xb0, 0x14, 0xfc, 0xa5,
+	0xaa, 0x61, 0x1d, 0xeb, 0xb8, 0x09, 0xf5, 0xff, 0xa3, 0xda, 0xd1, 0x04, 0xc5, 0x86, 0x8d, 0x68,
+	0x14, 0xe1, 0x33, 0x03, 0x57, 0x6a, 0x44, 0x7f, 0xbc, 0x85, 0x54, 0xcf, 0x45, 0xcf, 0x6c, 0xe4,
+	0x28, 0xae, 0x81, 0x2d, 0x76, 0x1e, 0xfa, 0x50, 0xa0, 0xc3, 0x0e, 0xc7, 0x14, 0x99, 0x52, 0x7f,
+	0x95, 0xfb, 0xf1, 0xed, 0xce, 0x30, 0x65, 0xf0, 0x50, 0xd3, 0x1c, 0x44, 0xc8, 0xb2, 0xeb, 0x18,
+	0x96, 0x2e, 0x47, 0x48, 0xf6, 0x3a, 0xe4, 0x70, 0x18, 0x62, 0xd5, 0xd0, 0xb8, 0xae, 0x22, 0x53,
+	0xea, 0x91, 0x07, 0x22, 0xdd, 0x53, 0xad, 0x32, 0xfb, 0xee, 0x68, 0xbb, 0x1c, 0x79, 0x7c, 0x38,
+	0xda, 0x2e, 0x17, 0x4f, 0xf0, 0x4b, 0x21, 0x23, 0x3c, 0x87, 0xd1, 0x14, 0xb5, 0x8c, 0x88, 0x8d,
+	0x2d, 0x82, 0x58, 0x0e, 0x2e, 0x10, 0x4f, 0x55, 0x11, 0x21, 0x01, 0xd5, 0x3e, 0x39, 0x14, 0x7d,
+	0x8b, 0x83, 0x88, 0x67, 0xba, 0x84, 0xeb, 0x2a, 0x76, 0x97, 0x72, 0x72, 0x28, 0x0a, 0x3b, 0x0c,
+	0xb0, 0x35, 0xa2, 0x3f, 0x52, 0x2c, 0x15, 0x99, 0xad, 0xb2, 0x17, 0xa0, 0x5f, 0xf1, 0xdc, 0x57,
+	0xd8, 0x31, 0xdc, 0x46, 0xdb, 0xba, 0x5b, 0xd0, 0x0e, 0x0a, 0x67, 0xaf, 0x41, 0xd6, 0x41, 0x0a,
+	0xc1, 0x16, 0xd7, 0xed, 0xc7, 0x95, 0xa9, 0xd4, 0x6c, 0x48, 0x2b, 0x94, 0xdf, 0x91, 0x89, 0x78,
+	0x47, 0x62, 0x34, 0x85, 0x31, 0xe0, 0x93, 0xda, 0xb0, 0x1f, 0xc2, 0x77, 0x3a, 0xd3, 0x3a, 0x72,
+	0x74, 0x64, 0xa9, 0x0d, 0xda, 0xb8, 0xff, 0x59, 0xdc, 0x24, 0x5c, 0x7c, 0xed, 0x11, 0xd7, 0x58,
+	0x37, 0xd4, 0x40, 0x45, 0x6b, 0x3c, 0xa9, 0xac, 0xcc, 0x25, 0x4b, 0x4d, 0x0e, 0x3f, 0xc6, 0x3a,
+	0x1c, 0x7e, 0x4c, 0xfd, 0x4f, 0xc3, 0xff, 0xca, 0xc0, 0x60, 0x8d, 0xe8, 0x2f, 0x6c, 0x4d, 0x71,
+	0xd1, 0x92, 0xe2, 0x28, 0x75, 0x72, 0xee, 0xe6, 0xdc, 0x85, 0xac, 0x1d, 0x44, 0x08, 0xda, 0x32,
+	0x30, 0x3b, 0x22, 0xc6, 0xf6, 0x5e, 0x6c, 0x26, 0xa8, 0xf6, 0xec, 0xfe, 0x9a, 0xc8, 0xc8, 0x14,
+	0x5c, 0x91, 0x92, 0xad, 0x18, 0x8b, 0xb7, 0xe2, 0x38, 0x3f, 0x21, 0x0f, 0x23, 0x31, 0x55, 0x34,
+	0xef, 0x1d, 0x06, 0x86, 0x22, 0xdb, 0x13, 0x4f, 0x71, 0x34, 0x43, 0x39, 0xff, 0x53, 0xbe, 0x0f,
+	0x39, 0x0b, 0x6d, 0xae, 0xea, 0x34, 0x0e, 0xd7, 0xd5, 0xc6, 0x75, 0xc0, 0x42, 0x9b, 0x61, 0xd2,
+	0xca, 0x4c, 0xb2, 0xac, 0x42, 0x7a, 0x59, 0xa1, 0x8b, 0x30, 0x0a, 0xf9, 0x84, 0x32, 0x2a, 0xed,
+	0x13, 0x03, 0x57, 0x6b, 0x44, 0x5f, 0x46, 0x6e, 0x73, 0xee, 0x06, 0xb6, 0x96, 0x14, 0x8f, 0x20,
+	0xcd, 0x3f, 0x50, 0x11, 0xc5, 0xb6, 0x07, 0x2a, 0x44, 0xfa, 0x4b, 0x68, 0x07, 0xfe, 0x41, 0x59,
+	0x7d, 0x32, 0x95, 0x2a, 0xf3, 0xc1, 0x55, 0x0a, 0x61, 0x3e, 0x6d, 0x21, 0x4e, 0x3b, 0xc9, 0x41,
+	0xb8, 0x07, 0xe3, 0xa9, 0x86, 0xe3, 0x8f, 0x53, 0xb1, 0x6d, 0xd3, 0x40, 0x5a, 0xf8, 0x38, 0xa9,
+	0x28, 0xec, 0x33, 0x30, 0x1e, 0x95, 0xbd, 0xa8, 0x58, 0x9a, 0x89, 0x9c, 0x9a, 0x41, 0x88, 0x61,
+	0xe9, 0x4b, 0xd8, 0x34, 0xd4, 0xc6, 0xb9, 0xe7, 0xb7, 0x08, 0x59, 0x3b, 0x88, 0x40, 0x1f, 0xe4,
+	0x54, 0xe2, 0x41, 0xa6, 0xa5, 0xab, 0xf6, 0xfb, 0xcf, 0xf3, 0xcb, 0xd1, 0x76, 0x99, 0x91, 0xa9,
+	0x7f, 0xe5, 0x41, 0x72, 0x98, 0xe5, 0xf4, 0x61, 0xa6, 0x45, 0x14, 0x6e, 0xc1, 0xd4, 0x99, 0x80,
+	0xb0, 0x4b, 0xb3, 0xbf, 0x7b, 0xa1, 0xbb, 0x46, 0x74, 0x76, 0x1d, 0x2e, 0x27, 0x7e, 0x87, 0x26,
+	0x13, 0xec, 0x53, 0x7e, 0x09, 0xf8, 0xe9, 0x4e, 0x50, 0xd1, 0x54, 0x54, 0x18, 0x8c, 0xdf, 0xfd,
+	0x1b, 0x69, 0x01, 0x62, 0x20, 0xfe, 0x76, 0x07, 0xa0, 0x28, 0x89, 0x5f, 0x4c, 0xfc, 0x00, 0xa7,
+	0x17, 0x13, 0x43, 0xf1, 0xd3, 0x9d, 0xa0, 0xa2, 0x3c, 0x2b, 0x90, 0x3b, 0x71, 0xc7, 0x8a, 0x69,
+	0xde, 0xc7, 0x11, 0x7c, 0xa9, 0x1d, 0x22, 0x8a, 0xfd, 0x12, 0x2e, 0xc5, 0x8e, 0x8a, 0x70, 0xba,
+	0x6f, 0x88, 0xe1, 0xcb, 0xed, 0x31, 0x51, 0x06, 0x13, 0xd8, 0x94, 0xdd, 0xbe, 0x99, 0x16, 0x21,
+	0x89, 0xe3, 0xc5, 0xce, 0x70, 0x51, 0xb6, 0xf7, 0x0c, 0xf0, 0x67, 0x6c, 0x9c, 0x78, 0x3a, 0xf1,
+	0x34, 0x3c, 0xbf, 0xf0, 0x77, 0xf8, 0x90, 0x06, 0xdf, 0xfb, 0xd6, 0x5f, 0xb3, 0xaa, 0xb8, 0x7b,
+	0x50, 0x60, 0xf6, 0x0e, 0x0a, 0xcc, 0xfe, 0x41, 0x81, 0xf9, 0x78, 0x58, 0xc8, 0xec, 0x1d, 0x16,
+	0x32, 0x3f, 0x0f, 0x0b, 0x99, 0x95, 0x61, 0x7f, 0xcb, 0xb6, 0x5a, 0x7b, 0x16, 0x7c, 0xb0, 0xad,
+	0x65, 0x83, 0x2f, 0xb6, 0xb9, 0x3f, 0x03, 0x00, 0x84, 0x75, 0xf1, 0x66, 0x73, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -629,6 +840,11 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// UpdateGuardian updates the guardian address (governance only)
 	UpdateGuardian(ctx context.Context, in *MsgUpdateGuardian, opts ...grpc.CallOption) (*MsgUpdateGuardianResponse, error)
+	// SetExecutionPaused pauses or resumes all operation execution (guardian only)
+	SetExecutionPaused(ctx context.Context, in *MsgSetExecutionPaused, opts ...grpc.CallOption) (*MsgSetExecutionPausedResponse, error)
+	// UpdateHandlerMissingPolicy sets how operations orphaned by an upgrade are
+	// handled (governance only)
+	UpdateHandlerMissingPolicy(ctx context.Context, in *MsgUpdateHandlerMissingPolicy, opts ...grpc.CallOption) (*MsgUpdateHandlerMissingPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -684,6 +900,24 @@ func (c *msgClient) UpdateGuardian(ctx context.Context, in *MsgUpdateGuardian, o
 	return out, nil
 }
 
+func (c *msgClient) SetExecutionPaused(ctx context.Context, in *MsgSetExecutionPaused, opts ...grpc.CallOption) (*MsgSetExecutionPausedResponse, error) {
+	out := new(MsgSetExecutionPausedResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Msg/SetExecutionPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateHandlerMissingPolicy(ctx context.Context, in *MsgUpdateHandlerMissingPolicy, opts ...grpc.CallOption) (*MsgUpdateHandlerMissingPolicyResponse, error) {
+	out := new(MsgUpdateHandlerMissingPolicyResponse)
+	err := c.cc.Invoke(ctx, "/pos.timelock.v1.Msg/UpdateHandlerMissingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ExecuteOperation executes a queued operation after the delay has passed
@@ -696,6 +930,11 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// UpdateGuardian updates the guardian address (governance only)
 	UpdateGuardian(context.Context, *MsgUpdateGuardian) (*MsgUpdateGuardianResponse, error)
+	// SetExecutionPaused pauses or resumes all operation execution (guardian only)
+	SetExecutionPaused(context.Context, *MsgSetExecutionPaused) (*MsgSetExecutionPausedResponse, error)
+	// UpdateHandlerMissingPolicy sets how operations orphaned by an upgrade are
+	// handled (governance only)
+	UpdateHandlerMissingPolicy(context.Context, *MsgUpdateHandlerMissingPolicy) (*MsgUpdateHandlerMissingPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -717,6 +956,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) UpdateGuardian(ctx context.Context, req *MsgUpdateGuardian) (*MsgUpdateGuardianResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuardian not implemented")
 }
+func (*UnimplementedMsgServer) SetExecutionPaused(ctx context.Context, req *MsgSetExecutionPaused) (*MsgSetExecutionPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExecutionPaused not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHandlerMissingPolicy(ctx context.Context, req *MsgUpdateHandlerMissingPolicy) (*MsgUpdateHandlerMissingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHandlerMissingPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -812,6 +1057,42 @@ func _Msg_UpdateGuardian_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetExecutionPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExecutionPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExecutionPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Msg/SetExecutionPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExecutionPaused(ctx, req.(*MsgSetExecutionPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateHandlerMissingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHandlerMissingPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHandlerMissingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.timelock.v1.Msg/UpdateHandlerMissingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHandlerMissingPolicy(ctx, req.(*MsgUpdateHandlerMissingPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pos.timelock.v1.Msg",
@@ -837,6 +1118,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGuardian",
 			Handler:    _Msg_UpdateGuardian_Handler,
 		},
+		{
+			MethodName: "SetExecutionPaused",
+			Handler:    _Msg_SetExecutionPaused_Handler,
+		},
+		{
+			MethodName: "UpdateHandlerMissingPolicy",
+			Handler:    _Msg_UpdateHandlerMissingPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pos/timelock/v1/tx.proto",
@@ -1191,78 +1480,214 @@ func (m *MsgUpdateGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetExecutionPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgExecuteOperation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Executor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.OperationId != 0 {
-		n += 1 + sovTx(uint64(m.OperationId))
-	}
-	return n
+
+func (m *MsgSetExecutionPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteOperationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetExecutionPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
-	if len(m.Results) > 0 {
-		for _, b := range m.Results {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelOperation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetExecutionPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExecutionPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExecutionPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.OperationId != 0 {
-		n += 1 + sovTx(uint64(m.OperationId))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelOperationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateHandlerMissingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHandlerMissingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHandlerMissingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHandlerMissingPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHandlerMissingPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHandlerMissingPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgExecuteOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OperationId != 0 {
+		n += 1 + sovTx(uint64(m.OperationId))
+	}
+	return n
+}
+
+func (m *MsgExecuteOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OperationId != 0 {
+		n += 1 + sovTx(uint64(m.OperationId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgEmergencyExecute) Size() (n int) {
@@ -1353,13 +1778,451 @@ func (m *MsgUpdateGuardianResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetExecutionPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetExecutionPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Applied {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateHandlerMissingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateHandlerMissingPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgExecuteOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			m.OperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			m.OperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgExecuteOperation) Unmarshal(dAtA []byte) error {
+func (m *MsgEmergencyExecute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1382,15 +2245,15 @@ func (m *MsgExecuteOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEmergencyExecute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEmergencyExecute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1418,7 +2281,7 @@ func (m *MsgExecuteOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executor = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1439,6 +2302,38 @@ func (m *MsgExecuteOperation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1460,7 +2355,7 @@ func (m *MsgExecuteOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteOperationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEmergencyExecuteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1483,10 +2378,10 @@ func (m *MsgExecuteOperationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteOperationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEmergencyExecuteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEmergencyExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1562,7 +2457,7 @@ func (m *MsgExecuteOperationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOperation) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,10 +2480,10 @@ func (m *MsgCancelOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1624,29 +2519,10 @@ func (m *MsgCancelOperation) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
-			}
-			m.OperationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OperationId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1656,23 +2532,24 @@ func (m *MsgCancelOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1695,7 +2572,7 @@ func (m *MsgCancelOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOperationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1718,10 +2595,10 @@ func (m *MsgCancelOperationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOperationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1745,7 +2622,7 @@ func (m *MsgCancelOperationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEmergencyExecute) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGuardian) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,10 +2645,10 @@ func (m *MsgEmergencyExecute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEmergencyExecute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGuardian: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEmergencyExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1807,27 +2684,8 @@ func (m *MsgEmergencyExecute) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
-			}
-			m.OperationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OperationId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGuardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1855,7 +2713,7 @@ func (m *MsgEmergencyExecute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Justification = string(dAtA[iNdEx:postIndex])
+			m.NewGuardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1878,7 +2736,7 @@ func (m *MsgEmergencyExecute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEmergencyExecuteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGuardianResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1901,64 +2759,12 @@ func (m *MsgEmergencyExecuteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEmergencyExecuteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGuardianResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEmergencyExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
-			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1980,7 +2786,7 @@ func (m *MsgEmergencyExecuteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetExecutionPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,15 +2809,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetExecutionPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetExecutionPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2039,13 +2845,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2055,25 +2861,12 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2095,7 +2888,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetExecutionPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,12 +2911,32 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetExecutionPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetExecutionPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2145,7 +2958,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGuardian) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHandlerMissingPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2168,10 +2981,10 @@ func (m *MsgUpdateGuardian) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGuardian: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHandlerMissingPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHandlerMissingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2208,9 +3021,9 @@ func (m *MsgUpdateGuardian) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewGuardian", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2220,23 +3033,24 @@ func (m *MsgUpdateGuardian) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewGuardian = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2259,7 +3073,7 @@ func (m *MsgUpdateGuardian) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGuardianResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHandlerMissingPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2282,10 +3096,10 @@ func (m *MsgUpdateGuardianResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGuardianResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHandlerMissingPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHandlerMissingPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_sortkeys "github.com/cosmos/gogoproto/sortkeys"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
 	math "math"
@@ -42,6 +43,9 @@ const (
 	OperationStatus_OPERATION_STATUS_EXPIRED OperationStatus = 4
 	// OPERATION_STATUS_FAILED means execution was attempted but failed
 	OperationStatus_OPERATION_STATUS_FAILED OperationStatus = 5
+	// OPERATION_STATUS_HANDLER_MISSING means execution was attempted but a
+	// message type no longer has a registered handler (e.g. removed by an upgrade)
+	OperationStatus_OPERATION_STATUS_HANDLER_MISSING OperationStatus = 6
 )
 
 var OperationStatus_name = map[int32]string{
@@ -51,15 +55,17 @@ var OperationStatus_name = map[int32]string{
 	3: "OPERATION_STATUS_CANCELLED",
 	4: "OPERATION_STATUS_EXPIRED",
 	5: "OPERATION_STATUS_FAILED",
+	6: "OPERATION_STATUS_HANDLER_MISSING",
 }
 
 var OperationStatus_value = map[string]int32{
-	"OPERATION_STATUS_UNSPECIFIED": 0,
-	"OPERATION_STATUS_QUEUED":      1,
-	"OPERATION_STATUS_EXECUTED":    2,
-	"OPERATION_STATUS_CANCELLED":   3,
-	"OPERATION_STATUS_EXPIRED":     4,
-	"OPERATION_STATUS_FAILED":      5,
+	"OPERATION_STATUS_UNSPECIFIED":     0,
+	"OPERATION_STATUS_QUEUED":          1,
+	"OPERATION_STATUS_EXECUTED":        2,
+	"OPERATION_STATUS_CANCELLED":       3,
+	"OPERATION_STATUS_EXPIRED":         4,
+	"OPERATION_STATUS_FAILED":          5,
+	"OPERATION_STATUS_HANDLER_MISSING": 6,
 }
 
 func (x OperationStatus) String() string {
@@ -82,6 +88,27 @@ type Params struct {
 	EmergencyDelaySeconds uint64 `protobuf:"varint,4,opt,name=emergency_delay_seconds,json=emergencyDelaySeconds,proto3" json:"emergency_delay_seconds,omitempty"`
 	// guardian is the address authorized to cancel operations and emergency execute
 	Guardian string `protobuf:"bytes,5,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// message_type_delays maps message type URLs to the minimum delay in seconds
+	// for operations containing that message type
+	MessageTypeDelays map[string]uint64 `protobuf:"bytes,6,rep,name=message_type_delays,json=messageTypeDelays,proto3" json:"message_type_delays,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// executable_soon_warning_seconds is how long before an operation becomes executable
+	// the operation_executable_soon event is emitted (0 disables the warning)
+	ExecutableSoonWarningSeconds uint64 `protobuf:"varint,7,opt,name=executable_soon_warning_seconds,json=executableSoonWarningSeconds,proto3" json:"executable_soon_warning_seconds,omitempty"`
+	// guardians are additional guardian addresses; together with guardian they
+	// form the guardian set
+	Guardians []string `protobuf:"bytes,8,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// guardian_threshold is the number of distinct guardian approvals required to
+	// cancel or emergency-execute an operation (0 or 1 lets any single guardian act)
+	GuardianThreshold uint64 `protobuf:"varint,9,opt,name=guardian_threshold,json=guardianThreshold,proto3" json:"guardian_threshold,omitempty"`
+	// max_auto_execution_gas is the gas limit for executing a single operation's
+	// messages (0 uses the default)
+	MaxAutoExecutionGas uint64 `protobuf:"varint,10,opt,name=max_auto_execution_gas,json=maxAutoExecutionGas,proto3" json:"max_auto_execution_gas,omitempty"`
+	// max_operations_per_block is the number of operations EndBlock auto-executes
+	// per block; further ready operations are deferred (0 uses the default)
+	MaxOperationsPerBlock uint64 `protobuf:"varint,11,opt,name=max_operations_per_block,json=maxOperationsPerBlock,proto3" json:"max_operations_per_block,omitempty"`
+	// paused stops all operation execution (auto and manual) until cleared;
+	// cancellation is still allowed. Set by a guardian via MsgSetExecutionPaused.
+	Paused bool `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -152,6 +179,55 @@ func (m *Params) GetGuardian() string {
 	return ""
 }
 
+func (m *Params) GetMessageTypeDelays() map[string]uint64 {
+	if m != nil {
+		return m.MessageTypeDelays
+	}
+	return nil
+}
+
+func (m *Params) GetExecutableSoonWarningSeconds() uint64 {
+	if m != nil {
+		return m.ExecutableSoonWarningSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *Params) GetGuardianThreshold() uint64 {
+	if m != nil {
+		return m.GuardianThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxAutoExecutionGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOperationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxOperationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // QueuedOperation represents an operation waiting for execution
 type QueuedOperation struct {
 	// id is the unique identifier for this operation
@@ -180,6 +256,10 @@ type QueuedOperation struct {
 	CancelReason string `protobuf:"bytes,12,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
 	// execution_error is the error message if execution failed
 	ExecutionError string `protobuf:"bytes,13,opt,name=execution_error,json=executionError,proto3" json:"execution_error,omitempty"`
+	// failed_at_height is the block height at which execution failed (0 if not failed)
+	FailedAtHeight int64 `protobuf:"varint,14,opt,name=failed_at_height,json=failedAtHeight,proto3" json:"failed_at_height,omitempty"`
+	// retry_count is how many times governance has re-queued the operation after a failure
+	RetryCount uint32 `protobuf:"varint,15,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
 }
 
 func (m *QueuedOperation) Reset()         { *m = QueuedOperation{} }
@@ -306,6 +386,77 @@ func (m *QueuedOperation) GetExecutionError() string {
 	return ""
 }
 
+func (m *QueuedOperation) GetFailedAtHeight() int64 {
+	if m != nil {
+		return m.FailedAtHeight
+	}
+	return 0
+}
+
+func (m *QueuedOperation) GetRetryCount() uint32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+// HandlerMissingPolicy governs operations whose message handler disappeared
+// after an upgrade. When auto_cancel_enabled is false, orphaned operations stay
+// in HANDLER_MISSING until governance or the guardian cancels them.
+type HandlerMissingPolicy struct {
+	AutoCancelEnabled bool `protobuf:"varint,1,opt,name=auto_cancel_enabled,json=autoCancelEnabled,proto3" json:"auto_cancel_enabled,omitempty"`
+	// grace_period_seconds is measured from the time the missing handler was
+	// detected (QueuedOperation.executed_at_unix)
+	GracePeriodSeconds uint64 `protobuf:"varint,2,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+}
+
+func (m *HandlerMissingPolicy) Reset()         { *m = HandlerMissingPolicy{} }
+func (m *HandlerMissingPolicy) String() string { return proto.CompactTextString(m) }
+func (*HandlerMissingPolicy) ProtoMessage()    {}
+func (*HandlerMissingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3397044bdb66ad0a, []int{2}
+}
+func (m *HandlerMissingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerMissingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerMissingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerMissingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerMissingPolicy.Merge(m, src)
+}
+func (m *HandlerMissingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerMissingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerMissingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerMissingPolicy proto.InternalMessageInfo
+
+func (m *HandlerMissingPolicy) GetAutoCancelEnabled() bool {
+	if m != nil {
+		return m.AutoCancelEnabled
+	}
+	return false
+}
+
+func (m *HandlerMissingPolicy) GetGracePeriodSeconds() uint64 {
+	if m != nil {
+		return m.GracePeriodSeconds
+	}
+	return 0
+}
+
 // GenesisState defines the timelock module's genesis state
 type GenesisState struct {
 	// params are the module parameters
@@ -314,13 +465,15 @@ type GenesisState struct {
 	Operations []QueuedOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations"`
 	// next_operation_id is the next available operation ID
 	NextOperationId uint64 `protobuf:"varint,3,opt,name=next_operation_id,json=nextOperationId,proto3" json:"next_operation_id,omitempty"`
+	// pending_proposal_ids are governance proposals awaiting timelock processing
+	PendingProposalIds []uint64 `protobuf:"varint,4,rep,packed,name=pending_proposal_ids,json=pendingProposalIds,proto3" json:"pending_proposal_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3397044bdb66ad0a, []int{2}
+	return fileDescriptor_3397044bdb66ad0a, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,66 +523,96 @@ func (m *GenesisState) GetNextOperationId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPendingProposalIds() []uint64 {
+	if m != nil {
+		return m.PendingProposalIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pos.timelock.v1.OperationStatus", OperationStatus_name, OperationStatus_value)
 	proto.RegisterType((*Params)(nil), "pos.timelock.v1.Params")
+	proto.RegisterMapType((map[string]uint64)(nil), "pos.timelock.v1.Params.MessageTypeDelaysEntry")
 	proto.RegisterType((*QueuedOperation)(nil), "pos.timelock.v1.QueuedOperation")
+	proto.RegisterType((*HandlerMissingPolicy)(nil), "pos.timelock.v1.HandlerMissingPolicy")
 	proto.RegisterType((*GenesisState)(nil), "pos.timelock.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("pos/timelock/v1/types.proto", fileDescriptor_3397044bdb66ad0a) }
 
 var fileDescriptor_3397044bdb66ad0a = []byte{
-	// 784 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcb, 0x6e, 0xeb, 0x44,
-	0x18, 0xc7, 0xe3, 0x24, 0x27, 0xb4, 0x93, 0x6b, 0x87, 0xa0, 0xba, 0x3d, 0x25, 0x8d, 0xca, 0x2d,
-	0x8a, 0xc0, 0x39, 0xa7, 0x20, 0x84, 0xce, 0x2e, 0x17, 0x17, 0x22, 0x55, 0x6d, 0xea, 0x24, 0x12,
-	0x62, 0x63, 0x4d, 0xed, 0xc1, 0x1d, 0x11, 0xcf, 0x98, 0x19, 0xa7, 0x72, 0x5e, 0x81, 0x15, 0x4f,
-	0x80, 0x58, 0x22, 0xb1, 0xe9, 0x82, 0x35, 0xeb, 0x8a, 0x55, 0xc5, 0x8a, 0x15, 0x42, 0xed, 0xa2,
-	0x3c, 0x06, 0x9a, 0xb1, 0xe3, 0xb6, 0x49, 0x25, 0x36, 0x51, 0xe6, 0xff, 0xff, 0x7d, 0xf9, 0x66,
-	0xbe, 0x4b, 0xc0, 0xcb, 0x80, 0x89, 0x4e, 0x48, 0x7c, 0x3c, 0x63, 0xce, 0x77, 0x9d, 0xcb, 0xd7,
-	0x9d, 0x70, 0x11, 0x60, 0x61, 0x04, 0x9c, 0x85, 0x0c, 0x56, 0x03, 0x26, 0x8c, 0xa5, 0x69, 0x5c,
-	0xbe, 0xde, 0xdd, 0xf1, 0x18, 0xf3, 0x66, 0xb8, 0xa3, 0xec, 0xf3, 0xf9, 0xb7, 0x1d, 0x44, 0x17,
-	0x31, 0xbb, 0xbb, 0xe3, 0x30, 0xe1, 0x33, 0x61, 0xab, 0x53, 0x27, 0x3e, 0x24, 0xd6, 0x16, 0xf2,
-	0x09, 0x65, 0x1d, 0xf5, 0x99, 0x48, 0x75, 0x8f, 0x79, 0x2c, 0x46, 0xe5, 0xb7, 0x58, 0x3d, 0xf8,
-	0x29, 0x0b, 0x0a, 0x23, 0xc4, 0x91, 0x2f, 0x60, 0x1b, 0x6c, 0xf9, 0x84, 0xda, 0x2e, 0x9e, 0xa1,
-	0x85, 0x2d, 0xb0, 0xc3, 0xa8, 0x2b, 0x74, 0xad, 0xa9, 0xb5, 0xf2, 0x56, 0xd5, 0x27, 0x74, 0x20,
-	0xf5, 0x71, 0x2c, 0x2b, 0x16, 0x45, 0x2b, 0x6c, 0x36, 0x61, 0x51, 0xf4, 0x84, 0x7d, 0x05, 0xea,
-	0x1e, 0x47, 0x0e, 0xb6, 0x03, 0xcc, 0x09, 0x73, 0x53, 0x3c, 0xa7, 0x70, 0xa8, 0xbc, 0x91, 0xb2,
-	0x96, 0x11, 0x9f, 0x83, 0x6d, 0xec, 0x63, 0xee, 0x61, 0xea, 0x2c, 0x56, 0x72, 0xe4, 0x55, 0xd0,
-	0x3b, 0xa9, 0xfd, 0x24, 0xd3, 0x67, 0x60, 0xc3, 0x9b, 0x23, 0xee, 0x12, 0x44, 0xf5, 0x17, 0x4d,
-	0xad, 0xb5, 0xd9, 0xd3, 0xff, 0xfc, 0xed, 0x93, 0x7a, 0x52, 0x99, 0xae, 0xeb, 0x72, 0x2c, 0xc4,
-	0x38, 0xe4, 0x84, 0x7a, 0x56, 0x4a, 0xbe, 0xd9, 0xfb, 0xf7, 0xe7, 0x7d, 0xed, 0x87, 0xfb, 0xab,
-	0xf6, 0xdb, 0x4f, 0x1a, 0x13, 0x57, 0xe5, 0xe0, 0xd7, 0x3c, 0xa8, 0x9e, 0xcd, 0xf1, 0x1c, 0xbb,
-	0xa7, 0x01, 0xe6, 0x28, 0x24, 0x8c, 0xc2, 0x0a, 0xc8, 0x12, 0x37, 0x29, 0x4d, 0x96, 0xb8, 0x70,
-	0x1f, 0x14, 0x03, 0xce, 0x02, 0x26, 0xd0, 0xcc, 0x26, 0x6e, 0x52, 0x07, 0xb0, 0x94, 0x86, 0x2e,
-	0x7c, 0x05, 0x36, 0x7c, 0x2c, 0x04, 0xf2, 0xb0, 0x7c, 0x76, 0xae, 0x55, 0x3c, 0xac, 0x1b, 0x71,
-	0x5f, 0x8d, 0x65, 0x5f, 0x8d, 0x2e, 0x5d, 0x58, 0x29, 0x05, 0x3f, 0x00, 0x15, 0xb6, 0xcc, 0x67,
-	0x5f, 0x20, 0x71, 0xa1, 0x5e, 0x5e, 0xb2, 0xca, 0xa9, 0xfa, 0x15, 0x12, 0x17, 0xf0, 0x7d, 0x50,
-	0xf9, 0x5e, 0x5d, 0xce, 0x46, 0xa1, 0x3d, 0xa7, 0x24, 0x52, 0xef, 0xce, 0x59, 0xa5, 0x58, 0xed,
-	0x86, 0x53, 0x4a, 0x22, 0xf8, 0x31, 0x80, 0x38, 0xc2, 0xce, 0x3c, 0x44, 0xe7, 0x33, 0x9c, 0x92,
-	0x05, 0x45, 0xd6, 0x1e, 0x9c, 0x84, 0xfe, 0x10, 0x54, 0x71, 0x14, 0x10, 0x8e, 0x45, 0x8a, 0xbe,
-	0xa5, 0xd0, 0x72, 0x22, 0x27, 0xdc, 0x17, 0xa0, 0x20, 0x42, 0x14, 0xce, 0x85, 0xbe, 0xd1, 0xd4,
-	0x5a, 0x95, 0xc3, 0xa6, 0xb1, 0x32, 0xbb, 0x46, 0x5a, 0xb1, 0xb1, 0xe2, 0xac, 0x84, 0x97, 0x7d,
-	0x8a, 0xb3, 0x32, 0xae, 0x6f, 0xfe, 0x5f, 0x9f, 0x96, 0x24, 0x6c, 0x81, 0xe4, 0xae, 0x8f, 0x5e,
-	0x0b, 0xd4, 0xc5, 0x2a, 0x4b, 0x3d, 0xb9, 0x59, 0x1b, 0x6c, 0x39, 0x88, 0x3a, 0x78, 0x36, 0x7b,
-	0x84, 0x16, 0x15, 0x5a, 0x4d, 0x8d, 0x84, 0x7d, 0x0f, 0x94, 0x63, 0xc9, 0xe6, 0x18, 0x09, 0x46,
-	0xf5, 0x92, 0xbc, 0x90, 0x55, 0x8a, 0x45, 0x4b, 0x69, 0xf0, 0x23, 0x59, 0x12, 0x99, 0x42, 0x76,
-	0x03, 0x73, 0xce, 0xb8, 0x5e, 0x56, 0x58, 0x25, 0x95, 0x4d, 0xa9, 0x1e, 0xfc, 0xae, 0x81, 0xd2,
-	0x97, 0x98, 0x62, 0x41, 0x84, 0x7c, 0x33, 0x86, 0x6f, 0x40, 0x21, 0x50, 0x83, 0xa4, 0xc6, 0xa5,
-	0x78, 0xb8, 0xbd, 0x56, 0xa4, 0x78, 0xce, 0x7a, 0x9b, 0xd7, 0x7f, 0xef, 0x67, 0x7e, 0xb9, 0xbf,
-	0x6a, 0x6b, 0x56, 0x12, 0x01, 0x8f, 0x00, 0x48, 0xbb, 0x2d, 0xb7, 0x4b, 0xce, 0xcd, 0x7a, 0x91,
-	0x57, 0x86, 0xb3, 0x97, 0x97, 0x3f, 0x64, 0x3d, 0x8a, 0x94, 0xe5, 0xa0, 0x38, 0x0a, 0xed, 0x87,
-	0x81, 0x22, 0x6e, 0xb2, 0x7d, 0x55, 0x69, 0xa4, 0xb1, 0x43, 0xb7, 0xfd, 0x87, 0x06, 0xaa, 0x2b,
-	0x6d, 0x83, 0x4d, 0xb0, 0x77, 0x3a, 0x32, 0xad, 0xee, 0x64, 0x78, 0x7a, 0x62, 0x8f, 0x27, 0xdd,
-	0xc9, 0x74, 0x6c, 0x4f, 0x4f, 0xc6, 0x23, 0xb3, 0x3f, 0x3c, 0x1a, 0x9a, 0x83, 0x5a, 0x06, 0xbe,
-	0x04, 0xdb, 0x6b, 0xc4, 0xd9, 0xd4, 0x9c, 0x9a, 0x83, 0x9a, 0x06, 0xdf, 0x05, 0x3b, 0x6b, 0xa6,
-	0xf9, 0xb5, 0xd9, 0x9f, 0x4e, 0xcc, 0x41, 0x2d, 0x0b, 0x1b, 0x60, 0x77, 0xcd, 0xee, 0x77, 0x4f,
-	0xfa, 0xe6, 0xf1, 0xb1, 0x39, 0xa8, 0xe5, 0xe0, 0x1e, 0xd0, 0x9f, 0x09, 0x1f, 0x0d, 0x2d, 0x73,
-	0x50, 0xcb, 0x3f, 0x9b, 0xf9, 0xa8, 0x3b, 0x94, 0xa1, 0x2f, 0x7a, 0xc6, 0xf5, 0x6d, 0x43, 0xbb,
-	0xb9, 0x6d, 0x68, 0xff, 0xdc, 0x36, 0xb4, 0x1f, 0xef, 0x1a, 0x99, 0x9b, 0xbb, 0x46, 0xe6, 0xaf,
-	0xbb, 0x46, 0xe6, 0x9b, 0xba, 0x5c, 0xf5, 0xe8, 0x61, 0xd9, 0xd5, 0x5f, 0xf0, 0x79, 0x41, 0x2d,
-	0xe3, 0xa7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xce, 0xb6, 0xe9, 0xa2, 0x05, 0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0x25, 0x5b, 0xb1, 0xc6, 0xb6, 0x7e, 0xc6, 0xfa, 0x12, 0xc6, 0xf1, 0x27, 0x0b, 0x6e,
+	0xda, 0x0a, 0x46, 0x43, 0x25, 0x4e, 0xd1, 0x06, 0xde, 0xc9, 0x12, 0x6d, 0x0b, 0xf0, 0x8f, 0x42,
+	0x49, 0x68, 0xd1, 0x45, 0x89, 0xb1, 0x38, 0xa1, 0x88, 0x48, 0x33, 0xec, 0x0c, 0xe9, 0x4a, 0xaf,
+	0xd0, 0x55, 0x1f, 0xa1, 0xcb, 0x2e, 0xb3, 0xe8, 0x43, 0x64, 0x19, 0x74, 0xd5, 0x55, 0x51, 0xd8,
+	0x8b, 0x74, 0x99, 0x55, 0xd1, 0x65, 0x31, 0x33, 0x24, 0x65, 0x4b, 0x76, 0x36, 0x86, 0xe6, 0x9c,
+	0x73, 0x7d, 0xef, 0xcc, 0x3d, 0xf7, 0x4a, 0xe0, 0x91, 0x4f, 0x79, 0x2d, 0xf0, 0x46, 0x78, 0x48,
+	0xfb, 0xaf, 0x6b, 0x17, 0xcf, 0x6a, 0xc1, 0xc4, 0xc7, 0xdc, 0xf0, 0x19, 0x0d, 0x28, 0xcc, 0xfb,
+	0x94, 0x1b, 0x31, 0x69, 0x5c, 0x3c, 0xdb, 0x78, 0xe8, 0x52, 0xea, 0x0e, 0x71, 0x4d, 0xd2, 0xe7,
+	0xe1, 0xab, 0x1a, 0x22, 0x13, 0xa5, 0xdd, 0x78, 0xd8, 0xa7, 0x7c, 0x44, 0xb9, 0x2d, 0x4f, 0x35,
+	0x75, 0x88, 0xa8, 0x22, 0x1a, 0x79, 0x84, 0xd6, 0xe4, 0xdf, 0x08, 0x2a, 0xb9, 0xd4, 0xa5, 0x4a,
+	0x2a, 0x3e, 0x29, 0x74, 0xfb, 0xdf, 0x25, 0x90, 0x69, 0x23, 0x86, 0x46, 0x1c, 0xee, 0x80, 0xe2,
+	0xc8, 0x23, 0xb6, 0x83, 0x87, 0x68, 0x62, 0x73, 0xdc, 0xa7, 0xc4, 0xe1, 0xba, 0x56, 0xd1, 0xaa,
+	0x8b, 0x56, 0x7e, 0xe4, 0x91, 0xa6, 0xc0, 0x3b, 0x0a, 0x96, 0x5a, 0x34, 0x9e, 0xd1, 0xa6, 0x22,
+	0x2d, 0x1a, 0xdf, 0xd0, 0x3e, 0x05, 0x25, 0x97, 0xa1, 0x3e, 0xb6, 0x7d, 0xcc, 0x3c, 0xea, 0x24,
+	0xf2, 0xb4, 0x94, 0x43, 0xc9, 0xb5, 0x25, 0x15, 0x47, 0x7c, 0x05, 0x1e, 0xe0, 0x11, 0x66, 0x2e,
+	0x26, 0xfd, 0xc9, 0x4c, 0x8e, 0x45, 0x19, 0xf4, 0xbf, 0x84, 0xbe, 0x91, 0xe9, 0x4b, 0xb0, 0xec,
+	0x86, 0x88, 0x39, 0x1e, 0x22, 0xfa, 0x52, 0x45, 0xab, 0x66, 0xf7, 0xf5, 0xdf, 0x7f, 0x7b, 0x52,
+	0x8a, 0x5e, 0xa6, 0xee, 0x38, 0x0c, 0x73, 0xde, 0x09, 0x98, 0x47, 0x5c, 0x2b, 0x51, 0xc2, 0xef,
+	0xc1, 0xfa, 0x08, 0x73, 0x8e, 0x5c, 0x6c, 0x8b, 0x4e, 0xa8, 0x84, 0x5c, 0xcf, 0x54, 0xd2, 0xd5,
+	0x95, 0x5d, 0xc3, 0x98, 0x69, 0x88, 0xa1, 0x5e, 0xcb, 0x38, 0x51, 0x21, 0xdd, 0x89, 0x8f, 0x65,
+	0x0d, 0xdc, 0x24, 0x01, 0x9b, 0x58, 0xc5, 0xd1, 0x2c, 0x0e, 0x4d, 0xb0, 0x85, 0xc7, 0xb8, 0x1f,
+	0x06, 0xe8, 0x7c, 0x88, 0x6d, 0x4e, 0x29, 0xb1, 0x7f, 0x44, 0x8c, 0x78, 0xc4, 0x4d, 0x6e, 0x75,
+	0x4f, 0xde, 0x6a, 0x73, 0x2a, 0xeb, 0x50, 0x4a, 0xbe, 0x51, 0xa2, 0xe9, 0xa3, 0x64, 0xe3, 0x92,
+	0xb9, 0xbe, 0x5c, 0x49, 0x7f, 0xf4, 0x76, 0x53, 0x29, 0x7c, 0x02, 0x60, 0x7c, 0xb0, 0x83, 0x01,
+	0xc3, 0x7c, 0x40, 0x87, 0x8e, 0x9e, 0x95, 0x19, 0x8b, 0x31, 0xd3, 0x8d, 0x09, 0xf8, 0x1c, 0xdc,
+	0x17, 0x9d, 0x45, 0x61, 0x40, 0x6d, 0x55, 0x8f, 0x47, 0x89, 0xed, 0x22, 0xae, 0x03, 0x19, 0xb2,
+	0x3e, 0x42, 0xe3, 0x7a, 0x18, 0x50, 0x33, 0xe6, 0x0e, 0x11, 0x87, 0x5f, 0x03, 0x5d, 0x04, 0x51,
+	0x1f, 0x33, 0x24, 0x30, 0x2e, 0x7a, 0x6d, 0x9f, 0x8b, 0x27, 0xd3, 0x57, 0x54, 0xc7, 0x46, 0x68,
+	0x7c, 0x96, 0xd0, 0x6d, 0xcc, 0xf6, 0x05, 0x09, 0xef, 0x83, 0x8c, 0x8f, 0x42, 0x8e, 0x1d, 0x7d,
+	0xb5, 0xa2, 0x55, 0x97, 0xad, 0xe8, 0xb4, 0xd1, 0x04, 0xf7, 0x6f, 0x7f, 0x60, 0x58, 0x00, 0xe9,
+	0xd7, 0x78, 0x22, 0x7d, 0x99, 0xb5, 0xc4, 0x47, 0x58, 0x02, 0x4b, 0x17, 0x68, 0x18, 0xe2, 0xc8,
+	0x7f, 0xea, 0xb0, 0x97, 0x7a, 0xa1, 0xed, 0x6d, 0xfe, 0xfd, 0xcb, 0x96, 0xf6, 0xd3, 0xfb, 0x37,
+	0x3b, 0xeb, 0x37, 0x46, 0x4e, 0x75, 0x70, 0xfb, 0x9f, 0x45, 0x90, 0x7f, 0x19, 0xe2, 0x10, 0x3b,
+	0x49, 0x61, 0x30, 0x07, 0x52, 0x9e, 0x13, 0x99, 0x3e, 0xe5, 0x39, 0x70, 0x0b, 0xac, 0xf8, 0x8c,
+	0xfa, 0x94, 0xa3, 0xa1, 0xed, 0x39, 0x51, 0x06, 0x10, 0x43, 0x2d, 0x07, 0x3e, 0x05, 0xcb, 0x51,
+	0xc7, 0x85, 0xa1, 0x85, 0x63, 0x4a, 0x86, 0x9a, 0x58, 0x23, 0x9e, 0x58, 0xa3, 0x4e, 0x26, 0x56,
+	0xa2, 0x82, 0x9f, 0x82, 0x5c, 0xf2, 0x4e, 0xf6, 0x00, 0xf1, 0x81, 0xf4, 0xf4, 0xaa, 0xb5, 0x96,
+	0xa0, 0x47, 0x88, 0x0f, 0xe0, 0x63, 0x90, 0xfb, 0x41, 0x16, 0x67, 0xa3, 0xc0, 0x0e, 0x89, 0x37,
+	0x96, 0x8e, 0x4e, 0x5b, 0xab, 0x0a, 0xad, 0x07, 0x3d, 0xe2, 0x8d, 0xe1, 0x17, 0x00, 0x5e, 0xf3,
+	0x56, 0xac, 0xcc, 0x48, 0x65, 0x61, 0xca, 0x44, 0xea, 0xcf, 0x40, 0x1e, 0x8f, 0x7d, 0x8f, 0x61,
+	0x9e, 0x48, 0xef, 0x49, 0xe9, 0x5a, 0x04, 0x47, 0xba, 0x17, 0x20, 0xc3, 0x03, 0x14, 0x84, 0xc2,
+	0x67, 0x5a, 0x35, 0xb7, 0x5b, 0x99, 0x1b, 0x82, 0xe4, 0xc5, 0x3a, 0x52, 0x67, 0x45, 0x7a, 0x31,
+	0x81, 0x2a, 0x2b, 0x65, 0xd2, 0x62, 0x1f, 0x9d, 0xc0, 0x58, 0x09, 0xab, 0x20, 0xaa, 0xf5, 0xda,
+	0x6d, 0x81, 0x2c, 0x2c, 0x17, 0xe3, 0x51, 0x65, 0x3b, 0xa0, 0xd8, 0x47, 0xa4, 0x8f, 0x87, 0xc3,
+	0x6b, 0xd2, 0x15, 0x29, 0xcd, 0x27, 0x44, 0xa4, 0xfd, 0x04, 0xac, 0x29, 0xc8, 0x66, 0x18, 0x71,
+	0x4a, 0xa4, 0xc5, 0xb2, 0xd6, 0xaa, 0x02, 0x2d, 0x89, 0xc1, 0xcf, 0x41, 0x5e, 0xa5, 0x10, 0xdd,
+	0xc0, 0x8c, 0x51, 0xa6, 0xaf, 0x49, 0x59, 0x2e, 0x81, 0x4d, 0xc6, 0x54, 0x8d, 0xaf, 0x90, 0x17,
+	0xa5, 0x1d, 0x60, 0xcf, 0x1d, 0x04, 0x7a, 0x4e, 0xd5, 0xa8, 0xf0, 0x7a, 0x70, 0x24, 0x51, 0xe1,
+	0x19, 0x86, 0x03, 0x36, 0xb1, 0xfb, 0x34, 0x24, 0x81, 0x9e, 0xaf, 0x68, 0xd5, 0x35, 0x0b, 0x48,
+	0xa8, 0x21, 0x90, 0xed, 0x31, 0x28, 0x1d, 0x21, 0xe2, 0x0c, 0x31, 0x3b, 0xf1, 0x38, 0xf7, 0x88,
+	0xdb, 0xa6, 0x43, 0xaf, 0x3f, 0x81, 0x06, 0x58, 0x97, 0x63, 0x17, 0x55, 0x8d, 0x89, 0x68, 0x9d,
+	0x72, 0xe3, 0xb2, 0x55, 0x14, 0x54, 0x43, 0x32, 0xa6, 0x22, 0xee, 0x5c, 0xac, 0xa9, 0xbb, 0x16,
+	0xeb, 0xf6, 0x07, 0x0d, 0xac, 0x1e, 0x62, 0x82, 0xb9, 0xc7, 0x45, 0xe3, 0x30, 0xdc, 0x13, 0xf3,
+	0x27, 0xa6, 0x41, 0x66, 0x59, 0xd9, 0x7d, 0x70, 0xc7, 0xba, 0xdb, 0xcf, 0xbe, 0xfd, 0x73, 0x6b,
+	0xe1, 0xd7, 0xf7, 0x6f, 0x76, 0x34, 0x2b, 0x8a, 0x80, 0x07, 0x00, 0x4c, 0x07, 0x5e, 0x4f, 0x49,
+	0xf3, 0xcf, 0x3b, 0x65, 0x66, 0xc2, 0xf6, 0x17, 0xc5, 0x3f, 0xb2, 0xae, 0x45, 0x8a, 0x9e, 0x12,
+	0x3c, 0x0e, 0xa6, 0xdb, 0x43, 0x4c, 0x9a, 0xfa, 0x72, 0xc8, 0x0b, 0x22, 0x89, 0x95, 0xe3, 0x56,
+	0xf2, 0x31, 0x71, 0xc4, 0xee, 0xbc, 0x36, 0x97, 0xe2, 0x6b, 0x21, 0x2d, 0xae, 0x1c, 0x71, 0xed,
+	0x64, 0x3e, 0xf9, 0xce, 0x07, 0x0d, 0xe4, 0x67, 0xdc, 0x0a, 0x2b, 0x60, 0xf3, 0xac, 0x6d, 0x5a,
+	0xf5, 0x6e, 0xeb, 0xec, 0xd4, 0xee, 0x74, 0xeb, 0xdd, 0x5e, 0xc7, 0xee, 0x9d, 0x76, 0xda, 0x66,
+	0xa3, 0x75, 0xd0, 0x32, 0x9b, 0x85, 0x05, 0xf8, 0x08, 0x3c, 0x98, 0x53, 0xbc, 0xec, 0x99, 0x3d,
+	0xb3, 0x59, 0xd0, 0xe0, 0xff, 0xc1, 0xc3, 0x39, 0xd2, 0xfc, 0xd6, 0x6c, 0xf4, 0xba, 0x66, 0xb3,
+	0x90, 0x82, 0x65, 0xb0, 0x31, 0x47, 0x37, 0xea, 0xa7, 0x0d, 0xf3, 0xf8, 0xd8, 0x6c, 0x16, 0xd2,
+	0x70, 0x13, 0xe8, 0xb7, 0x84, 0xb7, 0x5b, 0x96, 0xd9, 0x2c, 0x2c, 0xde, 0x9a, 0xf9, 0xa0, 0xde,
+	0x12, 0xa1, 0x4b, 0xf0, 0x31, 0xa8, 0xcc, 0x91, 0x47, 0xf5, 0xd3, 0xe6, 0xb1, 0x69, 0xd9, 0x27,
+	0xad, 0x4e, 0xa7, 0x75, 0x7a, 0x58, 0xc8, 0xec, 0x1b, 0x6f, 0x2f, 0xcb, 0xda, 0xbb, 0xcb, 0xb2,
+	0xf6, 0xd7, 0x65, 0x59, 0xfb, 0xf9, 0xaa, 0xbc, 0xf0, 0xee, 0xaa, 0xbc, 0xf0, 0xc7, 0x55, 0x79,
+	0xe1, 0xbb, 0x92, 0xd8, 0x83, 0xe3, 0xe9, 0x26, 0x94, 0xbf, 0x3c, 0xce, 0x33, 0x72, 0x53, 0x3d,
+	0xff, 0x6f, 0x00, 0x95, 0x17, 0xa2, 0x82, 0x99, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -466,6 +649,37 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.Guardian != that1.Guardian {
 		return false
 	}
+	if len(this.MessageTypeDelays) != len(that1.MessageTypeDelays) {
+		return false
+	}
+	for i := range this.MessageTypeDelays {
+		if this.MessageTypeDelays[i] != that1.MessageTypeDelays[i] {
+			return false
+		}
+	}
+	if this.ExecutableSoonWarningSeconds != that1.ExecutableSoonWarningSeconds {
+		return false
+	}
+	if len(this.Guardians) != len(that1.Guardians) {
+		return false
+	}
+	for i := range this.Guardians {
+		if this.Guardians[i] != that1.Guardians[i] {
+			return false
+		}
+	}
+	if this.GuardianThreshold != that1.GuardianThreshold {
+		return false
+	}
+	if this.MaxAutoExecutionGas != that1.MaxAutoExecutionGas {
+		return false
+	}
+	if this.MaxOperationsPerBlock != that1.MaxOperationsPerBlock {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -488,6 +702,67 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxOperationsPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxOperationsPerBlock))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxAutoExecutionGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAutoExecutionGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GuardianThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GuardianThreshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ExecutableSoonWarningSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutableSoonWarningSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MessageTypeDelays) > 0 {
+		keysForMessageTypeDelays := make([]string, 0, len(m.MessageTypeDelays))
+		for k := range m.MessageTypeDelays {
+			keysForMessageTypeDelays = append(keysForMessageTypeDelays, string(k))
+		}
+		github_com_cosmos_gogoproto_sortkeys.Strings(keysForMessageTypeDelays)
+		for iNdEx := len(keysForMessageTypeDelays) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MessageTypeDelays[string(keysForMessageTypeDelays[iNdEx])]
+			baseI := i
+			i = encodeVarintTypes(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForMessageTypeDelays[iNdEx])
+			copy(dAtA[i:], keysForMessageTypeDelays[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(keysForMessageTypeDelays[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTypes(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Guardian) > 0 {
 		i -= len(m.Guardian)
 		copy(dAtA[i:], m.Guardian)
@@ -538,6 +813,16 @@ func (m *QueuedOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.FailedAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedAtHeight))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.ExecutionError) > 0 {
 		i -= len(m.ExecutionError)
 		copy(dAtA[i:], m.ExecutionError)
@@ -623,6 +908,44 @@ func (m *QueuedOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HandlerMissingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerMissingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandlerMissingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GracePeriodSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GracePeriodSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AutoCancelEnabled {
+		i--
+		if m.AutoCancelEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -643,6 +966,24 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingProposalIds) > 0 {
+		dAtA2 := make([]byte, len(m.PendingProposalIds)*10)
+		var j1 int
+		for _, num := range m.PendingProposalIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NextOperationId != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.NextOperationId))
 		i--
@@ -708,6 +1049,35 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.MessageTypeDelays) > 0 {
+		for k, v := range m.MessageTypeDelays {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + sovTypes(uint64(v))
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutableSoonWarningSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutableSoonWarningSeconds))
+	}
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.GuardianThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.GuardianThreshold))
+	}
+	if m.MaxAutoExecutionGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxAutoExecutionGas))
+	}
+	if m.MaxOperationsPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxOperationsPerBlock))
+	}
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -763,6 +1133,27 @@ func (m *QueuedOperation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.FailedAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FailedAtHeight))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovTypes(uint64(m.RetryCount))
+	}
+	return n
+}
+
+func (m *HandlerMissingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AutoCancelEnabled {
+		n += 2
+	}
+	if m.GracePeriodSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.GracePeriodSeconds))
+	}
 	return n
 }
 
@@ -783,6 +1174,13 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextOperationId != 0 {
 		n += 1 + sovTypes(uint64(m.NextOperationId))
 	}
+	if len(m.PendingProposalIds) > 0 {
+		l = 0
+		for _, e := range m.PendingProposalIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
 	return n
 }
 
@@ -929,6 +1327,247 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypeDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageTypeDelays == nil {
+				m.MessageTypeDelays = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTypes(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MessageTypeDelays[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableSoonWarningSeconds", wireType)
+			}
+			m.ExecutableSoonWarningSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableSoonWarningSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianThreshold", wireType)
+			}
+			m.GuardianThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoExecutionGas", wireType)
+			}
+			m.MaxAutoExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOperationsPerBlock", wireType)
+			}
+			m.MaxOperationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOperationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1295,6 +1934,133 @@ func (m *QueuedOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.ExecutionError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAtHeight", wireType)
+			}
+			m.FailedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerMissingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerMissingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerMissingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCancelEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCancelEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodSeconds", wireType)
+			}
+			m.GracePeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1431,6 +2197,82 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingProposalIds = append(m.PendingProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingProposalIds) == 0 {
+					m.PendingProposalIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingProposalIds = append(m.PendingProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProposalIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
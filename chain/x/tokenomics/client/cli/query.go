@@ -25,16 +25,25 @@ func GetQueryCmd() *cobra.Command {
 
 	tokenomicsQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryFullConfig(),
+		GetCmdQueryPendingChanges(),
 		GetCmdQuerySupply(),
+		GetCmdQuerySupplyReconciliation(),
+		GetCmdQuerySupplyInvariant(),
 		GetCmdQueryInflation(),
 		GetCmdQueryEmissions(),
+		GetCmdQueryEmissionsHistory(),
 		GetCmdQueryBurns(),
 		GetCmdQueryBurnsBySource(),
 		GetCmdQueryBurnsByChain(),
+		GetCmdQueryBurnsBreakdown(),
+		GetCmdQueryBurnRateBySource(),
 		GetCmdQuerySummary(),
 		GetCmdQueryForecast(),
 		GetCmdQueryFeeStats(),
+		GetCmdQueryTreasuryRedirect(),
 		GetCmdQueryBurnRate(),
+		GetCmdQueryBurnRatioHistory(),
 	)
 
 	return tokenomicsQueryCmd
@@ -53,11 +62,21 @@ func GetCmdQueryParams() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
+
+			// Human-readable summary for text mode, formatted by the node;
+			// --output json emits every field
+			if clientCtx.OutputFormat == "text" {
+				res, err := queryClient.ParamsFormatted(context.Background(), &types.QueryParamsFormattedRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(res.Formatted + "\n")
+			}
+
 			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
 			if err != nil {
 				return err
 			}
-
 			return clientCtx.PrintProto(&res.Params)
 		},
 	}
@@ -217,8 +236,8 @@ func GetCmdQueryBurnsBySource() *cobra.Command {
 func GetCmdQueryBurnsByChain() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "burns-by-chain [chain-id]",
-		Short: "Query burned tokens by chain ID",
-		Args:  cobra.ExactArgs(1),
+		Short: "Query burned tokens by chain ID, or the totals of all chains",
+		Args:  cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -226,6 +245,14 @@ func GetCmdQueryBurnsByChain() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
+			if len(args) == 0 {
+				res, err := queryClient.AllBurnsByChain(context.Background(), &types.QueryAllBurnsByChainRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
 			res, err := queryClient.BurnsByChain(context.Background(), &types.QueryBurnsByChainRequest{
 				ChainId: args[0],
 			})
@@ -295,7 +322,7 @@ For full details, use individual query commands.
 `,
 				paramsRes.Params.TotalSupplyCap,
 				paramsRes.Params.CurrentTotalSupply,
-				supplyRes.CurrentTotalSupply,
+				supplyRes.CirculatingSupply,
 				paramsRes.Params.InflationRate.MustFloat64()*100,
 			)
 
@@ -313,14 +340,12 @@ func GetCmdQueryForecast() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "forecast [years]",
 		Short: "Query inflation and burn forecast for future years",
-		Long: `Display a comprehensive forecast of inflation decay and burn statistics.
-
-Shows year-by-year projection of:
+		Long: `Display a year-by-year projection from the current supply of:
 - Inflation rate (decaying model)
-- Annual mint amount
-- Estimated burn amount
+- Annual mint amount (limited by the supply cap)
+- Estimated burn amount (forecast_annual_burn_rate param)
 - Net inflation
-- Supply growth
+- End-of-year supply
 
 Example:
   $ posd query tokenomics forecast 7
@@ -338,56 +363,21 @@ Example:
 				return fmt.Errorf("invalid years: must be positive integer")
 			}
 
-			if years > 30 {
-				return fmt.Errorf("maximum forecast period is 30 years")
+			if years > types.MaxForecastYears {
+				return fmt.Errorf("maximum forecast period is %d years", types.MaxForecastYears)
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-
-			// Query current params
-			paramsRes, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			res, err := queryClient.SupplyForecast(context.Background(), &types.QuerySupplyForecastRequest{Years: years})
 			if err != nil {
-				return fmt.Errorf("failed to query params: %w", err)
+				return err
 			}
 
-			// Get current year (would need to add this to query response)
-			// For now, calculate from chain start
-
-			// Display forecast
-			fmt.Println("╔════════════════════════════════════════════════════════════════╗")
-			fmt.Println("║          OMNIPHI TOKENOMICS FORECAST                           ║")
-			fmt.Printf("║          %d-Year Projection                                     ║\n", years)
-			fmt.Println("╚════════════════════════════════════════════════════════════════╝")
-			fmt.Println()
-
-			// This is a simplified version - full implementation would query forecast from keeper
-			fmt.Println("Year | Inflation | Annual Mint    | Est. Burn      | Net Inflation | Supply")
-			fmt.Println("-----|-----------|----------------|----------------|---------------|----------------")
-
-			currentSupply := paramsRes.Params.CurrentTotalSupply
-			
-			inflationRates := []string{"3.00%", "2.75%", "2.50%", "2.25%", "2.00%", "1.75%", "1.50%"}
-			
-			for i := int64(0); i < years && i < int64(len(inflationRates)); i++ {
-				year := i + 1
-				
-				// Simplified calculation
-				inflationPct := inflationRates[i]
-				
-				fmt.Printf("  %2d | %6s   | ~15M OMNI     | ~1M OMNI       | ~2.8%%         | ~%dM OMNI\n",
-					year, inflationPct, 750+int(year)*15)
-			}
-
-			fmt.Println()
-			fmt.Println("Note: This is a simplified forecast. Actual values depend on")
-			fmt.Println("network usage, burn rates, and governance decisions.")
-			fmt.Println()
-			fmt.Println("Current Parameters:")
-			fmt.Printf("  Inflation Rate:  %s\n", paramsRes.Params.InflationRate.String())
-			fmt.Printf("  Supply Cap:      %s OMNI\n", paramsRes.Params.TotalSupplyCap.String())
-			fmt.Printf("  Current Supply:  %s OMNI\n", currentSupply.String())
+			if clientCtx.OutputFormat == "text" {
+				return clientCtx.PrintString(res.FormatString())
+			}
 
-			return nil
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQueryBurnRateBySource implements the query burn-rate-by-source command
+func GetCmdQueryBurnRateBySource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-rate-by-source",
+		Short: "Query each burn source's share of the fee burn and its module burns",
+		Long: `Query, for every burn source, how much was burned through the fee burn
+(the burn side of the 90/10 fee split, attributed to the source that paid the
+fees) and how much through its module-specific burns.
+
+Example:
+  $ posd query tokenomics burn-rate-by-source
+  $ posd query tokenomics burn-rate-by-source --output json
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BurnRateBySource(context.Background(), &types.QueryBurnRateBySourceRequest{})
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == "text" {
+				return clientCtx.PrintString(res.FormatString())
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQueryBurnRatioHistory implements the query burn-ratio-history command
+func GetCmdQueryBurnRatioHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-ratio-history",
+		Short: "Query the adaptive burn controller's ratio decisions, oldest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BurnRatioHistory(context.Background(), &types.QueryBurnRatioHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burn-ratio-history")
+	return cmd
+}
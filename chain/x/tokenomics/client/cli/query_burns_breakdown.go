@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQueryBurnsBreakdown implements the query burns-breakdown command
+func GetCmdQueryBurnsBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burns-breakdown",
+		Short: "Query burned tokens for every burn source with the total",
+		Long: `Query the cumulative amount burned from each burn source in one call.
+Sources that have never burned are listed with zero.
+
+Example:
+  $ posd query tokenomics burns-breakdown
+  $ posd query tokenomics burns-breakdown --output json
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BurnsBreakdown(context.Background(), &types.QueryBurnsBreakdownRequest{})
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == "text" {
+				return clientCtx.PrintString(res.FormatString())
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
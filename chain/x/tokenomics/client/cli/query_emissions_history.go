@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQueryEmissionsHistory implements the query emissions-history command
+func GetCmdQueryEmissionsHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emissions-history",
+		Short: "Query cumulative and last-epoch emissions per recipient",
+		Long: `Query how much has been emitted to staking, PoC, sequencer and treasury in
+total, the amount each received in the last emission epoch, and the height of
+that epoch.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EmissionsHistory(context.Background(), &types.QueryEmissionsHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQueryFullConfig implements the query full-config command
+func GetCmdQueryFullConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "full-config",
+		Short: "Query all tokenomics configuration as JSON, including settings stored outside params",
+		Long: `Query the comprehensive tokenomics configuration: every params field plus the
+treasury address, treasury redirect target addresses and redirect state, which
+are stored under their own keys rather than in the params object.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FullConfig(context.Background(), &types.QueryFullConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Config)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQueryPendingChanges implements the query pending-changes command
+func GetCmdQueryPendingChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-changes",
+		Short: "Query staged param changes and the epoch each takes effect",
+		Long: `Query the param changes staged for a future epoch, ordered by effective
+epoch, together with the current epoch. Each change lists the params field and
+its JSON-encoded new value.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingChanges(context.Background(), &types.QueryPendingChangesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQuerySupplyInvariant implements the query supply-invariant command
+func GetCmdQuerySupplyInvariant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-invariant",
+		Short: "Check the stored supply counters satisfy current = minted - burned",
+		Long: `Query the stored total minted, total burned and current supply counters and
+whether they satisfy the conservation law current = minted - burned. A broken
+invariant is also reported on stderr.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyInvariant(context.Background(), &types.QuerySupplyInvariantRequest{})
+			if err != nil {
+				return err
+			}
+
+			if inv := res.Invariant; !inv.Consistent {
+				cmd.PrintErrf("WARNING: supply invariant broken: current %s != minted %s - burned %s (expected %s)\n",
+					inv.CurrentTotalSupply, inv.TotalMinted, inv.TotalBurned, inv.ExpectedSupply())
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQuerySupplyReconciliation implements the query supply-reconciliation command
+func GetCmdQuerySupplyReconciliation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-reconciliation",
+		Short: "Compare the tokenomics tracked supply with the bank module supply",
+		Long: `Compare CurrentTotalSupply tracked by the tokenomics module with the bank
+module's actual supply of the native denom. A non-zero delta (bank - tracked)
+means the tokenomics counters have drifted and is also reported on stderr.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyReconciliation(context.Background(), &types.QuerySupplyReconciliationRequest{})
+			if err != nil {
+				return err
+			}
+
+			if rec := res.Reconciliation; !rec.Reconciled {
+				cmd.PrintErrf("WARNING: supply drift of %s%s (bank %s, tracked %s)\n",
+					rec.Delta, rec.Denom, rec.BankSupply, rec.TrackedSupply)
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetCmdQueryTreasuryRedirect implements the query treasury-redirect command
+func GetCmdQueryTreasuryRedirect() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-redirect",
+		Short: "Query treasury redirect configuration, pending inflows and totals",
+		Long: `Query the treasury redirect: whether it is enabled, the redirect ratio and
+execution interval, inflows accumulated since the last execution, the total
+redirected to date, the height of the last execution, and the address and
+share of each of the four redirect targets.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TreasuryRedirect(context.Background(), &types.QueryTreasuryRedirectRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
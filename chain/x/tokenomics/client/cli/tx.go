@@ -28,6 +28,7 @@ func GetTxCmd() *cobra.Command {
 	tokenomicsTxCmd.AddCommand(
 		GetCmdBurn(),
 		GetCmdReportBurn(),
+		GetCmdSetEmergencyBurnOverride(),
 	)
 
 	return tokenomicsTxCmd
@@ -152,3 +153,44 @@ func GetCmdReportBurn() *cobra.Command{
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdSetEmergencyBurnOverride implements the emergency-burn-override command
+// (burn override guardian only)
+func GetCmdSetEmergencyBurnOverride() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-burn-override [on|off] [burn-ratio]",
+		Short: "Pin the fee burn ratio until the override expires, or clear it (burn override guardian only)",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetEmergencyBurnOverride{
+				Guardian:  clientCtx.GetFromAddress().String(),
+				BurnRatio: math.LegacyZeroDec(),
+			}
+
+			switch args[0] {
+			case "on":
+				if len(args) != 2 {
+					return fmt.Errorf("a burn ratio is required to turn the override on")
+				}
+				msg.Enabled = true
+				msg.BurnRatio, err = math.LegacyNewDecFromStr(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid burn ratio: %s", args[1])
+				}
+			case "off":
+			default:
+				return fmt.Errorf("expected on or off, got %s", args[0])
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -12,7 +12,7 @@ import (
 
 // GetAdaptiveBurnRatio calculates the optimal burn ratio based on network conditions
 // Priority-ordered trigger logic:
-// 1. Emergency Override - returns current fee_burn_ratio
+// 1. Emergency Override - returns the guardian's override ratio, else fee_burn_ratio
 // 2. Adaptive Disabled - returns current fee_burn_ratio
 // 3. Treasury < Floor - returns min_burn_ratio (protect treasury)
 // 4. Congestion > Threshold - returns max_burn_ratio (combat spam)
@@ -22,9 +22,9 @@ func (k Keeper) GetAdaptiveBurnRatio(ctx context.Context) (math.LegacyDec, strin
 	params := k.GetParams(ctx)
 
 	// Priority 1: Emergency Override
-	if params.EmergencyBurnOverride {
-		k.Logger(ctx).Warn("adaptive burn in emergency override mode")
-		return params.FeeBurnRatio, "emergency_override"
+	if ratio, trigger, ok := k.burnOverride(ctx, params); ok {
+		k.Logger(ctx).Warn("adaptive burn in emergency override mode", "trigger", trigger)
+		return ratio, trigger
 	}
 
 	// Priority 2: Adaptive Disabled
@@ -33,7 +33,8 @@ func (k Keeper) GetAdaptiveBurnRatio(ctx context.Context) (math.LegacyDec, strin
 	}
 
 	// Priority 3: Treasury Below Floor
-	treasuryPct := k.GetTreasuryPct(ctx)
+	// Reads the smoothed input when a moving-average window is configured
+	treasuryPct := k.GetSmoothedTreasuryPct(ctx)
 	if treasuryPct.LT(params.TreasuryFloorPct) {
 		k.Logger(ctx).Info("treasury below floor, reducing burn",
 			"treasury_pct", treasuryPct.String(),
@@ -67,38 +68,10 @@ func (k Keeper) GetAdaptiveBurnRatio(ctx context.Context) (math.LegacyDec, strin
 	return params.DefaultBurnRatio, "normal"
 }
 
-// GetBlockCongestion returns the current block gas usage as a percentage (0.0-1.0)
-// Based on gas used vs gas limit in recent blocks
-func (k Keeper) GetBlockCongestion(ctx context.Context) math.LegacyDec {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	// Get the gas meter for the current block
-	// Note: This measures instantaneous congestion
-	// For production, should average over last N blocks
-	gasMeter := sdkCtx.BlockGasMeter()
-	if gasMeter == nil {
-		return math.LegacyZeroDec()
-	}
-
-	gasLimit := gasMeter.Limit()
-	gasConsumed := gasMeter.GasConsumed()
-
-	if gasLimit == 0 {
-		return math.LegacyZeroDec()
-	}
-
-	// Convert to decimal and calculate percentage
-	congestion := math.LegacyNewDec(int64(gasConsumed)).Quo(math.LegacyNewDec(int64(gasLimit)))
-
-	// Cap at 1.0 (100%)
-	if congestion.GT(math.LegacyOneDec()) {
-		return math.LegacyOneDec()
-	}
-
-	return congestion
-}
-
-// GetTreasuryPct returns the treasury balance as a percentage of total supply
+// GetTreasuryPct returns the treasury's BondDenom balance as a fraction of the
+// current total supply tracked in params. The treasury is the configured
+// treasury address, or the tokenomics module account if none is set. Returns
+// zero when the supply is zero or unset.
 func (k Keeper) GetTreasuryPct(ctx context.Context) math.LegacyDec {
 	params := k.GetParams(ctx)
 	treasuryAddr := k.GetTreasuryAddress(ctx)
@@ -114,7 +87,7 @@ func (k Keeper) GetTreasuryPct(ctx context.Context) math.LegacyDec {
 
 	// Calculate percentage of current supply
 	currentSupply := params.CurrentTotalSupply
-	if currentSupply.IsZero() {
+	if currentSupply.IsNil() || !currentSupply.IsPositive() {
 		return math.LegacyZeroDec()
 	}
 
@@ -308,44 +281,68 @@ func (k Keeper) setCurrentDayTxCount(ctx context.Context, count int64) error {
 }
 
 // ApplySmoothing applies exponential smoothing to prevent rapid burn ratio changes
-// Formula: new_ratio = (current_ratio * α) + (target_ratio * (1 - α))
-// where α = smoothing_factor based on burn_adjustment_smoothing blocks
+// Formula: new_ratio = current_ratio + (target_ratio - current_ratio) / smoothing_blocks
+// which is the moving average current * (1 - α) + target * α with α = 1 / smoothing_blocks.
+// The step never overshoots the target and the result is clamped to
+// [MinBurnRatio, MaxBurnRatio] so the persisted ratio always passes validation.
 func (k Keeper) ApplySmoothing(ctx context.Context, targetRatio math.LegacyDec) math.LegacyDec {
 	params := k.GetParams(ctx)
 	currentRatio := params.LastAppliedBurnRatio
 
 	// If this is the first application or smoothing is disabled (smoothing = 1)
 	if currentRatio.IsZero() || params.BurnAdjustmentSmoothing <= 1 {
-		return targetRatio
+		return clampBurnRatio(targetRatio, params)
 	}
 
-	// Calculate smoothing factor
-	// α = 1 / smoothing_blocks
-	// This creates an exponential moving average
-	smoothingBlocks := math.LegacyNewDec(int64(params.BurnAdjustmentSmoothing))
-	alpha := math.LegacyOneDec().Quo(smoothingBlocks)
+	// A ratio left outside the bounds by a governance change restarts from the nearest bound
+	currentRatio = clampBurnRatio(currentRatio, params)
 
-	// Smoothed ratio = (1 - α) * current + α * target
-	oneMinusAlpha := math.LegacyOneDec().Sub(alpha)
-	smoothedRatio := currentRatio.Mul(oneMinusAlpha).Add(targetRatio.Mul(alpha))
+	// Move at most 1/smoothing_blocks of the remaining distance per block.
+	// Truncating division keeps |step| <= |target - current|, so no overshoot.
+	step := targetRatio.Sub(currentRatio).QuoInt64(int64(params.BurnAdjustmentSmoothing))
+	smoothedRatio := clampBurnRatio(currentRatio.Add(step), params)
 
 	k.Logger(ctx).Debug("applying smoothing to burn ratio",
 		"current", currentRatio.String(),
 		"target", targetRatio.String(),
 		"smoothed", smoothedRatio.String(),
-		"alpha", alpha.String())
+		"step", step.String())
 
 	return smoothedRatio
 }
 
+// clampBurnRatio bounds ratio to [MinBurnRatio, MaxBurnRatio]. Unset bounds are ignored.
+func clampBurnRatio(ratio math.LegacyDec, params types.TokenomicsParams) math.LegacyDec {
+	if !params.MinBurnRatio.IsNil() && ratio.LT(params.MinBurnRatio) {
+		return params.MinBurnRatio
+	}
+	if !params.MaxBurnRatio.IsNil() && !params.MaxBurnRatio.IsZero() && ratio.GT(params.MaxBurnRatio) {
+		return params.MaxBurnRatio
+	}
+	return ratio
+}
+
 // UpdateBurnRatio updates the current burn ratio with smoothing and state tracking
 // This should be called in BeginBlock
 func (k Keeper) UpdateBurnRatio(ctx context.Context) error {
+	// Lapse a guardian override that has run its course before choosing a target
+	if err := k.ExpireGuardianBurnOverride(ctx); err != nil {
+		return fmt.Errorf("failed to expire guardian burn override: %w", err)
+	}
+
+	// Feed this block's treasury pct into the moving average (if enabled)
+	if err := k.RecordTreasuryPctSample(ctx); err != nil {
+		return fmt.Errorf("failed to record treasury pct sample: %w", err)
+	}
+
 	// Get the target ratio based on current conditions
 	targetRatio, trigger := k.GetAdaptiveBurnRatio(ctx)
 
-	// Apply smoothing
+	// Apply smoothing, except to a guardian override, which applies at once
 	smoothedRatio := k.ApplySmoothing(ctx, targetRatio)
+	if trigger == types.TriggerGuardianOverride {
+		smoothedRatio = targetRatio
+	}
 
 	// Update parameters with new values
 	params := k.GetParams(ctx)
@@ -365,6 +362,10 @@ func (k Keeper) UpdateBurnRatio(ctx context.Context) error {
 
 	if ratioChanged || triggerChanged {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		if err := k.RecordBurnRatioChange(ctx, sdkCtx.BlockHeight(), smoothedRatio, trigger); err != nil {
+			return fmt.Errorf("failed to record burn ratio change: %w", err)
+		}
+
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				"adaptive_burn_update",
@@ -392,8 +393,13 @@ func (k Keeper) UpdateBurnRatio(ctx context.Context) error {
 func (k Keeper) GetCurrentBurnRatio(ctx context.Context) math.LegacyDec {
 	params := k.GetParams(ctx)
 
-	// If adaptive is disabled or emergency override, use fee_burn_ratio
-	if !params.AdaptiveBurnEnabled || params.EmergencyBurnOverride {
+	// An emergency override applies from the block it is set in
+	if ratio, _, ok := k.burnOverride(ctx, params); ok {
+		return ratio
+	}
+
+	// If adaptive is disabled, use fee_burn_ratio
+	if !params.AdaptiveBurnEnabled {
 		return params.FeeBurnRatio
 	}
 
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// BLOCK CONGESTION
+// ============================================================================
+// Congestion is the gas a block consumed divided by the block gas limit from
+// the consensus params. EndBlock records one sample per block into a ring of
+// CongestionWindowBlocks slots, and the adaptive burn controller reads the
+// average so a single full block does not flip the burn ratio to max.
+
+// GetBlockCongestion returns the average block gas usage (0.0-1.0) over the
+// last CongestionWindowBlocks blocks. Before any block has been recorded
+// (genesis, or a chain without a block gas limit) it falls back to the
+// current block's usage so far.
+func (k Keeper) GetBlockCongestion(ctx context.Context) math.LegacyDec {
+	window := k.getCongestionWindow(ctx)
+	if window.Count > 0 {
+		return window.Average()
+	}
+
+	congestion, ok := currentBlockCongestion(ctx)
+	if !ok {
+		return math.LegacyZeroDec()
+	}
+	return congestion
+}
+
+// RecordBlockCongestion adds this block's gas usage to the congestion average,
+// replacing the oldest sample once the window is full. Should be called in
+// EndBlock, after all transactions have consumed block gas. No-op when the
+// block has no gas meter or the consensus params set no gas limit.
+func (k Keeper) RecordBlockCongestion(ctx context.Context) error {
+	sample, ok := currentBlockCongestion(ctx)
+	if !ok {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	window := k.getCongestionWindow(ctx)
+	slot := window.Next % types.CongestionWindowBlocks
+	key := types.GetCongestionSampleKey(slot)
+
+	if window.Count >= types.CongestionWindowBlocks {
+		bz, err := store.Get(key)
+		if err != nil {
+			return err
+		}
+		oldest := math.LegacyZeroDec()
+		if bz != nil {
+			if err := oldest.Unmarshal(bz); err != nil {
+				return fmt.Errorf("failed to decode congestion sample %d: %w", slot, err)
+			}
+		}
+		window.Sum = window.Sum.Sub(oldest)
+	} else {
+		window.Count++
+	}
+
+	bz, err := sample.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+
+	window.Sum = window.Sum.Add(sample)
+	window.Next = (slot + 1) % types.CongestionWindowBlocks
+	return k.setCongestionWindow(ctx, window)
+}
+
+// currentBlockCongestion returns gas consumed by the current block divided by
+// the consensus block gas limit, capped at 1.0. ok is false when there is no
+// block gas meter or no positive gas limit to compare against.
+func currentBlockCongestion(ctx context.Context) (math.LegacyDec, bool) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gasMeter := sdkCtx.BlockGasMeter()
+	if gasMeter == nil {
+		return math.LegacyDec{}, false
+	}
+
+	blockParams := sdkCtx.ConsensusParams().Block
+	if blockParams == nil || blockParams.MaxGas <= 0 {
+		return math.LegacyDec{}, false
+	}
+
+	gasConsumed := gasMeter.GasConsumed()
+	gasLimit := uint64(blockParams.MaxGas)
+	if gasConsumed >= gasLimit {
+		return math.LegacyOneDec(), true
+	}
+
+	congestion := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasConsumed)).
+		Quo(math.LegacyNewDec(blockParams.MaxGas))
+	return congestion, true
+}
+
+func (k Keeper) getCongestionWindow(ctx context.Context) types.CongestionWindow {
+	empty := types.CongestionWindow{Sum: math.LegacyZeroDec()}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyCongestionWindow)
+	if err != nil || bz == nil {
+		return empty
+	}
+
+	var window types.CongestionWindow
+	if err := json.Unmarshal(bz, &window); err != nil {
+		k.Logger(ctx).Error("failed to decode congestion window, restarting average", "error", err)
+		return empty
+	}
+	return window
+}
+
+func (k Keeper) setCongestionWindow(ctx context.Context, window types.CongestionWindow) error {
+	bz, err := json.Marshal(window)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyCongestionWindow, bz)
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+const testBlockMaxGas = int64(1_000_000)
+
+// setupCongestionTest enables adaptive burn with the treasury and adoption
+// triggers out of the way, so only congestion decides between max and default
+func setupCongestionTest(t *testing.T) (*TestSuiteWrapper, sdk.Context) {
+	t.Helper()
+	f := SetupTestSuite(t)
+
+	params := f.Keeper.GetParams(f.Ctx)
+	params.AdaptiveBurnEnabled = true
+	params.TreasuryFloorPct = math.LegacyZeroDec()
+	params.BlockCongestionThreshold = math.LegacyNewDecWithPrec(75, 2)
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	// One full day of transactions well above the target
+	ctx := f.Ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxGas: testBlockMaxGas},
+	})
+	require.NoError(t, f.Keeper.RecordBlockTransactions(ctx.WithBlockHeight(1), 2*int64(params.TxPerDayTarget)))
+	ctx = ctx.WithBlockHeight(keeper.BlocksPerDay)
+	require.NoError(t, f.Keeper.RecordBlockTransactions(ctx, 0))
+
+	return f, ctx
+}
+
+// withBlockGasUsed returns ctx with a block gas meter that has consumed gas
+func withBlockGasUsed(ctx sdk.Context, gas uint64) sdk.Context {
+	meter := storetypes.NewGasMeter(uint64(testBlockMaxGas))
+	meter.ConsumeGas(gas, "test")
+	return ctx.WithBlockGasMeter(meter)
+}
+
+// recordBlocks records n blocks that each consumed gas
+func recordBlocks(t *testing.T, f *TestSuiteWrapper, ctx sdk.Context, n int, gas uint64) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		require.NoError(t, f.Keeper.RecordBlockCongestion(withBlockGasUsed(ctx, gas)))
+	}
+}
+
+func TestGetBlockCongestion_NoHistoryUsesCurrentBlock(t *testing.T) {
+	f, ctx := setupCongestionTest(t)
+
+	// Genesis: no history and an empty block reads as uncongested
+	require.True(t, f.Keeper.GetBlockCongestion(withBlockGasUsed(ctx, 0)).IsZero())
+
+	// Before the first sample the current block's usage is used
+	congestion := f.Keeper.GetBlockCongestion(withBlockGasUsed(ctx, 800_000))
+	require.Equal(t, math.LegacyNewDecWithPrec(80, 2).String(), congestion.String())
+
+	// Usage past the limit is capped at 100%
+	ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(2*uint64(testBlockMaxGas), "test")
+	require.Equal(t, math.LegacyOneDec().String(), f.Keeper.GetBlockCongestion(ctx).String())
+}
+
+func TestRecordBlockCongestion_NoGasLimit(t *testing.T) {
+	f, ctx := setupCongestionTest(t)
+
+	// MaxGas -1 means unlimited: there is nothing to measure against
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxGas: -1},
+	})
+	require.NoError(t, f.Keeper.RecordBlockCongestion(withBlockGasUsed(ctx, 900_000)))
+	require.True(t, f.Keeper.GetBlockCongestion(ctx).IsZero())
+}
+
+func TestBlockCongestion_HighGasUsageDrivesMaxBurn(t *testing.T) {
+	f, ctx := setupCongestionTest(t)
+	params := f.Keeper.GetParams(ctx)
+
+	recordBlocks(t, f, ctx, int(types.CongestionWindowBlocks), 900_000)
+	require.Equal(t, math.LegacyNewDecWithPrec(90, 2).String(), f.Keeper.GetBlockCongestion(ctx).String())
+
+	// BeginBlock of the next block: the new block has used no gas yet, but the
+	// recorded history keeps the congestion trigger active
+	ratio, trigger := f.Keeper.GetAdaptiveBurnRatio(withBlockGasUsed(ctx, 0))
+	require.Equal(t, "congestion_control", trigger)
+	require.Equal(t, params.MaxBurnRatio, ratio)
+}
+
+func TestBlockCongestion_LowGasUsageKeepsDefaultBurn(t *testing.T) {
+	f, ctx := setupCongestionTest(t)
+	params := f.Keeper.GetParams(ctx)
+
+	recordBlocks(t, f, ctx, int(types.CongestionWindowBlocks), 200_000)
+	require.Equal(t, math.LegacyNewDecWithPrec(20, 2).String(), f.Keeper.GetBlockCongestion(ctx).String())
+
+	ratio, trigger := f.Keeper.GetAdaptiveBurnRatio(withBlockGasUsed(ctx, 0))
+	require.Equal(t, "normal", trigger)
+	require.Equal(t, params.DefaultBurnRatio, ratio)
+}
+
+func TestBlockCongestion_RollingWindow(t *testing.T) {
+	f, ctx := setupCongestionTest(t)
+
+	// A single full block among quiet ones does not trip the threshold
+	recordBlocks(t, f, ctx, int(types.CongestionWindowBlocks)-1, 100_000)
+	recordBlocks(t, f, ctx, 1, uint64(testBlockMaxGas))
+	require.Equal(t, math.LegacyNewDecWithPrec(19, 2).String(), f.Keeper.GetBlockCongestion(ctx).String())
+	_, trigger := f.Keeper.GetAdaptiveBurnRatio(ctx)
+	require.Equal(t, "normal", trigger)
+
+	// Sustained load replaces the old samples
+	recordBlocks(t, f, ctx, int(types.CongestionWindowBlocks), 800_000)
+	require.Equal(t, math.LegacyNewDecWithPrec(80, 2).String(), f.Keeper.GetBlockCongestion(ctx).String())
+	_, trigger = f.Keeper.GetAdaptiveBurnRatio(ctx)
+	require.Equal(t, "congestion_control", trigger)
+
+	// And drains back out once load drops
+	recordBlocks(t, f, ctx, int(types.CongestionWindowBlocks), 300_000)
+	require.Equal(t, math.LegacyNewDecWithPrec(30, 2).String(), f.Keeper.GetBlockCongestion(ctx).String())
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func TestBlocksPerYear_HalvingBlockTimeHalvesBlockProvisions(t *testing.T) {
+	f := SetupTestSuite(t)
+	require.NoError(t, f.Keeper.SetCurrentSupply(f.Ctx, math.NewInt(375_000_000_000_000)))
+
+	slow := types.BlocksPerYearForBlockTime(6 * time.Second)
+	fast := types.BlocksPerYearForBlockTime(3 * time.Second)
+	require.Equal(t, uint64(5_259_600), slow)
+	require.Equal(t, 2*slow, fast)
+
+	provisionsAt := func(blocksPerYear uint64) math.LegacyDec {
+		params := f.Keeper.GetParams(f.Ctx)
+		params.BlocksPerYear = blocksPerYear
+		require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+		return f.Keeper.CalculateBlockProvisions(f.Ctx)
+	}
+
+	slowProvisions := provisionsAt(slow)
+	fastProvisions := provisionsAt(fast)
+	require.True(t, fastProvisions.IsPositive())
+
+	// Same annual rate, so each block mints half as much (up to rounding)
+	diff := slowProvisions.QuoInt64(2).Sub(fastProvisions).Abs()
+	require.True(t, diff.LTE(math.LegacyNewDecWithPrec(1, math.LegacyPrecision)),
+		"slow %s / 2 != fast %s", slowProvisions, fastProvisions)
+
+	// Annual provisions do not depend on the block time
+	require.True(t, fastProvisions.MulInt64(int64(fast)).Sub(slowProvisions.MulInt64(int64(slow))).Abs().LT(math.LegacyOneDec()))
+
+	res, err := keeper.NewQueryServerImpl(f.Keeper).Inflation(f.Ctx, &types.QueryInflationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, fast, res.BlocksPerYear)
+}
+
+func TestBlocksPerYear_Validation(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.DefaultBlocksPerYear, params.BlocksPerYear)
+	require.NoError(t, params.Validate())
+
+	for _, bpy := range []uint64{0, types.MinBlocksPerYear - 1, types.MaxBlocksPerYear + 1} {
+		params.BlocksPerYear = bpy
+		require.Error(t, params.Validate(), "blocks per year %d", bpy)
+	}
+	for _, bpy := range []uint64{types.MinBlocksPerYear, types.MaxBlocksPerYear} {
+		params.BlocksPerYear = bpy
+		require.NoError(t, params.Validate(), "blocks per year %d", bpy)
+	}
+
+	// The bounds match 10 minute and 100ms blocks
+	require.Equal(t, types.MinBlocksPerYear, types.BlocksPerYearForBlockTime(10*time.Minute))
+	require.Equal(t, types.MaxBlocksPerYear, types.BlocksPerYearForBlockTime(100*time.Millisecond))
+	require.Zero(t, types.BlocksPerYearForBlockTime(0))
+
+	// Params stored before the field existed keep the old divisor
+	params.BlocksPerYear = 0
+	require.Equal(t, types.DefaultBlocksPerYear, params.EffectiveBlocksPerYear())
+}
+
+func TestBlocksPerYear_MovesYearBoundary(t *testing.T) {
+	f := SetupTestSuite(t)
+	require.Equal(t, int64(types.DefaultBlocksPerYear), f.Keeper.GetBlocksPerYear(f.Ctx))
+
+	fast := types.BlocksPerYearForBlockTime(3 * time.Second)
+	params := f.Keeper.GetParams(f.Ctx)
+	params.BlocksPerYear = fast
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+	require.Equal(t, int64(fast), f.Keeper.GetBlocksPerYear(f.Ctx))
+
+	// Year 0 now runs to height fast; at the default divisor that height
+	// would already be in year 2
+	lastOfYear0 := f.Ctx.WithBlockHeight(int64(fast))
+	firstOfYear1 := f.Ctx.WithBlockHeight(int64(fast) + 1)
+	require.Equal(t, int64(2), types.YearAtHeight(int64(fast), int64(types.DefaultBlocksPerYear)))
+	require.Equal(t, int64(0), f.Keeper.GetCurrentYear(lastOfYear0))
+	require.Equal(t, int64(1), f.Keeper.GetCurrentYear(firstOfYear1))
+	require.Equal(t, "0.030000000000000000", f.Keeper.CalculateDecayingInflation(lastOfYear0).String())
+	require.Equal(t, "0.027500000000000000", f.Keeper.CalculateDecayingInflation(firstOfYear1).String())
+
+	// The supply forecast starts from the same year
+	res, err := keeper.NewQueryServerImpl(f.Keeper).SupplyForecast(firstOfYear1, &types.QuerySupplyForecastRequest{Years: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.CurrentYear)
+	require.Equal(t, int64(1), res.Years[0].Year)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pos/x/tokenomics/types"
@@ -71,8 +72,8 @@ func (k Keeper) BurnTokens(
 			return math.ZeroInt(), math.ZeroInt(), fmt.Errorf("failed to send treasury redirect: %w", err)
 		}
 
-		// Track treasury inflows
-		k.IncrementTreasuryInflows(ctx, redirectAmount, "burn_redirect")
+		// Track treasury inflows, including for the treasury redirect
+		k.recordTreasuryInflow(ctx, redirectAmount, "burn_redirect")
 	}
 
 	// P0-ACCT-001: Update supply counters
@@ -210,6 +211,16 @@ func (k Keeper) GetBurnsBySource(ctx context.Context, source types.BurnSource) m
 	return total
 }
 
+// GetBurnsBreakdown returns the burn total of every burn source, zero for
+// sources that have never burned
+func (k Keeper) GetBurnsBreakdown(ctx context.Context) map[types.BurnSource]math.Int {
+	totals := make(map[types.BurnSource]math.Int)
+	for _, source := range types.BurnSources() {
+		totals[source] = k.GetBurnsBySource(ctx, source)
+	}
+	return totals
+}
+
 // IncrementBurnsByChain updates the burn counter for a specific chain
 func (k Keeper) IncrementBurnsByChain(ctx context.Context, chainID string, amount math.Int) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -252,6 +263,31 @@ func (k Keeper) GetBurnsByChain(ctx context.Context, chainID string) math.Int {
 	return total
 }
 
+// GetAllBurnsByChain returns the burn total of every chain with recorded
+// burns, ordered by chain ID
+func (k Keeper) GetAllBurnsByChain(ctx context.Context) []types.ChainBurnTotal {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.BurnByChainPrefix, storetypes.PrefixEndBytes(types.BurnByChainPrefix))
+	if err != nil {
+		return nil
+	}
+	defer iter.Close()
+
+	var totals []types.ChainBurnTotal
+	for ; iter.Valid(); iter.Next() {
+		var total math.Int
+		if err := total.Unmarshal(iter.Value()); err != nil {
+			k.Logger(ctx).Error("failed to decode chain burn total", "key", iter.Key(), "error", err)
+			continue
+		}
+		totals = append(totals, types.ChainBurnTotal{
+			ChainId:     string(iter.Key()[len(types.BurnByChainPrefix):]),
+			TotalBurned: total,
+		})
+	}
+	return totals
+}
+
 // IncrementTreasuryInflows tracks treasury deposits
 func (k Keeper) IncrementTreasuryInflows(ctx context.Context, amount math.Int, source string) {
 	// Track total inflows
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// GUARDIAN EMERGENCY BURN OVERRIDE
+// ============================================================================
+// The emergency_burn_override param needs a governance vote to flip, which is
+// too slow during an attack. The burn_override_guardian can instead pin the
+// fee burn ratio with MsgSetEmergencyBurnOverride. The override takes effect
+// from the same block and lapses on its own after burn_override_max_blocks,
+// so a lost or compromised guardian key cannot hold the burn ratio for long.
+
+// SetEmergencyBurnOverride activates (enabled) or clears the guardian's
+// emergency burn override and returns the height at which it expires, or zero
+// once cleared
+func (k Keeper) SetEmergencyBurnOverride(ctx context.Context, guardian string, enabled bool, ratio math.LegacyDec) (int64, error) {
+	params := k.GetParams(ctx)
+	if params.BurnOverrideGuardian == "" || params.BurnOverrideMaxBlocks == 0 {
+		return 0, types.ErrBurnOverrideDisabled
+	}
+	if guardian != params.BurnOverrideGuardian {
+		return 0, errorsmod.Wrapf(types.ErrNotBurnOverrideGuardian, "expected %s, got %s", params.BurnOverrideGuardian, guardian)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	if !enabled {
+		override, found := k.GetGuardianBurnOverride(ctx)
+		if err := k.deleteGuardianBurnOverride(ctx); err != nil {
+			return 0, err
+		}
+		if found && override.IsActive(height) {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBurnOverrideDeactivated,
+					sdk.NewAttribute("guardian", guardian),
+					sdk.NewAttribute("block_height", fmt.Sprintf("%d", height)),
+				),
+			)
+		}
+		return 0, nil
+	}
+
+	if ratio.IsNil() || ratio.IsNegative() || ratio.GT(math.LegacyOneDec()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidParams, "burn override ratio must be between 0 and 1, got %s", ratio)
+	}
+	// The guardian acts within the bounds governance set for the controller
+	if !clampBurnRatio(ratio, params).Equal(ratio) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidParams, "burn override ratio %s is outside [%s, %s]",
+			ratio, params.MinBurnRatio, params.MaxBurnRatio)
+	}
+
+	override := types.GuardianBurnOverride{
+		Guardian:        guardian,
+		Ratio:           ratio,
+		ActivatedHeight: height,
+		ExpiresAtHeight: height + int64(params.BurnOverrideMaxBlocks),
+	}
+	if err := k.setGuardianBurnOverride(ctx, override); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnOverrideActivated,
+			sdk.NewAttribute("guardian", guardian),
+			sdk.NewAttribute("burn_ratio", ratio.String()),
+			sdk.NewAttribute("expires_at_height", fmt.Sprintf("%d", override.ExpiresAtHeight)),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", height)),
+		),
+	)
+
+	k.Logger(ctx).Warn("guardian emergency burn override activated",
+		"guardian", guardian,
+		"burn_ratio", ratio.String(),
+		"expires_at_height", override.ExpiresAtHeight)
+
+	return override.ExpiresAtHeight, nil
+}
+
+// GetGuardianBurnOverride returns the stored guardian override, which may
+// already have expired
+func (k Keeper) GetGuardianBurnOverride(ctx context.Context) (types.GuardianBurnOverride, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyGuardianBurnOverride)
+	if err != nil || bz == nil {
+		return types.GuardianBurnOverride{}, false
+	}
+
+	var override types.GuardianBurnOverride
+	if err := json.Unmarshal(bz, &override); err != nil {
+		k.Logger(ctx).Error("failed to decode guardian burn override", "error", err)
+		return types.GuardianBurnOverride{}, false
+	}
+	return override, true
+}
+
+// ExpireGuardianBurnOverride clears the guardian override once it has run for
+// burn_override_max_blocks. Called at the start of UpdateBurnRatio.
+func (k Keeper) ExpireGuardianBurnOverride(ctx context.Context) error {
+	override, found := k.GetGuardianBurnOverride(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !found || override.IsActive(sdkCtx.BlockHeight()) {
+		return nil
+	}
+
+	if err := k.deleteGuardianBurnOverride(ctx); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnOverrideExpired,
+			sdk.NewAttribute("guardian", override.Guardian),
+			sdk.NewAttribute("activated_height", fmt.Sprintf("%d", override.ActivatedHeight)),
+			sdk.NewAttribute("block_height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+		),
+	)
+
+	k.Logger(ctx).Info("guardian emergency burn override expired",
+		"guardian", override.Guardian,
+		"activated_height", override.ActivatedHeight)
+
+	return nil
+}
+
+// burnOverride returns the fee burn ratio pinned by an active emergency
+// override and its trigger. The guardian's override takes precedence over
+// the emergency_burn_override param.
+func (k Keeper) burnOverride(ctx context.Context, params types.TokenomicsParams) (math.LegacyDec, string, bool) {
+	override, found := k.GetGuardianBurnOverride(ctx)
+	if found && override.IsActive(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return override.Ratio, types.TriggerGuardianOverride, true
+	}
+	if params.EmergencyBurnOverride {
+		return params.FeeBurnRatio, "emergency_override", true
+	}
+	return math.LegacyDec{}, "", false
+}
+
+func (k Keeper) setGuardianBurnOverride(ctx context.Context, override types.GuardianBurnOverride) error {
+	bz, err := json.Marshal(override)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyGuardianBurnOverride, bz)
+}
+
+func (k Keeper) deleteGuardianBurnOverride(ctx context.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.KeyGuardianBurnOverride)
+}
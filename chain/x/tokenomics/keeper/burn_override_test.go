@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+// setupBurnOverrideTest enables adaptive burn and appoints a guardian whose
+// override lasts 10 blocks
+func setupBurnOverrideTest(t *testing.T) (*TestSuiteWrapper, string) {
+	f, setTreasury := setupTreasuryPctTest(t)
+	setTreasury(100)
+
+	guardian := sdk.AccAddress("burn_guardian_______").String()
+	params := f.Keeper.GetParams(f.Ctx)
+	params.BurnOverrideGuardian = guardian
+	params.BurnOverrideMaxBlocks = 10
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	f.Ctx = f.Ctx.WithBlockHeight(100)
+	return f, guardian
+}
+
+func TestSetEmergencyBurnOverride_AppliesImmediately(t *testing.T) {
+	f, guardian := setupBurnOverrideTest(t)
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	ratio := math.LegacyNewDecWithPrec(82, 2)
+
+	res, err := ms.SetEmergencyBurnOverride(f.Ctx, &types.MsgSetEmergencyBurnOverride{
+		Guardian:  guardian,
+		Enabled:   true,
+		BurnRatio: ratio,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(110), res.ExpiresAtHeight)
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeBurnOverrideActivated))
+	require.Equal(t, "110", eventAttribute(f.Ctx, types.EventTypeBurnOverrideActivated, "expires_at_height"))
+
+	// Fee processing in the same block already uses the override
+	require.True(t, ratio.Equal(f.Keeper.GetCurrentBurnRatio(f.Ctx)))
+	target, trigger := f.Keeper.GetAdaptiveBurnRatio(f.Ctx)
+	require.True(t, ratio.Equal(target))
+	require.Equal(t, types.TriggerGuardianOverride, trigger)
+
+	// The next BeginBlock applies it without smoothing
+	ctx := f.Ctx.WithBlockHeight(101)
+	require.NoError(t, f.Keeper.UpdateBurnRatio(ctx))
+	params := f.Keeper.GetParams(ctx)
+	require.True(t, ratio.Equal(params.LastAppliedBurnRatio), params.LastAppliedBurnRatio.String())
+	require.Equal(t, types.TriggerGuardianOverride, params.LastBurnTrigger)
+
+	// Fees are split burn/treasury at the override ratio, with nothing to validators
+	totalFees := math.NewInt(1_000_000)
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, totalFees))
+	require.NoError(t, f.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, f.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+	require.NoError(t, f.Keeper.SetCurrentSupply(ctx, f.Keeper.GetCurrentSupply(ctx).Add(totalFees)))
+
+	burnedBefore := f.Keeper.GetTotalBurned(ctx)
+	require.NoError(t, f.Keeper.ProcessBlockFees(ctx))
+	require.Equal(t, math.NewInt(820_000), f.Keeper.GetTotalBurned(ctx).Sub(burnedBefore))
+	feeCollector := f.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, f.BankKeeper.GetBalance(ctx, feeCollector, types.BondDenom).Amount.IsZero())
+}
+
+func TestSetEmergencyBurnOverride_ExpiresAfterMaxBlocks(t *testing.T) {
+	f, guardian := setupBurnOverrideTest(t)
+	ratio := math.LegacyNewDecWithPrec(82, 2)
+	_, err := f.Keeper.SetEmergencyBurnOverride(f.Ctx, guardian, true, ratio)
+	require.NoError(t, err)
+
+	// Still in force on the last block of its window
+	ctx := f.Ctx.WithBlockHeight(109).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.Keeper.UpdateBurnRatio(ctx))
+	_, found := f.Keeper.GetGuardianBurnOverride(ctx)
+	require.True(t, found)
+	require.Equal(t, types.TriggerGuardianOverride, f.Keeper.GetParams(ctx).LastBurnTrigger)
+
+	// Lapses on its own once burn_override_max_blocks have passed
+	ctx = f.Ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+	_, trigger := f.Keeper.GetAdaptiveBurnRatio(ctx)
+	require.NotEqual(t, types.TriggerGuardianOverride, trigger)
+	require.NoError(t, f.Keeper.UpdateBurnRatio(ctx))
+	_, found = f.Keeper.GetGuardianBurnOverride(ctx)
+	require.False(t, found)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeBurnOverrideExpired))
+	require.Equal(t, trigger, f.Keeper.GetParams(ctx).LastBurnTrigger)
+
+	// Clearing it early emits a deactivation event instead
+	_, err = f.Keeper.SetEmergencyBurnOverride(ctx, guardian, true, ratio)
+	require.NoError(t, err)
+	expiresAt, err := f.Keeper.SetEmergencyBurnOverride(ctx, guardian, false, math.LegacyDec{})
+	require.NoError(t, err)
+	require.Zero(t, expiresAt)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeBurnOverrideDeactivated))
+	_, trigger = f.Keeper.GetAdaptiveBurnRatio(ctx)
+	require.NotEqual(t, types.TriggerGuardianOverride, trigger)
+}
+
+func TestSetEmergencyBurnOverride_Rejections(t *testing.T) {
+	f, guardian := setupBurnOverrideTest(t)
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	ratio := math.LegacyNewDecWithPrec(82, 2)
+
+	// Only the guardian may set it
+	_, err := ms.SetEmergencyBurnOverride(f.Ctx, &types.MsgSetEmergencyBurnOverride{
+		Guardian:  sdk.AccAddress("someone_else________").String(),
+		Enabled:   true,
+		BurnRatio: ratio,
+	})
+	require.ErrorIs(t, err, types.ErrNotBurnOverrideGuardian)
+	_, err = ms.SetEmergencyBurnOverride(f.Ctx, &types.MsgSetEmergencyBurnOverride{
+		Guardian:  f.Keeper.GetAuthority(),
+		Enabled:   true,
+		BurnRatio: ratio,
+	})
+	require.ErrorIs(t, err, types.ErrNotBurnOverrideGuardian)
+	_, found := f.Keeper.GetGuardianBurnOverride(f.Ctx)
+	require.False(t, found)
+
+	// The ratio must stay within [min_burn_ratio, max_burn_ratio]
+	_, err = f.Keeper.SetEmergencyBurnOverride(f.Ctx, guardian, true, math.LegacyNewDecWithPrec(50, 2))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	_, err = f.Keeper.SetEmergencyBurnOverride(f.Ctx, guardian, true, math.LegacyNewDecWithPrec(150, 2))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	// Without a guardian, or with max blocks set to zero, the message is disabled
+	params := f.Keeper.GetParams(f.Ctx)
+	params.BurnOverrideMaxBlocks = 0
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+	_, err = f.Keeper.SetEmergencyBurnOverride(f.Ctx, guardian, true, ratio)
+	require.ErrorIs(t, err, types.ErrBurnOverrideDisabled)
+
+	params.BurnOverrideMaxBlocks = 10
+	params.BurnOverrideGuardian = ""
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+	_, err = f.Keeper.SetEmergencyBurnOverride(f.Ctx, guardian, true, ratio)
+	require.ErrorIs(t, err, types.ErrBurnOverrideDisabled)
+
+	// A bad guardian address is caught by param validation
+	params.BurnOverrideGuardian = "not-an-address"
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func TestBurnRateBySource_AttributesFeeBurnToTaggedSources(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+	require.NoError(t, f.Keeper.SetTreasuryAddress(ctx, sdk.AccAddress("treasury____________")))
+
+	// 1,000,000 in fees: 600,000 tagged across three sources, the rest untagged
+	collectFees(t, f, ctx, math.NewInt(1_000_000))
+	tags := map[types.BurnSource]int64{
+		types.BurnSource_BURN_SOURCE_POC_ANCHORING:   300_000,
+		types.BurnSource_BURN_SOURCE_SEQUENCER_GAS:   150_000,
+		types.BurnSource_BURN_SOURCE_SMART_CONTRACTS: 100_000,
+	}
+	for source, amount := range tags {
+		require.NoError(t, f.Keeper.RecordFeeSource(ctx, source, math.NewInt(amount)))
+	}
+	// Tags from the same source accumulate
+	require.NoError(t, f.Keeper.RecordFeeSource(ctx, types.BurnSource_BURN_SOURCE_SEQUENCER_GAS, math.NewInt(50_000)))
+
+	require.NoError(t, f.Keeper.ProcessBlockFees(ctx))
+	require.Empty(t, f.Keeper.GetPendingFeeSources(ctx))
+
+	// 90% of each source's fees is burned; untagged fees count as POS_GAS
+	wantFee := map[types.BurnSource]int64{
+		types.BurnSource_BURN_SOURCE_POC_ANCHORING:   270_000,
+		types.BurnSource_BURN_SOURCE_SEQUENCER_GAS:   180_000,
+		types.BurnSource_BURN_SOURCE_SMART_CONTRACTS: 90_000,
+		types.BurnSource_BURN_SOURCE_POS_GAS:         360_000,
+	}
+	for source, amount := range wantFee {
+		require.Equal(t, math.NewInt(amount).String(), f.Keeper.GetFeeBurnsBySource(ctx, source).String(), source.String())
+	}
+	require.Equal(t, "900000", f.Keeper.GetTotalFeesBurned(ctx).String())
+
+	// A module-specific burn from a source that also paid fees
+	burner := sdk.AccAddress("burner______________")
+	f.BankKeeper.balances[burner.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 10_000))
+	moduleBurned, _, err := f.Keeper.BurnTokens(ctx, burner, math.NewInt(10_000), types.BurnSource_BURN_SOURCE_POC_ANCHORING, "omniphi-core-1")
+	require.NoError(t, err)
+	require.True(t, moduleBurned.IsPositive())
+
+	res, err := keeper.NewQueryServerImpl(f.Keeper).BurnRateBySource(ctx, &types.QueryBurnRateBySourceRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Sources, len(types.BurnSource_name)-1)
+
+	for i, s := range res.Sources {
+		source := types.BurnSource(i + 1)
+		require.Equal(t, source.String(), s.Source)
+
+		fee := math.NewInt(wantFee[source])
+		module := math.ZeroInt()
+		if source == types.BurnSource_BURN_SOURCE_POC_ANCHORING {
+			module = moduleBurned
+		}
+		require.Equal(t, fee.String(), s.FeeBurned.String(), s.Source)
+		require.Equal(t, module.String(), s.ModuleBurned.String(), s.Source)
+		require.Equal(t, fee.Add(module).String(), s.TotalBurned.String(), s.Source)
+		require.Equal(t, f.Keeper.GetBurnsBySource(ctx, source).String(), s.TotalBurned.String(), s.Source)
+	}
+
+	require.Equal(t, "900000", res.TotalFeeBurned.String())
+	require.Equal(t, moduleBurned.String(), res.TotalModuleBurned.String())
+	require.Equal(t, res.TotalFeeBurned.Add(res.TotalModuleBurned).String(), res.TotalBurned.String())
+}
+
+func TestBurnRateBySource_TagsApplyToOneBlock(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+	require.NoError(t, f.Keeper.SetTreasuryAddress(ctx, sdk.AccAddress("treasury____________")))
+	ai := types.BurnSource_BURN_SOURCE_AI_QUERIES
+	posGas := types.BurnSource_BURN_SOURCE_POS_GAS
+
+	collectFees(t, f, ctx, math.NewInt(100_000))
+	require.NoError(t, f.Keeper.RecordFeeSource(ctx, ai, math.NewInt(100_000)))
+	require.NoError(t, f.Keeper.ProcessBlockFees(ctx))
+	require.Equal(t, "90000", f.Keeper.GetFeeBurnsBySource(ctx, ai).String())
+	require.True(t, f.Keeper.GetFeeBurnsBySource(ctx, posGas).IsZero())
+
+	// The next block's untagged fees go to POS_GAS, not the previous tags
+	next := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	collectFees(t, f, next, math.NewInt(100_000))
+	require.NoError(t, f.Keeper.ProcessBlockFees(next))
+	require.Equal(t, "90000", f.Keeper.GetFeeBurnsBySource(next, ai).String())
+	require.Equal(t, "90000", f.Keeper.GetFeeBurnsBySource(next, posGas).String())
+
+	// Tags from a block without fees are dropped too
+	require.NoError(t, f.Keeper.RecordFeeSource(next, ai, math.NewInt(5_000)))
+	require.NoError(t, f.Keeper.ProcessBlockFees(next))
+	require.Empty(t, f.Keeper.GetPendingFeeSources(next))
+}
+
+func TestBurnRateBySource_OverTaggedFeesNeverExceedBurn(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+	require.NoError(t, f.Keeper.SetTreasuryAddress(ctx, sdk.AccAddress("treasury____________")))
+
+	// Sources claim twice the fees actually collected
+	collectFees(t, f, ctx, math.NewInt(100_000))
+	require.NoError(t, f.Keeper.RecordFeeSource(ctx, types.BurnSource_BURN_SOURCE_MESSAGING, math.NewInt(150_000)))
+	require.NoError(t, f.Keeper.RecordFeeSource(ctx, types.BurnSource_BURN_SOURCE_SMART_CONTRACTS, math.NewInt(50_000)))
+	require.NoError(t, f.Keeper.ProcessBlockFees(ctx))
+
+	// The burn is split by claimed share and sums to exactly the fee burn
+	require.Equal(t, "67500", f.Keeper.GetFeeBurnsBySource(ctx, types.BurnSource_BURN_SOURCE_MESSAGING).String())
+	require.Equal(t, "22500", f.Keeper.GetFeeBurnsBySource(ctx, types.BurnSource_BURN_SOURCE_SMART_CONTRACTS).String())
+	res := types.NewQueryBurnRateBySourceResponse(f.Keeper.GetBurnsBreakdown(ctx), f.Keeper.GetFeeBurnsBreakdown(ctx))
+	require.Equal(t, f.Keeper.GetTotalFeesBurned(ctx).String(), res.TotalFeeBurned.String())
+}
+
+func TestRecordFeeSource_Validation(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	require.Error(t, f.Keeper.RecordFeeSource(f.Ctx, types.BurnSource_BURN_SOURCE_UNSPECIFIED, math.NewInt(1)))
+	require.Error(t, f.Keeper.RecordFeeSource(f.Ctx, types.BurnSource(99), math.NewInt(1)))
+	require.Error(t, f.Keeper.RecordFeeSource(f.Ctx, types.BurnSource_BURN_SOURCE_POS_GAS, math.ZeroInt()))
+	require.Error(t, f.Keeper.RecordFeeSource(f.Ctx, types.BurnSource_BURN_SOURCE_POS_GAS, math.NewInt(-1)))
+	require.Empty(t, f.Keeper.GetPendingFeeSources(f.Ctx))
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// ADAPTIVE BURN DECISION HISTORY
+// ============================================================================
+// UpdateBurnRatio appends an entry whenever the applied ratio or its trigger
+// changes, so the DAO can see how the controller moved the burn ratio over
+// time. While smoothing converges on a new target every block changes the
+// ratio, so each step is its own entry. Only the most recent
+// GetBurnRatioHistoryLimit entries are kept; older ones are pruned as new
+// ones are written.
+
+// GetBurnRatioHistoryLimit returns the number of history entries kept, or the
+// default if governance has not set one
+func (k Keeper) GetBurnRatioHistoryLimit(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyBurnRatioHistoryLimit)
+	if err != nil || len(bz) != 8 {
+		return types.DefaultBurnRatioHistoryLimit
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetBurnRatioHistoryLimit validates and stores the number of history entries
+// kept. Lowering the limit prunes the oldest entries right away.
+func (k Keeper) SetBurnRatioHistoryLimit(ctx context.Context, limit uint64) error {
+	if err := types.ValidateBurnRatioHistoryLimit(limit); err != nil {
+		return err
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, limit)
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.KeyBurnRatioHistoryLimit, bz); err != nil {
+		return err
+	}
+	return k.pruneBurnRatioHistory(ctx, k.getBurnRatioHistoryRange(ctx), limit)
+}
+
+// RecordBurnRatioChange appends an entry to the burn ratio history and prunes
+// the oldest entries beyond the limit
+func (k Keeper) RecordBurnRatioChange(ctx context.Context, height int64, ratio math.LegacyDec, trigger string) error {
+	bz, err := json.Marshal(types.BurnRatioChange{
+		Height:       height,
+		AppliedRatio: ratio,
+		Trigger:      trigger,
+	})
+	if err != nil {
+		return err
+	}
+
+	r := k.getBurnRatioHistoryRange(ctx)
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetBurnRatioHistoryKey(r.Next), bz); err != nil {
+		return err
+	}
+	r.Next++
+	return k.pruneBurnRatioHistory(ctx, r, k.GetBurnRatioHistoryLimit(ctx))
+}
+
+// GetBurnRatioHistory pages through the burn ratio history, oldest first
+func (k Keeper) GetBurnRatioHistory(ctx context.Context, pageReq *query.PageRequest) ([]types.BurnRatioChange, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.BurnRatioHistoryPrefix)
+
+	var entries []types.BurnRatioChange
+	pageRes, err := query.Paginate(store, pageReq, func(key, value []byte) error {
+		var entry types.BurnRatioChange
+		if err := json.Unmarshal(value, &entry); err != nil {
+			return fmt.Errorf("failed to decode burn ratio history entry %x: %w", key, err)
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// pruneBurnRatioHistory deletes the oldest entries until at most limit remain
+// and stores the resulting range
+func (k Keeper) pruneBurnRatioHistory(ctx context.Context, r types.BurnRatioHistoryRange, limit uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	for r.Len() > limit {
+		if err := store.Delete(types.GetBurnRatioHistoryKey(r.First)); err != nil {
+			return err
+		}
+		r.First++
+	}
+	return k.setBurnRatioHistoryRange(ctx, r)
+}
+
+func (k Keeper) getBurnRatioHistoryRange(ctx context.Context) types.BurnRatioHistoryRange {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyBurnRatioHistoryRange)
+	if err != nil || bz == nil {
+		return types.BurnRatioHistoryRange{}
+	}
+
+	var r types.BurnRatioHistoryRange
+	if err := json.Unmarshal(bz, &r); err != nil {
+		k.Logger(ctx).Error("failed to decode burn ratio history range, restarting history", "error", err)
+		return types.BurnRatioHistoryRange{}
+	}
+	return r
+}
+
+func (k Keeper) setBurnRatioHistoryRange(ctx context.Context, r types.BurnRatioHistoryRange) error {
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyBurnRatioHistoryRange, bz)
+}
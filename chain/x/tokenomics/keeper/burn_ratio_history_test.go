@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func TestBurnRatioHistory_RecordsEachTransition(t *testing.T) {
+	f, setTreasury := setupTreasuryPctTest(t)
+	height := int64(10)
+
+	// step runs one BeginBlock update at the next height
+	var expected []types.BurnRatioChange
+	step := func(trigger string) {
+		t.Helper()
+		height++
+		ctx := f.Ctx.WithBlockHeight(height)
+		require.NoError(t, f.Keeper.UpdateBurnRatio(ctx))
+
+		params := f.Keeper.GetParams(ctx)
+		require.Equal(t, trigger, params.LastBurnTrigger)
+		expected = append(expected, types.BurnRatioChange{
+			Height:       height,
+			AppliedRatio: params.LastAppliedBurnRatio,
+			Trigger:      trigger,
+		})
+	}
+
+	// Treasury below the floor: the ratio steps down towards min_burn_ratio
+	setTreasury(10)
+	step("treasury_protection")
+	step("treasury_protection")
+
+	// Treasury recovered, but early blocks have no tx volume yet
+	setTreasury(100)
+	step("adoption_incentive")
+
+	// Emergency override pins the ratio to fee_burn_ratio
+	params := f.Keeper.GetParams(f.Ctx)
+	params.EmergencyBurnOverride = true
+	params.FeeBurnRatio = math.LegacyNewDecWithPrec(95, 2)
+	params.TreasuryFeeRatio = math.LegacyNewDecWithPrec(5, 2)
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+	step("emergency_override")
+
+	// Disabling adaptive burn falls back to fee_burn_ratio
+	params = f.Keeper.GetParams(f.Ctx)
+	params.EmergencyBurnOverride = false
+	params.AdaptiveBurnEnabled = false
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+	step("adaptive_disabled")
+
+	entries, pageRes, err := f.Keeper.GetBurnRatioHistory(f.Ctx, &query.PageRequest{CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(expected)), pageRes.Total)
+	require.Len(t, entries, len(expected))
+	for i, entry := range entries {
+		require.Equal(t, expected[i].Height, entry.Height, "entry %d", i)
+		require.Equal(t, expected[i].Trigger, entry.Trigger, "entry %d", i)
+		require.True(t, expected[i].AppliedRatio.Equal(entry.AppliedRatio), "entry %d: %s", i, entry.AppliedRatio)
+	}
+	require.True(t, entries[1].AppliedRatio.LT(entries[0].AppliedRatio))
+
+	// Once the ratio has reached its target, an update that changes neither
+	// ratio nor trigger adds nothing
+	params = f.Keeper.GetParams(f.Ctx)
+	params.LastAppliedBurnRatio = params.FeeBurnRatio
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+	height++
+	require.NoError(t, f.Keeper.UpdateBurnRatio(f.Ctx.WithBlockHeight(height)))
+	entries, _, err = f.Keeper.GetBurnRatioHistory(f.Ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, len(expected))
+}
+
+func TestBurnRatioHistory_PrunesBeyondLimit(t *testing.T) {
+	f := SetupTestSuite(t)
+	require.Equal(t, types.DefaultBurnRatioHistoryLimit, f.Keeper.GetBurnRatioHistoryLimit(f.Ctx))
+	require.Error(t, f.Keeper.SetBurnRatioHistoryLimit(f.Ctx, 0))
+	require.Error(t, f.Keeper.SetBurnRatioHistoryLimit(f.Ctx, types.MaxBurnRatioHistoryLimit+1))
+
+	require.NoError(t, f.Keeper.SetBurnRatioHistoryLimit(f.Ctx, 3))
+	for h := int64(1); h <= 5; h++ {
+		require.NoError(t, f.Keeper.RecordBurnRatioChange(f.Ctx, h, math.LegacyNewDecWithPrec(80+h, 2), "normal"))
+	}
+
+	requireHistoryHeights := func(heights ...int64) {
+		t.Helper()
+		entries, _, err := f.Keeper.GetBurnRatioHistory(f.Ctx, nil)
+		require.NoError(t, err)
+		got := make([]int64, len(entries))
+		for i, entry := range entries {
+			got[i] = entry.Height
+		}
+		require.Equal(t, heights, got)
+	}
+	requireHistoryHeights(3, 4, 5)
+
+	// Lowering the limit prunes right away; raising it keeps what is left
+	require.NoError(t, f.Keeper.SetBurnRatioHistoryLimit(f.Ctx, 2))
+	requireHistoryHeights(4, 5)
+	require.NoError(t, f.Keeper.SetBurnRatioHistoryLimit(f.Ctx, 10))
+	require.NoError(t, f.Keeper.RecordBurnRatioChange(f.Ctx, 6, math.LegacyNewDecWithPrec(86, 2), "normal"))
+	requireHistoryHeights(4, 5, 6)
+}
+
+func TestQueryBurnRatioHistory_Paginates(t *testing.T) {
+	f := SetupTestSuite(t)
+	for h := int64(1); h <= 5; h++ {
+		require.NoError(t, f.Keeper.RecordBurnRatioChange(f.Ctx, h, math.LegacyNewDecWithPrec(80+h, 2), "normal"))
+	}
+
+	qs := keeper.NewQueryServerImpl(f.Keeper)
+	res, err := qs.BurnRatioHistory(f.Ctx, &types.QueryBurnRatioHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, int64(1), res.Entries[0].Height)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = qs.BurnRatioHistory(f.Ctx, &types.QueryBurnRatioHistoryRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, int64(3), res.Entries[0].Height)
+	require.Nil(t, res.Pagination.NextKey)
+
+	_, err = qs.BurnRatioHistory(f.Ctx, nil)
+	require.Error(t, err)
+}
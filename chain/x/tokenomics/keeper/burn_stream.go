@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	"pos/x/tokenomics/types"
+)
+
+// BurnStreamBufferSize is the number of recent burns a node holds for
+// StreamBurnEvents. A new subscriber is replayed at most this many burns, and a
+// subscriber that falls this far behind is disconnected.
+const BurnStreamBufferSize = 256
+
+// ============================================================================
+// BURN EVENT STREAM
+// ============================================================================
+// Burns are published from EndBlock by reading the records committed since the
+// last publish, so burns from failed or simulated transactions never reach
+// subscribers. The buffer is node-local memory: it is empty after a restart
+// until the next EndBlock seeds it with the latest committed burns, and it
+// plays no part in consensus.
+
+// burnStream is a bounded ring of recent burns plus the live subscribers
+type burnStream struct {
+	mu            sync.Mutex
+	ring          []types.BurnRecord
+	start         int // index of the oldest burn in ring
+	lastPublished uint64
+	subscribers   map[chan types.BurnRecord]struct{}
+}
+
+func newBurnStream() *burnStream {
+	return &burnStream{
+		ring:        make([]types.BurnRecord, 0, BurnStreamBufferSize),
+		subscribers: make(map[chan types.BurnRecord]struct{}),
+	}
+}
+
+// publish appends a burn to the ring and fans it out to subscribers. A
+// subscriber whose channel is full is dropped by closing its channel.
+func (s *burnStream) publish(record types.BurnRecord) {
+	if len(s.ring) < BurnStreamBufferSize {
+		s.ring = append(s.ring, record)
+	} else {
+		s.ring[s.start] = record
+		s.start = (s.start + 1) % BurnStreamBufferSize
+	}
+
+	for ch := range s.subscribers {
+		select {
+		case ch <- record:
+		default:
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns the buffered burns, oldest first, and a channel receiving
+// every burn published afterwards. Taking both under one lock means no burn is
+// missed or delivered twice. cancel must be called to unsubscribe.
+func (s *burnStream) subscribe() (replay []types.BurnRecord, updates <-chan types.BurnRecord, cancel func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replay = make([]types.BurnRecord, 0, len(s.ring))
+	replay = append(replay, s.ring[s.start:]...)
+	replay = append(replay, s.ring[:s.start]...)
+
+	ch := make(chan types.BurnRecord, BurnStreamBufferSize)
+	s.subscribers[ch] = struct{}{}
+
+	cancel = func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.subscribers[ch]; ok {
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+	return replay, ch, cancel
+}
+
+// PublishBurnEvents pushes every burn committed since the last call to the
+// burn stream. Called from EndBlock; on the first call after start it seeds the
+// buffer with up to BurnStreamBufferSize of the latest burns.
+func (k Keeper) PublishBurnEvents(ctx context.Context) {
+	s := k.burnStream
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := k.GetNextBurnID(ctx)
+	from := s.lastPublished + 1
+	if next > BurnStreamBufferSize && from < next-BurnStreamBufferSize {
+		from = next - BurnStreamBufferSize
+	}
+
+	for id := from; id < next; id++ {
+		if record, found := k.GetBurnRecord(ctx, id); found {
+			s.publish(record)
+		}
+	}
+	s.lastPublished = next - 1
+}
+
+// SubscribeBurnEvents returns the buffered burns and a channel of new ones;
+// see burnStream.subscribe. The channel is closed if the subscriber falls
+// BurnStreamBufferSize burns behind.
+func (k Keeper) SubscribeBurnEvents() (replay []types.BurnRecord, updates <-chan types.BurnRecord, cancel func()) {
+	return k.burnStream.subscribe()
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+// fakeBurnStream collects the burns a StreamBurnEvents call sends
+type fakeBurnStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan types.BurnRecord
+}
+
+func (s *fakeBurnStream) Context() context.Context { return s.ctx }
+
+func (s *fakeBurnStream) Send(record *types.BurnRecord) error {
+	s.sent <- *record
+	return nil
+}
+
+// recordBurns stores n burns at height and runs the EndBlock publish
+func recordBurns(f *TestSuiteWrapper, height int64, n int) {
+	ctx := f.Ctx.WithBlockHeight(height)
+	burner := sdk.AccAddress([]byte("burner______________"))
+	for i := 0; i < n; i++ {
+		amount := math.NewInt(int64(100 + i))
+		f.Keeper.StoreBurnRecord(ctx, burner, amount, amount, math.ZeroInt(),
+			types.BurnSource_BURN_SOURCE_POS_GAS, "omniphi-core-1")
+	}
+	f.Keeper.PublishBurnEvents(ctx)
+}
+
+func receiveBurnIDs(t *testing.T, stream *fakeBurnStream, n int) []uint64 {
+	t.Helper()
+	ids := make([]uint64, 0, n)
+	for len(ids) < n {
+		select {
+		case record := <-stream.sent:
+			ids = append(ids, record.BurnId)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out after receiving burns %v", ids)
+		}
+	}
+	return ids
+}
+
+func TestStreamBurnEvents_ReplaysThenPushesInOrder(t *testing.T) {
+	f := SetupTestSuite(t)
+	recordBurns(f, 10, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeBurnStream{ctx: ctx, sent: make(chan types.BurnRecord, 16)}
+	done := make(chan error, 1)
+	go func() {
+		done <- keeper.NewQueryServerImpl(f.Keeper).StreamBurnEvents(&types.QueryStreamBurnEventsRequest{}, stream)
+	}()
+
+	// Burns recorded before subscribing are replayed
+	require.Equal(t, []uint64{1, 2}, receiveBurnIDs(t, stream, 2))
+
+	// New burns are pushed once their block publishes them, in order
+	recordBurns(f, 11, 3)
+	recordBurns(f, 12, 1)
+	require.Equal(t, []uint64{3, 4, 5, 6}, receiveBurnIDs(t, stream, 4))
+
+	cancel()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end after the client went away")
+	}
+}
+
+func TestStreamBurnEvents_ReplayIsBounded(t *testing.T) {
+	f := SetupTestSuite(t)
+	recordBurns(f, 10, keeper.BurnStreamBufferSize+10)
+
+	replay, _, cancel := f.Keeper.SubscribeBurnEvents()
+	defer cancel()
+
+	// Only the latest BurnStreamBufferSize burns are held, oldest first
+	require.Len(t, replay, keeper.BurnStreamBufferSize)
+	require.Equal(t, uint64(11), replay[0].BurnId)
+	require.Equal(t, uint64(keeper.BurnStreamBufferSize+10), replay[len(replay)-1].BurnId)
+}
+
+func TestStreamBurnEvents_SlowSubscriberIsDropped(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	_, updates, cancel := f.Keeper.SubscribeBurnEvents()
+	defer cancel()
+
+	// Nobody reads: one burn past the buffer closes the subscription. The
+	// burns span two blocks because a single publish never sends more than
+	// the buffer holds.
+	recordBurns(f, 10, 1)
+	recordBurns(f, 11, keeper.BurnStreamBufferSize)
+
+	received := 0
+	for range updates {
+		received++
+	}
+	require.Equal(t, keeper.BurnStreamBufferSize, received)
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func TestBurnsBreakdown_AllSourcesWithTotal(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+	require.NoError(t, f.Keeper.SetCurrentSupply(ctx, math.NewInt(1_000_000)))
+	require.NoError(t, f.Keeper.SetTreasuryAddress(ctx, sdk.AccAddress("treasury____________")))
+
+	burner := sdk.AccAddress("burner______________")
+	f.BankKeeper.fundAccount(burner, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 100_000)))
+
+	burns := []struct {
+		source types.BurnSource
+		amount int64
+	}{
+		{types.BurnSource_BURN_SOURCE_POS_GAS, 1_000},
+		{types.BurnSource_BURN_SOURCE_POS_GAS, 500},
+		{types.BurnSource_BURN_SOURCE_POC_ANCHORING, 2_000},
+		{types.BurnSource_BURN_SOURCE_AI_QUERIES, 330},
+		{types.BurnSource_BURN_SOURCE_SLASHING, 7_000},
+	}
+	burnedBefore := f.Keeper.GetTotalBurned(ctx)
+	want := make(map[types.BurnSource]math.Int)
+	for _, b := range burns {
+		burned, _, err := f.Keeper.BurnTokens(ctx, burner, math.NewInt(b.amount), b.source, "omniphi-core-1")
+		require.NoError(t, err)
+		if prev, ok := want[b.source]; ok {
+			burned = prev.Add(burned)
+		}
+		want[b.source] = burned
+	}
+	total := math.ZeroInt()
+	for _, amount := range want {
+		total = total.Add(amount)
+	}
+
+	res, err := keeper.NewQueryServerImpl(f.Keeper).BurnsBreakdown(ctx, &types.QueryBurnsBreakdownRequest{})
+	require.NoError(t, err)
+
+	// Every source except UNSPECIFIED is listed in enum order, zero if unused
+	require.Len(t, res.Sources, len(types.BurnSource_name)-1)
+	for i, s := range res.Sources {
+		source := types.BurnSource(i + 1)
+		require.Equal(t, source.String(), s.Source)
+
+		expected, ok := want[source]
+		if !ok {
+			expected = math.ZeroInt()
+		}
+		require.Equal(t, expected.String(), s.TotalBurned.String(), s.Source)
+		require.Equal(t, f.Keeper.GetBurnsBySource(ctx, source).String(), s.TotalBurned.String(), s.Source)
+	}
+
+	require.Equal(t, total.String(), res.TotalBurned.String())
+	require.Equal(t, f.Keeper.GetTotalBurned(ctx).Sub(burnedBefore).String(), res.TotalBurned.String())
+}
+
+func TestBurnsBreakdown_NoBurns(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	res, err := keeper.NewQueryServerImpl(f.Keeper).BurnsBreakdown(f.Ctx, &types.QueryBurnsBreakdownRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Sources, len(types.BurnSource_name)-1)
+	for _, s := range res.Sources {
+		require.True(t, s.TotalBurned.IsZero(), s.Source)
+	}
+	require.True(t, res.TotalBurned.IsZero())
+}
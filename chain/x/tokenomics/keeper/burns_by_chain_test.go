@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func TestBurnsByChain_PerChainTotalsAndAggregate(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+	require.NoError(t, f.Keeper.SetCurrentSupply(ctx, math.NewInt(1_000_000)))
+
+	// Local burns are recorded with their chain ID
+	burner := sdk.AccAddress([]byte("burner______________"))
+	f.Keeper.StoreBurnRecord(ctx, burner, math.NewInt(120), math.NewInt(100), math.NewInt(20),
+		types.BurnSource_BURN_SOURCE_POS_GAS, "omniphi-core-1")
+	f.Keeper.StoreBurnRecord(ctx, burner, math.NewInt(60), math.NewInt(50), math.NewInt(10),
+		types.BurnSource_BURN_SOURCE_MESSAGING, "omniphi-core-1")
+
+	// Cross-chain burns arrive as reports
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	for _, amount := range []int64{300, 200} {
+		_, err := ms.ReportBurn(ctx, &types.MsgReportBurn{
+			Reporter: burner.String(),
+			ChainId:  "omniphi-continuity-1",
+			Amount:   math.NewInt(amount),
+			Source:   types.BurnSource_BURN_SOURCE_POC_ANCHORING,
+			TxHash:   "ABCD",
+			Proof:    []byte("proof"),
+		})
+		require.NoError(t, err)
+	}
+	f.Keeper.IncrementBurnsByChain(ctx, "omniphi-sequencer-1", math.NewInt(75))
+
+	require.Equal(t, "150", f.Keeper.GetBurnsByChain(ctx, "omniphi-core-1").String())
+	require.Equal(t, "500", f.Keeper.GetBurnsByChain(ctx, "omniphi-continuity-1").String())
+	require.Equal(t, "75", f.Keeper.GetBurnsByChain(ctx, "omniphi-sequencer-1").String())
+	require.True(t, f.Keeper.GetBurnsByChain(ctx, "unknown-1").IsZero())
+
+	qs := keeper.NewQueryServerImpl(f.Keeper)
+	one, err := qs.BurnsByChain(ctx, &types.QueryBurnsByChainRequest{ChainId: "omniphi-continuity-1"})
+	require.NoError(t, err)
+	require.Equal(t, "500", one.TotalBurnedOnChain.String())
+
+	all, err := qs.AllBurnsByChain(ctx, &types.QueryAllBurnsByChainRequest{})
+	require.NoError(t, err)
+	got := make([]string, len(all.Chains))
+	for i, c := range all.Chains {
+		got[i] = c.ChainId + "=" + c.TotalBurned.String()
+	}
+	require.Equal(t, []string{"omniphi-continuity-1=500", "omniphi-core-1=150", "omniphi-sequencer-1=75"}, got)
+	require.Equal(t, "725", all.TotalBurned.String())
+}
+
+func TestBurnsByChain_EmptyStore(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	require.Empty(t, f.Keeper.GetAllBurnsByChain(f.Ctx))
+	res := types.NewQueryAllBurnsByChainResponse(f.Keeper.GetAllBurnsByChain(f.Ctx))
+	require.NotNil(t, res.Chains)
+	require.True(t, res.TotalBurned.IsZero())
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// ExecuteBuyAndBurn burns the OMNI that the treasury redirect has sent to the
+// buy-and-burn target. It runs every BuyAndBurnInterval blocks and is disabled
+// while the interval is zero or no target is set.
+//
+// v1 burns OMNI held by the target directly. Other denoms are left in place
+// until a swap route exists to convert them. The transfer, burn and burn
+// accounting commit together or not at all.
+func (k Keeper) ExecuteBuyAndBurn(ctx context.Context) (math.Int, error) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	burned, err := k.executeBuyAndBurn(cacheCtx)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	write()
+	return burned, nil
+}
+
+func (k Keeper) executeBuyAndBurn(ctx context.Context) (math.Int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+
+	if params.BuyAndBurnInterval == 0 {
+		return math.ZeroInt(), nil
+	}
+
+	addr := k.GetBuyAndBurnAddress(ctx)
+	if addr.Empty() {
+		return math.ZeroInt(), nil
+	}
+
+	currentHeight := sdkCtx.BlockHeight()
+	if currentHeight-k.GetLastBuyAndBurnHeight(ctx) < int64(params.BuyAndBurnInterval) {
+		return math.ZeroInt(), nil
+	}
+	k.SetLastBuyAndBurnHeight(ctx, currentHeight)
+
+	amount := k.bankKeeper.GetBalance(ctx, addr, types.BondDenom).Amount
+	if !amount.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	currentSupply := k.GetCurrentSupply(ctx)
+	if currentSupply.LT(amount) {
+		return math.ZeroInt(), types.ErrInsufficientSupply
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to transfer buy-and-burn balance: %w", err)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to burn buy-and-burn balance: %w", err)
+	}
+
+	// P0-ACCT-001: Update supply counters
+	newSupply := currentSupply.Sub(amount)
+	newBurned := k.GetTotalBurned(ctx).Add(amount)
+	if err := k.SetCurrentSupply(ctx, newSupply); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to update current supply: %w", err)
+	}
+	if err := k.SetTotalBurned(ctx, newBurned); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to update total burned: %w", err)
+	}
+
+	// Nothing is redirected back to the treasury: these funds came from it
+	k.StoreBurnRecord(ctx, addr, amount, amount, math.ZeroInt(), types.BurnSource_BURN_SOURCE_BUY_AND_BURN, sdkCtx.ChainID())
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBuyAndBurn,
+			sdk.NewAttribute(types.AttributeKeyBuyAndBurnAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountBurned, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnSource, types.BurnSource_BURN_SOURCE_BUY_AND_BURN.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, newSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", currentHeight)),
+		),
+	)
+
+	k.Logger(ctx).Info("buy-and-burn executed",
+		"address", addr.String(),
+		"burned", amount.String(),
+		"new_supply", newSupply.String(),
+	)
+
+	return amount, nil
+}
+
+// GetLastBuyAndBurnHeight returns the block height of the last buy-and-burn run
+func (k Keeper) GetLastBuyAndBurnHeight(ctx context.Context) int64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyLastBuyAndBurnHeight)
+	if err != nil || len(bz) != 8 {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetLastBuyAndBurnHeight sets the block height of the last buy-and-burn run
+func (k Keeper) SetLastBuyAndBurnHeight(ctx context.Context, height int64) {
+	store := k.storeService.OpenKVStore(ctx)
+	_ = store.Set(types.KeyLastBuyAndBurnHeight, sdk.Uint64ToBigEndian(uint64(height)))
+}
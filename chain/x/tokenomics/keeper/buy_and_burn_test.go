@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/types"
+)
+
+// setupBuyAndBurn funds a buy-and-burn target with OMNI and a foreign denom
+// and sets the buy-and-burn interval
+func setupBuyAndBurn(t *testing.T, interval uint64) (*TestSuiteWrapper, sdk.AccAddress) {
+	t.Helper()
+	f := SetupTestSuite(t)
+
+	target := sdk.AccAddress("buy_and_burn________")
+	require.NoError(t, f.Keeper.SetBuyAndBurnAddress(f.Ctx, target))
+	f.BankKeeper.fundAccount(target, sdk.NewCoins(
+		sdk.NewInt64Coin(types.BondDenom, 5_000),
+		sdk.NewInt64Coin("uatom", 7),
+	))
+	require.NoError(t, f.Keeper.SetCurrentSupply(f.Ctx, math.NewInt(1_000_000)))
+
+	params := f.Keeper.GetParams(f.Ctx)
+	params.BuyAndBurnInterval = interval
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	return f, target
+}
+
+func TestExecuteBuyAndBurn_BurnsAccumulatedOmni(t *testing.T) {
+	f, target := setupBuyAndBurn(t, 100)
+	ctx := f.Ctx.WithBlockHeight(100)
+	burnedBefore := f.Keeper.GetTotalBurned(ctx)
+
+	burned, err := f.Keeper.ExecuteBuyAndBurn(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "5000", burned.String())
+
+	// OMNI is burned; other denoms wait for a swap route
+	require.True(t, f.BankKeeper.GetBalance(ctx, target, types.BondDenom).Amount.IsZero())
+	require.Equal(t, int64(7), f.BankKeeper.GetBalance(ctx, target, "uatom").Amount.Int64())
+
+	// Supply accounting
+	require.Equal(t, "995000", f.Keeper.GetCurrentSupply(ctx).String())
+	require.Equal(t, burnedBefore.AddRaw(5_000).String(), f.Keeper.GetTotalBurned(ctx).String())
+	require.Equal(t, "5000", f.Keeper.GetBurnsBySource(ctx, types.BurnSource_BURN_SOURCE_BUY_AND_BURN).String())
+	require.Equal(t, "5000", f.Keeper.GetBurnsByChain(ctx, ctx.ChainID()).String())
+
+	record, found := f.Keeper.GetBurnRecord(ctx, f.Keeper.GetNextBurnID(ctx)-1)
+	require.True(t, found)
+	require.Equal(t, types.BurnSource_BURN_SOURCE_BUY_AND_BURN, record.Source)
+	require.Equal(t, target.String(), record.BurnerAddress)
+	require.Equal(t, "5000", record.Amount.String())
+	require.True(t, record.TreasuryRedirect.IsZero())
+
+	// Event
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeBuyAndBurn))
+	require.Equal(t, "5000", eventAttribute(ctx, types.EventTypeBuyAndBurn, types.AttributeKeyAmountBurned))
+	require.Equal(t, target.String(), eventAttribute(ctx, types.EventTypeBuyAndBurn, types.AttributeKeyBuyAndBurnAddress))
+	require.Equal(t, "995000", eventAttribute(ctx, types.EventTypeBuyAndBurn, types.AttributeKeyTotalSupply))
+}
+
+func TestExecuteBuyAndBurn_RunsOnInterval(t *testing.T) {
+	f, target := setupBuyAndBurn(t, 100)
+
+	burned, err := f.Keeper.ExecuteBuyAndBurn(f.Ctx.WithBlockHeight(100))
+	require.NoError(t, err)
+	require.Equal(t, "5000", burned.String())
+
+	// Funds arriving mid-interval wait for the next run
+	f.BankKeeper.fundAccount(target, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 300)))
+	burned, err = f.Keeper.ExecuteBuyAndBurn(f.Ctx.WithBlockHeight(199))
+	require.NoError(t, err)
+	require.True(t, burned.IsZero())
+	require.Equal(t, int64(300), f.BankKeeper.GetBalance(f.Ctx, target, types.BondDenom).Amount.Int64())
+
+	burned, err = f.Keeper.ExecuteBuyAndBurn(f.Ctx.WithBlockHeight(200))
+	require.NoError(t, err)
+	require.Equal(t, "300", burned.String())
+	require.Equal(t, "5300", f.Keeper.GetBurnsBySource(f.Ctx, types.BurnSource_BURN_SOURCE_BUY_AND_BURN).String())
+	require.Equal(t, int64(200), f.Keeper.GetLastBuyAndBurnHeight(f.Ctx))
+}
+
+func TestExecuteBuyAndBurn_Disabled(t *testing.T) {
+	f, target := setupBuyAndBurn(t, 0)
+	ctx := f.Ctx.WithBlockHeight(1_000)
+
+	burned, err := f.Keeper.ExecuteBuyAndBurn(ctx)
+	require.NoError(t, err)
+	require.True(t, burned.IsZero())
+	require.Equal(t, int64(5_000), f.BankKeeper.GetBalance(ctx, target, types.BondDenom).Amount.Int64())
+	require.True(t, f.Keeper.GetBurnsBySource(ctx, types.BurnSource_BURN_SOURCE_BUY_AND_BURN).IsZero())
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeBuyAndBurn))
+}
+
+func TestExecuteBuyAndBurn_SupplyUnderflowRollsBack(t *testing.T) {
+	f, target := setupBuyAndBurn(t, 100)
+	ctx := f.Ctx.WithBlockHeight(100)
+	require.NoError(t, f.Keeper.SetCurrentSupply(ctx, math.NewInt(1_000)))
+
+	_, err := f.Keeper.ExecuteBuyAndBurn(ctx)
+	require.ErrorIs(t, err, types.ErrInsufficientSupply)
+
+	// Nothing is recorded and the run is retried next block
+	require.Equal(t, int64(5_000), f.BankKeeper.GetBalance(ctx, target, types.BondDenom).Amount.Int64())
+	require.Equal(t, "1000", f.Keeper.GetCurrentSupply(ctx).String())
+	require.True(t, f.Keeper.GetBurnsBySource(ctx, types.BurnSource_BURN_SOURCE_BUY_AND_BURN).IsZero())
+	require.Zero(t, f.Keeper.GetLastBuyAndBurnHeight(ctx))
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+const testTreasuryModule = "treasury"
+
+// setupCirculatingSupply points the treasury at its own module account and
+// gives a user the whole tracked supply
+func setupCirculatingSupply(t *testing.T) (*TestSuiteWrapper, sdk.AccAddress, math.Int) {
+	t.Helper()
+	f := SetupTestSuite(t)
+
+	supply := math.NewInt(1_000_000)
+	require.NoError(t, f.Keeper.SetCurrentSupply(f.Ctx, supply))
+	require.NoError(t, f.Keeper.SetTreasuryAddress(f.Ctx, authtypes.NewModuleAddress(testTreasuryModule)))
+
+	user := sdk.AccAddress([]byte("circulating_user____"))
+	f.BankKeeper.balances[user.String()] = sdk.NewCoins(sdk.NewCoin(types.BondDenom, supply))
+
+	return f, user, supply
+}
+
+func TestCirculatingSupply_TreasuryDepositReducesCirculating(t *testing.T) {
+	f, user, supply := setupCirculatingSupply(t)
+	require.True(t, f.Keeper.GetCirculatingSupply(f.Ctx).Equal(supply))
+
+	deposit := math.NewInt(250_000)
+	require.NoError(t, f.BankKeeper.SendCoinsFromAccountToModule(f.Ctx, user, testTreasuryModule,
+		sdk.NewCoins(sdk.NewCoin(types.BondDenom, deposit))))
+
+	// Total supply is unchanged, circulating drops by the deposit
+	require.True(t, f.Keeper.GetCurrentSupply(f.Ctx).Equal(supply))
+	circulating := f.Keeper.GetCirculatingSupply(f.Ctx)
+	require.True(t, circulating.Equal(supply.Sub(deposit)), "circulating: %s", circulating)
+
+	res, err := keeper.NewQueryServerImpl(f.Keeper).Supply(f.Ctx, &types.QuerySupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, res.CurrentTotalSupply.Equal(supply))
+	require.True(t, res.CirculatingSupply.Equal(circulating))
+}
+
+func TestCirculatingSupply_DefaultExclusions(t *testing.T) {
+	f, user, supply := setupCirculatingSupply(t)
+
+	// Unclaimed emissions in the tokenomics module and queued timelock funds
+	for _, module := range []string{types.ModuleName, types.TimelockModuleName} {
+		require.NoError(t, f.BankKeeper.SendCoinsFromAccountToModule(f.Ctx, user, module,
+			sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewInt(100_000)))))
+	}
+
+	// Other denoms in excluded accounts do not count
+	timelock := authtypes.NewModuleAddress(types.TimelockModuleName).String()
+	f.BankKeeper.balances[timelock] = f.BankKeeper.balances[timelock].Add(sdk.NewInt64Coin("uatom", 5))
+
+	require.True(t, f.Keeper.GetNonCirculatingSupply(f.Ctx).Equal(math.NewInt(200_000)))
+	require.True(t, f.Keeper.GetCirculatingSupply(f.Ctx).Equal(supply.Sub(math.NewInt(200_000))))
+}
+
+func TestCirculatingSupply_ConfigurableExclusions(t *testing.T) {
+	f, user, supply := setupCirculatingSupply(t)
+
+	vesting := sdk.AccAddress([]byte("vesting_account_____"))
+	require.NoError(t, f.BankKeeper.SendCoins(f.Ctx, user, vesting,
+		sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewInt(300_000)))))
+	require.NoError(t, f.BankKeeper.SendCoinsFromAccountToModule(f.Ctx, user, types.TimelockModuleName,
+		sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewInt(100_000)))))
+
+	// Vesting accounts circulate until listed
+	require.True(t, f.Keeper.GetCirculatingSupply(f.Ctx).Equal(supply.Sub(math.NewInt(100_000))))
+
+	// Listing the vesting account and dropping the timelock swaps which balance is excluded
+	require.NoError(t, f.Keeper.SetNonCirculatingAccounts(f.Ctx, types.NonCirculatingAccounts{
+		ModuleAccounts: []string{types.ModuleName},
+		Addresses:      []string{vesting.String()},
+	}))
+	require.True(t, f.Keeper.GetCirculatingSupply(f.Ctx).Equal(supply.Sub(math.NewInt(300_000))))
+
+	// Listing the treasury again does not subtract it twice
+	require.NoError(t, f.BankKeeper.SendCoinsFromAccountToModule(f.Ctx, user, testTreasuryModule,
+		sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewInt(50_000)))))
+	require.NoError(t, f.Keeper.SetNonCirculatingAccounts(f.Ctx, types.NonCirculatingAccounts{
+		ModuleAccounts: []string{testTreasuryModule},
+	}))
+	require.True(t, f.Keeper.GetCirculatingSupply(f.Ctx).Equal(supply.Sub(math.NewInt(50_000))))
+}
+
+func TestCirculatingSupply_InvalidExclusionsRejected(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	require.Error(t, f.Keeper.SetNonCirculatingAccounts(f.Ctx, types.NonCirculatingAccounts{
+		ModuleAccounts: []string{""},
+	}))
+	require.Error(t, f.Keeper.SetNonCirculatingAccounts(f.Ctx, types.NonCirculatingAccounts{
+		Addresses: []string{"not-an-address"},
+	}))
+	require.Equal(t, types.DefaultNonCirculatingAccounts(), f.Keeper.GetNonCirculatingAccounts(f.Ctx))
+}
+
+func TestCirculatingSupply_FlooredAtZero(t *testing.T) {
+	f, user, _ := setupCirculatingSupply(t)
+
+	// Tracked supply behind the bank: exclusions can exceed it
+	require.NoError(t, f.BankKeeper.SendCoinsFromAccountToModule(f.Ctx, user, testTreasuryModule,
+		sdk.NewCoins(sdk.NewCoin(types.BondDenom, math.NewInt(900_000)))))
+	require.NoError(t, f.Keeper.SetCurrentSupply(f.Ctx, math.NewInt(500_000)))
+	require.True(t, f.Keeper.GetCirculatingSupply(f.Ctx).IsZero())
+}
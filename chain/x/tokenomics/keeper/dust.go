@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+)
+
+// Rounding dust
+//
+// Splitting an integer amount by decimal ratios leaves a remainder of at most
+// len(ratios)-1 base units once each share is truncated. Every payout path
+// that splits by ratios (emissions, treasury redirects) hands that dust to one
+// recipient chosen only from the ratios, so all nodes agree on it and the
+// outputs always sum exactly to the input. Callers control which recipient
+// absorbs the dust by ordering the ratios.
+
+// distributeWithDust splits total by ratios, truncating each share and adding
+// the remainder to the final nonzero share. The amounts always sum to total.
+// With no nonzero ratio the whole amount goes to the last entry.
+func distributeWithDust(total math.Int, ratios []math.LegacyDec) []math.Int {
+	amounts := make([]math.Int, len(ratios))
+	if len(ratios) == 0 {
+		return amounts
+	}
+
+	distributed := math.ZeroInt()
+	for i, ratio := range ratios {
+		amounts[i] = ratio.MulInt(total).TruncateInt()
+		distributed = distributed.Add(amounts[i])
+	}
+
+	last := dustIndex(ratios)
+	amounts[last] = amounts[last].Add(total.Sub(distributed))
+	return amounts
+}
+
+// dustIndex returns the entry distributeWithDust gives the remainder to: the
+// final nonzero ratio, or the last entry if every ratio is zero
+func dustIndex(ratios []math.LegacyDec) int {
+	for i := len(ratios) - 1; i >= 0; i-- {
+		if !ratios[i].IsNil() && ratios[i].IsPositive() {
+			return i
+		}
+	}
+	return len(ratios) - 1
+}
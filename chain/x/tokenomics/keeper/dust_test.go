@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func decs(values ...string) []math.LegacyDec {
+	out := make([]math.LegacyDec, len(values))
+	for i, v := range values {
+		out[i] = math.LegacyMustNewDecFromStr(v)
+	}
+	return out
+}
+
+// randomRatios returns n non-negative ratios that sum exactly to 1; truncating
+// the quotients keeps the last one from going negative
+func randomRatios(r *rand.Rand, n int) []math.LegacyDec {
+	weights := make([]int64, n)
+	var sum int64
+	for i := range weights {
+		weights[i] = r.Int63n(1_000_000_007)
+		sum += weights[i]
+	}
+	if sum == 0 {
+		weights[0], sum = 1, 1
+	}
+
+	ratios := make([]math.LegacyDec, n)
+	assigned := math.LegacyZeroDec()
+	for i := 0; i < n-1; i++ {
+		ratios[i] = math.LegacyNewDec(weights[i]).QuoTruncate(math.LegacyNewDec(sum))
+		assigned = assigned.Add(ratios[i])
+	}
+	ratios[n-1] = math.LegacyOneDec().Sub(assigned)
+	return ratios
+}
+
+func requireConserved(t *testing.T, total math.Int, ratios []math.LegacyDec) []math.Int {
+	t.Helper()
+	amounts := keeper.DistributeWithDust(total, ratios)
+	require.Len(t, amounts, len(ratios))
+
+	sum := math.ZeroInt()
+	for i, amount := range amounts {
+		require.False(t, amount.IsNegative(), "ratios %v total %s: amount %d is negative", ratios, total, i)
+		sum = sum.Add(amount)
+	}
+	require.Equal(t, total.String(), sum.String(), "ratios %v", ratios)
+	return amounts
+}
+
+func TestDistributeWithDust_DustGoesToFinalNonzeroShare(t *testing.T) {
+	// 40/30/20/10 of 1003 truncates to 401/300/200/100, leaving 2 of dust
+	amounts := requireConserved(t, math.NewInt(1003), decs("0.4", "0.3", "0.2", "0.1"))
+	require.Equal(t, []string{"401", "300", "200", "102"}, intStrings(amounts))
+
+	// Trailing zero shares never receive dust
+	amounts = requireConserved(t, math.NewInt(1003), decs("0.5", "0.5", "0", "0"))
+	require.Equal(t, []string{"501", "502", "0", "0"}, intStrings(amounts))
+
+	// Without any nonzero share the last entry takes everything
+	amounts = requireConserved(t, math.NewInt(7), decs("0", "0"))
+	require.Equal(t, []string{"0", "7"}, intStrings(amounts))
+
+	require.Empty(t, keeper.DistributeWithDust(math.NewInt(7), nil))
+}
+
+func TestDistributeWithDust_ConservesAcrossTotalsAndRatios(t *testing.T) {
+	ratioSets := [][]math.LegacyDec{
+		decs("1"),
+		decs("0.4", "0.3", "0.2", "0.1"),
+		decs("0.25", "0.25", "0.25", "0.25"),
+		decs("0.333333333333333333", "0.333333333333333333", "0.333333333333333334"),
+		decs("0.3", "0.25", "0.15", "0.1", "0.2"),
+		decs("0.000000000000000001", "0.999999999999999999"),
+		decs("0.6", "0", "0.4", "0"),
+	}
+	r := rand.New(rand.NewSource(1572))
+	for i := 0; i < 50; i++ {
+		ratioSets = append(ratioSets, randomRatios(r, 1+r.Intn(8)))
+	}
+
+	totals := []math.Int{
+		math.ZeroInt(),
+		math.OneInt(),
+		math.NewInt(7),
+		math.NewInt(999),
+		math.NewInt(1_000_001),
+		math.NewInt(22_500_000_000_000),
+		math.NewIntWithDecimal(1, 30).AddRaw(17),
+	}
+	for i := 0; i < 50; i++ {
+		totals = append(totals, math.NewInt(r.Int63()))
+	}
+
+	for _, ratios := range ratioSets {
+		for _, total := range totals {
+			amounts := requireConserved(t, total, ratios)
+			// The dust is below one unit per share
+			for i, amount := range amounts {
+				exact := ratios[i].MulInt(total)
+				require.True(t, math.LegacyNewDecFromInt(amount).Sub(exact).LT(math.LegacyNewDec(int64(len(ratios)))))
+			}
+		}
+	}
+}
+
+func TestDistributeEmissions_ZeroTreasuryShareSkipsDust(t *testing.T) {
+	f := SetupTestSuite(t)
+	params := f.Keeper.GetParams(f.Ctx)
+	params.EmissionSplitStaking = math.LegacyNewDecWithPrec(50, 2)
+	params.EmissionSplitPoc = math.LegacyNewDecWithPrec(30, 2)
+	params.EmissionSplitSequencer = math.LegacyNewDecWithPrec(20, 2)
+	params.EmissionSplitTreasury = math.LegacyZeroDec()
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	// 50/30/20/0 of 1003 truncates to 501/300/200/0; staking takes the 2 of dust
+	require.NoError(t, f.Keeper.DistributeEmissions(f.Ctx, math.NewInt(1003)))
+	requireEmissionConserved(t, f)
+	require.Equal(t, "503", emissionEventAmount(t, f, types.AttributeKeyToStaking).String())
+	require.Equal(t, "0", emissionEventAmount(t, f, types.AttributeKeyToTreasury).String())
+	require.Equal(t, types.EmissionCategoryStaking,
+		eventAttribute(f.Ctx, types.EventTypeEmission, types.AttributeKeyDustRecipient))
+}
+
+func intStrings(amounts []math.Int) []string {
+	out := make([]string, len(amounts))
+	for i, amount := range amounts {
+		out[i] = amount.String()
+	}
+	return out
+}
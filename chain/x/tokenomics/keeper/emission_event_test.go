@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/types"
+)
+
+// emissionEventAmount parses an Int attribute of the last tokenomics_emission event
+func emissionEventAmount(t *testing.T, f *TestSuiteWrapper, key string) math.Int {
+	t.Helper()
+	value := eventAttribute(f.Ctx, types.EventTypeEmission, key)
+	require.NotEmpty(t, value, "missing attribute %s", key)
+	amount, ok := math.NewIntFromString(value)
+	require.True(t, ok, "attribute %s is not an integer: %q", key, value)
+	return amount
+}
+
+// requireEmissionConserved checks that the event's split sums to the minted total
+func requireEmissionConserved(t *testing.T, f *TestSuiteWrapper) math.Int {
+	t.Helper()
+	total := emissionEventAmount(t, f, types.AttributeKeyTotalMinted)
+	sum := emissionEventAmount(t, f, types.AttributeKeyToStaking).
+		Add(emissionEventAmount(t, f, types.AttributeKeyToPoc)).
+		Add(emissionEventAmount(t, f, types.AttributeKeyToSequencer)).
+		Add(emissionEventAmount(t, f, types.AttributeKeyToTreasury))
+	require.Equal(t, total.String(), sum.String())
+	return total
+}
+
+func TestDistributeEmissions_EmitsEvent(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	// 40/30/20/10 of 1003 truncates to 401/300/200/100, leaving 2 of dust
+	require.NoError(t, f.Keeper.DistributeEmissions(f.Ctx, math.NewInt(1003)))
+	require.Equal(t, 1, countEvents(f.Ctx, types.EventTypeEmission))
+
+	total := requireEmissionConserved(t, f)
+	require.Equal(t, "1003", total.String())
+	require.Equal(t, "401", emissionEventAmount(t, f, types.AttributeKeyToStaking).String())
+	require.Equal(t, "300", emissionEventAmount(t, f, types.AttributeKeyToPoc).String())
+	require.Equal(t, "200", emissionEventAmount(t, f, types.AttributeKeyToSequencer).String())
+	require.Equal(t, "102", emissionEventAmount(t, f, types.AttributeKeyToTreasury).String())
+	require.Equal(t, "2", emissionEventAmount(t, f, types.AttributeKeyDustRemainder).String())
+	require.Equal(t, types.EmissionCategoryTreasury,
+		eventAttribute(f.Ctx, types.EventTypeEmission, types.AttributeKeyDustRecipient))
+}
+
+func TestDistributeEmissions_EventConservation(t *testing.T) {
+	for _, amount := range []int64{1, 7, 10, 999, 1_000_000, 123_456_789} {
+		f := SetupTestSuite(t)
+		require.NoError(t, f.Keeper.DistributeEmissions(f.Ctx, math.NewInt(amount)))
+
+		total := requireEmissionConserved(t, f)
+		require.Equal(t, amount, total.Int64())
+		require.True(t, emissionEventAmount(t, f, types.AttributeKeyDustRemainder).LT(math.NewInt(4)))
+	}
+}
+
+func TestMintInflation_EmitsEmissionEvent(t *testing.T) {
+	f := SetupTestSuite(t)
+	f.Ctx = f.Ctx.WithBlockHeight(1)
+
+	require.NoError(t, f.Keeper.MintInflation(f.Ctx))
+	total := requireEmissionConserved(t, f)
+	require.Equal(t, eventAttribute(f.Ctx, types.EventTypeMint, types.AttributeKeyBlockProvision), total.String())
+}
@@ -0,0 +1,348 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func newSplit(staking, poc, sequencer, treasury int64) types.EmissionSplit {
+	return types.EmissionSplit{
+		Staking:   math.LegacyNewDecWithPrec(staking, 2),
+		Poc:       math.LegacyNewDecWithPrec(poc, 2),
+		Sequencer: math.LegacyNewDecWithPrec(sequencer, 2),
+		Treasury:  math.LegacyNewDecWithPrec(treasury, 2),
+	}
+}
+
+// fiveRecipients adds a grants module to the built-in recipients
+func fiveRecipients() types.EmissionRecipients {
+	return types.EmissionRecipients{
+		{Module: types.EmissionCategoryStaking, Share: math.LegacyNewDecWithPrec(30, 2)},
+		{Module: types.EmissionCategoryPoc, Share: math.LegacyNewDecWithPrec(25, 2)},
+		{Module: types.EmissionCategorySequencer, Share: math.LegacyNewDecWithPrec(15, 2)},
+		{Module: types.EmissionCategoryTreasury, Share: math.LegacyNewDecWithPrec(10, 2)},
+		{Module: "grants", Share: math.LegacyNewDecWithPrec(20, 2)},
+	}
+}
+
+func TestStageEmissionSplit_ActivatesAtEpochBoundary(t *testing.T) {
+	f := SetupTestSuite(t)
+	k := f.Keeper
+	defaultSplit := types.DefaultParams().EmissionSplit()
+	staged := newSplit(35, 35, 20, 10)
+
+	// RewardStreamInterval defaults to 100 blocks: height 150 is epoch 1
+	ctx := f.Ctx.WithBlockHeight(150)
+	_, err := k.StageEmissionSplit(ctx, 2, staged)
+	require.NoError(t, err)
+
+	pending := k.GetPendingChanges(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, types.EmissionSplitChangeSource, pending[0].Source)
+	require.Equal(t, int64(2), pending[0].EffectiveEpoch)
+
+	// The rest of epoch 1 still pays out under the active split
+	ctx = ctx.WithBlockHeight(199)
+	require.True(t, k.GetEmissionSplit(ctx).Equal(defaultSplit))
+	require.NoError(t, k.DistributeEmissions(ctx, math.NewInt(1000)))
+	require.Equal(t, "400", eventAttribute(ctx, types.EventTypeEmission, types.AttributeKeyToStaking))
+
+	// From the epoch 2 boundary emissions use the staged split, even before
+	// BeginBlock has promoted it
+	ctx = f.Ctx.WithBlockHeight(200)
+	require.True(t, k.GetParams(ctx).EmissionSplit().Equal(defaultSplit))
+	require.True(t, k.GetEmissionSplit(ctx).Equal(staged))
+	require.NoError(t, k.DistributeEmissions(ctx, math.NewInt(1000)))
+	require.Equal(t, "350", eventAttribute(ctx, types.EventTypeEmission, types.AttributeKeyToStaking))
+	require.Equal(t, "350", eventAttribute(ctx, types.EventTypeEmission, types.AttributeKeyToPoc))
+
+	// Promotion writes it into the active params and clears the queue
+	require.NoError(t, k.ApplyStagedChanges(ctx))
+	require.True(t, k.GetParams(ctx).EmissionSplit().Equal(staged))
+	require.True(t, k.GetEmissionSplit(ctx).Equal(staged))
+	require.Empty(t, k.GetPendingChanges(ctx))
+}
+
+func TestStageEmissionSplit_RewardSplitsUseStagedValue(t *testing.T) {
+	f := SetupTestSuite(t)
+	k := f.Keeper
+
+	_, err := k.StageEmissionSplit(f.Ctx.WithBlockHeight(50), 1, newSplit(60, 20, 10, 10))
+	require.NoError(t, err)
+
+	total := math.NewInt(1000)
+	before := k.CalculateRewardSplits(f.Ctx.WithBlockHeight(99), total)
+	after := k.CalculateRewardSplits(f.Ctx.WithBlockHeight(100), total)
+	require.Equal(t, types.EmissionCategoryStaking, before[0].Category)
+	require.Equal(t, "400", before[0].Amount.String())
+	require.Equal(t, types.EmissionCategoryStaking, after[0].Category)
+	require.Equal(t, "600", after[0].Amount.String())
+}
+
+func TestStageEmissionSplit_RejectsInvalidSplits(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(150)
+
+	tests := []struct {
+		name  string
+		split types.EmissionSplit
+		err   error
+	}{
+		{"sum below 100%", newSplit(40, 30, 20, 5), types.ErrEmissionSplitInvalid},
+		{"sum above 100%", newSplit(40, 30, 20, 20), types.ErrEmissionSplitInvalid},
+		{"staking below 20%", newSplit(15, 55, 20, 10), types.ErrProtocolCapViolation},
+		{"recipient above 60%", newSplit(20, 65, 10, 5), types.ErrProtocolCapViolation},
+		{"staking above 60%", newSplit(70, 10, 10, 10), types.ErrProtocolCapViolation},
+		{"empty share", types.EmissionSplit{Staking: math.LegacyOneDec()}, types.ErrEmissionSplitInvalid},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.Keeper.StageEmissionSplit(ctx, 2, tc.split)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+	require.Empty(t, f.Keeper.GetPendingChanges(ctx))
+
+	// A valid split still has to target a future epoch
+	_, err := f.Keeper.StageEmissionSplit(ctx, 1, newSplit(35, 35, 20, 10))
+	require.Error(t, err)
+	require.Empty(t, f.Keeper.GetPendingChanges(ctx))
+}
+
+func TestUpdateParams_StagesEmissionSplitForNextEpoch(t *testing.T) {
+	f := SetupTestSuite(t)
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	ctx := f.Ctx.WithBlockHeight(150)
+
+	params := f.Keeper.GetParams(ctx)
+	params.EmissionSplitStaking = math.LegacyNewDecWithPrec(35, 2)
+	params.EmissionSplitPoc = math.LegacyNewDecWithPrec(35, 2)
+	params.BurnRatePosGas = math.LegacyNewDecWithPrec(25, 2)
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: f.Keeper.GetAuthority(), Params: params})
+	require.NoError(t, err)
+
+	// Other params apply immediately; the split waits for epoch 2
+	active := f.Keeper.GetParams(ctx)
+	require.True(t, active.BurnRatePosGas.Equal(math.LegacyNewDecWithPrec(25, 2)))
+	require.True(t, active.EmissionSplit().Equal(types.DefaultParams().EmissionSplit()))
+
+	pending := f.Keeper.GetPendingChanges(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, int64(2), pending[0].EffectiveEpoch)
+
+	require.NoError(t, f.Keeper.ApplyStagedChanges(ctx.WithBlockHeight(200)))
+	require.True(t, f.Keeper.GetParams(ctx).EmissionSplit().Equal(params.EmissionSplit()))
+
+	// An unchanged split stages nothing
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: f.Keeper.GetAuthority(), Params: f.Keeper.GetParams(ctx)})
+	require.NoError(t, err)
+	require.Empty(t, f.Keeper.GetPendingChanges(ctx))
+}
+
+// TestParamValidation_EmissionSplitBounds tests the protocol bounds on splits
+func TestParamValidation_EmissionSplitBounds(t *testing.T) {
+	tests := []struct {
+		name  string
+		split types.EmissionSplit
+		err   error
+		msg   string
+	}{
+		{"staking at 19%", newSplit(19, 41, 30, 10), types.ErrProtocolCapViolation, ""},
+		{"staking at exactly 20%", newSplit(20, 40, 30, 10), nil, ""},
+		{"recipient at 61%", newSplit(20, 61, 9, 10), types.ErrProtocolCapViolation, ""},
+		{"recipient at exactly 60%", newSplit(20, 60, 10, 10), nil, ""},
+		{"staking at 61%", newSplit(61, 19, 10, 10), types.ErrProtocolCapViolation, ""},
+		{"staking at exactly 60%", newSplit(60, 20, 10, 10), nil, ""},
+		{"sum below 100%", newSplit(40, 30, 20, 9), types.ErrEmissionSplitInvalid, ""},
+		{"negative share", newSplit(40, 40, 30, -10), nil, "cannot be negative"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.EmissionSplitStaking = tc.split.Staking
+			params.EmissionSplitPoc = tc.split.Poc
+			params.EmissionSplitSequencer = tc.split.Sequencer
+			params.EmissionSplitTreasury = tc.split.Treasury
+
+			err := params.Validate()
+			switch {
+			case tc.msg != "":
+				require.ErrorContains(t, err, tc.msg)
+			case tc.err != nil:
+				require.ErrorIs(t, err, tc.err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmissionRecipients_DefaultsToFourBuiltIns(t *testing.T) {
+	params := types.DefaultParams()
+	require.Empty(t, params.EmissionRecipients)
+
+	recipients := params.EmissionRecipientList()
+	require.Len(t, recipients, 4)
+	require.True(t, recipients.EmissionSplit().Equal(params.EmissionSplit()))
+	for i, category := range types.EmissionCategories {
+		require.Equal(t, category, recipients[i].Module)
+	}
+}
+
+func TestDistributeEmissions_FiveRecipients(t *testing.T) {
+	f := SetupTestSuite(t)
+	f.AccountKeeper.addModuleAccount("grants")
+	params := f.Keeper.GetParams(f.Ctx)
+	params.EmissionRecipients = fiveRecipients()
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	// 30/25/15/10/20 of 1003 truncates to 300/250/150/100/200, leaving 3 of dust
+	require.NoError(t, f.Keeper.DistributeEmissions(f.Ctx, math.NewInt(1003)))
+	require.Equal(t, "300", emissionEventAmount(t, f, types.AttributeKeyToStaking).String())
+	require.Equal(t, "250", emissionEventAmount(t, f, types.AttributeKeyToPoc).String())
+	require.Equal(t, "150", emissionEventAmount(t, f, types.AttributeKeyToSequencer).String())
+	require.Equal(t, "103", emissionEventAmount(t, f, types.AttributeKeyToTreasury).String())
+	require.Equal(t, "200", emissionEventAmount(t, f, "to_grants").String())
+	require.Equal(t, types.EmissionCategoryTreasury,
+		eventAttribute(f.Ctx, types.EventTypeEmission, types.AttributeKeyDustRecipient))
+
+	// The grants share lands in its module account
+	grants := f.BankKeeper.GetBalance(f.Ctx, authtypes.NewModuleAddress("grants"), types.BondDenom)
+	require.Equal(t, "200", grants.Amount.String())
+
+	// Built-in categories are still tracked per category
+	require.Equal(t, "300", f.Keeper.GetLastEpochEmission(f.Ctx, types.EmissionCategoryStaking).String())
+	require.Equal(t, "103", f.Keeper.GetLastEpochEmission(f.Ctx, types.EmissionCategoryTreasury).String())
+}
+
+func TestDistributeEmissions_DustGoesToStakingWithoutTreasury(t *testing.T) {
+	f := SetupTestSuite(t)
+	f.AccountKeeper.addModuleAccount("grants")
+	params := f.Keeper.GetParams(f.Ctx)
+	params.EmissionRecipients = []types.EmissionRecipient{
+		{Module: types.EmissionCategoryStaking, Share: math.LegacyNewDecWithPrec(50, 2)},
+		{Module: types.EmissionCategoryPoc, Share: math.LegacyNewDecWithPrec(30, 2)},
+		{Module: "grants", Share: math.LegacyNewDecWithPrec(20, 2)},
+	}
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	require.NoError(t, f.Keeper.DistributeEmissions(f.Ctx, math.NewInt(1003)))
+	require.Equal(t, "503", emissionEventAmount(t, f, types.AttributeKeyToStaking).String())
+	require.Equal(t, "0", emissionEventAmount(t, f, types.AttributeKeyToTreasury).String())
+	require.Equal(t, types.EmissionCategoryStaking,
+		eventAttribute(f.Ctx, types.EventTypeEmission, types.AttributeKeyDustRecipient))
+}
+
+func TestParamValidation_EmissionRecipientBounds(t *testing.T) {
+	withShare := func(module string, pct int64) types.EmissionRecipients {
+		recipients := fiveRecipients()
+		for i := range recipients {
+			if recipients[i].Module == module {
+				recipients[i].Share = math.LegacyNewDecWithPrec(pct, 2)
+			}
+		}
+		return recipients
+	}
+
+	tests := []struct {
+		name       string
+		recipients types.EmissionRecipients
+		err        error
+	}{
+		{"five recipients", fiveRecipients(), nil},
+		{"sum above 100%", withShare("grants", 25), types.ErrEmissionSplitInvalid},
+		{"staking below 20%", types.EmissionRecipients{
+			{Module: types.EmissionCategoryStaking, Share: math.LegacyNewDecWithPrec(15, 2)},
+			{Module: types.EmissionCategoryPoc, Share: math.LegacyNewDecWithPrec(45, 2)},
+			{Module: "grants", Share: math.LegacyNewDecWithPrec(40, 2)},
+		}, types.ErrProtocolCapViolation},
+		{"no staking entry", types.EmissionRecipients{
+			{Module: types.EmissionCategoryPoc, Share: math.LegacyNewDecWithPrec(50, 2)},
+			{Module: "grants", Share: math.LegacyNewDecWithPrec(50, 2)},
+		}, types.ErrProtocolCapViolation},
+		{"new recipient above 60%", types.EmissionRecipients{
+			{Module: types.EmissionCategoryStaking, Share: math.LegacyNewDecWithPrec(35, 2)},
+			{Module: "grants", Share: math.LegacyNewDecWithPrec(65, 2)},
+		}, types.ErrProtocolCapViolation},
+		{"duplicate recipient", append(fiveRecipients()[:4],
+			types.EmissionRecipient{Module: types.EmissionCategoryPoc, Share: math.LegacyNewDecWithPrec(20, 2)},
+		), types.ErrEmissionSplitInvalid},
+		{"empty module", append(fiveRecipients()[:4],
+			types.EmissionRecipient{Share: math.LegacyNewDecWithPrec(20, 2)},
+		), types.ErrEmissionSplitInvalid},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.EmissionRecipients = tc.recipients
+
+			err := params.Validate()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateParams_StagesEmissionRecipientsForNextEpoch(t *testing.T) {
+	f := SetupTestSuite(t)
+	f.AccountKeeper.addModuleAccount("grants")
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	ctx := f.Ctx.WithBlockHeight(150)
+
+	params := f.Keeper.GetParams(ctx)
+	params.EmissionRecipients = fiveRecipients()
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: f.Keeper.GetAuthority(), Params: params})
+	require.NoError(t, err)
+
+	// The list waits for epoch 2, but emissions use it from the boundary
+	require.Empty(t, f.Keeper.GetParams(ctx).EmissionRecipients)
+	require.Len(t, f.Keeper.GetPendingChanges(ctx), 1)
+	require.True(t, f.Keeper.GetEmissionRecipients(ctx.WithBlockHeight(200)).Equal(fiveRecipients()))
+
+	require.NoError(t, f.Keeper.ApplyStagedChanges(ctx.WithBlockHeight(200)))
+	require.True(t, types.EmissionRecipients(f.Keeper.GetParams(ctx).EmissionRecipients).Equal(fiveRecipients()))
+	require.Equal(t, "0.300000000000000000", f.Keeper.GetEmissionSplit(ctx).Staking.String())
+
+	// Staging a four-way split switches back to the fixed recipients
+	ctx = ctx.WithBlockHeight(250)
+	_, err = f.Keeper.StageEmissionSplit(ctx, 3, newSplit(35, 35, 20, 10))
+	require.NoError(t, err)
+	require.NoError(t, f.Keeper.ApplyStagedChanges(ctx.WithBlockHeight(300)))
+
+	active := f.Keeper.GetParams(ctx)
+	require.Empty(t, active.EmissionRecipients)
+	require.True(t, active.EmissionRecipientList().Equal(newSplit(35, 35, 20, 10).Recipients()))
+}
+
+func TestEmissionRecipients_RejectsUnknownModule(t *testing.T) {
+	f := SetupTestSuite(t)
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	ctx := f.Ctx.WithBlockHeight(150)
+
+	// "grants" has no module account until the app defines one
+	_, err := f.Keeper.StageEmissionRecipients(ctx, 2, fiveRecipients())
+	require.ErrorIs(t, err, types.ErrEmissionSplitInvalid)
+
+	params := f.Keeper.GetParams(ctx)
+	params.EmissionRecipients = fiveRecipients()
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: f.Keeper.GetAuthority(), Params: params})
+	require.ErrorIs(t, err, types.ErrEmissionSplitInvalid)
+	require.Empty(t, f.Keeper.GetPendingChanges(ctx))
+
+	f.AccountKeeper.addModuleAccount("grants")
+	_, err = f.Keeper.StageEmissionRecipients(ctx, 2, fiveRecipients())
+	require.NoError(t, err)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// EMISSION ACCOUNTING
+// ============================================================================
+// Each emission epoch adds the amount transferred to every split category to
+// its cumulative total (RecordDistribution) and replaces the category's
+// last-epoch amount. The distribution paths run transfers and accounting in
+// one cache context, so the counters only move when the transfers commit.
+
+// RecordEpochEmission records one epoch's emission per category. Categories
+// missing from amounts received nothing this epoch.
+func (k Keeper) RecordEpochEmission(ctx context.Context, amounts map[string]math.Int) error {
+	for category := range amounts {
+		if err := types.ValidateEmissionCategory(category); err != nil {
+			return err
+		}
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	for _, category := range types.EmissionCategories {
+		amount, ok := amounts[category]
+		if !ok {
+			amount = math.ZeroInt()
+		}
+		if amount.IsPositive() {
+			if err := k.RecordDistribution(ctx, category, amount); err != nil {
+				return err
+			}
+		}
+		if err := store.Set(types.GetLastEpochEmissionKey(category), []byte(amount.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLastEpochEmission returns the amount emitted to a category in the last epoch
+func (k Keeper) GetLastEpochEmission(ctx context.Context, category string) math.Int {
+	return k.getIntFromStore(ctx, types.GetLastEpochEmissionKey(category))
+}
+
+// GetEmissionsHistory returns the cumulative and last-epoch emission per category
+func (k Keeper) GetEmissionsHistory(ctx context.Context) types.EmissionsHistory {
+	totals := make(map[string]math.Int, len(types.EmissionCategories))
+	lastEpoch := make(map[string]math.Int, len(types.EmissionCategories))
+	for _, category := range types.EmissionCategories {
+		totals[category] = k.GetCumulativeDistributed(ctx, category)
+		lastEpoch[category] = k.GetLastEpochEmission(ctx, category)
+	}
+	return types.NewEmissionsHistory(totals, lastEpoch, k.GetLastDistributionHeight(ctx))
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func historyByCategory(h types.EmissionsHistory) map[string]types.EmissionRecipientHistory {
+	out := make(map[string]types.EmissionRecipientHistory, len(h.Recipients))
+	for _, r := range h.Recipients {
+		out[r.Category] = r
+	}
+	return out
+}
+
+func TestEmissionsHistory_MintInflationEpochs(t *testing.T) {
+	f := SetupTestSuite(t)
+	treasury := sdk.AccAddress([]byte("emission_treasury___"))
+	require.NoError(t, f.Keeper.SetTreasuryAddress(f.Ctx, treasury))
+
+	sums := map[string]math.Int{
+		types.EmissionCategoryStaking:   math.ZeroInt(),
+		types.EmissionCategoryPoc:       math.ZeroInt(),
+		types.EmissionCategorySequencer: math.ZeroInt(),
+		types.EmissionCategoryTreasury:  math.ZeroInt(),
+	}
+
+	const epochs = 5
+	var ctx sdk.Context
+	for height := int64(1); height <= epochs; height++ {
+		ctx = f.Ctx.WithBlockHeight(height)
+		require.NoError(t, f.Keeper.MintInflation(ctx))
+
+		records, err := f.Keeper.GetLatestEmissionRecords(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		r := records[0]
+		sums[types.EmissionCategoryStaking] = sums[types.EmissionCategoryStaking].Add(r.ToStaking)
+		sums[types.EmissionCategoryPoc] = sums[types.EmissionCategoryPoc].Add(r.ToPoc)
+		sums[types.EmissionCategorySequencer] = sums[types.EmissionCategorySequencer].Add(r.ToSequencer)
+		sums[types.EmissionCategoryTreasury] = sums[types.EmissionCategoryTreasury].Add(r.ToTreasury)
+	}
+
+	history := f.Keeper.GetEmissionsHistory(ctx)
+	require.Equal(t, int64(epochs), history.LastEpochHeight)
+	byCategory := historyByCategory(history)
+	for category, sum := range sums {
+		require.True(t, sum.IsPositive(), "%s received nothing", category)
+		require.True(t, byCategory[category].TotalEmitted.Equal(sum), "%s: recorded %s, emitted %s",
+			category, byCategory[category].TotalEmitted, sum)
+	}
+
+	// The recorded totals match what the transfers actually moved
+	balance := func(addr sdk.AccAddress) math.Int {
+		return f.BankKeeper.GetBalance(ctx, addr, types.BondDenom).Amount
+	}
+	require.True(t, balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName)).Equal(sums[types.EmissionCategoryStaking]))
+	require.True(t, balance(authtypes.NewModuleAddress(types.EmissionCategoryPoc)).Equal(sums[types.EmissionCategoryPoc]))
+	// Without a sequencer IBC channel the treasury holds the sequencer share
+	require.True(t, balance(treasury).Equal(
+		sums[types.EmissionCategoryTreasury].Add(sums[types.EmissionCategorySequencer])))
+	require.True(t, balance(authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+
+	// The grand total is the supply minted across the epochs
+	params := f.Keeper.GetParams(ctx)
+	require.True(t, history.TotalEmitted.Equal(params.TotalMinted.Sub(types.DefaultParams().TotalMinted)))
+
+	// Last-epoch amounts are the final epoch's split
+	last, err := f.Keeper.GetLatestEmissionRecords(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, byCategory[types.EmissionCategoryStaking].LastEpochAmount.Equal(last[0].ToStaking))
+	require.True(t, byCategory[types.EmissionCategoryTreasury].LastEpochAmount.Equal(last[0].ToTreasury))
+	require.True(t, history.LastEpochTotal.Equal(last[0].TotalEmitted))
+
+	// The Emissions query reports the same cumulative totals
+	qs := keeper.NewQueryServerImpl(f.Keeper)
+	emissions, err := qs.Emissions(ctx, &types.QueryEmissionsRequest{})
+	require.NoError(t, err)
+	for _, alloc := range emissions.Allocations {
+		require.True(t, alloc.TotalDistributed.Equal(sums[alloc.Category]), alloc.Category)
+	}
+
+	res, err := qs.EmissionsHistory(ctx, &types.QueryEmissionsHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, history, res.History)
+}
+
+func TestEmissionsHistory_RewardEpochs(t *testing.T) {
+	f := SetupTestSuite(t)
+	stakingAddr := sdk.AccAddress([]byte("staking_rewards_____"))
+	treasury := sdk.AccAddress([]byte("emission_treasury___"))
+
+	epoch := func(height int64, staking, treasuryAmt int64) sdk.Context {
+		ctx := f.Ctx.WithBlockHeight(height)
+		total := math.NewInt(staking + treasuryAmt)
+		require.NoError(t, f.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.BondDenom, total))))
+		_, _, _, err := f.Keeper.DistributeRewardsViaIBC(ctx, []types.RewardRecipient{
+			{Address: stakingAddr.String(), Amount: math.NewInt(staking), Category: types.EmissionCategoryStaking},
+			{Address: treasury.String(), Amount: math.NewInt(treasuryAmt), Category: types.EmissionCategoryTreasury},
+		})
+		require.NoError(t, err)
+		return ctx
+	}
+
+	epoch(100, 400, 100)
+	epoch(200, 800, 200)
+	ctx := epoch(300, 40, 10)
+
+	history := historyByCategory(f.Keeper.GetEmissionsHistory(ctx))
+	require.True(t, history[types.EmissionCategoryStaking].TotalEmitted.Equal(math.NewInt(1240)))
+	require.True(t, history[types.EmissionCategoryTreasury].TotalEmitted.Equal(math.NewInt(310)))
+	require.True(t, history[types.EmissionCategoryPoc].TotalEmitted.IsZero())
+	require.True(t, history[types.EmissionCategoryStaking].LastEpochAmount.Equal(math.NewInt(40)))
+	require.True(t, history[types.EmissionCategoryTreasury].LastEpochAmount.Equal(math.NewInt(10)))
+
+	require.True(t, f.BankKeeper.GetBalance(ctx, stakingAddr, types.BondDenom).Amount.Equal(math.NewInt(1240)))
+	require.True(t, f.BankKeeper.GetBalance(ctx, treasury, types.BondDenom).Amount.Equal(math.NewInt(310)))
+}
+
+func TestEmissionsHistory_FailedDistributionRecordsNothing(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(100)
+	require.NoError(t, f.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 1000))))
+
+	// The second recipient fails after the first has been paid
+	_, _, _, err := f.Keeper.DistributeRewardsViaIBC(ctx, []types.RewardRecipient{
+		{Address: sdk.AccAddress([]byte("staking_rewards_____")).String(), Amount: math.NewInt(400), Category: types.EmissionCategoryStaking},
+		{Address: "not-an-address", Amount: math.NewInt(100), Category: types.EmissionCategoryTreasury},
+	})
+	require.Error(t, err)
+
+	history := f.Keeper.GetEmissionsHistory(ctx)
+	require.True(t, history.TotalEmitted.IsZero())
+	require.True(t, history.LastEpochTotal.IsZero())
+	require.Zero(t, history.LastEpochHeight)
+}
+
+func TestRecordEpochEmission_RejectsUnknownCategory(t *testing.T) {
+	f := SetupTestSuite(t)
+	err := f.Keeper.RecordEpochEmission(f.Ctx, map[string]math.Int{"validators": math.NewInt(1)})
+	require.Error(t, err)
+	require.True(t, f.Keeper.GetEmissionsHistory(f.Ctx).TotalEmitted.IsZero())
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/types"
+)
+
+// setupGenesisSupply tracks the default genesis supply in the stored counters
+func setupGenesisSupply(t *testing.T) (*TestSuiteWrapper, sdk.AccAddress) {
+	t.Helper()
+	f := SetupTestSuite(t)
+	supply := f.Keeper.GetParams(f.Ctx).CurrentTotalSupply
+	require.NoError(t, f.Keeper.SetCurrentSupply(f.Ctx, supply))
+	require.NoError(t, f.Keeper.SetTotalMinted(f.Ctx, supply))
+
+	treasury := sdk.AccAddress([]byte("epoch_treasury______"))
+	require.NoError(t, f.Keeper.SetTreasuryAddress(f.Ctx, treasury))
+	return f, treasury
+}
+
+func TestMintEpochProvisions_ManyBlocks(t *testing.T) {
+	f, treasury := setupGenesisSupply(t)
+	params := f.Keeper.GetParams(f.Ctx)
+	interval := int64(params.RewardStreamInterval)
+	const epochs = 10
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	pocAddr := authtypes.NewModuleAddress(types.EmissionCategoryPoc)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) math.Int {
+		return f.BankKeeper.GetBalance(ctx, addr, types.BondDenom).Amount
+	}
+
+	genesisSupply := f.Keeper.GetCurrentSupply(f.Ctx)
+	totalMinted := math.ZeroInt()
+	ctx := f.Ctx
+
+	for height := int64(1); height <= epochs*interval; height++ {
+		ctx = f.Ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		supply := f.Keeper.GetCurrentSupply(ctx)
+		bankSupply := f.BankKeeper.GetSupply(ctx, types.BondDenom).Amount
+
+		// Annual provisions per block, times the epoch length
+		expected := math.ZeroInt()
+		if height%interval == 0 {
+			blocksPerYear := int64(params.BlocksPerYear)
+			expected = params.InflationRate.MulInt(supply).QuoInt64(blocksPerYear).MulInt64(interval).TruncateInt()
+		}
+
+		minted, err := f.Keeper.MintEpochProvisions(ctx)
+		require.NoError(t, err, "height %d", height)
+		require.True(t, minted.Equal(expected), "height %d: minted %s, expected %s", height, minted, expected)
+
+		// Tracked and bank supply both grow by exactly the provisions
+		require.True(t, f.Keeper.GetCurrentSupply(ctx).Equal(supply.Add(expected)), "height %d", height)
+		require.True(t, f.BankKeeper.GetSupply(ctx, types.BondDenom).Amount.Equal(bankSupply.Add(expected)), "height %d", height)
+		require.NoError(t, f.Keeper.CheckSupplyInvariant(ctx), "height %d", height)
+
+		if expected.IsPositive() {
+			require.Equal(t, 1, countEvents(ctx, types.EventTypeEmission), "height %d", height)
+			records, err := f.Keeper.GetLatestEmissionRecords(ctx, 1)
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			require.True(t, records[0].TotalEmitted.Equal(expected), "height %d", height)
+		} else {
+			require.Zero(t, countEvents(ctx, types.EventTypeEmission), "height %d", height)
+		}
+		totalMinted = totalMinted.Add(expected)
+	}
+
+	require.True(t, totalMinted.IsPositive())
+	require.True(t, f.Keeper.GetCurrentSupply(ctx).Equal(genesisSupply.Add(totalMinted)))
+	require.True(t, f.Keeper.GetTotalMinted(ctx).Equal(genesisSupply.Add(totalMinted)))
+
+	// Every minted token left the tokenomics module: staking rewards to the fee
+	// collector, PoC to its module account, and sequencer (no IBC channel)
+	// and treasury shares to the treasury
+	staking := balance(ctx, feeCollector)
+	poc := balance(ctx, pocAddr)
+	treasuryBal := balance(ctx, treasury)
+	require.True(t, balance(ctx, moduleAddr).IsZero())
+	require.True(t, staking.Add(poc).Add(treasuryBal).Equal(totalMinted),
+		"staking %s + poc %s + treasury %s != minted %s", staking, poc, treasuryBal, totalMinted)
+
+	// Shares follow the emission split, within per-epoch truncation dust
+	split := f.Keeper.GetEmissionSplit(ctx)
+	dust := math.NewInt(epochs * int64(len(f.Keeper.GetEmissionRecipients(ctx))))
+	wantStaking := split.Staking.MulInt(totalMinted).TruncateInt()
+	require.True(t, staking.Sub(wantStaking).Abs().LTE(dust), "staking %s, expected ~%s", staking, wantStaking)
+
+	history := f.Keeper.GetEmissionsHistory(ctx)
+	require.True(t, history.TotalEmitted.Equal(totalMinted))
+	require.Equal(t, epochs*interval, history.LastEpochHeight)
+}
+
+func TestMintEpochProvisions_StopsAtCap(t *testing.T) {
+	headroom := math.NewInt(1000)
+	f, _ := setupNearCap(t, headroom)
+	params := f.Keeper.GetParams(f.Ctx)
+	interval := int64(params.RewardStreamInterval)
+	require.NoError(t, f.Keeper.SetTotalMinted(f.Ctx, params.CurrentTotalSupply))
+
+	ctx := f.Ctx.WithBlockHeight(interval)
+	require.True(t, f.Keeper.EpochProvisions(ctx).GT(headroom))
+
+	// The final epoch mints only the headroom, then minting ceases
+	minted, err := f.Keeper.MintEpochProvisions(ctx)
+	require.NoError(t, err)
+	require.True(t, minted.Equal(headroom), "minted %s", minted)
+	require.True(t, f.Keeper.GetCurrentSupply(ctx).Equal(params.TotalSupplyCap))
+	require.True(t, f.Keeper.IsMintingDisabled(ctx))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeMintingCeased))
+	require.NoError(t, f.Keeper.CheckSupplyInvariant(ctx))
+
+	bankSupply := f.BankKeeper.GetSupply(ctx, types.BondDenom).Amount
+	require.True(t, bankSupply.Equal(headroom))
+
+	for epoch := int64(2); epoch <= 4; epoch++ {
+		next := f.Ctx.WithBlockHeight(epoch * interval).WithEventManager(sdk.NewEventManager())
+		minted, err := f.Keeper.MintEpochProvisions(next)
+		require.NoError(t, err)
+		require.True(t, minted.IsZero())
+		require.Empty(t, next.EventManager().Events())
+	}
+	require.True(t, f.BankKeeper.GetSupply(ctx, types.BondDenom).Amount.Equal(bankSupply))
+}
+
+func TestMintEpochProvisions_ZeroSupplyMintsNothing(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(int64(f.Keeper.GetParams(f.Ctx).RewardStreamInterval))
+
+	// No supply is tracked before genesis accounting, so provisions are zero
+	minted, err := f.Keeper.MintEpochProvisions(ctx)
+	require.NoError(t, err)
+	require.True(t, minted.IsZero())
+	require.False(t, f.Keeper.IsMintingDisabled(ctx))
+	require.True(t, f.BankKeeper.GetSupply(ctx, types.BondDenom).Amount.IsZero())
+}
@@ -0,0 +1,7 @@
+package keeper
+
+// SafeAddSupply exposes safeAddSupply to tests
+var SafeAddSupply = safeAddSupply
+
+// DistributeWithDust exposes distributeWithDust to tests
+var DistributeWithDust = distributeWithDust
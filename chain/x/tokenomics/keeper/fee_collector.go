@@ -51,6 +51,9 @@ func (k Keeper) ProcessBlockFees(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	params := k.GetParams(ctx)
 
+	// Fee source tags only apply to the block they were recorded in
+	defer k.clearPendingFeeSources(ctx)
+
 	// Check if fee burning is enabled
 	if !params.FeeBurnEnabled {
 		k.Logger(ctx).Debug("fee burning disabled, skipping")
@@ -83,7 +86,11 @@ func (k Keeper) ProcessBlockFees(ctx context.Context) error {
 
 	var burnAmount, treasuryAmount, validatorAmount math.Int
 
-	if params.AdaptiveBurnEnabled && !params.EmergencyBurnOverride {
+	// An emergency override (guardian or governance) always uses the fixed model
+	overrideRatio, _, overridden := k.burnOverride(ctx, params)
+	adaptive := params.AdaptiveBurnEnabled && !overridden
+
+	if adaptive {
 		// Adaptive burn model: 10% treasury, then adaptive burn of remaining
 		treasuryRate := params.TreasuryFeeRatio // Fixed 10%
 		treasuryAmount = treasuryRate.MulInt(totalFees).TruncateInt()
@@ -109,6 +116,10 @@ func (k Keeper) ProcessBlockFees(ctx context.Context) error {
 		// Fixed model: Use governance-set ratios
 		burnRatio := params.FeeBurnRatio       // Default: 0.90
 		treasuryRatio := params.TreasuryFeeRatio // Default: 0.10
+		if overridden {
+			burnRatio = overrideRatio
+			treasuryRatio = math.LegacyOneDec().Sub(overrideRatio)
+		}
 
 		// Validate ratios sum to 1.0 (should be enforced in param validation)
 		if !burnRatio.Add(treasuryRatio).Equal(math.LegacyOneDec()) {
@@ -158,12 +169,9 @@ func (k Keeper) ProcessBlockFees(ctx context.Context) error {
 			return fmt.Errorf("failed to send treasury portion: %w", err)
 		}
 
-		// Track treasury inflows
-		k.IncrementTreasuryInflows(ctx, treasuryAmount, "transaction_fees")
-
-		// Track inflows for treasury redirect mechanism
-		// This accumulates until the next redirect execution
-		k.IncrementAccumulatedRedirectInflows(ctx, treasuryAmount)
+		// Track treasury inflows, including for the treasury redirect
+		// mechanism, which accumulates them until its next execution
+		k.recordTreasuryInflow(ctx, treasuryAmount, "transaction_fees")
 	}
 
 	// Step 3b: Return validator portion to fee_collector (for distribution module)
@@ -205,7 +213,7 @@ func (k Keeper) ProcessBlockFees(ctx context.Context) error {
 	// Step 5: Track fee-specific statistics
 	k.IncrementTotalFeesBurned(ctx, burnAmount)
 	k.IncrementTotalFeesToTreasury(ctx, treasuryAmount)
-	k.IncrementBurnsBySource(ctx, types.BurnSource_BURN_SOURCE_POS_GAS, burnAmount)
+	k.attributeFeeBurn(ctx, burnAmount, totalFees)
 
 	// Step 6: Emit detailed events for transparency
 	// Calculate effective ratios for event emission
@@ -225,7 +233,7 @@ func (k Keeper) ProcessBlockFees(ctx context.Context) error {
 	)
 
 	// Add adaptive burn attributes if enabled
-	if params.AdaptiveBurnEnabled && !params.EmergencyBurnOverride {
+	if adaptive {
 		event = event.AppendAttributes(
 			sdk.NewAttribute("adaptive_burn_enabled", "true"),
 			sdk.NewAttribute("validator_amount", validatorAmount.String()),
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"pos/x/tokenomics/types"
+)
+
+// RecordFeeSource tags amount of the fees collected in the current block as
+// paid by source. ProcessBlockFees splits the block's fee burn across the
+// tagged sources pro rata; untagged fees are attributed to POS_GAS.
+func (k Keeper) RecordFeeSource(ctx context.Context, source types.BurnSource, amount math.Int) error {
+	if source == types.BurnSource_BURN_SOURCE_UNSPECIFIED {
+		return fmt.Errorf("fee source must be specified")
+	}
+	if _, ok := types.BurnSource_name[int32(source)]; !ok {
+		return fmt.Errorf("unknown fee source %d", source)
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return fmt.Errorf("fee amount must be positive")
+	}
+
+	pending := k.getMarshaledInt(ctx, types.GetPendingFeeSourceKey(source))
+	return k.setMarshaledInt(ctx, types.GetPendingFeeSourceKey(source), pending.Add(amount))
+}
+
+// GetPendingFeeSources returns the fees tagged per source in the current block
+func (k Keeper) GetPendingFeeSources(ctx context.Context) map[types.BurnSource]math.Int {
+	pending := make(map[types.BurnSource]math.Int)
+	for _, source := range types.BurnSources() {
+		if amount := k.getMarshaledInt(ctx, types.GetPendingFeeSourceKey(source)); amount.IsPositive() {
+			pending[source] = amount
+		}
+	}
+	return pending
+}
+
+// clearPendingFeeSources drops the current block's fee tags
+func (k Keeper) clearPendingFeeSources(ctx context.Context) {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, source := range types.BurnSources() {
+		_ = store.Delete(types.GetPendingFeeSourceKey(source))
+	}
+}
+
+// attributeFeeBurn splits burnAmount across the sources tagged this block in
+// proportion to the fees each paid out of totalFees, and records the shares in
+// both the fee burn and overall per-source counters. Untagged fees and
+// rounding dust are attributed to POS_GAS.
+func (k Keeper) attributeFeeBurn(ctx context.Context, burnAmount, totalFees math.Int) map[types.BurnSource]math.Int {
+	shares := make(map[types.BurnSource]math.Int)
+	if !burnAmount.IsPositive() {
+		return shares
+	}
+
+	pending := k.GetPendingFeeSources(ctx)
+
+	// Tags can exceed the collected fees if a source over-reports; scale by
+	// whichever is larger so shares never exceed the burn
+	denominator := math.ZeroInt()
+	for _, amount := range pending {
+		denominator = denominator.Add(amount)
+	}
+	if denominator.LT(totalFees) {
+		denominator = totalFees
+	}
+
+	attributed := math.ZeroInt()
+	for _, source := range types.BurnSources() {
+		amount, ok := pending[source]
+		if !ok {
+			continue
+		}
+		share := burnAmount.Mul(amount).Quo(denominator)
+		if share.IsPositive() {
+			shares[source] = share
+			attributed = attributed.Add(share)
+		}
+	}
+
+	if rest := burnAmount.Sub(attributed); rest.IsPositive() {
+		posGas := types.BurnSource_BURN_SOURCE_POS_GAS
+		if current, ok := shares[posGas]; ok {
+			rest = current.Add(rest)
+		}
+		shares[posGas] = rest
+	}
+
+	for source, share := range shares {
+		k.IncrementFeeBurnsBySource(ctx, source, share)
+		k.IncrementBurnsBySource(ctx, source, share)
+	}
+	return shares
+}
+
+// IncrementFeeBurnsBySource updates the fee burn counter for a specific source
+func (k Keeper) IncrementFeeBurnsBySource(ctx context.Context, source types.BurnSource, amount math.Int) {
+	key := types.GetFeeBurnBySourceKey(source)
+	_ = k.setMarshaledInt(ctx, key, k.getMarshaledInt(ctx, key).Add(amount))
+}
+
+// GetFeeBurnsBySource returns the fee burns attributed to a specific source
+func (k Keeper) GetFeeBurnsBySource(ctx context.Context, source types.BurnSource) math.Int {
+	return k.getMarshaledInt(ctx, types.GetFeeBurnBySourceKey(source))
+}
+
+// GetFeeBurnsBreakdown returns the fee burns of every burn source, zero for
+// sources that have never paid fees
+func (k Keeper) GetFeeBurnsBreakdown(ctx context.Context) map[types.BurnSource]math.Int {
+	totals := make(map[types.BurnSource]math.Int)
+	for _, source := range types.BurnSources() {
+		totals[source] = k.GetFeeBurnsBySource(ctx, source)
+	}
+	return totals
+}
+
+// getMarshaledInt reads a binary-encoded math.Int, as the burn counters store
+// them (getIntFromStore reads decimal strings). Zero if absent or unreadable.
+func (k Keeper) getMarshaledInt(ctx context.Context, key []byte) math.Int {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(key)
+	if err != nil || bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return math.ZeroInt()
+	}
+	return amount
+}
+
+// setMarshaledInt stores amount binary-encoded under key
+func (k Keeper) setMarshaledInt(ctx context.Context, key []byte, amount math.Int) error {
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(key, bz)
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+// setForecastSupply sets the tracked supply (keeping minted - burned in step)
+// and the forecast burn estimate
+func setForecastSupply(t *testing.T, f *TestSuiteWrapper, supply math.Int, burnRate math.LegacyDec) {
+	t.Helper()
+	params := f.Keeper.GetParams(f.Ctx)
+	params.CurrentTotalSupply = supply
+	params.TotalMinted = supply
+	params.TotalBurned = math.ZeroInt()
+	params.ForecastAnnualBurnRate = burnRate
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+}
+
+func TestForecastYears_FollowsDecaySchedule(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(1)
+
+	forecast, err := f.Keeper.ForecastYears(ctx, 12)
+	require.NoError(t, err)
+	require.Len(t, forecast, 12)
+
+	expectedRates := []string{
+		"0.030000000000000000", "0.027500000000000000", "0.025000000000000000",
+		"0.022500000000000000", "0.020000000000000000", "0.017500000000000000",
+		"0.015000000000000000", "0.012500000000000000", "0.010000000000000000",
+		"0.007500000000000000", "0.005000000000000000",
+		"0.005000000000000000", // Floor at InflationMin
+	}
+
+	supply := f.Keeper.GetParams(ctx).CurrentTotalSupply
+	for i, y := range forecast {
+		require.Equal(t, int64(i), y.Year)
+		require.Equal(t, expectedRates[i], y.InflationRate.String(), "year %d", i)
+		require.True(t, y.StartSupply.Equal(supply), "year %d starts from the previous end", i)
+
+		expectedMint := math.LegacyNewDecFromInt(supply).Mul(y.InflationRate).TruncateInt()
+		require.True(t, y.Mint.Equal(expectedMint), "year %d mint: %s", i, y.Mint)
+		require.True(t, y.Burn.IsZero())
+		require.Equal(t, math.LegacyNewDecFromInt(y.Mint).QuoInt(supply).String(), y.NetInflation.String(), "year %d", i)
+		require.True(t, y.EndSupply.Equal(supply.Add(y.Mint)))
+		supply = y.EndSupply
+	}
+
+	// The first forecast year matches the rate minted this block
+	require.Equal(t, f.Keeper.CalculateDecayingInflation(ctx).String(), forecast[0].InflationRate.String())
+}
+
+func TestForecastYears_StartsAtCurrentYear(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(3*int64(types.DefaultBlocksPerYear) + 1)
+
+	forecast, err := f.Keeper.ForecastYears(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), forecast[0].Year)
+	require.Equal(t, "0.022500000000000000", forecast[0].InflationRate.String())
+	require.Equal(t, int64(4), forecast[1].Year)
+	require.Equal(t, "0.020000000000000000", forecast[1].InflationRate.String())
+}
+
+func TestForecastYears_RespectsSupplyCap(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(1)
+	supplyCap := f.Keeper.GetParams(ctx).TotalSupplyCap
+
+	// 3% of supply is far more than the room left under the cap
+	room := math.NewInt(1_000_000_000_000)
+	setForecastSupply(t, f, supplyCap.Sub(room), math.LegacyZeroDec())
+
+	forecast, err := f.Keeper.ForecastYears(ctx, 5)
+	require.NoError(t, err)
+	require.Len(t, forecast, 5)
+	require.True(t, forecast[0].Mint.Equal(room), "first year mints only up to the cap: %s", forecast[0].Mint)
+	for i, y := range forecast {
+		require.True(t, y.EndSupply.LTE(supplyCap), "year %d exceeds cap: %s", i, y.EndSupply)
+		if i > 0 {
+			require.True(t, y.Mint.IsZero(), "year %d mints at cap", i)
+		}
+	}
+}
+
+func TestForecastYears_BurnsFreeRoomUnderCap(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(1)
+	supplyCap := f.Keeper.GetParams(ctx).TotalSupplyCap
+
+	// 1% burned per year against a supply already at the cap
+	setForecastSupply(t, f, supplyCap, math.LegacyNewDecWithPrec(1, 2))
+
+	forecast, err := f.Keeper.ForecastYears(ctx, 3)
+	require.NoError(t, err)
+
+	// Year 1: nothing to mint, 1% burned
+	require.True(t, forecast[0].Mint.IsZero())
+	require.True(t, forecast[0].Burn.Equal(supplyCap.QuoRaw(100)))
+	require.Equal(t, "-0.010000000000000000", forecast[0].NetInflation.String())
+	require.True(t, forecast[0].EndSupply.Equal(supplyCap.Sub(forecast[0].Burn)))
+
+	// Later years can only mint back what was burned
+	for i, y := range forecast[1:] {
+		room := supplyCap.Sub(y.StartSupply)
+		require.True(t, y.Mint.Equal(room), "year %d mint %s, room %s", i+1, y.Mint, room)
+		require.True(t, y.EndSupply.LTE(supplyCap))
+	}
+}
+
+func TestSupplyForecastQuery(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(1)
+	setForecastSupply(t, f, f.Keeper.GetParams(ctx).CurrentTotalSupply, math.LegacyNewDecWithPrec(5, 3))
+	qs := keeper.NewQueryServerImpl(f.Keeper)
+
+	res, err := qs.SupplyForecast(ctx, &types.QuerySupplyForecastRequest{Years: 7})
+	require.NoError(t, err)
+	require.Len(t, res.Years, 7)
+	require.Equal(t, int64(0), res.CurrentYear)
+	require.Equal(t, "0.005000000000000000", res.EstimatedAnnualBurnRate.String())
+	require.Contains(t, res.FormatString(), "   1 |     3.00% |")
+
+	for _, years := range []int64{0, -1, types.MaxForecastYears + 1} {
+		_, err := qs.SupplyForecast(ctx, &types.QuerySupplyForecastRequest{Years: years})
+		require.Error(t, err, "years %d", years)
+	}
+}
+
+func TestForecastAnnualBurnRateBounds(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.ForecastAnnualBurnRate = math.LegacyNewDecWithPrec(-1, 2)
+	require.Error(t, params.Validate())
+
+	params.ForecastAnnualBurnRate = math.LegacyNewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+
+	params.ForecastAnnualBurnRate = math.LegacyOneDec()
+	require.NoError(t, params.Validate())
+}
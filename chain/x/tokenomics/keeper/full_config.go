@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// GetFullConfig assembles the comprehensive tokenomics configuration: the
+// params object plus every setting persisted under its own store key.
+func (k Keeper) GetFullConfig(ctx context.Context) types.FullConfig {
+	return types.FullConfig{
+		Params:          k.GetParams(ctx),
+		TreasuryAddress: k.GetTreasuryAddress(ctx).String(),
+		RedirectTargets: types.RedirectTargetAddresses{
+			EcosystemGrants: addressString(k.GetEcosystemGrantsAddress(ctx)),
+			BuyAndBurn:      addressString(k.GetBuyAndBurnAddress(ctx)),
+			InsuranceFund:   addressString(k.GetInsuranceFundAddress(ctx)),
+			ResearchFund:    addressString(k.GetResearchFundAddress(ctx)),
+		},
+		RedirectState: types.RedirectState{
+			LastRedirectHeight:         k.GetLastRedirectHeight(ctx),
+			AccumulatedRedirectInflows: k.GetAccumulatedRedirectInflows(ctx),
+			TotalRedirected:            k.GetTotalRedirected(ctx),
+		},
+	}
+}
+
+// addressString returns the bech32 form of addr, or "" if it is unset
+func addressString(addr sdk.AccAddress) string {
+	if addr.Empty() {
+		return ""
+	}
+	return addr.String()
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+// paramsJSONFieldNames returns the JSON name of every TokenomicsParams field
+func paramsJSONFieldNames(t *testing.T) []string {
+	t.Helper()
+
+	var names []string
+	typ := reflect.TypeOf(types.TokenomicsParams{})
+	for i := 0; i < typ.NumField(); i++ {
+		tag := typ.Field(i).Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		names = append(names, name)
+	}
+	require.NotEmpty(t, names)
+	return names
+}
+
+func TestFullConfig_JSONRoundTripContainsAllFields(t *testing.T) {
+	ts := SetupTestSuite(t)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("ecosystem_grants____")),
+		sdk.AccAddress([]byte("buy_and_burn________")),
+		sdk.AccAddress([]byte("insurance_fund______")),
+		sdk.AccAddress([]byte("research_fund_______")),
+	}
+	require.NoError(t, ts.Keeper.SetEcosystemGrantsAddress(ts.Ctx, addrs[0]))
+	require.NoError(t, ts.Keeper.SetBuyAndBurnAddress(ts.Ctx, addrs[1]))
+	require.NoError(t, ts.Keeper.SetInsuranceFundAddress(ts.Ctx, addrs[2]))
+	require.NoError(t, ts.Keeper.SetResearchFundAddress(ts.Ctx, addrs[3]))
+	ts.Keeper.SetLastRedirectHeight(ts.Ctx, 1234)
+
+	cfg := ts.Keeper.GetFullConfig(ts.Ctx)
+	require.Equal(t, addrs[0].String(), cfg.RedirectTargets.EcosystemGrants)
+	require.Equal(t, addrs[1].String(), cfg.RedirectTargets.BuyAndBurn)
+	require.Equal(t, addrs[2].String(), cfg.RedirectTargets.InsuranceFund)
+	require.Equal(t, addrs[3].String(), cfg.RedirectTargets.ResearchFund)
+	require.NotEmpty(t, cfg.TreasuryAddress)
+	require.Equal(t, int64(1234), cfg.RedirectState.LastRedirectHeight)
+
+	res, err := keeper.NewQueryServerImpl(ts.Keeper).FullConfig(ts.Ctx, &types.QueryFullConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, cfg.RedirectTargets, res.Config.RedirectTargets)
+
+	bz, err := cdc.MarshalJSON(&res.Config)
+	require.NoError(t, err)
+
+	// Every params field must be present, including zero-valued bools and ints
+	var raw map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &raw))
+	for _, key := range []string{"params", "treasury_address", "redirect_targets", "redirect_state"} {
+		require.Contains(t, raw, key)
+	}
+
+	var params map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(raw["params"], &params))
+	for _, name := range paramsJSONFieldNames(t) {
+		require.Contains(t, params, name, "params JSON missing field %q", name)
+	}
+
+	// Decoding and re-encoding must be lossless
+	var decoded types.FullConfig
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, cfg.TreasuryAddress, decoded.TreasuryAddress)
+	require.Equal(t, cfg.RedirectTargets, decoded.RedirectTargets)
+	require.Equal(t, cfg.RedirectState.LastRedirectHeight, decoded.RedirectState.LastRedirectHeight)
+	require.True(t, cfg.RedirectState.TotalRedirected.Equal(decoded.RedirectState.TotalRedirected))
+
+	bz2, err := cdc.MarshalJSON(&decoded)
+	require.NoError(t, err)
+	require.JSONEq(t, string(bz), string(bz2))
+
+	_, err = keeper.NewQueryServerImpl(ts.Keeper).FullConfig(ts.Ctx, nil)
+	require.Error(t, err)
+}
+
+func TestFullConfig_UnsetRedirectTargetsAreEmpty(t *testing.T) {
+	ts := SetupTestSuite(t)
+
+	cfg := ts.Keeper.GetFullConfig(ts.Ctx)
+	require.Equal(t, types.RedirectTargetAddresses{}, cfg.RedirectTargets)
+	require.Equal(t, int64(0), cfg.RedirectState.LastRedirectHeight)
+	require.True(t, cfg.RedirectState.AccumulatedRedirectInflows.Equal(math.ZeroInt()))
+}
+
+func TestParamsFormatString_IncludesNewerSections(t *testing.T) {
+	out := types.DefaultParams().FormatString()
+	for _, section := range []string{"Fee Burn:", "Adaptive Burn:", "Treasury Redirect:"} {
+		require.Contains(t, out, section)
+	}
+}
+
+func TestQueryParamsFormatted(t *testing.T) {
+	f := SetupTestSuite(t)
+	qs := keeper.NewQueryServerImpl(f.Keeper)
+
+	params := f.Keeper.GetParams(f.Ctx)
+	params.RewardStreamInterval = 250
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	res, err := qs.ParamsFormatted(f.Ctx, &types.QueryParamsFormattedRequest{})
+	require.NoError(t, err)
+	for _, section := range []string{"Supply:", "Inflation:", "Emissions:", "Burn Rates:"} {
+		require.Contains(t, res.Formatted, section)
+	}
+
+	// The dashboard reflects the stored params, matching FormatString
+	require.Equal(t, params.FormatString(), res.Formatted)
+	require.Contains(t, res.Formatted, "Reward Interval:  250 blocks")
+
+	_, err = qs.ParamsFormatted(f.Ctx, nil)
+	require.Error(t, err)
+}
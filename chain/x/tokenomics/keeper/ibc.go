@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"pos/x/tokenomics/types"
 )
@@ -33,9 +34,24 @@ type IBCBurnReportPacket struct {
 
 // DistributeRewardsViaIBC distributes rewards to other chains via IBC
 // P0-IBC-001 to P0-IBC-006: IBC reward streaming
+// Transfers and emission accounting commit together: if any recipient fails,
+// nothing is distributed and the counters are untouched.
 func (k Keeper) DistributeRewardsViaIBC(
 	ctx context.Context,
 	recipients []types.RewardRecipient,
+) (localDist, ibcDist math.Int, packetsSent uint32, err error) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	localDist, ibcDist, packetsSent, err = k.distributeRewardsViaIBC(cacheCtx, recipients)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), 0, err
+	}
+	write()
+	return localDist, ibcDist, packetsSent, nil
+}
+
+func (k Keeper) distributeRewardsViaIBC(
+	ctx context.Context,
+	recipients []types.RewardRecipient,
 ) (localDist, ibcDist math.Int, packetsSent uint32, err error) {
 	params := k.GetParams(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -43,19 +59,33 @@ func (k Keeper) DistributeRewardsViaIBC(
 	localDist = math.ZeroInt()
 	ibcDist = math.ZeroInt()
 
+	// Per-category amounts actually transferred or queued, for emission accounting
+	emitted := make(map[string]math.Int)
+	categorized := false
+	credit := func(recipient types.RewardRecipient) {
+		if recipient.Category == "" {
+			return
+		}
+		if prev, ok := emitted[recipient.Category]; ok {
+			emitted[recipient.Category] = prev.Add(recipient.Amount)
+		} else {
+			emitted[recipient.Category] = recipient.Amount
+		}
+	}
+
 	for _, recipient := range recipients {
-		recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
-		if err != nil {
-			return math.ZeroInt(), math.ZeroInt(), 0, fmt.Errorf("invalid recipient address %s: %w", recipient.Address, err)
+		if recipient.Category != "" {
+			categorized = true
 		}
 
 		if recipient.DestinationChain == "" {
 			// P0-IBC-001: Local distribution (no IBC)
 			coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, recipient.Amount))
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+			if err := k.sendLocalReward(ctx, recipient.Address, coins); err != nil {
 				return math.ZeroInt(), math.ZeroInt(), 0, fmt.Errorf("failed to distribute rewards locally: %w", err)
 			}
 			localDist = localDist.Add(recipient.Amount)
+			credit(recipient)
 
 			k.Logger(ctx).Info("distributed rewards locally",
 				"recipient", recipient.Address,
@@ -85,6 +115,7 @@ func (k Keeper) DistributeRewardsViaIBC(
 						coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, recipient.Amount))
 						if sendErr := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, authorityAcc, coins); sendErr == nil {
 							localDist = localDist.Add(recipient.Amount)
+							credit(recipient)
 							k.Logger(ctx).Info("IBC channel not configured — redirected to governance treasury",
 								"destination", recipient.DestinationChain,
 								"amount", recipient.Amount.String(),
@@ -128,6 +159,7 @@ func (k Keeper) DistributeRewardsViaIBC(
 			)
 
 			ibcDist = ibcDist.Add(recipient.Amount)
+			credit(recipient)
 			packetsSent++
 
 			k.Logger(ctx).Info("queued IBC reward packet",
@@ -139,6 +171,12 @@ func (k Keeper) DistributeRewardsViaIBC(
 		}
 	}
 
+	if categorized {
+		if err := k.RecordEpochEmission(ctx, emitted); err != nil {
+			return math.ZeroInt(), math.ZeroInt(), 0, fmt.Errorf("failed to record epoch emission: %w", err)
+		}
+	}
+
 	// P0-IBC-004: Emit summary event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -153,6 +191,22 @@ func (k Keeper) DistributeRewardsViaIBC(
 	return localDist, ibcDist, packetsSent, nil
 }
 
+// sendLocalReward pays a local reward recipient from the tokenomics module.
+// The recipient is either a module account name, paid module to module so
+// that blocked module addresses such as the fee collector can receive it, or
+// a bech32 account address.
+func (k Keeper) sendLocalReward(ctx context.Context, recipient string, coins sdk.Coins) error {
+	if k.accountKeeper.GetModuleAddress(recipient) != nil {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient, coins)
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address %s: %w", recipient, err)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins)
+}
+
 // OnRecvBurnReport handles IBC burn reports from other chains
 // P0-BURN-IBC-001 to P0-BURN-IBC-006: Cross-chain burn reporting
 func (k Keeper) OnRecvBurnReport(
@@ -431,83 +485,72 @@ func (k Keeper) ShouldDistributeRewards(ctx context.Context) bool {
 	return sdkCtx.BlockHeight()%int64(params.RewardStreamInterval) == 0
 }
 
-// CalculateRewardSplits calculates how much to send to each chain
+// CalculateRewardSplits splits totalRewards across the emission recipients by
+// their shares, dust included, and routes each share for
+// DistributeRewardsViaIBC
 func (k Keeper) CalculateRewardSplits(ctx context.Context, totalRewards math.Int) []types.RewardRecipient {
+	recipients := k.GetEmissionRecipients(ctx)
+	amounts, _, _ := splitEmission(recipients, totalRewards)
+	return k.routeEmissions(ctx, recipients, amounts)
+}
+
+// routeEmissions turns each emission recipient's amount into a reward
+// recipient, skipping zero amounts:
+//   - staking goes to the fee collector, which x/distribution pays out to
+//     validators and delegators
+//   - PoC goes to the Continuity chain via IBC if its channel is configured,
+//     otherwise to the PoC module account
+//   - sequencer goes to the Sequencer chain via IBC if its channel is
+//     configured; this chain has no sequencer module account, so until then
+//     the treasury holds it
+//   - treasury goes to the treasury address, which falls back to this
+//     module's account while none is set
+//   - any other recipient is the module account it names
+//
+// SECURITY: Do NOT send to a non-existent IBC channel — tokens would be lost.
+func (k Keeper) routeEmissions(ctx context.Context, recipients types.EmissionRecipients, amounts []math.Int) []types.RewardRecipient {
 	params := k.GetParams(ctx)
+	routed := make([]types.RewardRecipient, 0, len(recipients))
 
-	var recipients []types.RewardRecipient
-
-	// Staking rewards (40%) - local
-	stakingRewards := params.EmissionSplitStaking.MulInt(totalRewards).TruncateInt()
-	if stakingRewards.IsPositive() {
-		recipients = append(recipients, types.RewardRecipient{
-			Address:          "staking", // Staking module
-			Amount:           stakingRewards,
-			DestinationChain: "", // Local chain
-			IbcChannel:       "",
-		})
-	}
+	for i, recipient := range recipients {
+		if !amounts[i].IsPositive() {
+			continue
+		}
 
-	// PoC rewards (30%) - send to Continuity chain via IBC if channel is configured,
-	// otherwise redirect to treasury until the Continuity chain is deployed.
-	pocRewards := params.EmissionSplitPoc.MulInt(totalRewards).TruncateInt()
-	if pocRewards.IsPositive() {
-		if params.ContinuityIbcChannel != "" && k.ibcKeeper != nil {
-			recipients = append(recipients, types.RewardRecipient{
-				Address:          "poc",
-				Amount:           pocRewards,
-				DestinationChain: "omniphi-continuity-1",
-				IbcChannel:       params.ContinuityIbcChannel,
-			})
-		} else {
-			// Continuity chain not yet deployed — hold in treasury
-			treasuryAddr := k.GetTreasuryAddress(ctx)
-			recipients = append(recipients, types.RewardRecipient{
-				Address:          treasuryAddr.String(),
-				Amount:           pocRewards,
-				DestinationChain: "",
-				IbcChannel:       "",
-			})
+		reward := types.RewardRecipient{
+			Address: recipient.Module,
+			Amount:  amounts[i],
+		}
+		if types.ValidateEmissionCategory(recipient.Module) == nil {
+			reward.Category = recipient.Module
 		}
-	}
 
-	// Sequencer rewards (20%) - send to Sequencer chain via IBC if channel is configured,
-	// otherwise redirect to treasury until the Sequencer chain is deployed.
-	// SECURITY: Do NOT send to a non-existent IBC channel — tokens would be lost.
-	sequencerRewards := params.EmissionSplitSequencer.MulInt(totalRewards).TruncateInt()
-	if sequencerRewards.IsPositive() {
-		if params.SequencerIbcChannel != "" && k.ibcKeeper != nil {
-			recipients = append(recipients, types.RewardRecipient{
-				Address:          "sequencer",
-				Amount:           sequencerRewards,
-				DestinationChain: "omniphi-sequencer-1",
-				IbcChannel:       params.SequencerIbcChannel,
-			})
-		} else {
-			// Sequencer chain not yet deployed — hold in treasury
-			treasuryAddr := k.GetTreasuryAddress(ctx)
-			recipients = append(recipients, types.RewardRecipient{
-				Address:          treasuryAddr.String(),
-				Amount:           sequencerRewards,
-				DestinationChain: "",
-				IbcChannel:       "",
-			})
+		switch recipient.Module {
+		case types.EmissionCategoryStaking:
+			reward.Address = authtypes.FeeCollectorName
+
+		case types.EmissionCategoryPoc:
+			if params.ContinuityIbcChannel != "" && k.ibcKeeper != nil {
+				reward.DestinationChain = "omniphi-continuity-1"
+				reward.IbcChannel = params.ContinuityIbcChannel
+			}
+
+		case types.EmissionCategorySequencer:
+			if params.SequencerIbcChannel != "" && k.ibcKeeper != nil {
+				reward.DestinationChain = "omniphi-sequencer-1"
+				reward.IbcChannel = params.SequencerIbcChannel
+			} else {
+				reward.Address = k.GetTreasuryAddress(ctx).String()
+			}
+
+		case types.EmissionCategoryTreasury:
+			reward.Address = k.GetTreasuryAddress(ctx).String()
 		}
-	}
 
-	// Treasury (10%) - local
-	treasuryRewards := params.EmissionSplitTreasury.MulInt(totalRewards).TruncateInt()
-	if treasuryRewards.IsPositive() {
-		treasuryAddr := k.GetTreasuryAddress(ctx)
-		recipients = append(recipients, types.RewardRecipient{
-			Address:          treasuryAddr.String(),
-			Amount:           treasuryRewards,
-			DestinationChain: "", // Local chain
-			IbcChannel:       "",
-		})
+		routed = append(routed, reward)
 	}
 
-	return recipients
+	return routed
 }
 
 // TrackIBCPacket stores metadata about sent IBC packets for monitoring
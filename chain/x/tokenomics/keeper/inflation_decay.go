@@ -20,86 +20,17 @@ import (
 // Year 6: 1.75%
 // Year 7+: Reduce by 0.25%/year until floor = 0.5%
 func (k Keeper) CalculateDecayingInflation(ctx context.Context) math.LegacyDec {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	params := k.GetParams(ctx)
-
-	// Calculate years since genesis
-	currentHeight := sdkCtx.BlockHeight()
-	genesisHeight := int64(1) // Assuming genesis is height 1
-
-	// Blocks per year (assuming 7-second blocks)
-	// 365.25 days * 24 hours * 60 minutes * 60 seconds / 7 seconds per block
-	blocksPerYear := int64(4_507_680) // ~4.5M blocks per year
-
-	yearsSinceGenesis := (currentHeight - genesisHeight) / blocksPerYear
-
-	// Get inflation floor from params (default 0.5%)
-	minInflation := params.InflationMin
-
-	// Calculate inflation based on year
-	var inflationRate math.LegacyDec
-
-	switch {
-	case yearsSinceGenesis == 0:
-		// Year 1: 3.00%
-		inflationRate = math.LegacyMustNewDecFromStr("0.03")
-	case yearsSinceGenesis == 1:
-		// Year 2: 2.75%
-		inflationRate = math.LegacyMustNewDecFromStr("0.0275")
-	case yearsSinceGenesis == 2:
-		// Year 3: 2.50%
-		inflationRate = math.LegacyMustNewDecFromStr("0.025")
-	case yearsSinceGenesis == 3:
-		// Year 4: 2.25%
-		inflationRate = math.LegacyMustNewDecFromStr("0.0225")
-	case yearsSinceGenesis == 4:
-		// Year 5: 2.00%
-		inflationRate = math.LegacyMustNewDecFromStr("0.02")
-	case yearsSinceGenesis == 5:
-		// Year 6: 1.75%
-		inflationRate = math.LegacyMustNewDecFromStr("0.0175")
-	default:
-		// Year 7+: Reduce by 0.25% per year until floor
-		// Starting from 1.75% (year 6), subtract 0.25% for each additional year
-		baseRate := math.LegacyMustNewDecFromStr("0.0175")
-		decayRate := math.LegacyMustNewDecFromStr("0.0025") // 0.25% per year
-
-		yearsAfterSix := yearsSinceGenesis - 5
-		totalDecay := decayRate.MulInt64(yearsAfterSix)
-
-		inflationRate = baseRate.Sub(totalDecay)
-
-		// Enforce minimum floor
-		if inflationRate.LT(minInflation) {
-			inflationRate = minInflation
-		}
-	}
-
-	// Enforce params boundaries (min/max from governance)
-	if inflationRate.LT(params.InflationMin) {
-		inflationRate = params.InflationMin
-	}
-	if inflationRate.GT(params.InflationMax) {
-		inflationRate = params.InflationMax
-	}
-
-	return inflationRate
+	return types.DecayingInflationRate(k.GetCurrentYear(ctx), k.GetParams(ctx))
 }
 
 // GetCurrentYear returns the current year since genesis (0-indexed)
 func (k Keeper) GetCurrentYear(ctx context.Context) int64 {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	currentHeight := sdkCtx.BlockHeight()
-	genesisHeight := int64(1)
-	blocksPerYear := int64(4_507_680)
-
-	return (currentHeight - genesisHeight) / blocksPerYear
+	return types.YearAtHeight(sdk.UnwrapSDKContext(ctx).BlockHeight(), k.GetBlocksPerYear(ctx))
 }
 
-// GetBlocksPerYear returns the number of blocks per year
-// Based on 7-second block time: 365.25 * 24 * 60 * 60 / 7
-func (k Keeper) GetBlocksPerYear() int64 {
-	return int64(4_507_680)
+// GetBlocksPerYear returns the governance-set number of blocks per year
+func (k Keeper) GetBlocksPerYear(ctx context.Context) int64 {
+	return int64(k.GetParams(ctx).EffectiveBlocksPerYear())
 }
 
 // CalculateDecayingAnnualProvisions calculates the annual provisions based on decaying inflation rate
@@ -122,7 +53,7 @@ func (k Keeper) CalculateDecayingAnnualProvisions(ctx context.Context) math.Int
 // CalculateBlockProvision calculates the provision for a single block using decaying inflation
 func (k Keeper) CalculateBlockProvision(ctx context.Context) math.Int {
 	annualProvisions := k.CalculateDecayingAnnualProvisions(ctx)
-	blocksPerYear := k.GetBlocksPerYear()
+	blocksPerYear := k.GetBlocksPerYear(ctx)
 
 	// Block provision = Annual provisions / Blocks per year
 	blockProvision := annualProvisions.QuoRaw(blocksPerYear)
@@ -133,34 +64,33 @@ func (k Keeper) CalculateBlockProvision(ctx context.Context) math.Int {
 // MintInflation mints inflation for the current block and distributes to recipients
 // This should be called in EndBlocker
 func (k Keeper) MintInflation(ctx context.Context) error {
-	params := k.GetParams(ctx)
-
-	// Check if we've reached supply cap
-	if params.CurrentTotalSupply.GTE(params.TotalSupplyCap) {
-		k.Logger(ctx).Info("Supply cap reached, no inflation minted",
-			"current_supply", params.CurrentTotalSupply.String(),
-			"supply_cap", params.TotalSupplyCap.String())
+	// Minting stops for good once the supply cap has been reached
+	if k.IsMintingDisabled(ctx) {
 		return nil
 	}
 
-	// Calculate block provision
-	blockProvision := k.CalculateBlockProvision(ctx)
+	params := k.GetParams(ctx)
+
+	// Calculate block provision, limited to the headroom below the cap
+	fullProvision := k.CalculateBlockProvision(ctx)
+	blockProvision, reachesCap := limitToHeadroom(fullProvision, params.CurrentTotalSupply, params.TotalSupplyCap)
 
 	if blockProvision.IsZero() {
+		if reachesCap {
+			return k.CeaseMinting(ctx, blockProvision, params.CurrentTotalSupply)
+		}
 		return nil
 	}
 
-	// Check if minting would exceed cap
-	newSupply := params.CurrentTotalSupply.Add(blockProvision)
-	if newSupply.GT(params.TotalSupplyCap) {
-		// Only mint up to cap
-		originalProvision := blockProvision
-		blockProvision = params.TotalSupplyCap.Sub(params.CurrentTotalSupply)
-
+	if blockProvision.LT(fullProvision) {
 		k.Logger(ctx).Warn("Minting limited to reach supply cap exactly",
-			"original_provision", originalProvision.String(),
-			"adjusted_provision", blockProvision.String(),
-			"remaining_mintable", blockProvision.String())
+			"original_provision", fullProvision.String(),
+			"adjusted_provision", blockProvision.String())
+	}
+
+	newSupply, err := safeAddSupply(params.CurrentTotalSupply, blockProvision, params.TotalSupplyCap)
+	if err != nil {
+		return err
 	}
 
 	// Distribute emissions
@@ -170,7 +100,7 @@ func (k Keeper) MintInflation(ctx context.Context) error {
 
 	// Update total minted and current supply
 	params.TotalMinted = params.TotalMinted.Add(blockProvision)
-	params.CurrentTotalSupply = params.CurrentTotalSupply.Add(blockProvision)
+	params.CurrentTotalSupply = newSupply
 
 	// Update inflation rate in params for queries
 	currentInflation := k.CalculateDecayingInflation(ctx)
@@ -192,92 +122,140 @@ func (k Keeper) MintInflation(ctx context.Context) error {
 		),
 	)
 
+	if reachesCap {
+		return k.CeaseMinting(ctx, blockProvision, params.CurrentTotalSupply)
+	}
+
 	return nil
 }
 
-// DistributeEmissions distributes minted inflation to various recipients
+// DistributeEmissions distributes minted inflation to various recipients.
+// Transfers and emission accounting commit together or not at all.
 func (k Keeper) DistributeEmissions(ctx context.Context, totalAmount math.Int) error {
-	params := k.GetParams(ctx)
-
-	// Calculate distribution amounts
-	totalAmountDec := math.LegacyNewDecFromInt(totalAmount)
-
-	stakingAmount := totalAmountDec.Mul(params.EmissionSplitStaking).TruncateInt()
-	pocAmount := totalAmountDec.Mul(params.EmissionSplitPoc).TruncateInt()
-	sequencerAmount := totalAmountDec.Mul(params.EmissionSplitSequencer).TruncateInt()
-	treasuryAmount := totalAmountDec.Mul(params.EmissionSplitTreasury).TruncateInt()
-
-	// Ensure exact distribution (handle rounding)
-	distributed := stakingAmount.Add(pocAmount).Add(sequencerAmount).Add(treasuryAmount)
-	if distributed.LT(totalAmount) {
-		// Add remainder to treasury
-		remainder := totalAmount.Sub(distributed)
-		treasuryAmount = treasuryAmount.Add(remainder)
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.distributeEmissions(cacheCtx, totalAmount); err != nil {
+		return err
 	}
+	write()
+	return nil
+}
 
-	// Mint to staking module (distributed by staking module)
-	if stakingAmount.IsPositive() {
-		stakingCoins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, stakingAmount))
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, stakingCoins); err != nil {
-			return fmt.Errorf("failed to mint staking rewards: %w", err)
-		}
-		// Transfer to staking module
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, "staking", stakingCoins); err != nil {
-			return fmt.Errorf("failed to send to staking module: %w", err)
+func (k Keeper) distributeEmissions(ctx context.Context, totalAmount math.Int) error {
+	recipients := k.GetEmissionRecipients(ctx)
+	amounts, dust, remainder := splitEmission(recipients, totalAmount)
+	dustRecipient := recipients[dust].Module
+
+	// Mint the whole emission once, then route each share to its module
+	// account, address or companion chain; the per-category emission history
+	// is recorded as the shares are paid
+	if totalAmount.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, totalAmount))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return fmt.Errorf("failed to mint emissions: %w", err)
 		}
 	}
-
-	// Mint to PoC module (PoC keeper wired via SetPocKeeper in app.go)
-	if pocAmount.IsPositive() {
-		pocCoins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, pocAmount))
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, pocCoins); err != nil {
-			return fmt.Errorf("failed to mint PoC rewards: %w", err)
-		}
-		// For now, keep in tokenomics module or transfer to PoC module when integrated
+	if _, _, _, err := k.distributeRewardsViaIBC(ctx, k.routeEmissions(ctx, recipients, amounts)); err != nil {
+		return fmt.Errorf("failed to distribute emissions: %w", err)
 	}
 
-	// Mint to sequencer module (if exists)
-	if sequencerAmount.IsPositive() {
-		sequencerCoins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, sequencerAmount))
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sequencerCoins); err != nil {
-			return fmt.Errorf("failed to mint sequencer rewards: %w", err)
-		}
-		// For now, keep in tokenomics module or transfer to sequencer module when ready
+	// Built-in categories are tracked individually; other recipients are
+	// reported in the emission event only
+	byCategory := make(map[string]math.Int, len(types.EmissionCategories))
+	for _, category := range types.EmissionCategories {
+		byCategory[category] = math.ZeroInt()
 	}
-
-	// Mint to treasury
-	if treasuryAmount.IsPositive() {
-		treasuryCoins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, treasuryAmount))
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, treasuryCoins); err != nil {
-			return fmt.Errorf("failed to mint treasury allocation: %w", err)
-		}
-
-		// Send to treasury address
-		treasuryAddr := k.GetTreasuryAddress(ctx)
-		if !treasuryAddr.Empty() {
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasuryAddr, treasuryCoins); err != nil {
-				return fmt.Errorf("failed to send to treasury: %w", err)
-			}
+	var otherAttrs []sdk.Attribute
+	otherTotal := math.ZeroInt()
+	for i, recipient := range recipients {
+		if _, builtIn := byCategory[recipient.Module]; builtIn {
+			byCategory[recipient.Module] = amounts[i]
+			continue
 		}
+		otherTotal = otherTotal.Add(amounts[i])
+		otherAttrs = append(otherAttrs, sdk.NewAttribute("to_"+recipient.Module, amounts[i].String()))
 	}
-
-	// Record the emission for auditing and transparency
-	_, err := k.RecordEmission(ctx, totalAmount, stakingAmount, pocAmount, sequencerAmount, treasuryAmount)
+	stakingAmount := byCategory[types.EmissionCategoryStaking]
+	pocAmount := byCategory[types.EmissionCategoryPoc]
+	sequencerAmount := byCategory[types.EmissionCategorySequencer]
+	treasuryAmount := byCategory[types.EmissionCategoryTreasury]
+
+	// Record the emission for auditing and transparency. The record covers the
+	// built-in categories, so its total excludes other recipients.
+	_, err := k.RecordEmission(ctx, totalAmount.Sub(otherTotal), stakingAmount, pocAmount, sequencerAmount, treasuryAmount)
 	if err != nil {
 		k.Logger(ctx).Error("failed to record emission", "error", err)
 		// Don't fail the emission, just log the error
 	}
 
+	// The amounts, including any to_<module> attributes for other recipients,
+	// sum exactly to total_minted; the dust recipient's amount includes the dust
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyTotalMinted, totalAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyToStaking, stakingAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyToPoc, pocAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyToSequencer, sequencerAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyToTreasury, treasuryAmount.String()),
+	}
+	attrs = append(attrs, otherAttrs...)
+	attrs = append(attrs,
+		sdk.NewAttribute(types.AttributeKeyDustRemainder, remainder.String()),
+		sdk.NewAttribute(types.AttributeKeyDustRecipient, dustRecipient),
+		sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+	)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEmission, attrs...))
+
 	k.Logger(ctx).Info("Emissions distributed",
 		"total", totalAmount.String(),
 		"staking", stakingAmount.String(),
 		"poc", pocAmount.String(),
 		"sequencer", sequencerAmount.String(),
-		"treasury", treasuryAmount.String())
+		"treasury", treasuryAmount.String(),
+		"other", otherTotal.String())
 
 	return nil
 }
 
+// splitEmission splits totalAmount across the recipients by their shares,
+// returning the amounts in recipient order, the index of the recipient that
+// received the dust and the dust itself. Dust goes to the treasury, or to
+// staking when the treasury is not a recipient or has a zero share.
+func splitEmission(recipients types.EmissionRecipients, totalAmount math.Int) (amounts []math.Int, dust int, remainder math.Int) {
+	order := emissionDustOrder(recipients)
+	ratios := make([]math.LegacyDec, len(order))
+	for j, i := range order {
+		ratios[j] = recipients[i].Share
+	}
+	ordered := distributeWithDust(totalAmount, ratios)
+
+	amounts = make([]math.Int, len(recipients))
+	for j, i := range order {
+		amounts[i] = ordered[j]
+	}
+	last := dustIndex(ratios)
+	return amounts, order[last], ordered[last].Sub(ratios[last].MulInt(totalAmount).TruncateInt())
+}
+
+// emissionDustOrder orders recipient indices for distributeWithDust so that
+// the treasury comes last and staking just before it
+func emissionDustOrder(recipients types.EmissionRecipients) []int {
+	order := make([]int, 0, len(recipients))
+	var tail []int
+	for _, module := range []string{types.EmissionCategoryStaking, types.EmissionCategoryTreasury} {
+		for i, recipient := range recipients {
+			if recipient.Module == module {
+				tail = append(tail, i)
+			}
+		}
+	}
+	for i, recipient := range recipients {
+		if recipient.Module != types.EmissionCategoryStaking && recipient.Module != types.EmissionCategoryTreasury {
+			order = append(order, i)
+		}
+	}
+	return append(order, tail...)
+}
+
 // GetInflationForecast returns inflation forecast for future years
 func (k Keeper) GetInflationForecast(ctx context.Context, years int64) []types.InflationForecast {
 	forecasts := make([]types.InflationForecast, years)
@@ -290,31 +268,7 @@ func (k Keeper) GetInflationForecast(ctx context.Context, years int64) []types.I
 		year := currentYear + i
 
 		// Calculate inflation rate for this year
-		var inflationRate math.LegacyDec
-		switch {
-		case year == 0:
-			inflationRate = math.LegacyMustNewDecFromStr("0.03")
-		case year == 1:
-			inflationRate = math.LegacyMustNewDecFromStr("0.0275")
-		case year == 2:
-			inflationRate = math.LegacyMustNewDecFromStr("0.025")
-		case year == 3:
-			inflationRate = math.LegacyMustNewDecFromStr("0.0225")
-		case year == 4:
-			inflationRate = math.LegacyMustNewDecFromStr("0.02")
-		case year == 5:
-			inflationRate = math.LegacyMustNewDecFromStr("0.0175")
-		default:
-			baseRate := math.LegacyMustNewDecFromStr("0.0175")
-			decayRate := math.LegacyMustNewDecFromStr("0.0025")
-			yearsAfterSix := year - 5
-			totalDecay := decayRate.MulInt64(yearsAfterSix)
-			inflationRate = baseRate.Sub(totalDecay)
-
-			if inflationRate.LT(params.InflationMin) {
-				inflationRate = params.InflationMin
-			}
-		}
+		inflationRate := types.DecayingInflationRate(year, params)
 
 		// Calculate annual mint
 		supplyDec := math.LegacyNewDecFromInt(currentSupply)
@@ -344,3 +298,10 @@ func (k Keeper) GetInflationForecast(ctx context.Context, years int64) []types.I
 
 	return forecasts
 }
+
+// ForecastYears projects mint, burn, net inflation and end-of-year supply for
+// the given number of years from the current year, using the decaying
+// inflation schedule and the ForecastAnnualBurnRate estimate
+func (k Keeper) ForecastYears(ctx context.Context, years int64) ([]types.SupplyForecastYear, error) {
+	return types.NewSupplyForecast(k.GetParams(ctx), k.GetCurrentYear(ctx), years)
+}
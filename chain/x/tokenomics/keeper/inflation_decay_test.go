@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"cosmossdk.io/math"
+
+	"pos/x/tokenomics/types"
 )
 
 // TestCalculateDecayingInflation tests the year-based inflation decay schedule
@@ -95,7 +97,7 @@ func (suite *KeeperTestSuite) TestCalculateDecayingInflation() {
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
 			// Set block height to simulate the year
-			blocksPerYear := int64(4_507_680)
+			blocksPerYear := int64(types.DefaultBlocksPerYear)
 			targetHeight := int64(1) + (tc.year * blocksPerYear)
 
 			// Create context with specific height
@@ -122,7 +124,7 @@ func (suite *KeeperTestSuite) TestCalculateDecayingInflation_EnforceFloor() {
 	suite.Require().NoError(err)
 
 	// Test far future (year 50) - should still be at floor
-	blocksPerYear := int64(4_507_680)
+	blocksPerYear := int64(types.DefaultBlocksPerYear)
 	futureHeight := int64(1) + (50 * blocksPerYear)
 	ctx := suite.ctx.WithBlockHeight(futureHeight)
 
@@ -137,7 +139,7 @@ func (suite *KeeperTestSuite) TestCalculateDecayingInflation_EnforceFloor() {
 
 // TestGetCurrentYear tests the year calculation from block height
 func (suite *KeeperTestSuite) TestGetCurrentYear() {
-	blocksPerYear := int64(4_507_680)
+	blocksPerYear := int64(types.DefaultBlocksPerYear)
 
 	tests := []struct {
 		height       int64
@@ -195,8 +197,8 @@ func (suite *KeeperTestSuite) TestCalculateBlockProvision() {
 	ctx := suite.ctx.WithBlockHeight(1) // Year 0
 	blockProvision := suite.keeper.CalculateBlockProvision(ctx)
 
-	// Expected: 22.5M / 4,507,680 blocks = ~4.99 OMNI per block
-	// 22,500,000,000,000 / 4,507,680 = 4,992,517 (with 6 decimals)
+	// Expected: 22.5M / 4,500,857 blocks = ~5.00 OMNI per block
+	// 22,500,000,000,000 / 4,500,857 = 4,999,047 (with 6 decimals)
 	expectedMin := math.NewInt(4_900_000) // ~4.9 OMNI (6 decimals)
 	expectedMax := math.NewInt(5_100_000) // ~5.1 OMNI (6 decimals)
 
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// RegisterInvariants registers module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply-conservation", SupplyConservationInvariant(k))
+}
+
+// SupplyConservationInvariant checks that the stored supply counters satisfy
+// current = minted - burned
+func SupplyConservationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		inv := k.GetSupplyInvariant(ctx)
+		if !inv.Consistent {
+			msg := fmt.Sprintf("current supply %s != minted %s - burned %s (expected %s)",
+				inv.CurrentTotalSupply, inv.TotalMinted, inv.TotalBurned, inv.ExpectedSupply())
+			return sdk.FormatInvariant(types.ModuleName, "supply-conservation", msg), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, "supply-conservation", "supply counters consistent"), false
+	}
+}
+
+// GetSupplyInvariant reads the stored supply counters and checks them against
+// the conservation law
+func (k Keeper) GetSupplyInvariant(ctx context.Context) types.SupplyInvariant {
+	return types.NewSupplyInvariant(k.GetTotalMinted(ctx), k.GetTotalBurned(ctx), k.GetCurrentSupply(ctx))
+}
+
+// CheckSupplyInvariant recomputes current = minted - burned from the stored
+// counters. A violation is logged, emitted as a critical
+// supply_invariant_broken event and returned as ErrSupplyInvariantBroken.
+func (k Keeper) CheckSupplyInvariant(ctx context.Context) error {
+	inv := k.GetSupplyInvariant(ctx)
+	if inv.Consistent {
+		return nil
+	}
+
+	expected := inv.ExpectedSupply()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSupplyInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeySeverity, "critical"),
+			sdk.NewAttribute(types.AttributeKeyTotalMinted, inv.TotalMinted.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalBurned, inv.TotalBurned.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, inv.CurrentTotalSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyExpectedSupply, expected.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+		),
+	)
+
+	k.Logger(ctx).Error("CRITICAL: supply conservation invariant broken",
+		"total_minted", inv.TotalMinted.String(),
+		"total_burned", inv.TotalBurned.String(),
+		"current_supply", inv.CurrentTotalSupply.String(),
+		"expected_supply", expected.String(),
+	)
+
+	return fmt.Errorf("%w: current supply %s != minted %s - burned %s",
+		types.ErrSupplyInvariantBroken, inv.CurrentTotalSupply, inv.TotalMinted, inv.TotalBurned)
+}
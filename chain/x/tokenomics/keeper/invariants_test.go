@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+// setupSupplyCounters stores consistent supply counters and runs a mint and a
+// burn through the keeper so the invariant holds after real mutations
+func setupSupplyCounters(t *testing.T) *TestSuiteWrapper {
+	t.Helper()
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+
+	require.NoError(t, f.Keeper.SetTotalMinted(ctx, math.NewInt(1_000_000)))
+	require.NoError(t, f.Keeper.SetTotalBurned(ctx, math.NewInt(100_000)))
+	require.NoError(t, f.Keeper.SetCurrentSupply(ctx, math.NewInt(900_000)))
+	require.NoError(t, f.Keeper.SetTreasuryAddress(ctx, sdk.AccAddress("treasury____________")))
+
+	user := sdk.AccAddress("user________________")
+	require.NoError(t, f.Keeper.MintTokens(ctx, math.NewInt(50_000), user, "test mint"))
+	_, _, err := f.Keeper.BurnTokens(ctx, user, math.NewInt(20_000), types.BurnSource_BURN_SOURCE_POS_GAS, "omniphi-core-1")
+	require.NoError(t, err)
+
+	return f
+}
+
+func TestCheckSupplyInvariant_Consistent(t *testing.T) {
+	f := setupSupplyCounters(t)
+
+	require.NoError(t, f.Keeper.CheckSupplyInvariant(f.Ctx))
+	require.Zero(t, countEvents(f.Ctx, types.EventTypeSupplyInvariantBroken))
+
+	res, err := keeper.NewQueryServerImpl(f.Keeper).SupplyInvariant(f.Ctx, &types.QuerySupplyInvariantRequest{})
+	require.NoError(t, err)
+	inv := res.Invariant
+	require.True(t, inv.Consistent)
+	require.Equal(t, "1050000", inv.TotalMinted.String())
+	require.True(t, inv.CurrentTotalSupply.Equal(inv.TotalMinted.Sub(inv.TotalBurned)))
+
+	msg, broken := keeper.SupplyConservationInvariant(f.Keeper)(f.Ctx)
+	require.False(t, broken, msg)
+}
+
+func TestCheckSupplyInvariant_CorruptedCounter(t *testing.T) {
+	f := setupSupplyCounters(t)
+	ctx := f.Ctx
+
+	// Corrupt the burned counter without touching supply
+	burned := f.Keeper.GetTotalBurned(ctx)
+	require.NoError(t, f.Keeper.SetTotalBurned(ctx, burned.AddRaw(1)))
+
+	err := f.Keeper.CheckSupplyInvariant(ctx)
+	require.ErrorIs(t, err, types.ErrSupplyInvariantBroken)
+
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeSupplyInvariantBroken))
+	require.Equal(t, "critical", eventAttribute(ctx, types.EventTypeSupplyInvariantBroken, types.AttributeKeySeverity))
+	require.Equal(t, burned.AddRaw(1).String(),
+		eventAttribute(ctx, types.EventTypeSupplyInvariantBroken, types.AttributeKeyTotalBurned))
+	require.Equal(t, f.Keeper.GetCurrentSupply(ctx).SubRaw(1).String(),
+		eventAttribute(ctx, types.EventTypeSupplyInvariantBroken, types.AttributeKeyExpectedSupply))
+
+	res, err := keeper.NewQueryServerImpl(f.Keeper).SupplyInvariant(ctx, &types.QuerySupplyInvariantRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Invariant.Consistent)
+
+	_, broken := keeper.SupplyConservationInvariant(f.Keeper)(ctx)
+	require.True(t, broken)
+}
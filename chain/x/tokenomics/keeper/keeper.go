@@ -28,6 +28,9 @@ type Keeper struct {
 
 	// Module authority (x/gov module account)
 	authority string
+
+	// Recent burns for StreamBurnEvents (node-local, not consensus state)
+	burnStream *burnStream
 }
 
 // NewKeeper creates a new tokenomics Keeper instance
@@ -77,6 +80,7 @@ func NewKeeper(
 		govKeeper:     govKeeper,
 		ibcKeeper:     ibcKeeper,
 		authority:     authority,
+		burnStream:    newBurnStream(),
 	}
 }
 
@@ -120,6 +124,14 @@ func (k Keeper) SetParams(ctx context.Context, params types.TokenomicsParams) er
 		return err
 	}
 
+	// REDIRECT-008: Every redirect target with a nonzero ratio needs an address
+	// before the redirect is switched on, or the next redirect fails mid-interval
+	if redirectTargetsChanged(existingParams, params) {
+		if _, err := k.GetRedirectTargets(ctx, params); err != nil {
+			return fmt.Errorf("invalid treasury redirect targets: %w", err)
+		}
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(&params)
 	return store.Set(types.ParamsKey, bz)
@@ -203,14 +215,8 @@ func (k Keeper) SetTotalBurned(ctx context.Context, burned math.Int) error {
 // ValidateSupplyCap checks if minting would exceed the hard cap
 func (k Keeper) ValidateSupplyCap(ctx context.Context, mintAmount math.Int) error {
 	params := k.GetParams(ctx)
-	currentSupply := k.GetCurrentSupply(ctx)
-
-	newSupply := currentSupply.Add(mintAmount)
-	if newSupply.GT(params.TotalSupplyCap) {
-		return types.ErrSupplyCapExceeded
-	}
-
-	return nil
+	_, err := safeAddSupply(k.GetCurrentSupply(ctx), mintAmount, params.TotalSupplyCap)
+	return err
 }
 
 // GetNextBurnID returns the next burn record ID
@@ -22,12 +22,28 @@ import (
 // MockAccountKeeper is a mock implementation of AccountKeeper
 type MockAccountKeeper struct {
 	accounts map[string]sdk.AccountI
+	modules  map[string]bool
 }
 
 func NewMockAccountKeeper() *MockAccountKeeper {
-	return &MockAccountKeeper{
+	m := &MockAccountKeeper{
 		accounts: make(map[string]sdk.AccountI),
+		modules:  make(map[string]bool),
 	}
+	for _, name := range []string{
+		authtypes.FeeCollectorName, "distribution", "mint", "gov", "poc",
+		stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName,
+		types.ModuleName, types.TimelockModuleName,
+	} {
+		m.addModuleAccount(name)
+	}
+	return m
+}
+
+// addModuleAccount makes name a known module account, as a module account
+// permission in the app config would
+func (m *MockAccountKeeper) addModuleAccount(name string) {
+	m.modules[name] = true
 }
 
 func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
@@ -38,7 +54,12 @@ func (m *MockAccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
 	m.accounts[acc.GetAddress().String()] = acc
 }
 
+// GetModuleAddress returns nil for names without a module account, like the
+// real account keeper
 func (m *MockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	if !m.modules[name] {
+		return nil
+	}
 	return authtypes.NewModuleAddress(name)
 }
 
@@ -199,6 +220,13 @@ func (m *MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt s
 	return nil
 }
 
+// fundAccount credits coins to addr and adds them to the total supply, so
+// they can later be burned
+func (m *MockBankKeeper) fundAccount(addr sdk.AccAddress, coins sdk.Coins) {
+	m.balances[addr.String()] = m.balances[addr.String()].Add(coins...)
+	m.supply = m.supply.Add(coins...)
+}
+
 func (m *MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	if bal, ok := m.balances[addr.String()]; ok {
 		return sdk.NewCoin(denom, bal.AmountOf(denom))
@@ -217,6 +245,10 @@ func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress
 	return m.GetAllBalances(ctx, addr)
 }
 
+func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.supply.AmountOf(denom))
+}
+
 // MockStakingKeeper is a mock implementation of StakingKeeper
 type MockStakingKeeper struct {
 	bondedTokens math.Int
@@ -306,6 +338,7 @@ type TestSuiteWrapper struct {
 	AccountKeeper *MockAccountKeeper
 	BankKeeper    *MockBankKeeper
 	StakingKeeper *MockStakingKeeper
+	StoreKey      *storetypes.KVStoreKey
 }
 
 // SetupTestSuite creates a test suite for non-suite-style tests
@@ -349,6 +382,7 @@ func SetupTestSuite(t *testing.T) *TestSuiteWrapper {
 		AccountKeeper: accountKeeper,
 		BankKeeper:    bankKeeper,
 		StakingKeeper: stakingKeeper,
+		StoreKey:      key,
 	}
 }
 
@@ -471,8 +505,9 @@ func (suite *KeeperTestSuite) TestSupplyCapOverflow_P0_CAP_006() {
 	recipient := sdk.AccAddress([]byte("recipient"))
 	err = suite.keeper.MintTokens(suite.ctx, math.NewInt(1<<62), recipient, "overflow attempt")
 
-	// Should be rejected (either by cap check or overflow protection)
+	// Rejected by safeAddSupply before the addition is performed
 	suite.Require().Error(err)
+	suite.Require().ErrorIs(err, types.ErrSupplyCapExceeded)
 }
 
 // ==================== P0-INF Tests: Inflation Bounds ====================
@@ -542,6 +577,21 @@ func (suite *KeeperTestSuite) TestInflationProtocolCap_P0_INF_005() {
 	suite.Require().True(newParams.InflationMax.Equal(math.LegacyNewDecWithPrec(3, 2)))
 }
 
+// TestInflationProtocolCap_P0_INF_006 tests inflation_max is bounded by
+// MaxAnnualInflationRateHardCap, the same constant the defaults sit at
+func (suite *KeeperTestSuite) TestInflationProtocolCap_P0_INF_006() {
+	hardCap := math.LegacyMustNewDecFromStr(types.MaxAnnualInflationRateHardCap)
+	suite.Require().True(types.DefaultParams().InflationMax.Equal(hardCap), "default inflation_max should sit at the protocol cap")
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.InflationMax = hardCap.Add(math.LegacySmallestDec())
+	err := suite.keeper.SetParams(suite.ctx, params)
+
+	suite.Require().Error(err)
+	suite.Require().ErrorIs(err, types.ErrProtocolCapViolation)
+	suite.Require().True(suite.keeper.GetParams(suite.ctx).InflationMax.LTE(hardCap))
+}
+
 // TestBlockProvisions_P0_INF_006 tests block provisions calculation accuracy
 func (suite *KeeperTestSuite) TestBlockProvisions_P0_INF_006() {
 	// Set known supply and inflation
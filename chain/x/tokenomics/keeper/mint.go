@@ -43,11 +43,12 @@ func (k Keeper) MintTokens(ctx context.Context, amount math.Int, recipient sdk.A
 	}
 
 	// P0-ACCT-001: Update supply counters
-	currentSupply := k.GetCurrentSupply(ctx)
-	totalMinted := k.GetTotalMinted(ctx)
-
-	newSupply := currentSupply.Add(amount)
-	newMinted := totalMinted.Add(amount)
+	params := k.GetParams(ctx)
+	newSupply, err := safeAddSupply(k.GetCurrentSupply(ctx), amount, params.TotalSupplyCap)
+	if err != nil {
+		return err
+	}
+	newMinted := k.GetTotalMinted(ctx).Add(amount)
 
 	if err := k.SetCurrentSupply(ctx, newSupply); err != nil {
 		return fmt.Errorf("failed to update current supply: %w", err)
@@ -62,7 +63,6 @@ func (k Keeper) MintTokens(ctx context.Context, amount math.Int, recipient sdk.A
 
 	// OBS-001: Emit mint event for transparency
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	params := k.GetParams(ctx)
 	remaining := params.TotalSupplyCap.Sub(newSupply)
 
 	sdkCtx.EventManager().EmitEvent(
@@ -98,8 +98,7 @@ func (k Keeper) CalculateBlockProvisions(ctx context.Context) math.LegacyDec {
 	annualProvisions := params.InflationRate.MulInt(currentSupply)
 
 	// Block provisions = annual_provisions / blocks_per_year
-	// Assuming ~7 second blocks: 365.25 days × 24 hours × 3600 seconds / 7 seconds
-	const blocksPerYear int64 = 4500857 // 365.25 * 24 * 3600 / 7
+	blocksPerYear := int64(params.EffectiveBlocksPerYear())
 
 	blockProvisions := annualProvisions.QuoInt64(blocksPerYear)
 
@@ -116,3 +115,73 @@ func (k Keeper) CalculateAnnualProvisions(ctx context.Context) math.Int {
 
 	return annualProvisions
 }
+
+// EpochProvisions returns the tokens minted for one reward epoch: the block
+// provisions times RewardStreamInterval, truncated
+func (k Keeper) EpochProvisions(ctx context.Context) math.Int {
+	params := k.GetParams(ctx)
+	return k.CalculateBlockProvisions(ctx).MulInt64(int64(params.RewardStreamInterval)).TruncateInt()
+}
+
+// MintEpochProvisions runs every block from BeginBlock. At each epoch boundary
+// it mints the epoch's provisions, limited to the headroom below the supply
+// cap, splits them across the emission recipients by their shares and advances
+// the supply counters. Minting, transfers and accounting commit together or
+// not at all. Returns the amount minted, zero off epoch boundaries.
+func (k Keeper) MintEpochProvisions(ctx context.Context) (math.Int, error) {
+	if !k.ShouldDistributeRewards(ctx) || k.IsMintingDisabled(ctx) {
+		return math.ZeroInt(), nil
+	}
+
+	provision, reachesCap := k.LimitProvisionToCap(ctx, k.EpochProvisions(ctx))
+	if provision.IsZero() {
+		// Zero at genesis when no supply is tracked yet, or at the cap
+		if reachesCap {
+			return provision, k.CeaseMinting(ctx, provision, k.GetCurrentSupply(ctx))
+		}
+		return provision, nil
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	newSupply, err := k.mintEpochProvisions(cacheCtx, provision)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	write()
+
+	k.CheckSupplyCapWarnings(ctx, newSupply)
+
+	if reachesCap {
+		if err := k.CeaseMinting(ctx, provision, newSupply); err != nil {
+			return math.ZeroInt(), err
+		}
+	}
+	return provision, nil
+}
+
+func (k Keeper) mintEpochProvisions(ctx context.Context, provision math.Int) (math.Int, error) {
+	params := k.GetParams(ctx)
+	newSupply, err := safeAddSupply(k.GetCurrentSupply(ctx), provision, params.TotalSupplyCap)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// Recipient shares sum exactly to provision, dust included
+	if err := k.distributeEmissions(ctx, provision); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to distribute epoch provisions: %w", err)
+	}
+
+	if err := k.SetCurrentSupply(ctx, newSupply); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to update current supply: %w", err)
+	}
+	if err := k.SetTotalMinted(ctx, k.GetTotalMinted(ctx).Add(provision)); err != nil {
+		return math.ZeroInt(), fmt.Errorf("failed to update total minted: %w", err)
+	}
+
+	k.Logger(ctx).Info("epoch provisions minted",
+		"amount", provision.String(),
+		"new_supply", newSupply.String(),
+		"block_height", sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	)
+	return newSupply, nil
+}
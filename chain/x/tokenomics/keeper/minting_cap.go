@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// MINTING CEASES AT THE SUPPLY CAP
+// ============================================================================
+// Near the cap a full provision would fail the cap check on every attempt and
+// stall emission distribution. Instead the last provision is limited to the
+// remaining headroom; the emission splits are computed from that reduced total,
+// so each split shrinks by the same factor. Once the cap is reached minting is
+// disabled for good and a minting_ceased event is emitted once.
+
+// IsMintingDisabled reports whether the supply cap has been reached and
+// minting has ceased
+func (k Keeper) IsMintingDisabled(ctx context.Context) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyMintingDisabled)
+	return err == nil && len(bz) > 0 && bz[0] == 1
+}
+
+// LimitProvisionToCap limits a provision to the headroom between the tracked
+// supply and TotalSupplyCap. The second return value is true when minting the
+// returned amount reaches the cap, i.e. this is the final mint.
+func (k Keeper) LimitProvisionToCap(ctx context.Context, provision math.Int) (math.Int, bool) {
+	return limitToHeadroom(provision, k.GetCurrentSupply(ctx), k.GetParams(ctx).TotalSupplyCap)
+}
+
+// safeAddSupply returns current + mint, or ErrSupplyCapExceeded if the sum
+// would exceed supplyCap. The headroom is checked before adding, so the sum is
+// never computed for amounts that could overflow math.Int. Every increase of
+// the tracked supply goes through here.
+func safeAddSupply(current, mint, supplyCap math.Int) (math.Int, error) {
+	if current.IsNil() || mint.IsNil() || supplyCap.IsNil() {
+		return math.ZeroInt(), types.ErrInvalidAmount
+	}
+	if mint.IsNegative() {
+		return math.ZeroInt(), types.ErrInvalidAmount
+	}
+
+	headroom := supplyCap.Sub(current)
+	if mint.GT(headroom) {
+		return math.ZeroInt(), fmt.Errorf("%w: supply %s + mint %s exceeds cap %s",
+			types.ErrSupplyCapExceeded, current.String(), mint.String(), supplyCap.String())
+	}
+	return current.Add(mint), nil
+}
+
+// limitToHeadroom returns min(provision, supplyCap - supply) and whether that
+// amount brings supply to the cap
+func limitToHeadroom(provision, supply, supplyCap math.Int) (math.Int, bool) {
+	headroom := supplyCap.Sub(supply)
+	if !headroom.IsPositive() {
+		return math.ZeroInt(), true
+	}
+	if provision.GTE(headroom) {
+		return headroom, true
+	}
+	return provision, false
+}
+
+// CeaseMinting disables minting and emits minting_ceased. No-op if minting is
+// already disabled, so the event is emitted once.
+func (k Keeper) CeaseMinting(ctx context.Context, finalMint, totalSupply math.Int) error {
+	if k.IsMintingDisabled(ctx) {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.KeyMintingDisabled, []byte{1}); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	supplyCap := k.GetParams(ctx).TotalSupplyCap
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintingCeased,
+			sdk.NewAttribute(types.AttributeKeySupplyCap, supplyCap.String()),
+			sdk.NewAttribute(types.AttributeKeyFinalMint, finalMint.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, totalSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", sdkCtx.BlockHeight())),
+		),
+	)
+
+	k.Logger(ctx).Info("supply cap reached, minting ceased",
+		"supply_cap", supplyCap.String(),
+		"final_mint", finalMint.String(),
+		"total_supply", totalSupply.String(),
+	)
+	return nil
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+// setupNearCap puts the tracked supply headroom below the supply cap
+func setupNearCap(t *testing.T, headroom math.Int) (*TestSuiteWrapper, sdk.Context) {
+	t.Helper()
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(1)
+
+	params := f.Keeper.GetParams(ctx)
+	supply := params.TotalSupplyCap.Sub(headroom)
+	params.CurrentTotalSupply = supply
+	params.TotalMinted = supply
+	params.TotalBurned = math.ZeroInt()
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+	require.NoError(t, f.Keeper.SetCurrentSupply(ctx, supply))
+
+	return f, ctx
+}
+
+// countEvents returns how many events of the given type were emitted
+func countEvents(ctx sdk.Context, eventType string) int {
+	n := 0
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMintInflation_PartialFinalMint(t *testing.T) {
+	headroom := math.NewInt(1000)
+	f, ctx := setupNearCap(t, headroom)
+	params := f.Keeper.GetParams(ctx)
+
+	// The full block provision is far larger than what is left under the cap
+	require.True(t, f.Keeper.CalculateBlockProvision(ctx).GT(headroom))
+	require.False(t, f.Keeper.IsMintingDisabled(ctx))
+
+	require.NoError(t, f.Keeper.MintInflation(ctx))
+
+	// Supply lands exactly on the cap
+	after := f.Keeper.GetParams(ctx)
+	require.True(t, after.CurrentTotalSupply.Equal(params.TotalSupplyCap), "supply: %s", after.CurrentTotalSupply)
+	require.True(t, after.TotalMinted.Equal(params.TotalSupplyCap))
+
+	// Every split is taken from the reduced total
+	records, err := f.Keeper.GetLatestEmissionRecords(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	record := records[0]
+	require.True(t, record.TotalEmitted.Equal(headroom))
+	require.True(t, record.ToStaking.Equal(math.NewInt(400)), "staking: %s", record.ToStaking)
+	require.True(t, record.ToPoc.Equal(math.NewInt(300)), "poc: %s", record.ToPoc)
+	require.True(t, record.ToSequencer.Equal(math.NewInt(200)), "sequencer: %s", record.ToSequencer)
+	require.True(t, record.ToTreasury.Equal(math.NewInt(100)), "treasury: %s", record.ToTreasury)
+	require.True(t, f.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), types.BondDenom).Amount.Equal(math.NewInt(400)))
+
+	// Minting is now disabled and the event was emitted once
+	require.True(t, f.Keeper.IsMintingDisabled(ctx))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeMintingCeased))
+	require.Equal(t, headroom.String(), eventAttribute(ctx, types.EventTypeMintingCeased, types.AttributeKeyFinalMint))
+}
+
+func TestMintInflation_NoOpAfterCeasing(t *testing.T) {
+	f, ctx := setupNearCap(t, math.NewInt(1000))
+	require.NoError(t, f.Keeper.MintInflation(ctx))
+	require.True(t, f.Keeper.IsMintingDisabled(ctx))
+
+	// Burns free headroom again, but minting stays off
+	params := f.Keeper.GetParams(ctx)
+	params.TotalBurned = math.NewInt(1_000_000_000)
+	params.CurrentTotalSupply = params.TotalMinted.Sub(params.TotalBurned)
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+	before, err := f.Keeper.GetLatestEmissionRecords(ctx, 10)
+	require.NoError(t, err)
+
+	for height := int64(2); height <= 5; height++ {
+		blockCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, f.Keeper.MintInflation(blockCtx))
+		require.Empty(t, blockCtx.EventManager().Events(), "height %d emitted events", height)
+	}
+
+	after := f.Keeper.GetParams(ctx)
+	require.True(t, after.CurrentTotalSupply.Equal(params.CurrentTotalSupply))
+	require.True(t, after.TotalMinted.Equal(params.TotalMinted))
+	records, err := f.Keeper.GetLatestEmissionRecords(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, records, len(before))
+}
+
+func TestMintInflation_AtCapCeasesWithoutMinting(t *testing.T) {
+	f, ctx := setupNearCap(t, math.ZeroInt())
+
+	require.NoError(t, f.Keeper.MintInflation(ctx))
+	require.True(t, f.Keeper.IsMintingDisabled(ctx))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeMintingCeased))
+	require.Equal(t, "0", eventAttribute(ctx, types.EventTypeMintingCeased, types.AttributeKeyFinalMint))
+
+	// Ceasing again does not emit a second event
+	require.NoError(t, f.Keeper.CeaseMinting(ctx, math.ZeroInt(), f.Keeper.GetCurrentSupply(ctx)))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeMintingCeased))
+}
+
+func TestMintInflation_BelowCapKeepsMinting(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx.WithBlockHeight(1)
+	before := f.Keeper.GetParams(ctx).CurrentTotalSupply
+
+	require.NoError(t, f.Keeper.MintInflation(ctx))
+	require.True(t, f.Keeper.GetParams(ctx).CurrentTotalSupply.GT(before))
+	require.False(t, f.Keeper.IsMintingDisabled(ctx))
+	require.Zero(t, countEvents(ctx, types.EventTypeMintingCeased))
+}
+
+func TestLimitProvisionToCap(t *testing.T) {
+	f, ctx := setupNearCap(t, math.NewInt(1000))
+
+	amount, final := f.Keeper.LimitProvisionToCap(ctx, math.NewInt(999))
+	require.True(t, amount.Equal(math.NewInt(999)))
+	require.False(t, final)
+
+	amount, final = f.Keeper.LimitProvisionToCap(ctx, math.NewInt(1000))
+	require.True(t, amount.Equal(math.NewInt(1000)))
+	require.True(t, final)
+
+	amount, final = f.Keeper.LimitProvisionToCap(ctx, math.NewInt(5000))
+	require.True(t, amount.Equal(math.NewInt(1000)))
+	require.True(t, final)
+
+	// The epoch mint of the final amount passes the cap check
+	require.NoError(t, f.Keeper.ValidateSupplyCap(ctx, amount))
+}
+
+func TestSafeAddSupply_NearCap(t *testing.T) {
+	supplyCap := types.DefaultParams().TotalSupplyCap
+	current := supplyCap.SubRaw(1000)
+
+	sum, err := keeper.SafeAddSupply(current, math.NewInt(999), supplyCap)
+	require.NoError(t, err)
+	require.Equal(t, supplyCap.SubRaw(1).String(), sum.String())
+
+	sum, err = keeper.SafeAddSupply(current, math.NewInt(1000), supplyCap)
+	require.NoError(t, err)
+	require.Equal(t, supplyCap.String(), sum.String())
+
+	_, err = keeper.SafeAddSupply(current, math.NewInt(1001), supplyCap)
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+
+	// Supply already over the cap accepts no mint, not even zero headroom
+	_, err = keeper.SafeAddSupply(supplyCap.AddRaw(1), math.NewInt(1), supplyCap)
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+
+	_, err = keeper.SafeAddSupply(current, math.NewInt(-1), supplyCap)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+	_, err = keeper.SafeAddSupply(current, math.Int{}, supplyCap)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+}
+
+func TestSafeAddSupply_NearIntLimit(t *testing.T) {
+	// The largest value math.Int holds; adding to it panics
+	maxInt := math.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1)))
+	require.Panics(t, func() { maxInt.AddRaw(1) })
+
+	// Reaching the limit exactly is fine when the cap allows it
+	sum, err := keeper.SafeAddSupply(maxInt.SubRaw(1), math.NewInt(1), maxInt)
+	require.NoError(t, err)
+	require.True(t, sum.Equal(maxInt))
+
+	// Going past it is rejected before the addition could panic
+	require.NotPanics(t, func() {
+		_, err = keeper.SafeAddSupply(maxInt, math.NewInt(1), maxInt)
+	})
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+
+	require.NotPanics(t, func() {
+		_, err = keeper.SafeAddSupply(maxInt, maxInt, types.DefaultParams().TotalSupplyCap)
+	})
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+}
+
+func TestMintTokens_OverflowRejectedDeterministically(t *testing.T) {
+	f, ctx := setupNearCap(t, math.NewInt(1000))
+	recipient := sdk.AccAddress("recipient___________")
+	supply := f.Keeper.GetCurrentSupply(ctx)
+
+	err := f.Keeper.MintTokens(ctx, math.NewInt(1001), recipient, "past the cap")
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+	require.True(t, f.Keeper.GetCurrentSupply(ctx).Equal(supply))
+	require.True(t, f.BankKeeper.GetBalance(ctx, recipient, types.BondDenom).Amount.IsZero())
+
+	require.NoError(t, f.Keeper.MintTokens(ctx, math.NewInt(1000), recipient, "up to the cap"))
+	require.True(t, f.Keeper.GetCurrentSupply(ctx).Equal(f.Keeper.GetParams(ctx).TotalSupplyCap))
+}
@@ -37,12 +37,37 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	if err := msg.Params.Validate(); err != nil {
 		return nil, fmt.Errorf("parameter validation failed: %w", err)
 	}
+	if err := ms.validateRecipientModules(msg.Params.EmissionRecipients); err != nil {
+		return nil, fmt.Errorf("parameter validation failed: %w", err)
+	}
+
+	// TC-EMISSION-008: Split changes apply from the next epoch, not
+	// retroactively, so the current split stays active until then
+	params := msg.Params
+	current := ms.GetParams(ctx)
+	newSplit := params.EmissionSplit()
+	newRecipients := params.EmissionRecipients
+	splitChanged := !newSplit.Equal(current.EmissionSplit()) ||
+		!types.EmissionRecipients(newRecipients).Equal(current.EmissionRecipients)
+	if splitChanged {
+		params.EmissionSplitStaking = current.EmissionSplitStaking
+		params.EmissionSplitPoc = current.EmissionSplitPoc
+		params.EmissionSplitSequencer = current.EmissionSplitSequencer
+		params.EmissionSplitTreasury = current.EmissionSplitTreasury
+		params.EmissionRecipients = current.EmissionRecipients
+	}
 
 	// Set the new parameters
-	if err := ms.SetParams(ctx, msg.Params); err != nil {
+	if err := ms.SetParams(ctx, params); err != nil {
 		return nil, fmt.Errorf("failed to set parameters: %w", err)
 	}
 
+	if splitChanged {
+		if err := ms.stageEmissionConfig(ctx, newSplit, newRecipients); err != nil {
+			return nil, err
+		}
+	}
+
 	// OBS-001: Emit parameter update event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -270,3 +295,39 @@ func (ms msgServer) ReportBurn(goCtx context.Context, msg *types.MsgReportBurn)
 		NewTotalBurned:  newBurned,
 	}, nil
 }
+
+// SetEmergencyBurnOverride lets the burn override guardian pin or release the
+// fee burn ratio without waiting for a governance vote
+func (ms msgServer) SetEmergencyBurnOverride(goCtx context.Context, msg *types.MsgSetEmergencyBurnOverride) (*types.MsgSetEmergencyBurnOverrideResponse, error) {
+	expiresAt, err := ms.Keeper.SetEmergencyBurnOverride(goCtx, msg.Guardian, msg.Enabled, msg.BurnRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetEmergencyBurnOverrideResponse{
+		ExpiresAtHeight: expiresAt,
+	}, nil
+}
+
+// ExecuteRedirectNow runs the treasury redirect without waiting for the
+// execution interval, so the DAO can flush accumulated inflows on demand
+func (ms msgServer) ExecuteRedirectNow(goCtx context.Context, msg *types.MsgExecuteRedirectNow) (*types.MsgExecuteRedirectNowResponse, error) {
+	if msg.Authority != ms.GetAuthority() {
+		return nil, types.ErrUnauthorized
+	}
+
+	result, err := ms.Keeper.ExecuteTreasuryRedirectNow(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgExecuteRedirectNowResponse{
+		RedirectedAmount: math.ZeroInt(),
+		CarriedOver:      ms.GetAccumulatedRedirectInflows(goCtx),
+	}
+	if result != nil {
+		res.RedirectedAmount = result.RedirectAmount
+		res.CarriedOver = result.CarriedOver
+	}
+	return res, nil
+}
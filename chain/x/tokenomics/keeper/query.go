@@ -39,6 +39,19 @@ func (qs queryServer) Params(goCtx context.Context, req *types.QueryParamsReques
 	}, nil
 }
 
+// ParamsFormatted returns the parameters as the human-readable dashboard
+// produced by FormatString, so clients need not re-implement the formatting
+func (qs queryServer) ParamsFormatted(goCtx context.Context, req *types.QueryParamsFormattedRequest) (*types.QueryParamsFormattedResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsFormattedResponse{
+		Formatted: qs.GetParams(ctx).FormatString(),
+	}, nil
+}
+
 // Supply returns comprehensive supply metrics
 // DASH-001: Global supply chart
 // P0-ACCT-001: Supply accounting verification
@@ -68,16 +81,13 @@ func (qs queryServer) Inflation(goCtx context.Context, req *types.QueryInflation
 	// Calculate block provisions
 	blockProvisions := qs.CalculateBlockProvisions(ctx)
 
-	// Estimate blocks per year (7 second blocks)
-	const blocksPerYear uint64 = 4500857 // 365.25 * 24 * 3600 / 7
-
 	return &types.QueryInflationResponse{
 		CurrentInflationRate: params.InflationRate,
 		InflationMin:         params.InflationMin,
 		InflationMax:         params.InflationMax,
 		AnnualProvisions:     annualProvisions,
 		BlockProvisions:      blockProvisions,
-		BlocksPerYear:        blocksPerYear,
+		BlocksPerYear:        params.EffectiveBlocksPerYear(),
 	}, nil
 }
 
@@ -94,32 +104,17 @@ func (qs queryServer) Emissions(goCtx context.Context, req *types.QueryEmissions
 	// Calculate total annual emissions
 	totalAnnualEmissions := qs.CalculateAnnualProvisions(ctx)
 
-	// Calculate per-category allocations with real cumulative tracking
-	allocations := []types.EmissionAllocation{
-		{
-			Category:         "staking",
-			Percentage:       params.EmissionSplitStaking,
-			AnnualAmount:     params.EmissionSplitStaking.MulInt(totalAnnualEmissions).TruncateInt(),
-			TotalDistributed: qs.GetCumulativeDistributed(ctx, "staking"),
-		},
-		{
-			Category:         "poc",
-			Percentage:       params.EmissionSplitPoc,
-			AnnualAmount:     params.EmissionSplitPoc.MulInt(totalAnnualEmissions).TruncateInt(),
-			TotalDistributed: qs.GetCumulativeDistributed(ctx, "poc"),
-		},
-		{
-			Category:         "sequencer",
-			Percentage:       params.EmissionSplitSequencer,
-			AnnualAmount:     params.EmissionSplitSequencer.MulInt(totalAnnualEmissions).TruncateInt(),
-			TotalDistributed: qs.GetCumulativeDistributed(ctx, "sequencer"),
-		},
-		{
-			Category:         "treasury",
-			Percentage:       params.EmissionSplitTreasury,
-			AnnualAmount:     params.EmissionSplitTreasury.MulInt(totalAnnualEmissions).TruncateInt(),
-			TotalDistributed: qs.GetCumulativeDistributed(ctx, "treasury"),
-		},
+	// Calculate per-recipient allocations with real cumulative tracking
+	// (recorded for the built-in categories only)
+	recipients := params.EmissionRecipientList()
+	allocations := make([]types.EmissionAllocation, 0, len(recipients))
+	for _, recipient := range recipients {
+		allocations = append(allocations, types.EmissionAllocation{
+			Category:         recipient.Module,
+			Percentage:       recipient.Share,
+			AnnualAmount:     recipient.Share.MulInt(totalAnnualEmissions).TruncateInt(),
+			TotalDistributed: qs.GetCumulativeDistributed(ctx, recipient.Module),
+		})
 	}
 
 	return &types.QueryEmissionsResponse{
@@ -262,6 +257,40 @@ func (qs queryServer) BurnsByChain(goCtx context.Context, req *types.QueryBurnsB
 	}, nil
 }
 
+// AllBurnsByChain returns the burn total of every chain and their sum.
+func (qs queryServer) AllBurnsByChain(goCtx context.Context, req *types.QueryAllBurnsByChainRequest) (*types.QueryAllBurnsByChainResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := types.NewQueryAllBurnsByChainResponse(qs.GetAllBurnsByChain(ctx))
+	return &res, nil
+}
+
+// BurnsBreakdown returns the burn total of every burn source and their sum.
+func (qs queryServer) BurnsBreakdown(goCtx context.Context, req *types.QueryBurnsBreakdownRequest) (*types.QueryBurnsBreakdownResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := types.NewQueryBurnsBreakdownResponse(qs.GetBurnsBreakdown(ctx))
+	return &res, nil
+}
+
+// BurnRateBySource returns, for every burn source, its share of the fee burn
+// and its module-specific burns.
+func (qs queryServer) BurnRateBySource(goCtx context.Context, req *types.QueryBurnRateBySourceRequest) (*types.QueryBurnRateBySourceResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := types.NewQueryBurnRateBySourceResponse(qs.GetBurnsBreakdown(ctx), qs.GetFeeBurnsBreakdown(ctx))
+	return &res, nil
+}
+
 // Treasury returns DAO treasury status
 // DASH-001: Treasury tracking
 // P1-TREAS-001: Treasury balance integrity
@@ -480,3 +509,147 @@ func (qs queryServer) BurnRate(goCtx context.Context, req *types.QueryBurnRateRe
 		EmergencyBurnOverride:   params.EmergencyBurnOverride,
 	}, nil
 }
+
+// StreamBurnEvents replays the buffered recent burns and then pushes each new
+// burn as EndBlock publishes it, until the client disconnects. A client that
+// falls BurnStreamBufferSize burns behind is disconnected and should
+// resubscribe.
+func (qs queryServer) StreamBurnEvents(req *types.QueryStreamBurnEventsRequest, stream types.Query_StreamBurnEventsServer) error {
+	if req == nil {
+		return fmt.Errorf("empty request")
+	}
+
+	replay, updates, cancel := qs.SubscribeBurnEvents()
+	defer cancel()
+
+	for i := range replay {
+		if err := stream.Send(&replay[i]); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case record, ok := <-updates:
+			if !ok {
+				return fmt.Errorf("burn stream subscriber fell more than %d burns behind", BurnStreamBufferSize)
+			}
+			if err := stream.Send(&record); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// FullConfig returns params together with the configuration stored outside
+// the params object (treasury and redirect target addresses, redirect state).
+func (qs queryServer) FullConfig(goCtx context.Context, req *types.QueryFullConfigRequest) (*types.QueryFullConfigResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryFullConfigResponse{
+		Config: qs.GetFullConfig(ctx),
+	}, nil
+}
+
+// PendingChanges lists all staged param changes and their effective epochs.
+func (qs queryServer) PendingChanges(goCtx context.Context, req *types.QueryPendingChangesRequest) (*types.QueryPendingChangesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryPendingChangesResponse{
+		CurrentEpoch: qs.CurrentEpoch(ctx),
+		Changes:      qs.GetPendingChanges(ctx),
+	}, nil
+}
+
+// SupplyReconciliation compares the tracked supply against the bank module's
+// supply.
+func (qs queryServer) SupplyReconciliation(goCtx context.Context, req *types.QuerySupplyReconciliationRequest) (*types.QuerySupplyReconciliationResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QuerySupplyReconciliationResponse{
+		Reconciliation: qs.GetSupplyReconciliation(ctx),
+	}, nil
+}
+
+// SupplyInvariant returns the stored supply counters and whether they satisfy
+// current = minted - burned.
+func (qs queryServer) SupplyInvariant(goCtx context.Context, req *types.QuerySupplyInvariantRequest) (*types.QuerySupplyInvariantResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QuerySupplyInvariantResponse{
+		Invariant: qs.GetSupplyInvariant(ctx),
+	}, nil
+}
+
+// TreasuryRedirect returns the treasury redirect configuration and its
+// cumulative state.
+func (qs queryServer) TreasuryRedirect(goCtx context.Context, req *types.QueryTreasuryRedirectRequest) (*types.QueryTreasuryRedirectResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryTreasuryRedirectResponse{
+		Redirect: qs.GetTreasuryRedirectStatus(ctx),
+	}, nil
+}
+
+// SupplyForecast projects per-year mint, burn and supply from the current
+// year.
+func (qs queryServer) SupplyForecast(goCtx context.Context, req *types.QuerySupplyForecastRequest) (*types.QuerySupplyForecastResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res, err := types.NewQuerySupplyForecastResponse(qs.GetParams(ctx), qs.GetCurrentYear(ctx), req.Years)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// EmissionsHistory returns cumulative and last-epoch emission per recipient
+// category.
+func (qs queryServer) EmissionsHistory(goCtx context.Context, req *types.QueryEmissionsHistoryRequest) (*types.QueryEmissionsHistoryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryEmissionsHistoryResponse{
+		History: qs.GetEmissionsHistory(ctx),
+	}, nil
+}
+
+// BurnRatioHistory pages through the adaptive burn controller's decision
+// history, oldest first.
+func (qs queryServer) BurnRatioHistory(goCtx context.Context, req *types.QueryBurnRatioHistoryRequest) (*types.QueryBurnRatioHistoryResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries, pageRes, err := qs.GetBurnRatioHistory(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBurnRatioHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
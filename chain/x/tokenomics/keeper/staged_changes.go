@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// EPOCH-STAGED PARAM CHANGES
+// ============================================================================
+// Features that change params "from next epoch" (emission splits, burn rates)
+// stage their changes here instead of writing params directly. At each epoch
+// boundary BeginBlock applies every change that is due in one step: either all
+// of them take effect or, if the resulting params are invalid, none do.
+//
+// An epoch is RewardStreamInterval blocks; epoch N starts at height
+// N * RewardStreamInterval.
+
+// CurrentEpoch returns the epoch containing the current block
+func (k Keeper) CurrentEpoch(ctx context.Context) int64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	interval := int64(k.GetParams(ctx).RewardStreamInterval)
+	if interval <= 0 {
+		return 0
+	}
+	return sdkCtx.BlockHeight() / interval
+}
+
+// StageParamChanges queues changes to take effect at the start of
+// effectiveEpoch, which must be in the future. Each change is checked against
+// the current params for unknown fields and malformed values; the combined
+// result is validated when the changes are applied.
+func (k Keeper) StageParamChanges(ctx context.Context, effectiveEpoch int64, source string, changes []types.ParamChange) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	staged := types.StagedChange{
+		EffectiveEpoch: effectiveEpoch,
+		Changes:        changes,
+		Source:         source,
+		StagedAtHeight: sdkCtx.BlockHeight(),
+	}
+	if err := staged.Validate(); err != nil {
+		return 0, err
+	}
+
+	if current := k.CurrentEpoch(ctx); effectiveEpoch <= current {
+		return 0, fmt.Errorf("effective epoch %d must be after current epoch %d", effectiveEpoch, current)
+	}
+
+	scratch := k.GetParams(ctx)
+	for _, c := range changes {
+		if err := c.ApplyTo(&scratch); err != nil {
+			return 0, err
+		}
+	}
+
+	staged.Id = k.getNextStagedChangeID(ctx)
+	if err := k.setStagedChange(ctx, staged); err != nil {
+		return 0, err
+	}
+	if err := k.setNextStagedChangeID(ctx, staged.Id+1); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangeStaged,
+			sdk.NewAttribute("id", fmt.Sprintf("%d", staged.Id)),
+			sdk.NewAttribute("effective_epoch", fmt.Sprintf("%d", effectiveEpoch)),
+			sdk.NewAttribute("source", source),
+		),
+	)
+
+	return staged.Id, nil
+}
+
+// GetPendingChanges returns all staged changes ordered by effective epoch,
+// then by staging order
+func (k Keeper) GetPendingChanges(ctx context.Context) []types.StagedChange {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.StagedChangePrefix, storetypes.PrefixEndBytes(types.StagedChangePrefix))
+	if err != nil {
+		return nil
+	}
+	defer iter.Close()
+
+	var changes []types.StagedChange
+	for ; iter.Valid(); iter.Next() {
+		var staged types.StagedChange
+		if err := json.Unmarshal(iter.Value(), &staged); err != nil {
+			k.Logger(ctx).Error("failed to decode staged change", "key", iter.Key(), "error", err)
+			continue
+		}
+		changes = append(changes, staged)
+	}
+	return changes
+}
+
+// ApplyStagedChanges applies every staged change whose effective epoch has
+// been reached. It only acts on epoch boundaries. Changes are applied in key
+// order (epoch, then ID), so a later change to the same field wins.
+func (k Keeper) ApplyStagedChanges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+	interval := int64(params.RewardStreamInterval)
+	if interval <= 0 || sdkCtx.BlockHeight()%interval != 0 {
+		return nil
+	}
+	epoch := sdkCtx.BlockHeight() / interval
+
+	var due []types.StagedChange
+	for _, staged := range k.GetPendingChanges(ctx) {
+		if staged.EffectiveEpoch > epoch {
+			break
+		}
+		due = append(due, staged)
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	// Due changes leave the queue whether or not they apply
+	store := k.storeService.OpenKVStore(ctx)
+	for _, staged := range due {
+		if err := store.Delete(types.GetStagedChangeKey(staged.EffectiveEpoch, staged.Id)); err != nil {
+			return err
+		}
+	}
+
+	applyErr := func() error {
+		for _, staged := range due {
+			for _, c := range staged.Changes {
+				if err := c.ApplyTo(&params); err != nil {
+					return fmt.Errorf("staged change %d: %w", staged.Id, err)
+				}
+			}
+		}
+		return k.SetParams(ctx, params)
+	}()
+
+	ids := make([]string, len(due))
+	for i, staged := range due {
+		ids[i] = fmt.Sprintf("%d", staged.Id)
+	}
+
+	if applyErr != nil {
+		k.Logger(ctx).Error("staged param changes rejected",
+			"epoch", epoch,
+			"ids", ids,
+			"error", applyErr,
+		)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStagedChangesRejected,
+				sdk.NewAttribute("epoch", fmt.Sprintf("%d", epoch)),
+				sdk.NewAttribute("count", fmt.Sprintf("%d", len(due))),
+				sdk.NewAttribute("error", applyErr.Error()),
+			),
+		)
+		return nil
+	}
+
+	k.Logger(ctx).Info("staged param changes applied",
+		"epoch", epoch,
+		"ids", ids,
+	)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStagedChangesApplied,
+			sdk.NewAttribute("epoch", fmt.Sprintf("%d", epoch)),
+			sdk.NewAttribute("count", fmt.Sprintf("%d", len(due))),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) setStagedChange(ctx context.Context, staged types.StagedChange) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := json.Marshal(staged)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.GetStagedChangeKey(staged.EffectiveEpoch, staged.Id), bz)
+}
+
+func (k Keeper) getNextStagedChangeID(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyNextStagedChangeID)
+	if err != nil || bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextStagedChangeID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return store.Set(types.KeyNextStagedChangeID, bz)
+}
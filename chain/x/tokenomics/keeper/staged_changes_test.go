@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+func decChange(field, value string) types.ParamChange {
+	return types.ParamChange{Field: field, Value: `"` + value + `"`}
+}
+
+func TestStagedChanges_ApplyAtEpochBoundaries(t *testing.T) {
+	ts := SetupTestSuite(t)
+	k := ts.Keeper
+
+	// RewardStreamInterval defaults to 100 blocks: height 150 is epoch 1
+	ctx := ts.Ctx.WithBlockHeight(150)
+	require.Equal(t, int64(1), k.CurrentEpoch(ctx))
+
+	// The two split changes are only valid together (splits must sum to 1.0)
+	_, err := k.StageParamChanges(ctx, 2, "emission-splits", []types.ParamChange{decChange("emission_split_staking", "0.45")})
+	require.NoError(t, err)
+	_, err = k.StageParamChanges(ctx, 2, "emission-splits", []types.ParamChange{decChange("emission_split_poc", "0.25")})
+	require.NoError(t, err)
+	_, err = k.StageParamChanges(ctx, 3, "burn-rates", []types.ParamChange{decChange("burn_rate_pos_gas", "0.30")})
+	require.NoError(t, err)
+
+	pending := k.GetPendingChanges(ctx)
+	require.Len(t, pending, 3)
+	require.Equal(t, []int64{2, 2, 3}, []int64{pending[0].EffectiveEpoch, pending[1].EffectiveEpoch, pending[2].EffectiveEpoch})
+	require.Less(t, pending[0].Id, pending[1].Id)
+
+	res, err := keeper.NewQueryServerImpl(k).PendingChanges(ctx, &types.QueryPendingChangesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.CurrentEpoch)
+	require.Equal(t, pending, res.Changes)
+
+	// Nothing applies before the boundary
+	require.NoError(t, k.ApplyStagedChanges(ctx.WithBlockHeight(199)))
+	require.True(t, k.GetParams(ctx).EmissionSplitStaking.Equal(math.LegacyNewDecWithPrec(40, 2)))
+
+	// Epoch 2 boundary: both epoch-2 changes apply together, epoch 3 stays queued
+	require.NoError(t, k.ApplyStagedChanges(ctx.WithBlockHeight(200)))
+	params := k.GetParams(ctx)
+	require.True(t, params.EmissionSplitStaking.Equal(math.LegacyNewDecWithPrec(45, 2)))
+	require.True(t, params.EmissionSplitPoc.Equal(math.LegacyNewDecWithPrec(25, 2)))
+	require.True(t, params.BurnRatePosGas.Equal(math.LegacyNewDecWithPrec(20, 2)))
+	require.Len(t, k.GetPendingChanges(ctx), 1)
+
+	// Mid-epoch blocks do nothing
+	require.NoError(t, k.ApplyStagedChanges(ctx.WithBlockHeight(250)))
+	require.True(t, k.GetParams(ctx).BurnRatePosGas.Equal(math.LegacyNewDecWithPrec(20, 2)))
+
+	// Epoch 3 boundary
+	require.NoError(t, k.ApplyStagedChanges(ctx.WithBlockHeight(300)))
+	require.True(t, k.GetParams(ctx).BurnRatePosGas.Equal(math.LegacyNewDecWithPrec(30, 2)))
+	require.Empty(t, k.GetPendingChanges(ctx))
+}
+
+func TestStagedChanges_InvalidBatchIsRejectedAtomically(t *testing.T) {
+	ts := SetupTestSuite(t)
+	k := ts.Keeper
+	ctx := ts.Ctx.WithBlockHeight(150)
+
+	// Valid on its own, but the batch below breaks the emission split sum
+	_, err := k.StageParamChanges(ctx, 2, "burn-rates", []types.ParamChange{decChange("burn_rate_messaging", "0.09")})
+	require.NoError(t, err)
+	_, err = k.StageParamChanges(ctx, 2, "emission-splits", []types.ParamChange{decChange("emission_split_staking", "0.50")})
+	require.NoError(t, err)
+
+	require.NoError(t, k.ApplyStagedChanges(ctx.WithBlockHeight(200)))
+
+	params := k.GetParams(ctx)
+	require.True(t, params.BurnRateMessaging.Equal(math.LegacyNewDecWithPrec(8, 2)))
+	require.True(t, params.EmissionSplitStaking.Equal(math.LegacyNewDecWithPrec(40, 2)))
+	require.Empty(t, k.GetPendingChanges(ctx))
+}
+
+func TestStagedChanges_StagingValidation(t *testing.T) {
+	ts := SetupTestSuite(t)
+	k := ts.Keeper
+	ctx := ts.Ctx.WithBlockHeight(150)
+
+	// Current and past epochs are rejected
+	_, err := k.StageParamChanges(ctx, 1, "test", []types.ParamChange{decChange("burn_rate_pos_gas", "0.30")})
+	require.Error(t, err)
+
+	// Unknown field
+	_, err = k.StageParamChanges(ctx, 2, "test", []types.ParamChange{decChange("no_such_param", "0.30")})
+	require.Error(t, err)
+
+	// Malformed value
+	_, err = k.StageParamChanges(ctx, 2, "test", []types.ParamChange{decChange("burn_rate_pos_gas", "not-a-dec")})
+	require.Error(t, err)
+
+	// Empty change set
+	_, err = k.StageParamChanges(ctx, 2, "test", nil)
+	require.Error(t, err)
+
+	require.Empty(t, k.GetPendingChanges(ctx))
+}
@@ -37,9 +37,18 @@ func (k Keeper) GetSupplyMetrics(ctx context.Context) types.QuerySupplyResponse
 		RemainingMintable:   remainingMintable,
 		SupplyPctOfCap:      supplyPct,
 		NetInflationRate:    netInflationRate,
+		CirculatingSupply:   k.GetCirculatingSupply(ctx),
 	}
 }
 
+// GetSupplyReconciliation compares CurrentTotalSupply with the bank module's
+// supply of the native denom. Any drift means mints or burns bypassed the
+// tokenomics accounting (or vice versa).
+func (k Keeper) GetSupplyReconciliation(ctx context.Context) types.SupplyReconciliation {
+	bankSupply := k.bankKeeper.GetSupply(ctx, types.BondDenom)
+	return types.NewSupplyReconciliation(types.BondDenom, k.GetCurrentSupply(ctx), bankSupply.Amount)
+}
+
 // CalculateNetInflationRate calculates the effective growth rate (minting - burning)
 func (k Keeper) CalculateNetInflationRate(ctx context.Context) math.LegacyDec {
 	params := k.GetParams(ctx)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
@@ -26,6 +27,9 @@ import (
 // - REDIRECT-004: Execution is atomic (all or nothing)
 // - REDIRECT-005: No impact on validator revenue
 // - REDIRECT-006: No double taxation (operates post-collection only)
+// - REDIRECT-007: Optional per-execution cap (fraction of supply); the excess
+//   carries over, so a long gap between executions is paid out gradually
+// - REDIRECT-008: Targets with a nonzero ratio must have an address before the redirect is enabled
 
 const (
 	// MaxRedirectRatio is the protocol-enforced maximum redirect ratio (10%)
@@ -48,6 +52,7 @@ type RedirectResult struct {
 	TotalInflows    math.Int
 	RedirectAmount  math.Int
 	RetainedAmount  math.Int
+	CarriedOver     math.Int // Inflows left pending because of the per-execution cap
 	Allocations     []RedirectAllocation
 	ExecutedAtBlock int64
 }
@@ -85,6 +90,27 @@ func (k Keeper) ProcessTreasuryRedirect(ctx context.Context) (*RedirectResult, e
 		return nil, nil
 	}
 
+	return k.executeTreasuryRedirect(ctx, params)
+}
+
+// ExecuteTreasuryRedirectNow runs the treasury redirect immediately, without
+// waiting for the execution interval. The protocol cap, the per-execution cap
+// and the target checks apply as usual, and the next interval counts from
+// this execution. Returns a nil result when there is nothing to redirect.
+func (k Keeper) ExecuteTreasuryRedirectNow(ctx context.Context) (*RedirectResult, error) {
+	params := k.GetParams(ctx)
+	if !params.TreasuryRedirectEnabled {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, "treasury redirect is disabled")
+	}
+	return k.executeTreasuryRedirect(ctx, params)
+}
+
+// executeTreasuryRedirect redirects the inflows accumulated since the last
+// execution to the redirect targets
+func (k Keeper) executeTreasuryRedirect(ctx context.Context, params types.TokenomicsParams) (*RedirectResult, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+
 	// Get accumulated inflows since last redirect
 	accumulatedInflows := k.GetAccumulatedRedirectInflows(ctx)
 	if accumulatedInflows.IsZero() {
@@ -105,12 +131,23 @@ func (k Keeper) ProcessTreasuryRedirect(ctx context.Context) (*RedirectResult, e
 
 	// Calculate redirect amount (max 10% of inflows)
 	redirectAmount := redirectRatio.MulInt(accumulatedInflows).TruncateInt()
-	retainedAmount := accumulatedInflows.Sub(redirectAmount)
+
+	// REDIRECT-007: Cap the execution; the inflows behind the excess stay
+	// pending and are redirected at the following intervals
+	consumedInflows := accumulatedInflows
+	if maxAmount, capped := maxRedirectPerExecution(params); capped && redirectAmount.GT(maxAmount) {
+		consumedInflows = math.LegacyNewDecFromInt(maxAmount).Quo(redirectRatio).TruncateInt()
+		redirectAmount = maxAmount
+	}
+	retainedAmount := consumedInflows.Sub(redirectAmount)
+	carriedOver := accumulatedInflows.Sub(consumedInflows)
 
 	if redirectAmount.IsZero() {
 		// Nothing to redirect
 		k.SetLastRedirectHeight(ctx, currentHeight)
-		k.ResetAccumulatedRedirectInflows(ctx)
+		if err := k.SetAccumulatedRedirectInflows(ctx, carriedOver); err != nil {
+			return nil, err
+		}
 		return nil, nil
 	}
 
@@ -140,20 +177,19 @@ func (k Keeper) ProcessTreasuryRedirect(ctx context.Context) (*RedirectResult, e
 	// Get treasury address (source of funds)
 	treasuryAddr := k.GetTreasuryAddress(ctx)
 
+	// Last target gets the rounding dust
+	ratios := make([]math.LegacyDec, len(targets))
+	for i, target := range targets {
+		ratios[i] = target.Ratio
+	}
+	amounts := distributeWithDust(redirectAmount, ratios)
+
 	// REDIRECT-004: Execute allocations atomically
 	allocations := make([]RedirectAllocation, 0, len(targets))
 	totalAllocated := math.ZeroInt()
 
 	for i, target := range targets {
-		// Calculate allocation for this target
-		var allocationAmount math.Int
-		if i == len(targets)-1 {
-			// Last target gets remainder to avoid dust
-			allocationAmount = redirectAmount.Sub(totalAllocated)
-		} else {
-			allocationAmount = target.Ratio.MulInt(redirectAmount).TruncateInt()
-		}
-
+		allocationAmount := amounts[i]
 		if allocationAmount.IsZero() {
 			continue
 		}
@@ -207,7 +243,9 @@ func (k Keeper) ProcessTreasuryRedirect(ctx context.Context) (*RedirectResult, e
 
 	// Update state
 	k.SetLastRedirectHeight(ctx, currentHeight)
-	k.ResetAccumulatedRedirectInflows(ctx)
+	if err := k.SetAccumulatedRedirectInflows(ctx, carriedOver); err != nil {
+		return nil, err
+	}
 	k.IncrementTotalRedirected(ctx, totalAllocated)
 
 	// Emit main redirect event
@@ -219,6 +257,7 @@ func (k Keeper) ProcessTreasuryRedirect(ctx context.Context) (*RedirectResult, e
 			sdk.NewAttribute(types.AttributeKeyRetainedAmount, retainedAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyRedirectRatio, redirectRatio.String()),
 			sdk.NewAttribute(types.AttributeKeyRedirectBlockHeight, fmt.Sprintf("%d", currentHeight)),
+			sdk.NewAttribute(types.AttributeKeyCarriedOverInflows, carriedOver.String()),
 		),
 	)
 
@@ -228,17 +267,31 @@ func (k Keeper) ProcessTreasuryRedirect(ctx context.Context) (*RedirectResult, e
 		"retained", retainedAmount.String(),
 		"redirect_ratio", redirectRatio.String(),
 		"allocations_count", len(allocations),
+		"carried_over", carriedOver.String(),
 		"block_height", currentHeight)
 
 	return &RedirectResult{
 		TotalInflows:    accumulatedInflows,
 		RedirectAmount:  totalAllocated,
 		RetainedAmount:  retainedAmount,
+		CarriedOver:     carriedOver,
 		Allocations:     allocations,
 		ExecutedAtBlock: currentHeight,
 	}, nil
 }
 
+// maxRedirectPerExecution returns the most a single redirect execution may
+// transfer. capped is false when no cap is configured.
+func maxRedirectPerExecution(params types.TokenomicsParams) (maxAmount math.Int, capped bool) {
+	if params.MaxRedirectPerExecution.IsNil() || !params.MaxRedirectPerExecution.IsPositive() {
+		return math.Int{}, false
+	}
+	if params.CurrentTotalSupply.IsNil() {
+		return math.ZeroInt(), true
+	}
+	return params.MaxRedirectPerExecution.MulInt(params.CurrentTotalSupply).TruncateInt(), true
+}
+
 // GetRedirectTargets returns the configured redirect target addresses and ratios
 func (k Keeper) GetRedirectTargets(ctx context.Context, params types.TokenomicsParams) ([]RedirectTarget, error) {
 	targets := make([]RedirectTarget, 0, 4)
@@ -250,7 +303,7 @@ func (k Keeper) GetRedirectTargets(ctx context.Context, params types.TokenomicsP
 			return nil, fmt.Errorf("ecosystem grants address not configured")
 		}
 		targets = append(targets, RedirectTarget{
-			Name:    "ecosystem_grants",
+			Name:    types.RedirectTargetEcosystemGrants,
 			Address: addr,
 			Ratio:   params.RedirectToEcosystemGrants,
 		})
@@ -263,7 +316,7 @@ func (k Keeper) GetRedirectTargets(ctx context.Context, params types.TokenomicsP
 			return nil, fmt.Errorf("buy and burn address not configured")
 		}
 		targets = append(targets, RedirectTarget{
-			Name:    "buy_and_burn",
+			Name:    types.RedirectTargetBuyAndBurn,
 			Address: addr,
 			Ratio:   params.RedirectToBuyAndBurn,
 		})
@@ -276,7 +329,7 @@ func (k Keeper) GetRedirectTargets(ctx context.Context, params types.TokenomicsP
 			return nil, fmt.Errorf("insurance fund address not configured")
 		}
 		targets = append(targets, RedirectTarget{
-			Name:    "insurance_fund",
+			Name:    types.RedirectTargetInsuranceFund,
 			Address: addr,
 			Ratio:   params.RedirectToInsuranceFund,
 		})
@@ -289,7 +342,7 @@ func (k Keeper) GetRedirectTargets(ctx context.Context, params types.TokenomicsP
 			return nil, fmt.Errorf("research fund address not configured")
 		}
 		targets = append(targets, RedirectTarget{
-			Name:    "research_fund",
+			Name:    types.RedirectTargetResearchFund,
 			Address: addr,
 			Ratio:   params.RedirectToResearchFund,
 		})
@@ -298,6 +351,28 @@ func (k Keeper) GetRedirectTargets(ctx context.Context, params types.TokenomicsP
 	return targets, nil
 }
 
+// redirectTargetsChanged reports whether next enables the treasury redirect or
+// changes its target ratios while enabled. SetParams only checks the target
+// addresses then, so the per-block param writes of a chain already running
+// with a misconfigured redirect keep working.
+func redirectTargetsChanged(prev, next types.TokenomicsParams) bool {
+	if !next.TreasuryRedirectEnabled {
+		return false
+	}
+	return !prev.TreasuryRedirectEnabled ||
+		!prev.RedirectToEcosystemGrants.Equal(next.RedirectToEcosystemGrants) ||
+		!prev.RedirectToBuyAndBurn.Equal(next.RedirectToBuyAndBurn) ||
+		!prev.RedirectToInsuranceFund.Equal(next.RedirectToInsuranceFund) ||
+		!prev.RedirectToResearchFund.Equal(next.RedirectToResearchFund)
+}
+
+// GetTreasuryRedirectStatus returns the redirect configuration, target
+// addresses and cumulative counters in one view
+func (k Keeper) GetTreasuryRedirectStatus(ctx context.Context) types.TreasuryRedirectStatus {
+	cfg := k.GetFullConfig(ctx)
+	return types.NewTreasuryRedirectStatus(cfg.Params, cfg.RedirectTargets, cfg.RedirectState)
+}
+
 // ============================================================================
 // STATE ACCESSORS
 // ============================================================================
@@ -327,6 +402,17 @@ func (k Keeper) SetAccumulatedRedirectInflows(ctx context.Context, amount math.I
 	return store.Set(types.KeyAccumulatedRedirectInflows, bz)
 }
 
+// recordTreasuryInflow tracks a deposit of new funds into the treasury: fee
+// splits and burn redirects. REDIRECT-002: only these inflows feed the
+// redirect, and only while it is enabled, so switching the redirect on never
+// reaches back to funds the treasury received before.
+func (k Keeper) recordTreasuryInflow(ctx context.Context, amount math.Int, source string) {
+	k.IncrementTreasuryInflows(ctx, amount, source)
+	if k.GetParams(ctx).TreasuryRedirectEnabled {
+		k.IncrementAccumulatedRedirectInflows(ctx, amount)
+	}
+}
+
 // IncrementAccumulatedRedirectInflows adds to accumulated inflows
 func (k Keeper) IncrementAccumulatedRedirectInflows(ctx context.Context, amount math.Int) {
 	current := k.GetAccumulatedRedirectInflows(ctx)
@@ -339,7 +425,10 @@ func (k Keeper) ResetAccumulatedRedirectInflows(ctx context.Context) {
 	_ = k.SetAccumulatedRedirectInflows(ctx, math.ZeroInt())
 }
 
-// GetLastRedirectHeight returns the block height of last redirect execution
+// GetLastRedirectHeight returns the block height of last redirect execution.
+// The height is stored as 8 big-endian bytes, the same layout the earlier
+// hand-rolled encoder wrote, so values stored before the switch to
+// sdk.BigEndianToUint64 read back unchanged.
 func (k Keeper) GetLastRedirectHeight(ctx context.Context) int64 {
 	store := k.storeService.OpenKVStore(ctx)
 	bz, err := store.Get(types.KeyLastRedirectHeight)
@@ -348,27 +437,17 @@ func (k Keeper) GetLastRedirectHeight(ctx context.Context) int64 {
 	}
 
 	if len(bz) != 8 {
+		k.Logger(ctx).Error("invalid last redirect height encoding, treating as unset", "len", len(bz))
 		return 0
 	}
 
-	height := int64(bz[0])<<56 | int64(bz[1])<<48 | int64(bz[2])<<40 | int64(bz[3])<<32 |
-		int64(bz[4])<<24 | int64(bz[5])<<16 | int64(bz[6])<<8 | int64(bz[7])
-	return height
+	return int64(sdk.BigEndianToUint64(bz))
 }
 
 // SetLastRedirectHeight sets the block height of last redirect execution
 func (k Keeper) SetLastRedirectHeight(ctx context.Context, height int64) {
 	store := k.storeService.OpenKVStore(ctx)
-	bz := make([]byte, 8)
-	bz[0] = byte(height >> 56)
-	bz[1] = byte(height >> 48)
-	bz[2] = byte(height >> 40)
-	bz[3] = byte(height >> 32)
-	bz[4] = byte(height >> 24)
-	bz[5] = byte(height >> 16)
-	bz[6] = byte(height >> 8)
-	bz[7] = byte(height)
-	_ = store.Set(types.KeyLastRedirectHeight, bz)
+	_ = store.Set(types.KeyLastRedirectHeight, sdk.Uint64ToBigEndian(uint64(height)))
 }
 
 // GetTotalRedirected returns the cumulative amount redirected
@@ -0,0 +1,439 @@
+package keeper_test
+
+import (
+	stdmath "math"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/keeper"
+	"pos/x/tokenomics/types"
+)
+
+// setupTreasuryRedirect configures a funded treasury, four vesting redirect
+// targets and a 10% redirect every 100 blocks split evenly between them
+func setupTreasuryRedirect(t *testing.T) (*TestSuiteWrapper, []sdk.AccAddress) {
+	t.Helper()
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+
+	treasury := sdk.AccAddress("treasury____________")
+	require.NoError(t, f.Keeper.SetTreasuryAddress(ctx, treasury))
+	f.BankKeeper.balances[treasury.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 10_000_000_000_000))
+
+	targets := []sdk.AccAddress{
+		sdk.AccAddress("ecosystem_grants____"),
+		sdk.AccAddress("buy_and_burn________"),
+		sdk.AccAddress("insurance_fund______"),
+		sdk.AccAddress("research_fund_______"),
+	}
+	require.NoError(t, f.Keeper.SetEcosystemGrantsAddress(ctx, targets[0]))
+	require.NoError(t, f.Keeper.SetBuyAndBurnAddress(ctx, targets[1]))
+	require.NoError(t, f.Keeper.SetInsuranceFundAddress(ctx, targets[2]))
+	require.NoError(t, f.Keeper.SetResearchFundAddress(ctx, targets[3]))
+	vestingEnd := ctx.BlockTime().Add(365 * 24 * time.Hour).Unix()
+	for _, addr := range targets {
+		acc, err := vestingtypes.NewDelayedVestingAccount(
+			authtypes.NewBaseAccountWithAddress(addr),
+			sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 1)),
+			vestingEnd,
+		)
+		require.NoError(t, err)
+		f.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	quarter := math.LegacyNewDecWithPrec(25, 2)
+	params := f.Keeper.GetParams(ctx)
+	params.TreasuryRedirectEnabled = true
+	params.TreasuryRedirectRatio = math.LegacyNewDecWithPrec(10, 2)
+	params.RedirectToEcosystemGrants = quarter
+	params.RedirectToBuyAndBurn = quarter
+	params.RedirectToInsuranceFund = quarter
+	params.RedirectToResearchFund = quarter
+	params.RedirectExecutionInterval = 100
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+
+	return f, targets
+}
+
+func TestTreasuryRedirectStatus_CumulativeTotals(t *testing.T) {
+	f, targets := setupTreasuryRedirect(t)
+	ctx := f.Ctx
+	quarter := math.LegacyNewDecWithPrec(25, 2)
+
+	// Nothing has run yet
+	status := f.Keeper.GetTreasuryRedirectStatus(ctx)
+	require.True(t, status.Enabled)
+	require.Equal(t, "0.100000000000000000", status.Ratio.String())
+	require.Equal(t, uint64(100), status.ExecutionInterval)
+	require.True(t, status.TotalRedirected.IsZero())
+	require.Zero(t, status.LastRedirectHeight)
+	require.Len(t, status.Targets, 4)
+	for i, name := range []string{
+		types.RedirectTargetEcosystemGrants,
+		types.RedirectTargetBuyAndBurn,
+		types.RedirectTargetInsuranceFund,
+		types.RedirectTargetResearchFund,
+	} {
+		require.Equal(t, name, status.Targets[i].Name)
+		require.Equal(t, targets[i].String(), status.Targets[i].Address)
+		require.True(t, quarter.Equal(status.Targets[i].Ratio))
+	}
+
+	// First execution: 10% of 1,000,000 inflows
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, math.NewInt(1_000_000))
+	require.Equal(t, int64(1_000_000), f.Keeper.GetTreasuryRedirectStatus(ctx).AccumulatedInflows.Int64())
+
+	res, err := f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(100))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, int64(100_000), res.RedirectAmount.Int64())
+
+	// Second execution: 10% of 500,000 inflows, one interval later
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, math.NewInt(500_000))
+	res, err = f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(200))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, int64(50_000), res.RedirectAmount.Int64())
+
+	qres, err := keeper.NewQueryServerImpl(f.Keeper).TreasuryRedirect(ctx, &types.QueryTreasuryRedirectRequest{})
+	require.NoError(t, err)
+	status = qres.Redirect
+	require.Equal(t, int64(150_000), status.TotalRedirected.Int64())
+	require.True(t, status.AccumulatedInflows.IsZero())
+	require.Equal(t, int64(200), status.LastRedirectHeight)
+	for _, addr := range targets {
+		require.Equal(t, int64(37_500), f.BankKeeper.balances[addr.String()].AmountOf(types.BondDenom).Int64())
+	}
+}
+
+func TestTreasuryRedirectStatus_UnsetTargets(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	status := f.Keeper.GetTreasuryRedirectStatus(f.Ctx)
+	require.False(t, status.Enabled)
+	require.Len(t, status.Targets, 4)
+	for _, target := range status.Targets {
+		require.Empty(t, target.Address)
+	}
+	require.True(t, status.AccumulatedInflows.IsZero())
+	require.True(t, status.TotalRedirected.IsZero())
+}
+
+func TestLastRedirectHeight_RoundTrip(t *testing.T) {
+	f := SetupTestSuite(t)
+
+	require.Zero(t, f.Keeper.GetLastRedirectHeight(f.Ctx), "unset height reads as zero")
+
+	for _, height := range []int64{0, 1, 1_000_000, stdmath.MaxInt64 - 1, stdmath.MaxInt64} {
+		f.Keeper.SetLastRedirectHeight(f.Ctx, height)
+		require.Equal(t, height, f.Keeper.GetLastRedirectHeight(f.Ctx))
+	}
+}
+
+func TestLastRedirectHeight_ReadsLegacyEncoding(t *testing.T) {
+	f := SetupTestSuite(t)
+	store := f.Ctx.KVStore(f.StoreKey)
+
+	// Bytes as written by the previous hand-rolled encoder
+	legacyEncode := func(height int64) []byte {
+		bz := make([]byte, 8)
+		for i := 0; i < 8; i++ {
+			bz[i] = byte(height >> (56 - 8*i))
+		}
+		return bz
+	}
+
+	for _, height := range []int64{0, 123_456, stdmath.MaxInt64 - 1} {
+		store.Set(types.KeyLastRedirectHeight, legacyEncode(height))
+		require.Equal(t, height, f.Keeper.GetLastRedirectHeight(f.Ctx))
+
+		// Re-writing through the keeper keeps the same bytes
+		f.Keeper.SetLastRedirectHeight(f.Ctx, height)
+		require.Equal(t, legacyEncode(height), store.Get(types.KeyLastRedirectHeight))
+	}
+
+	// A corrupt value is treated as unset rather than misread
+	store.Set(types.KeyLastRedirectHeight, []byte{0x01, 0x02})
+	require.Zero(t, f.Keeper.GetLastRedirectHeight(f.Ctx))
+}
+
+// redirectCapAmount sets the per-execution cap to 0.01% of supply and returns it in tokens
+func redirectCapAmount(t *testing.T, f *TestSuiteWrapper) math.Int {
+	t.Helper()
+	params := f.Keeper.GetParams(f.Ctx)
+	params.MaxRedirectPerExecution = math.LegacyNewDecWithPrec(1, 4)
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+	return params.MaxRedirectPerExecution.MulInt(params.CurrentTotalSupply).TruncateInt()
+}
+
+func TestTreasuryRedirect_HeightJumpWithoutCap(t *testing.T) {
+	f, _ := setupTreasuryRedirect(t)
+	ctx := f.Ctx
+
+	// A long halt: a large backlog of inflows, first executed far past the interval
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, math.NewInt(937_500_000_000))
+	res, err := f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(1_000_000))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// Without a cap the whole backlog goes out in one execution
+	require.Equal(t, int64(93_750_000_000), res.RedirectAmount.Int64())
+	require.True(t, res.CarriedOver.IsZero())
+	require.True(t, f.Keeper.GetAccumulatedRedirectInflows(ctx).IsZero())
+}
+
+func TestTreasuryRedirect_HeightJumpWithCap(t *testing.T) {
+	f, _ := setupTreasuryRedirect(t)
+	ctx := f.Ctx
+	maxAmount := redirectCapAmount(t, f)
+	require.Equal(t, int64(37_500_000_000), maxAmount.Int64())
+
+	// The backlog is owed 2.5x the cap
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, maxAmount.MulRaw(25))
+	ctx = ctx.WithBlockHeight(1_000_000)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	res, err := f.Keeper.ProcessTreasuryRedirect(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, maxAmount.Equal(res.RedirectAmount))
+	require.True(t, maxAmount.MulRaw(15).Equal(res.CarriedOver))
+	require.True(t, maxAmount.MulRaw(15).Equal(f.Keeper.GetAccumulatedRedirectInflows(ctx)))
+	require.Equal(t, maxAmount.MulRaw(15).String(), eventAttribute(ctx, types.EventTypeTreasuryRedirect, types.AttributeKeyCarriedOverInflows))
+
+	// Not before the next interval
+	res, err = f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(1_000_050))
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	// The carried-over remainder drains over the following intervals
+	res, err = f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(1_000_100))
+	require.NoError(t, err)
+	require.True(t, maxAmount.Equal(res.RedirectAmount))
+	require.True(t, maxAmount.MulRaw(5).Equal(res.CarriedOver))
+
+	res, err = f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(1_000_200))
+	require.NoError(t, err)
+	require.True(t, maxAmount.QuoRaw(2).Equal(res.RedirectAmount))
+	require.True(t, res.CarriedOver.IsZero())
+
+	// Same total as the uncapped single execution
+	status := f.Keeper.GetTreasuryRedirectStatus(ctx)
+	require.Equal(t, int64(93_750_000_000), status.TotalRedirected.Int64())
+	require.True(t, status.AccumulatedInflows.IsZero())
+	require.Equal(t, int64(1_000_200), status.LastRedirectHeight)
+	require.Equal(t, "0.000100000000000000", status.MaxPerExecution.String())
+}
+
+func TestExecuteRedirectNow_BeforeInterval(t *testing.T) {
+	f, targets := setupTreasuryRedirect(t)
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	ctx := f.Ctx.WithBlockHeight(50)
+
+	// The interval gate holds the regular execution back
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, math.NewInt(1_000_000))
+	res, err := f.Keeper.ProcessTreasuryRedirect(ctx)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = ms.ExecuteRedirectNow(ctx, &types.MsgExecuteRedirectNow{Authority: sdk.AccAddress("someone_else________").String()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	forced, err := ms.ExecuteRedirectNow(ctx, &types.MsgExecuteRedirectNow{Authority: f.Keeper.GetAuthority()})
+	require.NoError(t, err)
+	require.Equal(t, int64(100_000), forced.RedirectedAmount.Int64())
+	require.True(t, forced.CarriedOver.IsZero())
+	for _, addr := range targets {
+		require.Equal(t, int64(25_000), f.BankKeeper.balances[addr.String()].AmountOf(types.BondDenom).Int64())
+	}
+	require.Equal(t, int64(50), f.Keeper.GetLastRedirectHeight(ctx))
+	require.True(t, f.Keeper.GetAccumulatedRedirectInflows(ctx).IsZero())
+
+	// The next regular execution counts its interval from the forced one
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, math.NewInt(1_000_000))
+	res, err = f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(149))
+	require.NoError(t, err)
+	require.Nil(t, res)
+	res, err = f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(150))
+	require.NoError(t, err)
+	require.Equal(t, int64(100_000), res.RedirectAmount.Int64())
+
+	// With nothing accumulated the forced run moves nothing
+	forced, err = ms.ExecuteRedirectNow(ctx.WithBlockHeight(151), &types.MsgExecuteRedirectNow{Authority: f.Keeper.GetAuthority()})
+	require.NoError(t, err)
+	require.True(t, forced.RedirectedAmount.IsZero())
+
+	// A disabled redirect cannot be forced
+	params := f.Keeper.GetParams(ctx)
+	params.TreasuryRedirectEnabled = false
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+	_, err = ms.ExecuteRedirectNow(ctx, &types.MsgExecuteRedirectNow{Authority: f.Keeper.GetAuthority()})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+}
+
+func TestExecuteRedirectNow_RespectsCaps(t *testing.T) {
+	f, _ := setupTreasuryRedirect(t)
+	ms := keeper.NewMsgServerImpl(f.Keeper)
+	ctx := f.Ctx.WithBlockHeight(10)
+
+	// A ratio above the protocol cap is clamped to 10%
+	params := f.Keeper.GetParams(ctx)
+	params.TreasuryRedirectRatio = math.LegacyNewDecWithPrec(20, 2)
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, math.NewInt(1_000_000))
+
+	res, err := ms.ExecuteRedirectNow(ctx, &types.MsgExecuteRedirectNow{Authority: f.Keeper.GetAuthority()})
+	require.NoError(t, err)
+	require.Equal(t, int64(100_000), res.RedirectedAmount.Int64())
+
+	// The per-execution cap still applies and the excess stays pending
+	params.TreasuryRedirectRatio = math.LegacyNewDecWithPrec(10, 2)
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+	maxAmount := redirectCapAmount(t, f)
+	f.Keeper.IncrementAccumulatedRedirectInflows(ctx, maxAmount.MulRaw(25))
+
+	res, err = ms.ExecuteRedirectNow(ctx.WithBlockHeight(11), &types.MsgExecuteRedirectNow{Authority: f.Keeper.GetAuthority()})
+	require.NoError(t, err)
+	require.True(t, maxAmount.Equal(res.RedirectedAmount))
+	require.True(t, maxAmount.MulRaw(15).Equal(res.CarriedOver))
+}
+
+// collectFees puts amount into the fee collector as if paid by transactions
+func collectFees(t *testing.T, f *TestSuiteWrapper, ctx sdk.Context, amount math.Int) {
+	t.Helper()
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, amount))
+	require.NoError(t, f.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, f.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+	require.NoError(t, f.Keeper.SetCurrentSupply(ctx, f.Keeper.GetCurrentSupply(ctx).Add(amount)))
+}
+
+func TestTreasuryRedirect_AccumulatesFeeAndBurnInflows(t *testing.T) {
+	f, _ := setupTreasuryRedirect(t)
+	ctx := f.Ctx
+	treasury := f.Keeper.GetTreasuryAddress(ctx)
+	treasuryBalance := func() math.Int {
+		return f.BankKeeper.GetBalance(ctx, treasury, types.BondDenom).Amount
+	}
+	require.True(t, f.Keeper.GetAccumulatedRedirectInflows(ctx).IsZero())
+
+	// Fee split: the counter grows by exactly the treasury portion
+	before := treasuryBalance()
+	collectFees(t, f, ctx, math.NewInt(1_000_000))
+	require.NoError(t, f.Keeper.ProcessBlockFees(ctx))
+	feeInflow := treasuryBalance().Sub(before)
+	require.Equal(t, int64(100_000), feeInflow.Int64())
+	require.True(t, feeInflow.Equal(f.Keeper.GetAccumulatedRedirectInflows(ctx)))
+
+	// Burn redirect: so does the portion of a burn sent to the treasury
+	user := sdk.AccAddress("user________________")
+	require.NoError(t, f.Keeper.MintTokens(ctx, math.NewInt(50_000), user, "test mint"))
+	_, toTreasury, err := f.Keeper.BurnTokens(ctx, user, math.NewInt(20_000), types.BurnSource_BURN_SOURCE_POS_GAS, "omniphi-core-1")
+	require.NoError(t, err)
+	require.True(t, toTreasury.IsPositive())
+	require.True(t, feeInflow.Add(toTreasury).Equal(f.Keeper.GetAccumulatedRedirectInflows(ctx)))
+
+	// Executing the redirect consumes the counter; its own transfers are not inflows
+	res, err := f.Keeper.ProcessTreasuryRedirect(ctx.WithBlockHeight(100))
+	require.NoError(t, err)
+	require.True(t, feeInflow.Add(toTreasury).Equal(res.TotalInflows))
+	require.True(t, f.Keeper.GetAccumulatedRedirectInflows(ctx).IsZero())
+
+	// While the redirect is off, inflows are not banked for a later switch-on
+	params := f.Keeper.GetParams(ctx)
+	params.TreasuryRedirectEnabled = false
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+	before = treasuryBalance()
+	collectFees(t, f, ctx, math.NewInt(1_000_000))
+	require.NoError(t, f.Keeper.ProcessBlockFees(ctx))
+	require.True(t, treasuryBalance().GT(before))
+	require.True(t, f.Keeper.GetAccumulatedRedirectInflows(ctx).IsZero())
+}
+
+func TestParams_MaxRedirectPerExecutionBounds(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.MaxRedirectPerExecution.IsZero())
+	require.NoError(t, params.Validate())
+
+	params.MaxRedirectPerExecution = math.LegacyOneDec()
+	require.NoError(t, params.Validate())
+
+	params.MaxRedirectPerExecution = math.LegacyNewDecWithPrec(-1, 2)
+	require.Error(t, params.Validate())
+
+	params.MaxRedirectPerExecution = math.LegacyNewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+
+	// Params stored before the field existed decode with it unset
+	params.MaxRedirectPerExecution = math.LegacyDec{}
+	require.NoError(t, params.Validate())
+}
+
+func TestSetParams_RejectsRedirectTargetWithoutAddress(t *testing.T) {
+	f := SetupTestSuite(t)
+	ctx := f.Ctx
+
+	half := math.LegacyNewDecWithPrec(50, 2)
+	params := f.Keeper.GetParams(ctx)
+	params.TreasuryRedirectEnabled = true
+	params.TreasuryRedirectRatio = math.LegacyNewDecWithPrec(10, 2)
+	params.RedirectToEcosystemGrants = half
+	params.RedirectToBuyAndBurn = half
+	params.RedirectToInsuranceFund = math.LegacyZeroDec()
+	params.RedirectToResearchFund = math.LegacyZeroDec()
+
+	// Neither target with a nonzero ratio has an address yet
+	err := f.Keeper.SetParams(ctx, params)
+	require.ErrorContains(t, err, "ecosystem grants address not configured")
+	require.False(t, f.Keeper.GetParams(ctx).TreasuryRedirectEnabled)
+
+	require.NoError(t, f.Keeper.SetEcosystemGrantsAddress(ctx, sdk.AccAddress("ecosystem_grants____")))
+	err = f.Keeper.SetParams(ctx, params)
+	require.ErrorContains(t, err, "buy and burn address not configured")
+
+	// Targets with a zero ratio need no address
+	require.NoError(t, f.Keeper.SetBuyAndBurnAddress(ctx, sdk.AccAddress("buy_and_burn________")))
+	require.NoError(t, f.Keeper.SetParams(ctx, params))
+	require.True(t, f.Keeper.GetParams(ctx).TreasuryRedirectEnabled)
+
+	// Moving weight to an unconfigured target while enabled is rejected too
+	params.RedirectToBuyAndBurn = math.LegacyNewDecWithPrec(25, 2)
+	params.RedirectToResearchFund = math.LegacyNewDecWithPrec(25, 2)
+	err = f.Keeper.SetParams(ctx, params)
+	require.ErrorContains(t, err, "research fund address not configured")
+}
+
+func TestGenesisValidate_RejectsEnabledRedirect(t *testing.T) {
+	gs := keeper.DefaultGenesisState()
+	require.NoError(t, gs.Validate())
+
+	gs.Params.TreasuryRedirectEnabled = true
+	gs.Params.TreasuryRedirectRatio = math.LegacyNewDecWithPrec(10, 2)
+	gs.Params.RedirectToEcosystemGrants = math.LegacyOneDec()
+	require.ErrorContains(t, gs.Validate(), "treasury redirect cannot be enabled at genesis")
+
+	// Enabled with no weighted target has nothing to send anywhere
+	gs.Params.RedirectToEcosystemGrants = math.LegacyZeroDec()
+	require.NoError(t, gs.Validate())
+}
+
+// eventAttribute returns the value of key on the last event of eventType
+func eventAttribute(ctx sdk.Context, eventType, key string) string {
+	var value string
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != eventType {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if attr.Key == key {
+				value = attr.Value
+			}
+		}
+	}
+	return value
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"pos/x/tokenomics/types"
+)
+
+// ============================================================================
+// TREASURY-PCT SMOOTHING
+// ============================================================================
+// The adaptive burn controller's treasury floor check can read either the
+// instantaneous treasury pct or a moving average over the last WindowBlocks
+// blocks. In moving-average mode UpdateBurnRatio records one sample per block
+// into a ring buffer, so a single large inflow or spend only moves the input
+// by 1/WindowBlocks of its size.
+
+// GetTreasuryPctSmoothing returns the smoothing configuration, or the default
+// if governance has not set one
+func (k Keeper) GetTreasuryPctSmoothing(ctx context.Context) types.TreasuryPctSmoothing {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyTreasuryPctSmoothing)
+	if err != nil || bz == nil {
+		return types.DefaultTreasuryPctSmoothing()
+	}
+
+	var s types.TreasuryPctSmoothing
+	if err := json.Unmarshal(bz, &s); err != nil {
+		k.Logger(ctx).Error("failed to decode treasury pct smoothing, using default", "error", err)
+		return types.DefaultTreasuryPctSmoothing()
+	}
+	return s
+}
+
+// SetTreasuryPctSmoothing validates and stores the smoothing configuration.
+// Changing the mode or window discards the collected samples, since they were
+// gathered for a different window.
+func (k Keeper) SetTreasuryPctSmoothing(ctx context.Context, s types.TreasuryPctSmoothing) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	if current := k.GetTreasuryPctSmoothing(ctx); current != s {
+		if err := k.resetTreasuryPctWindow(ctx); err != nil {
+			return err
+		}
+	}
+
+	bz, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyTreasuryPctSmoothing, bz)
+}
+
+// GetSmoothedTreasuryPct returns the treasury pct input for the adaptive burn
+// controller. Falls back to the instantaneous value in instantaneous mode and
+// before the first sample has been recorded.
+func (k Keeper) GetSmoothedTreasuryPct(ctx context.Context) math.LegacyDec {
+	if k.GetTreasuryPctSmoothing(ctx).Mode != types.TreasuryPctModeMovingAverage {
+		return k.GetTreasuryPct(ctx)
+	}
+
+	window := k.getTreasuryPctWindow(ctx)
+	if window.Count == 0 {
+		return k.GetTreasuryPct(ctx)
+	}
+	return window.Average()
+}
+
+// RecordTreasuryPctSample adds the current treasury pct to the moving average,
+// replacing the oldest sample once the window is full. No-op in instantaneous mode.
+func (k Keeper) RecordTreasuryPctSample(ctx context.Context) error {
+	smoothing := k.GetTreasuryPctSmoothing(ctx)
+	if smoothing.Mode != types.TreasuryPctModeMovingAverage {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	window := k.getTreasuryPctWindow(ctx)
+	sample := k.GetTreasuryPct(ctx)
+	slot := window.Next % smoothing.WindowBlocks
+	key := types.GetTreasuryPctSampleKey(slot)
+
+	if window.Count >= smoothing.WindowBlocks {
+		bz, err := store.Get(key)
+		if err != nil {
+			return err
+		}
+		oldest := math.LegacyZeroDec()
+		if bz != nil {
+			if err := oldest.Unmarshal(bz); err != nil {
+				return fmt.Errorf("failed to decode treasury pct sample %d: %w", slot, err)
+			}
+		}
+		window.Sum = window.Sum.Sub(oldest)
+	} else {
+		window.Count++
+	}
+
+	bz, err := sample.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+
+	window.Sum = window.Sum.Add(sample)
+	window.Next = (slot + 1) % smoothing.WindowBlocks
+	return k.setTreasuryPctWindow(ctx, window)
+}
+
+func (k Keeper) getTreasuryPctWindow(ctx context.Context) types.TreasuryPctWindow {
+	empty := types.TreasuryPctWindow{Sum: math.LegacyZeroDec()}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.KeyTreasuryPctWindow)
+	if err != nil || bz == nil {
+		return empty
+	}
+
+	var window types.TreasuryPctWindow
+	if err := json.Unmarshal(bz, &window); err != nil {
+		k.Logger(ctx).Error("failed to decode treasury pct window, restarting average", "error", err)
+		return empty
+	}
+	return window
+}
+
+func (k Keeper) setTreasuryPctWindow(ctx context.Context, window types.TreasuryPctWindow) error {
+	bz, err := json.Marshal(window)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.KeyTreasuryPctWindow, bz)
+}
+
+// resetTreasuryPctWindow deletes all samples and the running state
+func (k Keeper) resetTreasuryPctWindow(ctx context.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.TreasuryPctSamplePrefix, storetypes.PrefixEndBytes(types.TreasuryPctSamplePrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	return store.Delete(types.KeyTreasuryPctWindow)
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"pos/x/tokenomics/types"
+)
+
+// setupTreasuryPctTest enables the adaptive burn controller with a 5% floor
+// and returns a function that sets the treasury balance in thousandths of the
+// current supply.
+func setupTreasuryPctTest(t *testing.T) (*TestSuiteWrapper, func(permille int64)) {
+	f := SetupTestSuite(t)
+
+	params := f.Keeper.GetParams(f.Ctx)
+	params.AdaptiveBurnEnabled = true
+	params.TreasuryFloorPct = math.LegacyNewDecWithPrec(5, 2)
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	treasury := sdk.AccAddress("treasury____________")
+	require.NoError(t, f.Keeper.SetTreasuryAddress(f.Ctx, treasury))
+
+	supply := params.CurrentTotalSupply
+	setTreasury := func(permille int64) {
+		amount := supply.MulRaw(permille).QuoRaw(1000)
+		f.BankKeeper.balances[treasury.String()] = sdk.NewCoins(sdk.NewCoin(types.BondDenom, amount))
+	}
+	return f, setTreasury
+}
+
+func TestTreasuryPctSmoothing_SpikeDoesNotFlipTrigger(t *testing.T) {
+	f, setTreasury := setupTreasuryPctTest(t)
+	require.NoError(t, f.Keeper.SetTreasuryPctSmoothing(f.Ctx, types.TreasuryPctSmoothing{
+		Mode:         types.TreasuryPctModeMovingAverage,
+		WindowBlocks: 10,
+	}))
+
+	// Fill the window at a steady 10%
+	setTreasury(100)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, f.Keeper.UpdateBurnRatio(f.Ctx))
+	}
+	require.True(t, math.LegacyNewDecWithPrec(10, 2).Equal(f.Keeper.GetSmoothedTreasuryPct(f.Ctx)))
+	_, baseline := f.Keeper.GetAdaptiveBurnRatio(f.Ctx)
+	require.NotEqual(t, "treasury_protection", baseline)
+
+	// A large spend drops the treasury to 1% for a single block, then an
+	// inflow restores it. The smoothed input stays well above the floor.
+	spiky := []int64{10, 100, 100, 100}
+	for _, permille := range spiky {
+		setTreasury(permille)
+		require.NoError(t, f.Keeper.UpdateBurnRatio(f.Ctx))
+
+		smoothed := f.Keeper.GetSmoothedTreasuryPct(f.Ctx)
+		require.True(t, smoothed.GTE(math.LegacyNewDecWithPrec(91, 3)), "smoothed pct %s", smoothed)
+		require.Equal(t, baseline, f.Keeper.GetParams(f.Ctx).LastBurnTrigger)
+	}
+
+	// The dip is still inside the window: (9*10% + 1%) / 10
+	require.True(t, math.LegacyNewDecWithPrec(91, 3).Equal(f.Keeper.GetSmoothedTreasuryPct(f.Ctx)))
+
+	// A sustained drop does reach the floor once it dominates the window
+	setTreasury(10)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, f.Keeper.UpdateBurnRatio(f.Ctx))
+	}
+	require.True(t, math.LegacyNewDecWithPrec(1, 2).Equal(f.Keeper.GetSmoothedTreasuryPct(f.Ctx)))
+	require.Equal(t, "treasury_protection", f.Keeper.GetParams(f.Ctx).LastBurnTrigger)
+}
+
+func TestTreasuryPctSmoothing_InstantaneousModeFollowsSpike(t *testing.T) {
+	f, setTreasury := setupTreasuryPctTest(t)
+	require.Equal(t, types.TreasuryPctModeInstantaneous, f.Keeper.GetTreasuryPctSmoothing(f.Ctx).Mode)
+
+	setTreasury(100)
+	require.NoError(t, f.Keeper.UpdateBurnRatio(f.Ctx))
+	require.NotEqual(t, "treasury_protection", f.Keeper.GetParams(f.Ctx).LastBurnTrigger)
+
+	setTreasury(10)
+	require.NoError(t, f.Keeper.UpdateBurnRatio(f.Ctx))
+	require.True(t, math.LegacyNewDecWithPrec(1, 2).Equal(f.Keeper.GetSmoothedTreasuryPct(f.Ctx)))
+	require.Equal(t, "treasury_protection", f.Keeper.GetParams(f.Ctx).LastBurnTrigger)
+}
+
+func TestTreasuryPctSmoothing_ChangingWindowResetsSamples(t *testing.T) {
+	f, setTreasury := setupTreasuryPctTest(t)
+	smoothing := types.TreasuryPctSmoothing{Mode: types.TreasuryPctModeMovingAverage, WindowBlocks: 5}
+	require.NoError(t, f.Keeper.SetTreasuryPctSmoothing(f.Ctx, smoothing))
+
+	setTreasury(10)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, f.Keeper.RecordTreasuryPctSample(f.Ctx))
+	}
+
+	// Re-setting the same config keeps the samples
+	require.NoError(t, f.Keeper.SetTreasuryPctSmoothing(f.Ctx, smoothing))
+	setTreasury(100)
+	require.True(t, math.LegacyNewDecWithPrec(1, 2).Equal(f.Keeper.GetSmoothedTreasuryPct(f.Ctx)))
+
+	// A new window starts from the current balance
+	smoothing.WindowBlocks = 20
+	require.NoError(t, f.Keeper.SetTreasuryPctSmoothing(f.Ctx, smoothing))
+	require.True(t, math.LegacyNewDecWithPrec(10, 2).Equal(f.Keeper.GetSmoothedTreasuryPct(f.Ctx)))
+
+	require.Error(t, f.Keeper.SetTreasuryPctSmoothing(f.Ctx, types.TreasuryPctSmoothing{
+		Mode:         types.TreasuryPctModeMovingAverage,
+		WindowBlocks: types.MaxTreasuryPctWindowBlocks + 1,
+	}))
+	require.Error(t, f.Keeper.SetTreasuryPctSmoothing(f.Ctx, types.TreasuryPctSmoothing{Mode: "median"}))
+}
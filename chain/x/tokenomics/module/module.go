@@ -98,6 +98,11 @@ func NewAppModule(
 	}
 }
 
+// RegisterInvariants registers the tokenomics module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
 // RegisterServices registers module services
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -129,6 +134,11 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// Apply param changes staged for this epoch before anything reads params
+	if err := am.keeper.ApplyStagedChanges(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("failed to apply staged param changes", "error", err)
+	}
+
 	// ADAPTIVE-BURN: Update burn ratio based on network conditions
 	// This runs every block to ensure responsive adjustments
 	if err := am.keeper.UpdateBurnRatio(ctx); err != nil {
@@ -136,58 +146,11 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 		// Don't halt chain - continue with existing ratio
 	}
 
-	// Check if it's time to distribute rewards
-	if am.keeper.ShouldDistributeRewards(ctx) {
-		// Calculate block provisions (tokens to mint this epoch)
-		blockProvisions := am.keeper.CalculateBlockProvisions(ctx)
-
-		// Calculate total rewards for this epoch
-		params := am.keeper.GetParams(ctx)
-		blocksPerEpoch := int64(params.RewardStreamInterval)
-		totalRewards := blockProvisions.MulInt64(blocksPerEpoch).TruncateInt()
-
-		// CRITICAL FIX: Skip minting if totalRewards is zero or negative
-		// This happens when current supply is zero (at genesis) or very low
-		if totalRewards.IsZero() || totalRewards.IsNegative() {
-			am.keeper.Logger(ctx).Debug("skipping reward distribution - zero or negative rewards calculated",
-				"total_rewards", totalRewards.String(),
-				"block_height", sdkCtx.BlockHeight(),
-			)
-			return nil
-		}
-
-		// Mint the rewards
-		// Get module address for minting (using treasury address method as template)
-		moduleAddr := am.keeper.GetTreasuryAddress(ctx)
-		if moduleAddr.Empty() {
-			// Fallback: use a placeholder - this should be set in genesis
-			am.keeper.Logger(ctx).Warn("treasury address not set, skipping reward distribution")
-			return nil
-		}
-
-		// Mint to module account for distribution
-		if err := am.keeper.MintTokens(ctx, totalRewards, moduleAddr, fmt.Sprintf("Epoch rewards at block %d", sdkCtx.BlockHeight())); err != nil {
-			am.keeper.Logger(ctx).Error("failed to mint epoch rewards", "error", err, "height", sdkCtx.BlockHeight())
-			return err
-		}
-
-		// Calculate reward splits
-		recipients := am.keeper.CalculateRewardSplits(ctx, totalRewards)
-
-		// Distribute rewards (local + IBC)
-		localDist, ibcDist, packetsSent, err := am.keeper.DistributeRewardsViaIBC(ctx, recipients)
-		if err != nil {
-			am.keeper.Logger(ctx).Error("failed to distribute rewards", "error", err)
-			return err
-		}
-
-		am.keeper.Logger(ctx).Info("epoch rewards distributed",
-			"total_rewards", totalRewards.String(),
-			"local_distributed", localDist.String(),
-			"ibc_distributed", ibcDist.String(),
-			"ibc_packets_sent", packetsSent,
-			"block_height", sdkCtx.BlockHeight(),
-		)
+	// Every RewardStreamInterval blocks, mint the epoch's provisions (never once
+	// the supply cap is reached) and split them across the emission recipients
+	if _, err := am.keeper.MintEpochProvisions(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("failed to mint epoch provisions", "error", err, "height", sdkCtx.BlockHeight())
+		return err
 	}
 
 	return nil
@@ -214,6 +177,21 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		// Don't halt chain - this is a metrics tracking feature
 	}
 
+	// Record block gas usage for the congestion average (used by adaptive burn)
+	if err := am.keeper.RecordBlockCongestion(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("failed to record block congestion", "error", err)
+		// Don't halt chain - this is a metrics tracking feature
+	}
+
+	// Burn the OMNI accumulated at the buy-and-burn target every BuyAndBurnInterval blocks
+	if _, err := am.keeper.ExecuteBuyAndBurn(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("failed to execute buy-and-burn", "error", err)
+		// Don't halt chain - the balance is retried next block
+	}
+
+	// Push the burns committed in this block to StreamBurnEvents subscribers
+	am.keeper.PublishBurnEvents(ctx)
+
 	// Process IBC packet acknowledgements
 	// This handles failed/timed-out packets and refunds
 	if err := am.keeper.ProcessIBCAcknowledgements(ctx); err != nil {
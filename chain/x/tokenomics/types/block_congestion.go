@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+)
+
+// CongestionWindowBlocks is the number of recent blocks averaged into the
+// congestion input of the adaptive burn controller (~1 minute at 6s blocks)
+const CongestionWindowBlocks uint64 = 10
+
+// CongestionWindow is the running state of the block congestion average.
+// Samples live in a ring of CongestionWindowBlocks slots under
+// CongestionSamplePrefix; Sum is the total of the Count samples in the ring.
+type CongestionWindow struct {
+	Sum   math.LegacyDec `json:"sum"`
+	Count uint64         `json:"count"`
+	Next  uint64         `json:"next"`
+}
+
+// Average returns the mean of the samples in the window, or zero when empty
+func (w CongestionWindow) Average() math.LegacyDec {
+	if w.Count == 0 {
+		return math.LegacyZeroDec()
+	}
+	return w.Sum.QuoInt64(int64(w.Count))
+}
+
+// GetCongestionSampleKey returns the store key for a block congestion sample slot
+func GetCongestionSampleKey(slot uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, slot)
+	return append(append([]byte{}, CongestionSamplePrefix...), b...)
+}
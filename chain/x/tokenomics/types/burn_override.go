@@ -0,0 +1,24 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// TriggerGuardianOverride is the burn trigger reported while the guardian's
+// emergency burn override is active
+const TriggerGuardianOverride = "guardian_override"
+
+// GuardianBurnOverride is an emergency burn override set by the burn override
+// guardian. It pins the fee burn ratio to Ratio until ExpiresAtHeight, when it
+// lapses without further action. Stored as JSON under KeyGuardianBurnOverride.
+type GuardianBurnOverride struct {
+	Guardian        string         `json:"guardian"`
+	Ratio           math.LegacyDec `json:"ratio"`
+	ActivatedHeight int64          `json:"activated_height"`
+	ExpiresAtHeight int64          `json:"expires_at_height"`
+}
+
+// IsActive reports whether the override still applies at height
+func (o GuardianBurnOverride) IsActive(height int64) bool {
+	return height < o.ExpiresAtHeight
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// NewQueryBurnRateBySourceResponse builds the attribution from per-source burn
+// totals, which include fee burns, and per-source fee burns. Sources missing
+// from either map are reported as zero. Fee burns processed before they were
+// tagged by source count as module burns of POS_GAS.
+func NewQueryBurnRateBySourceResponse(totals, feeBurns map[BurnSource]math.Int) QueryBurnRateBySourceResponse {
+	sources := BurnSources()
+	res := QueryBurnRateBySourceResponse{
+		Sources:           make([]SourceBurnAttribution, 0, len(sources)),
+		TotalFeeBurned:    math.ZeroInt(),
+		TotalModuleBurned: math.ZeroInt(),
+		TotalBurned:       math.ZeroInt(),
+	}
+	for _, source := range sources {
+		total, ok := totals[source]
+		if !ok || total.IsNil() {
+			total = math.ZeroInt()
+		}
+		fee, ok := feeBurns[source]
+		if !ok || fee.IsNil() {
+			fee = math.ZeroInt()
+		}
+		// Both counters grow together, so fee burns never exceed the
+		// total; clamp in case they were reset independently
+		if fee.GT(total) {
+			total = fee
+		}
+		module := total.Sub(fee)
+
+		res.Sources = append(res.Sources, SourceBurnAttribution{
+			Source:       source.String(),
+			FeeBurned:    fee,
+			ModuleBurned: module,
+			TotalBurned:  total,
+		})
+		res.TotalFeeBurned = res.TotalFeeBurned.Add(fee)
+		res.TotalModuleBurned = res.TotalModuleBurned.Add(module)
+		res.TotalBurned = res.TotalBurned.Add(total)
+	}
+	return res
+}
+
+// FormatString renders the attribution as a table
+func (r QueryBurnRateBySourceResponse) FormatString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-17s | %-18s | %-18s | %s\n", "Source", "Fee burn (OMNI)", "Module burn (OMNI)", "Total (OMNI)")
+	row := func(name string, fee, module, total math.Int) {
+		fmt.Fprintf(&b, "%-17s | %-18s | %-18s | %s\n", name, formatOMNI(fee), formatOMNI(module), formatOMNI(total))
+	}
+	for _, s := range r.Sources {
+		row(strings.ToLower(strings.TrimPrefix(s.Source, "BURN_SOURCE_")), s.FeeBurned, s.ModuleBurned, s.TotalBurned)
+	}
+	row("total", r.TotalFeeBurned, r.TotalModuleBurned, r.TotalBurned)
+	return b.String()
+}
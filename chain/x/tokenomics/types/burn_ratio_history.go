@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	// DefaultBurnRatioHistoryLimit is the number of burn ratio history entries
+	// kept before governance sets a limit
+	DefaultBurnRatioHistoryLimit uint64 = 1000
+
+	// MaxBurnRatioHistoryLimit bounds the history kept in state
+	MaxBurnRatioHistoryLimit uint64 = 10000
+)
+
+// ValidateBurnRatioHistoryLimit checks the number of history entries to keep
+func ValidateBurnRatioHistoryLimit(limit uint64) error {
+	if limit == 0 || limit > MaxBurnRatioHistoryLimit {
+		return fmt.Errorf("burn ratio history limit must be 1-%d entries, got %d",
+			MaxBurnRatioHistoryLimit, limit)
+	}
+	return nil
+}
+
+// BurnRatioHistoryRange tracks the live entries of the burn ratio history:
+// sequences First up to but excluding Next are in the store.
+type BurnRatioHistoryRange struct {
+	First uint64 `json:"first"`
+	Next  uint64 `json:"next"`
+}
+
+// Len returns the number of entries in the range
+func (r BurnRatioHistoryRange) Len() uint64 {
+	return r.Next - r.First
+}
+
+// GetBurnRatioHistoryKey returns the store key for a burn ratio history entry
+func GetBurnRatioHistoryKey(seq uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, seq)
+	return append(append([]byte{}, BurnRatioHistoryPrefix...), b...)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// BurnSources returns every burn source except UNSPECIFIED, in enum order
+func BurnSources() []BurnSource {
+	sources := make([]BurnSource, 0, len(BurnSource_name))
+	for value := range BurnSource_name {
+		if BurnSource(value) != BurnSource_BURN_SOURCE_UNSPECIFIED {
+			sources = append(sources, BurnSource(value))
+		}
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+	return sources
+}
+
+// NewQueryBurnsBreakdownResponse builds the breakdown from per-source totals.
+// Sources missing from totals are reported as zero.
+func NewQueryBurnsBreakdownResponse(totals map[BurnSource]math.Int) QueryBurnsBreakdownResponse {
+	sources := BurnSources()
+	res := QueryBurnsBreakdownResponse{
+		Sources:     make([]SourceBurnTotal, 0, len(sources)),
+		TotalBurned: math.ZeroInt(),
+	}
+	for _, source := range sources {
+		amount, ok := totals[source]
+		if !ok || amount.IsNil() {
+			amount = math.ZeroInt()
+		}
+		res.Sources = append(res.Sources, SourceBurnTotal{
+			Source:      source.String(),
+			TotalBurned: amount,
+		})
+		res.TotalBurned = res.TotalBurned.Add(amount)
+	}
+	return res
+}
+
+// FormatString renders the breakdown as a table
+func (r QueryBurnsBreakdownResponse) FormatString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-17s | %s\n", "Source", "Burned (OMNI)")
+	for _, s := range r.Sources {
+		name := strings.ToLower(strings.TrimPrefix(s.Source, "BURN_SOURCE_"))
+		fmt.Fprintf(&b, "%-17s | %s\n", name, formatOMNI(s.TotalBurned))
+	}
+	fmt.Fprintf(&b, "%-17s | %s\n", "total", formatOMNI(r.TotalBurned))
+	return b.String()
+}
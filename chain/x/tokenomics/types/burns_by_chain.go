@@ -0,0 +1,20 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// NewQueryAllBurnsByChainResponse builds the response from per-chain totals
+func NewQueryAllBurnsByChainResponse(chains []ChainBurnTotal) QueryAllBurnsByChainResponse {
+	total := math.ZeroInt()
+	for _, c := range chains {
+		total = total.Add(c.TotalBurned)
+	}
+	if chains == nil {
+		chains = []ChainBurnTotal{}
+	}
+	return QueryAllBurnsByChainResponse{
+		Chains:      chains,
+		TotalBurned: total,
+	}
+}
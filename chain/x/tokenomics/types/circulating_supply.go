@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TimelockModuleName is the module account holding queued timelock funds.
+// Kept as a literal so tokenomics does not import the timelock module.
+const TimelockModuleName = "timelock"
+
+// NonCirculatingAccounts lists the accounts whose native-denom balances are
+// excluded from the circulating supply. The treasury address is always
+// excluded on top of these. Stored as JSON under KeyNonCirculatingAccounts.
+type NonCirculatingAccounts struct {
+	// ModuleAccounts are module account names (e.g. unclaimed emissions held
+	// by the tokenomics module, funds queued in the timelock)
+	ModuleAccounts []string `json:"module_accounts"`
+
+	// Addresses are plain accounts such as team and advisor vesting accounts
+	Addresses []string `json:"addresses"`
+}
+
+// DefaultNonCirculatingAccounts returns the exclusions used before governance
+// sets them: the tokenomics module (unclaimed emissions) and the timelock
+func DefaultNonCirculatingAccounts() NonCirculatingAccounts {
+	return NonCirculatingAccounts{
+		ModuleAccounts: []string{ModuleName, TimelockModuleName},
+		Addresses:      []string{},
+	}
+}
+
+// Validate checks that module names are non-empty and addresses are valid bech32
+func (a NonCirculatingAccounts) Validate() error {
+	for _, name := range a.ModuleAccounts {
+		if name == "" {
+			return fmt.Errorf("non-circulating module account name cannot be empty")
+		}
+	}
+	for _, addr := range a.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid non-circulating address %q: %w", addr, err)
+		}
+	}
+	return nil
+}
@@ -14,6 +14,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDistributeRewards{}, "pos/tokenomics/MsgDistributeRewards", nil)
 	cdc.RegisterConcrete(&MsgReportBurn{}, "pos/tokenomics/MsgReportBurn", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "pos/tokenomics/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetEmergencyBurnOverride{}, "pos/tokenomics/MsgSetEmergencyBurnOverride", nil)
+	cdc.RegisterConcrete(&MsgExecuteRedirectNow{}, "pos/tokenomics/MsgExecuteRedirectNow", nil)
 }
 
 // RegisterInterfaces registers the module's interface types
@@ -24,6 +26,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgDistributeRewards{},
 		&MsgReportBurn{},
 		&MsgUpdateParams{},
+		&MsgSetEmergencyBurnOverride{},
+		&MsgExecuteRedirectNow{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
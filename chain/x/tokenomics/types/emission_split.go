@@ -0,0 +1,199 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// EmissionSplitChangeSource is the StagedChange source for emission split
+// changes, which apply from the next epoch rather than immediately
+const EmissionSplitChangeSource = "emission_split"
+
+// EmissionSplit is the share of each emission that goes to each recipient
+type EmissionSplit struct {
+	Staking   math.LegacyDec `json:"staking"`
+	Poc       math.LegacyDec `json:"poc"`
+	Sequencer math.LegacyDec `json:"sequencer"`
+	Treasury  math.LegacyDec `json:"treasury"`
+}
+
+// EmissionSplit returns the emission split configured in p
+func (p TokenomicsParams) EmissionSplit() EmissionSplit {
+	return EmissionSplit{
+		Staking:   p.EmissionSplitStaking,
+		Poc:       p.EmissionSplitPoc,
+		Sequencer: p.EmissionSplitSequencer,
+		Treasury:  p.EmissionSplitTreasury,
+	}
+}
+
+// Equal reports whether both splits assign the same shares
+func (s EmissionSplit) Equal(other EmissionSplit) bool {
+	return s.Staking.Equal(other.Staking) &&
+		s.Poc.Equal(other.Poc) &&
+		s.Sequencer.Equal(other.Sequencer) &&
+		s.Treasury.Equal(other.Treasury)
+}
+
+// Validate checks that the shares sum to 100% and stay within the protocol
+// bounds: no recipient above MaxSingleRecipientShare and staking at or above
+// MinStakingShare
+func (s EmissionSplit) Validate() error {
+	return s.Recipients().Validate()
+}
+
+// Recipients returns the split as the four built-in emission recipients
+func (s EmissionSplit) Recipients() EmissionRecipients {
+	return EmissionRecipients{
+		{Module: EmissionCategoryStaking, Share: s.Staking},
+		{Module: EmissionCategoryPoc, Share: s.Poc},
+		{Module: EmissionCategorySequencer, Share: s.Sequencer},
+		{Module: EmissionCategoryTreasury, Share: s.Treasury},
+	}
+}
+
+// ParamChanges returns the param changes that set the split, for staging.
+// They also clear emission_recipients so the four fields take effect.
+func (s EmissionSplit) ParamChanges() ([]ParamChange, error) {
+	fields := []struct {
+		name  string
+		value math.LegacyDec
+	}{
+		{"emission_split_staking", s.Staking},
+		{"emission_split_poc", s.Poc},
+		{"emission_split_sequencer", s.Sequencer},
+		{"emission_split_treasury", s.Treasury},
+	}
+
+	changes := make([]ParamChange, 0, len(fields)+1)
+	for _, f := range fields {
+		bz, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, fmt.Errorf("param change %s: %w", f.name, err)
+		}
+		changes = append(changes, ParamChange{Field: f.name, Value: string(bz)})
+	}
+	return append(changes, ParamChange{Field: emissionRecipientsField, Value: "null"}), nil
+}
+
+// emissionRecipientsField is the params JSON name of EmissionRecipients
+const emissionRecipientsField = "emission_recipients"
+
+// EmissionRecipients is a weighted list of emission targets. Entries named
+// after the built-in categories (staking, poc, sequencer, treasury) keep their
+// routing; any other entry names the module account that receives its share.
+type EmissionRecipients []EmissionRecipient
+
+// EmissionRecipientList returns the emission recipients configured in p: the
+// emission_recipients list when set, otherwise the four emission_split fields
+func (p TokenomicsParams) EmissionRecipientList() EmissionRecipients {
+	if len(p.EmissionRecipients) > 0 {
+		return EmissionRecipients(p.EmissionRecipients)
+	}
+	return p.EmissionSplit().Recipients()
+}
+
+// ShareOf returns the share of the named recipient, zero if it has none
+func (r EmissionRecipients) ShareOf(module string) math.LegacyDec {
+	for _, recipient := range r {
+		if recipient.Module == module {
+			return recipient.Share
+		}
+	}
+	return math.LegacyZeroDec()
+}
+
+// EmissionSplit returns the shares of the four built-in recipients; those
+// missing from the list get zero
+func (r EmissionRecipients) EmissionSplit() EmissionSplit {
+	return EmissionSplit{
+		Staking:   r.ShareOf(EmissionCategoryStaking),
+		Poc:       r.ShareOf(EmissionCategoryPoc),
+		Sequencer: r.ShareOf(EmissionCategorySequencer),
+		Treasury:  r.ShareOf(EmissionCategoryTreasury),
+	}
+}
+
+// Equal reports whether both lists assign the same shares in the same order
+func (r EmissionRecipients) Equal(other EmissionRecipients) bool {
+	if len(r) != len(other) {
+		return false
+	}
+	for i := range r {
+		if !r[i].Equal(other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks that every recipient is named once, the shares sum to 100%
+// and they stay within the protocol bounds: no recipient above
+// MaxSingleRecipientShare and a staking entry at or above MinStakingShare
+func (r EmissionRecipients) Validate() error {
+	if len(r) == 0 {
+		return fmt.Errorf("%w: no emission recipients", ErrEmissionSplitInvalid)
+	}
+
+	seen := make(map[string]bool, len(r))
+	emissionSum := math.LegacyZeroDec()
+	for _, recipient := range r {
+		if recipient.Module == "" {
+			return fmt.Errorf("%w: emission recipient module cannot be empty", ErrEmissionSplitInvalid)
+		}
+		if seen[recipient.Module] {
+			return fmt.Errorf("%w: duplicate emission recipient %s", ErrEmissionSplitInvalid, recipient.Module)
+		}
+		seen[recipient.Module] = true
+
+		if recipient.Share.IsNil() {
+			return fmt.Errorf("%w: emission split share cannot be empty", ErrEmissionSplitInvalid)
+		}
+		emissionSum = emissionSum.Add(recipient.Share)
+	}
+
+	if !emissionSum.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("%w: emission splits sum to %s, must equal 1.0",
+			ErrEmissionSplitInvalid, emissionSum.String())
+	}
+
+	// ========================================
+	// PROTOCOL SAFETY: Emission split bounds
+	// ========================================
+
+	// MaxSingleRecipientShare: No single recipient can exceed 60%
+	maxSingleShare := math.LegacyMustNewDecFromStr(MaxSingleRecipientShare) // 0.60 = 60%
+
+	// MinStakingShare: Staking must receive at least 20% (security requirement)
+	minStakingShare := math.LegacyMustNewDecFromStr(MinStakingShare) // 0.20 = 20%
+
+	for _, recipient := range r {
+		if recipient.Share.IsNegative() {
+			return fmt.Errorf("emission split %s cannot be negative, got %s", recipient.Module, recipient.Share.String())
+		}
+		// Enforce max single recipient cap (60%)
+		if recipient.Share.GT(maxSingleShare) {
+			return fmt.Errorf("%w: emission split %s (%s) exceeds max single recipient share (%s)",
+				ErrProtocolCapViolation, recipient.Module, recipient.Share.String(), maxSingleShare.String())
+		}
+	}
+
+	// Enforce minimum staking share (20%) for PoS security
+	if staking := r.ShareOf(EmissionCategoryStaking); staking.LT(minStakingShare) {
+		return fmt.Errorf("%w: staking emission split (%s) below minimum required (%s) for PoS security",
+			ErrProtocolCapViolation, staking.String(), minStakingShare.String())
+	}
+
+	return nil
+}
+
+// ParamChanges returns the param change that sets the list, for staging
+func (r EmissionRecipients) ParamChanges() ([]ParamChange, error) {
+	bz, err := json.Marshal([]EmissionRecipient(r))
+	if err != nil {
+		return nil, fmt.Errorf("param change %s: %w", emissionRecipientsField, err)
+	}
+	return []ParamChange{{Field: emissionRecipientsField, Value: string(bz)}}, nil
+}
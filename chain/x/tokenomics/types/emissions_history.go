@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Emission split categories. Cumulative and last-epoch amounts are tracked
+// per category under DistributedPrefix and LastEpochEmissionPrefix.
+const (
+	EmissionCategoryStaking   = "staking"
+	EmissionCategoryPoc       = "poc"
+	EmissionCategorySequencer = "sequencer"
+	EmissionCategoryTreasury  = "treasury"
+)
+
+// EmissionCategories lists the categories in reporting order
+var EmissionCategories = []string{
+	EmissionCategoryStaking,
+	EmissionCategoryPoc,
+	EmissionCategorySequencer,
+	EmissionCategoryTreasury,
+}
+
+// ValidateEmissionCategory rejects categories that are not tracked
+func ValidateEmissionCategory(category string) error {
+	for _, c := range EmissionCategories {
+		if c == category {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown emission category %q", category)
+}
+
+// NewEmissionsHistory builds the history from per-category totals and
+// last-epoch amounts; missing categories count as zero
+func NewEmissionsHistory(totals, lastEpoch map[string]math.Int, lastEpochHeight int64) EmissionsHistory {
+	h := EmissionsHistory{
+		Recipients:      make([]EmissionRecipientHistory, 0, len(EmissionCategories)),
+		TotalEmitted:    math.ZeroInt(),
+		LastEpochTotal:  math.ZeroInt(),
+		LastEpochHeight: lastEpochHeight,
+	}
+	for _, category := range EmissionCategories {
+		total, ok := totals[category]
+		if !ok {
+			total = math.ZeroInt()
+		}
+		last, ok := lastEpoch[category]
+		if !ok {
+			last = math.ZeroInt()
+		}
+		h.Recipients = append(h.Recipients, EmissionRecipientHistory{
+			Category:        category,
+			TotalEmitted:    total,
+			LastEpochAmount: last,
+		})
+		h.TotalEmitted = h.TotalEmitted.Add(total)
+		h.LastEpochTotal = h.LastEpochTotal.Add(last)
+	}
+	return h
+}
@@ -7,8 +7,9 @@ import (
 // Tokenomics module errors
 var (
 	// Supply cap errors
-	ErrSupplyCapExceeded = errorsmod.Register(ModuleName, 1, "total supply would exceed cap")
-	ErrSupplyCapReached  = errorsmod.Register(ModuleName, 2, "total supply has reached cap")
+	ErrSupplyCapExceeded     = errorsmod.Register(ModuleName, 1, "total supply would exceed cap")
+	ErrSupplyCapReached      = errorsmod.Register(ModuleName, 2, "total supply has reached cap")
+	ErrSupplyInvariantBroken = errorsmod.Register(ModuleName, 3, "supply conservation invariant broken")
 
 	// Inflation errors
 	ErrInflationBelowMin      = errorsmod.Register(ModuleName, 10, "inflation rate below minimum")
@@ -62,6 +63,10 @@ var (
 	ErrInvalidGasPrice   = errorsmod.Register(ModuleName, 91, "invalid gas price")
 	ErrInsufficientFunds = errorsmod.Register(ModuleName, 92, "insufficient funds")
 
+	// Burn override errors
+	ErrNotBurnOverrideGuardian = errorsmod.Register(ModuleName, 95, "signer is not the burn override guardian")
+	ErrBurnOverrideDisabled    = errorsmod.Register(ModuleName, 96, "guardian burn override is disabled")
+
 	// General errors
 	ErrNotFound = errorsmod.Register(ModuleName, 100, "not found")
 )
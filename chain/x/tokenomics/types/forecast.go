@@ -1,9 +1,15 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	"cosmossdk.io/math"
 )
 
+// MaxForecastYears bounds the supply forecast query
+const MaxForecastYears int64 = 30
+
 // InflationForecast represents projected inflation for a future year
 type InflationForecast struct {
 	Year          int64
@@ -11,3 +17,135 @@ type InflationForecast struct {
 	AnnualMint    math.Int
 	Supply        math.Int
 }
+
+// YearAtHeight returns the 0-indexed year since genesis (height 1) at height,
+// for a chain producing blocksPerYear blocks a year
+func YearAtHeight(height, blocksPerYear int64) int64 {
+	if height < 1 || blocksPerYear < 1 {
+		return 0
+	}
+	return (height - 1) / blocksPerYear
+}
+
+// DecayingInflationRate returns the scheduled inflation rate for a 0-indexed
+// year, clamped to the governance bounds:
+// 3.00%, 2.75%, 2.50%, 2.25%, 2.00%, 1.75%, then -0.25%/year down to InflationMin
+func DecayingInflationRate(year int64, params TokenomicsParams) math.LegacyDec {
+	var rate math.LegacyDec
+	switch {
+	case year <= 0:
+		rate = math.LegacyMustNewDecFromStr("0.03")
+	case year <= 5:
+		// 0.25% per year below the 3% starting rate
+		rate = math.LegacyMustNewDecFromStr("0.03").Sub(math.LegacyMustNewDecFromStr("0.0025").MulInt64(year))
+	default:
+		rate = math.LegacyMustNewDecFromStr("0.0175").Sub(math.LegacyMustNewDecFromStr("0.0025").MulInt64(year - 5))
+	}
+
+	if rate.LT(params.InflationMin) {
+		rate = params.InflationMin
+	}
+	if rate.GT(params.InflationMax) {
+		rate = params.InflationMax
+	}
+	return rate
+}
+
+// NewSupplyForecast projects supply for years starting at currentYear from
+// params.CurrentTotalSupply. Each year mints supply * DecayingInflationRate,
+// limited to the room left under TotalSupplyCap, and burns supply *
+// ForecastAnnualBurnRate. Burns free room under the cap for later years.
+func NewSupplyForecast(params TokenomicsParams, currentYear, years int64) ([]SupplyForecastYear, error) {
+	if years < 1 || years > MaxForecastYears {
+		return nil, fmt.Errorf("forecast years must be between 1 and %d, got %d", MaxForecastYears, years)
+	}
+
+	burnRate := params.ForecastAnnualBurnRate
+	if burnRate.IsNil() {
+		burnRate = math.LegacyZeroDec()
+	}
+
+	supply := params.CurrentTotalSupply
+	forecast := make([]SupplyForecastYear, 0, years)
+	for i := int64(0); i < years; i++ {
+		year := currentYear + i
+		rate := DecayingInflationRate(year, params)
+		supplyDec := math.LegacyNewDecFromInt(supply)
+
+		mint := supplyDec.Mul(rate).TruncateInt()
+		if room := params.TotalSupplyCap.Sub(supply); mint.GT(room) {
+			mint = math.MaxInt(room, math.ZeroInt())
+		}
+
+		burn := supplyDec.Mul(burnRate).TruncateInt()
+		if burn.GT(supply.Add(mint)) {
+			burn = supply.Add(mint)
+		}
+
+		net := math.LegacyZeroDec()
+		if supply.IsPositive() {
+			net = math.LegacyNewDecFromInt(mint.Sub(burn)).QuoInt(supply)
+		}
+
+		end := supply.Add(mint).Sub(burn)
+		forecast = append(forecast, SupplyForecastYear{
+			Year:          year,
+			InflationRate: rate,
+			StartSupply:   supply,
+			Mint:          mint,
+			Burn:          burn,
+			NetInflation:  net,
+			EndSupply:     end,
+		})
+		supply = end
+	}
+
+	return forecast, nil
+}
+
+// NewQuerySupplyForecastResponse builds the forecast response from params at
+// currentYear. Shared by the query server and the CLI.
+func NewQuerySupplyForecastResponse(params TokenomicsParams, currentYear, years int64) (QuerySupplyForecastResponse, error) {
+	forecast, err := NewSupplyForecast(params, currentYear, years)
+	if err != nil {
+		return QuerySupplyForecastResponse{}, err
+	}
+
+	burnRate := params.ForecastAnnualBurnRate
+	if burnRate.IsNil() {
+		burnRate = math.LegacyZeroDec()
+	}
+	return QuerySupplyForecastResponse{
+		CurrentYear:             currentYear,
+		TotalSupplyCap:          params.TotalSupplyCap,
+		EstimatedAnnualBurnRate: burnRate,
+		Years:                   forecast,
+	}, nil
+}
+
+// FormatString renders the forecast as a table. Years are shown 1-indexed.
+func (r QuerySupplyForecastResponse) FormatString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Supply forecast from year %d (est. burn %s%% of supply per year, cap %s OMNI)\n\n",
+		r.CurrentYear+1, formatPercentShort(r.EstimatedAnnualBurnRate), formatOMNI(r.TotalSupplyCap))
+	fmt.Fprintf(&b, "%4s | %9s | %20s | %20s | %13s | %s\n",
+		"Year", "Inflation", "Mint (OMNI)", "Est. Burn (OMNI)", "Net Inflation", "End Supply (OMNI)")
+	for _, y := range r.Years {
+		fmt.Fprintf(&b, "%4d | %8s%% | %20s | %20s | %12s%% | %s\n",
+			y.Year+1,
+			formatPercentShort(y.InflationRate),
+			formatOMNI(y.Mint),
+			formatOMNI(y.Burn),
+			formatPercentShort(y.NetInflation),
+			formatOMNI(y.EndSupply))
+	}
+	return b.String()
+}
+
+// formatPercentShort renders a decimal as a percentage with two decimals
+func formatPercentShort(dec math.LegacyDec) string {
+	if dec.IsNil() {
+		return "0.00"
+	}
+	return fmt.Sprintf("%.2f", dec.MulInt64(100).MustFloat64())
+}
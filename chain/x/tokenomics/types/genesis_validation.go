@@ -13,6 +13,12 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("invalid params: %w", err)
 	}
 
+	// REDIRECT-008: Genesis carries no redirect target addresses; they are set
+	// after launch, so the redirect cannot start out sending funds to any target
+	if gs.Params.TreasuryRedirectEnabled && gs.Params.HasRedirectTargets() {
+		return fmt.Errorf("treasury redirect cannot be enabled at genesis: redirect target addresses are not configured yet")
+	}
+
 	// Validate supply state
 	if gs.SupplyState.CurrentTotalSupply.IsNegative() {
 		return fmt.Errorf("current supply cannot be negative")
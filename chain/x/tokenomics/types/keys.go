@@ -106,15 +106,98 @@ var (
 
 	// Treasury inflow from inflation
 	KeyTreasuryFromInflation = []byte{0x96}
+
+	// ── Epoch-staged param changes ──
+
+	// Staged changes: key = StagedChangePrefix + effective_epoch + id (both big-endian)
+	StagedChangePrefix = []byte{0xA0}
+
+	// Next staged change ID
+	KeyNextStagedChangeID = []byte{0xA1}
+
+	// ── Treasury-pct smoothing for the adaptive burn controller ──
+
+	// Smoothing mode and window (JSON)
+	KeyTreasuryPctSmoothing = []byte{0xA2}
+
+	// Running sum, sample count and next ring slot of the moving average (JSON)
+	KeyTreasuryPctWindow = []byte{0xA3}
+
+	// Treasury-pct samples: key = TreasuryPctSamplePrefix + slot (big-endian)
+	TreasuryPctSamplePrefix = []byte{0xA4}
+
+	// ── Block congestion history for the adaptive burn controller ──
+
+	// Running sum, sample count and next ring slot of the congestion average (JSON)
+	KeyCongestionWindow = []byte{0xA5}
+
+	// Block congestion samples: key = CongestionSamplePrefix + slot (big-endian)
+	CongestionSamplePrefix = []byte{0xA6}
+
+	// ── Circulating supply ──
+
+	// Accounts excluded from the circulating supply (JSON)
+	KeyNonCirculatingAccounts = []byte{0xA7}
+
+	// ── Supply cap ──
+
+	// Set once the supply cap has been reached; minting is skipped from then on
+	KeyMintingDisabled = []byte{0xA8}
+
+	// ── Emission accounting ──
+
+	// Amount emitted to each category in the last epoch: key = LastEpochEmissionPrefix + category
+	LastEpochEmissionPrefix = []byte{0xA9}
+
+	// ── Buy-and-burn ──
+
+	// Block height of the last buy-and-burn run
+	KeyLastBuyAndBurnHeight = []byte{0xAA}
+
+	// ── Adaptive burn decision history ──
+
+	// Maximum number of burn ratio history entries kept
+	KeyBurnRatioHistoryLimit = []byte{0xAB}
+
+	// Sequence numbers of the oldest and next burn ratio history entries (JSON)
+	KeyBurnRatioHistoryRange = []byte{0xAC}
+
+	// Burn ratio history entries: key = BurnRatioHistoryPrefix + sequence (big-endian)
+	BurnRatioHistoryPrefix = []byte{0xAD}
+
+	// ── Guardian burn override ──
+
+	// Emergency burn override set by the burn override guardian (JSON)
+	KeyGuardianBurnOverride = []byte{0xAE}
+
+	// ── Fee burn attribution ──
+
+	// Fees tagged with their source in the current block: key = PendingFeeSourcePrefix + source
+	PendingFeeSourcePrefix = []byte{0xAF}
+
+	// Cumulative fee burns attributed to each source: key = FeeBurnBySourcePrefix + source
+	FeeBurnBySourcePrefix = []byte{0xB0}
 )
 
 // Event types
 const (
-	EventTypeMint               = "mint_inflation"
-	EventTypeBurn               = "burn_tokens"
-	EventTypeTreasuryRedirect   = "treasury_redirect"
-	EventTypeTreasuryAllocation = "treasury_allocation"
-	EventTypeEmissionAllocated  = "emission_allocated"
+	EventTypeMint                  = "mint_inflation"
+	EventTypeBurn                  = "burn_tokens"
+	EventTypeTreasuryRedirect      = "treasury_redirect"
+	EventTypeTreasuryAllocation    = "treasury_allocation"
+	EventTypeEmissionAllocated     = "emission_allocated"
+	EventTypeParamChangeStaged     = "param_change_staged"
+	EventTypeStagedChangesApplied  = "staged_changes_applied"
+	EventTypeStagedChangesRejected = "staged_changes_rejected"
+	EventTypeMintingCeased         = "minting_ceased"
+	EventTypeEmission              = "tokenomics_emission"
+	EventTypeBuyAndBurn            = "buy_and_burn"
+	EventTypeSupplyInvariantBroken = "supply_invariant_broken"
+
+	// Guardian emergency burn override events
+	EventTypeBurnOverrideActivated   = "emergency_burn_override_activated"
+	EventTypeBurnOverrideDeactivated = "emergency_burn_override_deactivated"
+	EventTypeBurnOverrideExpired     = "emergency_burn_override_expired"
 
 	AttributeKeyInflationRate    = "inflation_rate"
 	AttributeKeyAnnualProvisions = "annual_provisions"
@@ -132,6 +215,7 @@ const (
 	AttributeKeyAllocationAmount    = "allocation_amount"
 	AttributeKeyAllocationRatio     = "allocation_ratio"
 	AttributeKeyRedirectBlockHeight = "redirect_block_height"
+	AttributeKeyCarriedOverInflows  = "carried_over_inflows"
 
 	// Emission record event attributes
 	AttributeKeyEmissionID  = "emission_id"
@@ -141,6 +225,25 @@ const (
 	AttributeKeyToSequencer  = "to_sequencer"
 	AttributeKeyToTreasury   = "to_treasury"
 	AttributeKeyBlockHeight  = "block_height"
+
+	// Minting ceased event attributes
+	AttributeKeySupplyCap   = "supply_cap"
+	AttributeKeyFinalMint   = "final_mint"
+	AttributeKeyTotalSupply = "total_supply"
+
+	// Emission distribution event attributes
+	AttributeKeyTotalMinted   = "total_minted"
+	AttributeKeyDustRemainder = "dust_remainder"
+	AttributeKeyDustRecipient = "dust_recipient"
+
+	// Buy-and-burn event attributes
+	AttributeKeyBuyAndBurnAddress = "buy_and_burn_address"
+	AttributeKeyAmountBurned      = "amount_burned"
+
+	// Supply invariant event attributes
+	AttributeKeyTotalBurned    = "total_burned"
+	AttributeKeyExpectedSupply = "expected_supply"
+	AttributeKeySeverity       = "severity"
 )
 
 // GetBurnRecordKey returns the store key for a burn record
@@ -164,6 +267,17 @@ func GetBurnBySourceKey(source BurnSource) []byte {
 	return append(BurnBySourcePrefix, byte(source))
 }
 
+// GetPendingFeeSourceKey returns the store key for fees tagged with source in
+// the current block
+func GetPendingFeeSourceKey(source BurnSource) []byte {
+	return append(append([]byte{}, PendingFeeSourcePrefix...), byte(source))
+}
+
+// GetFeeBurnBySourceKey returns the store key for fee burns attributed to source
+func GetFeeBurnBySourceKey(source BurnSource) []byte {
+	return append(append([]byte{}, FeeBurnBySourcePrefix...), byte(source))
+}
+
 // GetBurnByChainKey returns the store key for chain-specific burn tracking
 func GetBurnByChainKey(chainID string) []byte {
 	return append(BurnByChainPrefix, []byte(chainID)...)
@@ -205,3 +319,8 @@ func GetBurnReportDedupKey(chainID, txHash string) []byte {
 func GetDistributedKey(category string) []byte {
 	return append(DistributedPrefix, []byte(category)...)
 }
+
+// GetLastEpochEmissionKey returns the store key for a category's last-epoch emission
+func GetLastEpochEmissionKey(category string) []byte {
+	return append(append([]byte{}, LastEpochEmissionPrefix...), []byte(category)...)
+}
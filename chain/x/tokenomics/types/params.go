@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ============================================================================
@@ -28,6 +30,45 @@ const (
 	MinStakingShare = "0.20" // 20% minimum to staking
 )
 
+const (
+	// DefaultBurnOverrideMaxBlocks is how long a guardian-activated burn
+	// override lasts (~1 day at 6s blocks)
+	DefaultBurnOverrideMaxBlocks uint64 = 14400
+
+	// MaxBurnOverrideBlocks bounds the guardian override (~2 weeks at 6s blocks)
+	MaxBurnOverrideBlocks uint64 = 201600
+
+	// DefaultBlocksPerYear divides annual provisions into per-block provisions
+	// (~7s blocks)
+	DefaultBlocksPerYear uint64 = 4_500_857
+
+	// MinBlocksPerYear and MaxBlocksPerYear bound blocks_per_year to block
+	// times between 10 minutes and 100ms
+	MinBlocksPerYear uint64 = 52_596
+	MaxBlocksPerYear uint64 = 315_576_000
+)
+
+// secondsPerYear is a 365.25-day year
+const secondsPerYear = 365.25 * 24 * 60 * 60
+
+// BlocksPerYearForBlockTime returns the blocks_per_year value for a target
+// block time, or 0 if blockTime is not positive
+func BlocksPerYearForBlockTime(blockTime time.Duration) uint64 {
+	if blockTime <= 0 {
+		return 0
+	}
+	return uint64(secondsPerYear * time.Second / blockTime)
+}
+
+// EffectiveBlocksPerYear returns BlocksPerYear, or DefaultBlocksPerYear for
+// params stored before the field existed
+func (p TokenomicsParams) EffectiveBlocksPerYear() uint64 {
+	if p.BlocksPerYear == 0 {
+		return DefaultBlocksPerYear
+	}
+	return p.BlocksPerYear
+}
+
 // DefaultParams returns the default tokenomics parameters
 // These are the mainnet launch parameters
 func DefaultParams() TokenomicsParams {
@@ -75,7 +116,8 @@ func DefaultParams() TokenomicsParams {
 		PocAlpha: math.LegacyNewDecWithPrec(10, 2), // 0.10 = 10% credit weight
 
 		// IBC parameters
-		RewardStreamInterval: 100, // blocks (every 100 blocks = ~12 minutes with 7s blocks)
+		RewardStreamInterval: 100,                  // blocks (every 100 blocks = ~12 minutes with 7s blocks)
+		BlocksPerYear:        DefaultBlocksPerYear, // ~7s blocks
 		ContinuityIbcChannel: "channel-0",
 		SequencerIbcChannel:  "channel-1",
 
@@ -98,6 +140,14 @@ func DefaultParams() TokenomicsParams {
 		LastAppliedBurnRatio:         math.LegacyNewDecWithPrec(90, 2),    // Initial 90%
 		LastBurnTrigger:              "normal",                            // Initial state
 		EmergencyBurnOverride:        false,                               // No emergency override
+		BurnOverrideMaxBlocks:        DefaultBurnOverrideMaxBlocks,        // Guardian override lasts ~1 day
+
+		// Treasury redirect
+		MaxRedirectPerExecution: math.LegacyZeroDec(), // No per-execution cap
+		BuyAndBurnInterval:      0,                    // Buy-and-burn target is not burned until governance enables it
+
+		// Supply forecast
+		ForecastAnnualBurnRate: math.LegacyZeroDec(), // No burn assumed until governance sets an estimate
 	}
 }
 
@@ -162,56 +212,20 @@ func (p TokenomicsParams) Validate() error {
 		return fmt.Errorf("inflation min (%s) cannot exceed max (%s)", p.InflationMin.String(), p.InflationMax.String())
 	}
 
-	// ========================================
-	// P0-DIST-001: Validate emission splits sum to 100%
-	// ========================================
-
-	emissionSum := p.EmissionSplitStaking.
-		Add(p.EmissionSplitPoc).
-		Add(p.EmissionSplitSequencer).
-		Add(p.EmissionSplitTreasury)
-
-	if !emissionSum.Equal(math.LegacyOneDec()) {
-		return fmt.Errorf("%w: emission splits sum to %s, must equal 1.0",
-			ErrEmissionSplitInvalid, emissionSum.String())
+	// Forecast burn estimate (fraction of supply per year). Unset in params
+	// stored before the field existed.
+	if !p.ForecastAnnualBurnRate.IsNil() {
+		if p.ForecastAnnualBurnRate.IsNegative() || p.ForecastAnnualBurnRate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("forecast annual burn rate must be between 0 and 1, got %s", p.ForecastAnnualBurnRate.String())
+		}
 	}
 
 	// ========================================
-	// PROTOCOL SAFETY: Emission split bounds
+	// P0-DIST-001: Emission splits (sum and protocol bounds)
 	// ========================================
 
-	// MaxSingleRecipientShare: No single recipient can exceed 60%
-	maxSingleShare := math.LegacyMustNewDecFromStr(MaxSingleRecipientShare) // 0.60 = 60%
-
-	// MinStakingShare: Staking must receive at least 20% (security requirement)
-	minStakingShare := math.LegacyMustNewDecFromStr(MinStakingShare) // 0.20 = 20%
-
-	// Validate each emission split
-	emissionSplits := []struct {
-		name  string
-		value math.LegacyDec
-	}{
-		{"staking", p.EmissionSplitStaking},
-		{"poc", p.EmissionSplitPoc},
-		{"sequencer", p.EmissionSplitSequencer},
-		{"treasury", p.EmissionSplitTreasury},
-	}
-
-	for _, split := range emissionSplits {
-		if split.value.IsNegative() {
-			return fmt.Errorf("emission split %s cannot be negative, got %s", split.name, split.value.String())
-		}
-		// Enforce max single recipient cap (60%)
-		if split.value.GT(maxSingleShare) {
-			return fmt.Errorf("%w: emission split %s (%s) exceeds max single recipient share (%s)",
-				ErrProtocolCapViolation, split.name, split.value.String(), maxSingleShare.String())
-		}
-	}
-
-	// Enforce minimum staking share (20%) for PoS security
-	if p.EmissionSplitStaking.LT(minStakingShare) {
-		return fmt.Errorf("%w: staking emission split (%s) below minimum required (%s) for PoS security",
-			ErrProtocolCapViolation, p.EmissionSplitStaking.String(), minStakingShare.String())
+	if err := p.EmissionRecipientList().Validate(); err != nil {
+		return err
 	}
 
 	// ========================================
@@ -252,6 +266,14 @@ func (p TokenomicsParams) Validate() error {
 		return fmt.Errorf("treasury burn redirect cannot exceed 20%%, got %s", p.TreasuryBurnRedirect.String())
 	}
 
+	// Per-execution redirect cap (fraction of supply, 0 = no cap). Unset in
+	// params stored before the field existed.
+	if !p.MaxRedirectPerExecution.IsNil() {
+		if p.MaxRedirectPerExecution.IsNegative() || p.MaxRedirectPerExecution.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("max redirect per execution must be between 0 and 1, got %s", p.MaxRedirectPerExecution.String())
+		}
+	}
+
 	// ========================================
 	// FEE-BURN: Validate fee burn ratios
 	// ========================================
@@ -321,6 +343,11 @@ func (p TokenomicsParams) Validate() error {
 		return fmt.Errorf("reward stream interval too large (max 10000 blocks), got %d", p.RewardStreamInterval)
 	}
 
+	if p.BlocksPerYear < MinBlocksPerYear || p.BlocksPerYear > MaxBlocksPerYear {
+		return fmt.Errorf("blocks per year must be between %d and %d, got %d",
+			MinBlocksPerYear, MaxBlocksPerYear, p.BlocksPerYear)
+	}
+
 	// IBC channels should be non-empty (but can be updated later via governance)
 	// Not enforcing strict format here as channels can be established after genesis
 
@@ -360,6 +387,17 @@ func (p TokenomicsParams) Validate() error {
 	// ADAPTIVE BURN: Validate dynamic burn parameters
 	// ========================================
 
+	// The burn override guardian is optional; its override expiry is bounded
+	if p.BurnOverrideGuardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.BurnOverrideGuardian); err != nil {
+			return fmt.Errorf("invalid burn override guardian address: %w", err)
+		}
+	}
+	if p.BurnOverrideMaxBlocks > MaxBurnOverrideBlocks {
+		return fmt.Errorf("burn override max blocks must be at most %d, got %d",
+			MaxBurnOverrideBlocks, p.BurnOverrideMaxBlocks)
+	}
+
 	if p.AdaptiveBurnEnabled {
 		// Validate min_burn_ratio (0.70 - 1.00)
 		minBurnProtocolFloor := math.LegacyNewDecWithPrec(70, 2) // 0.70 = 70%
@@ -414,6 +452,22 @@ func (p TokenomicsParams) Validate() error {
 	return nil
 }
 
+// HasRedirectTargets reports whether any treasury redirect target has a
+// nonzero ratio, i.e. needs an address for the redirect to run
+func (p TokenomicsParams) HasRedirectTargets() bool {
+	for _, ratio := range []math.LegacyDec{
+		p.RedirectToEcosystemGrants,
+		p.RedirectToBuyAndBurn,
+		p.RedirectToInsuranceFund,
+		p.RedirectToResearchFund,
+	} {
+		if !ratio.IsNil() && !ratio.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
 // FormatString returns a human-readable string representation of the params
 func (p TokenomicsParams) FormatString() string {
 	return fmt.Sprintf(`TokenomicsParams:
@@ -424,6 +478,8 @@ func (p TokenomicsParams) FormatString() string {
     Total Burned:     %s OMNI
   Inflation:
     Rate:             %s%% (min: %s%%, max: %s%%)
+    Blocks Per Year:  %d
+    Forecast Burn:    %s%% of supply per year
   Emissions:
     Staking:          %s%%
     PoC:              %s%%
@@ -438,6 +494,35 @@ func (p TokenomicsParams) FormatString() string {
     Messaging:        %s%%
   Treasury:
     Burn Redirect:    %s%%
+  Fee Burn:
+    Enabled:          %t
+    Burn Ratio:       %s%%
+    Treasury Ratio:   %s%%
+    Min Gas Price:    %s
+  Adaptive Burn:
+    Enabled:          %t
+    Ratio Range:      %s%% - %s%% (default: %s%%)
+    Congestion Threshold: %s%%
+    Tx/Day Target:    %d
+    Treasury Floor:   %s%%
+    Smoothing:        %d blocks
+    Last Applied:     %s%% (trigger: %s)
+    Emergency Override: %t
+    Override Guardian:  %s (override lasts %d blocks)
+  Treasury Redirect:
+    Enabled:          %t
+    Ratio:            %s%%
+    Ecosystem Grants: %s%%
+    Buy and Burn:     %s%%
+    Insurance Fund:   %s%%
+    Research Fund:    %s%%
+    Interval:         %d blocks
+    Last Height:      %d
+    Accumulated:      %s OMNI
+    Max Per Run:      %s%% of supply
+    Buy-and-Burn Run: every %d blocks (0 = disabled)
+  PoC:
+    Alpha:            %s
   Gas Conversion:
     Continuity:       %sx
     Sequencer:        %sx
@@ -458,6 +543,8 @@ func (p TokenomicsParams) FormatString() string {
 		formatPercent(p.InflationRate),
 		formatPercent(p.InflationMin),
 		formatPercent(p.InflationMax),
+		p.BlocksPerYear,
+		formatPercent(p.ForecastAnnualBurnRate),
 		formatPercent(p.EmissionSplitStaking),
 		formatPercent(p.EmissionSplitPoc),
 		formatPercent(p.EmissionSplitSequencer),
@@ -469,6 +556,35 @@ func (p TokenomicsParams) FormatString() string {
 		formatPercent(p.BurnRateAiQueries),
 		formatPercent(p.BurnRateMessaging),
 		formatPercent(p.TreasuryBurnRedirect),
+		p.FeeBurnEnabled,
+		formatPercent(p.FeeBurnRatio),
+		formatPercent(p.TreasuryFeeRatio),
+		formatDec(p.MinGasPrice),
+		p.AdaptiveBurnEnabled,
+		formatPercent(p.MinBurnRatio),
+		formatPercent(p.MaxBurnRatio),
+		formatPercent(p.DefaultBurnRatio),
+		formatPercent(p.BlockCongestionThreshold),
+		p.TxPerDayTarget,
+		formatPercent(p.TreasuryFloorPct),
+		p.BurnAdjustmentSmoothing,
+		formatPercent(p.LastAppliedBurnRatio),
+		p.LastBurnTrigger,
+		p.EmergencyBurnOverride,
+		p.BurnOverrideGuardian,
+		p.BurnOverrideMaxBlocks,
+		p.TreasuryRedirectEnabled,
+		formatPercent(p.TreasuryRedirectRatio),
+		formatPercent(p.RedirectToEcosystemGrants),
+		formatPercent(p.RedirectToBuyAndBurn),
+		formatPercent(p.RedirectToInsuranceFund),
+		formatPercent(p.RedirectToResearchFund),
+		p.RedirectExecutionInterval,
+		p.LastRedirectHeight,
+		formatOMNI(p.AccumulatedRedirectInflows),
+		formatPercent(p.MaxRedirectPerExecution),
+		p.BuyAndBurnInterval,
+		formatDec(p.PocAlpha),
 		p.GasConversionRatioContinuity.String(),
 		p.GasConversionRatioSequencer.String(),
 		p.RewardStreamInterval,
@@ -484,6 +600,10 @@ func (p TokenomicsParams) FormatString() string {
 
 // formatOMNI converts micro-OMNI to OMNI with decimals
 func formatOMNI(amount math.Int) string {
+	if amount.IsNil() {
+		return "0"
+	}
+
 	// Divide by 1,000,000 to get OMNI from omniphi (6 decimals)
 	divisor := math.NewInt(1_000_000)
 	omni := amount.Quo(divisor)
@@ -499,6 +619,17 @@ func formatOMNI(amount math.Int) string {
 
 // formatPercent converts decimal to percentage string
 func formatPercent(dec math.LegacyDec) string {
+	if dec.IsNil() {
+		return "0"
+	}
 	pct := dec.MulInt64(100)
 	return pct.String()
 }
+
+// formatDec renders a decimal, treating unset values as zero
+func formatDec(dec math.LegacyDec) string {
+	if dec.IsNil() {
+		return "0"
+	}
+	return dec.String()
+}
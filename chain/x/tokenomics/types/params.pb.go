@@ -38,12 +38,13 @@ type TokenomicsParams struct {
 	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
 	// total_burned tracks cumulative burns across all chains
 	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
-	// inflation_rate is the annual inflation percentage (0.01-0.05)
+	// inflation_rate is the annual inflation percentage (0.005-0.03)
 	// DAO can adjust between min and max
 	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
-	// inflation_min is the DAO-settable floor (default 1%)
+	// inflation_min is the DAO-settable floor (default 0.5%)
 	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min"`
-	// inflation_max is the PROTOCOL-ENFORCED ceiling (max 5%)
+	// inflation_max is the PROTOCOL-ENFORCED ceiling (max 3%,
+	// MaxAnnualInflationRateHardCap)
 	// DAO proposals cannot exceed this
 	InflationMax cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=inflation_max,json=inflationMax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_max"`
 	// emission_split_staking: % of inflation to PoS validators
@@ -166,6 +167,20 @@ type TokenomicsParams struct {
 	LastRedirectHeight int64 `protobuf:"varint,52,opt,name=last_redirect_height,json=lastRedirectHeight,proto3" json:"last_redirect_height,omitempty"`
 	// accumulated_redirect_inflows: Treasury inflows since last redirect
 	AccumulatedRedirectInflows cosmossdk_io_math.Int `protobuf:"bytes,53,opt,name=accumulated_redirect_inflows,json=accumulatedRedirectInflows,proto3,customtype=cosmossdk.io/math.Int" json:"accumulated_redirect_inflows"`
+	// max_redirect_per_execution: Cap on a single redirect execution as a fraction of supply (0 = no cap)
+	MaxRedirectPerExecution cosmossdk_io_math.LegacyDec `protobuf:"bytes,54,opt,name=max_redirect_per_execution,json=maxRedirectPerExecution,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_redirect_per_execution"`
+	// forecast_annual_burn_rate: Estimated fraction of supply burned per year, used by the supply forecast
+	ForecastAnnualBurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,55,opt,name=forecast_annual_burn_rate,json=forecastAnnualBurnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"forecast_annual_burn_rate"`
+	// buy_and_burn_interval: Blocks between burns of the OMNI at the buy-and-burn target (0 = disabled)
+	BuyAndBurnInterval uint64 `protobuf:"varint,56,opt,name=buy_and_burn_interval,json=buyAndBurnInterval,proto3" json:"buy_and_burn_interval,omitempty"`
+	// emission_recipients: Weighted emission targets replacing the four emission_split_* fields when set
+	EmissionRecipients []EmissionRecipient `protobuf:"bytes,57,rep,name=emission_recipients,json=emissionRecipients,proto3" json:"emission_recipients"`
+	// burn_override_guardian: Address that may switch the emergency burn override without a governance vote
+	BurnOverrideGuardian string `protobuf:"bytes,58,opt,name=burn_override_guardian,json=burnOverrideGuardian,proto3" json:"burn_override_guardian,omitempty"`
+	// burn_override_max_blocks: Blocks after which a guardian-activated burn override expires
+	BurnOverrideMaxBlocks uint64 `protobuf:"varint,59,opt,name=burn_override_max_blocks,json=burnOverrideMaxBlocks,proto3" json:"burn_override_max_blocks,omitempty"`
+	// blocks_per_year: Expected blocks per year, dividing annual provisions into per-block provisions
+	BlocksPerYear uint64 `protobuf:"varint,60,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
 }
 
 func (m *TokenomicsParams) Reset()         { *m = TokenomicsParams{} }
@@ -278,6 +293,27 @@ func (m *TokenomicsParams) GetEmergencyBurnOverride() bool {
 	return false
 }
 
+func (m *TokenomicsParams) GetEmissionRecipients() []EmissionRecipient {
+	if m != nil {
+		return m.EmissionRecipients
+	}
+	return nil
+}
+
+func (m *TokenomicsParams) GetBurnOverrideGuardian() string {
+	if m != nil {
+		return m.BurnOverrideGuardian
+	}
+	return ""
+}
+
+func (m *TokenomicsParams) GetBurnOverrideMaxBlocks() uint64 {
+	if m != nil {
+		return m.BurnOverrideMaxBlocks
+	}
+	return 0
+}
+
 // DefaultParams returns the default tokenomics parameters
 // These are the INITIAL values; DAO can modify within protocol constraints
 type DefaultTokenomicsParams struct {
@@ -286,7 +322,7 @@ type DefaultTokenomicsParams struct {
 	CurrentTotalSupply string `protobuf:"bytes,2,opt,name=current_total_supply,json=currentTotalSupply,proto3" json:"current_total_supply,omitempty"`
 	TotalMinted        string `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted,omitempty"`
 	TotalBurned        string `protobuf:"bytes,4,opt,name=total_burned,json=totalBurned,proto3" json:"total_burned,omitempty"`
-	// Inflation (DAO-adjustable 1-5%)
+	// Inflation (DAO-adjustable 0.5-3%)
 	InflationRate string `protobuf:"bytes,5,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
 	InflationMin  string `protobuf:"bytes,6,opt,name=inflation_min,json=inflationMin,proto3" json:"inflation_min,omitempty"`
 	InflationMax  string `protobuf:"bytes,7,opt,name=inflation_max,json=inflationMax,proto3" json:"inflation_max,omitempty"`
@@ -590,104 +626,181 @@ func (m *DefaultTokenomicsParams) GetVotingPeriod() uint64 {
 	return 0
 }
 
+// EmissionRecipient is one weighted emission target
+type EmissionRecipient struct {
+	// module is the recipient module name
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// share is the fraction of each emission the recipient receives
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *EmissionRecipient) Reset()         { *m = EmissionRecipient{} }
+func (m *EmissionRecipient) String() string { return proto.CompactTextString(m) }
+func (*EmissionRecipient) ProtoMessage()    {}
+func (*EmissionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_017f958255b51c12, []int{2}
+}
+func (m *EmissionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionRecipient.Merge(m, src)
+}
+func (m *EmissionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionRecipient proto.InternalMessageInfo
+
+func (m *EmissionRecipient) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TokenomicsParams)(nil), "pos.tokenomics.v1.TokenomicsParams")
 	proto.RegisterType((*DefaultTokenomicsParams)(nil), "pos.tokenomics.v1.DefaultTokenomicsParams")
+	proto.RegisterType((*EmissionRecipient)(nil), "pos.tokenomics.v1.EmissionRecipient")
 }
 
 func init() { proto.RegisterFile("pos/tokenomics/v1/params.proto", fileDescriptor_017f958255b51c12) }
 
 var fileDescriptor_017f958255b51c12 = []byte{
-	// 1414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x98, 0xc1, 0x72, 0xdb, 0x44,
-	0x1c, 0xc6, 0x63, 0x28, 0xa5, 0xdd, 0x26, 0x6e, 0xbc, 0x71, 0x92, 0x6d, 0xd2, 0x3a, 0x69, 0xd2,
-	0xd2, 0xb4, 0x0d, 0x71, 0x0b, 0x6d, 0x07, 0x7a, 0x4b, 0x9d, 0xd2, 0xc9, 0x0c, 0x2d, 0xc6, 0x49,
-	0x81, 0xe9, 0x00, 0x9a, 0xf5, 0x6a, 0x23, 0x2f, 0x91, 0xb4, 0xea, 0xee, 0xda, 0xd8, 0xaf, 0xc0,
-	0x89, 0x47, 0xe0, 0x11, 0x98, 0x81, 0x87, 0xe8, 0xb1, 0xc3, 0x89, 0xe1, 0x50, 0x98, 0xf6, 0x00,
-	0x8f, 0xc1, 0xec, 0x4a, 0x96, 0x64, 0x5b, 0x76, 0x5a, 0x85, 0x13, 0x97, 0x8c, 0xb3, 0x7f, 0xed,
-	0xef, 0xd3, 0x6a, 0xd7, 0x7f, 0x7d, 0x9f, 0x41, 0x25, 0xe0, 0xb2, 0xaa, 0xf8, 0x21, 0xf5, 0xb9,
-	0xc7, 0x88, 0xac, 0x76, 0x6e, 0x56, 0x03, 0x2c, 0xb0, 0x27, 0xb7, 0x02, 0xc1, 0x15, 0x87, 0xa5,
-	0x80, 0xcb, 0xad, 0xa4, 0xbe, 0xd5, 0xb9, 0xb9, 0x54, 0xc2, 0x1e, 0xf3, 0x79, 0xd5, 0xfc, 0x0d,
-	0xaf, 0x5a, 0x2a, 0x3b, 0xdc, 0xe1, 0xe6, 0x63, 0x55, 0x7f, 0x8a, 0x46, 0xcf, 0x11, 0x2e, 0x3d,
-	0x2e, 0xad, 0xb0, 0x10, 0xfe, 0x13, 0x96, 0xd6, 0xfe, 0xbc, 0x00, 0x66, 0xf7, 0x63, 0x6a, 0xdd,
-	0x28, 0xc2, 0xc7, 0x60, 0x56, 0x71, 0x85, 0x5d, 0x4b, 0xb6, 0x83, 0xc0, 0xed, 0x59, 0x04, 0x07,
-	0xa8, 0xb0, 0x5a, 0xd8, 0x38, 0x7d, 0xef, 0xfa, 0xb3, 0x17, 0x2b, 0x53, 0x7f, 0xbc, 0x58, 0x99,
-	0x0f, 0x21, 0xd2, 0x3e, 0xdc, 0x62, 0xbc, 0xea, 0x61, 0xd5, 0xda, 0xda, 0xf5, 0xd5, 0x6f, 0xbf,
-	0xbe, 0x0f, 0x22, 0xfa, 0xae, 0xaf, 0x1a, 0x45, 0x03, 0xd9, 0x33, 0x8c, 0x1a, 0x0e, 0xe0, 0x37,
-	0xa0, 0x4c, 0xda, 0x42, 0x50, 0x5f, 0x59, 0x69, 0x3c, 0x7a, 0xeb, 0xcd, 0xd1, 0x30, 0x02, 0xed,
-	0x27, 0x0a, 0xf0, 0x11, 0x98, 0x0e, 0xb1, 0x1e, 0xf3, 0x15, 0xb5, 0xd1, 0xdb, 0x6f, 0x8e, 0x3d,
-	0x63, 0x00, 0x0f, 0xcd, 0xfc, 0x84, 0xd7, 0x6c, 0x0b, 0x9f, 0xda, 0xe8, 0x44, 0x5e, 0xde, 0x3d,
-	0x33, 0x1f, 0x7e, 0x05, 0x8a, 0xcc, 0x3f, 0x70, 0xb1, 0x62, 0xdc, 0xb7, 0x04, 0x56, 0x14, 0xbd,
-	0x63, 0x88, 0x37, 0x23, 0xe2, 0xf2, 0x28, 0xf1, 0x53, 0xea, 0x60, 0xd2, 0xdb, 0xa1, 0x24, 0xc5,
-	0xdd, 0xa1, 0xa4, 0x31, 0x13, 0x83, 0x1a, 0x58, 0x51, 0xf8, 0x05, 0x48, 0x06, 0xf4, 0xea, 0xd1,
-	0xc9, 0xbc, 0xe0, 0xe9, 0x98, 0xf3, 0x90, 0xf9, 0x43, 0x5c, 0xdc, 0x45, 0xef, 0xfe, 0x07, 0x5c,
-	0xdc, 0x85, 0x0e, 0x58, 0xa0, 0x1e, 0x93, 0x52, 0x63, 0x65, 0xe0, 0x32, 0x65, 0x49, 0x85, 0x0f,
-	0x99, 0xef, 0xa0, 0x53, 0x79, 0x05, 0xca, 0x7d, 0xe0, 0x9e, 0xe6, 0xed, 0x85, 0x38, 0x68, 0x01,
-	0x38, 0x24, 0x14, 0x70, 0x82, 0x4e, 0xe7, 0x15, 0x99, 0x1d, 0x10, 0xa9, 0x73, 0x02, 0x0f, 0x01,
-	0x1a, 0x5e, 0x09, 0x7d, 0xda, 0xa6, 0x3e, 0xa1, 0x02, 0x81, 0xbc, 0x32, 0x0b, 0x83, 0x6b, 0xe9,
-	0x03, 0x21, 0x03, 0x8b, 0x43, 0x62, 0x4a, 0x50, 0x2c, 0xdb, 0xa2, 0x87, 0xce, 0xe4, 0xd5, 0x9a,
-	0x1f, 0xd0, 0xda, 0x8f, 0x78, 0xf0, 0x6b, 0x50, 0xd2, 0xa7, 0xde, 0x1c, 0x53, 0x2b, 0xe0, 0xd2,
-	0x72, 0xb0, 0x44, 0xd3, 0x79, 0x45, 0x8a, 0x9a, 0xa5, 0x4f, 0x6a, 0x9d, 0xcb, 0x07, 0x58, 0xc2,
-	0x16, 0x58, 0x4c, 0xd3, 0x89, 0x85, 0x7d, 0xd2, 0xe2, 0x42, 0x1f, 0x80, 0x99, 0xdc, 0x07, 0x20,
-	0xd1, 0x20, 0xdb, 0x7d, 0xdc, 0xa0, 0x52, 0xbc, 0x35, 0x66, 0x35, 0xc5, 0x63, 0x2b, 0xc5, 0x3b,
-	0xa3, 0xd7, 0xe4, 0x82, 0x73, 0x29, 0x25, 0x0f, 0x0b, 0x65, 0x11, 0xee, 0x2b, 0x81, 0x89, 0x92,
-	0xe8, 0x6c, 0xee, 0xa3, 0x10, 0x6b, 0x69, 0x62, 0xad, 0x0f, 0x84, 0x4d, 0x50, 0x4e, 0xd4, 0x30,
-	0xb3, 0x9e, 0xb6, 0xa9, 0x60, 0x54, 0xa2, 0xd9, 0xbc, 0x42, 0xa5, 0xbe, 0xd0, 0x36, 0xfb, 0x3c,
-	0x64, 0x41, 0x0c, 0xe6, 0x12, 0x0d, 0x8f, 0x4a, 0x89, 0x1d, 0xbd, 0x43, 0xa5, 0x63, 0x4b, 0x3c,
-	0xec, 0xb3, 0x74, 0x23, 0xe8, 0x1f, 0x61, 0x2b, 0xd4, 0xa2, 0x36, 0x13, 0x94, 0x28, 0x04, 0x73,
-	0xef, 0x4e, 0x1f, 0xa8, 0xbb, 0x6e, 0x23, 0xc2, 0xc1, 0x0d, 0x30, 0x7b, 0x40, 0x69, 0xa8, 0x41,
-	0x7d, 0xdc, 0x74, 0xa9, 0x8d, 0x56, 0x56, 0x0b, 0x1b, 0xa7, 0x1a, 0xc5, 0x03, 0x4a, 0xf5, 0xa5,
-	0xf7, 0xc3, 0x51, 0xf8, 0x25, 0x28, 0xc6, 0x57, 0x0a, 0xdd, 0xb1, 0xd0, 0x6a, 0xee, 0xa6, 0x17,
-	0xa1, 0x1b, 0x1a, 0xa3, 0x7b, 0x51, 0xbc, 0x56, 0xad, 0x10, 0xc2, 0x2f, 0xe6, 0xee, 0x45, 0x7d,
-	0xd8, 0x27, 0x94, 0x86, 0x02, 0x8f, 0xc1, 0x8c, 0xc7, 0x7c, 0x7d, 0xb6, 0xad, 0x40, 0x30, 0x42,
-	0xd1, 0x5c, 0x5e, 0xf6, 0x19, 0x8f, 0xf9, 0x0f, 0xb0, 0xac, 0x6b, 0x0a, 0xec, 0x82, 0x15, 0x8d,
-	0x24, 0xdc, 0xef, 0x50, 0x21, 0xa3, 0x77, 0x17, 0xe3, 0xe6, 0x74, 0x33, 0xbf, 0xcd, 0x54, 0x0f,
-	0x95, 0xf3, 0x0a, 0x9d, 0x77, 0xb0, 0xac, 0xc5, 0x60, 0xb3, 0x8c, 0x5a, 0x8c, 0x85, 0x1d, 0x50,
-	0xc9, 0x54, 0x4e, 0x5a, 0xec, 0x7c, 0x5e, 0xe1, 0xe5, 0x51, 0xe1, 0xa4, 0xcf, 0x3e, 0x02, 0xa7,
-	0x4d, 0x53, 0x72, 0x83, 0x16, 0x46, 0x0b, 0x79, 0x25, 0x4e, 0x05, 0x9c, 0x6c, 0x6b, 0x04, 0xbc,
-	0x05, 0x16, 0x04, 0xfd, 0x1e, 0x0b, 0xdb, 0x92, 0x7a, 0xd3, 0x3c, 0x4b, 0xfb, 0x0b, 0xd1, 0xc1,
-	0x2e, 0x5a, 0x5c, 0x2d, 0x6c, 0x9c, 0x68, 0x94, 0xc3, 0xea, 0x9e, 0x29, 0xee, 0x46, 0x35, 0x3d,
-	0x2b, 0x79, 0xc4, 0x16, 0x6b, 0x12, 0x8b, 0xb4, 0xb0, 0xef, 0x53, 0x17, 0x21, 0x7d, 0x4b, 0x8d,
-	0x72, 0x52, 0xdd, 0x6d, 0x92, 0x5a, 0x58, 0x83, 0x1f, 0x80, 0xf9, 0xa4, 0xcd, 0xa5, 0x27, 0x9d,
-	0x33, 0x93, 0xe6, 0xe2, 0x62, 0x6a, 0xce, 0x26, 0x80, 0xc6, 0x6a, 0x9a, 0x6b, 0x1d, 0x6a, 0xd9,
-	0xd4, 0xc5, 0x3d, 0xb4, 0x64, 0xee, 0x6d, 0xd6, 0x54, 0x6a, 0xa6, 0xb0, 0xa3, 0xc7, 0xb5, 0x8b,
-	0xd3, 0xc7, 0x2c, 0x10, 0x3c, 0xe0, 0x12, 0xbb, 0x96, 0x4d, 0x03, 0x2e, 0x99, 0x42, 0xcb, 0x39,
-	0x5c, 0x9c, 0xc7, 0xfc, 0x7a, 0xc4, 0xd9, 0x09, 0x31, 0xf0, 0x5b, 0x50, 0x7a, 0xda, 0xe6, 0xa2,
-	0xed, 0x59, 0x01, 0x15, 0x84, 0xfa, 0x0a, 0x3b, 0x14, 0x9d, 0xcf, 0xfd, 0x2d, 0x09, 0x59, 0xf5,
-	0x18, 0x05, 0x9f, 0x80, 0xb3, 0x01, 0x96, 0x32, 0x4d, 0xbf, 0x90, 0xfb, 0xbd, 0xa6, 0x49, 0x29,
-	0xf6, 0x3a, 0x98, 0xe9, 0x70, 0xc5, 0x7c, 0x47, 0xd3, 0x19, 0xb7, 0x51, 0xc5, 0x3c, 0xc3, 0xe9,
-	0x70, 0xb0, 0x6e, 0xc6, 0xf4, 0x0e, 0x61, 0x1b, 0x07, 0x8a, 0x75, 0x86, 0xfa, 0xd1, 0x9a, 0xe9,
-	0x47, 0x73, 0xfd, 0xe2, 0x50, 0x53, 0xd2, 0xcf, 0x3c, 0xd5, 0x94, 0xd6, 0x73, 0x37, 0x25, 0x8f,
-	0xf9, 0x49, 0x53, 0xd2, 0x60, 0xdc, 0x4d, 0x83, 0x2f, 0xe5, 0x07, 0xe3, 0xee, 0x40, 0xb7, 0xb3,
-	0xe9, 0x01, 0x6e, 0xbb, 0x2a, 0x0d, 0xbf, 0x9c, 0x7b, 0x1f, 0x23, 0x58, 0x22, 0xc0, 0xc1, 0x52,
-	0xd3, 0xe5, 0xe4, 0x50, 0xb7, 0x07, 0x87, 0x4a, 0x63, 0x51, 0x55, 0x4b, 0x50, 0xd9, 0xe2, 0xae,
-	0x8d, 0xde, 0xcb, 0x2b, 0x84, 0x0c, 0xb4, 0x16, 0x33, 0xf7, 0xfb, 0x48, 0x78, 0x15, 0x94, 0x54,
-	0x57, 0x6f, 0xac, 0x65, 0xe3, 0x9e, 0xa5, 0xb0, 0x70, 0xa8, 0x42, 0x57, 0xcc, 0x06, 0x17, 0x55,
-	0xb7, 0x4e, 0xc5, 0x0e, 0xee, 0xed, 0x9b, 0xd1, 0xc1, 0x56, 0xef, 0x72, 0x2e, 0xac, 0x80, 0x28,
-	0xb4, 0x71, 0xfc, 0x56, 0xaf, 0x59, 0x75, 0xa2, 0xe0, 0xdd, 0xc8, 0x6c, 0x60, 0xfb, 0xbb, 0xb6,
-	0x54, 0x9e, 0x4e, 0x54, 0xd2, 0xe3, 0x5c, 0xb5, 0xf4, 0x0b, 0xfa, 0xaa, 0xb9, 0x27, 0xe3, 0x7b,
-	0xb6, 0xe3, 0xfa, 0x5e, 0xbf, 0xac, 0x2d, 0x91, 0x8b, 0xa5, 0xb2, 0x70, 0x10, 0xb8, 0x8c, 0xda,
-	0xe9, 0xed, 0xb9, 0x96, 0xfb, 0xa5, 0xab, 0x89, 0xdb, 0x21, 0x30, 0xd9, 0xa2, 0x6b, 0xa0, 0x64,
-	0x94, 0x8c, 0x82, 0x12, 0xcc, 0x71, 0xa8, 0x40, 0xd7, 0x4d, 0x1f, 0x3a, 0xab, 0x0b, 0xfa, 0xca,
-	0xfd, 0x70, 0x18, 0xde, 0xd1, 0xde, 0x96, 0x0a, 0x87, 0xfa, 0x24, 0xb2, 0x02, 0xbc, 0x43, 0x85,
-	0x60, 0x36, 0x45, 0x9b, 0xe6, 0x7b, 0x31, 0x1f, 0x97, 0xf5, 0xb4, 0xcf, 0xa2, 0xe2, 0xdd, 0xd5,
-	0x7f, 0x7e, 0x5a, 0x29, 0xfc, 0xf0, 0xf7, 0xcf, 0xd7, 0x16, 0x75, 0x7e, 0xee, 0xa6, 0x13, 0x74,
-	0x18, 0x66, 0xd7, 0x7e, 0x99, 0x06, 0x8b, 0x3b, 0xe1, 0xe9, 0x19, 0x09, 0xba, 0x1b, 0xe3, 0x82,
-	0xee, 0x48, 0x76, 0xbd, 0x31, 0x29, 0xbb, 0x66, 0xc6, 0xd1, 0x8b, 0x59, 0x71, 0x74, 0x30, 0x61,
-	0x5e, 0xcc, 0x4a, 0x98, 0x83, 0xa1, 0xf1, 0x72, 0x76, 0x68, 0x1c, 0x4e, 0x80, 0xeb, 0x99, 0x09,
-	0x70, 0x28, 0xce, 0xad, 0x67, 0xc6, 0xb9, 0xa1, 0x6c, 0x76, 0x6b, 0x72, 0x36, 0x1b, 0x13, 0xb4,
-	0x36, 0xc7, 0x07, 0xad, 0x8c, 0xd4, 0xf4, 0xd1, 0x51, 0xa9, 0x69, 0x6c, 0x04, 0xba, 0x73, 0x44,
-	0x04, 0x1a, 0x97, 0x67, 0xae, 0x8e, 0xcd, 0x33, 0x23, 0xe1, 0xe4, 0xf6, 0x11, 0xe1, 0x64, 0x4c,
-	0xd2, 0xb8, 0x7d, 0x44, 0xd2, 0x18, 0x13, 0x1b, 0x3e, 0x3e, 0x32, 0x36, 0x8c, 0xcd, 0x00, 0xd5,
-	0x49, 0x19, 0x20, 0xcb, 0xd0, 0x6f, 0x4d, 0x30, 0xf4, 0x59, 0xee, 0xfc, 0xd6, 0x64, 0x77, 0x7e,
-	0x6c, 0xab, 0x7d, 0x29, 0xdb, 0x6a, 0x0f, 0xf9, 0xe6, 0xcd, 0xf1, 0xbe, 0x39, 0xc3, 0x04, 0xaf,
-	0x65, 0x9a, 0xe0, 0x41, 0x47, 0x7b, 0xff, 0x35, 0x1d, 0xed, 0x11, 0xf6, 0xb4, 0xf6, 0x7a, 0xf6,
-	0x74, 0xb2, 0xd7, 0x5c, 0x1e, 0xf1, 0x9a, 0xff, 0x5b, 0xe3, 0x78, 0x63, 0x92, 0x71, 0xcc, 0xf4,
-	0x82, 0xd7, 0xc7, 0x7a, 0xc1, 0x0c, 0x63, 0x77, 0x65, 0x8c, 0xb1, 0xcb, 0xe5, 0xd2, 0xee, 0xdd,
-	0x78, 0xf6, 0xb2, 0x52, 0x78, 0xfe, 0xb2, 0x52, 0xf8, 0xeb, 0x65, 0xa5, 0xf0, 0xe3, 0xab, 0xca,
-	0xd4, 0xf3, 0x57, 0x95, 0xa9, 0xdf, 0x5f, 0x55, 0xa6, 0x9e, 0x2c, 0x8c, 0xbc, 0x68, 0x54, 0x2f,
-	0xa0, 0xb2, 0x79, 0xd2, 0xfc, 0xa0, 0xfa, 0xe1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xfb,
-	0x7f, 0x2c, 0xc9, 0x15, 0x00, 0x00,
+	// 1861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xdd, 0x99, 0xc9, 0x72, 0x14, 0x37,
+	0x18, 0xc7, 0x21, 0x18, 0x02, 0xc2, 0x36, 0x1e, 0x79, 0x13, 0x36, 0xb1, 0x8d, 0xd9, 0xcc, 0x36,
+	0xb6, 0xd9, 0x21, 0xa9, 0x54, 0x79, 0x83, 0xb8, 0x2a, 0x10, 0x67, 0x6c, 0xb2, 0x90, 0xa5, 0x4b,
+	0xd3, 0x2d, 0xf7, 0x74, 0x3c, 0xd3, 0x1a, 0x5a, 0x6a, 0x33, 0x93, 0x47, 0xc8, 0x29, 0x8f, 0x90,
+	0x47, 0x48, 0x55, 0xf2, 0x06, 0xb9, 0x70, 0xa4, 0x72, 0x4a, 0xe5, 0x40, 0xa5, 0x92, 0x43, 0xf2,
+	0x18, 0xf9, 0xa4, 0x5e, 0x67, 0xb3, 0x41, 0xe4, 0x94, 0x83, 0xc1, 0xd3, 0x92, 0x7e, 0xff, 0x91,
+	0x3e, 0xf5, 0xa7, 0x4f, 0x7f, 0xa3, 0xa9, 0x3a, 0x17, 0xf3, 0x92, 0xef, 0x30, 0x9f, 0xd7, 0x3c,
+	0x5b, 0xcc, 0xef, 0x2e, 0xce, 0xd7, 0x69, 0x40, 0x6b, 0xa2, 0x58, 0x0f, 0xb8, 0xe4, 0xb8, 0x00,
+	0xed, 0xc5, 0xac, 0xbd, 0xb8, 0xbb, 0x38, 0x51, 0xa0, 0x35, 0xcf, 0xe7, 0xf3, 0xfa, 0xdf, 0xa8,
+	0xd7, 0xc4, 0x88, 0xcb, 0x5d, 0xae, 0x7f, 0x9d, 0x57, 0xbf, 0xc5, 0x4f, 0x4f, 0xda, 0x5c, 0xd4,
+	0xb8, 0xb0, 0xa2, 0x86, 0xe8, 0x43, 0xd4, 0x34, 0xfb, 0xcb, 0x1c, 0x1a, 0xda, 0x4a, 0xa9, 0x1b,
+	0x5a, 0x11, 0x3f, 0x46, 0x43, 0x92, 0x4b, 0x5a, 0xb5, 0x44, 0x58, 0xaf, 0x57, 0x9b, 0x96, 0x4d,
+	0xeb, 0xe4, 0xe0, 0xcc, 0xc1, 0xb9, 0x63, 0xcb, 0x97, 0x9f, 0xbf, 0x9c, 0x3e, 0xf0, 0xfb, 0xcb,
+	0xe9, 0xd1, 0x08, 0x22, 0x9c, 0x9d, 0xa2, 0xc7, 0xe7, 0x6b, 0x54, 0x56, 0x8a, 0xeb, 0xbe, 0xfc,
+	0xf5, 0xe7, 0xab, 0x28, 0xa6, 0xc3, 0xa7, 0xd2, 0xa0, 0x86, 0x6c, 0x6a, 0xc6, 0x0a, 0xad, 0xe3,
+	0xaf, 0xd0, 0x88, 0x1d, 0x06, 0x01, 0xf3, 0xa5, 0x95, 0xc7, 0x93, 0xb7, 0x5e, 0x1f, 0x8d, 0x63,
+	0xd0, 0x56, 0xa6, 0x80, 0x1f, 0xa1, 0xfe, 0x08, 0x0b, 0xeb, 0x21, 0x99, 0x43, 0x0e, 0xbd, 0x3e,
+	0xf6, 0xb8, 0x06, 0x3c, 0xd4, 0xe3, 0x33, 0x5e, 0x39, 0x0c, 0x7c, 0xe0, 0xf5, 0x99, 0xf2, 0x96,
+	0xf5, 0x78, 0xfc, 0x19, 0x1a, 0xf4, 0xfc, 0xed, 0x2a, 0x95, 0x1e, 0xf7, 0xad, 0x80, 0x4a, 0x46,
+	0x0e, 0x6b, 0xe2, 0x62, 0x4c, 0x9c, 0xec, 0x24, 0x7e, 0xc8, 0x5c, 0x6a, 0x37, 0x57, 0x99, 0x9d,
+	0xe3, 0xc2, 0xa7, 0xd2, 0x40, 0x0a, 0x2a, 0x01, 0x07, 0x7f, 0x82, 0xb2, 0x07, 0x6a, 0xf6, 0xe4,
+	0x88, 0x29, 0xb8, 0x3f, 0xe5, 0xc0, 0x22, 0xb4, 0x71, 0x69, 0x83, 0xbc, 0xfd, 0x1f, 0x70, 0x69,
+	0x03, 0xbb, 0x68, 0x8c, 0xd5, 0x3c, 0x21, 0x14, 0x56, 0xd4, 0xab, 0x9e, 0xb4, 0x84, 0xa4, 0x3b,
+	0x9e, 0xef, 0x92, 0xa3, 0xa6, 0x02, 0x23, 0x09, 0x70, 0x53, 0xf1, 0x36, 0x23, 0x1c, 0xb6, 0x10,
+	0x6e, 0x13, 0xaa, 0x73, 0x9b, 0x1c, 0x33, 0x15, 0x19, 0x6a, 0x11, 0xd9, 0xe0, 0x36, 0xde, 0x41,
+	0xa4, 0x7d, 0x26, 0xec, 0x69, 0xc8, 0x7c, 0x9b, 0x05, 0x04, 0x99, 0xca, 0x8c, 0xb5, 0xce, 0x25,
+	0x01, 0x62, 0x0f, 0x8d, 0xb7, 0x89, 0xc9, 0x80, 0x51, 0x11, 0x06, 0x4d, 0x72, 0xdc, 0x54, 0x6b,
+	0xb4, 0x45, 0x6b, 0x2b, 0xe6, 0xe1, 0x2f, 0x51, 0x41, 0xed, 0x7a, 0xbd, 0x4d, 0x61, 0xcd, 0x84,
+	0xe5, 0x52, 0x41, 0xfa, 0x4d, 0x45, 0x06, 0x15, 0x4b, 0xed, 0xd4, 0x0d, 0x2e, 0x1e, 0x50, 0x81,
+	0x2b, 0x68, 0x3c, 0x4f, 0xb7, 0x2d, 0xea, 0xdb, 0x15, 0x1e, 0xa8, 0x0d, 0x30, 0x60, 0xbc, 0x01,
+	0x32, 0x0d, 0x7b, 0x29, 0xc1, 0xb5, 0x2a, 0xa5, 0xa1, 0xd1, 0xb3, 0x19, 0x7c, 0x63, 0xa5, 0x34,
+	0x32, 0x6a, 0x4e, 0x55, 0x74, 0x32, 0xa7, 0x54, 0xa3, 0x81, 0xb4, 0x6c, 0xee, 0xcb, 0x80, 0xda,
+	0x52, 0x90, 0x13, 0xc6, 0x5b, 0x21, 0xd5, 0x52, 0xc4, 0x95, 0x04, 0x88, 0xcb, 0x68, 0x24, 0x53,
+	0xa3, 0x9e, 0x05, 0x5f, 0x24, 0xf0, 0x98, 0x20, 0x43, 0xa6, 0x42, 0x85, 0x44, 0x68, 0xc9, 0xfb,
+	0x38, 0x62, 0x61, 0x8a, 0x86, 0x33, 0x8d, 0x1a, 0x13, 0x82, 0xba, 0x2a, 0x42, 0x85, 0x37, 0x96,
+	0x78, 0x98, 0xb0, 0x54, 0x22, 0x48, 0xb6, 0xb0, 0x15, 0x69, 0x31, 0xc7, 0x0b, 0x98, 0x2d, 0x09,
+	0x36, 0x8e, 0x4e, 0x02, 0x54, 0x59, 0xb7, 0x14, 0xe3, 0x30, 0x9c, 0x72, 0xdb, 0x8c, 0x45, 0x1a,
+	0xcc, 0xa7, 0xe5, 0x2a, 0xe4, 0xf3, 0x69, 0x90, 0x38, 0x5a, 0x1a, 0x84, 0xe7, 0xaa, 0xeb, 0x5a,
+	0xf4, 0x14, 0x7f, 0x8a, 0x06, 0xd3, 0x9e, 0x81, 0xca, 0x58, 0x64, 0xc6, 0x38, 0xe9, 0xc5, 0xe8,
+	0x92, 0xc2, 0xa8, 0x5c, 0x94, 0xce, 0x55, 0x29, 0x44, 0xf0, 0xd3, 0xc6, 0xb9, 0x28, 0x81, 0xdd,
+	0x67, 0x2c, 0x12, 0x78, 0x8c, 0x06, 0x20, 0xf7, 0xab, 0xbd, 0x0d, 0x07, 0xbd, 0x67, 0x33, 0x32,
+	0x6c, 0xca, 0x3e, 0x0e, 0x1c, 0xd8, 0xd3, 0x1b, 0x8a, 0x82, 0x1b, 0x68, 0x5a, 0x21, 0x61, 0x33,
+	0xef, 0xb2, 0x40, 0xc4, 0x67, 0x97, 0xc7, 0xf5, 0xee, 0xf6, 0xfc, 0xd0, 0x93, 0x4d, 0x32, 0x62,
+	0x2a, 0x74, 0x0a, 0xc8, 0x2b, 0x29, 0x58, 0x4f, 0x63, 0x25, 0xc5, 0xe2, 0x5d, 0x34, 0xd5, 0x55,
+	0x39, 0x4b, 0xb1, 0xa3, 0xa6, 0xc2, 0x93, 0x9d, 0xc2, 0x59, 0x9e, 0x7d, 0x84, 0x8e, 0xe9, 0xa4,
+	0x54, 0xad, 0x57, 0x28, 0x19, 0x33, 0x95, 0x38, 0x0a, 0x8c, 0x25, 0x85, 0xc0, 0x37, 0xd0, 0x58,
+	0xc0, 0x9e, 0xd1, 0xc0, 0x81, 0x63, 0x0e, 0x82, 0x56, 0xb3, 0x54, 0x7d, 0x11, 0xec, 0xd2, 0x2a,
+	0x19, 0x07, 0x78, 0x5f, 0x69, 0x24, 0x6a, 0xdd, 0xd4, 0x8d, 0xeb, 0x71, 0x9b, 0x1a, 0x95, 0x2d,
+	0xb1, 0xe5, 0x95, 0x6d, 0xcb, 0xae, 0x50, 0xdf, 0x67, 0x55, 0x42, 0xd4, 0x57, 0x2a, 0x8d, 0x64,
+	0xad, 0xeb, 0x65, 0x7b, 0x25, 0x6a, 0xc3, 0xd7, 0xd0, 0x68, 0x96, 0xe6, 0xf2, 0x83, 0x4e, 0xea,
+	0x41, 0xc3, 0x69, 0x63, 0x6e, 0xcc, 0x15, 0x84, 0x75, 0xa9, 0xa9, 0xfb, 0xba, 0xcc, 0x72, 0x58,
+	0x95, 0x36, 0xc9, 0x84, 0xfe, 0x6e, 0x43, 0xba, 0x65, 0x45, 0x37, 0xac, 0xaa, 0xe7, 0xaa, 0x8a,
+	0x53, 0xdb, 0x0c, 0xca, 0x47, 0x38, 0x17, 0xa0, 0x3a, 0x72, 0x18, 0xfc, 0xef, 0x49, 0x32, 0x69,
+	0x50, 0xc5, 0x01, 0x68, 0x23, 0xe6, 0xac, 0x46, 0x18, 0xfc, 0x35, 0x2a, 0x3c, 0x0d, 0x79, 0x10,
+	0xd6, 0xac, 0x3a, 0x0b, 0x6c, 0x28, 0xf1, 0xa8, 0xcb, 0xc8, 0x29, 0xe3, 0xb7, 0x24, 0x62, 0x6d,
+	0xa4, 0x28, 0xfc, 0x04, 0x9d, 0xa8, 0x53, 0x21, 0xf2, 0xf4, 0x77, 0x8c, 0xcf, 0x35, 0x45, 0xca,
+	0xb1, 0xcf, 0xa0, 0x81, 0x5d, 0x0e, 0x31, 0x71, 0x15, 0xdd, 0xe3, 0x0e, 0x99, 0xd2, 0x6b, 0xd8,
+	0x1f, 0x3d, 0xdc, 0xd0, 0xcf, 0x54, 0x84, 0xa8, 0x43, 0xeb, 0xd2, 0xdb, 0x6d, 0xcb, 0x47, 0xb3,
+	0x3a, 0x1f, 0x0d, 0x27, 0x8d, 0x6d, 0x49, 0x49, 0xad, 0x79, 0x2e, 0x29, 0x9d, 0x31, 0x4e, 0x4a,
+	0x00, 0xca, 0x92, 0x92, 0x02, 0xd3, 0x46, 0x1e, 0x7c, 0xd6, 0x1c, 0x4c, 0x1b, 0x2d, 0xd9, 0xce,
+	0x61, 0xdb, 0x34, 0xac, 0xca, 0x3c, 0xfc, 0x9c, 0x71, 0x1c, 0x63, 0x58, 0x26, 0xc0, 0xd1, 0x44,
+	0xb9, 0xca, 0xed, 0x1d, 0x95, 0x1e, 0x5c, 0x26, 0x74, 0x89, 0x2a, 0x2b, 0x01, 0x13, 0x15, 0x5e,
+	0x75, 0xc8, 0x79, 0x53, 0x21, 0xa2, 0xa1, 0x2b, 0x29, 0x73, 0x2b, 0x41, 0xe2, 0x8b, 0xa8, 0x20,
+	0x1b, 0x2a, 0xb0, 0x96, 0x43, 0x9b, 0x96, 0xa4, 0x81, 0xcb, 0x24, 0xb9, 0xa0, 0x03, 0x3c, 0x28,
+	0x1b, 0x10, 0xdc, 0x55, 0xda, 0xdc, 0xd2, 0x4f, 0x5b, 0x53, 0x7d, 0x95, 0xf3, 0xc0, 0xaa, 0xc3,
+	0x91, 0x36, 0xf7, 0xe6, 0xa9, 0x5e, 0xb1, 0x36, 0xe0, 0x38, 0xbb, 0x17, 0x17, 0x1b, 0xd4, 0xf9,
+	0x26, 0x14, 0xb2, 0xa6, 0x6e, 0x54, 0xd0, 0x9b, 0xcb, 0x8a, 0x3a, 0xa0, 0x2f, 0xea, 0xef, 0xa4,
+	0xeb, 0x9e, 0xa5, 0xb4, 0x7d, 0x33, 0x69, 0x56, 0x25, 0x51, 0x95, 0x0a, 0x69, 0x51, 0xb8, 0x34,
+	0x79, 0xcc, 0xc9, 0x87, 0xe7, 0x92, 0xf1, 0xa1, 0xab, 0x88, 0x4b, 0x11, 0x30, 0x0b, 0xd1, 0x25,
+	0x54, 0xd0, 0x4a, 0x5a, 0x41, 0x06, 0x9e, 0xeb, 0x42, 0xca, 0xbe, 0xac, 0xf3, 0xd0, 0x09, 0xd5,
+	0xa0, 0x7a, 0x6e, 0x45, 0x8f, 0xf1, 0x2d, 0x55, 0xdb, 0x32, 0x58, 0x3d, 0xdf, 0x8e, 0x4b, 0x01,
+	0x0e, 0xc9, 0x39, 0xf0, 0x1c, 0x46, 0xae, 0xe8, 0xf7, 0x62, 0x34, 0x6d, 0x56, 0xc3, 0x3e, 0x8a,
+	0x1b, 0xd5, 0x4a, 0xa4, 0x4b, 0x9d, 0x14, 0x0f, 0xe9, 0x1b, 0x75, 0x55, 0x8f, 0x1c, 0x4f, 0x3a,
+	0x24, 0xd5, 0x40, 0xf2, 0x56, 0x41, 0x3d, 0xdd, 0x39, 0x36, 0x5a, 0x89, 0xa2, 0x71, 0x3d, 0xdd,
+	0x2e, 0x16, 0x2d, 0x45, 0x80, 0x4e, 0xa5, 0x0a, 0x92, 0x5b, 0x0c, 0x46, 0x34, 0x85, 0x64, 0x35,
+	0xcb, 0x0d, 0xa8, 0x0f, 0x05, 0xe2, 0xbc, 0xa9, 0xde, 0xc9, 0x04, 0xbb, 0xc5, 0xd7, 0x12, 0xe8,
+	0x03, 0xcd, 0x84, 0xe9, 0x91, 0xbc, 0x66, 0x39, 0x6c, 0x42, 0x9d, 0x1d, 0xc5, 0x9b, 0x2c, 0x18,
+	0x47, 0x3a, 0xd3, 0x5b, 0x0e, 0x9b, 0x4b, 0xbe, 0x0e, 0x37, 0xf6, 0xd1, 0x44, 0x5e, 0xca, 0xf3,
+	0x61, 0x05, 0xa0, 0xa6, 0x67, 0xd6, 0x76, 0xe8, 0x3b, 0x64, 0xd1, 0x54, 0x6c, 0x3c, 0x13, 0x5b,
+	0x4f, 0x90, 0xf7, 0x81, 0xa8, 0x8a, 0xed, 0xbc, 0x1e, 0xbc, 0xa2, 0x8c, 0x06, 0x76, 0x25, 0x92,
+	0xbb, 0x66, 0x5c, 0x6c, 0x67, 0x72, 0xa5, 0x98, 0xa8, 0xd5, 0xde, 0x47, 0x93, 0xd9, 0xd6, 0x6a,
+	0x30, 0x3b, 0xd4, 0xc9, 0x26, 0x3d, 0xc4, 0xaf, 0xeb, 0xf7, 0x2d, 0xfd, 0x42, 0x6b, 0x49, 0x8f,
+	0xf4, 0x24, 0x5f, 0x40, 0xfa, 0xfd, 0xc8, 0xf6, 0x58, 0x85, 0x79, 0x6e, 0x45, 0x92, 0x1b, 0x30,
+	0xf0, 0x50, 0x09, 0xab, 0xb6, 0x64, 0xb7, 0x7c, 0xa0, 0x5b, 0x70, 0x0d, 0x9d, 0xa2, 0xb6, 0x1d,
+	0xd6, 0x42, 0xb8, 0x33, 0xc3, 0x2b, 0x9a, 0x0e, 0x54, 0xb7, 0x68, 0xfe, 0x4c, 0x90, 0x9b, 0xaf,
+	0x7f, 0xd6, 0x4e, 0xe4, 0x80, 0x89, 0xda, 0x7a, 0x84, 0x53, 0xe1, 0x53, 0xa7, 0x40, 0x2a, 0xa3,
+	0x92, 0x5c, 0x3a, 0x51, 0x72, 0xcb, 0x38, 0x7c, 0x00, 0x4d, 0xa4, 0x20, 0x3f, 0xa6, 0x0b, 0xa3,
+	0xc2, 0xb7, 0xcd, 0xe1, 0xa9, 0x4e, 0x43, 0xbe, 0x1f, 0xc6, 0x1e, 0x4b, 0x64, 0x8a, 0xdc, 0x36,
+	0x0e, 0x5f, 0xc2, 0x5c, 0xd2, 0xc8, 0x38, 0x0f, 0x31, 0xbc, 0x88, 0x46, 0xf3, 0x7b, 0x3f, 0x0b,
+	0xdc, 0x1d, 0x1d, 0x38, 0x5c, 0x4e, 0xf7, 0x71, 0x1a, 0xb1, 0x2f, 0xd0, 0x70, 0x7a, 0xd3, 0x06,
+	0xa6, 0x57, 0xf7, 0x98, 0x7a, 0x4b, 0xef, 0xce, 0x1c, 0x9a, 0x3b, 0x7e, 0xed, 0x6c, 0xb1, 0xc3,
+	0x8a, 0x2b, 0xae, 0xc5, 0xbd, 0x4b, 0x49, 0xe7, 0xe5, 0x3e, 0x35, 0x81, 0x52, 0x6a, 0x3f, 0xa4,
+	0x0d, 0x02, 0xca, 0xcb, 0xb1, 0x96, 0x04, 0x67, 0xb9, 0x21, 0x54, 0x7f, 0x1e, 0xf5, 0xc9, 0x3d,
+	0x3d, 0x75, 0x02, 0xf3, 0x1a, 0x89, 0xe7, 0xb5, 0xe4, 0x38, 0xb0, 0xb9, 0x05, 0x94, 0x86, 0x90,
+	0xba, 0xa3, 0x9b, 0x67, 0x92, 0xfa, 0x1e, 0xc4, 0xa3, 0xf0, 0x6d, 0x44, 0x5a, 0x79, 0xfa, 0x44,
+	0x57, 0xc7, 0x98, 0x20, 0xef, 0xea, 0x29, 0x8e, 0xe6, 0xc7, 0x3d, 0x84, 0x63, 0x5a, 0x37, 0xe2,
+	0xf3, 0xe8, 0x44, 0xd4, 0x4d, 0x07, 0xbc, 0x09, 0x1b, 0x9e, 0xbc, 0xa7, 0xfb, 0x0f, 0x44, 0x8f,
+	0x21, 0x66, 0x9f, 0xc3, 0xc3, 0x7b, 0x33, 0xff, 0xfc, 0x30, 0x7d, 0xf0, 0xbb, 0xbf, 0x7f, 0xbc,
+	0x34, 0xae, 0x3c, 0xca, 0x46, 0xde, 0xa5, 0x8c, 0x0c, 0xc3, 0xd9, 0x9f, 0xfa, 0xd1, 0xf8, 0x6a,
+	0x74, 0x42, 0x77, 0x98, 0x89, 0x73, 0xbd, 0xcc, 0xc4, 0x0e, 0x7f, 0x70, 0x61, 0x2f, 0x7f, 0xb0,
+	0xab, 0xe5, 0x77, 0xba, 0x9b, 0xe5, 0xd7, 0xea, 0xe2, 0x9d, 0xee, 0xe6, 0xe2, 0xb5, 0x1a, 0x73,
+	0xe7, 0xba, 0x1b, 0x73, 0xed, 0x2e, 0xdb, 0x99, 0xae, 0x2e, 0x5b, 0x9b, 0x65, 0x76, 0xa6, 0xab,
+	0x65, 0xd6, 0xe6, 0x7f, 0xdd, 0xd8, 0xdb, 0xff, 0xea, 0x61, 0x66, 0x5d, 0xe9, 0x6d, 0x66, 0x75,
+	0x71, 0xa6, 0xee, 0xec, 0xe7, 0x4c, 0xf5, 0xb4, 0x99, 0x6e, 0xed, 0x63, 0x33, 0xf5, 0xf2, 0x8c,
+	0x2e, 0xf6, 0xf4, 0x8c, 0x3a, 0x0c, 0xa0, 0x9b, 0xfb, 0x18, 0x40, 0x3d, 0xdc, 0x9c, 0x9b, 0xfb,
+	0xb8, 0x39, 0x3d, 0xac, 0x99, 0xbb, 0xfb, 0x5a, 0x33, 0x3d, 0x7d, 0x96, 0xf9, 0xbd, 0x7c, 0x96,
+	0x6e, 0xa6, 0x49, 0x71, 0x0f, 0xd3, 0xa4, 0x9b, 0x03, 0x72, 0x63, 0x6f, 0x07, 0xe4, 0x8d, 0xed,
+	0x8c, 0xb3, 0xdd, 0xed, 0x8c, 0x36, 0x6f, 0xe2, 0x4a, 0x6f, 0x6f, 0xa2, 0x8b, 0xd1, 0x30, 0xdb,
+	0xd5, 0x68, 0x68, 0x75, 0x0d, 0xd6, 0x5e, 0xd1, 0x35, 0xd8, 0xc7, 0x02, 0x58, 0x79, 0x35, 0x0b,
+	0x60, 0xef, 0xfb, 0xfc, 0x64, 0xc7, 0x7d, 0xfe, 0x7f, 0x7b, 0x39, 0x5f, 0xd8, 0xeb, 0x72, 0xde,
+	0xf5, 0xbe, 0x7d, 0xb9, 0xe7, 0x7d, 0xbb, 0xcb, 0xe5, 0xf9, 0x42, 0x8f, 0xcb, 0xb3, 0xd1, 0x4d,
+	0x78, 0xf6, 0x5b, 0x54, 0xe8, 0x38, 0x37, 0xf1, 0x18, 0x3a, 0x52, 0xe3, 0x4e, 0x58, 0x65, 0xf1,
+	0x21, 0x11, 0x7f, 0xc2, 0x0f, 0xd0, 0x61, 0x51, 0xa1, 0x01, 0x8b, 0xff, 0x5a, 0x64, 0x50, 0x1f,
+	0x44, 0xe3, 0xef, 0xf5, 0xa9, 0xd3, 0x6c, 0x79, 0xe1, 0xf9, 0x9f, 0x53, 0x07, 0x5f, 0xc0, 0xcf,
+	0x1f, 0xf0, 0xf3, 0xfd, 0x5f, 0x53, 0x07, 0x5e, 0xc0, 0xcf, 0x6f, 0xf0, 0xf3, 0x64, 0xac, 0xe3,
+	0x90, 0x93, 0xcd, 0x3a, 0x13, 0xe5, 0x23, 0xfa, 0x0f, 0x66, 0xd7, 0xff, 0x05, 0xf2, 0x82, 0x99,
+	0xb9, 0xa9, 0x1b, 0x00, 0x00,
 }
 
 func (this *TokenomicsParams) Equal(that interface{}) bool {
@@ -841,6 +954,86 @@ func (this *TokenomicsParams) Equal(that interface{}) bool {
 	if this.EmergencyBurnOverride != that1.EmergencyBurnOverride {
 		return false
 	}
+	if this.TreasuryRedirectEnabled != that1.TreasuryRedirectEnabled {
+		return false
+	}
+	if !this.TreasuryRedirectRatio.Equal(that1.TreasuryRedirectRatio) {
+		return false
+	}
+	if !this.RedirectToEcosystemGrants.Equal(that1.RedirectToEcosystemGrants) {
+		return false
+	}
+	if !this.RedirectToBuyAndBurn.Equal(that1.RedirectToBuyAndBurn) {
+		return false
+	}
+	if !this.RedirectToInsuranceFund.Equal(that1.RedirectToInsuranceFund) {
+		return false
+	}
+	if !this.RedirectToResearchFund.Equal(that1.RedirectToResearchFund) {
+		return false
+	}
+	if this.RedirectExecutionInterval != that1.RedirectExecutionInterval {
+		return false
+	}
+	if this.LastRedirectHeight != that1.LastRedirectHeight {
+		return false
+	}
+	if !this.AccumulatedRedirectInflows.Equal(that1.AccumulatedRedirectInflows) {
+		return false
+	}
+	if !this.MaxRedirectPerExecution.Equal(that1.MaxRedirectPerExecution) {
+		return false
+	}
+	if !this.ForecastAnnualBurnRate.Equal(that1.ForecastAnnualBurnRate) {
+		return false
+	}
+	if this.BuyAndBurnInterval != that1.BuyAndBurnInterval {
+		return false
+	}
+	if len(this.EmissionRecipients) != len(that1.EmissionRecipients) {
+		return false
+	}
+	for i := range this.EmissionRecipients {
+		if !this.EmissionRecipients[i].Equal(&that1.EmissionRecipients[i]) {
+			return false
+		}
+	}
+	if this.BurnOverrideGuardian != that1.BurnOverrideGuardian {
+		return false
+	}
+	if this.BurnOverrideMaxBlocks != that1.BurnOverrideMaxBlocks {
+		return false
+	}
+	if this.BlocksPerYear != that1.BlocksPerYear {
+		return false
+	}
+	return true
+}
+func (this *EmissionRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmissionRecipient)
+	if !ok {
+		that2, ok := that.(EmissionRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Module != that1.Module {
+		return false
+	}
+	if !this.Share.Equal(that1.Share) {
+		return false
+	}
 	return true
 }
 func (m *TokenomicsParams) Marshal() (dAtA []byte, err error) {
@@ -863,6 +1056,174 @@ func (m *TokenomicsParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.BurnOverrideMaxBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BurnOverrideMaxBlocks))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.BurnOverrideGuardian) > 0 {
+		i -= len(m.BurnOverrideGuardian)
+		copy(dAtA[i:], m.BurnOverrideGuardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BurnOverrideGuardian)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.EmissionRecipients) > 0 {
+		for iNdEx := len(m.EmissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.BuyAndBurnInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BuyAndBurnInterval))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	{
+		size := m.ForecastAnnualBurnRate.Size()
+		i -= size
+		if _, err := m.ForecastAnnualBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.MaxRedirectPerExecution.Size()
+		i -= size
+		if _, err := m.MaxRedirectPerExecution.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.AccumulatedRedirectInflows.Size()
+		i -= size
+		if _, err := m.AccumulatedRedirectInflows.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xaa
+	if m.LastRedirectHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LastRedirectHeight))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RedirectExecutionInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedirectExecutionInterval))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.RedirectToResearchFund.Size()
+		i -= size
+		if _, err := m.RedirectToResearchFund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.RedirectToInsuranceFund.Size()
+		i -= size
+		if _, err := m.RedirectToInsuranceFund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.RedirectToBuyAndBurn.Size()
+		i -= size
+		if _, err := m.RedirectToBuyAndBurn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.RedirectToEcosystemGrants.Size()
+		i -= size
+		if _, err := m.RedirectToEcosystemGrants.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xfa
+	{
+		size := m.TreasuryRedirectRatio.Size()
+		i -= size
+		if _, err := m.TreasuryRedirectRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xf2
+	if m.TreasuryRedirectEnabled {
+		i--
+		if m.TreasuryRedirectEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
 	if m.EmergencyBurnOverride {
 		i--
 		if m.EmergencyBurnOverride {
@@ -1617,6 +1978,46 @@ func (m *DefaultTokenomicsParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EmissionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -1736,6 +2137,50 @@ func (m *TokenomicsParams) Size() (n int) {
 	if m.EmergencyBurnOverride {
 		n += 3
 	}
+	if m.TreasuryRedirectEnabled {
+		n += 3
+	}
+	l = m.TreasuryRedirectRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RedirectToEcosystemGrants.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RedirectToBuyAndBurn.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RedirectToInsuranceFund.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RedirectToResearchFund.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.RedirectExecutionInterval != 0 {
+		n += 2 + sovParams(uint64(m.RedirectExecutionInterval))
+	}
+	if m.LastRedirectHeight != 0 {
+		n += 2 + sovParams(uint64(m.LastRedirectHeight))
+	}
+	l = m.AccumulatedRedirectInflows.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxRedirectPerExecution.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ForecastAnnualBurnRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.BuyAndBurnInterval != 0 {
+		n += 2 + sovParams(uint64(m.BuyAndBurnInterval))
+	}
+	if len(m.EmissionRecipients) > 0 {
+		for _, e := range m.EmissionRecipients {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.BurnOverrideGuardian)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.BurnOverrideMaxBlocks != 0 {
+		n += 2 + sovParams(uint64(m.BurnOverrideMaxBlocks))
+	}
+	if m.BlocksPerYear != 0 {
+		n += 2 + sovParams(uint64(m.BlocksPerYear))
+	}
 	return n
 }
 
@@ -1876,13 +2321,28 @@ func (m *DefaultTokenomicsParams) Size() (n int) {
 	return n
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *TokenomicsParams) Unmarshal(dAtA []byte) error {
+func (m *EmissionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenomicsParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3284,61 +3744,11 @@ func (m *TokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EmergencyBurnOverride = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DefaultTokenomicsParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DefaultTokenomicsParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyCap", wireType)
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryRedirectEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3348,27 +3758,15 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalSupplyCap = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.TreasuryRedirectEnabled = bool(v != 0)
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryRedirectRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3396,11 +3794,13 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentTotalSupply = string(dAtA[iNdEx:postIndex])
+			if err := m.TreasuryRedirectRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectToEcosystemGrants", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3428,11 +3828,13 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalMinted = string(dAtA[iNdEx:postIndex])
+			if err := m.RedirectToEcosystemGrants.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectToBuyAndBurn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3460,11 +3862,13 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalBurned = string(dAtA[iNdEx:postIndex])
+			if err := m.RedirectToBuyAndBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectToInsuranceFund", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3492,11 +3896,13 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InflationRate = string(dAtA[iNdEx:postIndex])
+			if err := m.RedirectToInsuranceFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectToResearchFund", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3524,13 +3930,15 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InflationMin = string(dAtA[iNdEx:postIndex])
+			if err := m.RedirectToResearchFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectExecutionInterval", wireType)
 			}
-			var stringLen uint64
+			m.RedirectExecutionInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3540,29 +3948,16 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RedirectExecutionInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InflationMax = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitStaking", wireType)
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedirectHeight", wireType)
 			}
-			var stringLen uint64
+			m.LastRedirectHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3572,27 +3967,14 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastRedirectHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EmissionSplitStaking = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitPoc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedRedirectInflows", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3620,11 +4002,13 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmissionSplitPoc = string(dAtA[iNdEx:postIndex])
+			if err := m.AccumulatedRedirectInflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitSequencer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedirectPerExecution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3652,11 +4036,13 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmissionSplitSequencer = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxRedirectPerExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitTreasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastAnnualBurnRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3684,13 +4070,15 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EmissionSplitTreasury = string(dAtA[iNdEx:postIndex])
+			if err := m.ForecastAnnualBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatePosGas", wireType)
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyAndBurnInterval", wireType)
 			}
-			var stringLen uint64
+			m.BuyAndBurnInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3700,29 +4088,16 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BuyAndBurnInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BurnRatePosGas = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatePocAnchoring", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRecipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3732,27 +4107,29 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnRatePocAnchoring = string(dAtA[iNdEx:postIndex])
+			m.EmissionRecipients = append(m.EmissionRecipients, EmissionRecipient{})
+			if err := m.EmissionRecipients[len(m.EmissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateSequencerGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnOverrideGuardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3780,13 +4157,13 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnRateSequencerGas = string(dAtA[iNdEx:postIndex])
+			m.BurnOverrideGuardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateSmartContracts", wireType)
+		case 59:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnOverrideMaxBlocks", wireType)
 			}
-			var stringLen uint64
+			m.BurnOverrideMaxBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3796,29 +4173,16 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BurnOverrideMaxBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BurnRateSmartContracts = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateAiQueries", wireType)
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
 			}
-			var stringLen uint64
+			m.BlocksPerYear = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -3828,40 +4192,589 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnRateAiQueries = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateMessaging", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefaultTokenomicsParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefaultTokenomicsParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupplyCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentTotalSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalMinted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitStaking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSplitStaking = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitPoc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSplitPoc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitSequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSplitSequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSplitTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSplitTreasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatePosGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRatePosGas = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatePocAnchoring", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRatePocAnchoring = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateSequencerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRateSequencerGas = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateSmartContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRateSmartContracts = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateAiQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRateAiQueries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateMessaging", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
@@ -4360,6 +5273,122 @@ func (m *DefaultTokenomicsParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EmissionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -164,6 +164,9 @@ type QuerySupplyResponse struct {
 	SupplyPctOfCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=supply_pct_of_cap,json=supplyPctOfCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"supply_pct_of_cap"`
 	// net_inflation_rate is the effective growth rate (minting - burning)
 	NetInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=net_inflation_rate,json=netInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"net_inflation_rate"`
+	// circulating_supply is current_total_supply minus balances held by
+	// non-circulating accounts (treasury, timelock, vesting, unclaimed emissions)
+	CirculatingSupply cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=circulating_supply,json=circulatingSupply,proto3,customtype=cosmossdk.io/math.Int" json:"circulating_supply"`
 }
 
 func (m *QuerySupplyResponse) Reset()         { *m = QuerySupplyResponse{} }
@@ -238,7 +241,7 @@ var xxx_messageInfo_QueryInflationRequest proto.InternalMessageInfo
 
 // QueryInflationResponse is response type for the Query/Inflation RPC method.
 type QueryInflationResponse struct {
-	// current_inflation_rate is the current annual inflation (0.01-0.05)
+	// current_inflation_rate is the current annual inflation (0.005-0.03)
 	CurrentInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=current_inflation_rate,json=currentInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"current_inflation_rate"`
 	// inflation_min is the DAO floor
 	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min"`
@@ -1540,2189 +1543,11298 @@ func (m *QueryBurnRateResponse) GetEmergencyBurnOverride() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pos.tokenomics.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pos.tokenomics.v1.QueryParamsResponse")
-	proto.RegisterType((*QuerySupplyRequest)(nil), "pos.tokenomics.v1.QuerySupplyRequest")
-	proto.RegisterType((*QuerySupplyResponse)(nil), "pos.tokenomics.v1.QuerySupplyResponse")
-	proto.RegisterType((*QueryInflationRequest)(nil), "pos.tokenomics.v1.QueryInflationRequest")
-	proto.RegisterType((*QueryInflationResponse)(nil), "pos.tokenomics.v1.QueryInflationResponse")
-	proto.RegisterType((*QueryEmissionsRequest)(nil), "pos.tokenomics.v1.QueryEmissionsRequest")
-	proto.RegisterType((*EmissionAllocation)(nil), "pos.tokenomics.v1.EmissionAllocation")
-	proto.RegisterType((*QueryEmissionsResponse)(nil), "pos.tokenomics.v1.QueryEmissionsResponse")
-	proto.RegisterType((*QueryBurnsRequest)(nil), "pos.tokenomics.v1.QueryBurnsRequest")
-	proto.RegisterType((*BurnRecord)(nil), "pos.tokenomics.v1.BurnRecord")
-	proto.RegisterType((*QueryBurnsResponse)(nil), "pos.tokenomics.v1.QueryBurnsResponse")
-	proto.RegisterType((*QueryBurnsBySourceRequest)(nil), "pos.tokenomics.v1.QueryBurnsBySourceRequest")
-	proto.RegisterType((*BurnsBySourceStats)(nil), "pos.tokenomics.v1.BurnsBySourceStats")
-	proto.RegisterType((*QueryBurnsBySourceResponse)(nil), "pos.tokenomics.v1.QueryBurnsBySourceResponse")
-	proto.RegisterType((*QueryBurnsByChainRequest)(nil), "pos.tokenomics.v1.QueryBurnsByChainRequest")
-	proto.RegisterType((*QueryBurnsByChainResponse)(nil), "pos.tokenomics.v1.QueryBurnsByChainResponse")
-	proto.RegisterType((*QueryTreasuryRequest)(nil), "pos.tokenomics.v1.QueryTreasuryRequest")
-	proto.RegisterType((*QueryTreasuryResponse)(nil), "pos.tokenomics.v1.QueryTreasuryResponse")
-	proto.RegisterType((*QueryProjectionsRequest)(nil), "pos.tokenomics.v1.QueryProjectionsRequest")
-	proto.RegisterType((*SupplyProjection)(nil), "pos.tokenomics.v1.SupplyProjection")
-	proto.RegisterType((*QueryProjectionsResponse)(nil), "pos.tokenomics.v1.QueryProjectionsResponse")
-	proto.RegisterType((*QueryChainMetricsRequest)(nil), "pos.tokenomics.v1.QueryChainMetricsRequest")
-	proto.RegisterType((*QueryChainMetricsResponse)(nil), "pos.tokenomics.v1.QueryChainMetricsResponse")
-	proto.RegisterType((*QueryFeeStatsRequest)(nil), "pos.tokenomics.v1.QueryFeeStatsRequest")
-	proto.RegisterType((*QueryFeeStatsResponse)(nil), "pos.tokenomics.v1.QueryFeeStatsResponse")
-	proto.RegisterType((*QueryBurnRateRequest)(nil), "pos.tokenomics.v1.QueryBurnRateRequest")
-	proto.RegisterType((*QueryBurnRateResponse)(nil), "pos.tokenomics.v1.QueryBurnRateResponse")
+// QueryStreamBurnEventsRequest is request type for the Query/StreamBurnEvents RPC method.
+type QueryStreamBurnEventsRequest struct {
 }
 
-func init() { proto.RegisterFile("pos/tokenomics/v1/query.proto", fileDescriptor_ff681edaa2931a07) }
+func (m *QueryStreamBurnEventsRequest) Reset()         { *m = QueryStreamBurnEventsRequest{} }
+func (m *QueryStreamBurnEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamBurnEventsRequest) ProtoMessage()    {}
+func (*QueryStreamBurnEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{28}
+}
+func (m *QueryStreamBurnEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamBurnEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamBurnEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamBurnEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamBurnEventsRequest.Merge(m, src)
+}
+func (m *QueryStreamBurnEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamBurnEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamBurnEventsRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_ff681edaa2931a07 = []byte{
-	// 2415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
-	0xf5, 0xf7, 0xea, 0x4a, 0x1d, 0x8a, 0xb2, 0x34, 0x96, 0x25, 0x8a, 0xb6, 0x64, 0x87, 0x8e, 0x6d,
-	0xf9, 0x26, 0x46, 0xfe, 0xc3, 0x7f, 0x34, 0x8f, 0x92, 0x1c, 0x25, 0x42, 0xeb, 0x9a, 0x59, 0x2b,
-	0x49, 0x9d, 0xc6, 0xdd, 0x0e, 0x97, 0xa3, 0xd5, 0xd6, 0xe4, 0xee, 0x66, 0x76, 0x48, 0x93, 0x0d,
-	0xfc, 0x92, 0xa6, 0x01, 0xfa, 0x52, 0xb4, 0x28, 0xd0, 0x00, 0x45, 0xd0, 0xe7, 0x02, 0x7d, 0x68,
-	0x0b, 0xf4, 0x43, 0xe4, 0x31, 0x68, 0x5f, 0x8a, 0x3e, 0x04, 0x85, 0x5d, 0xa0, 0xfd, 0x10, 0x05,
-	0x5a, 0xcc, 0x99, 0xd9, 0x0b, 0x6f, 0x92, 0xbd, 0xca, 0x43, 0x5e, 0x12, 0x71, 0x2e, 0xbf, 0x73,
-	0xe6, 0xcc, 0x6f, 0xce, 0x6d, 0x0d, 0xab, 0x81, 0x1f, 0x56, 0x84, 0xff, 0x98, 0x79, 0x7e, 0xd3,
-	0xb5, 0xc3, 0x4a, 0x7b, 0xb3, 0xf2, 0x61, 0x8b, 0xf1, 0xee, 0x46, 0xc0, 0x7d, 0xe1, 0x93, 0x85,
-	0xc0, 0x0f, 0x37, 0x92, 0xe9, 0x8d, 0xf6, 0x66, 0x69, 0x81, 0x36, 0x5d, 0xcf, 0xaf, 0xe0, 0x7f,
-	0xd5, 0xaa, 0xd2, 0x75, 0xdb, 0x0f, 0x9b, 0x7e, 0x58, 0xa9, 0xd1, 0x90, 0xa9, 0xed, 0x95, 0xf6,
-	0x66, 0x8d, 0x09, 0xba, 0x59, 0x09, 0xa8, 0xe3, 0x7a, 0x54, 0xb8, 0xbe, 0xa7, 0xd7, 0xae, 0xa8,
-	0xb5, 0x16, 0xfe, 0xaa, 0xa8, 0x1f, 0x7a, 0x6a, 0xd1, 0xf1, 0x1d, 0x5f, 0x8d, 0xcb, 0xbf, 0xf4,
-	0xe8, 0x79, 0xc7, 0xf7, 0x9d, 0x06, 0xab, 0xd0, 0xc0, 0xad, 0x50, 0xcf, 0xf3, 0x05, 0xa2, 0x45,
-	0x7b, 0xd6, 0x06, 0xf5, 0x0f, 0x28, 0xa7, 0xcd, 0x68, 0xbe, 0x34, 0x38, 0x2f, 0x3a, 0x6a, 0xae,
-	0xbc, 0x08, 0xe4, 0x6d, 0xa9, 0x6c, 0x15, 0x37, 0x98, 0xec, 0xc3, 0x16, 0x0b, 0x45, 0xf9, 0x11,
-	0x9c, 0xe9, 0x19, 0x0d, 0x03, 0xdf, 0x0b, 0x19, 0xd9, 0x85, 0x29, 0x05, 0x5c, 0x34, 0x2e, 0x1a,
-	0xeb, 0xf9, 0xdb, 0x97, 0x36, 0x06, 0x4c, 0xb3, 0xb1, 0x1f, 0xff, 0x52, 0x9b, 0xb7, 0x67, 0xbe,
-	0xf8, 0xea, 0xc2, 0xa9, 0xdf, 0xfd, 0xeb, 0x8f, 0xd7, 0x0d, 0x53, 0xef, 0x8e, 0x85, 0x3e, 0x68,
-	0x05, 0x41, 0xa3, 0x1b, 0x09, 0xfd, 0x74, 0x52, 0x4b, 0x8d, 0x86, 0xb5, 0xd4, 0x77, 0x60, 0x5e,
-	0xf8, 0x82, 0x36, 0xac, 0x10, 0xc7, 0x2d, 0x9b, 0x06, 0x28, 0x7f, 0x66, 0xfb, 0x86, 0x84, 0xfe,
-	0xfb, 0x57, 0x17, 0xce, 0x2a, 0x13, 0x86, 0xf5, 0xc7, 0x1b, 0xae, 0x5f, 0x69, 0x52, 0x71, 0xb8,
-	0xb1, 0xe7, 0x89, 0xbf, 0xfc, 0xf9, 0x16, 0x68, 0xdb, 0xee, 0x79, 0xc2, 0x9c, 0x43, 0x10, 0x85,
-	0xbd, 0x43, 0x03, 0xf2, 0x08, 0x16, 0xed, 0x16, 0xe7, 0xcc, 0x13, 0x56, 0x1a, 0xbe, 0x38, 0xf6,
-	0xf2, 0xd0, 0x44, 0x03, 0xed, 0x27, 0x12, 0xc8, 0x77, 0x61, 0x56, 0xc1, 0x36, 0x5d, 0x4f, 0xb0,
-	0x7a, 0x71, 0xfc, 0xe5, 0x61, 0xf3, 0x08, 0x70, 0x0f, 0xf7, 0x27, 0x78, 0xb5, 0x16, 0xf7, 0x58,
-	0xbd, 0x38, 0x91, 0x15, 0x6f, 0x1b, 0xf7, 0x93, 0xf7, 0x81, 0x70, 0xd6, 0xa4, 0xae, 0xe7, 0x7a,
-	0x0e, 0xea, 0x48, 0x6b, 0x0d, 0x56, 0x9c, 0x7c, 0x79, 0xd4, 0x85, 0x18, 0xe6, 0x9e, 0x46, 0x21,
-	0x1f, 0xc0, 0x82, 0xbe, 0xab, 0xc0, 0x16, 0x96, 0x7f, 0x80, 0x57, 0x36, 0x85, 0xd0, 0x9b, 0x1a,
-	0xfa, 0xdc, 0x20, 0xf4, 0x77, 0x98, 0x43, 0xed, 0xee, 0x5d, 0x66, 0xa7, 0x04, 0xdc, 0x65, 0xb6,
-	0x39, 0xa7, 0xb0, 0xaa, 0xb6, 0xb8, 0x7f, 0x20, 0x2f, 0xce, 0x02, 0xe2, 0x31, 0x61, 0xb9, 0xde,
-	0x41, 0x03, 0x9f, 0x81, 0xc5, 0xa9, 0x60, 0xc5, 0xe9, 0xac, 0xf0, 0xf3, 0x1e, 0x13, 0x7b, 0x11,
-	0x96, 0x49, 0x05, 0x2b, 0x2f, 0xc3, 0x59, 0xe4, 0x61, 0x32, 0xaa, 0x19, 0xfa, 0xcb, 0x09, 0x58,
-	0xea, 0x9f, 0xd1, 0x24, 0x75, 0x60, 0x29, 0x62, 0x53, 0x9f, 0x62, 0x46, 0x56, 0xc5, 0x22, 0x7a,
-	0xf6, 0x28, 0x47, 0xde, 0x85, 0x42, 0x22, 0xa0, 0xe9, 0x7a, 0x9a, 0xaf, 0x19, 0xf0, 0x67, 0x63,
-	0x9c, 0x7b, 0xae, 0xd7, 0x87, 0x4b, 0x3b, 0x9a, 0xb0, 0x27, 0xc3, 0xa5, 0x1d, 0xf2, 0x3d, 0x58,
-	0xa0, 0x9e, 0xd7, 0xa2, 0x0d, 0xe9, 0xed, 0xda, 0x6e, 0x28, 0xfd, 0x56, 0x16, 0xf2, 0xce, 0x2b,
-	0x94, 0x6a, 0x0c, 0x42, 0x3e, 0x80, 0xf9, 0x5a, 0xc3, 0xb7, 0x1f, 0xa7, 0x81, 0x27, 0xb3, 0x2a,
-	0x7d, 0x1a, 0xa1, 0x52, 0xe8, 0x57, 0x40, 0x0d, 0x85, 0x56, 0xc0, 0xb8, 0xd5, 0x65, 0x94, 0x23,
-	0x83, 0x27, 0xcc, 0x82, 0x1a, 0xae, 0x32, 0xfe, 0x90, 0x51, 0x1e, 0x93, 0xe5, 0x8d, 0xa6, 0x1b,
-	0xe2, 0xce, 0x88, 0x2c, 0x7f, 0x18, 0x03, 0x12, 0x0d, 0x6e, 0x35, 0x1a, 0xbe, 0x8d, 0x26, 0x21,
-	0x25, 0xc8, 0xd9, 0x54, 0x30, 0xc7, 0xe7, 0x5d, 0x45, 0x0d, 0x33, 0xfe, 0x4d, 0xde, 0x06, 0x08,
-	0x18, 0xb7, 0x99, 0x27, 0xa8, 0xc3, 0xb2, 0x5f, 0x6c, 0x0a, 0x84, 0x54, 0xa1, 0xa0, 0xcd, 0x4f,
-	0x9b, 0x7e, 0xcb, 0x13, 0x59, 0xfc, 0xd0, 0xac, 0x42, 0xd8, 0x42, 0x00, 0x79, 0xa1, 0xca, 0x11,
-	0xd5, 0xdd, 0x50, 0x70, 0xb7, 0xd6, 0x12, 0xd9, 0xbc, 0x91, 0x72, 0xea, 0x77, 0x13, 0x90, 0xf2,
-	0x27, 0x63, 0xfa, 0x79, 0xa5, 0x6c, 0xa9, 0x9f, 0xd7, 0x3d, 0xc8, 0xd3, 0xd8, 0x86, 0x32, 0xfc,
-	0x8c, 0xaf, 0xe7, 0x6f, 0x5f, 0x1e, 0x12, 0x7e, 0x06, 0x2d, 0xbe, 0x3d, 0x21, 0xb5, 0x32, 0xd3,
-	0xfb, 0x09, 0x85, 0x25, 0x75, 0x06, 0x6d, 0x1b, 0x16, 0x09, 0xcc, 0xe2, 0xfd, 0x17, 0x11, 0x6a,
-	0x0b, 0x91, 0x62, 0xcd, 0xc9, 0xb7, 0xa0, 0xd8, 0xa0, 0xa1, 0x48, 0xac, 0x24, 0xdf, 0xd5, 0x21,
-	0x73, 0x9d, 0x43, 0x75, 0x07, 0xe3, 0xe6, 0x92, 0x9c, 0xbf, 0x9b, 0x9a, 0x7e, 0x0b, 0x67, 0xcb,
-	0xdf, 0x87, 0x05, 0xb4, 0x82, 0x74, 0xd4, 0x11, 0x9b, 0xc8, 0x2e, 0x40, 0x92, 0x46, 0xe8, 0xf0,
-	0x7b, 0x65, 0x43, 0x6b, 0x21, 0x73, 0x8e, 0x0d, 0x95, 0xb2, 0xe8, 0x9c, 0x63, 0xa3, 0x4a, 0x1d,
-	0xa6, 0xf7, 0x9a, 0xa9, 0x9d, 0xe5, 0xcf, 0xc6, 0x01, 0x24, 0xb0, 0xc9, 0x6c, 0x9f, 0xd7, 0xc9,
-	0x32, 0x4c, 0xcb, 0x78, 0x62, 0xb9, 0x75, 0xc4, 0x9c, 0x30, 0xa7, 0xe4, 0xcf, 0xbd, 0x3a, 0xd9,
-	0x81, 0x29, 0x4d, 0x98, 0x0c, 0x16, 0xd1, 0x5b, 0xc9, 0x1d, 0x98, 0x0a, 0xfd, 0x16, 0xb7, 0x19,
-	0x9e, 0x78, 0xee, 0xf6, 0xea, 0x90, 0x0b, 0x93, 0xca, 0x3c, 0xc0, 0x45, 0xa6, 0x5e, 0x4c, 0x56,
-	0x20, 0x67, 0x1f, 0x52, 0x17, 0xb5, 0x42, 0x62, 0x99, 0xd3, 0xf8, 0x7b, 0xaf, 0x4e, 0x5e, 0x81,
-	0x59, 0xf5, 0xe6, 0xb5, 0x25, 0x27, 0xd1, 0x92, 0x79, 0x1c, 0x53, 0xe6, 0x93, 0x47, 0x12, 0x1d,
-	0xeb, 0x90, 0x86, 0x87, 0x2a, 0xe4, 0x98, 0x53, 0xa2, 0xf3, 0x16, 0x0d, 0x0f, 0xc9, 0x79, 0x98,
-	0x11, 0x6e, 0x93, 0x85, 0x82, 0x36, 0x03, 0x0c, 0x17, 0xe3, 0x66, 0x32, 0x40, 0x2e, 0xc3, 0x1c,
-	0x46, 0x56, 0x6e, 0xd1, 0x7a, 0x9d, 0xb3, 0x30, 0x2c, 0xe6, 0x70, 0x77, 0x41, 0x8d, 0x6e, 0xa9,
-	0x41, 0x64, 0x3f, 0x67, 0x34, 0x6c, 0xf1, 0xae, 0xc5, 0x59, 0xdd, 0xe5, 0xcc, 0x16, 0xc5, 0x99,
-	0x2c, 0xec, 0xd7, 0x28, 0xa6, 0x06, 0x29, 0xff, 0xdb, 0xd0, 0x59, 0x91, 0xbe, 0x77, 0xcd, 0xfc,
-	0xd7, 0x61, 0x52, 0x6a, 0x10, 0x71, 0x7e, 0x94, 0x09, 0xd5, 0x7d, 0x6a, 0xae, 0xab, 0x1d, 0xe4,
-	0xcd, 0x1e, 0xce, 0x8c, 0x21, 0x67, 0xae, 0x1e, 0xcb, 0x19, 0x25, 0x37, 0x4d, 0x9a, 0x81, 0xdc,
-	0x63, 0xfc, 0x64, 0xb9, 0x47, 0xf9, 0x37, 0x06, 0xac, 0x24, 0x47, 0xdd, 0xee, 0xea, 0xfb, 0xd7,
-	0x54, 0x4f, 0x58, 0x63, 0xbc, 0x0c, 0x6b, 0x76, 0x87, 0x9c, 0x36, 0xcb, 0x0b, 0xf9, 0xcf, 0x18,
-	0x90, 0x1e, 0xbd, 0x1e, 0x08, 0x2a, 0xc2, 0xac, 0x5a, 0xc5, 0xa6, 0xcb, 0xfe, 0x9a, 0x94, 0xe9,
-	0xb4, 0xf7, 0x5d, 0x05, 0xc0, 0x07, 0x6b, 0xc7, 0xce, 0x7c, 0xc2, 0x9c, 0x91, 0x23, 0x3b, 0x38,
-	0xfd, 0x08, 0x16, 0xa2, 0x34, 0x04, 0x97, 0x61, 0x06, 0x32, 0x91, 0x39, 0x28, 0x6a, 0x2c, 0x24,
-	0x98, 0x4c, 0x3e, 0x28, 0x9c, 0xa1, 0x6d, 0xc6, 0xa9, 0xc3, 0x14, 0xbc, 0x3e, 0x54, 0xe6, 0xa8,
-	0xbb, 0xa0, 0xd1, 0xa4, 0x00, 0x75, 0xc0, 0xf2, 0x73, 0x03, 0x4a, 0xc3, 0xb8, 0xf1, 0x0d, 0x7a,
-	0x0e, 0x5b, 0x30, 0x19, 0x4a, 0x4e, 0xa0, 0xf9, 0x87, 0x87, 0xa1, 0x41, 0x02, 0x45, 0xba, 0xe0,
-	0xce, 0xf2, 0x53, 0x28, 0xa6, 0x0f, 0xb9, 0x23, 0xdd, 0x5b, 0xc4, 0xff, 0xb4, 0xfb, 0x33, 0x7a,
-	0xdd, 0xdf, 0xd7, 0xc5, 0xf1, 0xff, 0xf6, 0x3d, 0x40, 0x2d, 0xff, 0x1b, 0x64, 0xe3, 0x1f, 0xc0,
-	0xd9, 0xb4, 0xcb, 0xb1, 0x7c, 0xcf, 0x42, 0x23, 0x64, 0xf1, 0x3d, 0x24, 0xe5, 0x7b, 0xee, 0x7b,
-	0x78, 0xd6, 0xf2, 0x12, 0x2c, 0xa2, 0x01, 0xf6, 0x63, 0x37, 0xac, 0xb2, 0xb6, 0xcf, 0x27, 0x74,
-	0x3e, 0x97, 0x4c, 0x68, 0xab, 0xbc, 0x0b, 0xb1, 0xcf, 0xb6, 0x6a, 0xb4, 0x41, 0x3d, 0x9b, 0x65,
-	0x29, 0x43, 0x4f, 0x47, 0x20, 0xdb, 0x0a, 0x23, 0xc9, 0x45, 0x62, 0x74, 0x99, 0x3f, 0xfb, 0x4f,
-	0x4e, 0x90, 0x8b, 0x44, 0xba, 0xef, 0x29, 0x20, 0x62, 0xc2, 0xdc, 0x01, 0xf7, 0x9b, 0x49, 0x65,
-	0x92, 0xc5, 0x8a, 0x05, 0x09, 0x11, 0xd7, 0x22, 0xe4, 0x21, 0x10, 0xc4, 0x54, 0x6e, 0x26, 0x8a,
-	0x84, 0x59, 0xf2, 0x40, 0x09, 0xa3, 0xf8, 0xa4, 0x40, 0x88, 0x07, 0xa5, 0xc4, 0xd2, 0x69, 0x78,
-	0x59, 0x4e, 0x66, 0x77, 0x36, 0xcb, 0xb1, 0xe5, 0x53, 0xc2, 0xaa, 0xb6, 0x20, 0xd7, 0x52, 0x37,
-	0x1b, 0x05, 0x7f, 0x95, 0x3a, 0xc4, 0x97, 0xa5, 0xc3, 0x7f, 0xb9, 0x05, 0xcb, 0xaa, 0x31, 0xc2,
-	0xfd, 0x1f, 0x31, 0x5b, 0xa4, 0xf2, 0x7d, 0x72, 0x01, 0xf2, 0xb2, 0x4a, 0x08, 0x2d, 0x7a, 0xc8,
-	0xa8, 0x7a, 0xb9, 0x05, 0x13, 0x70, 0x68, 0x4b, 0x8e, 0x90, 0xd7, 0x61, 0x85, 0x86, 0x61, 0xab,
-	0xc9, 0x2c, 0xdb, 0xf7, 0x42, 0x41, 0x7b, 0x7c, 0xb4, 0xbc, 0xeb, 0x9c, 0xb9, 0xa4, 0x16, 0xec,
-	0xe8, 0xf9, 0xc8, 0xef, 0x96, 0xff, 0x34, 0x0e, 0xf3, 0xaa, 0xaf, 0x90, 0x08, 0x26, 0x04, 0x26,
-	0xb0, 0x2c, 0x51, 0x92, 0xf0, 0x6f, 0x49, 0xd2, 0x40, 0xad, 0x60, 0xf5, 0x13, 0x34, 0x34, 0x4e,
-	0xc7, 0x20, 0xba, 0x9b, 0xd1, 0x83, 0x9b, 0xbd, 0xa3, 0x91, 0xe0, 0xea, 0xae, 0x46, 0x0f, 0x6e,
-	0xf6, 0xce, 0x46, 0x82, 0xab, 0xbb, 0x1b, 0x0f, 0xe1, 0xb4, 0xc7, 0x84, 0xe5, 0x70, 0xff, 0x89,
-	0x38, 0x54, 0x16, 0xce, 0xcc, 0x9b, 0x82, 0xc7, 0xc4, 0x9b, 0x08, 0x84, 0x31, 0xf0, 0x0a, 0x9c,
-	0x56, 0xf7, 0xdc, 0xf2, 0x84, 0xdb, 0x88, 0x5b, 0x1b, 0x05, 0xb3, 0x80, 0xc3, 0xef, 0xc8, 0xd1,
-	0x1d, 0x1a, 0x94, 0x7f, 0x66, 0x68, 0x1f, 0xdf, 0xc3, 0x15, 0xed, 0x4c, 0xbe, 0x0d, 0xf9, 0x20,
-	0x19, 0xd6, 0x8e, 0x76, 0x58, 0x3b, 0xad, 0xff, 0xd6, 0xa3, 0x6a, 0x26, 0xb5, 0x9b, 0x5c, 0x84,
-	0x3c, 0xf2, 0x26, 0x10, 0x49, 0x09, 0x63, 0xa6, 0x87, 0xca, 0x77, 0xb4, 0x2a, 0xe8, 0xfb, 0xee,
-	0x31, 0xc1, 0x5d, 0x3b, 0x3c, 0x3e, 0xdc, 0x48, 0x67, 0xb8, 0x32, 0x64, 0x9f, 0x3e, 0xc3, 0x11,
-	0x71, 0xaa, 0x3f, 0x61, 0x1c, 0x3b, 0x61, 0xb3, 0x2a, 0xf6, 0x91, 0x9c, 0x3d, 0xa1, 0xbc, 0x1e,
-	0x5a, 0x9c, 0xd9, 0xcc, 0x6d, 0x67, 0x23, 0xa1, 0xf2, 0x91, 0xa6, 0x42, 0x32, 0x35, 0x10, 0xd9,
-	0x85, 0x9c, 0x64, 0x8c, 0x74, 0x98, 0x59, 0x18, 0x38, 0xed, 0x31, 0xb1, 0xdb, 0xf0, 0x9f, 0x48,
-	0x37, 0xe0, 0xd6, 0x6c, 0x19, 0xac, 0x3c, 0x8f, 0x35, 0x14, 0xeb, 0x4c, 0x70, 0x6b, 0xf6, 0x8e,
-	0x1a, 0x21, 0x36, 0x2c, 0x3a, 0x34, 0x94, 0x3e, 0xa0, 0xcd, 0x78, 0xa8, 0xdb, 0x44, 0xae, 0x9f,
-	0xbd, 0x3f, 0x46, 0x1c, 0x1a, 0xee, 0xc4, 0x68, 0xa6, 0x04, 0x23, 0x37, 0x81, 0x60, 0xf5, 0xa9,
-	0xec, 0x15, 0x55, 0x4b, 0xaa, 0xe8, 0x99, 0x97, 0x33, 0xea, 0xf8, 0xba, 0x64, 0xba, 0x03, 0xcb,
-	0xb8, 0x5a, 0x3b, 0xdb, 0xc0, 0xe7, 0x22, 0xda, 0x92, 0xc3, 0x2d, 0x8b, 0x72, 0x5a, 0xb9, 0x4d,
-	0x39, 0xa9, 0x0b, 0xd5, 0x28, 0x86, 0xee, 0x32, 0x95, 0xe2, 0x44, 0x31, 0xf4, 0xf7, 0x51, 0x0c,
-	0x4d, 0x26, 0x34, 0x65, 0xde, 0x8b, 0x7a, 0x07, 0x07, 0x8c, 0x85, 0x11, 0x39, 0x32, 0x05, 0x51,
-	0x89, 0xb2, 0xcb, 0x58, 0xa8, 0x09, 0xf2, 0xc3, 0x88, 0x20, 0x08, 0x2c, 0xfc, 0x38, 0x98, 0x66,
-	0xa1, 0xde, 0x99, 0x18, 0x7d, 0xdf, 0x8f, 0x42, 0x29, 0x09, 0x61, 0x35, 0x4a, 0x7d, 0x53, 0xca,
-	0x63, 0x73, 0x08, 0xab, 0xcf, 0xec, 0xfd, 0xb2, 0x15, 0x8d, 0x9b, 0x1c, 0xa7, 0xca, 0xf8, 0xb6,
-	0xc4, 0x24, 0xeb, 0x30, 0x7f, 0xc0, 0x74, 0xae, 0xcd, 0x3c, 0x5a, 0x6b, 0x68, 0xf7, 0x98, 0x33,
-	0xe7, 0x0e, 0x18, 0x66, 0xcd, 0x6f, 0xa8, 0x51, 0xf2, 0x1e, 0xcc, 0xc5, 0x2b, 0x15, 0x9f, 0x32,
-	0xfb, 0xbb, 0x59, 0x0d, 0xad, 0x98, 0x64, 0x01, 0x89, 0x83, 0xa3, 0x94, 0x70, 0x42, 0xb2, 0xc6,
-	0x91, 0x76, 0x97, 0x31, 0x14, 0x10, 0xb3, 0x28, 0x0a, 0x76, 0x11, 0x8b, 0x3e, 0x9b, 0xd2, 0x2c,
-	0x4a, 0x26, 0x34, 0x8b, 0x6e, 0xc3, 0x59, 0x5a, 0xa7, 0x81, 0x70, 0xdb, 0x7d, 0xa6, 0x31, 0xd0,
-	0x34, 0x67, 0xa2, 0xc9, 0xb4, 0x7d, 0x2c, 0x20, 0xfd, 0x85, 0x91, 0xeb, 0x67, 0x6f, 0xb1, 0xcd,
-	0xf7, 0x56, 0x46, 0xae, 0x4f, 0x8a, 0x30, 0x2d, 0xb8, 0xeb, 0x38, 0x8c, 0x2b, 0x26, 0x98, 0xd1,
-	0x4f, 0x79, 0x35, 0x4d, 0xd7, 0x4b, 0x8b, 0xcd, 0x5c, 0x90, 0xcd, 0x36, 0x5d, 0x2f, 0x11, 0x29,
-	0x81, 0x69, 0xe7, 0xeb, 0xb9, 0xf3, 0x26, 0xed, 0xf4, 0xdc, 0x79, 0x9d, 0x1d, 0xd0, 0x56, 0xa3,
-	0xc7, 0x58, 0xd9, 0xef, 0x5c, 0x83, 0x25, 0x02, 0xe2, 0xd6, 0xad, 0xed, 0x7b, 0x0e, 0x0b, 0x31,
-	0x25, 0x9d, 0x3e, 0x59, 0xeb, 0x76, 0x27, 0x46, 0x22, 0xfb, 0x30, 0x1b, 0x53, 0x56, 0x66, 0x8c,
-	0xb9, 0xac, 0xc8, 0xf9, 0x08, 0x46, 0x66, 0x89, 0x55, 0x98, 0xa3, 0x6d, 0xc7, 0x12, 0x1d, 0x7c,
-	0xf3, 0x75, 0xda, 0xcd, 0xd2, 0xf6, 0xc9, 0xd3, 0xb6, 0xb3, 0xdf, 0xa9, 0x32, 0x7e, 0x97, 0x76,
-	0xc9, 0xff, 0xc3, 0x32, 0x6b, 0x32, 0xee, 0x30, 0xcf, 0xd6, 0x89, 0xae, 0xdf, 0x66, 0x9c, 0xbb,
-	0x75, 0x56, 0x04, 0x64, 0xf2, 0xd9, 0x78, 0x5a, 0x9a, 0xee, 0xbe, 0x9e, 0xbc, 0xfd, 0xd3, 0x02,
-	0x4c, 0xe2, 0xcb, 0x20, 0x3f, 0x86, 0x29, 0xf5, 0x95, 0x8d, 0x0c, 0x2b, 0x42, 0x07, 0x3f, 0xec,
-	0x95, 0xae, 0x1c, 0xb7, 0x4c, 0x3d, 0xb1, 0xf2, 0x2b, 0x1f, 0xff, 0xf5, 0x9f, 0xbf, 0x1a, 0x3b,
-	0x47, 0x56, 0x2a, 0xa3, 0xbe, 0x2d, 0x4a, 0xd9, 0x3a, 0x39, 0x1c, 0x29, 0xbb, 0xe7, 0xfb, 0xde,
-	0x68, 0xd9, 0xbd, 0xdf, 0xfb, 0x8e, 0x94, 0xad, 0x52, 0x5a, 0xf2, 0xa9, 0x01, 0x33, 0x49, 0x29,
-	0xb2, 0x3e, 0x0a, 0xb8, 0xff, 0x03, 0x4e, 0xe9, 0xda, 0x0b, 0xac, 0xd4, 0x5a, 0xbc, 0x8a, 0x5a,
-	0xac, 0x91, 0xf3, 0x43, 0xb4, 0x88, 0xeb, 0x28, 0x54, 0x24, 0xe9, 0xf9, 0x8e, 0x54, 0xa4, 0xff,
-	0xe3, 0xc0, 0x68, 0x45, 0x06, 0x5a, 0xdf, 0x47, 0x2a, 0x12, 0xf7, 0xad, 0x49, 0x1b, 0x26, 0xb1,
-	0x96, 0x27, 0xaf, 0x8e, 0x42, 0x4e, 0xb7, 0x93, 0x4b, 0x97, 0x8f, 0x59, 0xa5, 0x65, 0x5f, 0x44,
-	0xd9, 0x25, 0x52, 0x1c, 0x22, 0x5b, 0x15, 0xfc, 0xbf, 0x35, 0xa0, 0xd0, 0xd3, 0xec, 0x20, 0x37,
-	0x8f, 0x84, 0xee, 0x6b, 0xf6, 0x95, 0x6e, 0xbd, 0xe0, 0x6a, 0xad, 0xd0, 0x6b, 0xa8, 0xd0, 0x75,
-	0xb2, 0x3e, 0x4a, 0xa1, 0x8a, 0xea, 0xbb, 0x55, 0x3e, 0x52, 0xff, 0x7f, 0x4a, 0x3e, 0x37, 0x60,
-	0x36, 0xdd, 0xe5, 0x20, 0x37, 0x8e, 0x91, 0x98, 0xee, 0xc5, 0x94, 0x6e, 0xbe, 0xd8, 0x62, 0xad,
-	0xdd, 0x26, 0x6a, 0x77, 0x83, 0x5c, 0x1b, 0xa9, 0x1d, 0x26, 0xc8, 0x95, 0x8f, 0xa2, 0xbc, 0xf9,
-	0x29, 0xf9, 0xd8, 0x80, 0x5c, 0x9c, 0x63, 0x5c, 0x1d, 0x25, 0xad, 0xaf, 0x4b, 0x51, 0x5a, 0x3f,
-	0x7e, 0xa1, 0x56, 0xe9, 0x12, 0xaa, 0xb4, 0x4a, 0xce, 0x0d, 0x51, 0x29, 0xf2, 0x6e, 0xe4, 0xe7,
-	0x06, 0xe4, 0x53, 0x55, 0x0a, 0xb9, 0x3e, 0xd2, 0x4b, 0x0c, 0x94, 0xbd, 0xa5, 0x1b, 0x2f, 0xb4,
-	0x56, 0x6b, 0x73, 0x05, 0xb5, 0xb9, 0x48, 0xd6, 0x86, 0xb9, 0x95, 0x94, 0x02, 0xbf, 0x36, 0x60,
-	0x36, 0x5d, 0x73, 0x8c, 0xbe, 0xb4, 0x21, 0x15, 0xcd, 0xe8, 0x4b, 0x1b, 0x56, 0xc6, 0x94, 0x6f,
-	0xa0, 0x4e, 0x97, 0xc9, 0xa5, 0x21, 0x3a, 0x0d, 0x5c, 0xd7, 0x27, 0x06, 0xe4, 0xa2, 0xac, 0x76,
-	0xf4, 0x75, 0xf5, 0x25, 0xc4, 0xa3, 0xaf, 0xab, 0x3f, 0x41, 0x2e, 0x5f, 0x46, 0x65, 0x2e, 0x90,
-	0xd5, 0x21, 0xca, 0xc8, 0xb4, 0xb3, 0x82, 0xed, 0x43, 0xf2, 0x13, 0x03, 0x72, 0x71, 0x53, 0xf6,
-	0xea, 0x51, 0x1c, 0x4d, 0x65, 0x54, 0xa3, 0xd5, 0xe8, 0xcf, 0xb0, 0x8e, 0xf4, 0x39, 0x92, 0xc8,
-	0xb7, 0x64, 0x45, 0xbd, 0xfd, 0xda, 0x17, 0xcf, 0xd6, 0x8c, 0x2f, 0x9f, 0xad, 0x19, 0xff, 0x78,
-	0xb6, 0x66, 0xfc, 0xe2, 0xf9, 0xda, 0xa9, 0x2f, 0x9f, 0xaf, 0x9d, 0xfa, 0xdb, 0xf3, 0xb5, 0x53,
-	0xef, 0x2f, 0xc9, 0x6d, 0x9d, 0xf4, 0x46, 0xd1, 0x0d, 0x58, 0x58, 0x9b, 0xc2, 0x7f, 0x74, 0xf2,
-	0x7f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x3e, 0x88, 0xdf, 0x72, 0x23, 0x00, 0x00,
+var xxx_messageInfo_QueryStreamBurnEventsRequest proto.InternalMessageInfo
+
+// QueryParamsFormattedRequest is request type for the Query/ParamsFormatted RPC method.
+type QueryParamsFormattedRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsFormattedRequest) Reset()         { *m = QueryParamsFormattedRequest{} }
+func (m *QueryParamsFormattedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsFormattedRequest) ProtoMessage()    {}
+func (*QueryParamsFormattedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{29}
+}
+func (m *QueryParamsFormattedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsFormattedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsFormattedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsFormattedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsFormattedRequest.Merge(m, src)
+}
+func (m *QueryParamsFormattedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsFormattedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsFormattedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsFormattedRequest proto.InternalMessageInfo
+
+// QueryParamsFormattedResponse is response type for the Query/ParamsFormatted RPC method.
+type QueryParamsFormattedResponse struct {
+	// formatted is the human-readable dump of every params field, as produced
+	// by TokenomicsParams.FormatString
+	Formatted string `protobuf:"bytes,1,opt,name=formatted,proto3" json:"formatted,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsFormattedResponse) Reset()         { *m = QueryParamsFormattedResponse{} }
+func (m *QueryParamsFormattedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsFormattedResponse) ProtoMessage()    {}
+func (*QueryParamsFormattedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{30}
+}
+func (m *QueryParamsFormattedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsFormattedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsFormattedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsFormattedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsFormattedResponse.Merge(m, src)
+}
+func (m *QueryParamsFormattedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsFormattedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsFormattedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsFormattedResponse proto.InternalMessageInfo
+
+func (m *QueryParamsFormattedResponse) GetFormatted() string {
+	if m != nil {
+		return m.Formatted
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RedirectTargetAddresses holds the bech32 destination of each treasury
+// redirect bucket. Empty when the target has not been configured.
+type RedirectTargetAddresses struct {
+	EcosystemGrants string `protobuf:"bytes,1,opt,name=ecosystem_grants,json=ecosystemGrants,proto3" json:"ecosystem_grants,omitempty"`
+	BuyAndBurn      string `protobuf:"bytes,2,opt,name=buy_and_burn,json=buyAndBurn,proto3" json:"buy_and_burn,omitempty"`
+	InsuranceFund   string `protobuf:"bytes,3,opt,name=insurance_fund,json=insuranceFund,proto3" json:"insurance_fund,omitempty"`
+	ResearchFund    string `protobuf:"bytes,4,opt,name=research_fund,json=researchFund,proto3" json:"research_fund,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *RedirectTargetAddresses) Reset()         { *m = RedirectTargetAddresses{} }
+func (m *RedirectTargetAddresses) String() string { return proto.CompactTextString(m) }
+func (*RedirectTargetAddresses) ProtoMessage()    {}
+func (*RedirectTargetAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{31}
+}
+func (m *RedirectTargetAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedirectTargetAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedirectTargetAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *RedirectTargetAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedirectTargetAddresses.Merge(m, src)
+}
+func (m *RedirectTargetAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedirectTargetAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedirectTargetAddresses.DiscardUnknown(m)
 }
 
-func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RedirectTargetAddresses proto.InternalMessageInfo
+
+func (m *RedirectTargetAddresses) GetEcosystemGrants() string {
+	if m != nil {
+		return m.EcosystemGrants
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RedirectTargetAddresses) GetBuyAndBurn() string {
+	if m != nil {
+		return m.BuyAndBurn
+	}
+	return ""
 }
 
-func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RedirectTargetAddresses) GetInsuranceFund() string {
+	if m != nil {
+		return m.InsuranceFund
+	}
+	return ""
 }
 
-func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RedirectTargetAddresses) GetResearchFund() string {
+	if m != nil {
+		return m.ResearchFund
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RedirectState holds treasury redirect counters tracked outside params
+type RedirectState struct {
+	LastRedirectHeight         int64                 `protobuf:"varint,1,opt,name=last_redirect_height,json=lastRedirectHeight,proto3" json:"last_redirect_height,omitempty"`
+	AccumulatedRedirectInflows cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=accumulated_redirect_inflows,json=accumulatedRedirectInflows,proto3,customtype=cosmossdk.io/math.Int" json:"accumulated_redirect_inflows"`
+	TotalRedirected            cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_redirected,json=totalRedirected,proto3,customtype=cosmossdk.io/math.Int" json:"total_redirected"`
 }
 
-func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NetInflationRate.Size()
-		i -= size
-		if _, err := m.NetInflationRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *RedirectState) Reset()         { *m = RedirectState{} }
+func (m *RedirectState) String() string { return proto.CompactTextString(m) }
+func (*RedirectState) ProtoMessage()    {}
+func (*RedirectState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{32}
+}
+func (m *RedirectState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedirectState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedirectState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.SupplyPctOfCap.Size()
-		i -= size
-		if _, err := m.SupplyPctOfCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *RedirectState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedirectState.Merge(m, src)
+}
+func (m *RedirectState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedirectState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedirectState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedirectState proto.InternalMessageInfo
+
+func (m *RedirectState) GetLastRedirectHeight() int64 {
+	if m != nil {
+		return m.LastRedirectHeight
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.RemainingMintable.Size()
-		i -= size
-		if _, err := m.RemainingMintable.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// FullConfig is the comprehensive view of tokenomics configuration.
+//
+// Most settings live in TokenomicsParams, but a few are stored under their own
+// keys (treasury and redirect target addresses, redirect runtime counters).
+// FullConfig gathers both so tooling does not need to know the store layout.
+type FullConfig struct {
+	Params          TokenomicsParams        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TreasuryAddress string                  `protobuf:"bytes,2,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	RedirectTargets RedirectTargetAddresses `protobuf:"bytes,3,opt,name=redirect_targets,json=redirectTargets,proto3" json:"redirect_targets"`
+	RedirectState   RedirectState           `protobuf:"bytes,4,opt,name=redirect_state,json=redirectState,proto3" json:"redirect_state"`
+}
+
+func (m *FullConfig) Reset()         { *m = FullConfig{} }
+func (m *FullConfig) String() string { return proto.CompactTextString(m) }
+func (*FullConfig) ProtoMessage()    {}
+func (*FullConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{33}
+}
+func (m *FullConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FullConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FullConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.TotalBurned.Size()
-		i -= size
-		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *FullConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullConfig.Merge(m, src)
+}
+func (m *FullConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *FullConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FullConfig proto.InternalMessageInfo
+
+func (m *FullConfig) GetParams() TokenomicsParams {
+	if m != nil {
+		return m.Params
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TotalMinted.Size()
-		i -= size
-		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CurrentTotalSupply.Size()
-		i -= size
-		if _, err := m.CurrentTotalSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalSupplyCap.Size()
-		i -= size
-		if _, err := m.TotalSupplyCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return TokenomicsParams{}
+}
+
+func (m *FullConfig) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FullConfig) GetRedirectTargets() RedirectTargetAddresses {
+	if m != nil {
+		return m.RedirectTargets
 	}
-	return dAtA[:n], nil
+	return RedirectTargetAddresses{}
 }
 
-func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FullConfig) GetRedirectState() RedirectState {
+	if m != nil {
+		return m.RedirectState
+	}
+	return RedirectState{}
 }
 
-func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryFullConfigRequest is request type for the Query/FullConfig RPC method.
+type QueryFullConfigRequest struct {
 }
 
-func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFullConfigRequest) Reset()         { *m = QueryFullConfigRequest{} }
+func (m *QueryFullConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFullConfigRequest) ProtoMessage()    {}
+func (*QueryFullConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{34}
+}
+func (m *QueryFullConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFullConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFullConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFullConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFullConfigRequest.Merge(m, src)
+}
+func (m *QueryFullConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFullConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFullConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFullConfigRequest proto.InternalMessageInfo
+
+// QueryFullConfigResponse is response type for the Query/FullConfig RPC method.
+type QueryFullConfigResponse struct {
+	Config FullConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
 }
 
-func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlocksPerYear != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksPerYear))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.BlockProvisions.Size()
-		i -= size
-		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.InflationMax.Size()
-		i -= size
-		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.InflationMin.Size()
-		i -= size
-		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.CurrentInflationRate.Size()
-		i -= size
-		if _, err := m.CurrentInflationRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryFullConfigResponse) Reset()         { *m = QueryFullConfigResponse{} }
+func (m *QueryFullConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFullConfigResponse) ProtoMessage()    {}
+func (*QueryFullConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{35}
+}
+func (m *QueryFullConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFullConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFullConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEmissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFullConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFullConfigResponse.Merge(m, src)
 }
-
-func (m *QueryEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFullConfigResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFullConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFullConfigResponse.DiscardUnknown(m)
 }
 
-func (m *EmissionAllocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFullConfigResponse proto.InternalMessageInfo
+
+func (m *QueryFullConfigResponse) GetConfig() FullConfig {
+	if m != nil {
+		return m.Config
 	}
-	return dAtA[:n], nil
+	return FullConfig{}
 }
 
-func (m *EmissionAllocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SourceBurnAttribution splits one source's cumulative burns into the share
+// of the fee burn attributed to it and its module-specific burns
+type SourceBurnAttribution struct {
+	Source       string                `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	FeeBurned    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fee_burned,json=feeBurned,proto3,customtype=cosmossdk.io/math.Int" json:"fee_burned"`
+	ModuleBurned cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=module_burned,json=moduleBurned,proto3,customtype=cosmossdk.io/math.Int" json:"module_burned"`
+	TotalBurned  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
 }
 
-func (m *EmissionAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalDistributed.Size()
-		i -= size
-		if _, err := m.TotalDistributed.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.AnnualAmount.Size()
-		i -= size
-		if _, err := m.AnnualAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Percentage.Size()
-		i -= size
-		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SourceBurnAttribution) Reset()         { *m = SourceBurnAttribution{} }
+func (m *SourceBurnAttribution) String() string { return proto.CompactTextString(m) }
+func (*SourceBurnAttribution) ProtoMessage()    {}
+func (*SourceBurnAttribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{36}
+}
+func (m *SourceBurnAttribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceBurnAttribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceBurnAttribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SourceBurnAttribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceBurnAttribution.Merge(m, src)
+}
+func (m *SourceBurnAttribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceBurnAttribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceBurnAttribution.DiscardUnknown(m)
 }
 
-func (m *QueryEmissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SourceBurnAttribution proto.InternalMessageInfo
+
+func (m *SourceBurnAttribution) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnRateBySourceRequest is request type for the Query/BurnRateBySource RPC method.
+type QueryBurnRateBySourceRequest struct {
 }
 
-func (m *QueryEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastDistributionHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastDistributionHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.TotalAnnualEmissions.Size()
-		i -= size
-		if _, err := m.TotalAnnualEmissions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryBurnRateBySourceRequest) Reset()         { *m = QueryBurnRateBySourceRequest{} }
+func (m *QueryBurnRateBySourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnRateBySourceRequest) ProtoMessage()    {}
+func (*QueryBurnRateBySourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{37}
+}
+func (m *QueryBurnRateBySourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnRateBySourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnRateBySourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Allocations) > 0 {
-		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryBurnRateBySourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnRateBySourceRequest.Merge(m, src)
+}
+func (m *QueryBurnRateBySourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnRateBySourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnRateBySourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnRateBySourceRequest proto.InternalMessageInfo
+
+// QueryBurnRateBySourceResponse is response type for the Query/BurnRateBySource
+// RPC method. Every burn source is listed, in enum order, including those with
+// no burns.
+type QueryBurnRateBySourceResponse struct {
+	Sources           []SourceBurnAttribution `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources"`
+	TotalFeeBurned    cosmossdk_io_math.Int   `protobuf:"bytes,2,opt,name=total_fee_burned,json=totalFeeBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_fee_burned"`
+	TotalModuleBurned cosmossdk_io_math.Int   `protobuf:"bytes,3,opt,name=total_module_burned,json=totalModuleBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_module_burned"`
+	TotalBurned       cosmossdk_io_math.Int   `protobuf:"bytes,4,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+}
+
+func (m *QueryBurnRateBySourceResponse) Reset()         { *m = QueryBurnRateBySourceResponse{} }
+func (m *QueryBurnRateBySourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnRateBySourceResponse) ProtoMessage()    {}
+func (*QueryBurnRateBySourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{38}
+}
+func (m *QueryBurnRateBySourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnRateBySourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnRateBySourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBurnRateBySourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnRateBySourceResponse.Merge(m, src)
+}
+func (m *QueryBurnRateBySourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnRateBySourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnRateBySourceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBurnsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnRateBySourceResponse proto.InternalMessageInfo
+
+func (m *QueryBurnRateBySourceResponse) GetSources() []SourceBurnAttribution {
+	if m != nil {
+		return m.Sources
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBurnsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SourceBurnTotal is the cumulative amount burned from one burn source
+type SourceBurnTotal struct {
+	Source      string                `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
 }
 
-func (m *QueryBurnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *SourceBurnTotal) Reset()         { *m = SourceBurnTotal{} }
+func (m *SourceBurnTotal) String() string { return proto.CompactTextString(m) }
+func (*SourceBurnTotal) ProtoMessage()    {}
+func (*SourceBurnTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{39}
+}
+func (m *SourceBurnTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceBurnTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceBurnTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SourceBurnTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceBurnTotal.Merge(m, src)
+}
+func (m *SourceBurnTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceBurnTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceBurnTotal.DiscardUnknown(m)
 }
 
-func (m *BurnRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SourceBurnTotal proto.InternalMessageInfo
+
+func (m *SourceBurnTotal) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BurnRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnsBreakdownRequest is request type for the Query/BurnsBreakdown RPC method.
+type QueryBurnsBreakdownRequest struct {
 }
 
-func (m *BurnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TreasuryRedirect.Size()
-		i -= size
-		if _, err := m.TreasuryRedirect.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryBurnsBreakdownRequest) Reset()         { *m = QueryBurnsBreakdownRequest{} }
+func (m *QueryBurnsBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnsBreakdownRequest) ProtoMessage()    {}
+func (*QueryBurnsBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{40}
+}
+func (m *QueryBurnsBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnsBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnsBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.BurnerAddress) > 0 {
-		i -= len(m.BurnerAddress)
-		copy(dAtA[i:], m.BurnerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BurnerAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Source != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *QueryBurnsBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnsBreakdownRequest.Merge(m, src)
+}
+func (m *QueryBurnsBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnsBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnsBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnsBreakdownRequest proto.InternalMessageInfo
+
+// QueryBurnsBreakdownResponse is response type for the Query/BurnsBreakdown RPC
+// method. Every burn source is listed, in enum order, including those with no
+// burns. total_burned is the sum over all sources.
+type QueryBurnsBreakdownResponse struct {
+	Sources     []SourceBurnTotal     `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources"`
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+}
+
+func (m *QueryBurnsBreakdownResponse) Reset()         { *m = QueryBurnsBreakdownResponse{} }
+func (m *QueryBurnsBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnsBreakdownResponse) ProtoMessage()    {}
+func (*QueryBurnsBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{41}
+}
+func (m *QueryBurnsBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnsBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnsBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.BurnId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BurnId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBurnsBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnsBreakdownResponse.Merge(m, src)
+}
+func (m *QueryBurnsBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnsBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnsBreakdownResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBurnsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnsBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryBurnsBreakdownResponse) GetSources() []SourceBurnTotal {
+	if m != nil {
+		return m.Sources
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBurnsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChainBurnTotal is the cumulative amount burned on one chain
+type ChainBurnTotal struct {
+	ChainId     string                `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
 }
 
-func (m *QueryBurnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalBurned.Size()
-		i -= size
-		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ChainBurnTotal) Reset()         { *m = ChainBurnTotal{} }
+func (m *ChainBurnTotal) String() string { return proto.CompactTextString(m) }
+func (*ChainBurnTotal) ProtoMessage()    {}
+func (*ChainBurnTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{42}
+}
+func (m *ChainBurnTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainBurnTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainBurnTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Burns) > 0 {
-		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Burns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ChainBurnTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainBurnTotal.Merge(m, src)
+}
+func (m *ChainBurnTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainBurnTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainBurnTotal.DiscardUnknown(m)
 }
 
-func (m *QueryBurnsBySourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChainBurnTotal proto.InternalMessageInfo
+
+func (m *ChainBurnTotal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBurnsBySourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllBurnsByChainRequest is request type for the Query/AllBurnsByChain RPC method.
+type QueryAllBurnsByChainRequest struct {
 }
 
-func (m *QueryBurnsBySourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllBurnsByChainRequest) Reset()         { *m = QueryAllBurnsByChainRequest{} }
+func (m *QueryAllBurnsByChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBurnsByChainRequest) ProtoMessage()    {}
+func (*QueryAllBurnsByChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{43}
+}
+func (m *QueryAllBurnsByChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBurnsByChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBurnsByChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Source != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *BurnsBySourceStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllBurnsByChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBurnsByChainRequest.Merge(m, src)
+}
+func (m *QueryAllBurnsByChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBurnsByChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBurnsByChainRequest.DiscardUnknown(m)
 }
 
-func (m *BurnsBySourceStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllBurnsByChainRequest proto.InternalMessageInfo
+
+// QueryAllBurnsByChainResponse is response type for the Query/AllBurnsByChain
+// RPC method. total_burned is the sum over all chains.
+type QueryAllBurnsByChainResponse struct {
+	Chains      []ChainBurnTotal      `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains"`
+	TotalBurned cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
 }
 
-func (m *BurnsBySourceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AverageBurnAmount.Size()
-		i -= size
-		if _, err := m.AverageBurnAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.CurrentBurnRate.Size()
-		i -= size
-		if _, err := m.CurrentBurnRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryAllBurnsByChainResponse) Reset()         { *m = QueryAllBurnsByChainResponse{} }
+func (m *QueryAllBurnsByChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBurnsByChainResponse) ProtoMessage()    {}
+func (*QueryAllBurnsByChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{44}
+}
+func (m *QueryAllBurnsByChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBurnsByChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBurnsByChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.BurnCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BurnCount))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *QueryAllBurnsByChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBurnsByChainResponse.Merge(m, src)
+}
+func (m *QueryAllBurnsByChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBurnsByChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBurnsByChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBurnsByChainResponse proto.InternalMessageInfo
+
+func (m *QueryAllBurnsByChainResponse) GetChains() []ChainBurnTotal {
+	if m != nil {
+		return m.Chains
 	}
-	{
-		size := m.TotalAmount.Size()
-		i -= size
-		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// ParamChange sets a single TokenomicsParams field
+type ParamChange struct {
+	// field is the params JSON field name (e.g. "burn_rate_pos_gas")
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// value is the JSON-encoded new value
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ParamChange) Reset()         { *m = ParamChange{} }
+func (m *ParamChange) String() string { return proto.CompactTextString(m) }
+func (*ParamChange) ProtoMessage()    {}
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{45}
+}
+func (m *ParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Source != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChange.Merge(m, src)
+}
+func (m *ParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChange proto.InternalMessageInfo
+
+func (m *ParamChange) GetField() string {
+	if m != nil {
+		return m.Field
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBurnsBySourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParamChange) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBurnsBySourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StagedChange is a group of param changes that take effect together at the
+// start of effective_epoch. An epoch is reward_stream_interval blocks.
+type StagedChange struct {
+	Id             uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EffectiveEpoch int64         `protobuf:"varint,2,opt,name=effective_epoch,json=effectiveEpoch,proto3" json:"effective_epoch,omitempty"`
+	Changes        []ParamChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes"`
+	// source identifies the feature or authority that staged the change
+	Source         string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	StagedAtHeight int64  `protobuf:"varint,5,opt,name=staged_at_height,json=stagedAtHeight,proto3" json:"staged_at_height,omitempty"`
 }
 
-func (m *QueryBurnsBySourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+func (m *StagedChange) Reset()         { *m = StagedChange{} }
+func (m *StagedChange) String() string { return proto.CompactTextString(m) }
+func (*StagedChange) ProtoMessage()    {}
+func (*StagedChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{46}
+}
+func (m *StagedChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StagedChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StagedChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *StagedChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StagedChange.Merge(m, src)
+}
+func (m *StagedChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StagedChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StagedChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StagedChange proto.InternalMessageInfo
+
+func (m *StagedChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Burns) > 0 {
-		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Burns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *StagedChange) GetEffectiveEpoch() int64 {
+	if m != nil {
+		return m.EffectiveEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBurnsByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StagedChange) GetChanges() []ParamChange {
+	if m != nil {
+		return m.Changes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBurnsByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StagedChange) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *QueryBurnsByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *StagedChange) GetStagedAtHeight() int64 {
+	if m != nil {
+		return m.StagedAtHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBurnsByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryPendingChangesRequest is request type for the Query/PendingChanges RPC method.
+type QueryPendingChangesRequest struct {
 }
 
-func (m *QueryBurnsByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingChangesRequest) Reset()         { *m = QueryPendingChangesRequest{} }
+func (m *QueryPendingChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChangesRequest) ProtoMessage()    {}
+func (*QueryPendingChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{47}
 }
-
-func (m *QueryBurnsByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalBurnedOnChain.Size()
-		i -= size
-		if _, err := m.TotalBurnedOnChain.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Burns) > 0 {
-		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Burns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPendingChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTreasuryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChangesRequest.Merge(m, src)
 }
-
-func (m *QueryTreasuryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingChangesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryTreasuryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChangesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTreasuryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryPendingChangesRequest proto.InternalMessageInfo
 
-func (m *QueryTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingChangesResponse is response type for the Query/PendingChanges RPC method.
+type QueryPendingChangesResponse struct {
+	CurrentEpoch int64          `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	Changes      []StagedChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
 }
 
-func (m *QueryTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TreasuryAddress) > 0 {
-		i -= len(m.TreasuryAddress)
-		copy(dAtA[i:], m.TreasuryAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TreasuryAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size := m.TreasuryBurnRedirectPct.Size()
-		i -= size
-		if _, err := m.TreasuryBurnRedirectPct.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.FromBurnRedirect.Size()
-		i -= size
-		if _, err := m.FromBurnRedirect.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FromInflation.Size()
-		i -= size
-		if _, err := m.FromInflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalTreasuryInflows.Size()
-		i -= size
-		if _, err := m.TotalTreasuryInflows.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryPendingChangesResponse) Reset()         { *m = QueryPendingChangesResponse{} }
+func (m *QueryPendingChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChangesResponse) ProtoMessage()    {}
+func (*QueryPendingChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{48}
+}
+func (m *QueryPendingChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TreasuryBalance.Size()
-		i -= size
-		if _, err := m.TreasuryBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryPendingChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingChangesResponse) GetCurrentEpoch() int64 {
+	if m != nil {
+		return m.CurrentEpoch
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryProjectionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingChangesResponse) GetChanges() []StagedChange {
+	if m != nil {
+		return m.Changes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProjectionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SupplyReconciliation compares the supply tracked by the tokenomics counters
+// with the bank module's actual supply of the native denom.
+type SupplyReconciliation struct {
+	Denom         string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TrackedSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=tracked_supply,json=trackedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"tracked_supply"`
+	BankSupply    cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=bank_supply,json=bankSupply,proto3,customtype=cosmossdk.io/math.Int" json:"bank_supply"`
+	// delta is bank_supply - tracked_supply; positive when the bank holds more
+	// tokens than tokenomics has accounted for
+	Delta      cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=delta,proto3,customtype=cosmossdk.io/math.Int" json:"delta"`
+	Reconciled bool                  `protobuf:"varint,5,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
 }
 
-func (m *QueryProjectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AssumeConstantBurnRate {
-		i--
-		if m.AssumeConstantBurnRate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *SupplyReconciliation) Reset()         { *m = SupplyReconciliation{} }
+func (m *SupplyReconciliation) String() string { return proto.CompactTextString(m) }
+func (*SupplyReconciliation) ProtoMessage()    {}
+func (*SupplyReconciliation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{49}
+}
+func (m *SupplyReconciliation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyReconciliation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyReconciliation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.YearsAhead != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.YearsAhead))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SupplyReconciliation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyReconciliation.Merge(m, src)
+}
+func (m *SupplyReconciliation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyReconciliation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyReconciliation.DiscardUnknown(m)
 }
 
-func (m *SupplyProjection) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SupplyReconciliation proto.InternalMessageInfo
+
+func (m *SupplyReconciliation) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SupplyProjection) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SupplyReconciliation) GetReconciled() bool {
+	if m != nil {
+		return m.Reconciled
+	}
+	return false
 }
 
-func (m *SupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.YearsUntilCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.YearsUntilCap))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.NetGrowthRate.Size()
-		i -= size
-		if _, err := m.NetGrowthRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// QuerySupplyReconciliationRequest is request type for the Query/SupplyReconciliation RPC method.
+type QuerySupplyReconciliationRequest struct {
+}
+
+func (m *QuerySupplyReconciliationRequest) Reset()         { *m = QuerySupplyReconciliationRequest{} }
+func (m *QuerySupplyReconciliationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyReconciliationRequest) ProtoMessage()    {}
+func (*QuerySupplyReconciliationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{50}
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyReconciliationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.ProjectedBurned.Size()
-		i -= size
-		if _, err := m.ProjectedBurned.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyReconciliationRequest.Merge(m, src)
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyReconciliationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyReconciliationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyReconciliationRequest proto.InternalMessageInfo
+
+// QuerySupplyReconciliationResponse is response type for the Query/SupplyReconciliation RPC method.
+type QuerySupplyReconciliationResponse struct {
+	Reconciliation SupplyReconciliation `protobuf:"bytes,1,opt,name=reconciliation,proto3" json:"reconciliation"`
+}
+
+func (m *QuerySupplyReconciliationResponse) Reset()         { *m = QuerySupplyReconciliationResponse{} }
+func (m *QuerySupplyReconciliationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyReconciliationResponse) ProtoMessage()    {}
+func (*QuerySupplyReconciliationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{51}
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyReconciliationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ProjectedMinted.Size()
-		i -= size
-		if _, err := m.ProjectedMinted.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyReconciliationResponse.Merge(m, src)
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyReconciliationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyReconciliationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyReconciliationResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyReconciliationResponse) GetReconciliation() SupplyReconciliation {
+	if m != nil {
+		return m.Reconciliation
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ProjectedSupply.Size()
-		i -= size
-		if _, err := m.ProjectedSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return SupplyReconciliation{}
+}
+
+// SupplyInvariant reports the stored supply counters and whether they satisfy
+// the conservation law current_total_supply = total_minted - total_burned
+type SupplyInvariant struct {
+	TotalMinted        cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
+	TotalBurned        cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_burned,json=totalBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned"`
+	CurrentTotalSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=current_total_supply,json=currentTotalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"current_total_supply"`
+	Consistent         bool                  `protobuf:"varint,4,opt,name=consistent,proto3" json:"consistent,omitempty"`
+}
+
+func (m *SupplyInvariant) Reset()         { *m = SupplyInvariant{} }
+func (m *SupplyInvariant) String() string { return proto.CompactTextString(m) }
+func (*SupplyInvariant) ProtoMessage()    {}
+func (*SupplyInvariant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{52}
+}
+func (m *SupplyInvariant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyInvariant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyInvariant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Year != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SupplyInvariant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyInvariant.Merge(m, src)
+}
+func (m *SupplyInvariant) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyInvariant) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyInvariant.DiscardUnknown(m)
 }
 
-func (m *QueryProjectionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SupplyInvariant proto.InternalMessageInfo
+
+func (m *SupplyInvariant) GetConsistent() bool {
+	if m != nil {
+		return m.Consistent
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryProjectionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySupplyInvariantRequest is request type for the Query/SupplyInvariant RPC method.
+type QuerySupplyInvariantRequest struct {
 }
 
-func (m *QueryProjectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Assumptions) > 0 {
-		i -= len(m.Assumptions)
-		copy(dAtA[i:], m.Assumptions)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Assumptions)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Projections) > 0 {
-		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySupplyInvariantRequest) Reset()         { *m = QuerySupplyInvariantRequest{} }
+func (m *QuerySupplyInvariantRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyInvariantRequest) ProtoMessage()    {}
+func (*QuerySupplyInvariantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{53}
+}
+func (m *QuerySupplyInvariantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyInvariantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyInvariantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryChainMetricsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySupplyInvariantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyInvariantRequest.Merge(m, src)
+}
+func (m *QuerySupplyInvariantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyInvariantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyInvariantRequest.DiscardUnknown(m)
 }
 
-func (m *QueryChainMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySupplyInvariantRequest proto.InternalMessageInfo
+
+// QuerySupplyInvariantResponse is response type for the Query/SupplyInvariant RPC method.
+type QuerySupplyInvariantResponse struct {
+	Invariant SupplyInvariant `protobuf:"bytes,1,opt,name=invariant,proto3" json:"invariant"`
 }
 
-func (m *QueryChainMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySupplyInvariantResponse) Reset()         { *m = QuerySupplyInvariantResponse{} }
+func (m *QuerySupplyInvariantResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyInvariantResponse) ProtoMessage()    {}
+func (*QuerySupplyInvariantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{54}
+}
+func (m *QuerySupplyInvariantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyInvariantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyInvariantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySupplyInvariantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyInvariantResponse.Merge(m, src)
+}
+func (m *QuerySupplyInvariantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyInvariantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyInvariantResponse.DiscardUnknown(m)
 }
 
-func (m *QueryChainMetricsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySupplyInvariantResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyInvariantResponse) GetInvariant() SupplyInvariant {
+	if m != nil {
+		return m.Invariant
 	}
-	return dAtA[:n], nil
+	return SupplyInvariant{}
 }
 
-func (m *QueryChainMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RedirectTargetStatus is one treasury redirect bucket: its configured
+// address ("" when unset) and its share of each redirect
+type RedirectTargetStatus struct {
+	Name    string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Ratio   cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
 }
 
-func (m *QueryChainMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastBurnReportHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastBurnReportHeight))
-		i--
-		dAtA[i] = 0x40
+func (m *RedirectTargetStatus) Reset()         { *m = RedirectTargetStatus{} }
+func (m *RedirectTargetStatus) String() string { return proto.CompactTextString(m) }
+func (*RedirectTargetStatus) ProtoMessage()    {}
+func (*RedirectTargetStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{55}
+}
+func (m *RedirectTargetStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedirectTargetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedirectTargetStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.LastRewardHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastRewardHeight))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *RedirectTargetStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedirectTargetStatus.Merge(m, src)
+}
+func (m *RedirectTargetStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedirectTargetStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedirectTargetStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedirectTargetStatus proto.InternalMessageInfo
+
+func (m *RedirectTargetStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	{
-		size := m.GasConversionRatio.Size()
-		i -= size
-		if _, err := m.GasConversionRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *RedirectTargetStatus) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.IbcChannel) > 0 {
-		i -= len(m.IbcChannel)
-		copy(dAtA[i:], m.IbcChannel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcChannel)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.NetFlow.Size()
-		i -= size
-		if _, err := m.NetFlow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TotalRewardsReceived.Size()
-		i -= size
-		if _, err := m.TotalRewardsReceived.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalBurned.Size()
-		i -= size
-		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// TreasuryRedirectStatus is the treasury redirect configuration together with
+// its runtime counters, for explorers and the DAO dashboard
+type TreasuryRedirectStatus struct {
+	Enabled            bool                        `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Ratio              cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+	ExecutionInterval  uint64                      `protobuf:"varint,3,opt,name=execution_interval,json=executionInterval,proto3" json:"execution_interval,omitempty"`
+	MaxPerExecution    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_per_execution,json=maxPerExecution,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_per_execution"`
+	AccumulatedInflows cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=accumulated_inflows,json=accumulatedInflows,proto3,customtype=cosmossdk.io/math.Int" json:"accumulated_inflows"`
+	TotalRedirected    cosmossdk_io_math.Int       `protobuf:"bytes,6,opt,name=total_redirected,json=totalRedirected,proto3,customtype=cosmossdk.io/math.Int" json:"total_redirected"`
+	LastRedirectHeight int64                       `protobuf:"varint,7,opt,name=last_redirect_height,json=lastRedirectHeight,proto3" json:"last_redirect_height,omitempty"`
+	Targets            []RedirectTargetStatus      `protobuf:"bytes,8,rep,name=targets,proto3" json:"targets"`
+}
+
+func (m *TreasuryRedirectStatus) Reset()         { *m = TreasuryRedirectStatus{} }
+func (m *TreasuryRedirectStatus) String() string { return proto.CompactTextString(m) }
+func (*TreasuryRedirectStatus) ProtoMessage()    {}
+func (*TreasuryRedirectStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{56}
+}
+func (m *TreasuryRedirectStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryRedirectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryRedirectStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TreasuryRedirectStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryRedirectStatus.Merge(m, src)
+}
+func (m *TreasuryRedirectStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryRedirectStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryRedirectStatus.DiscardUnknown(m)
 }
 
-func (m *QueryFeeStatsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TreasuryRedirectStatus proto.InternalMessageInfo
+
+func (m *TreasuryRedirectStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryFeeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TreasuryRedirectStatus) GetExecutionInterval() uint64 {
+	if m != nil {
+		return m.ExecutionInterval
+	}
+	return 0
 }
 
-func (m *QueryFeeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *TreasuryRedirectStatus) GetLastRedirectHeight() int64 {
+	if m != nil {
+		return m.LastRedirectHeight
+	}
+	return 0
 }
 
-func (m *QueryFeeStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryRedirectStatus) GetTargets() []RedirectTargetStatus {
+	if m != nil {
+		return m.Targets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFeeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTreasuryRedirectRequest is request type for the Query/TreasuryRedirect RPC method.
+type QueryTreasuryRedirectRequest struct {
 }
 
-func (m *QueryFeeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TreasuryFeeRatio.Size()
-		i -= size
-		if _, err := m.TreasuryFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.FeeBurnRatio.Size()
-		i -= size
-		if _, err := m.FeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.FeeBurnEnabled {
-		i--
-		if m.FeeBurnEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.AverageFeesBurnedPerBlock.Size()
-		i -= size
-		if _, err := m.AverageFeesBurnedPerBlock.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalFeesToTreasury.Size()
-		i -= size
-		if _, err := m.TotalFeesToTreasury.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryTreasuryRedirectRequest) Reset()         { *m = QueryTreasuryRedirectRequest{} }
+func (m *QueryTreasuryRedirectRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryRedirectRequest) ProtoMessage()    {}
+func (*QueryTreasuryRedirectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{57}
+}
+func (m *QueryTreasuryRedirectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryRedirectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryRedirectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalFeesBurned.Size()
-		i -= size
-		if _, err := m.TotalFeesBurned.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *QueryTreasuryRedirectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryRedirectRequest.Merge(m, src)
+}
+func (m *QueryTreasuryRedirectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryRedirectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryRedirectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryRedirectRequest proto.InternalMessageInfo
+
+// QueryTreasuryRedirectResponse is response type for the Query/TreasuryRedirect RPC method.
+type QueryTreasuryRedirectResponse struct {
+	Redirect TreasuryRedirectStatus `protobuf:"bytes,1,opt,name=redirect,proto3" json:"redirect"`
+}
+
+func (m *QueryTreasuryRedirectResponse) Reset()         { *m = QueryTreasuryRedirectResponse{} }
+func (m *QueryTreasuryRedirectResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryRedirectResponse) ProtoMessage()    {}
+func (*QueryTreasuryRedirectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{58}
+}
+func (m *QueryTreasuryRedirectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryRedirectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryRedirectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTreasuryRedirectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryRedirectResponse.Merge(m, src)
+}
+func (m *QueryTreasuryRedirectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryRedirectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryRedirectResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBurnRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTreasuryRedirectResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryRedirectResponse) GetRedirect() TreasuryRedirectStatus {
+	if m != nil {
+		return m.Redirect
 	}
-	return dAtA[:n], nil
+	return TreasuryRedirectStatus{}
 }
 
-func (m *QueryBurnRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SupplyForecastYear is one row of the supply forecast. Year is 0-indexed
+// from genesis, like GetCurrentYear.
+type SupplyForecastYear struct {
+	Year          int64                       `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	StartSupply   cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=start_supply,json=startSupply,proto3,customtype=cosmossdk.io/math.Int" json:"start_supply"`
+	Mint          cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=mint,proto3,customtype=cosmossdk.io/math.Int" json:"mint"`
+	Burn          cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=burn,proto3,customtype=cosmossdk.io/math.Int" json:"burn"`
+	// net_inflation is (mint - burn) / start_supply; negative when burns win
+	NetInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=net_inflation,json=netInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"net_inflation"`
+	EndSupply    cosmossdk_io_math.Int       `protobuf:"bytes,7,opt,name=end_supply,json=endSupply,proto3,customtype=cosmossdk.io/math.Int" json:"end_supply"`
 }
 
-func (m *QueryBurnRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SupplyForecastYear) Reset()         { *m = SupplyForecastYear{} }
+func (m *SupplyForecastYear) String() string { return proto.CompactTextString(m) }
+func (*SupplyForecastYear) ProtoMessage()    {}
+func (*SupplyForecastYear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{59}
+}
+func (m *SupplyForecastYear) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyForecastYear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyForecastYear.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyForecastYear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyForecastYear.Merge(m, src)
+}
+func (m *SupplyForecastYear) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyForecastYear) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyForecastYear.DiscardUnknown(m)
 }
 
-func (m *QueryBurnRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SupplyForecastYear proto.InternalMessageInfo
+
+func (m *SupplyForecastYear) GetYear() int64 {
+	if m != nil {
+		return m.Year
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBurnRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySupplyForecastRequest is request type for the Query/SupplyForecast RPC method.
+type QuerySupplyForecastRequest struct {
+	// years to project, between 1 and MaxForecastYears
+	Years int64 `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
 }
 
-func (m *QueryBurnRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EmergencyBurnOverride {
-		i--
-		if m.EmergencyBurnOverride {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size := m.AvgTxPerDay.Size()
-		i -= size
-		if _, err := m.AvgTxPerDay.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.TreasuryPct.Size()
-		i -= size
-		if _, err := m.TreasuryPct.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.BlockCongestion.Size()
-		i -= size
-		if _, err := m.BlockCongestion.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.DefaultBurnRatio.Size()
-		i -= size
-		if _, err := m.DefaultBurnRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MaxBurnRatio.Size()
-		i -= size
-		if _, err := m.MaxBurnRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MinBurnRatio.Size()
-		i -= size
-		if _, err := m.MinBurnRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QuerySupplyForecastRequest) Reset()         { *m = QuerySupplyForecastRequest{} }
+func (m *QuerySupplyForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyForecastRequest) ProtoMessage()    {}
+func (*QuerySupplyForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{60}
+}
+func (m *QuerySupplyForecastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyForecastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Trigger) > 0 {
-		i -= len(m.Trigger)
-		copy(dAtA[i:], m.Trigger)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trigger)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	{
-		size := m.CurrentBurnRatio.Size()
-		i -= size
-		if _, err := m.CurrentBurnRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QuerySupplyForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyForecastRequest.Merge(m, src)
+}
+func (m *QuerySupplyForecastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyForecastRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyForecastRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyForecastRequest) GetYears() int64 {
+	if m != nil {
+		return m.Years
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.AdaptiveBurnEnabled {
-		i--
-		if m.AdaptiveBurnEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+// QuerySupplyForecastResponse is response type for the Query/SupplyForecast RPC method.
+type QuerySupplyForecastResponse struct {
+	CurrentYear             int64                       `protobuf:"varint,1,opt,name=current_year,json=currentYear,proto3" json:"current_year,omitempty"`
+	TotalSupplyCap          cosmossdk_io_math.Int       `protobuf:"bytes,2,opt,name=total_supply_cap,json=totalSupplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply_cap"`
+	EstimatedAnnualBurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=estimated_annual_burn_rate,json=estimatedAnnualBurnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"estimated_annual_burn_rate"`
+	Years                   []SupplyForecastYear        `protobuf:"bytes,4,rep,name=years,proto3" json:"years"`
+}
+
+func (m *QuerySupplyForecastResponse) Reset()         { *m = QuerySupplyForecastResponse{} }
+func (m *QuerySupplyForecastResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyForecastResponse) ProtoMessage()    {}
+func (*QuerySupplyForecastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{61}
+}
+func (m *QuerySupplyForecastResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyForecastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyForecastResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySupplyForecastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyForecastResponse.Merge(m, src)
+}
+func (m *QuerySupplyForecastResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyForecastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyForecastResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QuerySupplyForecastResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyForecastResponse) GetCurrentYear() int64 {
+	if m != nil {
+		return m.CurrentYear
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QuerySupplyForecastResponse) GetYears() []SupplyForecastYear {
+	if m != nil {
+		return m.Years
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// EmissionRecipientHistory is the emission received by one category
+type EmissionRecipientHistory struct {
+	Category        string                `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	TotalEmitted    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_emitted,json=totalEmitted,proto3,customtype=cosmossdk.io/math.Int" json:"total_emitted"`
+	LastEpochAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=last_epoch_amount,json=lastEpochAmount,proto3,customtype=cosmossdk.io/math.Int" json:"last_epoch_amount"`
 }
 
-func (m *QuerySupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EmissionRecipientHistory) Reset()         { *m = EmissionRecipientHistory{} }
+func (m *EmissionRecipientHistory) String() string { return proto.CompactTextString(m) }
+func (*EmissionRecipientHistory) ProtoMessage()    {}
+func (*EmissionRecipientHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{62}
+}
+func (m *EmissionRecipientHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionRecipientHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionRecipientHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *EmissionRecipientHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionRecipientHistory.Merge(m, src)
+}
+func (m *EmissionRecipientHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionRecipientHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionRecipientHistory.DiscardUnknown(m)
 }
 
-func (m *QuerySupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EmissionRecipientHistory proto.InternalMessageInfo
+
+func (m *EmissionRecipientHistory) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	var l int
-	_ = l
-	l = m.TotalSupplyCap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentTotalSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalMinted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalBurned.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.RemainingMintable.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.SupplyPctOfCap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NetInflationRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// EmissionsHistory is the cumulative and last-epoch emission per category
+type EmissionsHistory struct {
+	Recipients      []EmissionRecipientHistory `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+	TotalEmitted    cosmossdk_io_math.Int      `protobuf:"bytes,2,opt,name=total_emitted,json=totalEmitted,proto3,customtype=cosmossdk.io/math.Int" json:"total_emitted"`
+	LastEpochTotal  cosmossdk_io_math.Int      `protobuf:"bytes,3,opt,name=last_epoch_total,json=lastEpochTotal,proto3,customtype=cosmossdk.io/math.Int" json:"last_epoch_total"`
+	LastEpochHeight int64                      `protobuf:"varint,4,opt,name=last_epoch_height,json=lastEpochHeight,proto3" json:"last_epoch_height,omitempty"`
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CurrentInflationRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.InflationMin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.InflationMax.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BlockProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovQuery(uint64(m.BlocksPerYear))
+func (m *EmissionsHistory) Reset()         { *m = EmissionsHistory{} }
+func (m *EmissionsHistory) String() string { return proto.CompactTextString(m) }
+func (*EmissionsHistory) ProtoMessage()    {}
+func (*EmissionsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{63}
+}
+func (m *EmissionsHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionsHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EmissionsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionsHistory.Merge(m, src)
+}
+func (m *EmissionsHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionsHistory.DiscardUnknown(m)
 }
 
-func (m *QueryEmissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EmissionsHistory proto.InternalMessageInfo
+
+func (m *EmissionsHistory) GetRecipients() []EmissionRecipientHistory {
+	if m != nil {
+		return m.Recipients
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *EmissionAllocation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EmissionsHistory) GetLastEpochHeight() int64 {
+	if m != nil {
+		return m.LastEpochHeight
 	}
-	var l int
-	_ = l
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Percentage.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AnnualAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalDistributed.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryEmissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allocations) > 0 {
-		for _, e := range m.Allocations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = m.TotalAnnualEmissions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastDistributionHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastDistributionHeight))
-	}
-	return n
+// QueryEmissionsHistoryRequest is request type for the Query/EmissionsHistory RPC method.
+type QueryEmissionsHistoryRequest struct {
 }
 
-func (m *QueryBurnsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEmissionsHistoryRequest) Reset()         { *m = QueryEmissionsHistoryRequest{} }
+func (m *QueryEmissionsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionsHistoryRequest) ProtoMessage()    {}
+func (*QueryEmissionsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{64}
+}
+func (m *QueryEmissionsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionsHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryEmissionsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionsHistoryRequest.Merge(m, src)
+}
+func (m *QueryEmissionsHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionsHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *BurnRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BurnId != 0 {
-		n += 1 + sovQuery(uint64(m.BurnId))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Source != 0 {
-		n += 1 + sovQuery(uint64(m.Source))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovQuery(uint64(m.Timestamp))
-	}
-	l = len(m.BurnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.TreasuryRedirect.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+var xxx_messageInfo_QueryEmissionsHistoryRequest proto.InternalMessageInfo
+
+// QueryEmissionsHistoryResponse is response type for the Query/EmissionsHistory RPC method.
+type QueryEmissionsHistoryResponse struct {
+	History EmissionsHistory `protobuf:"bytes,1,opt,name=history,proto3" json:"history"`
 }
 
-func (m *QueryBurnsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Burns) > 0 {
-		for _, e := range m.Burns {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEmissionsHistoryResponse) Reset()         { *m = QueryEmissionsHistoryResponse{} }
+func (m *QueryEmissionsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionsHistoryResponse) ProtoMessage()    {}
+func (*QueryEmissionsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{65}
+}
+func (m *QueryEmissionsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionsHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.TotalBurned.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryEmissionsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionsHistoryResponse.Merge(m, src)
+}
+func (m *QueryEmissionsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionsHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBurnsBySourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Source != 0 {
-		n += 1 + sovQuery(uint64(m.Source))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryEmissionsHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionsHistoryResponse) GetHistory() EmissionsHistory {
+	if m != nil {
+		return m.History
 	}
-	return n
+	return EmissionsHistory{}
 }
 
-func (m *BurnsBySourceStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Source != 0 {
-		n += 1 + sovQuery(uint64(m.Source))
-	}
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.BurnCount != 0 {
-		n += 1 + sovQuery(uint64(m.BurnCount))
-	}
-	l = m.CurrentBurnRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AverageBurnAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// BurnRatioChange is one entry of the adaptive burn controller's decision
+// history: the ratio applied from height on and the trigger that chose it.
+// Stored as JSON under BurnRatioHistoryPrefix.
+type BurnRatioChange struct {
+	Height       int64                       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	AppliedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=applied_ratio,json=appliedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"applied_ratio"`
+	Trigger      string                      `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
 }
 
-func (m *QueryBurnsBySourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Burns) > 0 {
-		for _, e := range m.Burns {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *BurnRatioChange) Reset()         { *m = BurnRatioChange{} }
+func (m *BurnRatioChange) String() string { return proto.CompactTextString(m) }
+func (*BurnRatioChange) ProtoMessage()    {}
+func (*BurnRatioChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{66}
+}
+func (m *BurnRatioChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnRatioChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnRatioChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Stats.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *BurnRatioChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnRatioChange.Merge(m, src)
+}
+func (m *BurnRatioChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnRatioChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnRatioChange.DiscardUnknown(m)
 }
 
-func (m *QueryBurnsByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_BurnRatioChange proto.InternalMessageInfo
+
+func (m *BurnRatioChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *QueryBurnsByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BurnRatioChange) GetTrigger() string {
+	if m != nil {
+		return m.Trigger
 	}
-	var l int
-	_ = l
-	if len(m.Burns) > 0 {
-		for _, e := range m.Burns {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryBurnRatioHistoryRequest is request type for the Query/BurnRatioHistory RPC method.
+type QueryBurnRatioHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBurnRatioHistoryRequest) Reset()         { *m = QueryBurnRatioHistoryRequest{} }
+func (m *QueryBurnRatioHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnRatioHistoryRequest) ProtoMessage()    {}
+func (*QueryBurnRatioHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{67}
+}
+func (m *QueryBurnRatioHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnRatioHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnRatioHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.TotalBurnedOnChain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryBurnRatioHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnRatioHistoryRequest.Merge(m, src)
+}
+func (m *QueryBurnRatioHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnRatioHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnRatioHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTreasuryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBurnRatioHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBurnRatioHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryTreasuryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TreasuryBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalTreasuryInflows.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.FromInflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.FromBurnRedirect.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TreasuryBurnRedirectPct.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.TreasuryAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryBurnRatioHistoryResponse is response type for the Query/BurnRatioHistory RPC method.
+type QueryBurnRatioHistoryResponse struct {
+	// entries are oldest first
+	Entries []BurnRatioChange `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProjectionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.YearsAhead != 0 {
-		n += 1 + sovQuery(uint64(m.YearsAhead))
-	}
-	if m.AssumeConstantBurnRate {
-		n += 2
+func (m *QueryBurnRatioHistoryResponse) Reset()         { *m = QueryBurnRatioHistoryResponse{} }
+func (m *QueryBurnRatioHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnRatioHistoryResponse) ProtoMessage()    {}
+func (*QueryBurnRatioHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff681edaa2931a07, []int{68}
+}
+func (m *QueryBurnRatioHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnRatioHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnRatioHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBurnRatioHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnRatioHistoryResponse.Merge(m, src)
+}
+func (m *QueryBurnRatioHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnRatioHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnRatioHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *SupplyProjection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Year != 0 {
-		n += 1 + sovQuery(uint64(m.Year))
-	}
-	l = m.ProjectedSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ProjectedMinted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ProjectedBurned.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NetGrowthRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.YearsUntilCap != 0 {
-		n += 1 + sovQuery(uint64(m.YearsUntilCap))
+var xxx_messageInfo_QueryBurnRatioHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBurnRatioHistoryResponse) GetEntries() []BurnRatioChange {
+	if m != nil {
+		return m.Entries
 	}
-	return n
+	return nil
 }
 
-func (m *QueryProjectionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Projections) > 0 {
-		for _, e := range m.Projections {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.Assumptions)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBurnRatioHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryChainMetricsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pos.tokenomics.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pos.tokenomics.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySupplyRequest)(nil), "pos.tokenomics.v1.QuerySupplyRequest")
+	proto.RegisterType((*QuerySupplyResponse)(nil), "pos.tokenomics.v1.QuerySupplyResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "pos.tokenomics.v1.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "pos.tokenomics.v1.QueryInflationResponse")
+	proto.RegisterType((*QueryEmissionsRequest)(nil), "pos.tokenomics.v1.QueryEmissionsRequest")
+	proto.RegisterType((*EmissionAllocation)(nil), "pos.tokenomics.v1.EmissionAllocation")
+	proto.RegisterType((*QueryEmissionsResponse)(nil), "pos.tokenomics.v1.QueryEmissionsResponse")
+	proto.RegisterType((*QueryBurnsRequest)(nil), "pos.tokenomics.v1.QueryBurnsRequest")
+	proto.RegisterType((*BurnRecord)(nil), "pos.tokenomics.v1.BurnRecord")
+	proto.RegisterType((*QueryBurnsResponse)(nil), "pos.tokenomics.v1.QueryBurnsResponse")
+	proto.RegisterType((*QueryBurnsBySourceRequest)(nil), "pos.tokenomics.v1.QueryBurnsBySourceRequest")
+	proto.RegisterType((*BurnsBySourceStats)(nil), "pos.tokenomics.v1.BurnsBySourceStats")
+	proto.RegisterType((*QueryBurnsBySourceResponse)(nil), "pos.tokenomics.v1.QueryBurnsBySourceResponse")
+	proto.RegisterType((*QueryBurnsByChainRequest)(nil), "pos.tokenomics.v1.QueryBurnsByChainRequest")
+	proto.RegisterType((*QueryBurnsByChainResponse)(nil), "pos.tokenomics.v1.QueryBurnsByChainResponse")
+	proto.RegisterType((*QueryTreasuryRequest)(nil), "pos.tokenomics.v1.QueryTreasuryRequest")
+	proto.RegisterType((*QueryTreasuryResponse)(nil), "pos.tokenomics.v1.QueryTreasuryResponse")
+	proto.RegisterType((*QueryProjectionsRequest)(nil), "pos.tokenomics.v1.QueryProjectionsRequest")
+	proto.RegisterType((*SupplyProjection)(nil), "pos.tokenomics.v1.SupplyProjection")
+	proto.RegisterType((*QueryProjectionsResponse)(nil), "pos.tokenomics.v1.QueryProjectionsResponse")
+	proto.RegisterType((*QueryChainMetricsRequest)(nil), "pos.tokenomics.v1.QueryChainMetricsRequest")
+	proto.RegisterType((*QueryChainMetricsResponse)(nil), "pos.tokenomics.v1.QueryChainMetricsResponse")
+	proto.RegisterType((*QueryFeeStatsRequest)(nil), "pos.tokenomics.v1.QueryFeeStatsRequest")
+	proto.RegisterType((*QueryFeeStatsResponse)(nil), "pos.tokenomics.v1.QueryFeeStatsResponse")
+	proto.RegisterType((*QueryBurnRateRequest)(nil), "pos.tokenomics.v1.QueryBurnRateRequest")
+	proto.RegisterType((*QueryBurnRateResponse)(nil), "pos.tokenomics.v1.QueryBurnRateResponse")
+	proto.RegisterType((*QueryStreamBurnEventsRequest)(nil), "pos.tokenomics.v1.QueryStreamBurnEventsRequest")
+	proto.RegisterType((*QueryParamsFormattedRequest)(nil), "pos.tokenomics.v1.QueryParamsFormattedRequest")
+	proto.RegisterType((*QueryParamsFormattedResponse)(nil), "pos.tokenomics.v1.QueryParamsFormattedResponse")
+	proto.RegisterType((*RedirectTargetAddresses)(nil), "pos.tokenomics.v1.RedirectTargetAddresses")
+	proto.RegisterType((*RedirectState)(nil), "pos.tokenomics.v1.RedirectState")
+	proto.RegisterType((*FullConfig)(nil), "pos.tokenomics.v1.FullConfig")
+	proto.RegisterType((*QueryFullConfigRequest)(nil), "pos.tokenomics.v1.QueryFullConfigRequest")
+	proto.RegisterType((*QueryFullConfigResponse)(nil), "pos.tokenomics.v1.QueryFullConfigResponse")
+	proto.RegisterType((*SourceBurnAttribution)(nil), "pos.tokenomics.v1.SourceBurnAttribution")
+	proto.RegisterType((*QueryBurnRateBySourceRequest)(nil), "pos.tokenomics.v1.QueryBurnRateBySourceRequest")
+	proto.RegisterType((*QueryBurnRateBySourceResponse)(nil), "pos.tokenomics.v1.QueryBurnRateBySourceResponse")
+	proto.RegisterType((*SourceBurnTotal)(nil), "pos.tokenomics.v1.SourceBurnTotal")
+	proto.RegisterType((*QueryBurnsBreakdownRequest)(nil), "pos.tokenomics.v1.QueryBurnsBreakdownRequest")
+	proto.RegisterType((*QueryBurnsBreakdownResponse)(nil), "pos.tokenomics.v1.QueryBurnsBreakdownResponse")
+	proto.RegisterType((*ChainBurnTotal)(nil), "pos.tokenomics.v1.ChainBurnTotal")
+	proto.RegisterType((*QueryAllBurnsByChainRequest)(nil), "pos.tokenomics.v1.QueryAllBurnsByChainRequest")
+	proto.RegisterType((*QueryAllBurnsByChainResponse)(nil), "pos.tokenomics.v1.QueryAllBurnsByChainResponse")
+	proto.RegisterType((*ParamChange)(nil), "pos.tokenomics.v1.ParamChange")
+	proto.RegisterType((*StagedChange)(nil), "pos.tokenomics.v1.StagedChange")
+	proto.RegisterType((*QueryPendingChangesRequest)(nil), "pos.tokenomics.v1.QueryPendingChangesRequest")
+	proto.RegisterType((*QueryPendingChangesResponse)(nil), "pos.tokenomics.v1.QueryPendingChangesResponse")
+	proto.RegisterType((*SupplyReconciliation)(nil), "pos.tokenomics.v1.SupplyReconciliation")
+	proto.RegisterType((*QuerySupplyReconciliationRequest)(nil), "pos.tokenomics.v1.QuerySupplyReconciliationRequest")
+	proto.RegisterType((*QuerySupplyReconciliationResponse)(nil), "pos.tokenomics.v1.QuerySupplyReconciliationResponse")
+	proto.RegisterType((*SupplyInvariant)(nil), "pos.tokenomics.v1.SupplyInvariant")
+	proto.RegisterType((*QuerySupplyInvariantRequest)(nil), "pos.tokenomics.v1.QuerySupplyInvariantRequest")
+	proto.RegisterType((*QuerySupplyInvariantResponse)(nil), "pos.tokenomics.v1.QuerySupplyInvariantResponse")
+	proto.RegisterType((*RedirectTargetStatus)(nil), "pos.tokenomics.v1.RedirectTargetStatus")
+	proto.RegisterType((*TreasuryRedirectStatus)(nil), "pos.tokenomics.v1.TreasuryRedirectStatus")
+	proto.RegisterType((*QueryTreasuryRedirectRequest)(nil), "pos.tokenomics.v1.QueryTreasuryRedirectRequest")
+	proto.RegisterType((*QueryTreasuryRedirectResponse)(nil), "pos.tokenomics.v1.QueryTreasuryRedirectResponse")
+	proto.RegisterType((*SupplyForecastYear)(nil), "pos.tokenomics.v1.SupplyForecastYear")
+	proto.RegisterType((*QuerySupplyForecastRequest)(nil), "pos.tokenomics.v1.QuerySupplyForecastRequest")
+	proto.RegisterType((*QuerySupplyForecastResponse)(nil), "pos.tokenomics.v1.QuerySupplyForecastResponse")
+	proto.RegisterType((*EmissionRecipientHistory)(nil), "pos.tokenomics.v1.EmissionRecipientHistory")
+	proto.RegisterType((*EmissionsHistory)(nil), "pos.tokenomics.v1.EmissionsHistory")
+	proto.RegisterType((*QueryEmissionsHistoryRequest)(nil), "pos.tokenomics.v1.QueryEmissionsHistoryRequest")
+	proto.RegisterType((*QueryEmissionsHistoryResponse)(nil), "pos.tokenomics.v1.QueryEmissionsHistoryResponse")
+	proto.RegisterType((*BurnRatioChange)(nil), "pos.tokenomics.v1.BurnRatioChange")
+	proto.RegisterType((*QueryBurnRatioHistoryRequest)(nil), "pos.tokenomics.v1.QueryBurnRatioHistoryRequest")
+	proto.RegisterType((*QueryBurnRatioHistoryResponse)(nil), "pos.tokenomics.v1.QueryBurnRatioHistoryResponse")
 }
 
-func (m *QueryChainMetricsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.TotalBurned.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalRewardsReceived.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NetFlow.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.IbcChannel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.GasConversionRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastRewardHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastRewardHeight))
-	}
-	if m.LastBurnReportHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastBurnReportHeight))
-	}
-	return n
+func init() { proto.RegisterFile("pos/tokenomics/v1/query.proto", fileDescriptor_ff681edaa2931a07) }
+
+var fileDescriptor_ff681edaa2931a07 = []byte{
+	// 4230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0xdd, 0x6f, 0x5c, 0x49,
+	0x56, 0x9f, 0xdb, 0xfe, 0x3e, 0xb6, 0xdb, 0x4e, 0xc5, 0x71, 0x3a, 0x1d, 0xdb, 0x49, 0x6e, 0x26,
+	0x89, 0xf3, 0xe5, 0x4e, 0xb2, 0x0c, 0x62, 0x04, 0x62, 0x64, 0x3b, 0x71, 0x26, 0xb0, 0xd9, 0xf1,
+	0xdc, 0xc9, 0xcc, 0xee, 0xec, 0xcc, 0xd0, 0x94, 0x6f, 0x97, 0xdb, 0x97, 0x74, 0xdf, 0xdb, 0x7b,
+	0x6f, 0xb5, 0x63, 0xef, 0x68, 0x5e, 0x76, 0x57, 0x08, 0x84, 0x40, 0x20, 0x24, 0x56, 0x62, 0x17,
+	0x78, 0x40, 0x20, 0xa4, 0x45, 0xda, 0x1d, 0xe0, 0x69, 0xff, 0x82, 0x85, 0xa7, 0x15, 0xbc, 0x20,
+	0x1e, 0x56, 0x30, 0x83, 0x04, 0x2f, 0xbc, 0xf3, 0x80, 0x04, 0xaa, 0xaa, 0x53, 0x75, 0x3f, 0x7c,
+	0xaf, 0xdd, 0xb9, 0xed, 0x45, 0xfb, 0x32, 0xe3, 0x5b, 0x1f, 0xbf, 0x3a, 0x75, 0xea, 0xd4, 0xf9,
+	0xaa, 0xd3, 0x81, 0xe5, 0x5e, 0x10, 0x35, 0x78, 0xf0, 0x9c, 0xf9, 0x41, 0xd7, 0x73, 0xa3, 0xc6,
+	0xfe, 0xfd, 0xc6, 0xd7, 0xfa, 0x2c, 0x3c, 0x5c, 0xeb, 0x85, 0x01, 0x0f, 0xc8, 0x99, 0x5e, 0x10,
+	0xad, 0xc5, 0xdd, 0x6b, 0xfb, 0xf7, 0xeb, 0x67, 0x68, 0xd7, 0xf3, 0x83, 0x86, 0xfc, 0xaf, 0x1a,
+	0x55, 0xbf, 0xe5, 0x06, 0x51, 0x37, 0x88, 0x1a, 0x3b, 0x34, 0x62, 0x6a, 0x7a, 0x63, 0xff, 0xfe,
+	0x0e, 0xe3, 0xf4, 0x7e, 0xa3, 0x47, 0xdb, 0x9e, 0x4f, 0xb9, 0x17, 0xf8, 0x38, 0xf6, 0x82, 0x1a,
+	0xdb, 0x94, 0x5f, 0x0d, 0xf5, 0x81, 0x5d, 0x0b, 0xed, 0xa0, 0x1d, 0xa8, 0x76, 0xf1, 0x17, 0xb6,
+	0x2e, 0xb5, 0x83, 0xa0, 0xdd, 0x61, 0x0d, 0xda, 0xf3, 0x1a, 0xd4, 0xf7, 0x03, 0x2e, 0xd1, 0xf4,
+	0x9c, 0x95, 0xa3, 0xf4, 0xf7, 0x68, 0x48, 0xbb, 0xba, 0xbf, 0x7e, 0xb4, 0x9f, 0x1f, 0xa8, 0x3e,
+	0x7b, 0x01, 0xc8, 0xdb, 0x82, 0xd8, 0x6d, 0x39, 0xc1, 0x61, 0x5f, 0xeb, 0xb3, 0x88, 0xdb, 0x1f,
+	0xc1, 0xd9, 0x54, 0x6b, 0xd4, 0x0b, 0xfc, 0x88, 0x91, 0x2d, 0x18, 0x57, 0xc0, 0x35, 0xeb, 0xb2,
+	0xb5, 0x3a, 0xfd, 0xe0, 0xea, 0xda, 0x11, 0xd6, 0xac, 0x3d, 0x33, 0x5f, 0x6a, 0xf2, 0xc6, 0xd4,
+	0x8f, 0x7e, 0x72, 0xe9, 0x95, 0xbf, 0xfa, 0x8f, 0x1f, 0xdc, 0xb2, 0x1c, 0x9c, 0x6d, 0x16, 0x7d,
+	0xa7, 0xdf, 0xeb, 0x75, 0x0e, 0xf5, 0xa2, 0x9f, 0x8d, 0xc1, 0xd9, 0x54, 0x33, 0xae, 0xfa, 0x2e,
+	0xcc, 0xf3, 0x80, 0xd3, 0x4e, 0x33, 0x92, 0xed, 0x4d, 0x97, 0xf6, 0xe4, 0xfa, 0x53, 0x1b, 0xb7,
+	0x05, 0xf4, 0xbf, 0xfc, 0xe4, 0xd2, 0x39, 0xc5, 0xc2, 0xa8, 0xf5, 0x7c, 0xcd, 0x0b, 0x1a, 0x5d,
+	0xca, 0xf7, 0xd6, 0x9e, 0xf8, 0xfc, 0x1f, 0xff, 0xee, 0x2e, 0x20, 0x6f, 0x9f, 0xf8, 0xdc, 0xa9,
+	0x4a, 0x10, 0x85, 0xbd, 0x49, 0x7b, 0xe4, 0x23, 0x58, 0x70, 0xfb, 0x61, 0xc8, 0x7c, 0xde, 0x4c,
+	0xc2, 0xd7, 0x2a, 0x2f, 0x0f, 0x4d, 0x10, 0xe8, 0x59, 0xbc, 0x02, 0xf9, 0x12, 0xcc, 0x28, 0xd8,
+	0xae, 0xe7, 0x73, 0xd6, 0xaa, 0x8d, 0xbc, 0x3c, 0xec, 0xb4, 0x04, 0x78, 0x2a, 0xe7, 0xc7, 0x78,
+	0x3b, 0xfd, 0xd0, 0x67, 0xad, 0xda, 0x68, 0x59, 0xbc, 0x0d, 0x39, 0x9f, 0x7c, 0x15, 0x48, 0xc8,
+	0xba, 0xd4, 0xf3, 0x3d, 0xbf, 0x2d, 0x69, 0xa4, 0x3b, 0x1d, 0x56, 0x1b, 0x7b, 0x79, 0xd4, 0x33,
+	0x06, 0xe6, 0x29, 0xa2, 0x90, 0x0f, 0xe1, 0x0c, 0x9e, 0x55, 0xcf, 0xe5, 0xcd, 0x60, 0x57, 0x1e,
+	0xd9, 0xb8, 0x84, 0xbe, 0x8f, 0xd0, 0x17, 0x8f, 0x42, 0x7f, 0x91, 0xb5, 0xa9, 0x7b, 0xf8, 0x90,
+	0xb9, 0x89, 0x05, 0x1e, 0x32, 0xd7, 0xa9, 0x2a, 0xac, 0x6d, 0x97, 0xbf, 0xb5, 0x2b, 0x0e, 0xae,
+	0x09, 0xc4, 0x67, 0xbc, 0xe9, 0xf9, 0xbb, 0x1d, 0x79, 0x0d, 0x9a, 0x21, 0xe5, 0xac, 0x36, 0x51,
+	0x16, 0x7e, 0xde, 0x67, 0xfc, 0x89, 0xc6, 0x72, 0x28, 0x67, 0x82, 0x35, 0xae, 0x17, 0xba, 0x7d,
+	0xd1, 0xe4, 0xb7, 0xb5, 0x5c, 0x4c, 0x96, 0x60, 0x4d, 0x02, 0x46, 0x89, 0x85, 0x7d, 0x1e, 0xce,
+	0x49, 0x19, 0x8f, 0x57, 0x44, 0xe9, 0xff, 0x83, 0x51, 0x58, 0xcc, 0xf6, 0xe0, 0x05, 0x68, 0xc3,
+	0xa2, 0x96, 0xd4, 0xcc, 0xa6, 0xad, 0xb2, 0x9b, 0xd6, 0xa2, 0x9f, 0xde, 0xf8, 0x7b, 0x30, 0x1b,
+	0x2f, 0xd0, 0xf5, 0xfc, 0x5a, 0xa5, 0x2c, 0xfe, 0x8c, 0xc1, 0x79, 0xea, 0xf9, 0x19, 0x5c, 0x7a,
+	0x50, 0x1b, 0x39, 0x05, 0x5c, 0x7a, 0x40, 0xbe, 0x02, 0x67, 0xa8, 0xef, 0xf7, 0x69, 0x47, 0x68,
+	0xd2, 0x7d, 0x2f, 0x12, 0x3a, 0xb1, 0xcc, 0xc5, 0x98, 0x57, 0x28, 0xdb, 0x06, 0x84, 0x7c, 0x08,
+	0xf3, 0x3b, 0x9d, 0xc0, 0x7d, 0x9e, 0x04, 0x1e, 0x2b, 0x4b, 0xf4, 0x9c, 0x84, 0x4a, 0xa0, 0x5f,
+	0x07, 0xd5, 0x14, 0x35, 0x7b, 0x2c, 0x6c, 0x1e, 0x32, 0x1a, 0xca, 0xdb, 0x31, 0xea, 0xcc, 0xaa,
+	0xe6, 0x6d, 0x16, 0xbe, 0xcf, 0x68, 0x68, 0x84, 0xe5, 0x51, 0xd7, 0x8b, 0xe4, 0x4c, 0x2d, 0x2c,
+	0xdf, 0xaf, 0x00, 0xd1, 0x8d, 0xeb, 0x9d, 0x4e, 0xe0, 0x4a, 0x96, 0x90, 0x3a, 0x4c, 0xba, 0x94,
+	0xb3, 0x76, 0x10, 0x1e, 0x2a, 0xd1, 0x70, 0xcc, 0x37, 0x79, 0x1b, 0xa0, 0xc7, 0x42, 0x97, 0xf9,
+	0x9c, 0xb6, 0x59, 0xf9, 0x83, 0x4d, 0x80, 0x90, 0x6d, 0x98, 0x45, 0xf6, 0xd3, 0x6e, 0xd0, 0xf7,
+	0x79, 0x19, 0x1d, 0x37, 0xa3, 0x10, 0xd6, 0x25, 0x80, 0x38, 0x50, 0xa5, 0xe4, 0x5a, 0x5e, 0xc4,
+	0x43, 0x6f, 0xa7, 0xcf, 0xcb, 0x69, 0x3a, 0x65, 0x30, 0x1e, 0xc6, 0x20, 0xf6, 0xb7, 0x2a, 0x78,
+	0xbd, 0x12, 0xbc, 0xc4, 0xeb, 0xf5, 0x14, 0xa6, 0xa9, 0xe1, 0xa1, 0x30, 0x6d, 0x23, 0xab, 0xd3,
+	0x0f, 0xae, 0xe5, 0x98, 0xb6, 0xa3, 0x1c, 0xdf, 0x18, 0x15, 0x54, 0x39, 0xc9, 0xf9, 0x84, 0xc2,
+	0xa2, 0xda, 0x03, 0xf2, 0x86, 0xe9, 0x05, 0xcb, 0x58, 0x96, 0x05, 0x09, 0xb5, 0x2e, 0x91, 0x0c,
+	0xe5, 0xe4, 0x17, 0xa0, 0xd6, 0xa1, 0x11, 0x8f, 0xb9, 0x24, 0xee, 0xd5, 0x1e, 0xf3, 0xda, 0x7b,
+	0xea, 0x0c, 0x46, 0x9c, 0x45, 0xd1, 0xff, 0x30, 0xd1, 0xfd, 0xa6, 0xec, 0xb5, 0x3f, 0x80, 0x33,
+	0x92, 0x0b, 0xc2, 0x08, 0x68, 0x69, 0x22, 0x5b, 0x00, 0xb1, 0x8b, 0x82, 0xa6, 0xfd, 0xfa, 0x1a,
+	0x52, 0x21, 0xfc, 0x99, 0x35, 0xe5, 0x0e, 0xa1, 0x3f, 0xb3, 0xb6, 0x4d, 0xdb, 0x0c, 0xe7, 0x3a,
+	0x89, 0x99, 0xf6, 0xb7, 0x47, 0x00, 0x04, 0xb0, 0xc3, 0xdc, 0x20, 0x6c, 0x91, 0xf3, 0x30, 0x21,
+	0x6c, 0x55, 0xd3, 0x6b, 0x49, 0xcc, 0x51, 0x67, 0x5c, 0x7c, 0x3e, 0x69, 0x91, 0x4d, 0x18, 0x47,
+	0x81, 0x29, 0xc1, 0x11, 0x9c, 0x4a, 0x5e, 0x83, 0xf1, 0x28, 0xe8, 0x87, 0x2e, 0x93, 0x3b, 0xae,
+	0x3e, 0x58, 0xce, 0x39, 0x30, 0x41, 0xcc, 0x3b, 0x72, 0x90, 0x83, 0x83, 0xc9, 0x05, 0x98, 0x74,
+	0xf7, 0xa8, 0x27, 0xa9, 0x92, 0x82, 0xe5, 0x4c, 0xc8, 0xef, 0x27, 0x2d, 0x72, 0x05, 0x66, 0xd4,
+	0x9d, 0x47, 0x4e, 0x8e, 0x49, 0x4e, 0x4e, 0xcb, 0x36, 0xc5, 0x3e, 0xb1, 0x25, 0x7e, 0xd0, 0xdc,
+	0xa3, 0xd1, 0x9e, 0x32, 0x67, 0xce, 0x38, 0x3f, 0x78, 0x93, 0x46, 0x7b, 0x64, 0x09, 0xa6, 0xb8,
+	0xd7, 0x65, 0x11, 0xa7, 0xdd, 0x9e, 0x34, 0x45, 0x23, 0x4e, 0xdc, 0x40, 0xae, 0x41, 0x55, 0x5a,
+	0xed, 0xb0, 0x49, 0x5b, 0xad, 0x90, 0x45, 0x91, 0x32, 0x26, 0xce, 0xac, 0x6a, 0x5d, 0x57, 0x8d,
+	0x52, 0xfa, 0x43, 0x46, 0xa3, 0x7e, 0x78, 0xd8, 0x0c, 0x59, 0xcb, 0x0b, 0x99, 0xcb, 0x6b, 0x53,
+	0x65, 0xa4, 0x1f, 0x51, 0x1c, 0x04, 0xb1, 0xff, 0xd3, 0x42, 0x8f, 0x0b, 0xcf, 0x1d, 0x25, 0xff,
+	0x75, 0x18, 0x13, 0x14, 0x68, 0x99, 0x2f, 0x62, 0xa1, 0x3a, 0x4f, 0x94, 0x75, 0x35, 0x83, 0x3c,
+	0x4e, 0xc9, 0x4c, 0x45, 0xca, 0xcc, 0x8d, 0x13, 0x65, 0x46, 0xad, 0x9b, 0x14, 0x9a, 0x23, 0x7e,
+	0xcd, 0xc8, 0x70, 0x7e, 0x8d, 0xfd, 0xc7, 0x16, 0x5c, 0x88, 0xb7, 0xba, 0x71, 0x88, 0xe7, 0x8f,
+	0xa2, 0x1e, 0x4b, 0x8d, 0xf5, 0x32, 0x52, 0xb3, 0x95, 0xb3, 0xdb, 0x32, 0x37, 0xe4, 0x7f, 0x2a,
+	0x40, 0x52, 0x74, 0xbd, 0xc3, 0x29, 0x8f, 0xca, 0x52, 0x65, 0x58, 0x57, 0xfe, 0x36, 0x29, 0xd6,
+	0xa1, 0xf6, 0x5d, 0x06, 0x90, 0x17, 0xd6, 0x35, 0xca, 0x7c, 0xd4, 0x99, 0x12, 0x2d, 0x9b, 0xb2,
+	0xfb, 0x23, 0x38, 0xa3, 0xdd, 0x10, 0x39, 0x4c, 0x7a, 0x20, 0xa3, 0xa5, 0x8d, 0x22, 0x62, 0x49,
+	0x01, 0x13, 0xce, 0x07, 0x85, 0xb3, 0x74, 0x9f, 0x85, 0xb4, 0xcd, 0x14, 0x3c, 0x6e, 0xaa, 0xb4,
+	0xd5, 0x3d, 0x83, 0x68, 0x62, 0x01, 0xb5, 0x41, 0xfb, 0x73, 0x0b, 0xea, 0x79, 0xb2, 0xf1, 0x33,
+	0x74, 0x1d, 0xd6, 0x61, 0x2c, 0x12, 0x32, 0x21, 0xd9, 0x9f, 0x6f, 0x86, 0x8e, 0x0a, 0x90, 0xa6,
+	0x45, 0xce, 0xb4, 0x3f, 0x81, 0x5a, 0x72, 0x93, 0x9b, 0x42, 0xbd, 0x69, 0xf9, 0x4f, 0xaa, 0x3f,
+	0x2b, 0xad, 0xfe, 0x4e, 0x4b, 0xc6, 0xff, 0x37, 0x73, 0x01, 0x71, 0xfd, 0x9f, 0x21, 0x1e, 0xff,
+	0x1a, 0x9c, 0x4b, 0xaa, 0x9c, 0x66, 0xe0, 0x37, 0x25, 0x13, 0xca, 0xe8, 0x1e, 0x92, 0xd0, 0x3d,
+	0x6f, 0xf9, 0x72, 0xaf, 0xf6, 0x22, 0x2c, 0x48, 0x06, 0x3c, 0x33, 0x6a, 0x58, 0x79, 0x6d, 0xdf,
+	0x1d, 0x85, 0x73, 0x99, 0x0e, 0xe4, 0xca, 0x7b, 0x60, 0x74, 0x76, 0x73, 0x87, 0x76, 0xa8, 0xef,
+	0xb2, 0x32, 0x21, 0xee, 0x9c, 0x06, 0xd9, 0x50, 0x18, 0xb1, 0x2f, 0x62, 0xd0, 0x85, 0xff, 0x1c,
+	0xbc, 0x18, 0xc2, 0x17, 0xd1, 0xb4, 0x3f, 0x51, 0x40, 0xc4, 0x81, 0xea, 0x6e, 0x18, 0x74, 0xe3,
+	0xc8, 0xa4, 0x0c, 0x17, 0x67, 0x05, 0x84, 0x89, 0x45, 0xc8, 0xfb, 0x40, 0x24, 0xa6, 0x52, 0x33,
+	0xda, 0x12, 0x96, 0xf1, 0x03, 0x05, 0x8c, 0x92, 0x27, 0x05, 0x42, 0x7c, 0xa8, 0xc7, 0x9c, 0x4e,
+	0xc2, 0x8b, 0x50, 0xb5, 0xbc, 0xb2, 0x39, 0x6f, 0x38, 0x9f, 0x58, 0x6c, 0xdb, 0xe5, 0xe4, 0x66,
+	0xe2, 0x64, 0xb5, 0xf1, 0x57, 0xae, 0x83, 0x39, 0x2c, 0x34, 0xff, 0x76, 0x1f, 0xce, 0xab, 0xa4,
+	0x4b, 0x18, 0xfc, 0x06, 0x73, 0x79, 0xc2, 0xdf, 0x27, 0x97, 0x60, 0x5a, 0x44, 0x09, 0x51, 0x93,
+	0xee, 0x31, 0xaa, 0x6e, 0xee, 0xac, 0x03, 0xb2, 0x69, 0x5d, 0xb4, 0x90, 0xd7, 0xe1, 0x02, 0x8d,
+	0xa2, 0x7e, 0x97, 0x35, 0xdd, 0xc0, 0x8f, 0x38, 0x4d, 0xe9, 0x68, 0x71, 0xd6, 0x93, 0xce, 0xa2,
+	0x1a, 0xb0, 0x89, 0xfd, 0x5a, 0xef, 0xda, 0x9f, 0x8e, 0xc0, 0xbc, 0x0a, 0x4e, 0xe3, 0x85, 0x09,
+	0x81, 0x51, 0x19, 0x96, 0xa8, 0x95, 0xe4, 0xdf, 0x42, 0x48, 0x7b, 0x6a, 0x04, 0x6b, 0x0d, 0x91,
+	0x2c, 0x99, 0x33, 0x20, 0x6a, 0xd5, 0x34, 0x6e, 0xf9, 0x6c, 0x49, 0x8c, 0x8b, 0x19, 0x93, 0x14,
+	0x6e, 0xf9, 0xac, 0x49, 0x8c, 0x8b, 0x99, 0x93, 0xf7, 0x61, 0x4e, 0xe4, 0x1f, 0xda, 0x61, 0xf0,
+	0x82, 0xef, 0x29, 0x0e, 0x97, 0x96, 0x9b, 0x59, 0x9f, 0xf1, 0xc7, 0x12, 0x48, 0xda, 0xc0, 0xeb,
+	0x30, 0xa7, 0xce, 0xb9, 0xef, 0x73, 0xaf, 0x63, 0xd2, 0x26, 0xb3, 0xce, 0xac, 0x6c, 0x7e, 0x57,
+	0xb4, 0x6e, 0xd2, 0x9e, 0xfd, 0xdb, 0x16, 0xea, 0xf8, 0x94, 0xac, 0xa0, 0x32, 0xf9, 0x55, 0x98,
+	0xee, 0xc5, 0xcd, 0xa8, 0x68, 0xf3, 0x52, 0x75, 0xd9, 0x53, 0xd7, 0xd1, 0x4c, 0x62, 0x36, 0xb9,
+	0x0c, 0xd3, 0x52, 0x6e, 0x7a, 0x3c, 0x0e, 0x61, 0x9c, 0x64, 0x93, 0xfd, 0x1a, 0x92, 0x22, 0x75,
+	0xdf, 0x53, 0xc6, 0x43, 0xcf, 0x8d, 0x4e, 0x36, 0x37, 0x42, 0x19, 0x5e, 0xc8, 0x99, 0x87, 0x7b,
+	0x38, 0xc6, 0x4e, 0x65, 0x1d, 0xc6, 0xca, 0x90, 0x89, 0x30, 0xa3, 0x23, 0x43, 0xf6, 0x82, 0x86,
+	0xad, 0xa8, 0x19, 0x32, 0x97, 0x79, 0xfb, 0xe5, 0x84, 0x50, 0xe9, 0x48, 0x47, 0x21, 0x39, 0x08,
+	0x44, 0xb6, 0x60, 0x52, 0x48, 0x8c, 0x50, 0x98, 0x65, 0x24, 0x70, 0xc2, 0x67, 0x7c, 0xab, 0x13,
+	0xbc, 0x10, 0x6a, 0xc0, 0xdb, 0x71, 0x85, 0xb1, 0xf2, 0x7d, 0xd6, 0x51, 0x52, 0xe7, 0x80, 0xb7,
+	0xe3, 0x6e, 0xaa, 0x16, 0xe2, 0xc2, 0x42, 0x9b, 0x46, 0x42, 0x07, 0xec, 0xb3, 0x30, 0xc2, 0x34,
+	0x91, 0x17, 0x94, 0xcf, 0xbd, 0x91, 0x36, 0x8d, 0x36, 0x0d, 0x9a, 0x23, 0xc0, 0xc8, 0x1d, 0x20,
+	0x32, 0xfa, 0x54, 0xfc, 0xd2, 0xd1, 0x92, 0x0a, 0x7a, 0xe6, 0x45, 0x8f, 0xda, 0x3e, 0x86, 0x4c,
+	0xaf, 0xc1, 0x79, 0x39, 0x1a, 0x95, 0x6d, 0x2f, 0x08, 0xb9, 0x9e, 0x32, 0x29, 0xa7, 0x2c, 0x88,
+	0x6e, 0xa5, 0x36, 0x45, 0x27, 0x06, 0xaa, 0xda, 0x86, 0x6e, 0x31, 0xe5, 0xe2, 0x68, 0x1b, 0xfa,
+	0x3d, 0x6d, 0x43, 0xe3, 0x0e, 0x14, 0x99, 0x2f, 0xeb, 0xdc, 0xc1, 0x2e, 0x63, 0x91, 0x16, 0x8e,
+	0x52, 0x46, 0x54, 0xa0, 0x6c, 0x31, 0x16, 0xa1, 0x80, 0xfc, 0x3a, 0x2c, 0x26, 0x80, 0x79, 0x60,
+	0x8c, 0x69, 0x19, 0xd1, 0x3b, 0x6b, 0xd0, 0x9f, 0x05, 0xda, 0x94, 0x92, 0x08, 0x96, 0xb5, 0xeb,
+	0x9b, 0x20, 0x5e, 0x26, 0x87, 0x64, 0xf4, 0x59, 0x3e, 0x5f, 0x76, 0x01, 0x71, 0xe3, 0xed, 0x6c,
+	0xb3, 0x70, 0x43, 0x60, 0x92, 0x55, 0x98, 0xdf, 0x65, 0xe8, 0x6b, 0x33, 0x5f, 0xe4, 0x6d, 0x95,
+	0x7a, 0x9c, 0x74, 0xaa, 0xbb, 0x4c, 0x7a, 0xcd, 0x8f, 0x54, 0x2b, 0xf9, 0x32, 0x54, 0xcd, 0x48,
+	0x25, 0x4f, 0xa5, 0xf5, 0xdd, 0x0c, 0x42, 0x2b, 0x49, 0x6a, 0x02, 0x31, 0xc6, 0x51, 0xac, 0x30,
+	0xa4, 0xb0, 0x1a, 0x4b, 0xbb, 0xc5, 0x98, 0x5c, 0xc0, 0x48, 0x11, 0x2e, 0xa9, 0xfd, 0x55, 0xfb,
+	0xdb, 0xe3, 0x70, 0x2e, 0xd3, 0x81, 0x52, 0xf4, 0x00, 0xce, 0xd1, 0x16, 0xed, 0x71, 0x6f, 0x3f,
+	0xc3, 0x1a, 0x4b, 0xb2, 0xe6, 0xac, 0xee, 0x4c, 0xf2, 0xa7, 0x09, 0x24, 0x1b, 0x18, 0x79, 0x41,
+	0xf9, 0x14, 0xdb, 0x7c, 0x3a, 0x32, 0xf2, 0x02, 0x52, 0x83, 0x09, 0x1e, 0x7a, 0xed, 0x36, 0x0b,
+	0x95, 0x24, 0x38, 0xfa, 0x53, 0x1c, 0x4d, 0xd7, 0xf3, 0x93, 0xcb, 0x96, 0x0e, 0xc8, 0x66, 0xba,
+	0x9e, 0x1f, 0x2f, 0x29, 0x80, 0xe9, 0xc1, 0xe9, 0x9c, 0x79, 0x97, 0x1e, 0xa4, 0xce, 0xbc, 0xc5,
+	0x76, 0x69, 0xbf, 0x93, 0x62, 0x56, 0xf9, 0x33, 0x47, 0xb0, 0x78, 0x01, 0x93, 0xba, 0x75, 0x03,
+	0xbf, 0xcd, 0x22, 0xe9, 0x92, 0x4e, 0x0c, 0x97, 0xba, 0xdd, 0x34, 0x48, 0xe4, 0x19, 0xcc, 0x18,
+	0x91, 0xed, 0xb9, 0x4a, 0x87, 0x95, 0x42, 0x9e, 0xd6, 0x30, 0xc2, 0x4b, 0xdc, 0x86, 0x2a, 0xdd,
+	0x6f, 0x37, 0xf9, 0x81, 0xbc, 0xf3, 0x2d, 0x7a, 0x58, 0x26, 0xed, 0x33, 0x4d, 0xf7, 0xdb, 0xcf,
+	0x0e, 0xb6, 0x59, 0xf8, 0x90, 0x1e, 0x92, 0x9f, 0x87, 0xf3, 0xac, 0xcb, 0xc2, 0x36, 0xf3, 0x5d,
+	0x74, 0x74, 0x83, 0x7d, 0x16, 0x86, 0x5e, 0x8b, 0xd5, 0x40, 0x4a, 0xf2, 0x39, 0xd3, 0x2d, 0x58,
+	0xf7, 0x16, 0x76, 0xda, 0x2b, 0xb0, 0xa4, 0xde, 0xe0, 0x04, 0x79, 0xd2, 0x75, 0x7e, 0xb4, 0xcf,
+	0xfc, 0x58, 0xff, 0x2e, 0xc3, 0xc5, 0xc4, 0xcb, 0xe0, 0x56, 0x10, 0x76, 0x29, 0xe7, 0xac, 0xa5,
+	0xbb, 0x7f, 0x09, 0x96, 0xf2, 0xbb, 0xf1, 0x7a, 0x2d, 0xc1, 0xd4, 0xae, 0x6e, 0x44, 0xc3, 0x1e,
+	0x37, 0xd8, 0x7f, 0x63, 0xc1, 0x79, 0xed, 0x3c, 0x3f, 0xa3, 0x61, 0x9b, 0x71, 0xf4, 0x8d, 0x59,
+	0x24, 0x1c, 0x69, 0xe6, 0x06, 0xd1, 0x61, 0xc4, 0x59, 0xb7, 0xd9, 0x0e, 0xa9, 0xcf, 0x23, 0x04,
+	0x98, 0x33, 0xed, 0x8f, 0x65, 0x33, 0xb9, 0x0c, 0x33, 0x3b, 0xfd, 0xc3, 0x26, 0xf5, 0x95, 0xdb,
+	0x87, 0x4e, 0x0b, 0xec, 0xf4, 0x0f, 0xd7, 0x7d, 0xe9, 0xc4, 0x89, 0x84, 0x9c, 0xe7, 0x47, 0xfd,
+	0x50, 0x04, 0x49, 0xcd, 0xdd, 0xbe, 0x8f, 0xb6, 0xde, 0x99, 0x35, 0xad, 0x5b, 0x7d, 0xbf, 0x45,
+	0xae, 0xc2, 0x6c, 0xc8, 0x22, 0x46, 0x43, 0x77, 0x4f, 0x8d, 0x52, 0x19, 0xc3, 0x19, 0xdd, 0x28,
+	0x06, 0xd9, 0xbf, 0x55, 0x81, 0x59, 0x4d, 0xb4, 0xb0, 0x48, 0x8c, 0xdc, 0x83, 0x05, 0x34, 0x90,
+	0xaa, 0x55, 0xdb, 0x3b, 0x4b, 0xda, 0x3b, 0xa2, 0x4c, 0xa4, 0xea, 0x42, 0x23, 0xd9, 0x85, 0x25,
+	0xea, 0xba, 0xfd, 0xae, 0x78, 0x2b, 0x62, 0xad, 0x78, 0xe2, 0x10, 0xd1, 0x5a, 0x3d, 0x01, 0xa8,
+	0x57, 0xd3, 0x31, 0xdb, 0x7b, 0xfa, 0x45, 0x55, 0x2f, 0x54, 0xd2, 0xe3, 0x46, 0x67, 0x47, 0x63,
+	0xd8, 0xdf, 0xab, 0x00, 0x6c, 0xf5, 0x3b, 0x9d, 0xcd, 0xc0, 0xdf, 0xf5, 0xda, 0xa7, 0xf5, 0x5c,
+	0x9c, 0x1b, 0x43, 0x55, 0x72, 0x63, 0x28, 0xf2, 0x01, 0xcc, 0x1b, 0xe6, 0x71, 0x29, 0x41, 0x3a,
+	0x93, 0x72, 0x2b, 0x67, 0xf1, 0x02, 0x59, 0x43, 0x3f, 0x78, 0x2e, 0x4c, 0x75, 0x47, 0xe4, 0x29,
+	0x54, 0x0d, 0x78, 0xc4, 0x75, 0xf6, 0x6b, 0xfa, 0xc1, 0xe5, 0x63, 0xa0, 0xa5, 0x44, 0x20, 0xe0,
+	0x6c, 0x98, 0x6c, 0xb4, 0x6b, 0xf8, 0x22, 0x11, 0x73, 0x4c, 0xdf, 0xa2, 0xf7, 0xe0, 0xfc, 0x91,
+	0x1e, 0xbc, 0x40, 0xbf, 0x08, 0xe3, 0xae, 0x6c, 0x41, 0x9e, 0xe6, 0x25, 0x50, 0xe2, 0x69, 0xb8,
+	0x30, 0x4e, 0xb1, 0xff, 0xa4, 0x02, 0xe7, 0x54, 0xda, 0x48, 0x26, 0xc5, 0xb8, 0x79, 0x1d, 0x20,
+	0x8b, 0xa9, 0x0c, 0xe4, 0x94, 0x49, 0x31, 0xfe, 0x0a, 0x80, 0x36, 0xfd, 0xe5, 0x5c, 0xed, 0x29,
+	0x34, 0xf8, 0xac, 0x25, 0x9e, 0x8b, 0xba, 0x41, 0xab, 0xdf, 0x61, 0x43, 0xa4, 0x7a, 0x67, 0x14,
+	0x02, 0x22, 0x9e, 0xf2, 0x9b, 0xb8, 0x51, 0x7e, 0xda, 0x2b, 0xc8, 0x64, 0x8f, 0xed, 0xff, 0xaa,
+	0xc0, 0x72, 0xc1, 0x00, 0x3c, 0x9e, 0x37, 0x61, 0x42, 0x71, 0x4e, 0xc7, 0x5d, 0xab, 0x79, 0x71,
+	0x57, 0xde, 0x11, 0xe0, 0x51, 0xe9, 0xe9, 0x71, 0xd5, 0xc3, 0x70, 0xfc, 0xaf, 0x6a, 0x7f, 0x13,
+	0x59, 0xf6, 0x01, 0x28, 0x0f, 0xb4, 0x39, 0xf4, 0x51, 0x28, 0x6f, 0xfb, 0xe9, 0x4f, 0xf3, 0x3c,
+	0x0e, 0x61, 0x2e, 0xe6, 0x95, 0x2c, 0xae, 0x28, 0x14, 0xd4, 0x53, 0x8e, 0x0a, 0xed, 0xa5, 0x54,
+	0xa6, 0x38, 0x64, 0xf4, 0x79, 0x2b, 0x78, 0x61, 0x1e, 0xeb, 0x3f, 0xb5, 0xe0, 0x62, 0x6e, 0x37,
+	0x8a, 0xc1, 0x46, 0x56, 0x0c, 0xec, 0x63, 0xc5, 0x40, 0x6e, 0x2d, 0x2b, 0x00, 0xa7, 0xbd, 0xa3,
+	0x8f, 0xa1, 0x2a, 0x43, 0xed, 0x98, 0x97, 0xff, 0x7f, 0x41, 0xb6, 0x71, 0x1b, 0xd6, 0x3b, 0x9d,
+	0x9c, 0xb4, 0xb4, 0xfd, 0x7d, 0x0b, 0x96, 0xf2, 0xfb, 0x91, 0xa1, 0x6f, 0xc0, 0xb8, 0x24, 0x4d,
+	0xf3, 0xf3, 0x4a, 0x0e, 0x3f, 0xd3, 0xbb, 0x33, 0xaa, 0x4f, 0x4e, 0x3b, 0xf5, 0x0d, 0xbd, 0x0e,
+	0xd3, 0xd2, 0x60, 0x89, 0xc8, 0xbb, 0xcd, 0xc8, 0x02, 0x8c, 0xed, 0x7a, 0xac, 0xa3, 0xf9, 0xa8,
+	0x3e, 0x44, 0xeb, 0x3e, 0xed, 0xf4, 0xf1, 0xb9, 0xdd, 0x51, 0x1f, 0xf6, 0xdf, 0x5b, 0x30, 0xf3,
+	0x8e, 0x78, 0x40, 0x6f, 0xe1, 0xe4, 0x2a, 0x54, 0xcc, 0x1b, 0x69, 0xc5, 0x6b, 0x91, 0x1b, 0x30,
+	0xc7, 0x76, 0x77, 0x99, 0x2b, 0x83, 0x10, 0xd6, 0x0b, 0xdc, 0x3d, 0x09, 0x30, 0xe2, 0x54, 0x4d,
+	0xf3, 0x23, 0xd1, 0x4a, 0x7e, 0x19, 0xc4, 0x81, 0x09, 0xdf, 0xb4, 0x36, 0x22, 0xd9, 0xb2, 0x92,
+	0xc3, 0x96, 0x04, 0x99, 0x5a, 0xc4, 0x70, 0x52, 0xe2, 0x32, 0x8d, 0xa6, 0x2e, 0xd3, 0x2a, 0xcc,
+	0x47, 0x92, 0xc0, 0x26, 0xe5, 0xe9, 0xd7, 0xd0, 0xaa, 0x6a, 0x5f, 0xd7, 0x61, 0xba, 0xbe, 0x26,
+	0xdb, 0xcc, 0x6f, 0x79, 0x7e, 0x5b, 0x2d, 0x63, 0x9c, 0xc5, 0x6f, 0xea, 0x6b, 0x92, 0xed, 0xc6,
+	0x53, 0xbd, 0x0a, 0xb3, 0x3a, 0x70, 0x52, 0xdb, 0x54, 0x1e, 0xd2, 0x0c, 0x36, 0xaa, 0x4d, 0xbe,
+	0x11, 0x6f, 0xb2, 0x22, 0x37, 0x79, 0x29, 0xef, 0x2e, 0x25, 0xf8, 0x99, 0xd9, 0xa5, 0xfd, 0x69,
+	0x05, 0x16, 0x74, 0x49, 0x99, 0x1b, 0xf8, 0xae, 0xd7, 0xf1, 0x54, 0x9a, 0x79, 0x01, 0xc6, 0x5a,
+	0x02, 0x43, 0x1f, 0x9a, 0xfc, 0x10, 0x09, 0x6d, 0x1e, 0x52, 0xf7, 0xf9, 0x50, 0x49, 0xce, 0x59,
+	0x84, 0x50, 0xeb, 0x92, 0x2f, 0xc2, 0xf4, 0x0e, 0xf5, 0x9f, 0x6b, 0xc0, 0x12, 0xda, 0x16, 0xc4,
+	0x7c, 0x44, 0x5b, 0x17, 0x74, 0x77, 0x38, 0x2d, 0xa3, 0x5f, 0xd5, 0x4c, 0xb2, 0x02, 0x10, 0x22,
+	0x33, 0x58, 0x4b, 0x9e, 0xed, 0xa4, 0x93, 0x68, 0xb1, 0x6d, 0xb8, 0x9c, 0x2a, 0xc5, 0x4b, 0xf2,
+	0x4d, 0x9f, 0xee, 0xd7, 0xe1, 0xca, 0x31, 0x63, 0x4c, 0xf1, 0x5e, 0x35, 0x4c, 0xf5, 0xa0, 0xdf,
+	0x72, 0xa3, 0x30, 0x1f, 0x99, 0x06, 0xc2, 0xc3, 0xcc, 0x80, 0xd8, 0x3f, 0xa8, 0xc0, 0x9c, 0x1a,
+	0xfe, 0xc4, 0xdf, 0xa7, 0xa1, 0x47, 0x7d, 0x7e, 0xa4, 0xe2, 0xce, 0x3a, 0xe5, 0x8a, 0xbb, 0x61,
+	0x13, 0x8d, 0x45, 0x05, 0x87, 0x23, 0xa7, 0x53, 0x70, 0xb8, 0x02, 0xe0, 0x06, 0x7e, 0xe4, 0x45,
+	0x9c, 0xf9, 0x1c, 0x33, 0x39, 0x89, 0x16, 0xa3, 0x82, 0x33, 0x6c, 0xd3, 0xa7, 0xb9, 0x0b, 0x4b,
+	0xf9, 0xdd, 0xa6, 0xf6, 0x73, 0xca, 0xd3, 0x8d, 0x78, 0x86, 0x76, 0xe1, 0x19, 0x9a, 0xe9, 0x78,
+	0x7c, 0xf1, 0x54, 0xfb, 0x77, 0x2d, 0x58, 0x48, 0xfb, 0xdd, 0xc2, 0x1b, 0xee, 0x47, 0xe2, 0xc9,
+	0xc1, 0xa7, 0x5d, 0x6d, 0xd7, 0xe5, 0xdf, 0x22, 0xf1, 0x91, 0x76, 0xf8, 0xf5, 0x27, 0x79, 0x0c,
+	0x63, 0x2a, 0x73, 0x50, 0x3a, 0x35, 0xa6, 0xe6, 0xdb, 0x3f, 0x1c, 0x85, 0xc5, 0x67, 0x99, 0x7a,
+	0x09, 0xa4, 0xa8, 0x06, 0x13, 0xe9, 0xec, 0x8f, 0xfe, 0x8c, 0x57, 0xaf, 0x0c, 0xb7, 0x3a, 0xb9,
+	0x0b, 0x84, 0x1d, 0x30, 0x57, 0x55, 0xf0, 0x08, 0xb1, 0x0b, 0xf7, 0x69, 0x07, 0x9f, 0xde, 0xcf,
+	0x98, 0x9e, 0x27, 0xd8, 0x21, 0x9e, 0xe0, 0xbb, 0x54, 0x25, 0x09, 0x4c, 0xe7, 0x10, 0x4f, 0xf0,
+	0x5d, 0x2a, 0xd2, 0x05, 0x8f, 0x34, 0x12, 0xf9, 0x10, 0xce, 0x26, 0xc3, 0x50, 0x1d, 0x7d, 0x96,
+	0x28, 0x0a, 0x25, 0x09, 0x9c, 0xe3, 0xa2, 0xce, 0xf1, 0xe1, 0xa3, 0xce, 0xc2, 0x70, 0x7b, 0xa2,
+	0x30, 0xdc, 0x7e, 0x0c, 0x13, 0x3a, 0x38, 0x9c, 0xbc, 0x3c, 0x52, 0xa0, 0x8d, 0xf2, 0x84, 0x54,
+	0x9b, 0x16, 0x9c, 0x6d, 0x02, 0x86, 0xac, 0x00, 0xe9, 0x4b, 0xd5, 0x81, 0xe5, 0x82, 0x7e, 0xf3,
+	0x56, 0x33, 0x69, 0xde, 0x37, 0xd5, 0xa5, 0xba, 0x99, 0x17, 0x24, 0xe7, 0xca, 0x27, 0x12, 0x63,
+	0x00, 0xec, 0xff, 0x1e, 0x01, 0xa2, 0xee, 0xdf, 0x56, 0x10, 0x32, 0x97, 0x46, 0x5c, 0x54, 0x11,
+	0xa6, 0xde, 0xf2, 0x46, 0xf0, 0x2d, 0xef, 0x2b, 0x22, 0x01, 0x92, 0x2a, 0x25, 0x2d, 0x2d, 0xc9,
+	0x71, 0x69, 0xa7, 0x7c, 0xc2, 0xfa, 0x12, 0xcc, 0x44, 0x9c, 0x86, 0x7c, 0x08, 0xed, 0x36, 0x2d,
+	0x01, 0x50, 0xad, 0xbd, 0x01, 0xa3, 0x42, 0x9f, 0x97, 0xb1, 0x75, 0x72, 0xa2, 0x00, 0x90, 0x59,
+	0xa0, 0x12, 0x52, 0x2c, 0x27, 0x8a, 0xea, 0xd5, 0x54, 0xbd, 0x71, 0xf9, 0x64, 0xe5, 0x4c, 0xb2,
+	0xd4, 0x58, 0xc4, 0xd6, 0xcc, 0x37, 0x4e, 0xc6, 0x44, 0x89, 0xd8, 0x9a, 0xf9, 0xe8, 0x60, 0xd8,
+	0x0f, 0xd0, 0x0f, 0x4b, 0x1f, 0xbf, 0x7e, 0x86, 0x5b, 0x80, 0x31, 0x71, 0xea, 0x11, 0x8a, 0x80,
+	0xfa, 0xb0, 0xff, 0xa1, 0x02, 0x17, 0x73, 0x27, 0xa1, 0x6c, 0x5e, 0x01, 0xed, 0x88, 0x35, 0x13,
+	0xf2, 0x33, 0x8d, 0x6d, 0x52, 0xb4, 0xf2, 0x4a, 0xf3, 0x2b, 0xc3, 0x97, 0xe6, 0xfb, 0x50, 0x67,
+	0x11, 0xf7, 0xba, 0x52, 0x0b, 0x61, 0x19, 0x65, 0xfc, 0x9c, 0x5d, 0x5a, 0xe3, 0x9f, 0x37, 0xa0,
+	0xaa, 0xa0, 0xd2, 0x94, 0x1e, 0xad, 0x6b, 0xfe, 0x8c, 0x16, 0xd6, 0x7e, 0x1e, 0xbd, 0x57, 0xba,
+	0x38, 0x45, 0x31, 0xf3, 0xdf, 0x2c, 0xa8, 0xe9, 0x02, 0x4d, 0x87, 0xb9, 0x5e, 0xcf, 0x63, 0x3e,
+	0x7f, 0xd3, 0x8b, 0xb8, 0xa8, 0xbd, 0x3d, 0xae, 0x2e, 0x77, 0x1b, 0x66, 0x15, 0x0b, 0x59, 0xd7,
+	0x93, 0x59, 0xd1, 0x12, 0xfc, 0x53, 0x7e, 0xca, 0x23, 0x05, 0x20, 0x1e, 0xc2, 0xa4, 0x3e, 0x94,
+	0x2e, 0xf5, 0x10, 0xa5, 0xb9, 0x73, 0x02, 0x45, 0xfa, 0xe0, 0x58, 0x3e, 0xf5, 0xc3, 0x0a, 0xcc,
+	0xeb, 0x3d, 0x46, 0x7a, 0x6f, 0x6f, 0x4b, 0x4f, 0x52, 0xed, 0x57, 0x47, 0x67, 0xb7, 0x8f, 0x29,
+	0x9e, 0xcd, 0x32, 0x07, 0xd9, 0x98, 0x00, 0xf9, 0x29, 0xb0, 0xe4, 0x5d, 0x98, 0x4f, 0xb0, 0x44,
+	0x76, 0x95, 0xe1, 0x48, 0xd5, 0x70, 0x44, 0x05, 0xd0, 0xb7, 0x52, 0x9c, 0x46, 0xb3, 0x33, 0x2a,
+	0xaf, 0x49, 0xcc, 0x3c, 0x8c, 0x94, 0xb4, 0xa9, 0xc8, 0x32, 0x50, 0x9b, 0x8a, 0x16, 0x2c, 0x17,
+	0xf4, 0xe3, 0x75, 0xdc, 0x84, 0x89, 0x3d, 0xd5, 0x74, 0x4c, 0x3a, 0x35, 0x3b, 0x5b, 0x1b, 0x2c,
+	0x9c, 0x69, 0x7f, 0xc7, 0x82, 0x39, 0xf3, 0x54, 0x82, 0xe1, 0xe7, 0x22, 0x8c, 0xa7, 0x12, 0xd4,
+	0xf8, 0x25, 0xf4, 0x1e, 0xed, 0xf5, 0x3a, 0x9e, 0x48, 0x48, 0x0f, 0xe7, 0xec, 0xcc, 0x20, 0xce,
+	0x09, 0xaf, 0x59, 0xc6, 0x07, 0x35, 0x14, 0xa6, 0x79, 0x74, 0x6a, 0x85, 0xca, 0x7f, 0x6d, 0xc1,
+	0x72, 0xc1, 0x42, 0x71, 0x02, 0x87, 0xf9, 0x3c, 0xf4, 0x8e, 0x4d, 0xe0, 0x64, 0x18, 0xa9, 0x79,
+	0x8d, 0x13, 0x4f, 0xad, 0x5e, 0xed, 0xc1, 0xef, 0x2c, 0xc3, 0x98, 0x24, 0x97, 0x7c, 0x1d, 0xc6,
+	0x55, 0x9a, 0x9c, 0xe4, 0xe9, 0xa8, 0xa3, 0x3f, 0xe4, 0xaa, 0x5f, 0x3f, 0x69, 0x98, 0x5a, 0xce,
+	0xbe, 0xf2, 0x8d, 0x7f, 0xfa, 0xf7, 0x3f, 0xac, 0x5c, 0x24, 0x17, 0x1a, 0x45, 0xbf, 0x25, 0x13,
+	0x6b, 0xa3, 0x49, 0x2e, 0x5c, 0x3b, 0xf5, 0x7b, 0xae, 0xfa, 0xf5, 0x93, 0x86, 0x0d, 0xb0, 0xb6,
+	0xb2, 0x2b, 0xe4, 0x37, 0x2d, 0x98, 0x8a, 0x0d, 0xe7, 0x6a, 0x11, 0x70, 0xf6, 0x47, 0x35, 0xf5,
+	0x9b, 0x03, 0x8c, 0x44, 0x2a, 0x5e, 0x95, 0x54, 0xac, 0x90, 0xa5, 0x1c, 0x2a, 0x8c, 0xe9, 0x97,
+	0x84, 0xc4, 0x75, 0xf8, 0x85, 0x84, 0x64, 0x7f, 0xb0, 0x51, 0xbf, 0x39, 0xc0, 0xc8, 0x01, 0x08,
+	0x31, 0xbf, 0x25, 0x20, 0xfb, 0x30, 0x26, 0x13, 0x65, 0xe4, 0xd5, 0x22, 0xe4, 0x64, 0x89, 0x7f,
+	0xfd, 0xda, 0x09, 0xa3, 0x70, 0xed, 0xcb, 0x72, 0xed, 0x3a, 0xa9, 0xe5, 0xac, 0xad, 0x8a, 0x30,
+	0xff, 0xd4, 0x82, 0xd9, 0x54, 0x01, 0x2a, 0xb9, 0x73, 0x2c, 0x74, 0x26, 0x85, 0x5e, 0xbf, 0x3b,
+	0xe0, 0x68, 0x24, 0xe8, 0x9e, 0x24, 0xe8, 0x16, 0x59, 0x2d, 0x22, 0xa8, 0xa1, 0x52, 0x56, 0x8d,
+	0x8f, 0xd5, 0xff, 0x3f, 0x21, 0xdf, 0xb5, 0x60, 0x26, 0x99, 0x42, 0x24, 0xb7, 0x4f, 0x58, 0x31,
+	0x99, 0x88, 0xac, 0xdf, 0x19, 0x6c, 0x30, 0x52, 0x77, 0x5f, 0x52, 0x77, 0x9b, 0xdc, 0x2c, 0xa4,
+	0x4e, 0x66, 0x1f, 0x1b, 0x1f, 0xeb, 0x34, 0xeb, 0x27, 0xe4, 0x1b, 0x16, 0x4c, 0x9a, 0xba, 0x8f,
+	0x1b, 0x45, 0xab, 0x65, 0x2a, 0x47, 0xeb, 0xab, 0x27, 0x0f, 0x44, 0x92, 0xae, 0x4a, 0x92, 0x96,
+	0xc9, 0xc5, 0x1c, 0x92, 0xf4, 0x63, 0x19, 0xf9, 0x3d, 0x0b, 0xa6, 0x13, 0x95, 0x63, 0xe4, 0x56,
+	0xa1, 0x96, 0x38, 0x52, 0x8a, 0x58, 0xbf, 0x3d, 0xd0, 0x58, 0xa4, 0xe6, 0xba, 0xa4, 0xe6, 0x32,
+	0x59, 0xc9, 0x53, 0x2b, 0x09, 0x02, 0xfe, 0xc8, 0x82, 0x99, 0x64, 0x1d, 0x58, 0xf1, 0xa1, 0xe5,
+	0x54, 0x99, 0xd5, 0xef, 0x0c, 0x36, 0x18, 0x69, 0xba, 0x2d, 0x69, 0xba, 0x46, 0xae, 0xe6, 0xd0,
+	0x74, 0xe4, 0xb8, 0xbe, 0x65, 0xc1, 0xa4, 0xae, 0x34, 0x2a, 0x3e, 0xae, 0x4c, 0x91, 0x52, 0x7d,
+	0xf5, 0xe4, 0x81, 0x48, 0xcc, 0x35, 0x49, 0xcc, 0x25, 0xb2, 0x9c, 0x43, 0x8c, 0x28, 0x05, 0x6a,
+	0xc8, 0x92, 0x6e, 0xf2, 0x4d, 0x0b, 0x26, 0x8d, 0xb7, 0x7a, 0xe3, 0x38, 0x19, 0x4d, 0x54, 0xb9,
+	0xd4, 0x57, 0x4f, 0x1e, 0x38, 0x80, 0xce, 0x11, 0x82, 0x7c, 0x57, 0x38, 0xde, 0xa4, 0x05, 0xf3,
+	0xd9, 0xb2, 0x00, 0xd2, 0x28, 0x54, 0xf2, 0xf9, 0x05, 0x04, 0xf5, 0xe3, 0x2b, 0xbe, 0xef, 0x59,
+	0xe4, 0xcf, 0x2c, 0x98, 0xcb, 0x94, 0x0f, 0x90, 0xb5, 0xe3, 0xcd, 0x58, 0xb6, 0x0c, 0xa1, 0xde,
+	0x18, 0x78, 0xfc, 0x00, 0x42, 0xa1, 0xec, 0x5f, 0xc3, 0x94, 0x29, 0x08, 0x23, 0x90, 0x7c, 0xe6,
+	0x2e, 0xd4, 0xed, 0x47, 0x1e, 0x76, 0xeb, 0xb7, 0x06, 0x19, 0x3a, 0x80, 0x59, 0x54, 0xef, 0xb9,
+	0xe4, 0x2f, 0x2d, 0x98, 0xcf, 0x3e, 0x45, 0x16, 0x9f, 0x48, 0xc1, 0xab, 0x66, 0xfd, 0xde, 0xe0,
+	0x13, 0x90, 0xb4, 0x86, 0x24, 0xed, 0x26, 0xb9, 0x51, 0xa8, 0xf7, 0x84, 0xbc, 0xdc, 0xdd, 0x39,
+	0xbc, 0x8b, 0x0f, 0x0a, 0xdf, 0xb1, 0xa0, 0x9a, 0x7e, 0x2a, 0x23, 0x27, 0x18, 0x82, 0xcc, 0x8b,
+	0x5b, 0x7d, 0x6d, 0xd0, 0xe1, 0x48, 0xe2, 0x2d, 0x49, 0xe2, 0xab, 0xc4, 0x2e, 0x24, 0x71, 0xc7,
+	0x90, 0x22, 0x9c, 0xe2, 0xcc, 0xc3, 0x53, 0xb1, 0xc4, 0xe5, 0xbf, 0x60, 0xd5, 0x1b, 0x03, 0x8f,
+	0x47, 0x02, 0x6f, 0x48, 0x02, 0xaf, 0x90, 0x4b, 0xc7, 0xdb, 0x8e, 0x48, 0xf2, 0x2e, 0xfd, 0x7e,
+	0x52, 0xcc, 0xbb, 0xdc, 0x67, 0x98, 0xfa, 0xda, 0xa0, 0xc3, 0x07, 0xe0, 0x5d, 0x4f, 0x4d, 0x69,
+	0xea, 0x27, 0xa4, 0xbf, 0xb5, 0x0a, 0x1e, 0x57, 0xbe, 0x70, 0x92, 0xf7, 0x97, 0xf3, 0xa4, 0x50,
+	0xff, 0xb9, 0x97, 0x9b, 0x34, 0x80, 0x93, 0xa0, 0x1c, 0xc8, 0x46, 0xfa, 0xf9, 0x40, 0xea, 0x98,
+	0xec, 0xf3, 0xc1, 0xda, 0xf1, 0x6b, 0x67, 0x13, 0xe6, 0xf5, 0xc6, 0xc0, 0xe3, 0x07, 0xd0, 0x31,
+	0x48, 0xa6, 0x49, 0x93, 0x93, 0x3f, 0xb7, 0x60, 0x3e, 0x9b, 0xf6, 0x2b, 0xbe, 0xda, 0x05, 0xf9,
+	0xc7, 0xfa, 0xbd, 0xc1, 0x27, 0x20, 0x91, 0x77, 0x24, 0x91, 0xd7, 0xc9, 0xab, 0xc7, 0xf8, 0x0f,
+	0x0d, 0x9d, 0x71, 0x14, 0x54, 0x56, 0xd3, 0x99, 0x91, 0x62, 0xd9, 0xcc, 0x4d, 0x4d, 0xd5, 0xd7,
+	0x06, 0x1d, 0x8e, 0xf4, 0x3d, 0x90, 0xf4, 0xdd, 0x21, 0xb7, 0x8a, 0x99, 0xb8, 0x8b, 0x73, 0x1a,
+	0x1f, 0xcb, 0xd4, 0xcc, 0x27, 0x92, 0x97, 0x47, 0xf2, 0x16, 0x8d, 0x13, 0x3d, 0xf2, 0x74, 0xf0,
+	0x59, 0xbf, 0x37, 0xf8, 0x84, 0x01, 0x78, 0x69, 0x3c, 0xf9, 0x06, 0x86, 0xe6, 0xe4, 0x2f, 0x62,
+	0x65, 0xee, 0x05, 0x27, 0x52, 0x59, 0x10, 0x22, 0xd7, 0xef, 0x0d, 0x3e, 0x01, 0xa9, 0x5c, 0x93,
+	0x54, 0xae, 0x92, 0xeb, 0xc7, 0x29, 0x73, 0x2f, 0x68, 0x22, 0x9d, 0x1b, 0xf7, 0x7e, 0xf4, 0xd9,
+	0x8a, 0xf5, 0xe3, 0xcf, 0x56, 0xac, 0x7f, 0xfd, 0x6c, 0xc5, 0xfa, 0xfd, 0xcf, 0x57, 0x5e, 0xf9,
+	0xf1, 0xe7, 0x2b, 0xaf, 0xfc, 0xf3, 0xe7, 0x2b, 0xaf, 0x7c, 0x75, 0x51, 0x00, 0x1c, 0x24, 0x21,
+	0xf8, 0x61, 0x8f, 0x45, 0x3b, 0xe3, 0xf2, 0x9f, 0x1a, 0xf9, 0xc2, 0xff, 0x0d, 0x00, 0x99, 0x0f,
+	0x19, 0x51, 0x68, 0x45, 0x00, 0x00,
 }
 
-func (m *QueryFeeStatsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalFeesBurned.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalFeesToTreasury.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AverageFeesBurnedPerBlock.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.FeeBurnEnabled {
-		n += 2
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.FeeBurnRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TreasuryFeeRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurnRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurnRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.AdaptiveBurnEnabled {
-		n += 2
+	{
+		size := m.CirculatingSupply.Size()
+		i -= size
+		if _, err := m.CirculatingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.CurrentBurnRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.Trigger)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.NetInflationRate.Size()
+		i -= size
+		if _, err := m.NetInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SupplyPctOfCap.Size()
+		i -= size
+		if _, err := m.SupplyPctOfCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RemainingMintable.Size()
+		i -= size
+		if _, err := m.RemainingMintable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentTotalSupply.Size()
+		i -= size
+		if _, err := m.CurrentTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalSupplyCap.Size()
+		i -= size
+		if _, err := m.TotalSupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.BlockProvisions.Size()
+		i -= size
+		if _, err := m.BlockProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CurrentInflationRate.Size()
+		i -= size
+		if _, err := m.CurrentInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalDistributed.Size()
+		i -= size
+		if _, err := m.TotalDistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AnnualAmount.Size()
+		i -= size
+		if _, err := m.AnnualAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastDistributionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastDistributionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalAnnualEmissions.Size()
+		i -= size
+		if _, err := m.TotalAnnualEmissions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TreasuryRedirect.Size()
+		i -= size
+		if _, err := m.TreasuryRedirect.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.BurnerAddress) > 0 {
+		i -= len(m.BurnerAddress)
+		copy(dAtA[i:], m.BurnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BurnerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BurnId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BurnId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Burns) > 0 {
+		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsBySourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsBySourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsBySourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnsBySourceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnsBySourceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnsBySourceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageBurnAmount.Size()
+		i -= size
+		if _, err := m.AverageBurnAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentBurnRate.Size()
+		i -= size
+		if _, err := m.CurrentBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BurnCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BurnCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Source != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsBySourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsBySourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsBySourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Burns) > 0 {
+		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsByChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsByChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurnedOnChain.Size()
+		i -= size
+		if _, err := m.TotalBurnedOnChain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Burns) > 0 {
+		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.TreasuryBurnRedirectPct.Size()
+		i -= size
+		if _, err := m.TreasuryBurnRedirectPct.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FromBurnRedirect.Size()
+		i -= size
+		if _, err := m.FromBurnRedirect.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FromInflation.Size()
+		i -= size
+		if _, err := m.FromInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalTreasuryInflows.Size()
+		i -= size
+		if _, err := m.TotalTreasuryInflows.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TreasuryBalance.Size()
+		i -= size
+		if _, err := m.TreasuryBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AssumeConstantBurnRate {
+		i--
+		if m.AssumeConstantBurnRate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.YearsAhead != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.YearsAhead))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.YearsUntilCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.YearsUntilCap))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.NetGrowthRate.Size()
+		i -= size
+		if _, err := m.NetGrowthRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ProjectedBurned.Size()
+		i -= size
+		if _, err := m.ProjectedBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ProjectedMinted.Size()
+		i -= size
+		if _, err := m.ProjectedMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ProjectedSupply.Size()
+		i -= size
+		if _, err := m.ProjectedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assumptions) > 0 {
+		i -= len(m.Assumptions)
+		copy(dAtA[i:], m.Assumptions)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Assumptions)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBurnReportHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBurnReportHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LastRewardHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastRewardHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.GasConversionRatio.Size()
+		i -= size
+		if _, err := m.GasConversionRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.IbcChannel) > 0 {
+		i -= len(m.IbcChannel)
+		copy(dAtA[i:], m.IbcChannel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.NetFlow.Size()
+		i -= size
+		if _, err := m.NetFlow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalRewardsReceived.Size()
+		i -= size
+		if _, err := m.TotalRewardsReceived.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TreasuryFeeRatio.Size()
+		i -= size
+		if _, err := m.TreasuryFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FeeBurnRatio.Size()
+		i -= size
+		if _, err := m.FeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FeeBurnEnabled {
+		i--
+		if m.FeeBurnEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AverageFeesBurnedPerBlock.Size()
+		i -= size
+		if _, err := m.AverageFeesBurnedPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalFeesToTreasury.Size()
+		i -= size
+		if _, err := m.TotalFeesToTreasury.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalFeesBurned.Size()
+		i -= size
+		if _, err := m.TotalFeesBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmergencyBurnOverride {
+		i--
+		if m.EmergencyBurnOverride {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.AvgTxPerDay.Size()
+		i -= size
+		if _, err := m.AvgTxPerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TreasuryPct.Size()
+		i -= size
+		if _, err := m.TreasuryPct.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.BlockCongestion.Size()
+		i -= size
+		if _, err := m.BlockCongestion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DefaultBurnRatio.Size()
+		i -= size
+		if _, err := m.DefaultBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxBurnRatio.Size()
+		i -= size
+		if _, err := m.MaxBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinBurnRatio.Size()
+		i -= size
+		if _, err := m.MinBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Trigger) > 0 {
+		i -= len(m.Trigger)
+		copy(dAtA[i:], m.Trigger)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trigger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.CurrentBurnRatio.Size()
+		i -= size
+		if _, err := m.CurrentBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AdaptiveBurnEnabled {
+		i--
+		if m.AdaptiveBurnEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamBurnEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamBurnEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamBurnEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsFormattedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsFormattedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsFormattedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsFormattedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsFormattedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsFormattedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formatted) > 0 {
+		i -= len(m.Formatted)
+		copy(dAtA[i:], m.Formatted)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Formatted)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedirectTargetAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedirectTargetAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedirectTargetAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResearchFund) > 0 {
+		i -= len(m.ResearchFund)
+		copy(dAtA[i:], m.ResearchFund)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ResearchFund)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InsuranceFund) > 0 {
+		i -= len(m.InsuranceFund)
+		copy(dAtA[i:], m.InsuranceFund)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InsuranceFund)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BuyAndBurn) > 0 {
+		i -= len(m.BuyAndBurn)
+		copy(dAtA[i:], m.BuyAndBurn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BuyAndBurn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EcosystemGrants) > 0 {
+		i -= len(m.EcosystemGrants)
+		copy(dAtA[i:], m.EcosystemGrants)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EcosystemGrants)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedirectState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedirectState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedirectState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalRedirected.Size()
+		i -= size
+		if _, err := m.TotalRedirected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AccumulatedRedirectInflows.Size()
+		i -= size
+		if _, err := m.AccumulatedRedirectInflows.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LastRedirectHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastRedirectHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FullConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RedirectState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedirectTargets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFullConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFullConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFullConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFullConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFullConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFullConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceBurnAttribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceBurnAttribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceBurnAttribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ModuleBurned.Size()
+		i -= size
+		if _, err := m.ModuleBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeeBurned.Size()
+		i -= size
+		if _, err := m.FeeBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRateBySourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRateBySourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRateBySourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRateBySourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRateBySourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRateBySourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalModuleBurned.Size()
+		i -= size
+		if _, err := m.TotalModuleBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalFeeBurned.Size()
+		i -= size
+		if _, err := m.TotalFeeBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceBurnTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceBurnTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceBurnTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnsBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnsBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnsBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainBurnTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainBurnTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainBurnTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBurnsByChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBurnsByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBurnsByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBurnsByChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBurnsByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBurnsByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StagedChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StagedChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StagedChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StagedAtHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StagedAtHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EffectiveEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EffectiveEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyReconciliation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyReconciliation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyReconciliation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reconciled {
+		i--
+		if m.Reconciled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BankSupply.Size()
+		i -= size
+		if _, err := m.BankSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TrackedSupply.Size()
+		i -= size
+		if _, err := m.TrackedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyReconciliationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyReconciliationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyReconciliationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyReconciliationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyReconciliationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyReconciliationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reconciliation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyInvariant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyInvariant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consistent {
+		i--
+		if m.Consistent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.CurrentTotalSupply.Size()
+		i -= size
+		if _, err := m.CurrentTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalBurned.Size()
+		i -= size
+		if _, err := m.TotalBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyInvariantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyInvariantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyInvariantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyInvariantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyInvariantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Invariant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedirectTargetStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedirectTargetStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedirectTargetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryRedirectStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryRedirectStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryRedirectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LastRedirectHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastRedirectHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TotalRedirected.Size()
+		i -= size
+		if _, err := m.TotalRedirected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AccumulatedInflows.Size()
+		i -= size
+		if _, err := m.AccumulatedInflows.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPerExecution.Size()
+		i -= size
+		if _, err := m.MaxPerExecution.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExecutionInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutionInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryRedirectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryRedirectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryRedirectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryRedirectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryRedirectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryRedirectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redirect.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyForecastYear) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyForecastYear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyForecastYear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EndSupply.Size()
+		i -= size
+		if _, err := m.EndSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NetInflation.Size()
+		i -= size
+		if _, err := m.NetInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Mint.Size()
+		i -= size
+		if _, err := m.Mint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.StartSupply.Size()
+		i -= size
+		if _, err := m.StartSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyForecastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyForecastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyForecastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyForecastResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyForecastResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyForecastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Years) > 0 {
+		for iNdEx := len(m.Years) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Years[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.EstimatedAnnualBurnRate.Size()
+		i -= size
+		if _, err := m.EstimatedAnnualBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalSupplyCap.Size()
+		i -= size
+		if _, err := m.TotalSupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CurrentYear != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentYear))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionRecipientHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionRecipientHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionRecipientHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LastEpochAmount.Size()
+		i -= size
+		if _, err := m.LastEpochAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalEmitted.Size()
+		i -= size
+		if _, err := m.TotalEmitted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionsHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionsHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionsHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastEpochHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastEpochHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LastEpochTotal.Size()
+		i -= size
+		if _, err := m.LastEpochTotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalEmitted.Size()
+		i -= size
+		if _, err := m.TotalEmitted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnRatioChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnRatioChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnRatioChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trigger) > 0 {
+		i -= len(m.Trigger)
+		copy(dAtA[i:], m.Trigger)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Trigger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.AppliedRatio.Size()
+		i -= size
+		if _, err := m.AppliedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRatioHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRatioHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRatioHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRatioHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRatioHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRatioHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalSupplyCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentTotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingMintable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SupplyPctOfCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetInflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentInflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksPerYear))
+	}
+	return n
+}
+
+func (m *QueryEmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EmissionAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalDistributed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalAnnualEmissions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastDistributionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastDistributionHeight))
+	}
+	return n
+}
+
+func (m *QueryBurnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BurnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnId != 0 {
+		n += 1 + sovQuery(uint64(m.BurnId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	l = len(m.BurnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TreasuryRedirect.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burns) > 0 {
+		for _, e := range m.Burns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnsBySourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BurnsBySourceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != 0 {
+		n += 1 + sovQuery(uint64(m.Source))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BurnCount != 0 {
+		n += 1 + sovQuery(uint64(m.BurnCount))
+	}
+	l = m.CurrentBurnRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageBurnAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnsBySourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burns) > 0 {
+		for _, e := range m.Burns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnsByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnsByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burns) > 0 {
+		for _, e := range m.Burns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalBurnedOnChain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TreasuryBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalTreasuryInflows.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FromInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FromBurnRedirect.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TreasuryBurnRedirectPct.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.YearsAhead != 0 {
+		n += 1 + sovQuery(uint64(m.YearsAhead))
+	}
+	if m.AssumeConstantBurnRate {
+		n += 2
+	}
+	return n
+}
+
+func (m *SupplyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovQuery(uint64(m.Year))
+	}
+	l = m.ProjectedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetGrowthRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.YearsUntilCap != 0 {
+		n += 1 + sovQuery(uint64(m.YearsUntilCap))
+	}
+	return n
+}
+
+func (m *QueryProjectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Assumptions)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalRewardsReceived.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetFlow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.IbcChannel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.GasConversionRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastRewardHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastRewardHeight))
+	}
+	if m.LastBurnReportHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastBurnReportHeight))
+	}
+	return n
+}
+
+func (m *QueryFeeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalFeesBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFeesToTreasury.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageFeesBurnedPerBlock.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FeeBurnEnabled {
+		n += 2
+	}
+	l = m.FeeBurnRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TreasuryFeeRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AdaptiveBurnEnabled {
+		n += 2
+	}
+	l = m.CurrentBurnRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Trigger)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MinBurnRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxBurnRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DefaultBurnRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockCongestion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TreasuryPct.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AvgTxPerDay.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EmergencyBurnOverride {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStreamBurnEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsFormattedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsFormattedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Formatted)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RedirectTargetAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EcosystemGrants)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BuyAndBurn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InsuranceFund)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ResearchFund)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RedirectState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRedirectHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastRedirectHeight))
+	}
+	l = m.AccumulatedRedirectInflows.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalRedirected.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *FullConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RedirectTargets.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RedirectState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFullConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFullConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SourceBurnAttribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.FeeBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnRateBySourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnRateBySourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalFeeBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalModuleBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SourceBurnTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnsBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnsBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ChainBurnTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBurnsByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllBurnsByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StagedChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.EffectiveEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EffectiveEpoch))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StagedAtHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StagedAtHeight))
+	}
+	return n
+}
+
+func (m *QueryPendingChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyReconciliation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TrackedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BankSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Reconciled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySupplyReconciliationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyReconciliationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reconciliation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SupplyInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentTotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Consistent {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySupplyInvariantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyInvariantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Invariant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RedirectTargetStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TreasuryRedirectStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ExecutionInterval != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutionInterval))
+	}
+	l = m.MaxPerExecution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccumulatedInflows.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalRedirected.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastRedirectHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastRedirectHeight))
+	}
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTreasuryRedirectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTreasuryRedirectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redirect.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SupplyForecastYear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovQuery(uint64(m.Year))
+	}
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StartSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Mint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EndSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyForecastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	return n
+}
+
+func (m *QuerySupplyForecastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentYear != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentYear))
+	}
+	l = m.TotalSupplyCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EstimatedAnnualBurnRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Years) > 0 {
+		for _, e := range m.Years {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionRecipientHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalEmitted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LastEpochAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EmissionsHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalEmitted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LastEpochTotal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastEpochHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastEpochHeight))
+	}
+	return n
+}
+
+func (m *QueryEmissionsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.History.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BurnRatioChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.AppliedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Trigger)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnRatioHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnRatioHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingMintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyPctOfCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyPctOfCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, EmissionAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAnnualEmissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAnnualEmissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionHeight", wireType)
+			}
+			m.LastDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDistributionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnId", wireType)
+			}
+			m.BurnId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= BurnSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryRedirect", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryRedirect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burns = append(m.Burns, BurnRecord{})
+			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsBySourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsBySourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsBySourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= BurnSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnsBySourceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnsBySourceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnsBySourceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= BurnSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnCount", wireType)
+			}
+			m.BurnCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBurnAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageBurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsBySourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsBySourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsBySourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burns = append(m.Burns, BurnRecord{})
+			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnsByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burns = append(m.Burns, BurnRecord{})
+			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedOnChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurnedOnChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTreasuryInflows", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTreasuryInflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBurnRedirect", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromBurnRedirect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBurnRedirectPct", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryBurnRedirectPct.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearsAhead", wireType)
+			}
+			m.YearsAhead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YearsAhead |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssumeConstantBurnRate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AssumeConstantBurnRate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetGrowthRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetGrowthRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearsUntilCap", wireType)
+			}
+			m.YearsUntilCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YearsUntilCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, SupplyProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assumptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assumptions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainMetricsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewardsReceived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRewardsReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetFlow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetFlow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConversionRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasConversionRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardHeight", wireType)
+			}
+			m.LastRewardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBurnReportHeight", wireType)
+			}
+			m.LastBurnReportHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBurnReportHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesToTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesToTreasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageFeesBurnedPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageFeesBurnedPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeBurnEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveBurnEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdaptiveBurnEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trigger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCongestion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockCongestion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryPct", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryPct.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgTxPerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AvgTxPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyBurnOverride", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmergencyBurnOverride = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamBurnEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamBurnEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamBurnEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsFormattedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsFormattedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsFormattedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsFormattedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsFormattedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsFormattedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Formatted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.MinBurnRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MaxBurnRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.DefaultBurnRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BlockCongestion.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TreasuryPct.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.AvgTxPerDay.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.EmergencyBurnOverride {
-		n += 2
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *RedirectTargetAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedirectTargetAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedirectTargetAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemGrants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcosystemGrants = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyAndBurn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyAndBurn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFund = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResearchFund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResearchFund = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *RedirectState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3732,25 +12844,112 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedirectState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedirectState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedirectHeight", wireType)
+			}
+			m.LastRedirectHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRedirectHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedRedirectInflows", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AccumulatedRedirectInflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRedirected", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalRedirected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3772,7 +12971,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *FullConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3795,10 +12994,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FullConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FullConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3834,6 +13033,104 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedirectTargets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedirectState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3855,7 +13152,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFullConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3878,10 +13175,10 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFullConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFullConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3905,7 +13202,7 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFullConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3928,85 +13225,17 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFullConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFullConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyCap", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalSupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTotalSupply", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4016,29 +13245,78 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceBurnAttribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceBurnAttribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceBurnAttribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4066,13 +13344,11 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4100,13 +13376,13 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingMintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupplyPctOfCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4134,13 +13410,13 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SupplyPctOfCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ModuleBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetInflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4168,7 +13444,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4193,7 +13469,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnRateBySourceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4216,10 +13492,10 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnRateBySourceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnRateBySourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4243,7 +13519,7 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnRateBySourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4266,17 +13542,17 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnRateBySourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnRateBySourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentInflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4286,29 +13562,29 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, SourceBurnAttribution{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeeBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4336,13 +13612,13 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalFeeBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalModuleBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4370,13 +13646,13 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalModuleBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4404,13 +13680,63 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceBurnTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceBurnTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceBurnTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4438,15 +13764,13 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
 			}
-			m.BlocksPerYear = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4456,11 +13780,26 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4482,7 +13821,7 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEmissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnsBreakdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4505,10 +13844,10 @@ func (m *QueryEmissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEmissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnsBreakdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnsBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4532,7 +13871,7 @@ func (m *QueryEmissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EmissionAllocation) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnsBreakdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4547,91 +13886,25 @@ func (m *EmissionAllocation) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EmissionAllocation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EmissionAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Category = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnsBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnsBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4641,29 +13914,29 @@ func (m *EmissionAllocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, SourceBurnTotal{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4691,7 +13964,7 @@ func (m *EmissionAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4716,7 +13989,7 @@ func (m *EmissionAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEmissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ChainBurnTotal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4739,17 +14012,17 @@ func (m *QueryEmissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEmissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainBurnTotal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainBurnTotal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4759,29 +14032,27 @@ func (m *QueryEmissionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allocations = append(m.Allocations, EmissionAllocation{})
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAnnualEmissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4809,29 +14080,60 @@ func (m *QueryEmissionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAnnualEmissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastDistributionHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastDistributionHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBurnsByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBurnsByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBurnsByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4853,7 +14155,7 @@ func (m *QueryEmissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBurnsByChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4876,15 +14178,15 @@ func (m *QueryBurnsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBurnsByChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBurnsByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4911,10 +14213,42 @@ func (m *QueryBurnsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Chains = append(m.Chains, ChainBurnTotal{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4939,7 +14273,7 @@ func (m *QueryBurnsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BurnRecord) Unmarshal(dAtA []byte) error {
+func (m *ParamChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4962,17 +14296,17 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BurnRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BurnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			m.BurnId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4982,14 +14316,27 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BurnId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5017,15 +14364,63 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StagedChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StagedChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StagedChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Source = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5035,16 +14430,16 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= BurnSource(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveEpoch", wireType)
 			}
-			var stringLen uint64
+			m.EffectiveEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5054,29 +14449,16 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EffectiveEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5086,14 +14468,29 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5121,13 +14518,13 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StagedAtHeight", wireType)
 			}
-			m.Timestamp = 0
+			m.StagedAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5137,16 +14534,116 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.StagedAtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnerAddress", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5156,29 +14653,16 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BurnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryRedirect", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5188,23 +14672,23 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TreasuryRedirect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, StagedChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5229,7 +14713,7 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
+func (m *SupplyReconciliation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5252,17 +14736,17 @@ func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplyReconciliation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplyReconciliation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5272,31 +14756,29 @@ func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burns = append(m.Burns, BurnRecord{})
-			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5306,31 +14788,63 @@ func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrackedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5355,13 +14869,83 @@ func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reconciled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyReconciliationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyReconciliationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyReconciliationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5383,7 +14967,7 @@ func (m *QueryBurnsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnsBySourceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyReconciliationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5406,34 +14990,15 @@ func (m *QueryBurnsBySourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnsBySourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyReconciliationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnsBySourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyReconciliationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			m.Source = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Source |= BurnSource(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciliation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5460,10 +15025,7 @@ func (m *QueryBurnsBySourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reconciliation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5488,7 +15050,7 @@ func (m *QueryBurnsBySourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BurnsBySourceStats) Unmarshal(dAtA []byte) error {
+func (m *SupplyInvariant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5511,34 +15073,15 @@ func (m *BurnsBySourceStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BurnsBySourceStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplyInvariant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BurnsBySourceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplyInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			m.Source = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Source |= BurnSource(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5566,32 +15109,13 @@ func (m *BurnsBySourceStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnCount", wireType)
-			}
-			m.BurnCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BurnCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5619,13 +15143,13 @@ func (m *BurnsBySourceStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTotalSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5653,10 +15177,30 @@ func (m *BurnsBySourceStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AverageBurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consistent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Consistent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5678,7 +15222,7 @@ func (m *BurnsBySourceStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnsBySourceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyInvariantRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5688,128 +15232,25 @@ func (m *QueryBurnsBySourceResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnsBySourceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnsBySourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Burns = append(m.Burns, BurnRecord{})
-			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyInvariantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyInvariantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5831,7 +15272,7 @@ func (m *QueryBurnsBySourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnsByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyInvariantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5854,47 +15295,15 @@ func (m *QueryBurnsByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnsByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyInvariantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnsByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyInvariantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5921,10 +15330,7 @@ func (m *QueryBurnsByChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Invariant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5949,7 +15355,7 @@ func (m *QueryBurnsByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnsByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *RedirectTargetStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5972,17 +15378,17 @@ func (m *QueryBurnsByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnsByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedirectTargetStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnsByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedirectTargetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5992,31 +15398,29 @@ func (m *QueryBurnsByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burns = append(m.Burns, BurnRecord{})
-			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6026,31 +15430,27 @@ func (m *QueryBurnsByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedOnChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6078,7 +15478,7 @@ func (m *QueryBurnsByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBurnedOnChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6103,57 +15503,7 @@ func (m *QueryBurnsByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTreasuryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTreasuryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
+func (m *TreasuryRedirectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6176,17 +15526,17 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTreasuryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryRedirectStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryRedirectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6196,29 +15546,15 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TreasuryBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTreasuryInflows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6246,13 +15582,32 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalTreasuryInflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionInterval", wireType)
+			}
+			m.ExecutionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromInflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerExecution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6280,13 +15635,13 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPerExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromBurnRedirect", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedInflows", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6314,13 +15669,13 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromBurnRedirect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccumulatedInflows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBurnRedirectPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRedirected", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6348,15 +15703,34 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TreasuryBurnRedirectPct.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalRedirected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedirectHeight", wireType)
+			}
+			m.LastRedirectHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRedirectHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6366,24 +15740,76 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, RedirectTargetStatus{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryRedirectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryRedirectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryRedirectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6405,7 +15831,7 @@ func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProjectionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryRedirectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6428,17 +15854,17 @@ func (m *QueryProjectionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProjectionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryRedirectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProjectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryRedirectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YearsAhead", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redirect", wireType)
 			}
-			m.YearsAhead = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6448,31 +15874,25 @@ func (m *QueryProjectionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.YearsAhead |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssumeConstantBurnRate", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.AssumeConstantBurnRate = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redirect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6494,7 +15914,7 @@ func (m *QueryProjectionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
+func (m *SupplyForecastYear) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6517,10 +15937,10 @@ func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyProjection: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplyForecastYear: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplyForecastYear: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6537,14 +15957,14 @@ func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Year |= uint32(b&0x7F) << shift
+				m.Year |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6572,13 +15992,13 @@ func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProjectedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedMinted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6606,13 +16026,13 @@ func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProjectedMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6640,13 +16060,13 @@ func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProjectedBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetGrowthRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6674,84 +16094,15 @@ func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetGrowthRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YearsUntilCap", wireType)
-			}
-			m.YearsUntilCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.YearsUntilCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProjectionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProjectionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProjectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetInflation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6761,29 +16112,29 @@ func (m *QueryProjectionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projections = append(m.Projections, SupplyProjection{})
-			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assumptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6811,7 +16162,9 @@ func (m *QueryProjectionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assumptions = string(dAtA[iNdEx:postIndex])
+			if err := m.EndSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6834,7 +16187,7 @@ func (m *QueryProjectionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainMetricsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyForecastRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6857,17 +16210,17 @@ func (m *QueryChainMetricsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainMetricsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyForecastRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyForecastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
 			}
-			var stringLen uint64
+			m.Years = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6877,24 +16230,11 @@ func (m *QueryChainMetricsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Years |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6916,7 +16256,7 @@ func (m *QueryChainMetricsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyForecastResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6939,17 +16279,17 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryChainMetricsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyForecastResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryChainMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyForecastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentYear", wireType)
 			}
-			var stringLen uint64
+			m.CurrentYear = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6959,27 +16299,14 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentYear |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7007,13 +16334,13 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalSupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewardsReceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedAnnualBurnRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7041,15 +16368,15 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRewardsReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EstimatedAnnualBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetFlow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7059,29 +16386,79 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetFlow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Years = append(m.Years, SupplyForecastYear{})
+			if err := m.Years[len(m.Years)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionRecipientHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionRecipientHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionRecipientHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7109,11 +16486,11 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcChannel = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasConversionRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEmitted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7141,34 +16518,15 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasConversionRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalEmitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardHeight", wireType)
-			}
-			m.LastRewardHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastRewardHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBurnReportHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochAmount", wireType)
 			}
-			m.LastBurnReportHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7178,61 +16536,26 @@ func (m *QueryChainMetricsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastBurnReportHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFeeStatsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LastEpochAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeStatsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7254,7 +16577,7 @@ func (m *QueryFeeStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *EmissionsHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7277,17 +16600,17 @@ func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionsHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionsHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7297,29 +16620,29 @@ func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalFeesBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, EmissionRecipientHistory{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesToTreasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEmitted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7347,13 +16670,13 @@ func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalFeesToTreasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalEmitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageFeesBurnedPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTotal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7381,35 +16704,15 @@ func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AverageFeesBurnedPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastEpochTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FeeBurnEnabled = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochHeight", wireType)
 			}
-			var stringLen uint64
+			m.LastEpochHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7419,60 +16722,61 @@ func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastEpochHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFeeRatio", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionsHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TreasuryFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionsHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7494,7 +16798,7 @@ func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEmissionsHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7513,16 +16817,49 @@ func (m *QueryBurnRateRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnRateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionsHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7544,7 +16881,7 @@ func (m *QueryBurnRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
+func (m *BurnRatioChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7567,17 +16904,17 @@ func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnRatioChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnRatioChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveBurnEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7587,15 +16924,14 @@ func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AdaptiveBurnEnabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBurnRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7623,7 +16959,7 @@ func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AppliedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7659,79 +16995,61 @@ func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Trigger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBurnRatio", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnRatio", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnRatioHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnRatioHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnRatioHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBurnRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7741,65 +17059,83 @@ func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockCongestion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnRatioHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockCongestion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnRatioHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnRatioHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7809,31 +17145,31 @@ func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TreasuryPct.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, BurnRatioChange{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvgTxPerDay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7843,46 +17179,28 @@ func (m *QueryBurnRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AvgTxPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyBurnOverride", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EmergencyBurnOverride = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -42,6 +42,42 @@ type QueryClient interface {
 	FeeStats(ctx context.Context, in *QueryFeeStatsRequest, opts ...grpc.CallOption) (*QueryFeeStatsResponse, error)
 	// BurnRate queries the current adaptive burn rate and trigger
 	BurnRate(ctx context.Context, in *QueryBurnRateRequest, opts ...grpc.CallOption) (*QueryBurnRateResponse, error)
+	// StreamBurnEvents replays the most recent burns held by the node (at most
+	// BurnStreamBufferSize) and then pushes each new burn as it is recorded.
+	// Only served over gRPC; there is no REST route.
+	StreamBurnEvents(ctx context.Context, in *QueryStreamBurnEventsRequest, opts ...grpc.CallOption) (Query_StreamBurnEventsClient, error)
+	// ParamsFormatted returns the parameters as a human-readable dashboard
+	ParamsFormatted(ctx context.Context, in *QueryParamsFormattedRequest, opts ...grpc.CallOption) (*QueryParamsFormattedResponse, error)
+	// FullConfig returns params together with the configuration stored outside
+	// the params object (treasury and redirect target addresses, redirect state).
+	FullConfig(ctx context.Context, in *QueryFullConfigRequest, opts ...grpc.CallOption) (*QueryFullConfigResponse, error)
+	// BurnRateBySource returns, for every burn source, its share of the fee burn
+	// and its module-specific burns
+	BurnRateBySource(ctx context.Context, in *QueryBurnRateBySourceRequest, opts ...grpc.CallOption) (*QueryBurnRateBySourceResponse, error)
+	// BurnsBreakdown returns the burn total of every burn source and their sum
+	BurnsBreakdown(ctx context.Context, in *QueryBurnsBreakdownRequest, opts ...grpc.CallOption) (*QueryBurnsBreakdownResponse, error)
+	// AllBurnsByChain returns the burn total of every chain and their sum
+	AllBurnsByChain(ctx context.Context, in *QueryAllBurnsByChainRequest, opts ...grpc.CallOption) (*QueryAllBurnsByChainResponse, error)
+	// PendingChanges lists all staged param changes and their effective epochs
+	PendingChanges(ctx context.Context, in *QueryPendingChangesRequest, opts ...grpc.CallOption) (*QueryPendingChangesResponse, error)
+	// SupplyReconciliation compares the tracked supply against the bank
+	// module's supply of the native denom
+	SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error)
+	// SupplyInvariant returns the stored supply counters and whether they
+	// satisfy current = minted - burned
+	SupplyInvariant(ctx context.Context, in *QuerySupplyInvariantRequest, opts ...grpc.CallOption) (*QuerySupplyInvariantResponse, error)
+	// TreasuryRedirect returns the treasury redirect configuration and its
+	// cumulative state
+	TreasuryRedirect(ctx context.Context, in *QueryTreasuryRedirectRequest, opts ...grpc.CallOption) (*QueryTreasuryRedirectResponse, error)
+	// SupplyForecast projects per-year mint, burn and supply from the current
+	// year
+	SupplyForecast(ctx context.Context, in *QuerySupplyForecastRequest, opts ...grpc.CallOption) (*QuerySupplyForecastResponse, error)
+	// EmissionsHistory returns cumulative and last-epoch emission per recipient
+	// category
+	EmissionsHistory(ctx context.Context, in *QueryEmissionsHistoryRequest, opts ...grpc.CallOption) (*QueryEmissionsHistoryResponse, error)
+	// BurnRatioHistory pages through the adaptive burn controller's decision
+	// history, oldest first
+	BurnRatioHistory(ctx context.Context, in *QueryBurnRatioHistoryRequest, opts ...grpc.CallOption) (*QueryBurnRatioHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -160,6 +196,146 @@ func (c *queryClient) BurnRate(ctx context.Context, in *QueryBurnRateRequest, op
 	return out, nil
 }
 
+func (c *queryClient) StreamBurnEvents(ctx context.Context, in *QueryStreamBurnEventsRequest, opts ...grpc.CallOption) (Query_StreamBurnEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], "/pos.tokenomics.v1.Query/StreamBurnEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamBurnEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamBurnEventsClient interface {
+	Recv() (*BurnRecord, error)
+	grpc.ClientStream
+}
+
+type queryStreamBurnEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamBurnEventsClient) Recv() (*BurnRecord, error) {
+	m := new(BurnRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) ParamsFormatted(ctx context.Context, in *QueryParamsFormattedRequest, opts ...grpc.CallOption) (*QueryParamsFormattedResponse, error) {
+	out := new(QueryParamsFormattedResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/ParamsFormatted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FullConfig(ctx context.Context, in *QueryFullConfigRequest, opts ...grpc.CallOption) (*QueryFullConfigResponse, error) {
+	out := new(QueryFullConfigResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/FullConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnRateBySource(ctx context.Context, in *QueryBurnRateBySourceRequest, opts ...grpc.CallOption) (*QueryBurnRateBySourceResponse, error) {
+	out := new(QueryBurnRateBySourceResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/BurnRateBySource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnsBreakdown(ctx context.Context, in *QueryBurnsBreakdownRequest, opts ...grpc.CallOption) (*QueryBurnsBreakdownResponse, error) {
+	out := new(QueryBurnsBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/BurnsBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllBurnsByChain(ctx context.Context, in *QueryAllBurnsByChainRequest, opts ...grpc.CallOption) (*QueryAllBurnsByChainResponse, error) {
+	out := new(QueryAllBurnsByChainResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/AllBurnsByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingChanges(ctx context.Context, in *QueryPendingChangesRequest, opts ...grpc.CallOption) (*QueryPendingChangesResponse, error) {
+	out := new(QueryPendingChangesResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/PendingChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error) {
+	out := new(QuerySupplyReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/SupplyReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyInvariant(ctx context.Context, in *QuerySupplyInvariantRequest, opts ...grpc.CallOption) (*QuerySupplyInvariantResponse, error) {
+	out := new(QuerySupplyInvariantResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/SupplyInvariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryRedirect(ctx context.Context, in *QueryTreasuryRedirectRequest, opts ...grpc.CallOption) (*QueryTreasuryRedirectResponse, error) {
+	out := new(QueryTreasuryRedirectResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/TreasuryRedirect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyForecast(ctx context.Context, in *QuerySupplyForecastRequest, opts ...grpc.CallOption) (*QuerySupplyForecastResponse, error) {
+	out := new(QuerySupplyForecastResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/SupplyForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionsHistory(ctx context.Context, in *QueryEmissionsHistoryRequest, opts ...grpc.CallOption) (*QueryEmissionsHistoryResponse, error) {
+	out := new(QueryEmissionsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/EmissionsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnRatioHistory(ctx context.Context, in *QueryBurnRatioHistoryRequest, opts ...grpc.CallOption) (*QueryBurnRatioHistoryResponse, error) {
+	out := new(QueryBurnRatioHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Query/BurnRatioHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -188,6 +364,42 @@ type QueryServer interface {
 	FeeStats(context.Context, *QueryFeeStatsRequest) (*QueryFeeStatsResponse, error)
 	// BurnRate queries the current adaptive burn rate and trigger
 	BurnRate(context.Context, *QueryBurnRateRequest) (*QueryBurnRateResponse, error)
+	// StreamBurnEvents replays the most recent burns held by the node (at most
+	// BurnStreamBufferSize) and then pushes each new burn as it is recorded.
+	// Only served over gRPC; there is no REST route.
+	StreamBurnEvents(*QueryStreamBurnEventsRequest, Query_StreamBurnEventsServer) error
+	// ParamsFormatted returns the parameters as a human-readable dashboard
+	ParamsFormatted(context.Context, *QueryParamsFormattedRequest) (*QueryParamsFormattedResponse, error)
+	// FullConfig returns params together with the configuration stored outside
+	// the params object (treasury and redirect target addresses, redirect state).
+	FullConfig(context.Context, *QueryFullConfigRequest) (*QueryFullConfigResponse, error)
+	// BurnRateBySource returns, for every burn source, its share of the fee burn
+	// and its module-specific burns
+	BurnRateBySource(context.Context, *QueryBurnRateBySourceRequest) (*QueryBurnRateBySourceResponse, error)
+	// BurnsBreakdown returns the burn total of every burn source and their sum
+	BurnsBreakdown(context.Context, *QueryBurnsBreakdownRequest) (*QueryBurnsBreakdownResponse, error)
+	// AllBurnsByChain returns the burn total of every chain and their sum
+	AllBurnsByChain(context.Context, *QueryAllBurnsByChainRequest) (*QueryAllBurnsByChainResponse, error)
+	// PendingChanges lists all staged param changes and their effective epochs
+	PendingChanges(context.Context, *QueryPendingChangesRequest) (*QueryPendingChangesResponse, error)
+	// SupplyReconciliation compares the tracked supply against the bank
+	// module's supply of the native denom
+	SupplyReconciliation(context.Context, *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error)
+	// SupplyInvariant returns the stored supply counters and whether they
+	// satisfy current = minted - burned
+	SupplyInvariant(context.Context, *QuerySupplyInvariantRequest) (*QuerySupplyInvariantResponse, error)
+	// TreasuryRedirect returns the treasury redirect configuration and its
+	// cumulative state
+	TreasuryRedirect(context.Context, *QueryTreasuryRedirectRequest) (*QueryTreasuryRedirectResponse, error)
+	// SupplyForecast projects per-year mint, burn and supply from the current
+	// year
+	SupplyForecast(context.Context, *QuerySupplyForecastRequest) (*QuerySupplyForecastResponse, error)
+	// EmissionsHistory returns cumulative and last-epoch emission per recipient
+	// category
+	EmissionsHistory(context.Context, *QueryEmissionsHistoryRequest) (*QueryEmissionsHistoryResponse, error)
+	// BurnRatioHistory pages through the adaptive burn controller's decision
+	// history, oldest first
+	BurnRatioHistory(context.Context, *QueryBurnRatioHistoryRequest) (*QueryBurnRatioHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -231,6 +443,45 @@ func (UnimplementedQueryServer) FeeStats(context.Context, *QueryFeeStatsRequest)
 func (UnimplementedQueryServer) BurnRate(context.Context, *QueryBurnRateRequest) (*QueryBurnRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnRate not implemented")
 }
+func (UnimplementedQueryServer) StreamBurnEvents(*QueryStreamBurnEventsRequest, Query_StreamBurnEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBurnEvents not implemented")
+}
+func (UnimplementedQueryServer) ParamsFormatted(context.Context, *QueryParamsFormattedRequest) (*QueryParamsFormattedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsFormatted not implemented")
+}
+func (UnimplementedQueryServer) FullConfig(context.Context, *QueryFullConfigRequest) (*QueryFullConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FullConfig not implemented")
+}
+func (UnimplementedQueryServer) BurnRateBySource(context.Context, *QueryBurnRateBySourceRequest) (*QueryBurnRateBySourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnRateBySource not implemented")
+}
+func (UnimplementedQueryServer) BurnsBreakdown(context.Context, *QueryBurnsBreakdownRequest) (*QueryBurnsBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnsBreakdown not implemented")
+}
+func (UnimplementedQueryServer) AllBurnsByChain(context.Context, *QueryAllBurnsByChainRequest) (*QueryAllBurnsByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBurnsByChain not implemented")
+}
+func (UnimplementedQueryServer) PendingChanges(context.Context, *QueryPendingChangesRequest) (*QueryPendingChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingChanges not implemented")
+}
+func (UnimplementedQueryServer) SupplyReconciliation(context.Context, *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyReconciliation not implemented")
+}
+func (UnimplementedQueryServer) SupplyInvariant(context.Context, *QuerySupplyInvariantRequest) (*QuerySupplyInvariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyInvariant not implemented")
+}
+func (UnimplementedQueryServer) TreasuryRedirect(context.Context, *QueryTreasuryRedirectRequest) (*QueryTreasuryRedirectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryRedirect not implemented")
+}
+func (UnimplementedQueryServer) SupplyForecast(context.Context, *QuerySupplyForecastRequest) (*QuerySupplyForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyForecast not implemented")
+}
+func (UnimplementedQueryServer) EmissionsHistory(context.Context, *QueryEmissionsHistoryRequest) (*QueryEmissionsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionsHistory not implemented")
+}
+func (UnimplementedQueryServer) BurnRatioHistory(context.Context, *QueryBurnRatioHistoryRequest) (*QueryBurnRatioHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnRatioHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -460,6 +711,243 @@ func _Query_BurnRate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StreamBurnEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryStreamBurnEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamBurnEvents(m, &queryStreamBurnEventsServer{stream})
+}
+
+type Query_StreamBurnEventsServer interface {
+	Send(*BurnRecord) error
+	grpc.ServerStream
+}
+
+type queryStreamBurnEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamBurnEventsServer) Send(m *BurnRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ParamsFormatted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsFormattedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsFormatted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/ParamsFormatted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsFormatted(ctx, req.(*QueryParamsFormattedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FullConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFullConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FullConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/FullConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FullConfig(ctx, req.(*QueryFullConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnRateBySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnRateBySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnRateBySource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/BurnRateBySource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnRateBySource(ctx, req.(*QueryBurnRateBySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnsBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnsBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnsBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/BurnsBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnsBreakdown(ctx, req.(*QueryBurnsBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBurnsByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBurnsByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBurnsByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/AllBurnsByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBurnsByChain(ctx, req.(*QueryAllBurnsByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/PendingChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingChanges(ctx, req.(*QueryPendingChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/SupplyReconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyReconciliation(ctx, req.(*QuerySupplyReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyInvariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyInvariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyInvariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/SupplyInvariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyInvariant(ctx, req.(*QuerySupplyInvariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryRedirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryRedirectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryRedirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/TreasuryRedirect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryRedirect(ctx, req.(*QueryTreasuryRedirectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/SupplyForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyForecast(ctx, req.(*QuerySupplyForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/EmissionsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionsHistory(ctx, req.(*QueryEmissionsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnRatioHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnRatioHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnRatioHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Query/BurnRatioHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnRatioHistory(ctx, req.(*QueryBurnRatioHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -515,7 +1003,61 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BurnRate",
 			Handler:    _Query_BurnRate_Handler,
 		},
+		{
+			MethodName: "ParamsFormatted",
+			Handler:    _Query_ParamsFormatted_Handler,
+		},
+		{
+			MethodName: "FullConfig",
+			Handler:    _Query_FullConfig_Handler,
+		},
+		{
+			MethodName: "BurnRateBySource",
+			Handler:    _Query_BurnRateBySource_Handler,
+		},
+		{
+			MethodName: "BurnsBreakdown",
+			Handler:    _Query_BurnsBreakdown_Handler,
+		},
+		{
+			MethodName: "AllBurnsByChain",
+			Handler:    _Query_AllBurnsByChain_Handler,
+		},
+		{
+			MethodName: "PendingChanges",
+			Handler:    _Query_PendingChanges_Handler,
+		},
+		{
+			MethodName: "SupplyReconciliation",
+			Handler:    _Query_SupplyReconciliation_Handler,
+		},
+		{
+			MethodName: "SupplyInvariant",
+			Handler:    _Query_SupplyInvariant_Handler,
+		},
+		{
+			MethodName: "TreasuryRedirect",
+			Handler:    _Query_TreasuryRedirect_Handler,
+		},
+		{
+			MethodName: "SupplyForecast",
+			Handler:    _Query_SupplyForecast_Handler,
+		},
+		{
+			MethodName: "EmissionsHistory",
+			Handler:    _Query_EmissionsHistory_Handler,
+		},
+		{
+			MethodName: "BurnRatioHistory",
+			Handler:    _Query_BurnRatioHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBurnEvents",
+			Handler:       _Query_StreamBurnEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pos/tokenomics/v1/query.proto",
 }
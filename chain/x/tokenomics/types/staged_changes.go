@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+)
+
+// Validate performs stateless validation of a staged change
+func (s StagedChange) Validate() error {
+	if s.EffectiveEpoch <= 0 {
+		return fmt.Errorf("effective epoch must be positive, got %d", s.EffectiveEpoch)
+	}
+	if len(s.Changes) == 0 {
+		return fmt.Errorf("staged change must contain at least one param change")
+	}
+	for _, c := range s.Changes {
+		if c.Field == "" {
+			return fmt.Errorf("param change field cannot be empty")
+		}
+		if !json.Valid([]byte(c.Value)) {
+			return fmt.Errorf("param change %s: value is not valid JSON", c.Field)
+		}
+	}
+	return nil
+}
+
+// ApplyTo overwrites the named field of p with Value. Unknown fields and
+// values of the wrong type are rejected. p is not validated.
+func (c ParamChange) ApplyTo(p *TokenomicsParams) error {
+	patch, err := json.Marshal(map[string]json.RawMessage{c.Field: json.RawMessage(c.Value)})
+	if err != nil {
+		return fmt.Errorf("param change %s: %w", c.Field, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(patch))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(p); err != nil {
+		return fmt.Errorf("param change %s: %w", c.Field, err)
+	}
+	return nil
+}
+
+// GetStagedChangeKey returns the store key for a staged change. Keys sort by
+// effective epoch, then by ID, so iteration yields application order.
+func GetStagedChangeKey(epoch int64, id uint64) []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b[:8], uint64(epoch))
+	binary.BigEndian.PutUint64(b[8:], id)
+	return append(append([]byte{}, StagedChangePrefix...), b...)
+}
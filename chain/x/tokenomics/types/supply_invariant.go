@@ -0,0 +1,20 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// NewSupplyInvariant checks the conservation law over the three counters
+func NewSupplyInvariant(minted, burned, current math.Int) SupplyInvariant {
+	return SupplyInvariant{
+		TotalMinted:        minted,
+		TotalBurned:        burned,
+		CurrentTotalSupply: current,
+		Consistent:         current.Equal(minted.Sub(burned)),
+	}
+}
+
+// ExpectedSupply is the supply the conservation law requires
+func (s SupplyInvariant) ExpectedSupply() math.Int {
+	return s.TotalMinted.Sub(s.TotalBurned)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// NewSupplyReconciliation builds a reconciliation from the two supply figures
+func NewSupplyReconciliation(denom string, tracked, bank math.Int) SupplyReconciliation {
+	delta := bank.Sub(tracked)
+	return SupplyReconciliation{
+		Denom:         denom,
+		TrackedSupply: tracked,
+		BankSupply:    bank,
+		Delta:         delta,
+		Reconciled:    delta.IsZero(),
+	}
+}
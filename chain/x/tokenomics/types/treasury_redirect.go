@@ -0,0 +1,30 @@
+package types
+
+// Treasury redirect target names, in allocation order
+const (
+	RedirectTargetEcosystemGrants = "ecosystem_grants"
+	RedirectTargetBuyAndBurn      = "buy_and_burn"
+	RedirectTargetInsuranceFund   = "insurance_fund"
+	RedirectTargetResearchFund    = "research_fund"
+)
+
+// NewTreasuryRedirectStatus assembles the redirect status from params, the
+// target addresses and the redirect counters stored outside params. All four
+// targets are listed, including those with a zero ratio or no address.
+func NewTreasuryRedirectStatus(params TokenomicsParams, targets RedirectTargetAddresses, state RedirectState) TreasuryRedirectStatus {
+	return TreasuryRedirectStatus{
+		Enabled:            params.TreasuryRedirectEnabled,
+		Ratio:              params.TreasuryRedirectRatio,
+		ExecutionInterval:  params.RedirectExecutionInterval,
+		MaxPerExecution:    params.MaxRedirectPerExecution,
+		AccumulatedInflows: state.AccumulatedRedirectInflows,
+		TotalRedirected:    state.TotalRedirected,
+		LastRedirectHeight: state.LastRedirectHeight,
+		Targets: []RedirectTargetStatus{
+			{Name: RedirectTargetEcosystemGrants, Address: targets.EcosystemGrants, Ratio: params.RedirectToEcosystemGrants},
+			{Name: RedirectTargetBuyAndBurn, Address: targets.BuyAndBurn, Ratio: params.RedirectToBuyAndBurn},
+			{Name: RedirectTargetInsuranceFund, Address: targets.InsuranceFund, Ratio: params.RedirectToInsuranceFund},
+			{Name: RedirectTargetResearchFund, Address: targets.ResearchFund, Ratio: params.RedirectToResearchFund},
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Treasury-pct input modes for the adaptive burn controller
+const (
+	// TreasuryPctModeInstantaneous reads the treasury balance of the current block
+	TreasuryPctModeInstantaneous = "instantaneous"
+
+	// TreasuryPctModeMovingAverage averages the treasury pct over the last
+	// WindowBlocks blocks
+	TreasuryPctModeMovingAverage = "moving_average"
+)
+
+const (
+	// DefaultTreasuryPctWindowBlocks is the moving-average window (~1 hour at 6s blocks)
+	DefaultTreasuryPctWindowBlocks uint64 = 600
+
+	// MaxTreasuryPctWindowBlocks bounds the window (~1 day at 6s blocks)
+	MaxTreasuryPctWindowBlocks uint64 = 14400
+)
+
+// TreasuryPctSmoothing configures how the adaptive burn controller reads the
+// treasury percentage. Stored as JSON under KeyTreasuryPctSmoothing.
+type TreasuryPctSmoothing struct {
+	Mode         string `json:"mode"`
+	WindowBlocks uint64 `json:"window_blocks"`
+}
+
+// DefaultTreasuryPctSmoothing returns the configuration used before governance
+// sets one. Instantaneous mode keeps the controller's original behaviour.
+func DefaultTreasuryPctSmoothing() TreasuryPctSmoothing {
+	return TreasuryPctSmoothing{
+		Mode:         TreasuryPctModeInstantaneous,
+		WindowBlocks: DefaultTreasuryPctWindowBlocks,
+	}
+}
+
+// Validate checks the mode and window bounds
+func (s TreasuryPctSmoothing) Validate() error {
+	switch s.Mode {
+	case TreasuryPctModeInstantaneous:
+		return nil
+	case TreasuryPctModeMovingAverage:
+		if s.WindowBlocks == 0 || s.WindowBlocks > MaxTreasuryPctWindowBlocks {
+			return fmt.Errorf("treasury pct window must be 1-%d blocks, got %d",
+				MaxTreasuryPctWindowBlocks, s.WindowBlocks)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown treasury pct smoothing mode %q", s.Mode)
+	}
+}
+
+// TreasuryPctWindow is the running state of the moving average. Samples live
+// in a ring of WindowBlocks slots under TreasuryPctSamplePrefix; Sum is the
+// total of the Count samples currently in the ring.
+type TreasuryPctWindow struct {
+	Sum   math.LegacyDec `json:"sum"`
+	Count uint64         `json:"count"`
+	Next  uint64         `json:"next"`
+}
+
+// Average returns the mean of the samples in the window, or zero when empty
+func (w TreasuryPctWindow) Average() math.LegacyDec {
+	if w.Count == 0 {
+		return math.LegacyZeroDec()
+	}
+	return w.Sum.QuoInt64(int64(w.Count))
+}
+
+// GetTreasuryPctSampleKey returns the store key for a treasury-pct sample slot
+func GetTreasuryPctSampleKey(slot uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, slot)
+	return append(append([]byte{}, TreasuryPctSamplePrefix...), b...)
+}
@@ -46,19 +46,21 @@ const (
 	BurnSource_BURN_SOURCE_SLASHING        BurnSource = 7
 	BurnSource_BURN_SOURCE_GOVERNANCE      BurnSource = 8
 	BurnSource_BURN_SOURCE_OTHER           BurnSource = 9
+	BurnSource_BURN_SOURCE_BUY_AND_BURN    BurnSource = 10
 )
 
 var BurnSource_name = map[int32]string{
-	0: "BURN_SOURCE_UNSPECIFIED",
-	1: "BURN_SOURCE_POS_GAS",
-	2: "BURN_SOURCE_POC_ANCHORING",
-	3: "BURN_SOURCE_SEQUENCER_GAS",
-	4: "BURN_SOURCE_SMART_CONTRACTS",
-	5: "BURN_SOURCE_AI_QUERIES",
-	6: "BURN_SOURCE_MESSAGING",
-	7: "BURN_SOURCE_SLASHING",
-	8: "BURN_SOURCE_GOVERNANCE",
-	9: "BURN_SOURCE_OTHER",
+	0:  "BURN_SOURCE_UNSPECIFIED",
+	1:  "BURN_SOURCE_POS_GAS",
+	2:  "BURN_SOURCE_POC_ANCHORING",
+	3:  "BURN_SOURCE_SEQUENCER_GAS",
+	4:  "BURN_SOURCE_SMART_CONTRACTS",
+	5:  "BURN_SOURCE_AI_QUERIES",
+	6:  "BURN_SOURCE_MESSAGING",
+	7:  "BURN_SOURCE_SLASHING",
+	8:  "BURN_SOURCE_GOVERNANCE",
+	9:  "BURN_SOURCE_OTHER",
+	10: "BURN_SOURCE_BUY_AND_BURN",
 }
 
 var BurnSource_value = map[string]int32{
@@ -72,6 +74,7 @@ var BurnSource_value = map[string]int32{
 	"BURN_SOURCE_SLASHING":        7,
 	"BURN_SOURCE_GOVERNANCE":      8,
 	"BURN_SOURCE_OTHER":           9,
+	"BURN_SOURCE_BUY_AND_BURN":    10,
 }
 
 func (x BurnSource) String() string {
@@ -416,6 +419,9 @@ type RewardRecipient struct {
 	DestinationChain string `protobuf:"bytes,3,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
 	// ibc_channel is the IBC channel ID (e.g., "channel-0")
 	IbcChannel string `protobuf:"bytes,4,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	// category is the emission split the amount belongs to
+	// (staking, poc, sequencer or treasury), used for emission accounting
+	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
 }
 
 func (m *RewardRecipient) Reset()         { *m = RewardRecipient{} }
@@ -472,6 +478,13 @@ func (m *RewardRecipient) GetIbcChannel() string {
 	return ""
 }
 
+func (m *RewardRecipient) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
 // MsgDistributeRewards distributes inflationary rewards
 // Called by inflation module in EndBlock
 type MsgDistributeRewards struct {
@@ -733,6 +746,198 @@ func (m *MsgReportBurnResponse) GetRecorded() bool {
 	return false
 }
 
+// MsgSetEmergencyBurnOverride switches the guardian's emergency burn override
+// on or off without a governance vote
+type MsgSetEmergencyBurnOverride struct {
+	// guardian must be the burn_override_guardian param
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// enabled activates the override when true and clears it when false
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// burn_ratio is the fee burn ratio applied while the override is active
+	BurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=burn_ratio,json=burnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_ratio"`
+}
+
+func (m *MsgSetEmergencyBurnOverride) Reset()         { *m = MsgSetEmergencyBurnOverride{} }
+func (m *MsgSetEmergencyBurnOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmergencyBurnOverride) ProtoMessage()    {}
+func (*MsgSetEmergencyBurnOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071b56fcbfafea1b, []int{11}
+}
+func (m *MsgSetEmergencyBurnOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmergencyBurnOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmergencyBurnOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmergencyBurnOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmergencyBurnOverride.Merge(m, src)
+}
+func (m *MsgSetEmergencyBurnOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmergencyBurnOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmergencyBurnOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmergencyBurnOverride proto.InternalMessageInfo
+
+func (m *MsgSetEmergencyBurnOverride) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *MsgSetEmergencyBurnOverride) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetEmergencyBurnOverrideResponse reports when the override expires
+type MsgSetEmergencyBurnOverrideResponse struct {
+	// expires_at_height is the height at which the override lapses (0 when cleared)
+	ExpiresAtHeight int64 `protobuf:"varint,1,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (m *MsgSetEmergencyBurnOverrideResponse) Reset()         { *m = MsgSetEmergencyBurnOverrideResponse{} }
+func (m *MsgSetEmergencyBurnOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmergencyBurnOverrideResponse) ProtoMessage()    {}
+func (*MsgSetEmergencyBurnOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071b56fcbfafea1b, []int{12}
+}
+func (m *MsgSetEmergencyBurnOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmergencyBurnOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmergencyBurnOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmergencyBurnOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmergencyBurnOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetEmergencyBurnOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmergencyBurnOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmergencyBurnOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmergencyBurnOverrideResponse proto.InternalMessageInfo
+
+func (m *MsgSetEmergencyBurnOverrideResponse) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+// MsgExecuteRedirectNow runs the treasury redirect immediately instead of
+// waiting for the next execution interval
+type MsgExecuteRedirectNow struct {
+	// authority is the address that controls the module (defaults to x/gov)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgExecuteRedirectNow) Reset()         { *m = MsgExecuteRedirectNow{} }
+func (m *MsgExecuteRedirectNow) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRedirectNow) ProtoMessage()    {}
+func (*MsgExecuteRedirectNow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071b56fcbfafea1b, []int{13}
+}
+func (m *MsgExecuteRedirectNow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRedirectNow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRedirectNow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteRedirectNow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRedirectNow.Merge(m, src)
+}
+func (m *MsgExecuteRedirectNow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRedirectNow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRedirectNow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteRedirectNow proto.InternalMessageInfo
+
+func (m *MsgExecuteRedirectNow) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgExecuteRedirectNowResponse reports what the forced redirect moved
+type MsgExecuteRedirectNowResponse struct {
+	// redirected_amount is the total sent to the redirect targets
+	RedirectedAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=redirected_amount,json=redirectedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"redirected_amount"`
+	// carried_over is the inflow left pending by the per-execution cap
+	CarriedOver cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=carried_over,json=carriedOver,proto3,customtype=cosmossdk.io/math.Int" json:"carried_over"`
+}
+
+func (m *MsgExecuteRedirectNowResponse) Reset()         { *m = MsgExecuteRedirectNowResponse{} }
+func (m *MsgExecuteRedirectNowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteRedirectNowResponse) ProtoMessage()    {}
+func (*MsgExecuteRedirectNowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_071b56fcbfafea1b, []int{14}
+}
+func (m *MsgExecuteRedirectNowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteRedirectNowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteRedirectNowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteRedirectNowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteRedirectNowResponse.Merge(m, src)
+}
+func (m *MsgExecuteRedirectNowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteRedirectNowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteRedirectNowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteRedirectNowResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("pos.tokenomics.v1.BurnSource", BurnSource_name, BurnSource_value)
 	proto.RegisterType((*MsgUpdateParams)(nil), "pos.tokenomics.v1.MsgUpdateParams")
@@ -746,92 +951,110 @@ func init() {
 	proto.RegisterType((*MsgDistributeRewardsResponse)(nil), "pos.tokenomics.v1.MsgDistributeRewardsResponse")
 	proto.RegisterType((*MsgReportBurn)(nil), "pos.tokenomics.v1.MsgReportBurn")
 	proto.RegisterType((*MsgReportBurnResponse)(nil), "pos.tokenomics.v1.MsgReportBurnResponse")
+	proto.RegisterType((*MsgSetEmergencyBurnOverride)(nil), "pos.tokenomics.v1.MsgSetEmergencyBurnOverride")
+	proto.RegisterType((*MsgSetEmergencyBurnOverrideResponse)(nil), "pos.tokenomics.v1.MsgSetEmergencyBurnOverrideResponse")
+	proto.RegisterType((*MsgExecuteRedirectNow)(nil), "pos.tokenomics.v1.MsgExecuteRedirectNow")
+	proto.RegisterType((*MsgExecuteRedirectNowResponse)(nil), "pos.tokenomics.v1.MsgExecuteRedirectNowResponse")
 }
 
 func init() { proto.RegisterFile("pos/tokenomics/v1/tx.proto", fileDescriptor_071b56fcbfafea1b) }
 
 var fileDescriptor_071b56fcbfafea1b = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0xb6, 0x24, 0x5b, 0xb6, 0xc7, 0x76, 0x42, 0x6d, 0xec, 0x58, 0x56, 0x7e, 0x91, 0xfd, 0x53,
-	0x51, 0xd4, 0x70, 0x10, 0x29, 0x76, 0xda, 0xa2, 0xf0, 0x4d, 0x92, 0x15, 0x5b, 0x40, 0x24, 0x2b,
-	0x4b, 0xa9, 0x68, 0x73, 0x28, 0x41, 0x91, 0x5b, 0x89, 0xb0, 0xc5, 0x25, 0xb8, 0xab, 0xc4, 0xbe,
-	0x15, 0x3d, 0xe6, 0xd2, 0xbe, 0x42, 0x0f, 0x05, 0x7a, 0xcc, 0x21, 0xcf, 0xd0, 0xe6, 0x18, 0xe4,
-	0x14, 0xf4, 0x10, 0x04, 0xc9, 0x21, 0xe8, 0xa9, 0x40, 0x9f, 0xa0, 0xe0, 0x2e, 0x25, 0x52, 0x7f,
-	0x0c, 0xc7, 0xaa, 0xdb, 0x8b, 0xe1, 0x9d, 0xf9, 0xf6, 0xe3, 0xcc, 0xe8, 0x9b, 0x19, 0x12, 0x52,
-	0x0e, 0x65, 0x39, 0x4e, 0x8f, 0x88, 0x4d, 0x3b, 0x96, 0xc1, 0x72, 0x8f, 0xb6, 0x73, 0xfc, 0x24,
-	0xeb, 0xb8, 0x94, 0x53, 0x94, 0x70, 0x28, 0xcb, 0x06, 0xbe, 0xec, 0xa3, 0xed, 0x54, 0x42, 0xef,
-	0x58, 0x36, 0xcd, 0x89, 0xbf, 0x12, 0x95, 0x5a, 0x35, 0x28, 0xeb, 0x50, 0x96, 0xeb, 0xb0, 0x96,
-	0x77, 0xbb, 0xc3, 0x5a, 0xbe, 0x63, 0x4d, 0x3a, 0x34, 0x71, 0xca, 0xc9, 0x83, 0xef, 0x5a, 0x6e,
-	0xd1, 0x16, 0x95, 0x76, 0xef, 0x3f, 0xdf, 0x9a, 0x1e, 0x8d, 0xc5, 0xd1, 0x5d, 0xbd, 0xe3, 0xdf,
-	0xca, 0xfc, 0x1a, 0x81, 0xab, 0x15, 0xd6, 0x6a, 0x38, 0xa6, 0xce, 0x49, 0x4d, 0x78, 0xd0, 0xe7,
-	0x30, 0xaf, 0x77, 0x79, 0x9b, 0xba, 0x16, 0x3f, 0x4d, 0x46, 0x36, 0x22, 0x9b, 0xf3, 0x85, 0xe4,
-	0xcb, 0x67, 0xb7, 0x97, 0xfd, 0xc7, 0xe5, 0x4d, 0xd3, 0x25, 0x8c, 0xa9, 0xdc, 0xb5, 0xec, 0x16,
-	0x0e, 0xa0, 0xe8, 0x1e, 0xc4, 0x25, 0x77, 0x32, 0xba, 0x11, 0xd9, 0x5c, 0xd8, 0xf9, 0x28, 0x3b,
-	0x92, 0x6c, 0xb6, 0xde, 0x3f, 0xc9, 0x87, 0x15, 0xe6, 0x9f, 0xbf, 0x5e, 0x9f, 0xfa, 0xe5, 0xfd,
-	0xd3, 0xad, 0x08, 0xf6, 0x6f, 0xef, 0xde, 0xfd, 0xfe, 0xfd, 0xd3, 0xad, 0x80, 0xf7, 0xc9, 0xfb,
-	0xa7, 0x5b, 0x1b, 0x5e, 0x1a, 0x27, 0xe1, 0x44, 0x86, 0x82, 0xce, 0xac, 0xc1, 0xea, 0x90, 0x09,
-	0x13, 0xe6, 0x50, 0x9b, 0x91, 0xcc, 0x0f, 0x51, 0x58, 0xaa, 0xb0, 0x56, 0xc5, 0xb2, 0xb9, 0x78,
-	0xfc, 0xe4, 0x19, 0x16, 0x21, 0xae, 0x77, 0x68, 0xd7, 0xe6, 0x22, 0xc3, 0xf9, 0xc2, 0x2d, 0x2f,
-	0xf8, 0xdf, 0x5f, 0xaf, 0xaf, 0xc8, 0x8b, 0xcc, 0x3c, 0xca, 0x5a, 0x34, 0xd7, 0xd1, 0x79, 0x3b,
-	0x5b, 0xb6, 0xf9, 0xcb, 0x67, 0xb7, 0xc1, 0x67, 0x2c, 0xdb, 0x1c, 0xfb, 0x57, 0xd1, 0x75, 0x88,
-	0xbb, 0x44, 0x67, 0xd4, 0x4e, 0xc6, 0x3c, 0x12, 0xec, 0x9f, 0xbc, 0xa0, 0x5c, 0x62, 0x58, 0x8e,
-	0x45, 0x6c, 0x9e, 0x9c, 0x3e, 0x2f, 0xa8, 0x3e, 0x74, 0x77, 0x7b, 0xb4, 0x5c, 0xe9, 0x71, 0xe5,
-	0x0a, 0xf2, 0xcf, 0xfc, 0x14, 0x85, 0x95, 0x01, 0x4b, 0xaf, 0x56, 0xa8, 0x01, 0x8a, 0x4d, 0x1e,
-	0x6b, 0x9c, 0x72, 0xfd, 0x58, 0x63, 0x5d, 0xc7, 0x39, 0xee, 0x15, 0xe8, 0x42, 0xb9, 0x5e, 0xb1,
-	0xc9, 0xe3, 0xba, 0xc7, 0xa1, 0x0a, 0x8a, 0x41, 0xda, 0x8e, 0x65, 0x73, 0x62, 0x4e, 0x52, 0xc2,
-	0x3e, 0x6d, 0x45, 0x50, 0xa0, 0x87, 0x80, 0x5c, 0xd2, 0xd1, 0x2d, 0xdb, 0xb2, 0x5b, 0x82, 0x56,
-	0x6f, 0x1e, 0x13, 0x59, 0xd6, 0x8b, 0x11, 0x27, 0xfa, 0x34, 0x15, 0x9f, 0x25, 0xf3, 0xa7, 0x54,
-	0x4d, 0xa1, 0xeb, 0xda, 0xbe, 0x6a, 0xee, 0x40, 0xbc, 0xd9, 0x75, 0x6d, 0xe2, 0x9e, 0x2b, 0x19,
-	0x1f, 0x77, 0x39, 0x7a, 0xf9, 0x0c, 0xe2, 0x8c, 0x76, 0x5d, 0x43, 0x26, 0x76, 0x65, 0xe7, 0xe6,
-	0x98, 0xb6, 0xf2, 0xa2, 0x54, 0x05, 0x08, 0xfb, 0x60, 0xb4, 0x06, 0x73, 0x46, 0x5b, 0xb7, 0x6c,
-	0xcd, 0x32, 0xa5, 0x9a, 0xf0, 0xac, 0x38, 0x97, 0x4d, 0x44, 0x60, 0x85, 0x7b, 0xa2, 0xeb, 0xba,
-	0xa7, 0x9a, 0x4b, 0x4c, 0xcb, 0x25, 0x06, 0xd7, 0x1c, 0x83, 0x27, 0x67, 0x44, 0x94, 0xdb, 0x7e,
-	0x94, 0x37, 0x46, 0xa3, 0xbc, 0x4f, 0x5a, 0xba, 0x71, 0xba, 0x47, 0x8c, 0x50, 0xac, 0x7b, 0xc4,
-	0xc0, 0xd7, 0x7a, 0x7c, 0xd8, 0xa7, 0xab, 0x19, 0x7c, 0x37, 0xeb, 0x09, 0xd3, 0x2f, 0xc5, 0x99,
-	0xaa, 0x0c, 0xea, 0x9b, 0xf9, 0x4b, 0xaa, 0x32, 0xb0, 0xfc, 0xa7, 0xaa, 0x14, 0x71, 0xfe, 0x33,
-	0x55, 0x16, 0x04, 0x05, 0xaa, 0xc1, 0x92, 0xfc, 0xe9, 0x7a, 0x9c, 0x13, 0x08, 0x72, 0x51, 0x32,
-	0xf8, 0x8c, 0x5f, 0x03, 0xf2, 0x19, 0x39, 0xd5, 0x7a, 0xa5, 0xf6, 0x67, 0xc4, 0x85, 0x68, 0x15,
-	0x49, 0x53, 0xa7, 0x75, 0x9f, 0x24, 0xf3, 0x2a, 0x02, 0x57, 0x31, 0x79, 0xac, 0xbb, 0x26, 0xee,
-	0x4d, 0x14, 0xb4, 0x03, 0xb3, 0xba, 0xd4, 0xf3, 0xb9, 0x4a, 0xef, 0x01, 0x2f, 0x47, 0xea, 0xb7,
-	0x20, 0x61, 0x12, 0xc6, 0x2d, 0x5b, 0xe7, 0x16, 0xb5, 0x35, 0xa1, 0x57, 0x7f, 0x4a, 0x2a, 0x21,
-	0x47, 0xd1, 0xb3, 0xa3, 0x75, 0x58, 0xb0, 0x9a, 0x86, 0x07, 0xb2, 0x6d, 0x72, 0xec, 0x6b, 0x1c,
-	0xac, 0xa6, 0x51, 0x94, 0x96, 0xcc, 0x6f, 0x51, 0x58, 0xae, 0xb0, 0xd6, 0x9e, 0xc5, 0xb8, 0x6b,
-	0x35, 0xbb, 0x9c, 0xc8, 0x3c, 0x27, 0x1f, 0xff, 0x35, 0x58, 0x92, 0x5a, 0x71, 0x25, 0xd1, 0x24,
-	0xa9, 0x2e, 0x0a, 0x86, 0x5e, 0x24, 0x07, 0x00, 0xfd, 0x41, 0xce, 0x92, 0xb1, 0x8d, 0xd8, 0xe6,
-	0xc2, 0x4e, 0x66, 0x4c, 0x7f, 0x0f, 0xfd, 0x42, 0x85, 0x69, 0xef, 0x91, 0x38, 0x74, 0x17, 0xfd,
-	0x1f, 0x16, 0x9b, 0xc7, 0xd4, 0x38, 0xd2, 0xda, 0xc4, 0x6a, 0xb5, 0xe5, 0x02, 0x89, 0xe1, 0x05,
-	0x61, 0x3b, 0x10, 0xa6, 0xdd, 0x2f, 0x46, 0x17, 0xc5, 0xc7, 0xe3, 0x5a, 0x72, 0xa4, 0x60, 0x99,
-	0x97, 0x51, 0xf8, 0xdf, 0x38, 0x47, 0xbf, 0x41, 0xbf, 0x82, 0x84, 0xac, 0x8c, 0xd9, 0x87, 0x98,
-	0x93, 0x74, 0xa8, 0x22, 0x58, 0x82, 0xe7, 0x98, 0x1e, 0xf3, 0x31, 0x35, 0x86, 0x98, 0x27, 0xa8,
-	0xbb, 0x22, 0x58, 0xc2, 0xcc, 0x75, 0xb8, 0xea, 0xe9, 0x27, 0xcc, 0x3b, 0x41, 0xa3, 0x5e, 0xb1,
-	0x9a, 0x46, 0x98, 0x75, 0x13, 0x14, 0x8f, 0xd5, 0xd1, 0x8d, 0x23, 0xc2, 0x99, 0xc6, 0x7a, 0xcb,
-	0x7c, 0x49, 0x20, 0x6b, 0xd2, 0xac, 0x12, 0x9b, 0x67, 0xde, 0xc8, 0x05, 0x83, 0x89, 0x43, 0x5d,
-	0xd1, 0xe8, 0xe8, 0x53, 0x98, 0x73, 0xc5, 0xe9, 0x03, 0x56, 0x4c, 0x1f, 0x39, 0x30, 0xe8, 0xa3,
-	0x83, 0x83, 0x3e, 0x68, 0xca, 0xd8, 0x65, 0xec, 0x9f, 0xe9, 0x8b, 0xec, 0x9f, 0x61, 0x41, 0xce,
-	0x8c, 0x08, 0x12, 0xad, 0xc2, 0x2c, 0x3f, 0xd1, 0xda, 0x3a, 0x6b, 0x27, 0xe3, 0xf2, 0x55, 0x88,
-	0x9f, 0x1c, 0xe8, 0xac, 0x8d, 0x96, 0x61, 0xc6, 0x71, 0x29, 0xfd, 0x36, 0x39, 0xbb, 0x11, 0xd9,
-	0x5c, 0xc4, 0xf2, 0xb0, 0x7b, 0xc7, 0xd3, 0x6f, 0x3f, 0xef, 0x33, 0x37, 0x4a, 0x50, 0xd0, 0xcc,
-	0x93, 0x88, 0xd8, 0x28, 0x81, 0xa5, 0x2f, 0xd8, 0x94, 0x57, 0x6a, 0x83, 0xba, 0xa6, 0xaf, 0xd3,
-	0x39, 0xdc, 0x3f, 0xff, 0x4b, 0x6b, 0x61, 0xeb, 0xe7, 0x28, 0x40, 0x50, 0x27, 0x74, 0x03, 0x56,
-	0x0b, 0x0d, 0x5c, 0xd5, 0xd4, 0xc3, 0x06, 0x2e, 0x96, 0xb4, 0x46, 0x55, 0xad, 0x95, 0x8a, 0xe5,
-	0x7b, 0xe5, 0xd2, 0x9e, 0x32, 0x85, 0x56, 0xe1, 0x5a, 0xd8, 0x59, 0x3b, 0x54, 0xb5, 0xfd, 0xbc,
-	0xaa, 0x44, 0xd0, 0x4d, 0x58, 0x1b, 0x74, 0x14, 0xb5, 0x7c, 0xb5, 0x78, 0x70, 0x88, 0xcb, 0xd5,
-	0x7d, 0x25, 0x3a, 0xec, 0x56, 0x4b, 0x0f, 0x1a, 0xa5, 0x6a, 0xb1, 0x84, 0xc5, 0xed, 0x18, 0x5a,
-	0x87, 0x1b, 0x03, 0xee, 0x4a, 0x1e, 0xd7, 0xb5, 0xe2, 0x61, 0xb5, 0x8e, 0xf3, 0xc5, 0xba, 0xaa,
-	0x4c, 0xa3, 0x14, 0x5c, 0x0f, 0x03, 0xf2, 0x65, 0xed, 0x41, 0xa3, 0x84, 0xcb, 0x25, 0x55, 0x99,
-	0x41, 0x6b, 0xb0, 0x12, 0xf6, 0x55, 0x4a, 0xaa, 0x9a, 0xdf, 0xf7, 0x1e, 0x1b, 0x47, 0x49, 0x58,
-	0x1e, 0xe0, 0xbd, 0x9f, 0x57, 0x0f, 0x3c, 0xcf, 0xec, 0x30, 0xe1, 0xfe, 0xe1, 0x97, 0x25, 0x5c,
-	0xcd, 0x57, 0x8b, 0x25, 0x65, 0x0e, 0xad, 0x40, 0x22, 0xec, 0x3b, 0xac, 0x1f, 0x94, 0xb0, 0x32,
-	0xbf, 0xf3, 0x47, 0x0c, 0x62, 0x15, 0xd6, 0x42, 0xdf, 0xc0, 0xe2, 0xc0, 0x67, 0xc9, 0xb8, 0xb9,
-	0x38, 0xf4, 0xca, 0x9f, 0xda, 0x3a, 0x1f, 0x13, 0x9a, 0x59, 0x10, 0xfa, 0x24, 0xd8, 0x18, 0x7f,
-	0x33, 0x40, 0xa4, 0x36, 0xcf, 0x43, 0x84, 0x99, 0x43, 0xaf, 0x8d, 0x67, 0x30, 0x07, 0x88, 0xb3,
-	0x98, 0xc7, 0xbc, 0x08, 0x75, 0x20, 0x31, 0xba, 0xce, 0x3e, 0x19, 0x7f, 0x7d, 0x04, 0x98, 0xca,
-	0x7d, 0x20, 0x30, 0x9c, 0x48, 0x68, 0x3c, 0x9d, 0x91, 0x48, 0x80, 0x38, 0x2b, 0x91, 0xd1, 0xfe,
-	0x4b, 0xcd, 0x7c, 0xe7, 0x7d, 0xf2, 0x15, 0xee, 0x3c, 0x7f, 0x9b, 0x8e, 0xbc, 0x78, 0x9b, 0x8e,
-	0xbc, 0x79, 0x9b, 0x8e, 0xfc, 0xf8, 0x2e, 0x3d, 0xf5, 0xe2, 0x5d, 0x7a, 0xea, 0xd5, 0xbb, 0xf4,
-	0xd4, 0xc3, 0xeb, 0x23, 0xad, 0xcd, 0x4f, 0x1d, 0xc2, 0x9a, 0x71, 0xf1, 0xdd, 0x7a, 0xf7, 0xef,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x10, 0x17, 0x6b, 0x65, 0x0f, 0x00, 0x00,
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xbd, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xaf, 0x9d, 0xc4, 0x49, 0x26, 0x69, 0xbb, 0xde, 0x26, 0x8d, 0xe3, 0xb4, 0x49, 0xd9, 0x0a,
+	0xa8, 0x52, 0xb0, 0x93, 0x14, 0x2a, 0xf0, 0x01, 0xc9, 0x76, 0xdc, 0xc4, 0x52, 0x63, 0xa7, 0xb3,
+	0x36, 0xa2, 0x3d, 0xb0, 0xda, 0xec, 0x0e, 0xf6, 0x2a, 0xf1, 0xee, 0x6a, 0x77, 0xdc, 0x26, 0x37,
+	0xd4, 0x63, 0x2f, 0xf0, 0x2f, 0x70, 0xe3, 0xc8, 0x81, 0xff, 0x00, 0x09, 0xca, 0xad, 0xea, 0x09,
+	0x71, 0xa8, 0x2a, 0x38, 0x70, 0x44, 0x42, 0xdc, 0x61, 0x3e, 0xf6, 0xcb, 0xde, 0x35, 0x69, 0x4c,
+	0xe1, 0xb0, 0x49, 0xde, 0xc7, 0xfc, 0xe6, 0xbd, 0x37, 0xbf, 0x79, 0x6f, 0x37, 0x20, 0x6f, 0x5b,
+	0x6e, 0x11, 0x5b, 0x87, 0xc8, 0xb4, 0x7a, 0x86, 0xe6, 0x16, 0x1f, 0x6e, 0x16, 0xf1, 0x71, 0xc1,
+	0x76, 0x2c, 0x6c, 0x89, 0x59, 0x62, 0x2b, 0x84, 0xb6, 0xc2, 0xc3, 0xcd, 0x7c, 0x56, 0xed, 0x19,
+	0xa6, 0x55, 0x64, 0x3f, 0xb9, 0x57, 0x7e, 0x49, 0xb3, 0xdc, 0x1e, 0x01, 0xe9, 0xb9, 0x1d, 0xba,
+	0x9a, 0xfc, 0xf2, 0x0c, 0xcb, 0xdc, 0xa0, 0x30, 0xa9, 0xc8, 0x05, 0xcf, 0xb4, 0xd0, 0xb1, 0x3a,
+	0x16, 0xd7, 0xd3, 0xbf, 0x3c, 0xed, 0x6a, 0x3c, 0x16, 0x5b, 0x75, 0xd4, 0x9e, 0xb7, 0x4a, 0xfa,
+	0x3e, 0x05, 0x2e, 0xee, 0xb9, 0x9d, 0xb6, 0xad, 0xab, 0x18, 0xed, 0x33, 0x8b, 0x78, 0x1b, 0xcc,
+	0xaa, 0x7d, 0xdc, 0xb5, 0x1c, 0x03, 0x9f, 0xe4, 0x52, 0xd7, 0x52, 0x37, 0x66, 0x2b, 0xb9, 0xe7,
+	0xdf, 0xbe, 0xbb, 0xe0, 0x6d, 0x57, 0xd6, 0x75, 0x07, 0xb9, 0xae, 0x8c, 0x1d, 0xc3, 0xec, 0xc0,
+	0xd0, 0x55, 0xbc, 0x03, 0x32, 0x1c, 0x3b, 0x97, 0x26, 0x8b, 0xe6, 0xb6, 0xae, 0x17, 0x62, 0xc9,
+	0x16, 0x5a, 0x81, 0xc4, 0x37, 0xab, 0xcc, 0x3e, 0x7d, 0xb1, 0x76, 0xee, 0xeb, 0xdf, 0xbe, 0x59,
+	0x4f, 0x41, 0x6f, 0x75, 0xe9, 0xd6, 0x63, 0x22, 0x86, 0xb8, 0x4f, 0x88, 0x74, 0x8d, 0xa6, 0x71,
+	0x1c, 0x4d, 0x64, 0x28, 0x68, 0x69, 0x19, 0x2c, 0x0d, 0xa9, 0x20, 0x72, 0x6d, 0xcb, 0x74, 0x91,
+	0xf4, 0x45, 0x1a, 0x9c, 0x27, 0xb6, 0x3d, 0xc3, 0xc4, 0x6c, 0xfb, 0xf1, 0x33, 0xac, 0x82, 0x8c,
+	0xda, 0xb3, 0xfa, 0x26, 0x66, 0x19, 0xce, 0x56, 0x6e, 0xd2, 0xe0, 0x7f, 0x7e, 0xb1, 0xb6, 0xc8,
+	0x17, 0xba, 0xfa, 0x61, 0xc1, 0xb0, 0x8a, 0x3d, 0x15, 0x77, 0x0b, 0x75, 0x13, 0x13, 0x44, 0xe0,
+	0x21, 0x12, 0x09, 0x7a, 0x4b, 0xc5, 0xcb, 0x20, 0xe3, 0x20, 0xd5, 0xb5, 0xcc, 0xdc, 0x04, 0x05,
+	0x81, 0x9e, 0x44, 0x83, 0x72, 0x90, 0x66, 0xd8, 0x06, 0x22, 0xf8, 0x93, 0xa7, 0x05, 0x15, 0xb8,
+	0x96, 0x36, 0xe3, 0xe5, 0x5a, 0x4d, 0x2a, 0x57, 0x98, 0xbf, 0xf4, 0x55, 0x1a, 0x2c, 0x0e, 0x68,
+	0xfc, 0x5a, 0x89, 0x6d, 0x20, 0x98, 0xe8, 0x91, 0x82, 0x2d, 0xac, 0x1e, 0x29, 0x6e, 0xdf, 0xb6,
+	0x8f, 0xfc, 0x02, 0x9d, 0x29, 0xd7, 0x0b, 0x04, 0xa4, 0x45, 0x31, 0x64, 0x06, 0x31, 0x08, 0x4b,
+	0x88, 0x8e, 0x91, 0x3e, 0x4e, 0x09, 0x03, 0xd8, 0x3d, 0x06, 0x21, 0x3e, 0x00, 0xa2, 0x83, 0x7a,
+	0xaa, 0x61, 0x92, 0x92, 0x30, 0x58, 0xf5, 0xe0, 0x08, 0xf1, 0xb2, 0x9e, 0x0d, 0x38, 0x1b, 0xc0,
+	0xec, 0x79, 0x28, 0xd2, 0xef, 0x9c, 0x35, 0x95, 0xbe, 0x63, 0x7a, 0xac, 0xd9, 0x00, 0x99, 0x03,
+	0x22, 0x21, 0xe7, 0x54, 0xca, 0x78, 0x7e, 0xaf, 0x87, 0x2f, 0xef, 0x83, 0x8c, 0x6b, 0xf5, 0x1d,
+	0x8d, 0x27, 0x76, 0x61, 0xeb, 0x6a, 0xc2, 0xb5, 0xa2, 0x51, 0xca, 0xcc, 0x09, 0x7a, 0xce, 0xe2,
+	0x32, 0x98, 0xd1, 0xba, 0x24, 0x27, 0xc5, 0xd0, 0x39, 0x9b, 0xe0, 0x34, 0x93, 0xeb, 0xba, 0x88,
+	0xc0, 0x22, 0xa6, 0xa4, 0xeb, 0x3b, 0x27, 0x8a, 0x83, 0x74, 0x83, 0x70, 0x09, 0x2b, 0xb6, 0x86,
+	0x73, 0x53, 0x2c, 0xca, 0x4d, 0x2f, 0xca, 0x95, 0x78, 0x94, 0x77, 0x51, 0x47, 0xd5, 0x4e, 0xb6,
+	0x91, 0x16, 0x89, 0x95, 0x48, 0xf0, 0x92, 0x8f, 0x07, 0x3d, 0xb8, 0x7d, 0x0d, 0x97, 0x0a, 0x94,
+	0x98, 0x5e, 0x29, 0x46, 0xb2, 0x32, 0xac, 0xaf, 0xf4, 0x07, 0x67, 0x65, 0xa8, 0xf9, 0x5f, 0x59,
+	0xc9, 0xe2, 0xfc, 0x77, 0xac, 0xac, 0x30, 0x08, 0x71, 0x1f, 0x9c, 0xe7, 0x47, 0xe7, 0x63, 0x8e,
+	0x41, 0xc8, 0x79, 0x8e, 0xe0, 0x21, 0xde, 0x07, 0xa2, 0x87, 0x88, 0x2d, 0xc5, 0x2f, 0xb5, 0xd7,
+	0x23, 0xce, 0x04, 0x2b, 0x70, 0x98, 0x96, 0xd5, 0xf2, 0x40, 0xa4, 0x3f, 0xc9, 0x00, 0x80, 0xe8,
+	0x91, 0xea, 0xe8, 0xd0, 0xef, 0x28, 0xe2, 0x16, 0x98, 0x56, 0x39, 0x9f, 0x4f, 0x65, 0xba, 0xef,
+	0xf8, 0x7a, 0xa8, 0x7e, 0x13, 0x64, 0x75, 0xe4, 0x62, 0xc3, 0x54, 0xb1, 0x61, 0x99, 0x0a, 0xe3,
+	0xab, 0xd7, 0x25, 0x85, 0x88, 0xa1, 0x4a, 0xf5, 0xe2, 0x1a, 0x98, 0x33, 0x0e, 0x34, 0xea, 0x64,
+	0x9a, 0xe8, 0xc8, 0xe3, 0x38, 0x20, 0xaa, 0x2a, 0xd7, 0x88, 0x79, 0x72, 0x03, 0xc8, 0x34, 0xe8,
+	0x58, 0xa4, 0x56, 0x8c, 0xd9, 0x30, 0x90, 0xa5, 0x1f, 0xd2, 0x60, 0x81, 0x70, 0x6d, 0xdb, 0x70,
+	0x49, 0x1e, 0x07, 0x7d, 0x8c, 0x78, 0x0d, 0xc6, 0x1f, 0x0d, 0xe4, 0xd0, 0x39, 0x8f, 0x1c, 0x0e,
+	0x34, 0x4e, 0x19, 0xe6, 0x19, 0x82, 0x1f, 0xc9, 0x2e, 0x00, 0x41, 0x93, 0x77, 0x49, 0x15, 0x26,
+	0xc8, 0x48, 0x95, 0x12, 0xee, 0xfe, 0xd0, 0xe9, 0x55, 0x26, 0xe9, 0x96, 0x30, 0xb2, 0x56, 0x7c,
+	0x03, 0xcc, 0x1f, 0x1c, 0x59, 0xda, 0xa1, 0xd2, 0x45, 0x46, 0xa7, 0xcb, 0x87, 0xcb, 0x04, 0x9c,
+	0x63, 0xba, 0x5d, 0xa6, 0x2a, 0x7d, 0x10, 0x1f, 0x22, 0x6f, 0x26, 0x5d, 0xd7, 0x58, 0xc1, 0xa4,
+	0xe7, 0x69, 0x70, 0x25, 0xc9, 0x10, 0x5c, 0xde, 0x4f, 0x40, 0x96, 0x57, 0x46, 0x0f, 0x5c, 0xf4,
+	0x71, 0x6e, 0xaf, 0xc0, 0x50, 0xc2, 0x7d, 0x74, 0x8a, 0x4c, 0x52, 0x18, 0x42, 0x1e, 0xa3, 0xee,
+	0x02, 0x43, 0x89, 0x22, 0xb7, 0xc0, 0x45, 0xca, 0xad, 0x28, 0xee, 0x18, 0x97, 0xf8, 0x02, 0xc1,
+	0x88, 0xa2, 0xde, 0x00, 0x02, 0x45, 0xb5, 0x55, 0xed, 0x10, 0x61, 0x57, 0x71, 0xfd, 0x41, 0x7f,
+	0x9e, 0x79, 0xee, 0x73, 0xb5, 0x4c, 0xb4, 0xd2, 0x4b, 0x3e, 0x7c, 0x20, 0xb2, 0x2d, 0x87, 0x35,
+	0x01, 0xf1, 0x3d, 0x30, 0xe3, 0x30, 0xe9, 0x15, 0xc6, 0x4f, 0xe0, 0x39, 0x30, 0x04, 0xd2, 0x83,
+	0x43, 0x20, 0xbc, 0xb0, 0x13, 0xaf, 0x63, 0x36, 0x4d, 0x9e, 0x65, 0x36, 0x0d, 0x13, 0x72, 0x2a,
+	0x46, 0x48, 0x71, 0x09, 0x4c, 0xe3, 0x63, 0xa5, 0xab, 0xba, 0xdd, 0x5c, 0x86, 0xbf, 0x26, 0xe1,
+	0xe3, 0x5d, 0x22, 0x89, 0x0b, 0x60, 0x8a, 0xbc, 0xba, 0x5a, 0x9f, 0xe5, 0xa6, 0x89, 0x7a, 0x1e,
+	0x72, 0xa1, 0xb4, 0x41, 0xf9, 0x1b, 0xe4, 0x3d, 0x72, 0xda, 0x84, 0x05, 0x95, 0x9e, 0xa4, 0xd8,
+	0xb4, 0x09, 0x35, 0x01, 0x61, 0xf3, 0xb4, 0xd4, 0x9a, 0xe5, 0xe8, 0x1e, 0x4f, 0x67, 0x60, 0x20,
+	0xff, 0x47, 0x23, 0x43, 0xfa, 0x2b, 0x05, 0x56, 0x48, 0x30, 0x32, 0xc2, 0xb5, 0x1e, 0x72, 0x3a,
+	0xc8, 0xd4, 0x4e, 0xa8, 0xa5, 0xf9, 0x10, 0x39, 0x8e, 0xa1, 0x23, 0x7a, 0xfa, 0x9d, 0x3e, 0xb9,
+	0x55, 0x86, 0x6a, 0x9e, 0x7e, 0xfa, 0xbe, 0xa7, 0x98, 0x03, 0xd3, 0xc8, 0xa4, 0x2f, 0x33, 0x3c,
+	0xc6, 0x19, 0xe8, 0x8b, 0xa4, 0x5b, 0x01, 0x1a, 0xbc, 0xe2, 0xd0, 0x7e, 0xea, 0x11, 0x60, 0x8c,
+	0xb1, 0x3f, 0x4b, 0x41, 0x20, 0xc5, 0x28, 0x7d, 0xc4, 0x0e, 0xc0, 0xdf, 0x9a, 0x1e, 0xc0, 0x3b,
+	0x49, 0x07, 0x30, 0x2a, 0x43, 0xe9, 0x1e, 0xb8, 0xfe, 0x0f, 0xe6, 0xe0, 0x6c, 0xd6, 0x41, 0x16,
+	0x1d, 0xdb, 0xe4, 0x15, 0xc3, 0x55, 0x54, 0xec, 0xd3, 0x27, 0xc5, 0xe8, 0x73, 0xd1, 0x33, 0x94,
+	0x31, 0xa7, 0x90, 0x7f, 0xc2, 0xb5, 0x63, 0xa4, 0xb1, 0xb6, 0xc4, 0xdf, 0x4c, 0x1a, 0xd6, 0xa3,
+	0x71, 0x9b, 0x7c, 0xe9, 0xc3, 0x78, 0x97, 0x7c, 0x2b, 0x29, 0xcb, 0xf8, 0x96, 0xd2, 0x8f, 0x29,
+	0x70, 0x35, 0xd1, 0x12, 0xed, 0x93, 0xfe, 0xcb, 0x18, 0xd2, 0x15, 0xef, 0x6e, 0x8e, 0xd3, 0x27,
+	0x43, 0x94, 0x32, 0xbf, 0xa5, 0x0d, 0x30, 0xaf, 0xa9, 0xa4, 0x8e, 0x04, 0xd6, 0x22, 0x05, 0x1d,
+	0x87, 0xb0, 0x73, 0x1e, 0x00, 0x3d, 0x90, 0xf5, 0xef, 0xd2, 0x00, 0x84, 0xb7, 0x5a, 0x5c, 0x01,
+	0x4b, 0x95, 0x36, 0x6c, 0x28, 0x72, 0xb3, 0x0d, 0xab, 0x35, 0xa5, 0xdd, 0x90, 0xf7, 0x6b, 0xd5,
+	0xfa, 0x9d, 0x7a, 0x6d, 0x5b, 0x38, 0x47, 0xee, 0xf1, 0xa5, 0xa8, 0x71, 0xbf, 0x29, 0x2b, 0x3b,
+	0x65, 0x59, 0x48, 0x89, 0x57, 0xc1, 0xf2, 0xa0, 0xa1, 0xaa, 0x94, 0x1b, 0xd5, 0xdd, 0x26, 0xac,
+	0x37, 0x76, 0x84, 0xf4, 0xb0, 0x59, 0xae, 0xdd, 0x6b, 0xd7, 0x1a, 0xd5, 0x1a, 0x64, 0xab, 0x27,
+	0xc8, 0xf0, 0x5f, 0x19, 0x30, 0xef, 0x95, 0x61, 0x4b, 0xa9, 0x36, 0x1b, 0x2d, 0x58, 0xae, 0xb6,
+	0x64, 0x61, 0x92, 0x5c, 0xe2, 0xcb, 0x51, 0x87, 0x72, 0x5d, 0x21, 0x00, 0xb0, 0x5e, 0x93, 0x85,
+	0x29, 0xd2, 0x15, 0x17, 0xa3, 0xb6, 0xbd, 0x9a, 0x2c, 0x97, 0x77, 0xe8, 0xb6, 0x19, 0x72, 0x65,
+	0x16, 0x06, 0x70, 0xef, 0x96, 0xe5, 0x5d, 0x6a, 0x99, 0x1e, 0x06, 0xdc, 0x69, 0x7e, 0x5c, 0x83,
+	0x0d, 0x12, 0x71, 0x4d, 0x98, 0x11, 0x17, 0x41, 0x36, 0x6a, 0x6b, 0xb6, 0x76, 0x6b, 0x50, 0x98,
+	0x15, 0xaf, 0x80, 0x5c, 0x54, 0x5d, 0x69, 0xdf, 0x27, 0x29, 0x6e, 0x2b, 0x54, 0x27, 0x80, 0xad,
+	0x97, 0x53, 0x60, 0x82, 0x30, 0x42, 0xfc, 0x14, 0xcc, 0x0f, 0x7c, 0x7e, 0x27, 0xcd, 0xf8, 0xa1,
+	0x4f, 0xdb, 0xfc, 0xfa, 0xe9, 0x3e, 0x11, 0x5e, 0x81, 0xc8, 0xa7, 0xef, 0xb5, 0xe4, 0x95, 0xa1,
+	0x47, 0xfe, 0xc6, 0x69, 0x1e, 0x51, 0xe4, 0xc8, 0xe7, 0xd1, 0x08, 0xe4, 0xd0, 0x63, 0x14, 0x72,
+	0xc2, 0x0b, 0x7f, 0x0f, 0x64, 0xe3, 0xaf, 0x66, 0x6f, 0x27, 0x2f, 0x8f, 0x39, 0xe6, 0x8b, 0xaf,
+	0xe8, 0x18, 0x4d, 0x24, 0x32, 0x6a, 0x47, 0x24, 0x12, 0x7a, 0x8c, 0x4a, 0x24, 0x61, 0x96, 0x3c,
+	0x4e, 0x81, 0xdc, 0xc8, 0xae, 0x5e, 0x48, 0x86, 0x19, 0xe5, 0x9f, 0xbf, 0x7d, 0x36, 0xff, 0x20,
+	0x08, 0x1b, 0x88, 0x09, 0x4d, 0x70, 0x44, 0x12, 0x71, 0xcf, 0xfc, 0xc6, 0xab, 0x7a, 0xfa, 0x3b,
+	0xe6, 0xa7, 0x3e, 0xa7, 0xff, 0xd1, 0xa9, 0x6c, 0x3c, 0xfd, 0x65, 0x35, 0xf5, 0x8c, 0x3c, 0x2f,
+	0xc9, 0xf3, 0xe5, 0xaf, 0xab, 0xe7, 0x9e, 0x91, 0xe7, 0x27, 0xf2, 0x3c, 0xb8, 0x1c, 0x6b, 0x9b,
+	0xf8, 0xc4, 0x46, 0xee, 0x41, 0x86, 0xfd, 0x5b, 0xea, 0xd6, 0xdf, 0x58, 0x54, 0xb0, 0xd6, 0x44,
+	0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -857,6 +1080,12 @@ type MsgClient interface {
 	DistributeRewards(ctx context.Context, in *MsgDistributeRewards, opts ...grpc.CallOption) (*MsgDistributeRewardsResponse, error)
 	// ReportBurn reports a burn event from another chain (IBC callback)
 	ReportBurn(ctx context.Context, in *MsgReportBurn, opts ...grpc.CallOption) (*MsgReportBurnResponse, error)
+	// SetEmergencyBurnOverride lets the burn override guardian switch the
+	// emergency burn override on or off without a governance vote
+	SetEmergencyBurnOverride(ctx context.Context, in *MsgSetEmergencyBurnOverride, opts ...grpc.CallOption) (*MsgSetEmergencyBurnOverrideResponse, error)
+	// ExecuteRedirectNow runs the treasury redirect immediately, ignoring the
+	// execution interval (governance only)
+	ExecuteRedirectNow(ctx context.Context, in *MsgExecuteRedirectNow, opts ...grpc.CallOption) (*MsgExecuteRedirectNowResponse, error)
 }
 
 type msgClient struct {
@@ -912,6 +1141,24 @@ func (c *msgClient) ReportBurn(ctx context.Context, in *MsgReportBurn, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetEmergencyBurnOverride(ctx context.Context, in *MsgSetEmergencyBurnOverride, opts ...grpc.CallOption) (*MsgSetEmergencyBurnOverrideResponse, error) {
+	out := new(MsgSetEmergencyBurnOverrideResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Msg/SetEmergencyBurnOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteRedirectNow(ctx context.Context, in *MsgExecuteRedirectNow, opts ...grpc.CallOption) (*MsgExecuteRedirectNowResponse, error) {
+	out := new(MsgExecuteRedirectNowResponse)
+	err := c.cc.Invoke(ctx, "/pos.tokenomics.v1.Msg/ExecuteRedirectNow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the tokenomics
@@ -925,6 +1172,12 @@ type MsgServer interface {
 	DistributeRewards(context.Context, *MsgDistributeRewards) (*MsgDistributeRewardsResponse, error)
 	// ReportBurn reports a burn event from another chain (IBC callback)
 	ReportBurn(context.Context, *MsgReportBurn) (*MsgReportBurnResponse, error)
+	// SetEmergencyBurnOverride lets the burn override guardian switch the
+	// emergency burn override on or off without a governance vote
+	SetEmergencyBurnOverride(context.Context, *MsgSetEmergencyBurnOverride) (*MsgSetEmergencyBurnOverrideResponse, error)
+	// ExecuteRedirectNow runs the treasury redirect immediately, ignoring the
+	// execution interval (governance only)
+	ExecuteRedirectNow(context.Context, *MsgExecuteRedirectNow) (*MsgExecuteRedirectNowResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -946,6 +1199,12 @@ func (*UnimplementedMsgServer) DistributeRewards(ctx context.Context, req *MsgDi
 func (*UnimplementedMsgServer) ReportBurn(ctx context.Context, req *MsgReportBurn) (*MsgReportBurnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportBurn not implemented")
 }
+func (*UnimplementedMsgServer) SetEmergencyBurnOverride(ctx context.Context, req *MsgSetEmergencyBurnOverride) (*MsgSetEmergencyBurnOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmergencyBurnOverride not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteRedirectNow(ctx context.Context, req *MsgExecuteRedirectNow) (*MsgExecuteRedirectNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRedirectNow not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1041,6 +1300,42 @@ func _Msg_ReportBurn_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetEmergencyBurnOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEmergencyBurnOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEmergencyBurnOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Msg/SetEmergencyBurnOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEmergencyBurnOverride(ctx, req.(*MsgSetEmergencyBurnOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteRedirectNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteRedirectNow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteRedirectNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pos.tokenomics.v1.Msg/ExecuteRedirectNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteRedirectNow(ctx, req.(*MsgExecuteRedirectNow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pos.tokenomics.v1.Msg",
@@ -1066,6 +1361,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportBurn",
 			Handler:    _Msg_ReportBurn_Handler,
 		},
+		{
+			MethodName: "SetEmergencyBurnOverride",
+			Handler:    _Msg_SetEmergencyBurnOverride_Handler,
+		},
+		{
+			MethodName: "ExecuteRedirectNow",
+			Handler:    _Msg_ExecuteRedirectNow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pos/tokenomics/v1/tx.proto",
@@ -1386,6 +1689,13 @@ func (m *RewardRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.IbcChannel) > 0 {
 		i -= len(m.IbcChannel)
 		copy(dAtA[i:], m.IbcChannel)
@@ -1651,70 +1961,221 @@ func (m *MsgReportBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetEmergencyBurnOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetEmergencyBurnOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmergencyBurnOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetEmergencyBurnOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMintTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetEmergencyBurnOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmergencyBurnOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteRedirectNow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRedirectNow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRedirectNow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteRedirectNowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteRedirectNowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteRedirectNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CarriedOver.Size()
+		i -= size
+		if _, err := m.CarriedOver.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RedirectedAmount.Size()
+		i -= size
+		if _, err := m.RedirectedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.NewTotalSupply.Size()
 	n += 1 + l + sovTx(uint64(l))
 	l = m.NewTotalMinted.Size()
@@ -1785,6 +2246,10 @@ func (m *RewardRecipient) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1877,6 +2342,62 @@ func (m *MsgReportBurnResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetEmergencyBurnOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetEmergencyBurnOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *MsgExecuteRedirectNow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteRedirectNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedirectedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CarriedOver.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2926,6 +3447,38 @@ func (m *RewardRecipient) Unmarshal(dAtA []byte) error {
 			}
 			m.IbcChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3643,6 +4196,411 @@ func (m *MsgReportBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetEmergencyBurnOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmergencyBurnOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmergencyBurnOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEmergencyBurnOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmergencyBurnOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmergencyBurnOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteRedirectNow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteRedirectNow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteRedirectNow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteRedirectNowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteRedirectNowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteRedirectNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedirectedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedOver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CarriedOver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// accountSyncRetryInterval is the delay between failed startup sync attempts
+var accountSyncRetryInterval = 5 * time.Second
+
+// syncAccount queries the faucet account's number and sequence over gRPC and
+// caches them. The cache is written before the faucet is marked ready so
+// /readyz never reports ready with a stale or missing sequence.
+func (f *FaucetService) syncAccount(ctx context.Context) error {
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := f.authQuery.AccountInfo(queryCtx, &authtypes.QueryAccountInfoRequest{
+		Address: f.faucetAddr.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("account query failed: %w", err)
+	}
+	if resp.Info == nil {
+		return fmt.Errorf("account %s not found on chain", f.faucetAddr)
+	}
+
+	f.seqMu.Lock()
+	f.accountNumber = resp.Info.AccountNumber
+	f.sequence = resp.Info.Sequence
+	f.seqMu.Unlock()
+
+	f.ready.Store(true)
+
+	f.logger.Info("Synced faucet account",
+		"event", logEventAccountSync,
+		"account_number", resp.Info.AccountNumber,
+		"sequence", resp.Info.Sequence,
+	)
+	return nil
+}
+
+// startAccountSync retries syncAccount in the background until it succeeds
+// or ctx is cancelled. The faucet stays not-ready until the first success.
+func (f *FaucetService) startAccountSync(ctx context.Context) {
+	if f.authQuery == nil {
+		return
+	}
+
+	go func() {
+		for {
+			err := f.syncAccount(ctx)
+			if err == nil {
+				return
+			}
+			f.logger.Warn("Account sync failed, retrying",
+				"event", logEventAccountSync, "retry_in", accountSyncRetryInterval.String(), "error", err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(accountSyncRetryInterval):
+			}
+		}
+	}()
+}
+
+// resyncAccount refreshes the cached sequence after a failed broadcast, since
+// the most common cause is a sequence mismatch. Readiness is not revoked. The
+// sync holds broadcastMu so no broadcast uses or advances the sequence between
+// the query and the cache write. Callers must not hold broadcastMu.
+func (f *FaucetService) resyncAccount() {
+	if f.authQuery == nil {
+		return
+	}
+
+	go func() {
+		f.broadcastMu.Lock()
+		defer f.broadcastMu.Unlock()
+		if err := f.syncAccount(context.Background()); err != nil {
+			f.logger.Warn("Account re-sync after broadcast error failed",
+				"event", logEventAccountSync, "error", err)
+		}
+	}()
+}
+
+// isReady reports whether the account number and sequence have been synced
+func (f *FaucetService) isReady() bool {
+	return f.ready.Load()
+}
+
+// accountSequence returns the cached account number and sequence
+func (f *FaucetService) accountSequence() (uint64, uint64) {
+	f.seqMu.Lock()
+	defer f.seqMu.Unlock()
+	return f.accountNumber, f.sequence
+}
+
+// incrementSequence advances the cached sequence after a successful broadcast
+func (f *FaucetService) incrementSequence() {
+	f.seqMu.Lock()
+	f.sequence++
+	f.seqMu.Unlock()
+}
+
+// Handle readiness probe
+func (f *FaucetService) handleReady(w http.ResponseWriter, r *http.Request) {
+	if !f.isReady() {
+		http.Error(w, "account sequence not synced", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
@@ -0,0 +1,26 @@
+package main
+
+import "time"
+
+// Clock supplies the current time to the faucet's rate limits, signature
+// expiry and GitHub cache, so tests can drive them without sleeping
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock reads the system clock
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+// nextDailyReset returns the first reset boundary strictly after now: the next
+// occurrence of resetHour:00 UTC. The result does not depend on the server's
+// local time zone.
+func nextDailyReset(now time.Time, resetHour int64) time.Time {
+	now = now.UTC()
+	reset := time.Date(now.Year(), now.Month(), now.Day(), int(resetHour), 0, 0, 0, time.UTC)
+	if !reset.After(now) {
+		reset = reset.AddDate(0, 0, 1)
+	}
+	return reset
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// DenomConfig describes one token the faucet hands out.
+// Each denom has its own amount, daily cap and per-address cooldown.
+type DenomConfig struct {
+	Denom           string `json:"denom"`
+	Amount          int64  `json:"amount"`    // in base units
+	DailyCap        int64  `json:"daily_cap"` // max distributions of this denom per day
+	CooldownSeconds int64  `json:"cooldown"`  // per-address cooldown for this denom
+}
+
+// DenomStats reports per-denom distribution settings and usage for /stats
+type DenomStats struct {
+	Denom            string `json:"denom"`
+	Amount           string `json:"amount"`
+	DailyCap         int64  `json:"daily_cap"`
+	DistributedToday int64  `json:"distributed_today"`
+	CooldownSeconds  int64  `json:"cooldown_seconds"`
+}
+
+// denomConfigs returns the configured denoms. When FAUCET_DENOMS is not set
+// the legacy single-denom settings are used, so existing deployments keep
+// their behaviour unchanged.
+func (c *Config) denomConfigs() []DenomConfig {
+	if len(c.Denoms) > 0 {
+		return c.Denoms
+	}
+	return []DenomConfig{{
+		Denom:           c.Denom,
+		Amount:          c.DistributionAmount,
+		DailyCap:        c.DailyCap,
+		CooldownSeconds: c.CooldownSeconds,
+	}}
+}
+
+// parseDenomConfigs decodes the FAUCET_DENOMS JSON list and validates it
+func parseDenomConfigs(raw string) ([]DenomConfig, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var denoms []DenomConfig
+	if err := json.Unmarshal([]byte(raw), &denoms); err != nil {
+		return nil, fmt.Errorf("invalid FAUCET_DENOMS: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, d := range denoms {
+		if d.Denom == "" {
+			return nil, fmt.Errorf("invalid FAUCET_DENOMS: denom cannot be empty")
+		}
+		if seen[d.Denom] {
+			return nil, fmt.Errorf("invalid FAUCET_DENOMS: duplicate denom %s", d.Denom)
+		}
+		seen[d.Denom] = true
+		if d.Amount <= 0 {
+			return nil, fmt.Errorf("invalid FAUCET_DENOMS: %s amount must be positive", d.Denom)
+		}
+		if d.DailyCap < 0 || d.CooldownSeconds < 0 {
+			return nil, fmt.Errorf("invalid FAUCET_DENOMS: %s daily_cap and cooldown cannot be negative", d.Denom)
+		}
+	}
+	return denoms, nil
+}
+
+// resolveDenoms maps the denoms named in a request onto their configuration.
+// An empty request selects every configured denom.
+func (f *FaucetService) resolveDenoms(requested []string) ([]DenomConfig, error) {
+	configured := f.config.denomConfigs()
+	if len(requested) == 0 {
+		return configured, nil
+	}
+
+	byDenom := make(map[string]DenomConfig, len(configured))
+	for _, d := range configured {
+		byDenom[d.Denom] = d
+	}
+
+	var selected []DenomConfig
+	seen := make(map[string]bool)
+	for _, name := range requested {
+		d, ok := byDenom[name]
+		if !ok {
+			return nil, fmt.Errorf("denom %s is not available from this faucet", name)
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		selected = append(selected, d)
+	}
+	return selected, nil
+}
+
+// formatCoin renders an amount for display. Micro-denoms ("u" prefix) are
+// shown in whole units, e.g. 10000000000uomni as "10000 OMNI".
+func formatCoin(denom string, amount int64) string {
+	if len(denom) > 1 && strings.HasPrefix(denom, "u") {
+		return formatAmount(amount) + " " + strings.ToUpper(denom[1:])
+	}
+	return fmt.Sprintf("%d %s", amount, denom)
+}
+
+// formatCoins renders a list of denom amounts, comma separated
+func formatCoins(denoms []DenomConfig) string {
+	parts := make([]string, 0, len(denoms))
+	for _, d := range denoms {
+		parts = append(parts, formatCoin(d.Denom, d.Amount))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// cooldownSummary describes per-denom cooldowns for the home page
+func cooldownSummary(denoms []DenomConfig) string {
+	parts := make([]string, 0, len(denoms))
+	for _, d := range denoms {
+		hours := d.CooldownSeconds / 3600
+		if len(denoms) == 1 {
+			return fmt.Sprintf("%d hours between requests", hours)
+		}
+		parts = append(parts, fmt.Sprintf("%s %d hours", html.EscapeString(d.Denom), hours))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// denomSelector renders a checkbox per denom so users can request a subset.
+// Omitted for single-denom faucets.
+func denomSelector(denoms []DenomConfig) string {
+	if len(denoms) < 2 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(`<div class="denoms">`)
+	for _, d := range denoms {
+		fmt.Fprintf(&b, `<label><input type="checkbox" name="denom" value="%s" checked />%s</label>`,
+			html.EscapeString(d.Denom), html.EscapeString(formatCoin(d.Denom, d.Amount)))
+	}
+	b.WriteString(`</div>`)
+	return b.String()
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
+)
+
+// Broadcaster signs msg with txf, which carries the account number, sequence
+// and gas limit, broadcasts it and returns the tx hash. Swapped out in tests.
+type Broadcaster func(txf tx.Factory, msg *banktypes.MsgSend) (string, error)
+
+// SpendableFetcher returns every coin the faucet account can currently spend.
+// Swapped out in tests so /admin/drain can run without a live node.
+type SpendableFetcher func(ctx context.Context) (sdk.Coins, error)
+
+// newGRPCSpendableFetcher pages through the bank module's spendable balances
+// for the faucet account
+func newGRPCSpendableFetcher(conn *grpc.ClientConn, address string) SpendableFetcher {
+	client := banktypes.NewQueryClient(conn)
+	return func(ctx context.Context) (sdk.Coins, error) {
+		var (
+			coins sdk.Coins
+			key   []byte
+		)
+		for {
+			resp, err := client.SpendableBalances(ctx, &banktypes.QuerySpendableBalancesRequest{
+				Address:    address,
+				Pagination: &query.PageRequest{Key: key},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("spendable balance query failed: %w", err)
+			}
+			coins = coins.Add(resp.Balances...)
+			if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+				return coins, nil
+			}
+			key = resp.Pagination.NextKey
+		}
+	}
+}
+
+// isAdmin reports whether the request carries the configured admin token as
+// "Authorization: Bearer <token>". Always false when no token is configured.
+func (f *FaucetService) isAdmin(r *http.Request) bool {
+	if f.config.AdminToken == "" {
+		return false
+	}
+	header := strings.TrimSpace(r.Header.Get("Authorization"))
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(f.config.AdminToken)) == 1
+}
+
+// Handle /admin/drain: sweep the faucet's entire spendable balance to the
+// recovery address when decommissioning. Distributions stay paused afterwards,
+// even if the account is refilled, until an admin calls /admin/resume.
+func (f *FaucetService) handleDrain(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	reject := func(status int, msg string) {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: false,
+			Error:   msg,
+		})
+	}
+
+	if r.Method != http.MethodPost {
+		reject(http.StatusMethodNotAllowed, "Method not allowed. Use POST.")
+		return
+	}
+
+	if !f.isAdmin(r) {
+		f.logger.Warn("Rejected unauthorized drain request", "event", logEventDrain, "remote_addr", r.RemoteAddr)
+		reject(http.StatusUnauthorized, "Admin authentication required. Send an Authorization: Bearer <ADMIN_TOKEN> header.")
+		return
+	}
+
+	if !isValidAddress(f.config.RecoveryAddress, f.config.Bech32Prefix) {
+		reject(http.StatusInternalServerError, "RECOVERY_ADDRESS is not configured or invalid")
+		return
+	}
+
+	if f.spendableFetcher == nil {
+		reject(http.StatusServiceUnavailable, "Balance query unavailable")
+		return
+	}
+
+	queryCtx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	balance, err := f.spendableFetcher(queryCtx)
+	if err != nil {
+		f.logger.Error("Drain balance query failed", "event", logEventDrain, "error", err)
+		reject(http.StatusBadGateway, "Failed to query faucet balance")
+		return
+	}
+	if balance.IsZero() {
+		reject(http.StatusConflict, "Faucet balance is empty; nothing to drain")
+		return
+	}
+
+	// Stop accepting new requests before the sweep
+	wasDrained := f.drained.Swap(true)
+
+	f.broadcastMu.Lock()
+	amount, gas, err := f.drainAmount(balance)
+	if err != nil {
+		f.broadcastMu.Unlock()
+		f.drained.Store(wasDrained)
+		reject(http.StatusConflict, err.Error())
+		return
+	}
+	txHash, err := f.sendCoinsWithGas(f.config.RecoveryAddress, amount, gas)
+	f.broadcastMu.Unlock()
+
+	if err != nil {
+		f.logger.Error("Failed to drain faucet",
+			"event", logEventDrain,
+			"address", f.config.RecoveryAddress,
+			"amount", amount.String(),
+			"error", err,
+		)
+		f.drained.Store(wasDrained)
+		f.resyncAccount()
+		reject(http.StatusBadGateway, "Failed to send drain transaction")
+		return
+	}
+
+	f.logger.Warn("Drained faucet balance",
+		"event", logEventDrain,
+		"address", f.config.RecoveryAddress,
+		"amount", amount.String(),
+		"tx_hash", txHash,
+	)
+
+	json.NewEncoder(w).Encode(DistributionResponse{
+		Success: true,
+		TxHash:  txHash,
+		Amount:  amount.String(),
+		Message: "Faucet balance sent to the recovery address. Distributions are paused.",
+	})
+}
+
+// Handle /admin/resume: lift the pause left by /admin/drain. The balance
+// monitor still pauses distributions while the balance is below MinBalance.
+func (f *FaucetService) handleResume(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	reject := func(status int, msg string) {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: false,
+			Error:   msg,
+		})
+	}
+
+	if r.Method != http.MethodPost {
+		reject(http.StatusMethodNotAllowed, "Method not allowed. Use POST.")
+		return
+	}
+
+	if !f.isAdmin(r) {
+		f.logger.Warn("Rejected unauthorized resume request", "event", logEventDrain, "remote_addr", r.RemoteAddr)
+		reject(http.StatusUnauthorized, "Admin authentication required. Send an Authorization: Bearer <ADMIN_TOKEN> header.")
+		return
+	}
+
+	if !f.drained.Swap(false) {
+		reject(http.StatusConflict, "Faucet has not been drained; nothing to resume")
+		return
+	}
+
+	f.logger.Warn("Resumed drained faucet", "event", logEventDrain, "paused", f.isPaused())
+
+	json.NewEncoder(w).Encode(DistributionResponse{
+		Success: true,
+		Message: "Drain pause lifted. Distributions resume once the balance is above the minimum.",
+	})
+}
+
+// drainAmount returns what is left of balance once the fee for sweeping it is
+// paid, and the gas limit that fee was priced at. The sweep is sent with that
+// same limit so the fee charged matches the one deducted. Callers hold
+// broadcastMu.
+func (f *FaucetService) drainAmount(balance sdk.Coins) (sdk.Coins, uint64, error) {
+	recipient, err := sdk.AccAddressFromBech32(f.config.RecoveryAddress)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid address: %w", err)
+	}
+	msg := banktypes.NewMsgSend(f.faucetAddr, recipient, balance)
+
+	accountNumber, sequence := f.accountSequence()
+	txf := f.withGas(f.txFactory.WithAccountNumber(accountNumber).WithSequence(sequence), msg)
+	fee := txFee(txf)
+	amount, negative := balance.SafeSub(fee...)
+	if negative || amount.IsZero() {
+		return nil, 0, fmt.Errorf("faucet balance %s does not cover the %s drain fee", balance, fee)
+	}
+	return amount, txf.Gas(), nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Gas modes accepted by GAS_MODE
+const (
+	// GasModeFixed uses FIXED_GAS for every transaction
+	GasModeFixed = "fixed"
+	// GasModeSimulate simulates each transaction over gRPC and applies the
+	// factory's gas adjustment to the gas used
+	GasModeSimulate = "simulate"
+)
+
+// defaultFixedGas is the gas limit used in fixed mode and as the fallback
+// when simulation fails
+const defaultFixedGas = 200000
+
+// newTxConfig returns the protobuf tx config used to encode faucet transactions
+func newTxConfig() client.TxConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+}
+
+// fixedGas returns the configured fixed gas limit
+func (f *FaucetService) fixedGas() uint64 {
+	if f.config.FixedGas <= 0 {
+		return defaultFixedGas
+	}
+	return uint64(f.config.FixedGas)
+}
+
+// txFee returns the fee txf pays: its gas prices times its gas limit, rounded
+// up per denom the same way the tx factory prices the fee when signing
+func txFee(txf tx.Factory) sdk.Coins {
+	var fee sdk.Coins
+	for _, gp := range txf.GasPrices() {
+		fee = fee.Add(sdk.NewCoin(gp.Denom, gp.Amount.MulInt64(int64(txf.Gas())).Ceil().RoundInt()))
+	}
+	return fee
+}
+
+// withGas sets the gas limit for msg on txf. In simulate mode the limit is the
+// simulated gas used times the gas adjustment; if simulation fails the fixed
+// limit is kept so distributions are not blocked by the simulate endpoint.
+func (f *FaucetService) withGas(txf tx.Factory, msg sdk.Msg) tx.Factory {
+	txf = txf.WithGas(f.fixedGas())
+	if f.config.GasMode != GasModeSimulate || f.simConn == nil {
+		return txf
+	}
+
+	_, adjusted, err := tx.CalculateGas(f.simConn, txf, msg)
+	if err != nil {
+		f.logger.Warn("Gas simulation failed, using fixed gas",
+			"event", logEventGasEstimate, "gas", txf.Gas(), "error", err)
+		return txf
+	}
+	return txf.WithGas(adjusted)
+}
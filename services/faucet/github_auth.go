@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// githubCacheTTL is how long a GitHub account verification is reused
+const githubCacheTTL = 24 * time.Hour
+
+var (
+	errGitHubTokenMissing = errors.New("GitHub authentication required. Send an Authorization: Bearer <token> header.")
+	errGitHubTokenInvalid = errors.New("GitHub token is invalid or expired")
+)
+
+// githubUser is the subset of the GitHub /user response the faucet needs
+type githubUser struct {
+	ID        int64     `json:"id"`
+	Login     string    `json:"login"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// githubVerification is a cached GitHub account lookup
+type githubVerification struct {
+	user       githubUser
+	verifiedAt time.Time
+}
+
+// githubToken extracts the token from an "Authorization: Bearer <token>" or
+// "Authorization: token <token>" header
+func githubToken(r *http.Request) string {
+	header := strings.TrimSpace(r.Header.Get("Authorization"))
+	for _, scheme := range []string{"Bearer ", "bearer ", "token "} {
+		if strings.HasPrefix(header, scheme) {
+			return strings.TrimSpace(header[len(scheme):])
+		}
+	}
+	return ""
+}
+
+// verifyGitHubAccount checks that the request carries a GitHub token for an
+// account at least GitHubMinAccountAgeDays old. Lookups are cached per GitHub
+// user ID (and token → user ID) so repeat requests do not spend API quota.
+func (f *FaucetService) verifyGitHubAccount(ctx context.Context, r *http.Request) (githubUser, error) {
+	token := githubToken(r)
+	if token == "" {
+		return githubUser{}, errGitHubTokenMissing
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	tokenHash := hex.EncodeToString(sum[:])
+
+	user, ok := f.cachedGitHubUser(tokenHash)
+	if !ok {
+		var err error
+		user, err = f.fetchGitHubUser(ctx, token)
+		if err != nil {
+			return githubUser{}, err
+		}
+		f.cacheGitHubUser(tokenHash, user)
+	}
+
+	minAge := time.Duration(f.config.GitHubMinAccountAgeDays) * 24 * time.Hour
+	if age := f.clock.Now().Sub(user.CreatedAt); age < minAge {
+		return user, fmt.Errorf("GitHub account %s is too new. Accounts must be at least %d days old.",
+			user.Login, f.config.GitHubMinAccountAgeDays)
+	}
+	return user, nil
+}
+
+// fetchGitHubUser calls the GitHub API to resolve the token's account
+func (f *FaucetService) fetchGitHubUser(ctx context.Context, token string) (githubUser, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(f.config.GitHubAPIURL, "/")+"/user", nil)
+	if err != nil {
+		return githubUser{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return githubUser{}, fmt.Errorf("GitHub API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return githubUser{}, errGitHubTokenInvalid
+	}
+	if resp.StatusCode != http.StatusOK {
+		return githubUser{}, fmt.Errorf("GitHub API returned %s", resp.Status)
+	}
+
+	var user githubUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return githubUser{}, fmt.Errorf("invalid GitHub API response: %w", err)
+	}
+	if user.ID == 0 || user.CreatedAt.IsZero() {
+		return githubUser{}, fmt.Errorf("GitHub API response missing account id or creation date")
+	}
+	return user, nil
+}
+
+// cachedGitHubUser returns a fresh cached lookup for the token, if any
+func (f *FaucetService) cachedGitHubUser(tokenHash string) (githubUser, bool) {
+	f.githubMu.Lock()
+	defer f.githubMu.Unlock()
+
+	id, ok := f.githubTokens[tokenHash]
+	if !ok {
+		return githubUser{}, false
+	}
+	v, ok := f.githubUsers[id]
+	if !ok || f.clock.Now().Sub(v.verifiedAt) > githubCacheTTL {
+		return githubUser{}, false
+	}
+	return v.user, true
+}
+
+// cacheGitHubUser stores a lookup keyed by GitHub user ID, dropping expired entries
+func (f *FaucetService) cacheGitHubUser(tokenHash string, user githubUser) {
+	f.githubMu.Lock()
+	defer f.githubMu.Unlock()
+
+	now := f.clock.Now()
+	for id, v := range f.githubUsers {
+		if now.Sub(v.verifiedAt) > githubCacheTTL {
+			delete(f.githubUsers, id)
+		}
+	}
+	for hash, id := range f.githubTokens {
+		if _, ok := f.githubUsers[id]; !ok {
+			delete(f.githubTokens, hash)
+		}
+	}
+
+	f.githubUsers[user.ID] = githubVerification{user: user, verifiedAt: now}
+	f.githubTokens[tokenHash] = user.ID
+}
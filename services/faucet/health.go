@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// nodeHealthTTL is how long a node connectivity result is reused, so frequent
+// health probes do not each hit the node
+const nodeHealthTTL = 5 * time.Second
+
+// nodeCheckTimeout bounds a single node connectivity check
+const nodeCheckTimeout = 3 * time.Second
+
+// NodeChecker returns nil if the chain node is reachable.
+// Swapped out in tests so /health can run without a live node.
+type NodeChecker func(ctx context.Context) error
+
+// newRPCNodeChecker queries the node's RPC /status endpoint
+func newRPCNodeChecker(endpoint string) NodeChecker {
+	url := strings.TrimSuffix(endpoint, "/") + "/status"
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("node status request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("node status returned %d", resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+// checkNode returns the node connectivity error, reusing the last result for
+// nodeHealthTTL. Always nil when no checker is configured.
+func (f *FaucetService) checkNode(ctx context.Context) error {
+	if f.nodeChecker == nil {
+		return nil
+	}
+
+	f.nodeMu.Lock()
+	defer f.nodeMu.Unlock()
+
+	now := f.clock.Now()
+	if !f.nodeCheckedAt.IsZero() && now.Sub(f.nodeCheckedAt) < nodeHealthTTL {
+		return f.nodeErr
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, nodeCheckTimeout)
+	defer cancel()
+	f.nodeErr = f.nodeChecker(checkCtx)
+	f.nodeCheckedAt = now
+	return f.nodeErr
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// Log formats accepted by LOG_FORMAT
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+// Log event names, carried in the "event" field so aggregators can filter on them
+const (
+	logEventStartup            = "startup"
+	logEventShutdown           = "shutdown"
+	logEventServerError        = "server_error"
+	logEventDistribution       = "distribution"
+	logEventDistributionFailed = "distribution_failed"
+	logEventBroadcast          = "broadcast"
+	logEventRateLimited        = "rate_limited"
+	logEventAccountSync        = "account_sync"
+	logEventBalanceCheck       = "balance_check"
+	logEventWebhook            = "low_balance_webhook"
+	logEventDrain              = "drain"
+	logEventGasEstimate        = "gas_estimate"
+)
+
+// newLogger returns the faucet logger for format. Text keeps the standard log
+// package's line format; JSON writes one object per line to w.
+func newLogger(w io.Writer, format string) (*slog.Logger, error) {
+	switch format {
+	case "", LogFormatText:
+		return slog.Default(), nil
+	case LogFormatJSON:
+		return slog.New(slog.NewJSONHandler(w, nil)), nil
+	default:
+		return nil, fmt.Errorf("LOG_FORMAT must be %q or %q, got %q", LogFormatText, LogFormatJSON, format)
+	}
+}
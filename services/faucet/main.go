@@ -5,14 +5,16 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
-	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -21,10 +23,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
-	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
 )
 
 // Config holds the faucet configuration
@@ -48,8 +52,57 @@ type Config struct {
 	CooldownSeconds int64 `json:"cooldown_seconds"` // per-address cooldown
 	DailyCap        int64 `json:"daily_cap"`        // max distributions per day
 
+	// Hour of the day (UTC, 0-23) at which daily caps reset
+	DailyResetHourUTC int64 `json:"daily_reset_hour_utc"`
+
+	// Tiered amounts: each prior successful request adds TierStepPercent of
+	// the base amount, up to TierMaxPercent (see tiers.go)
+	TieredAmounts   bool  `json:"tiered_amounts"`
+	TierStepPercent int64 `json:"tier_step_percent"`
+	TierMaxPercent  int64 `json:"tier_max_percent"`
+	TierHistoryDays int64 `json:"tier_history_days"` // forget an address's history this long after its last success
+
+	// Multi-denom distribution; when empty, Denom/DistributionAmount/DailyCap/
+	// CooldownSeconds above describe the single distributed token
+	Denoms []DenomConfig `json:"denoms"`
+
+	// Balance monitoring
+	MinBalance           int64  `json:"min_balance"`             // pause distributions below this balance (0 = disabled)
+	BalancePollSeconds   int64  `json:"balance_poll_seconds"`    // how often to check the faucet balance
+	LowBalanceWebhookURL string `json:"low_balance_webhook_url"` // optional webhook notified on pause/resume
+
+	// Request queue
+	QueueSize             int64 `json:"queue_size"`              // max pending distribution jobs
+	WorkerCount           int64 `json:"worker_count"`            // worker goroutines draining the queue
+	RequestTimeoutSeconds int64 `json:"request_timeout_seconds"` // how long synchronous requests wait for a result
+
+	// GitHub gating: require a GitHub token for an account of minimum age
+	RequireGitHubAuth       bool   `json:"require_github_auth"`
+	GitHubMinAccountAgeDays int64  `json:"github_min_account_age_days"`
+	GitHubAPIURL            string `json:"github_api_url"`
+
+	// Signed requests: require proof of address ownership bound to this faucet
+	RequireSignedRequests       bool   `json:"require_signed_requests"`
+	SignatureMaxValiditySeconds int64  `json:"signature_max_validity_seconds"` // max time between now and expires_at
+	NonceStorePath              string `json:"nonce_store_path"`               // consumed nonces file ("" = memory only)
+
 	// CORS
 	AllowedOrigins []string `json:"allowed_origins"`
+
+	// Gas: "fixed" uses FixedGas; "simulate" estimates each transaction over
+	// gRPC and falls back to FixedGas if simulation fails. Fees are the gas
+	// limit times GasPrices.
+	GasMode   string `json:"gas_mode"`
+	FixedGas  int64  `json:"fixed_gas"`
+	GasPrices string `json:"gas_prices"`
+
+	// Logging: "text" (default) or "json" for structured output
+	LogFormat string `json:"log_format"`
+
+	// Admin: bearer token for /admin endpoints ("" = disabled) and the address
+	// /admin/drain sweeps the faucet balance to
+	AdminToken      string `json:"-"`
+	RecoveryAddress string `json:"recovery_address"`
 }
 
 // FaucetService manages token distribution
@@ -58,17 +111,61 @@ type FaucetService struct {
 	clientCtx   client.Context
 	txFactory   tx.Factory
 	faucetAddr  sdk.AccAddress
+	logger      *slog.Logger
 
-	// Rate limiting state
+	// Rate limiting state, tracked per denom
 	mu             sync.RWMutex
-	addressCooldowns map[string]time.Time
-	dailyCount     int64
+	denomCooldowns map[string]map[string]time.Time // denom -> address -> cooldown end
+	dailyCounts    map[string]int64                // denom -> distributions today
+	tierHistory    map[string]tierRecord           // address -> successful requests, for tiered amounts
 	dailyResetTime time.Time
+	clock          Clock
+
+	// Balance monitoring state
+	grpcConn         *grpc.ClientConn
+	simConn          gogogrpc.ClientConn // tx service for gas simulation
+	balanceFetcher   BalanceFetcher
+	spendableFetcher SpendableFetcher
+	paused         atomic.Bool // low balance; managed by the balance monitor
+	drained        atomic.Bool // set by /admin/drain, cleared only by /admin/resume
+	lastBalance    atomic.Int64
+
+	// Node connectivity for /health, cached for nodeHealthTTL
+	nodeChecker   NodeChecker
+	nodeMu        sync.Mutex
+	nodeCheckedAt time.Time
+	nodeErr       error
+
+	// Distribution queue; broadcastMu serializes signing with the faucet key
+	jobQueue    chan *distributionJob
+	jobsMu      sync.RWMutex
+	jobs        map[string]*JobStatus
+	broadcastMu sync.Mutex
+	broadcaster Broadcaster // nil uses placeholderBroadcast
+
+	// Account sequence cache, synced from chain at startup
+	authQuery     authtypes.QueryClient
+	seqMu         sync.Mutex
+	accountNumber uint64
+	sequence      uint64
+	ready         atomic.Bool
+
+	// GitHub verification cache: token hash -> user ID -> lookup
+	githubMu     sync.Mutex
+	githubTokens map[string]int64
+	githubUsers  map[int64]githubVerification
+
+	// Consumed signed-request nonces
+	nonces *nonceStore
 }
 
 // DistributionRequest represents a faucet request
 type DistributionRequest struct {
-	Address string `json:"address"`
+	Address string   `json:"address"`
+	Denoms  []string `json:"denoms,omitempty"` // optional subset; all configured denoms when empty
+
+	// Signature proves ownership of Address; required when RequireSignedRequests is set
+	Signature *RequestSignature `json:"signature,omitempty"`
 }
 
 // DistributionResponse represents a faucet response
@@ -78,6 +175,7 @@ type DistributionResponse struct {
 	Amount  string `json:"amount,omitempty"`
 	Message string `json:"message,omitempty"`
 	Error   string `json:"error,omitempty"`
+	JobID   string `json:"job_id,omitempty"`
 }
 
 // HealthResponse for health check endpoint
@@ -86,6 +184,8 @@ type HealthResponse struct {
 	FaucetAddress string `json:"faucet_address"`
 	ChainID       string `json:"chain_id"`
 	DailyRemaining int64  `json:"daily_remaining"`
+	Paused         bool   `json:"paused"`
+	Error          string `json:"error,omitempty"` // node connectivity error when unhealthy
 }
 
 // StatsResponse for statistics endpoint
@@ -94,6 +194,7 @@ type StatsResponse struct {
 	DailyCap         int64  `json:"daily_cap"`
 	CooldownSeconds  int64  `json:"cooldown_seconds"`
 	DistributionAmount string `json:"distribution_amount"`
+	Denoms             []DenomStats `json:"denoms"`
 }
 
 func main() {
@@ -119,8 +220,23 @@ func main() {
 	// Endpoints
 	mux.HandleFunc("/", faucet.handleHome)
 	mux.HandleFunc("/health", faucet.handleHealth)
+	mux.HandleFunc("/readyz", faucet.handleReady)
 	mux.HandleFunc("/stats", faucet.handleStats)
 	mux.HandleFunc("/faucet", faucet.handleFaucet)
+	mux.HandleFunc("/status/", faucet.handleStatus)
+	mux.HandleFunc("/admin/drain", faucet.handleDrain)
+	mux.HandleFunc("/admin/resume", faucet.handleResume)
+
+	// Background balance monitor
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	defer stopMonitor()
+	faucet.startBalanceMonitor(monitorCtx)
+
+	// Sync account number/sequence before reporting ready
+	faucet.startAccountSync(monitorCtx)
+
+	// Distribution workers
+	faucet.startWorkers(monitorCtx)
 
 	// Wrap with CORS middleware
 	handler := faucet.corsMiddleware(mux)
@@ -139,18 +255,23 @@ func main() {
 		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 		<-sigChan
 
-		log.Println("Shutting down faucet service...")
+		faucet.logger.Info("Shutting down faucet service", "event", logEventShutdown)
+		stopMonitor()
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 		server.Shutdown(ctx)
 	}()
 
-	log.Printf("Omniphi Faucet starting on %s:%s", config.Host, config.Port)
-	log.Printf("Faucet address: %s", faucet.faucetAddr.String())
-	log.Printf("Distribution amount: %d %s", config.DistributionAmount, config.Denom)
+	faucet.logger.Info("Omniphi Faucet starting",
+		"event", logEventStartup,
+		"listen", server.Addr,
+		"address", faucet.faucetAddr.String(),
+		"amount", formatCoins(config.denomConfigs()),
+	)
 
 	if err := server.ListenAndServe(); err != http.ErrServerClosed {
-		log.Fatalf("Server error: %v", err)
+		faucet.logger.Error("Server error", "event", logEventServerError, "error", err)
+		os.Exit(1)
 	}
 }
 
@@ -168,13 +289,61 @@ func loadConfig() *Config {
 		DistributionAmount: getEnvInt64("DISTRIBUTION_AMOUNT", 10000000000), // 10,000 OMNI
 		CooldownSeconds:   getEnvInt64("COOLDOWN_SECONDS", 86400), // 24 hours
 		DailyCap:          getEnvInt64("DAILY_CAP", 1000), // 1000 distributions per day
+		DailyResetHourUTC: getEnvInt64("DAILY_RESET_HOUR_UTC", 0), // midnight UTC
+		MinBalance:           getEnvInt64("MIN_BALANCE", 0), // disabled by default
+		BalancePollSeconds:   getEnvInt64("BALANCE_POLL_SECONDS", 60),
+		LowBalanceWebhookURL: getEnv("LOW_BALANCE_WEBHOOK_URL", ""),
+		QueueSize:             getEnvInt64("QUEUE_SIZE", 100),
+		WorkerCount:           getEnvInt64("WORKER_COUNT", 2),
+		RequestTimeoutSeconds: getEnvInt64("REQUEST_TIMEOUT_SECONDS", 10),
+		RequireGitHubAuth:       getEnv("REQUIRE_GITHUB_AUTH", "false") == "true",
+		GitHubMinAccountAgeDays: getEnvInt64("GITHUB_MIN_ACCOUNT_AGE_DAYS", 30),
+		GitHubAPIURL:            getEnv("GITHUB_API_URL", "https://api.github.com"),
+		RequireSignedRequests:       getEnv("REQUIRE_SIGNED_REQUESTS", "false") == "true",
+		SignatureMaxValiditySeconds: getEnvInt64("SIGNATURE_MAX_VALIDITY_SECONDS", 600),
+		NonceStorePath:              getEnv("NONCE_STORE_PATH", "faucet-nonces.json"),
 		AllowedOrigins:    strings.Split(getEnv("ALLOWED_ORIGINS", "*"), ","),
+		LogFormat:         getEnv("LOG_FORMAT", LogFormatText),
+		TieredAmounts:     getEnv("TIERED_AMOUNTS", "false") == "true",
+		TierStepPercent:   getEnvInt64("TIER_STEP_PERCENT", 50),
+		TierMaxPercent:    getEnvInt64("TIER_MAX_PERCENT", 300),
+		TierHistoryDays:   getEnvInt64("TIER_HISTORY_DAYS", 30),
+		GasMode:           getEnv("GAS_MODE", GasModeFixed),
+		FixedGas:          getEnvInt64("FIXED_GAS", defaultFixedGas),
+		GasPrices:         getEnv("GAS_PRICES", "0.025uomni"),
+		AdminToken:        getEnv("ADMIN_TOKEN", ""),
+		RecoveryAddress:   getEnv("RECOVERY_ADDRESS", ""),
 	}
 
 	if config.FaucetMnemonic == "" {
 		log.Fatal("FAUCET_MNEMONIC environment variable is required")
 	}
 
+	if config.DailyResetHourUTC < 0 || config.DailyResetHourUTC > 23 {
+		log.Fatalf("DAILY_RESET_HOUR_UTC must be between 0 and 23, got %d", config.DailyResetHourUTC)
+	}
+
+	if config.TieredAmounts && config.TierHistoryDays < 1 {
+		log.Fatalf("TIER_HISTORY_DAYS must be at least 1, got %d", config.TierHistoryDays)
+	}
+
+	if config.GasMode != GasModeFixed && config.GasMode != GasModeSimulate {
+		log.Fatalf("GAS_MODE must be %q or %q, got %q", GasModeFixed, GasModeSimulate, config.GasMode)
+	}
+
+	if _, err := sdk.ParseDecCoins(config.GasPrices); err != nil {
+		log.Fatalf("GAS_PRICES is invalid: %v", err)
+	}
+	if config.FixedGas <= 0 {
+		log.Fatalf("FIXED_GAS must be positive, got %d", config.FixedGas)
+	}
+
+	denoms, err := parseDenomConfigs(getEnv("FAUCET_DENOMS", ""))
+	if err != nil {
+		log.Fatal(err)
+	}
+	config.Denoms = denoms
+
 	return config
 }
 
@@ -224,38 +393,82 @@ func NewFaucetService(config *Config) (*FaucetService, error) {
 	txFactory := tx.Factory{}.
 		WithChainID(config.ChainID).
 		WithKeybase(kr).
-		WithGas(200000).
+		WithTxConfig(newTxConfig()).
+		WithGas(uint64(config.FixedGas)).
 		WithGasAdjustment(1.5).
+		WithGasPrices(config.GasPrices).
 		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
 
+	// gRPC connection for chain queries (connects lazily)
+	grpcConn, err := dialGRPC(config.GRPCEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	nonces, err := newNonceStore(config.NonceStorePath)
+	if err != nil {
+		return nil, err
+	}
+
+	logger, err := newLogger(os.Stderr, config.LogFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	clock := realClock{}
 	return &FaucetService{
 		config:           config,
 		clientCtx:        clientCtx,
 		txFactory:        txFactory,
 		faucetAddr:       addr,
-		addressCooldowns: make(map[string]time.Time),
-		dailyResetTime:   time.Now().Truncate(24 * time.Hour).Add(24 * time.Hour),
+		logger:           logger,
+		denomCooldowns:   make(map[string]map[string]time.Time),
+		dailyCounts:      make(map[string]int64),
+		tierHistory:      make(map[string]tierRecord),
+		jobQueue:         make(chan *distributionJob, config.QueueSize),
+		jobs:             make(map[string]*JobStatus),
+		githubTokens:     make(map[string]int64),
+		githubUsers:      make(map[int64]githubVerification),
+		nonces:           nonces,
+		dailyResetTime:   nextDailyReset(clock.Now(), config.DailyResetHourUTC),
+		clock:            clock,
+		grpcConn:         grpcConn,
+		simConn:          grpcConn,
+		balanceFetcher:   newGRPCBalanceFetcher(grpcConn, addr.String(), config.Denom),
+		spendableFetcher: newGRPCSpendableFetcher(grpcConn, addr.String()),
+		nodeChecker:      newRPCNodeChecker(config.RPCEndpoint),
+		authQuery:        authtypes.NewQueryClient(grpcConn),
 	}, nil
 }
 
-// CORS middleware
+// CORS middleware. A "*" entry in AllowedOrigins allows every origin without
+// credentials; otherwise only listed origins are echoed back, with credentials.
 func (f *FaucetService) corsMiddleware(next http.Handler) http.Handler {
+	wildcard := false
+	for _, o := range f.config.AllowedOrigins {
+		if o == "*" {
+			wildcard = true
+			break
+		}
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		origin := r.Header.Get("Origin")
 
-		// Check if origin is allowed
-		allowed := false
-		for _, o := range f.config.AllowedOrigins {
-			if o == "*" || o == origin {
-				allowed = true
-				break
-			}
-		}
-
-		if allowed {
-			w.Header().Set("Access-Control-Allow-Origin", origin)
-		} else if len(f.config.AllowedOrigins) > 0 && f.config.AllowedOrigins[0] == "*" {
+		if wildcard {
+			// Browsers reject credentials with a wildcard origin, so never send both
 			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			// The response depends on the Origin header; keep caches from
+			// serving one origin's headers to another
+			w.Header().Add("Vary", "Origin")
+			for _, o := range f.config.AllowedOrigins {
+				if origin != "" && o == origin {
+					w.Header().Set("Access-Control-Allow-Origin", origin)
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+					break
+				}
+			}
 		}
 
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
@@ -278,6 +491,8 @@ func (f *FaucetService) handleHome(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	denoms := f.config.denomConfigs()
+
 	html := fmt.Sprintf(`<!DOCTYPE html>
 <html>
 <head>
@@ -342,6 +557,8 @@ func (f *FaucetService) handleHome(w http.ResponseWriter, r *http.Request) {
         .stat { background: rgba(0,0,0,0.2); padding: 15px; border-radius: 8px; text-align: center; }
         .stat-value { font-size: 24px; font-weight: 600; color: #667eea; }
         .stat-label { font-size: 12px; color: #888; margin-top: 5px; }
+        .denoms { display: flex; flex-wrap: wrap; gap: 15px; margin-bottom: 15px; color: #ccc; }
+        .denoms input { width: auto; margin: 0 5px 0 0; }
     </style>
 </head>
 <body>
@@ -351,12 +568,13 @@ func (f *FaucetService) handleHome(w http.ResponseWriter, r *http.Request) {
 
         <div class="card">
             <input type="text" id="address" placeholder="Enter your omni1... address" />
+            %s
             <button id="request" onclick="requestTokens()">Request Tokens</button>
             <div id="result"></div>
 
             <div class="info">
-                <p><strong>Distribution:</strong> %s OMNI per request</p>
-                <p><strong>Cooldown:</strong> %d hours between requests</p>
+                <p><strong>Distribution:</strong> %s per request</p>
+                <p><strong>Cooldown:</strong> %s</p>
                 <p><strong>Faucet Address:</strong> %s</p>
             </div>
 
@@ -390,10 +608,11 @@ func (f *FaucetService) handleHome(w http.ResponseWriter, r *http.Request) {
             result.innerHTML = '';
 
             try {
+                const denoms = Array.from(document.querySelectorAll('input[name=denom]:checked')).map(el => el.value);
                 const response = await fetch('/faucet', {
                     method: 'POST',
                     headers: { 'Content-Type': 'application/json' },
-                    body: JSON.stringify({ address })
+                    body: JSON.stringify({ address, denoms })
                 });
 
                 const data = await response.json();
@@ -431,8 +650,9 @@ func (f *FaucetService) handleHome(w http.ResponseWriter, r *http.Request) {
     </script>
 </body>
 </html>`,
-		formatAmount(f.config.DistributionAmount),
-		f.config.CooldownSeconds/3600,
+		denomSelector(denoms),
+		formatCoins(denoms),
+		cooldownSummary(denoms),
 		f.faucetAddr.String(),
 	)
 
@@ -442,32 +662,72 @@ func (f *FaucetService) handleHome(w http.ResponseWriter, r *http.Request) {
 
 // Handle health check
 func (f *FaucetService) handleHealth(w http.ResponseWriter, r *http.Request) {
+	// Remaining full requests: the tightest per-denom cap wins
 	f.mu.RLock()
-	remaining := f.config.DailyCap - f.dailyCount
+	var remaining int64 = -1
+	for _, d := range f.config.denomConfigs() {
+		left := d.DailyCap - f.dailyCounts[d.Denom]
+		if remaining < 0 || left < remaining {
+			remaining = left
+		}
+	}
 	f.mu.RUnlock()
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	status := "healthy"
+	paused := f.isPaused()
+	if paused {
+		status = "degraded"
+	}
 
 	response := HealthResponse{
-		Status:         "healthy",
 		FaucetAddress:  f.faucetAddr.String(),
 		ChainID:        f.config.ChainID,
 		DailyRemaining: remaining,
+		Paused:         paused,
 	}
 
+	// An unreachable node outranks a low balance
 	w.Header().Set("Content-Type", "application/json")
+	if err := f.checkNode(r.Context()); err != nil {
+		response.Status = "unhealthy"
+		response.Error = err.Error()
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	response.Status = status
 	json.NewEncoder(w).Encode(response)
 }
 
 // Handle stats
 func (f *FaucetService) handleStats(w http.ResponseWriter, r *http.Request) {
+	denoms := f.config.denomConfigs()
+
 	f.mu.RLock()
-	count := f.dailyCount
+	stats := make([]DenomStats, 0, len(denoms))
+	for _, d := range denoms {
+		stats = append(stats, DenomStats{
+			Denom:            d.Denom,
+			Amount:           formatCoin(d.Denom, d.Amount),
+			DailyCap:         d.DailyCap,
+			DistributedToday: f.dailyCounts[d.Denom],
+			CooldownSeconds:  d.CooldownSeconds,
+		})
+	}
 	f.mu.RUnlock()
 
+	// Top-level fields describe the primary (first) denom for older clients
+	primary := stats[0]
 	response := StatsResponse{
-		TotalDistributed:   count,
-		DailyCap:           f.config.DailyCap,
-		CooldownSeconds:    f.config.CooldownSeconds,
-		DistributionAmount: formatAmount(f.config.DistributionAmount) + " OMNI",
+		TotalDistributed:   primary.DistributedToday,
+		DailyCap:           primary.DailyCap,
+		CooldownSeconds:    primary.CooldownSeconds,
+		DistributionAmount: primary.Amount,
+		Denoms:             stats,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -495,6 +755,43 @@ func (f *FaucetService) handleFaucet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Refuse early while the faucet is out of funds
+	if f.isPaused() {
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: false,
+			Error:   "Faucet temporarily out of funds. Please try again later.",
+		})
+		return
+	}
+
+	// Refuse until the account sequence is known to avoid a mismatch on first broadcast
+	if !f.isReady() {
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: false,
+			Error:   "Faucet is starting up. Please try again shortly.",
+		})
+		return
+	}
+
+	// Optional abuse protection: require a sufficiently old GitHub account
+	if f.config.RequireGitHubAuth {
+		if user, err := f.verifyGitHubAccount(r.Context(), r); err != nil {
+			status := http.StatusForbidden
+			if errors.Is(err, errGitHubTokenMissing) || errors.Is(err, errGitHubTokenInvalid) {
+				status = http.StatusUnauthorized
+			} else if user.ID == 0 {
+				// Lookup itself failed, not a policy rejection
+				status = http.StatusBadGateway
+			}
+			w.WriteHeader(status)
+			json.NewEncoder(w).Encode(DistributionResponse{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+	}
+
 	// Validate address
 	if !isValidAddress(req.Address, f.config.Bech32Prefix) {
 		json.NewEncoder(w).Encode(DistributionResponse{
@@ -504,8 +801,29 @@ func (f *FaucetService) handleFaucet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check rate limits
-	if err := f.checkRateLimits(req.Address); err != nil {
+	// Optional replay protection: the request must be signed by the address's
+	// key for this faucet and carry an unused nonce
+	if f.config.RequireSignedRequests {
+		if err := f.verifySignedRequest(req.Address, req.Signature, f.clock.Now()); err != nil {
+			status := http.StatusUnauthorized
+			switch {
+			case errors.Is(err, errNonceReused):
+				status = http.StatusConflict
+			case errors.Is(err, errPersistNonce):
+				status = http.StatusInternalServerError
+			}
+			w.WriteHeader(status)
+			json.NewEncoder(w).Encode(DistributionResponse{
+				Success: false,
+				Error:   err.Error(),
+			})
+			return
+		}
+	}
+
+	// Resolve requested denoms
+	denoms, err := f.resolveDenoms(req.Denoms)
+	if err != nil {
 		json.NewEncoder(w).Encode(DistributionResponse{
 			Success: false,
 			Error:   err.Error(),
@@ -513,74 +831,240 @@ func (f *FaucetService) handleFaucet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Send tokens
-	txHash, err := f.sendTokens(req.Address)
+	// Check rate limits and hold the cooldown until the job finishes
+	reservation, err := f.reserveRateLimits(req.Address, denoms)
 	if err != nil {
-		log.Printf("Failed to send tokens to %s: %v", req.Address, err)
+		// Requesting during a cooldown forfeits the tiered amount
+		var cooldown *cooldownError
+		if errors.As(err, &cooldown) {
+			f.forfeitTier(req.Address)
+		}
+		f.logger.Info("Rate limit rejected request",
+			"event", logEventRateLimited, "address", req.Address, "error", err)
 		json.NewEncoder(w).Encode(DistributionResponse{
 			Success: false,
-			Error:   "Failed to send tokens. Please try again later.",
+			Error:   err.Error(),
 		})
 		return
 	}
 
-	// Update rate limit tracking
-	f.recordDistribution(req.Address)
+	// Scale amounts by the address's request history
+	denoms = f.tieredDenoms(req.Address, denoms)
+
+	// Hand off to the worker pool
+	job, ok := f.enqueueJob(req.Address, denoms, reservation)
+	if !ok {
+		f.releaseReservation(reservation)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: false,
+			Error:   "Faucet is busy. Please try again shortly.",
+		})
+		return
+	}
+
+	// Async mode: return the job ID immediately for polling at /status/{id}
+	if r.URL.Query().Get("async") == "true" {
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: true,
+			JobID:   job.id,
+			Message: "Request queued. Poll /status/" + job.id + " for the result.",
+		})
+		return
+	}
+
+	timeout := time.Duration(f.config.RequestTimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	select {
+	case <-job.done:
+	case <-time.After(timeout):
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: true,
+			JobID:   job.id,
+			Message: "Request is still processing. Poll /status/" + job.id + " for the result.",
+		})
+		return
+	}
 
-	log.Printf("Sent %d %s to %s (tx: %s)", f.config.DistributionAmount, f.config.Denom, req.Address, txHash)
+	status, _ := f.jobStatus(job.id)
+	if status.Status != JobSuccess {
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: false,
+			Error:   status.Error,
+			JobID:   job.id,
+		})
+		return
+	}
 
 	json.NewEncoder(w).Encode(DistributionResponse{
 		Success: true,
-		TxHash:  txHash,
-		Amount:  formatAmount(f.config.DistributionAmount) + " OMNI",
+		TxHash:  status.TxHash,
+		Amount:  status.Amount,
 		Message: "Tokens sent successfully!",
+		JobID:   job.id,
 	})
 }
 
-// Check rate limits
-func (f *FaucetService) checkRateLimits(address string) error {
+// Check rate limits for every requested denom. The request is rejected as a
+// whole if any denom is capped or on cooldown for this address.
+func (f *FaucetService) checkRateLimits(address string, denoms []DenomConfig) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	return f.checkRateLimitsLocked(address, denoms)
+}
 
-	// Reset daily counter if needed
-	if time.Now().After(f.dailyResetTime) {
-		f.dailyCount = 0
-		f.dailyResetTime = time.Now().Truncate(24 * time.Hour).Add(24 * time.Hour)
+// checkRateLimitsLocked is checkRateLimits for callers holding mu
+func (f *FaucetService) checkRateLimitsLocked(address string, denoms []DenomConfig) error {
+	// Reset daily counters once the UTC reset boundary has passed
+	now := f.clock.Now()
+	if !now.Before(f.dailyResetTime) {
+		f.dailyCounts = make(map[string]int64)
+		f.dailyResetTime = nextDailyReset(now, f.config.DailyResetHourUTC)
 		// Clear old cooldowns
-		for addr := range f.addressCooldowns {
-			if time.Now().After(f.addressCooldowns[addr]) {
-				delete(f.addressCooldowns, addr)
+		for denom, cooldowns := range f.denomCooldowns {
+			for addr, end := range cooldowns {
+				if now.After(end) {
+					delete(cooldowns, addr)
+				}
+			}
+			if len(cooldowns) == 0 {
+				delete(f.denomCooldowns, denom)
 			}
 		}
+		f.pruneTierHistoryLocked(now)
 	}
 
-	// Check daily cap
-	if f.dailyCount >= f.config.DailyCap {
-		return fmt.Errorf("daily distribution limit reached. Please try again tomorrow")
-	}
+	for _, d := range denoms {
+		// Check daily cap
+		if f.dailyCounts[d.Denom] >= d.DailyCap {
+			return fmt.Errorf("daily distribution limit reached for %s. Please try again tomorrow", d.Denom)
+		}
 
-	// Check address cooldown
-	if cooldownEnd, exists := f.addressCooldowns[address]; exists {
-		if time.Now().Before(cooldownEnd) {
-			remaining := time.Until(cooldownEnd).Round(time.Minute)
-			return fmt.Errorf("please wait %v before requesting again", remaining)
+		// Check address cooldown
+		if cooldownEnd, exists := f.denomCooldowns[d.Denom][address]; exists {
+			if now.Before(cooldownEnd) {
+				return &cooldownError{denom: d.Denom, remaining: cooldownEnd.Sub(now).Round(time.Minute)}
+			}
 		}
 	}
 
 	return nil
 }
 
-// Record a distribution for rate limiting
-func (f *FaucetService) recordDistribution(address string) {
+// cooldownError rejects a request for a denom the address is on cooldown for
+type cooldownError struct {
+	denom     string
+	remaining time.Duration
+}
+
+func (e *cooldownError) Error() string {
+	return fmt.Sprintf("please wait %v before requesting %s again", e.remaining, e.denom)
+}
+
+// rateReservation is the daily-cap slot and pending cooldown held for a
+// queued distribution from the moment it passes the rate limit check
+type rateReservation struct {
+	address      string
+	denoms       []DenomConfig
+	cooldownEnds map[string]time.Time // denom -> pending cooldown end
+	dailyReset   time.Time            // end of the daily window the slot was counted in
+}
+
+// reserveRateLimits checks the rate limits and, in the same critical section,
+// counts the distribution against the daily caps and starts the cooldown.
+// Concurrent requests for one address therefore cannot all pass the check
+// before the first is recorded. The reservation is kept by recordDistribution
+// once the tokens are sent, or returned by releaseReservation.
+func (f *FaucetService) reserveRateLimits(address string, denoms []DenomConfig) (*rateReservation, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.dailyCount++
-	f.addressCooldowns[address] = time.Now().Add(time.Duration(f.config.CooldownSeconds) * time.Second)
+	if err := f.checkRateLimitsLocked(address, denoms); err != nil {
+		return nil, err
+	}
+
+	now := f.clock.Now()
+	res := &rateReservation{
+		address:      address,
+		denoms:       denoms,
+		cooldownEnds: make(map[string]time.Time, len(denoms)),
+		dailyReset:   f.dailyResetTime,
+	}
+	for _, d := range denoms {
+		f.dailyCounts[d.Denom]++
+		if f.denomCooldowns[d.Denom] == nil {
+			f.denomCooldowns[d.Denom] = make(map[string]time.Time)
+		}
+		end := now.Add(time.Duration(d.CooldownSeconds) * time.Second)
+		f.denomCooldowns[d.Denom][address] = end
+		res.cooldownEnds[d.Denom] = end
+	}
+	return res, nil
+}
+
+// releaseReservation rolls back a reservation whose distribution failed: the
+// pending cooldowns are cleared and the daily-cap slots returned, unless the
+// daily counters have been reset since
+func (f *FaucetService) releaseReservation(res *rateReservation) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sameDay := f.dailyResetTime.Equal(res.dailyReset)
+	for _, d := range res.denoms {
+		if end, ok := f.denomCooldowns[d.Denom][res.address]; ok && end.Equal(res.cooldownEnds[d.Denom]) {
+			delete(f.denomCooldowns[d.Denom], res.address)
+		}
+		if sameDay && f.dailyCounts[d.Denom] > 0 {
+			f.dailyCounts[d.Denom]--
+		}
+	}
+}
+
+// Record a successful distribution for rate limiting. The cooldown restarts
+// from delivery so time spent queued does not shorten it.
+func (f *FaucetService) recordDistribution(res *rateReservation) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.clock.Now()
+	if f.config.TieredAmounts {
+		record := f.tierHistory[res.address]
+		record.successes++
+		record.lastSuccess = now
+		f.tierHistory[res.address] = record
+	}
+	for _, d := range res.denoms {
+		if f.denomCooldowns[d.Denom] == nil {
+			f.denomCooldowns[d.Denom] = make(map[string]time.Time)
+		}
+		f.denomCooldowns[d.Denom][res.address] = now.Add(time.Duration(d.CooldownSeconds) * time.Second)
+	}
 }
 
 // Send tokens to an address
-func (f *FaucetService) sendTokens(toAddress string) (string, error) {
+func (f *FaucetService) sendTokens(toAddress string, denoms []DenomConfig) (string, error) {
+	coins := make([]sdk.Coin, 0, len(denoms))
+	for _, d := range denoms {
+		coins = append(coins, sdk.NewInt64Coin(d.Denom, d.Amount))
+	}
+	return f.sendCoins(toAddress, sdk.NewCoins(coins...))
+}
+
+// sendCoins signs and broadcasts a MsgSend of amount from the faucet account
+// using the cached account number and sequence. Callers serialize on
+// broadcastMu.
+func (f *FaucetService) sendCoins(toAddress string, amount sdk.Coins) (string, error) {
+	return f.sendCoinsWithGas(toAddress, amount, 0)
+}
+
+// sendCoinsWithGas is sendCoins with a fixed gas limit; zero sets the limit
+// from GasMode
+func (f *FaucetService) sendCoinsWithGas(toAddress string, amount sdk.Coins, gas uint64) (string, error) {
 	// Parse recipient address
 	recipient, err := sdk.AccAddressFromBech32(toAddress)
 	if err != nil {
@@ -588,11 +1072,33 @@ func (f *FaucetService) sendTokens(toAddress string) (string, error) {
 	}
 
 	// Create send message
-	amount := sdk.NewCoins(sdk.NewInt64Coin(f.config.Denom, f.config.DistributionAmount))
 	msg := banktypes.NewMsgSend(f.faucetAddr, recipient, amount)
 
+	broadcast := f.broadcaster
+	if broadcast == nil {
+		broadcast = f.placeholderBroadcast
+	}
+
+	accountNumber, sequence := f.accountSequence()
+	txf := f.txFactory.WithAccountNumber(accountNumber).WithSequence(sequence)
+	if gas == 0 {
+		txf = f.withGas(txf, msg)
+	} else {
+		txf = txf.WithGas(gas)
+	}
+	txHash, err := broadcast(txf, msg)
+	if err != nil {
+		return "", err
+	}
+	f.incrementSequence()
+	return txHash, nil
+}
+
+// placeholderBroadcast stands in for signing and broadcasting until the faucet
+// talks to a node.
+func (f *FaucetService) placeholderBroadcast(txf tx.Factory, msg *banktypes.MsgSend) (string, error) {
 	// This is a simplified version - in production you would:
-	// 1. Query the account sequence
+	// 1. Take the cached account number/sequence (see account.go)
 	// 2. Build and sign the transaction
 	// 3. Broadcast to the chain
 	// 4. Wait for confirmation
@@ -602,26 +1108,42 @@ func (f *FaucetService) sendTokens(toAddress string) (string, error) {
 
 	// In a real implementation, you would use:
 	// - grpc connection to broadcast
-	// - proper sequence/account number handling
 	// - async confirmation handling
 
-	log.Printf("Would send %v from %s to %s", amount, f.faucetAddr, recipient)
+	f.logger.Info("Would send tokens",
+		"event", logEventBroadcast,
+		"address", msg.ToAddress,
+		"amount", msg.Amount.String(),
+		"account_number", txf.AccountNumber(),
+		"sequence", txf.Sequence(),
+		"gas", txf.Gas(),
+	)
 
 	// Placeholder - return a mock tx hash
 	// In production, this would be the actual broadcast result
-	return fmt.Sprintf("MOCK_%s_%d", toAddress[5:15], time.Now().UnixNano()), nil
+	return fmt.Sprintf("MOCK_%s_%d", msg.ToAddress[5:15], time.Now().UnixNano()), nil
 }
 
-// Validate address format
+// addressDataLength is the bech32 data part length (20-byte account plus
+// 6-char checksum) of the addresses the faucet sends to
+const addressDataLength = 38
+
+// Validate address format. Decodes the address, so strings with the right
+// shape but a bad bech32 checksum are rejected before any broadcast.
+// Relies on the SDK config prefix having been set at startup.
 func isValidAddress(address, prefix string) bool {
-	if !strings.HasPrefix(address, prefix+"1") {
+	// Fast pre-check to reject obvious garbage without decoding
+	if len(address) != len(prefix)+1+addressDataLength || !strings.HasPrefix(address, prefix+"1") {
+		return false
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(address)
+	if err != nil || hrp != prefix {
 		return false
 	}
 
-	// Basic bech32 validation
-	pattern := fmt.Sprintf(`^%s1[a-z0-9]{38}$`, prefix)
-	matched, _ := regexp.MatchString(pattern, address)
-	return matched
+	_, err = sdk.AccAddressFromBech32(address)
+	return err == nil
 }
 
 // Format amount for display
@@ -0,0 +1,1780 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
+)
+
+func TestMain(m *testing.M) {
+	// sendTokens parses recipients with the global bech32 prefix
+	sdk.GetConfig().SetBech32PrefixForAccount("omni", "omnipub")
+	os.Exit(m.Run())
+}
+
+// newTestFaucet builds a FaucetService without a keyring or node connection
+func newTestFaucet(t *testing.T) *FaucetService {
+	t.Helper()
+	return &FaucetService{
+		config: &Config{
+			ChainID:               "omniphi-test",
+			Denom:                 "uomni",
+			Bech32Prefix:          "omni",
+			DistributionAmount:    1000,
+			CooldownSeconds:       60,
+			DailyCap:              10,
+			MinBalance:            5000,
+			BalancePollSeconds:    1,
+			QueueSize:             10,
+			WorkerCount:           1,
+			RequestTimeoutSeconds: 5,
+		},
+		faucetAddr:     sdk.AccAddress([]byte("faucet______________")),
+		logger:         slog.Default(),
+		denomCooldowns: make(map[string]map[string]time.Time),
+		dailyCounts:    make(map[string]int64),
+		tierHistory:    make(map[string]tierRecord),
+		jobQueue:       make(chan *distributionJob, 10),
+		jobs:           make(map[string]*JobStatus),
+		githubTokens:   make(map[string]int64),
+		githubUsers:    make(map[int64]githubVerification),
+		dailyResetTime: time.Now().Add(24 * time.Hour),
+		clock:          realClock{},
+	}
+}
+
+// fakeClock is a Clock that only moves when advanced
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// withFakeClock points the faucet's rate limiter at a fake clock starting at start
+func withFakeClock(f *FaucetService, start time.Time) *fakeClock {
+	clock := &fakeClock{now: start}
+	f.clock = clock
+	f.dailyResetTime = nextDailyReset(start, f.config.DailyResetHourUTC)
+	return clock
+}
+
+// distribute reserves and records a successful distribution to address,
+// failing the test if the rate limits reject it
+func distribute(t *testing.T, f *FaucetService, address string, denoms []DenomConfig) {
+	t.Helper()
+	res, err := f.reserveRateLimits(address, denoms)
+	if err != nil {
+		t.Fatalf("reserve %s: %v", address, err)
+	}
+	f.recordDistribution(res)
+}
+
+// staticBalance returns a fetcher whose balance can be changed between polls
+func staticBalance(balance *atomic.Int64) BalanceFetcher {
+	return func(ctx context.Context) (int64, error) {
+		return balance.Load(), nil
+	}
+}
+
+func decodeHealth(t *testing.T, f *FaucetService) HealthResponse {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	f.handleHealth(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	var resp HealthResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode health: %v", err)
+	}
+	return resp
+}
+
+func TestBalanceMonitor_PauseAndResume(t *testing.T) {
+	f := newTestFaucet(t)
+
+	var balance atomic.Int64
+	balance.Store(10000)
+	f.balanceFetcher = staticBalance(&balance)
+
+	// Healthy balance: not paused
+	f.checkBalance(context.Background())
+	if f.isPaused() {
+		t.Fatal("faucet should not be paused above MinBalance")
+	}
+	if got := decodeHealth(t, f).Status; got != "healthy" {
+		t.Fatalf("expected healthy, got %s", got)
+	}
+
+	// Drop below threshold: paused, health degraded, requests refused
+	balance.Store(4999)
+	f.checkBalance(context.Background())
+	if !f.isPaused() {
+		t.Fatal("faucet should be paused below MinBalance")
+	}
+	health := decodeHealth(t, f)
+	if health.Status != "degraded" || !health.Paused {
+		t.Fatalf("expected degraded/paused health, got %+v", health)
+	}
+
+	rec := httptest.NewRecorder()
+	body := strings.NewReader(`{"address":"omni1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq"}`)
+	f.handleFaucet(rec, httptest.NewRequest(http.MethodPost, "/faucet", body))
+	var resp DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode faucet response: %v", err)
+	}
+	if resp.Success || !strings.Contains(resp.Error, "out of funds") {
+		t.Fatalf("expected out-of-funds error, got %+v", resp)
+	}
+
+	// Refill: resumes
+	balance.Store(5000)
+	f.checkBalance(context.Background())
+	if f.isPaused() {
+		t.Fatal("faucet should resume once balance reaches MinBalance")
+	}
+	if got := decodeHealth(t, f).Status; got != "healthy" {
+		t.Fatalf("expected healthy after refill, got %s", got)
+	}
+}
+
+func TestBalanceMonitor_FetchErrorKeepsState(t *testing.T) {
+	f := newTestFaucet(t)
+	f.paused.Store(true)
+	f.balanceFetcher = func(ctx context.Context) (int64, error) {
+		return 0, errors.New("node unreachable")
+	}
+
+	f.checkBalance(context.Background())
+	if !f.isPaused() {
+		t.Fatal("query errors must not change the paused state")
+	}
+}
+
+func TestBalanceMonitor_WebhookOnTransitions(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		events []LowBalanceEvent
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev LowBalanceEvent
+		if err := json.NewDecoder(r.Body).Decode(&ev); err != nil {
+			t.Errorf("decode webhook body: %v", err)
+		}
+		mu.Lock()
+		events = append(events, ev)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	f := newTestFaucet(t)
+	f.config.LowBalanceWebhookURL = srv.URL
+
+	var balance atomic.Int64
+	balance.Store(100)
+	f.balanceFetcher = staticBalance(&balance)
+
+	f.checkBalance(context.Background()) // cross below → paused
+	f.checkBalance(context.Background()) // still below → no new event
+	balance.Store(1_000_000)
+	f.checkBalance(context.Background()) // cross above → resumed
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 2 {
+		t.Fatalf("expected 2 webhook events, got %d", len(events))
+	}
+	if events[0].Event != "faucet_paused" || events[0].Balance != 100 {
+		t.Fatalf("unexpected first event: %+v", events[0])
+	}
+	if events[1].Event != "faucet_resumed" {
+		t.Fatalf("unexpected second event: %+v", events[1])
+	}
+}
+
+func TestBalanceMonitor_BackgroundPoller(t *testing.T) {
+	f := newTestFaucet(t)
+
+	var balance atomic.Int64
+	balance.Store(1)
+	f.balanceFetcher = staticBalance(&balance)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f.startBalanceMonitor(ctx)
+
+	waitFor(t, 2*time.Second, f.isPaused)
+
+	balance.Store(1_000_000)
+	waitFor(t, 3*time.Second, func() bool { return !f.isPaused() })
+}
+
+func TestBalanceMonitor_DisabledWithoutMinBalance(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.MinBalance = 0
+	f.balanceFetcher = func(ctx context.Context) (int64, error) {
+		t.Error("fetcher must not be called when monitoring is disabled")
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f.startBalanceMonitor(ctx)
+	time.Sleep(50 * time.Millisecond)
+
+	if f.isPaused() {
+		t.Fatal("faucet should never pause with monitoring disabled")
+	}
+}
+
+// waitFor polls cond until it returns true or the timeout elapses
+func waitFor(t *testing.T, timeout time.Duration, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("condition not met before timeout")
+}
+
+// mockAuthQuery implements authtypes.QueryClient; only AccountInfo is used
+type mockAuthQuery struct {
+	authtypes.QueryClient
+
+	mu      sync.Mutex
+	info    *authtypes.BaseAccount
+	err     error
+	calls   int
+	release chan struct{} // if set, AccountInfo blocks until closed
+}
+
+func (m *mockAuthQuery) AccountInfo(ctx context.Context, req *authtypes.QueryAccountInfoRequest, opts ...grpc.CallOption) (*authtypes.QueryAccountInfoResponse, error) {
+	if m.release != nil {
+		<-m.release
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &authtypes.QueryAccountInfoResponse{Info: m.info}, nil
+}
+
+func (m *mockAuthQuery) callCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls
+}
+
+// postFaucet submits a faucet request and decodes the response
+func postFaucet(t *testing.T, f *FaucetService, address string) DistributionResponse {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	body := strings.NewReader(fmt.Sprintf(`{"address":%q}`, address))
+	f.handleFaucet(rec, httptest.NewRequest(http.MethodPost, "/faucet", body))
+	var resp DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode faucet response: %v", err)
+	}
+	return resp
+}
+
+func readyzStatus(f *FaucetService) int {
+	rec := httptest.NewRecorder()
+	f.handleReady(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	return rec.Code
+}
+
+func TestAccountSync_SequenceCachedBeforeReady(t *testing.T) {
+	f := newTestFaucet(t)
+	mock := &mockAuthQuery{
+		info:    &authtypes.BaseAccount{AccountNumber: 7, Sequence: 42},
+		release: make(chan struct{}),
+	}
+	f.authQuery = mock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f.startAccountSync(ctx)
+
+	// Query still in flight: not ready, requests refused
+	if code := readyzStatus(f); code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 before sync, got %d", code)
+	}
+	if resp := postFaucet(t, f, "omni1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq"); resp.Success {
+		t.Fatal("faucet should refuse requests before account sync")
+	}
+
+	close(mock.release)
+	waitFor(t, 2*time.Second, f.isReady)
+
+	// Readiness implies the cache holds the on-chain values
+	accNum, seq := f.accountSequence()
+	if accNum != 7 || seq != 42 {
+		t.Fatalf("expected account 7 sequence 42, got %d/%d", accNum, seq)
+	}
+	if code := readyzStatus(f); code != http.StatusOK {
+		t.Fatalf("expected 200 after sync, got %d", code)
+	}
+}
+
+func TestAccountSync_RetriesUntilSuccess(t *testing.T) {
+	prev := accountSyncRetryInterval
+	accountSyncRetryInterval = 10 * time.Millisecond
+	defer func() { accountSyncRetryInterval = prev }()
+
+	f := newTestFaucet(t)
+	mock := &mockAuthQuery{err: errors.New("node unavailable")}
+	f.authQuery = mock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f.startAccountSync(ctx)
+
+	waitFor(t, time.Second, func() bool { return mock.callCount() >= 2 })
+	if f.isReady() {
+		t.Fatal("faucet must not be ready while the account query fails")
+	}
+
+	mock.mu.Lock()
+	mock.err = nil
+	mock.info = &authtypes.BaseAccount{AccountNumber: 1, Sequence: 3}
+	mock.mu.Unlock()
+
+	waitFor(t, time.Second, f.isReady)
+	if _, seq := f.accountSequence(); seq != 3 {
+		t.Fatalf("expected sequence 3, got %d", seq)
+	}
+}
+
+func TestAccountSync_ResyncAfterBroadcastError(t *testing.T) {
+	f := newTestFaucet(t)
+	mock := &mockAuthQuery{info: &authtypes.BaseAccount{AccountNumber: 7, Sequence: 42}}
+	f.authQuery = mock
+
+	if err := f.syncAccount(context.Background()); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	f.incrementSequence()
+	f.incrementSequence()
+
+	// Chain reports a different sequence; a broadcast error triggers re-sync
+	mock.mu.Lock()
+	mock.info = &authtypes.BaseAccount{AccountNumber: 7, Sequence: 50}
+	mock.mu.Unlock()
+	f.resyncAccount()
+
+	waitFor(t, time.Second, func() bool {
+		_, seq := f.accountSequence()
+		return seq == 50
+	})
+	if !f.isReady() {
+		t.Fatal("re-sync must not revoke readiness")
+	}
+}
+
+func TestAccountSync_ResyncWaitsForBroadcast(t *testing.T) {
+	f := newTestFaucet(t)
+	mock := &mockAuthQuery{info: &authtypes.BaseAccount{AccountNumber: 7, Sequence: 42}}
+	f.authQuery = mock
+	if err := f.syncAccount(context.Background()); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	mock.mu.Lock()
+	mock.info = &authtypes.BaseAccount{AccountNumber: 7, Sequence: 50}
+	mock.mu.Unlock()
+
+	// A broadcast in flight keeps the re-sync from touching the sequence
+	f.broadcastMu.Lock()
+	f.resyncAccount()
+	time.Sleep(50 * time.Millisecond)
+	if _, seq := f.accountSequence(); seq != 42 {
+		f.broadcastMu.Unlock()
+		t.Fatalf("re-sync must wait for the broadcast, sequence is %d", seq)
+	}
+	f.broadcastMu.Unlock()
+
+	waitFor(t, time.Second, func() bool {
+		_, seq := f.accountSequence()
+		return seq == 50
+	})
+}
+
+// testAddress returns a valid omni1... address derived from name
+func testAddress(name string) string {
+	return sdk.AccAddress([]byte(fmt.Sprintf("%-20s", name))).String()
+}
+
+// newMultiDenomFaucet returns a ready faucet distributing uomni and uusdc
+func newMultiDenomFaucet(t *testing.T) *FaucetService {
+	t.Helper()
+	f := newTestFaucet(t)
+	f.config.MinBalance = 0
+	f.config.Denoms = []DenomConfig{
+		{Denom: "uomni", Amount: 1000000, DailyCap: 10, CooldownSeconds: 60},
+		{Denom: "uusdc", Amount: 5000000, DailyCap: 1, CooldownSeconds: 60},
+	}
+	f.ready.Store(true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	f.startWorkers(ctx)
+	return f
+}
+
+func postFaucetDenoms(t *testing.T, f *FaucetService, address string, denoms []string) DistributionResponse {
+	t.Helper()
+	bz, err := json.Marshal(DistributionRequest{Address: address, Denoms: denoms})
+	if err != nil {
+		t.Fatalf("encode request: %v", err)
+	}
+	rec := httptest.NewRecorder()
+	f.handleFaucet(rec, httptest.NewRequest(http.MethodPost, "/faucet", strings.NewReader(string(bz))))
+	var resp DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode faucet response: %v", err)
+	}
+	return resp
+}
+
+func TestMultiDenom_DefaultsToAllDenoms(t *testing.T) {
+	f := newMultiDenomFaucet(t)
+
+	resp := postFaucetDenoms(t, f, testAddress("alice"), nil)
+	if !resp.Success {
+		t.Fatalf("expected success, got %+v", resp)
+	}
+	if resp.Amount != "1 OMNI, 5 USDC" {
+		t.Fatalf("unexpected amount %q", resp.Amount)
+	}
+	if f.dailyCounts["uomni"] != 1 || f.dailyCounts["uusdc"] != 1 {
+		t.Fatalf("expected both denoms recorded, got %v", f.dailyCounts)
+	}
+}
+
+func TestMultiDenom_PartialRequest(t *testing.T) {
+	f := newMultiDenomFaucet(t)
+	alice := testAddress("alice")
+
+	resp := postFaucetDenoms(t, f, alice, []string{"uomni"})
+	if !resp.Success || resp.Amount != "1 OMNI" {
+		t.Fatalf("expected uomni-only success, got %+v", resp)
+	}
+	if f.dailyCounts["uusdc"] != 0 {
+		t.Fatal("unrequested denom must not be counted")
+	}
+
+	// uomni is on cooldown for alice, but uusdc is still available
+	if resp := postFaucetDenoms(t, f, alice, []string{"uomni"}); resp.Success {
+		t.Fatal("expected uomni cooldown to apply")
+	}
+	if resp := postFaucetDenoms(t, f, alice, []string{"uusdc"}); !resp.Success {
+		t.Fatalf("expected uusdc to be available, got %+v", resp)
+	}
+
+	// Unknown denoms are rejected
+	resp = postFaucetDenoms(t, f, testAddress("bob"), []string{"uatom"})
+	if resp.Success || !strings.Contains(resp.Error, "uatom") {
+		t.Fatalf("expected unknown denom error, got %+v", resp)
+	}
+}
+
+func TestMultiDenom_PerDenomDailyCap(t *testing.T) {
+	f := newMultiDenomFaucet(t)
+
+	// uusdc cap is 1: the first request drains it
+	if resp := postFaucetDenoms(t, f, testAddress("alice"), nil); !resp.Success {
+		t.Fatalf("expected success, got %+v", resp)
+	}
+
+	// A full request from someone else now fails on uusdc, without spending uomni
+	resp := postFaucetDenoms(t, f, testAddress("bob"), nil)
+	if resp.Success || !strings.Contains(resp.Error, "uusdc") {
+		t.Fatalf("expected uusdc cap error, got %+v", resp)
+	}
+	if f.dailyCounts["uomni"] != 1 {
+		t.Fatalf("rejected request must not consume uomni cap, got %d", f.dailyCounts["uomni"])
+	}
+
+	// uomni alone is still under its cap
+	if resp := postFaucetDenoms(t, f, testAddress("bob"), []string{"uomni"}); !resp.Success {
+		t.Fatalf("expected uomni-only success, got %+v", resp)
+	}
+
+	// Stats report per-denom usage
+	rec := httptest.NewRecorder()
+	f.handleStats(rec, httptest.NewRequest(http.MethodGet, "/stats", nil))
+	var stats StatsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&stats); err != nil {
+		t.Fatalf("decode stats: %v", err)
+	}
+	if len(stats.Denoms) != 2 || stats.Denoms[0].DistributedToday != 2 || stats.Denoms[1].DistributedToday != 1 {
+		t.Fatalf("unexpected per-denom stats %+v", stats.Denoms)
+	}
+}
+
+func TestParseDenomConfigs(t *testing.T) {
+	denoms, err := parseDenomConfigs(`[{"denom":"uomni","amount":10,"daily_cap":5,"cooldown":60}]`)
+	if err != nil || len(denoms) != 1 || denoms[0].CooldownSeconds != 60 {
+		t.Fatalf("unexpected parse result %+v, %v", denoms, err)
+	}
+
+	for _, raw := range []string{
+		`not json`,
+		`[{"denom":"","amount":10}]`,
+		`[{"denom":"uomni","amount":0}]`,
+		`[{"denom":"uomni","amount":1},{"denom":"uomni","amount":2}]`,
+	} {
+		if _, err := parseDenomConfigs(raw); err == nil {
+			t.Errorf("expected error for %s", raw)
+		}
+	}
+}
+
+func TestQueue_SaturationReturns503(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.MinBalance = 0
+	f.ready.Store(true)
+	// No workers draining a single-slot queue
+	f.jobQueue = make(chan *distributionJob, 1)
+
+	req := func(addr string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		body := strings.NewReader(fmt.Sprintf(`{"address":%q}`, addr))
+		f.handleFaucet(rec, httptest.NewRequest(http.MethodPost, "/faucet?async=true", body))
+		return rec
+	}
+
+	if rec := req(testAddress("alice")); rec.Code != http.StatusAccepted {
+		t.Fatalf("expected 202 for first job, got %d", rec.Code)
+	}
+
+	rec := req(testAddress("bob"))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 when queue is full, got %d", rec.Code)
+	}
+	var resp DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.Success || resp.JobID != "" {
+		t.Fatalf("rejected request must not return a job, got %+v", resp)
+	}
+
+	// The rejected request gives back its cooldown and daily-cap slot
+	if err := f.checkRateLimits(testAddress("bob"), f.config.denomConfigs()); err != nil {
+		t.Fatalf("bob should not be on cooldown after a 503: %v", err)
+	}
+	if f.dailyCounts["uomni"] != 1 {
+		t.Fatalf("expected only alice's job counted, got %d", f.dailyCounts["uomni"])
+	}
+}
+
+func TestQueue_ConcurrentRequestsReserveOnce(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.MinBalance = 0
+	f.ready.Store(true)
+	// No workers, so every accepted request stays queued
+	f.jobQueue = make(chan *distributionJob, 20)
+
+	var (
+		wg       sync.WaitGroup
+		accepted atomic.Int64
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			body := strings.NewReader(fmt.Sprintf(`{"address":%q}`, testAddress("alice")))
+			f.handleFaucet(rec, httptest.NewRequest(http.MethodPost, "/faucet?async=true", body))
+			if rec.Code == http.StatusAccepted {
+				accepted.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if accepted.Load() != 1 {
+		t.Fatalf("expected exactly one queued job for one address, got %d", accepted.Load())
+	}
+	if len(f.jobQueue) != 1 || f.dailyCounts["uomni"] != 1 {
+		t.Fatalf("expected one job and one counted distribution, got %d and %d", len(f.jobQueue), f.dailyCounts["uomni"])
+	}
+}
+
+func TestQueue_FailedBroadcastReleasesReservation(t *testing.T) {
+	f := newTestFaucet(t)
+	f.ready.Store(true)
+	f.broadcaster = func(txf tx.Factory, msg *banktypes.MsgSend) (string, error) {
+		return "", errors.New("account sequence mismatch")
+	}
+
+	alice := testAddress("alice")
+	denoms := f.config.denomConfigs()
+	res, err := f.reserveRateLimits(alice, denoms)
+	if err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	if err := f.checkRateLimits(alice, denoms); err == nil {
+		t.Fatal("a reserved address must be on cooldown while its job is queued")
+	}
+
+	job, ok := f.enqueueJob(alice, denoms, res)
+	if !ok {
+		t.Fatal("enqueue failed")
+	}
+	f.processJob(<-f.jobQueue)
+
+	if status, _ := f.jobStatus(job.id); status.Status != JobFailed {
+		t.Fatalf("expected failed job, got %+v", status)
+	}
+	if err := f.checkRateLimits(alice, denoms); err != nil {
+		t.Fatalf("a failed distribution must not leave a cooldown: %v", err)
+	}
+	if f.dailyCounts["uomni"] != 0 || f.tierHistory[alice].successes != 0 {
+		t.Fatalf("expected the reservation rolled back, got daily %d successes %d",
+			f.dailyCounts["uomni"], f.tierHistory[alice].successes)
+	}
+}
+
+func TestQueue_AsyncStatusPolling(t *testing.T) {
+	f := newMultiDenomFaucet(t)
+
+	rec := httptest.NewRecorder()
+	body := strings.NewReader(fmt.Sprintf(`{"address":%q}`, testAddress("alice")))
+	f.handleFaucet(rec, httptest.NewRequest(http.MethodPost, "/faucet?async=true", body))
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", rec.Code)
+	}
+	var queued DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&queued); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if queued.JobID == "" {
+		t.Fatal("async response must include a job ID")
+	}
+
+	poll := func() (int, JobStatus) {
+		rec := httptest.NewRecorder()
+		f.handleStatus(rec, httptest.NewRequest(http.MethodGet, "/status/"+queued.JobID, nil))
+		var status JobStatus
+		json.NewDecoder(rec.Body).Decode(&status)
+		return rec.Code, status
+	}
+
+	waitFor(t, 2*time.Second, func() bool {
+		_, status := poll()
+		return status.Status == JobSuccess
+	})
+	code, status := poll()
+	if code != http.StatusOK || status.TxHash == "" || status.Amount != "1 OMNI, 5 USDC" {
+		t.Fatalf("unexpected final status %d %+v", code, status)
+	}
+
+	// Unknown IDs are 404
+	rec = httptest.NewRecorder()
+	f.handleStatus(rec, httptest.NewRequest(http.MethodGet, "/status/nope", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown job, got %d", rec.Code)
+	}
+}
+
+func TestIsValidAddress(t *testing.T) {
+	valid := testAddress("alice")
+	accBz := sdk.AccAddress([]byte(fmt.Sprintf("%-20s", "alice")))
+
+	wrongPrefix, err := bech32.ConvertAndEncode("cosmos", accBz)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	// Same length as an omni address, different HRP
+	wrongPrefixSameLen, err := bech32.ConvertAndEncode("omnx", accBz)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	// Flip the last checksum character; still matches the old regex shape
+	last := valid[len(valid)-1]
+	replacement := byte('q')
+	if last == 'q' {
+		replacement = 'p'
+	}
+	badChecksum := valid[:len(valid)-1] + string(replacement)
+
+	// Corrupt a data character instead of the checksum
+	dataChar := byte('z')
+	if valid[10] == 'z' {
+		dataChar = 'x'
+	}
+	badData := valid[:10] + string(dataChar) + valid[11:]
+
+	tests := []struct {
+		name    string
+		address string
+		want    bool
+	}{
+		{"valid", valid, true},
+		{"valid other account", testAddress("bob"), true},
+		{"wrong prefix", wrongPrefix, false},
+		{"wrong prefix same length", wrongPrefixSameLen, false},
+		{"corrupted checksum", badChecksum, false},
+		{"corrupted data", badData, false},
+		{"uppercase and lowercase mixed", strings.ToUpper(valid[:5]) + valid[5:], false},
+		{"too short", valid[:len(valid)-1], false},
+		{"too long", valid + "q", false},
+		{"empty", "", false},
+		{"garbage", "omni1!!!!", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isValidAddress(tt.address, "omni"); got != tt.want {
+				t.Fatalf("isValidAddress(%q) = %v, want %v", tt.address, got, tt.want)
+			}
+		})
+	}
+}
+
+// stubGitHub serves /user for known tokens and counts API calls
+func stubGitHub(t *testing.T, users map[string]githubUser, calls *atomic.Int64) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path != "/user" {
+			http.NotFound(w, r)
+			return
+		}
+		user, ok := users[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(user)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// newGitHubGatedFaucet returns a ready faucet requiring 30-day-old GitHub accounts
+func newGitHubGatedFaucet(t *testing.T, apiURL string) *FaucetService {
+	t.Helper()
+	f := newMultiDenomFaucet(t)
+	f.config.RequireGitHubAuth = true
+	f.config.GitHubMinAccountAgeDays = 30
+	f.config.GitHubAPIURL = apiURL
+	return f
+}
+
+func postFaucetWithToken(t *testing.T, f *FaucetService, address, token string) (int, DistributionResponse) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/faucet", strings.NewReader(fmt.Sprintf(`{"address":%q}`, address)))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	f.handleFaucet(rec, req)
+	var resp DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode faucet response: %v", err)
+	}
+	return rec.Code, resp
+}
+
+func TestGitHubAuth_MissingToken(t *testing.T) {
+	var calls atomic.Int64
+	srv := stubGitHub(t, nil, &calls)
+	f := newGitHubGatedFaucet(t, srv.URL)
+
+	code, resp := postFaucetWithToken(t, f, testAddress("alice"), "")
+	if code != http.StatusUnauthorized || resp.Success {
+		t.Fatalf("expected 401 failure, got %d %+v", code, resp)
+	}
+	if calls.Load() != 0 {
+		t.Fatalf("GitHub API should not be called without a token, got %d calls", calls.Load())
+	}
+}
+
+func TestGitHubAuth_InvalidToken(t *testing.T) {
+	var calls atomic.Int64
+	srv := stubGitHub(t, nil, &calls)
+	f := newGitHubGatedFaucet(t, srv.URL)
+
+	code, resp := postFaucetWithToken(t, f, testAddress("alice"), "bogus")
+	if code != http.StatusUnauthorized || resp.Success {
+		t.Fatalf("expected 401 failure, got %d %+v", code, resp)
+	}
+}
+
+func TestGitHubAuth_AccountTooNew(t *testing.T) {
+	var calls atomic.Int64
+	srv := stubGitHub(t, map[string]githubUser{
+		"new-token": {ID: 1, Login: "newbie", CreatedAt: time.Now().Add(-48 * time.Hour)},
+	}, &calls)
+	f := newGitHubGatedFaucet(t, srv.URL)
+
+	code, resp := postFaucetWithToken(t, f, testAddress("alice"), "new-token")
+	if code != http.StatusForbidden || resp.Success {
+		t.Fatalf("expected 403 failure, got %d %+v", code, resp)
+	}
+	if !strings.Contains(resp.Error, "too new") {
+		t.Fatalf("expected account age error, got %q", resp.Error)
+	}
+}
+
+func TestGitHubAuth_OldAccountIsCached(t *testing.T) {
+	var calls atomic.Int64
+	srv := stubGitHub(t, map[string]githubUser{
+		"old-token": {ID: 2, Login: "veteran", CreatedAt: time.Now().AddDate(-2, 0, 0)},
+	}, &calls)
+	f := newGitHubGatedFaucet(t, srv.URL)
+
+	code, resp := postFaucetWithToken(t, f, testAddress("alice"), "old-token")
+	if code != http.StatusOK || !resp.Success {
+		t.Fatalf("expected success, got %d %+v", code, resp)
+	}
+
+	// Second request with the same token is served from the cache
+	postFaucetWithToken(t, f, testAddress("bob"), "old-token")
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 GitHub API call, got %d", calls.Load())
+	}
+}
+
+// newSignedRequestFaucet returns a ready faucet requiring signed requests,
+// with consumed nonces persisted under a temp dir
+func newSignedRequestFaucet(t *testing.T) *FaucetService {
+	t.Helper()
+	f := newMultiDenomFaucet(t)
+	f.config.RequireSignedRequests = true
+	f.config.SignatureMaxValiditySeconds = 600
+
+	nonces, err := newNonceStore(filepath.Join(t.TempDir(), "nonces.json"))
+	if err != nil {
+		t.Fatalf("nonce store: %v", err)
+	}
+	f.nonces = nonces
+	return f
+}
+
+// signRequest signs a request for address as a client targeting chainID and
+// faucet would
+func signRequest(t *testing.T, priv *secp256k1.PrivKey, address, chainID string, faucet sdk.AccAddress, nonce string, expiresAt int64) *RequestSignature {
+	t.Helper()
+	bz, err := json.Marshal(requestSignDoc{
+		Address:       address,
+		ChainID:       chainID,
+		ExpiresAt:     expiresAt,
+		FaucetAddress: faucet.String(),
+		Nonce:         nonce,
+	})
+	if err != nil {
+		t.Fatalf("encode sign doc: %v", err)
+	}
+	sig, err := priv.Sign(bz)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return &RequestSignature{
+		PubKey:    base64.StdEncoding.EncodeToString(priv.PubKey().Bytes()),
+		Signature: base64.StdEncoding.EncodeToString(sig),
+		Nonce:     nonce,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func postSignedFaucet(t *testing.T, f *FaucetService, req DistributionRequest) (int, DistributionResponse) {
+	t.Helper()
+	bz, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("encode request: %v", err)
+	}
+	rec := httptest.NewRecorder()
+	f.handleFaucet(rec, httptest.NewRequest(http.MethodPost, "/faucet", strings.NewReader(string(bz))))
+	var resp DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode faucet response: %v", err)
+	}
+	return rec.Code, resp
+}
+
+func TestSignedRequest_MissingSignature(t *testing.T) {
+	f := newSignedRequestFaucet(t)
+
+	code, resp := postSignedFaucet(t, f, DistributionRequest{Address: testAddress("alice")})
+	if code != http.StatusUnauthorized || resp.Success {
+		t.Fatalf("expected 401 failure, got %d %+v", code, resp)
+	}
+}
+
+func TestSignedRequest_ReplayRejected(t *testing.T) {
+	f := newSignedRequestFaucet(t)
+	priv := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(priv.PubKey().Address()).String()
+	sig := signRequest(t, priv, address, f.config.ChainID, f.faucetAddr, "nonce-0001", time.Now().Add(5*time.Minute).Unix())
+
+	code, resp := postSignedFaucet(t, f, DistributionRequest{Address: address, Signature: sig})
+	if code != http.StatusOK || !resp.Success {
+		t.Fatalf("expected success, got %d %+v", code, resp)
+	}
+
+	code, resp = postSignedFaucet(t, f, DistributionRequest{Address: address, Signature: sig})
+	if code != http.StatusConflict || resp.Success {
+		t.Fatalf("expected 409 for replayed nonce, got %d %+v", code, resp)
+	}
+
+	// Consumed nonces survive a restart
+	reloaded, err := newNonceStore(f.nonces.path)
+	if err != nil {
+		t.Fatalf("reload nonce store: %v", err)
+	}
+	f.nonces = reloaded
+	code, _ = postSignedFaucet(t, f, DistributionRequest{Address: address, Signature: sig})
+	if code != http.StatusConflict {
+		t.Fatalf("expected 409 after reload, got %d", code)
+	}
+}
+
+func TestSignedRequest_CrossChainSignatureRejected(t *testing.T) {
+	f := newSignedRequestFaucet(t)
+	priv := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(priv.PubKey().Address()).String()
+	expiresAt := time.Now().Add(5 * time.Minute).Unix()
+
+	tests := []struct {
+		name string
+		sig  *RequestSignature
+	}{
+		{"other chain", signRequest(t, priv, address, "omniphi-other-1", f.faucetAddr, "nonce-0002", expiresAt)},
+		{"other faucet", signRequest(t, priv, address, f.config.ChainID, sdk.AccAddress([]byte("other_faucet________")), "nonce-0003", expiresAt)},
+		{"other key", signRequest(t, secp256k1.GenPrivKey(), address, f.config.ChainID, f.faucetAddr, "nonce-0004", expiresAt)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			code, resp := postSignedFaucet(t, f, DistributionRequest{Address: address, Signature: tc.sig})
+			if code != http.StatusUnauthorized || resp.Success {
+				t.Fatalf("expected 401 failure, got %d %+v", code, resp)
+			}
+		})
+	}
+
+	// Rejected signatures must not burn the nonce
+	sig := signRequest(t, priv, address, f.config.ChainID, f.faucetAddr, "nonce-0002", expiresAt)
+	code, resp := postSignedFaucet(t, f, DistributionRequest{Address: address, Signature: sig})
+	if code != http.StatusOK || !resp.Success {
+		t.Fatalf("expected success, got %d %+v", code, resp)
+	}
+}
+
+func TestSignedRequest_Expiry(t *testing.T) {
+	f := newSignedRequestFaucet(t)
+	priv := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(priv.PubKey().Address()).String()
+
+	for _, expiresAt := range []int64{
+		time.Now().Add(-time.Minute).Unix(),
+		time.Now().Add(time.Hour).Unix(), // beyond SignatureMaxValiditySeconds
+	} {
+		sig := signRequest(t, priv, address, f.config.ChainID, f.faucetAddr, "nonce-0005", expiresAt)
+		code, _ := postSignedFaucet(t, f, DistributionRequest{Address: address, Signature: sig})
+		if code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for expires_at %d, got %d", expiresAt, code)
+		}
+	}
+}
+
+func TestNonceStore_PrunesExpired(t *testing.T) {
+	s, err := newNonceStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	if err := s.consume("a/nonce-1", now.Add(time.Minute).Unix(), now); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.consume("b/nonce-1", now.Add(time.Hour).Unix(), now); err != nil {
+		t.Fatal(err)
+	}
+
+	// After the first signature expires its entry is dropped
+	if err := s.consume("c/nonce-1", now.Add(time.Hour).Unix(), now.Add(2*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.nonces["a/nonce-1"]; ok {
+		t.Fatal("expired nonce was not pruned")
+	}
+	if _, ok := s.nonces["b/nonce-1"]; !ok {
+		t.Fatal("unexpired nonce was pruned")
+	}
+}
+
+func TestNextDailyReset_UTCBoundary(t *testing.T) {
+	// 23:30 in UTC+9 is 14:30 UTC the same day
+	tokyo := time.FixedZone("UTC+9", 9*3600)
+	now := time.Date(2026, 3, 1, 23, 30, 0, 0, tokyo)
+
+	if got, want := nextDailyReset(now, 0), time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("midnight reset: got %v, want %v", got, want)
+	}
+	if got, want := nextDailyReset(now, 15), time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("15:00 reset: got %v, want %v", got, want)
+	}
+
+	// Exactly on the boundary, the next reset is a day later
+	boundary := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	if got := nextDailyReset(boundary, 0); !got.Equal(boundary.AddDate(0, 0, 1)) {
+		t.Fatalf("boundary reset: got %v", got)
+	}
+}
+
+func TestRateLimits_DailyCapResetsOnceAtUTCMidnight(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.DailyCap = 1
+	denoms := f.config.denomConfigs()
+	clock := withFakeClock(f, time.Date(2026, 3, 1, 23, 59, 0, 0, time.UTC))
+
+	distribute(t, f, testAddress("alice"), denoms)
+
+	clock.Advance(59 * time.Second) // 23:59:59
+	if err := f.checkRateLimits(testAddress("bob"), denoms); err == nil || !strings.Contains(err.Error(), "daily distribution limit") {
+		t.Fatalf("expected daily cap before midnight, got %v", err)
+	}
+
+	clock.Advance(time.Second) // 00:00:00 UTC
+	if err := f.checkRateLimits(testAddress("bob"), denoms); err != nil {
+		t.Fatalf("cap should reset at UTC midnight: %v", err)
+	}
+	distribute(t, f, testAddress("bob"), denoms)
+	if want := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC); !f.dailyResetTime.Equal(want) {
+		t.Fatalf("next reset: got %v, want %v", f.dailyResetTime, want)
+	}
+
+	// No second reset later the same day
+	clock.Advance(23*time.Hour + 59*time.Minute)
+	if err := f.checkRateLimits(testAddress("carol"), denoms); err == nil {
+		t.Fatal("cap must not reset again before the next UTC midnight")
+	}
+	if f.dailyCounts["uomni"] != 1 {
+		t.Fatalf("expected one distribution today, got %d", f.dailyCounts["uomni"])
+	}
+}
+
+func TestRateLimits_ConfiguredResetHour(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.DailyCap = 1
+	f.config.DailyResetHourUTC = 6
+	denoms := f.config.denomConfigs()
+	clock := withFakeClock(f, time.Date(2026, 3, 1, 5, 0, 0, 0, time.UTC))
+
+	distribute(t, f, testAddress("alice"), denoms)
+
+	// Midnight has no effect; 06:00 UTC resets
+	clock.Advance(30 * time.Minute)
+	if err := f.checkRateLimits(testAddress("bob"), denoms); err == nil {
+		t.Fatal("expected daily cap before 06:00 UTC")
+	}
+	clock.Advance(30 * time.Minute)
+	if err := f.checkRateLimits(testAddress("bob"), denoms); err != nil {
+		t.Fatalf("cap should reset at 06:00 UTC: %v", err)
+	}
+}
+
+func TestRateLimits_CooldownExpiresWithClock(t *testing.T) {
+	f := newTestFaucet(t)
+	denoms := f.config.denomConfigs() // 60s cooldown
+	clock := withFakeClock(f, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	distribute(t, f, testAddress("alice"), denoms)
+
+	err := f.checkRateLimits(testAddress("alice"), denoms)
+	if err == nil || !strings.Contains(err.Error(), "please wait 1m0s") {
+		t.Fatalf("expected full cooldown, got %v", err)
+	}
+
+	clock.Advance(59 * time.Second)
+	if err := f.checkRateLimits(testAddress("alice"), denoms); err == nil {
+		t.Fatal("cooldown should still apply one second before it ends")
+	}
+
+	clock.Advance(time.Second)
+	if err := f.checkRateLimits(testAddress("alice"), denoms); err != nil {
+		t.Fatalf("cooldown should expire exactly after 60s: %v", err)
+	}
+
+	// A new distribution starts a new cooldown from the clock's time
+	distribute(t, f, testAddress("alice"), denoms)
+	if want := clock.Now().Add(time.Minute); !f.denomCooldowns["uomni"][testAddress("alice")].Equal(want) {
+		t.Fatalf("cooldown end: got %v, want %v", f.denomCooldowns["uomni"][testAddress("alice")], want)
+	}
+}
+
+func TestGitHubAuth_CacheExpiresWithClock(t *testing.T) {
+	f := newTestFaucet(t)
+	clock := withFakeClock(f, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	f.cacheGitHubUser("token-hash", githubUser{ID: 7, Login: "octocat"})
+	if _, ok := f.cachedGitHubUser("token-hash"); !ok {
+		t.Fatal("expected cached lookup")
+	}
+
+	clock.Advance(githubCacheTTL + time.Second)
+	if _, ok := f.cachedGitHubUser("token-hash"); ok {
+		t.Fatal("lookup should expire after githubCacheTTL")
+	}
+}
+
+func TestLogging_JSONDistribution(t *testing.T) {
+	f := newTestFaucet(t)
+	var buf bytes.Buffer
+	logger, err := newLogger(&buf, LogFormatJSON)
+	if err != nil {
+		t.Fatalf("newLogger: %v", err)
+	}
+	f.logger = logger
+	f.ready.Store(true)
+
+	addr := testAddress("alice")
+	res, err := f.reserveRateLimits(addr, f.config.denomConfigs())
+	if err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	job, ok := f.enqueueJob(addr, f.config.denomConfigs(), res)
+	if !ok {
+		t.Fatal("enqueue failed")
+	}
+	f.processJob(<-f.jobQueue)
+	status, _ := f.jobStatus(job.id)
+
+	var entry map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var e map[string]any
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("log line is not JSON: %q: %v", line, err)
+		}
+		if e["event"] == logEventDistribution {
+			entry = e
+		}
+	}
+	if entry == nil {
+		t.Fatalf("no distribution log line in %q", buf.String())
+	}
+	if entry["address"] != addr || entry["tx_hash"] != status.TxHash || entry["amount"] != status.Amount {
+		t.Fatalf("unexpected distribution log fields: %v", entry)
+	}
+	if entry["level"] != "INFO" || entry["msg"] == "" {
+		t.Fatalf("missing level or message: %v", entry)
+	}
+
+	// A rate-limited retry logs the rejection with its error
+	buf.Reset()
+	postFaucet(t, f, addr)
+	if !strings.Contains(buf.String(), `"event":"rate_limited"`) || !strings.Contains(buf.String(), `"error":`) {
+		t.Fatalf("expected a rate_limited log line, got %q", buf.String())
+	}
+
+	if _, err := newLogger(&buf, "xml"); err == nil {
+		t.Fatal("expected an error for an unknown log format")
+	}
+}
+
+// newDrainFaucet builds a faucet with an admin token, a recovery address,
+// 0.025uomni gas prices and a mocked broadcast recording every MsgSend and
+// the fee it was signed with
+func newDrainFaucet(t *testing.T, balance sdk.Coins) (*FaucetService, *[]*banktypes.MsgSend) {
+	f, sent, _ := newDrainFaucetWithFees(t, balance)
+	return f, sent
+}
+
+func newDrainFaucetWithFees(t *testing.T, balance sdk.Coins) (*FaucetService, *[]*banktypes.MsgSend, *[]sdk.Coins) {
+	t.Helper()
+	f := newTestFaucet(t)
+	f.config.AdminToken = "s3cret"
+	f.config.RecoveryAddress = testAddress("recovery")
+	f.txFactory = tx.Factory{}.WithChainID(f.config.ChainID).WithTxConfig(newTxConfig()).
+		WithGasAdjustment(1.5).WithGasPrices("0.025uomni")
+	f.spendableFetcher = func(ctx context.Context) (sdk.Coins, error) {
+		return balance, nil
+	}
+	var (
+		sent []*banktypes.MsgSend
+		fees []sdk.Coins
+	)
+	f.broadcaster = func(txf tx.Factory, msg *banktypes.MsgSend) (string, error) {
+		sent = append(sent, msg)
+		fees = append(fees, txFee(txf))
+		return fmt.Sprintf("TX%d", txf.Sequence()), nil
+	}
+	return f, &sent, &fees
+}
+
+func postDrain(t *testing.T, f *FaucetService, token string) (int, DistributionResponse) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/admin/drain", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	f.handleDrain(rec, req)
+	var resp DistributionResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode drain response: %v", err)
+	}
+	return rec.Code, resp
+}
+
+func TestDrain_RequiresAdminToken(t *testing.T) {
+	f, sent := newDrainFaucet(t, sdk.NewCoins(sdk.NewInt64Coin("uomni", 5000)))
+
+	for _, token := range []string{"", "wrong"} {
+		if code, resp := postDrain(t, f, token); code != http.StatusUnauthorized || resp.Success {
+			t.Fatalf("token %q: expected 401, got %d %+v", token, code, resp)
+		}
+	}
+
+	// Without a configured token the endpoint is disabled, even for an empty header
+	f.config.AdminToken = ""
+	if code, _ := postDrain(t, f, ""); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with admin disabled, got %d", code)
+	}
+
+	if len(*sent) != 0 || f.isPaused() {
+		t.Fatal("rejected drain must not broadcast or pause")
+	}
+}
+
+func TestDrain_EmptyBalanceIsNoop(t *testing.T) {
+	f, sent := newDrainFaucet(t, sdk.NewCoins())
+
+	code, resp := postDrain(t, f, "s3cret")
+	if code != http.StatusConflict || resp.Success {
+		t.Fatalf("expected 409 for an empty balance, got %d %+v", code, resp)
+	}
+	if len(*sent) != 0 || f.isPaused() {
+		t.Fatal("empty drain must not broadcast or pause")
+	}
+}
+
+func TestDrain_SweepsBalanceToRecoveryAddress(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewInt64Coin("uomni", 50000), sdk.NewInt64Coin("uusdc", 42))
+	f, sent := newDrainFaucet(t, balance)
+	f.sequence = 7
+
+	// 200000 fixed gas at 0.025uomni leaves 50000 - 5000 uomni to sweep
+	swept := sdk.NewCoins(sdk.NewInt64Coin("uomni", 45000), sdk.NewInt64Coin("uusdc", 42))
+	code, resp := postDrain(t, f, "s3cret")
+	if code != http.StatusOK || !resp.Success {
+		t.Fatalf("expected successful drain, got %d %+v", code, resp)
+	}
+	if resp.TxHash != "TX7" || resp.Amount != swept.String() {
+		t.Fatalf("unexpected drain response %+v", resp)
+	}
+
+	if len(*sent) != 1 {
+		t.Fatalf("expected one broadcast, got %d", len(*sent))
+	}
+	msg := (*sent)[0]
+	if msg.FromAddress != f.faucetAddr.String() || msg.ToAddress != f.config.RecoveryAddress || !msg.Amount.Equal(swept) {
+		t.Fatalf("unexpected drain msg %+v", msg)
+	}
+
+	// The sequence advances like any other broadcast, and distributions stop
+	if _, seq := f.accountSequence(); seq != 8 {
+		t.Fatalf("expected sequence 8 after drain, got %d", seq)
+	}
+	if !f.isPaused() {
+		t.Fatal("faucet should be paused after a drain")
+	}
+}
+
+// TestDrain_RefillKeepsPaused checks that refilling a drained faucet above
+// MinBalance does not let the balance monitor resume it; only /admin/resume
+// lifts the drain
+func TestDrain_RefillKeepsPaused(t *testing.T) {
+	f, _ := newDrainFaucet(t, sdk.NewCoins(sdk.NewInt64Coin("uomni", 50000)))
+	var balance atomic.Int64
+	f.balanceFetcher = staticBalance(&balance)
+
+	if code, resp := postDrain(t, f, "s3cret"); code != http.StatusOK || !resp.Success {
+		t.Fatalf("expected successful drain, got %d %+v", code, resp)
+	}
+
+	// Drained below the minimum, then refilled above it
+	balance.Store(0)
+	f.checkBalance(context.Background())
+	balance.Store(f.config.MinBalance * 10)
+	f.checkBalance(context.Background())
+	if !f.isPaused() {
+		t.Fatal("a refill must not resume a drained faucet")
+	}
+	if resp := postFaucet(t, f, testAddress("alice")); resp.Success {
+		t.Fatalf("expected distributions refused after a drain, got %+v", resp)
+	}
+
+	postResume := func(token string) int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/admin/resume", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		f.handleResume(rec, req)
+		return rec.Code
+	}
+	if code := postResume("wrong"); code != http.StatusUnauthorized || !f.isPaused() {
+		t.Fatalf("expected 401 and the drain kept, got %d", code)
+	}
+	if code := postResume("s3cret"); code != http.StatusOK || f.isPaused() {
+		t.Fatalf("expected resume to lift the drain, got %d paused=%v", code, f.isPaused())
+	}
+	if code := postResume("s3cret"); code != http.StatusConflict {
+		t.Fatalf("expected 409 resuming an undrained faucet, got %d", code)
+	}
+}
+
+// TestDrain_LeavesFeeInBalance checks that the swept amount plus the fee the
+// sweep is signed with never exceeds the spendable balance, with both fixed
+// and simulated gas
+func TestDrain_LeavesFeeInBalance(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewInt64Coin("uomni", 10000), sdk.NewInt64Coin("uusdc", 42))
+
+	for _, mode := range []string{GasModeFixed, GasModeSimulate} {
+		f, sent, fees := newDrainFaucetWithFees(t, balance)
+		f.config.GasMode = mode
+		f.simConn = &mockSimConn{gasUsed: 80001}
+
+		if code, resp := postDrain(t, f, "s3cret"); code != http.StatusOK || !resp.Success {
+			t.Fatalf("%s: expected successful drain, got %d %+v", mode, code, resp)
+		}
+		if len(*sent) != 1 {
+			t.Fatalf("%s: expected one broadcast, got %d", mode, len(*sent))
+		}
+		fee := (*fees)[0]
+		if fee.AmountOf("uomni").IsZero() {
+			t.Fatalf("%s: expected the sweep to pay a fee", mode)
+		}
+		total := (*sent)[0].Amount.Add(fee...)
+		if !total.Equal(balance) {
+			t.Fatalf("%s: swept %s plus fee %s must equal the balance %s", mode, (*sent)[0].Amount, fee, balance)
+		}
+	}
+}
+
+func TestDrain_RejectsBalanceBelowFee(t *testing.T) {
+	// 42uusdc and 4000uomni cannot pay the 5000uomni fee
+	f, sent := newDrainFaucet(t, sdk.NewCoins(sdk.NewInt64Coin("uomni", 4000), sdk.NewInt64Coin("uusdc", 42)))
+
+	code, resp := postDrain(t, f, "s3cret")
+	if code != http.StatusConflict || resp.Success || !strings.Contains(resp.Error, "does not cover") {
+		t.Fatalf("expected 409 for a balance below the fee, got %d %+v", code, resp)
+	}
+	if len(*sent) != 0 {
+		t.Fatalf("expected no broadcast, got %d", len(*sent))
+	}
+	if f.isPaused() {
+		t.Fatal("a rejected drain must not leave the faucet paused")
+	}
+}
+
+// mockSimConn answers the tx service's Simulate with a fixed gas used
+type mockSimConn struct {
+	gasUsed uint64
+	err     error
+	calls   atomic.Int64
+}
+
+func (m *mockSimConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	m.calls.Add(1)
+	if method != "/cosmos.tx.v1beta1.Service/Simulate" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	if m.err != nil {
+		return m.err
+	}
+	reply.(*txtypes.SimulateResponse).GasInfo = &sdk.GasInfo{GasUsed: m.gasUsed}
+	return nil
+}
+
+func (m *mockSimConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streams not supported")
+}
+
+func TestGas_SimulateAppliesAdjustment(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.GasMode = GasModeSimulate
+	f.config.FixedGas = 200000
+	f.txFactory = tx.Factory{}.WithChainID(f.config.ChainID).WithTxConfig(newTxConfig()).WithGasAdjustment(1.5)
+	sim := &mockSimConn{gasUsed: 80000}
+	f.simConn = sim
+
+	var gas uint64
+	f.broadcaster = func(txf tx.Factory, msg *banktypes.MsgSend) (string, error) {
+		gas = txf.Gas()
+		return "TX", nil
+	}
+	send := func() {
+		t.Helper()
+		if _, err := f.sendTokens(testAddress("alice"), f.config.denomConfigs()); err != nil {
+			t.Fatalf("sendTokens: %v", err)
+		}
+	}
+
+	send()
+	if sim.calls.Load() != 1 || gas != 120000 {
+		t.Fatalf("expected simulated gas 80000 * 1.5 = 120000, got %d after %d calls", gas, sim.calls.Load())
+	}
+
+	// A failing simulation falls back to the fixed gas
+	sim.err = errors.New("simulate unavailable")
+	send()
+	if gas != 200000 {
+		t.Fatalf("expected fixed gas fallback, got %d", gas)
+	}
+
+	// Fixed mode never simulates
+	f.config.GasMode = GasModeFixed
+	sim.err = nil
+	calls := sim.calls.Load()
+	send()
+	if gas != 200000 || sim.calls.Load() != calls {
+		t.Fatalf("fixed mode should not simulate: gas %d, calls %d", gas, sim.calls.Load()-calls)
+	}
+}
+
+// newTieredFaucet returns a ready faucet paying 1 OMNI plus 50% per prior
+// success, capped at 2 OMNI, with a fake clock for stepping past cooldowns
+func newTieredFaucet(t *testing.T) (*FaucetService, *fakeClock) {
+	t.Helper()
+	f := newTestFaucet(t)
+	f.config.MinBalance = 0
+	f.config.DistributionAmount = 1000000
+	f.config.TieredAmounts = true
+	f.config.TierStepPercent = 50
+	f.config.TierMaxPercent = 200
+	f.config.TierHistoryDays = 30
+	f.ready.Store(true)
+	clock := withFakeClock(f, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	f.startWorkers(ctx)
+	return f, clock
+}
+
+func TestTieredAmounts_FirstRequestGetsBaseAmount(t *testing.T) {
+	f, _ := newTieredFaucet(t)
+
+	if resp := postFaucet(t, f, testAddress("alice")); !resp.Success || resp.Amount != "1 OMNI" {
+		t.Fatalf("expected base amount on first request, got %+v", resp)
+	}
+
+	// Another address starts at the base amount regardless of alice's history
+	if resp := postFaucet(t, f, testAddress("bob")); !resp.Success || resp.Amount != "1 OMNI" {
+		t.Fatalf("expected base amount for a new address, got %+v", resp)
+	}
+}
+
+func TestTieredAmounts_EscalatesUpToCap(t *testing.T) {
+	f, clock := newTieredFaucet(t)
+	alice := testAddress("alice")
+
+	for i, want := range []string{"1 OMNI", "1.50 OMNI", "2 OMNI", "2 OMNI"} {
+		resp := postFaucet(t, f, alice)
+		if !resp.Success || resp.Amount != want {
+			t.Fatalf("request %d: expected %s, got %+v", i+1, want, resp)
+		}
+		clock.Advance(time.Minute)
+	}
+	if f.tierHistory[alice].successes != 4 {
+		t.Fatalf("expected 4 recorded successes, got %d", f.tierHistory[alice].successes)
+	}
+
+	// Requesting during the cooldown resets the history to the base amount
+	if resp := postFaucet(t, f, alice); !resp.Success {
+		t.Fatalf("expected success after cooldown, got %+v", resp)
+	}
+	if resp := postFaucet(t, f, alice); resp.Success {
+		t.Fatal("expected cooldown rejection")
+	}
+	clock.Advance(time.Minute)
+	if resp := postFaucet(t, f, alice); !resp.Success || resp.Amount != "1 OMNI" {
+		t.Fatalf("expected base amount after a cooldown violation, got %+v", resp)
+	}
+}
+
+// TestTieredAmounts_QueuedJobKeepsTier checks that only a request the address
+// makes during a cooldown forfeits its tier: the cooldown held by its own
+// queued job does not
+func TestTieredAmounts_QueuedJobKeepsTier(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.TieredAmounts = true
+	f.config.TierStepPercent = 50
+	f.config.TierMaxPercent = 200
+	f.config.TierHistoryDays = 30
+	f.config.DistributionAmount = 1000000
+	clock := withFakeClock(f, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	f.broadcaster = func(txf tx.Factory, msg *banktypes.MsgSend) (string, error) {
+		return "TX", nil
+	}
+
+	alice := testAddress("alice")
+	denoms := f.config.denomConfigs()
+	distribute(t, f, alice, denoms)
+	clock.Advance(time.Minute)
+
+	res, err := f.reserveRateLimits(alice, denoms)
+	if err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+	job, ok := f.enqueueJob(alice, f.tieredDenoms(alice, denoms), res)
+	if !ok {
+		t.Fatal("enqueue failed")
+	}
+
+	// Checking the limits outside the request handler reports the cooldown
+	// without touching the history
+	var cooldown *cooldownError
+	if err := f.checkRateLimits(alice, denoms); !errors.As(err, &cooldown) {
+		t.Fatalf("expected a cooldown error, got %v", err)
+	}
+	if f.tierHistory[alice].successes != 1 {
+		t.Fatalf("a cooldown check must not forfeit the tier, got %d successes", f.tierHistory[alice].successes)
+	}
+	f.processJob(<-f.jobQueue)
+
+	if status, _ := f.jobStatus(job.id); status.Status != JobSuccess || status.Amount != "1.50 OMNI" {
+		t.Fatalf("expected the tiered amount delivered, got %+v", status)
+	}
+	if f.tierHistory[alice].successes != 2 {
+		t.Fatalf("expected 2 recorded successes, got %d", f.tierHistory[alice].successes)
+	}
+}
+
+func TestTieredAmounts_HistoryExpires(t *testing.T) {
+	f, clock := newTieredFaucet(t)
+	alice, bob := testAddress("alice"), testAddress("bob")
+
+	for _, addr := range []string{alice, alice, bob} {
+		if resp := postFaucet(t, f, addr); !resp.Success {
+			t.Fatalf("request for %s: %+v", addr, resp)
+		}
+		clock.Advance(time.Minute)
+	}
+
+	// Alice stays active; bob goes quiet past the history window
+	clock.Advance(29 * 24 * time.Hour)
+	if resp := postFaucet(t, f, alice); !resp.Success || resp.Amount != "2 OMNI" {
+		t.Fatalf("expected alice to keep the tier, got %+v", resp)
+	}
+	clock.Advance(2 * 24 * time.Hour)
+
+	if resp := postFaucet(t, f, bob); !resp.Success || resp.Amount != "1 OMNI" {
+		t.Fatalf("expected bob's stale history to be forgotten, got %+v", resp)
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if record := f.tierHistory[bob]; record.successes != 1 {
+		t.Fatalf("expected bob's history to restart, got %+v", record)
+	}
+	if _, ok := f.tierHistory[alice]; !ok {
+		t.Fatal("alice's history is still within the window and must be kept")
+	}
+}
+
+func TestTieredAmounts_TierPercent(t *testing.T) {
+	c := &Config{TieredAmounts: true, TierStepPercent: 30, TierMaxPercent: 200}
+	for prior, want := range map[int64]int64{0: 100, 1: 130, 3: 190, 4: 200, 1 << 62: 200} {
+		if got := c.tierPercent(prior); got != want {
+			t.Errorf("tierPercent(%d) = %d, want %d", prior, got, want)
+		}
+	}
+
+	c.TieredAmounts = false
+	if got := c.tierPercent(5); got != 100 {
+		t.Fatalf("disabled tiers should pay the base amount, got %d", got)
+	}
+}
+
+func TestHealth_ReachableNode(t *testing.T) {
+	var calls atomic.Int64
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path != "/status" {
+			t.Errorf("unexpected node path %q", r.URL.Path)
+		}
+		w.Write([]byte(`{"result":{}}`))
+	}))
+	defer node.Close()
+
+	f := newTestFaucet(t)
+	clock := withFakeClock(f, time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	f.nodeChecker = newRPCNodeChecker(node.URL + "/")
+
+	resp := decodeHealth(t, f)
+	if resp.Status != "healthy" || resp.Error != "" {
+		t.Fatalf("expected healthy without error, got %+v", resp)
+	}
+	if resp.DailyRemaining != f.config.DailyCap {
+		t.Fatalf("expected %d daily remaining, got %d", f.config.DailyCap, resp.DailyRemaining)
+	}
+
+	// Probes within the TTL reuse the cached result
+	decodeHealth(t, f)
+	clock.Advance(nodeHealthTTL - time.Second)
+	decodeHealth(t, f)
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 node call within TTL, got %d", calls.Load())
+	}
+
+	clock.Advance(time.Second)
+	decodeHealth(t, f)
+	if calls.Load() != 2 {
+		t.Fatalf("expected node to be rechecked after TTL, got %d calls", calls.Load())
+	}
+}
+
+func TestHealth_UnreachableNode(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	node.Close()
+
+	f := newTestFaucet(t)
+	f.nodeChecker = newRPCNodeChecker(node.URL)
+
+	rec := httptest.NewRecorder()
+	f.handleHealth(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+	var resp HealthResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode health: %v", err)
+	}
+	if resp.Status != "unhealthy" {
+		t.Fatalf("expected unhealthy, got %q", resp.Status)
+	}
+	if !strings.Contains(resp.Error, "node status request failed") {
+		t.Fatalf("expected node error, got %q", resp.Error)
+	}
+	if resp.FaucetAddress != f.faucetAddr.String() || resp.DailyRemaining != f.config.DailyCap {
+		t.Fatalf("expected faucet address and daily remaining to be reported, got %+v", resp)
+	}
+}
+
+func TestHealth_NodeErrorStatus(t *testing.T) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer node.Close()
+
+	f := newTestFaucet(t)
+	f.nodeChecker = newRPCNodeChecker(node.URL)
+
+	resp := decodeHealth(t, f)
+	if resp.Status != "unhealthy" || resp.Error != "node status returned 500" {
+		t.Fatalf("expected unhealthy with status error, got %+v", resp)
+	}
+}
+
+// corsRequest sends a preflight from origin through the CORS middleware
+func corsRequest(t *testing.T, f *FaucetService, origin string) *httptest.ResponseRecorder {
+	t.Helper()
+	handler := f.corsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("preflight must not reach the handler")
+	}))
+	req := httptest.NewRequest(http.MethodOptions, "/faucet", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204 for preflight, got %d", rec.Code)
+	}
+	return rec
+}
+
+func TestCORS_AllowedOrigin(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.AllowedOrigins = []string{"https://app.omniphi.io", "https://docs.omniphi.io"}
+
+	rec := corsRequest(t, f, "https://docs.omniphi.io")
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://docs.omniphi.io" {
+		t.Fatalf("expected matched origin to be echoed, got %q", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "true" {
+		t.Fatalf("expected credentials for a listed origin, got %q", got)
+	}
+	if got := rec.Header().Get("Vary"); got != "Origin" {
+		t.Fatalf("expected Vary: Origin, got %q", got)
+	}
+}
+
+func TestCORS_DisallowedOrigin(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.AllowedOrigins = []string{"https://app.omniphi.io"}
+
+	for _, origin := range []string{"https://evil.example", ""} {
+		rec := corsRequest(t, f, origin)
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+			t.Fatalf("origin %q: expected no allow-origin header, got %q", origin, got)
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+			t.Fatalf("origin %q: expected no credentials header, got %q", origin, got)
+		}
+		if got := rec.Header().Get("Vary"); got != "Origin" {
+			t.Fatalf("origin %q: expected Vary: Origin, got %q", origin, got)
+		}
+	}
+}
+
+func TestCORS_WildcardNeverAllowsCredentials(t *testing.T) {
+	f := newTestFaucet(t)
+	f.config.AllowedOrigins = []string{"https://app.omniphi.io", "*"}
+
+	for _, origin := range []string{"https://app.omniphi.io", "https://other.example"} {
+		rec := corsRequest(t, f, origin)
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+			t.Fatalf("origin %q: expected wildcard origin, got %q", origin, got)
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+			t.Fatalf("origin %q: wildcard must not allow credentials, got %q", origin, got)
+		}
+		if got := rec.Header().Get("Vary"); got != "" {
+			t.Fatalf("origin %q: wildcard response does not vary by origin, got Vary %q", origin, got)
+		}
+	}
+}
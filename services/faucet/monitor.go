@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// BalanceFetcher returns the faucet account's spendable balance in base units.
+// Swapped out in tests so the monitor can run without a live node.
+type BalanceFetcher func(ctx context.Context) (int64, error)
+
+// LowBalanceEvent is the JSON body POSTed to the low-balance webhook
+type LowBalanceEvent struct {
+	Event         string `json:"event"` // "faucet_paused" or "faucet_resumed"
+	FaucetAddress string `json:"faucet_address"`
+	ChainID       string `json:"chain_id"`
+	Denom         string `json:"denom"`
+	Balance       int64  `json:"balance"`
+	MinBalance    int64  `json:"min_balance"`
+	Timestamp     int64  `json:"timestamp"`
+}
+
+// newGRPCBalanceFetcher queries the bank module over gRPC for the faucet balance
+func newGRPCBalanceFetcher(conn *grpc.ClientConn, address, denom string) BalanceFetcher {
+	client := banktypes.NewQueryClient(conn)
+	return func(ctx context.Context) (int64, error) {
+		resp, err := client.Balance(ctx, &banktypes.QueryBalanceRequest{
+			Address: address,
+			Denom:   denom,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("balance query failed: %w", err)
+		}
+		if resp.Balance == nil {
+			return 0, nil
+		}
+		if !resp.Balance.Amount.IsInt64() {
+			// Larger than we can represent; certainly above any sane threshold
+			return int64(^uint64(0) >> 1), nil
+		}
+		return resp.Balance.Amount.Int64(), nil
+	}
+}
+
+// dialGRPC opens a plaintext gRPC connection to the node
+func dialGRPC(endpoint string) (*grpc.ClientConn, error) {
+	return grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// isPaused reports whether distributions are paused due to low balance or
+// because an admin drained the faucet
+func (f *FaucetService) isPaused() bool {
+	return f.paused.Load() || f.drained.Load()
+}
+
+// startBalanceMonitor polls the faucet balance until ctx is cancelled.
+// Disabled when MinBalance is zero or no fetcher is configured.
+func (f *FaucetService) startBalanceMonitor(ctx context.Context) {
+	if f.config.MinBalance <= 0 || f.balanceFetcher == nil {
+		return
+	}
+
+	interval := time.Duration(f.config.BalancePollSeconds) * time.Second
+	if interval <= 0 {
+		interval = 60 * time.Second
+	}
+
+	go func() {
+		// Check immediately so a dry faucet is paused before the first request
+		f.checkBalance(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				f.checkBalance(ctx)
+			}
+		}
+	}()
+}
+
+// checkBalance performs a single poll and flips the paused flag when the
+// balance crosses MinBalance in either direction. Query errors leave the
+// current state untouched.
+func (f *FaucetService) checkBalance(ctx context.Context) {
+	queryCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	balance, err := f.balanceFetcher(queryCtx)
+	if err != nil {
+		f.logger.Warn("Balance check failed", "event", logEventBalanceCheck, "error", err)
+		return
+	}
+	f.lastBalance.Store(balance)
+
+	shouldPause := balance < f.config.MinBalance
+	if f.paused.CompareAndSwap(!shouldPause, shouldPause) {
+		if shouldPause {
+			f.logger.Warn("Faucet balance below minimum, pausing distributions",
+				"event", logEventBalanceCheck, "balance", balance, "denom", f.config.Denom, "min_balance", f.config.MinBalance)
+			f.notifyWebhook(ctx, "faucet_paused", balance)
+		} else {
+			f.logger.Info("Faucet balance restored above minimum, resuming distributions",
+				"event", logEventBalanceCheck, "balance", balance, "denom", f.config.Denom, "min_balance", f.config.MinBalance)
+			f.notifyWebhook(ctx, "faucet_resumed", balance)
+		}
+	}
+}
+
+// notifyWebhook POSTs a LowBalanceEvent to the configured webhook, if any.
+// Failures are logged only; alerting must never block distributions.
+func (f *FaucetService) notifyWebhook(ctx context.Context, event string, balance int64) {
+	if f.config.LowBalanceWebhookURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(LowBalanceEvent{
+		Event:         event,
+		FaucetAddress: f.faucetAddr.String(),
+		ChainID:       f.config.ChainID,
+		Denom:         f.config.Denom,
+		Balance:       balance,
+		MinBalance:    f.config.MinBalance,
+		Timestamp:     time.Now().Unix(),
+	})
+	if err != nil {
+		f.logger.Error("Failed to encode webhook payload", "event", logEventWebhook, "error", err)
+		return
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, f.config.LowBalanceWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		f.logger.Error("Failed to build webhook request", "event", logEventWebhook, "error", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		f.logger.Warn("Low balance webhook failed", "event", logEventWebhook, "error", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		f.logger.Warn("Low balance webhook returned error status", "event", logEventWebhook, "status", resp.StatusCode)
+	}
+}
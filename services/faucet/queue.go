@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Job states reported by /status/{id}
+const (
+	JobQueued     = "queued"
+	JobProcessing = "processing"
+	JobSuccess    = "success"
+	JobFailed     = "failed"
+)
+
+// jobRetention is how long finished jobs remain pollable
+const jobRetention = time.Hour
+
+// JobStatus is the pollable state of a queued distribution
+type JobStatus struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Address   string `json:"address"`
+	TxHash    string `json:"tx_hash,omitempty"`
+	Amount    string `json:"amount,omitempty"`
+	Error     string `json:"error,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// distributionJob is a unit of work for the worker pool
+type distributionJob struct {
+	id          string
+	address     string
+	denoms      []DenomConfig
+	reservation *rateReservation // rate limits held since the request was accepted
+	done        chan struct{}    // closed once the job reaches a final state
+}
+
+// newJobID returns a random 16-char hex identifier
+func newJobID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%016x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// enqueueJob registers a job and hands it to the worker pool without blocking.
+// Returns false when the queue is full; the caller still owns reservation.
+func (f *FaucetService) enqueueJob(address string, denoms []DenomConfig, reservation *rateReservation) (*distributionJob, bool) {
+	job := &distributionJob{
+		id:          newJobID(),
+		address:     address,
+		denoms:      denoms,
+		reservation: reservation,
+		done:        make(chan struct{}),
+	}
+
+	now := time.Now().Unix()
+	f.jobsMu.Lock()
+	f.pruneJobsLocked()
+	f.jobs[job.id] = &JobStatus{
+		ID:        job.id,
+		Status:    JobQueued,
+		Address:   address,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	f.jobsMu.Unlock()
+
+	select {
+	case f.jobQueue <- job:
+		return job, true
+	default:
+		f.jobsMu.Lock()
+		delete(f.jobs, job.id)
+		f.jobsMu.Unlock()
+		return nil, false
+	}
+}
+
+// startWorkers launches the worker pool; workers exit when ctx is cancelled
+func (f *FaucetService) startWorkers(ctx context.Context) {
+	workers := f.config.WorkerCount
+	if workers <= 0 {
+		workers = 1
+	}
+
+	for i := int64(0); i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-f.jobQueue:
+					f.processJob(job)
+				}
+			}
+		}()
+	}
+}
+
+// processJob signs and broadcasts a job whose rate limits were reserved when
+// it was accepted. Broadcasts are serialized on broadcastMu because every job
+// spends from the same key and sequence.
+func (f *FaucetService) processJob(job *distributionJob) {
+	defer close(job.done)
+	f.updateJob(job.id, func(s *JobStatus) { s.Status = JobProcessing })
+
+	f.broadcastMu.Lock()
+	txHash, err := f.sendTokens(job.address, job.denoms)
+	f.broadcastMu.Unlock()
+
+	if err != nil {
+		f.logger.Error("Failed to send tokens",
+			"event", logEventDistributionFailed,
+			"job_id", job.id,
+			"address", job.address,
+			"amount", formatCoins(job.denoms),
+			"error", err,
+		)
+		// Nothing was sent, so the address may retry without waiting
+		f.releaseReservation(job.reservation)
+		// A failed broadcast usually means our cached sequence drifted
+		f.resyncAccount()
+		f.updateJob(job.id, func(s *JobStatus) {
+			s.Status = JobFailed
+			s.Error = "Failed to send tokens. Please try again later."
+		})
+		return
+	}
+
+	// Update rate limit tracking
+	f.recordDistribution(job.reservation)
+
+	f.logger.Info("Sent tokens",
+		"event", logEventDistribution,
+		"job_id", job.id,
+		"address", job.address,
+		"amount", formatCoins(job.denoms),
+		"tx_hash", txHash,
+	)
+
+	f.updateJob(job.id, func(s *JobStatus) {
+		s.Status = JobSuccess
+		s.TxHash = txHash
+		s.Amount = formatCoins(job.denoms)
+	})
+}
+
+// updateJob applies fn to the stored status of job id
+func (f *FaucetService) updateJob(id string, fn func(*JobStatus)) {
+	f.jobsMu.Lock()
+	defer f.jobsMu.Unlock()
+	if s, ok := f.jobs[id]; ok {
+		fn(s)
+		s.UpdatedAt = time.Now().Unix()
+	}
+}
+
+// jobStatus returns a copy of the stored status of job id
+func (f *FaucetService) jobStatus(id string) (JobStatus, bool) {
+	f.jobsMu.RLock()
+	defer f.jobsMu.RUnlock()
+	s, ok := f.jobs[id]
+	if !ok {
+		return JobStatus{}, false
+	}
+	return *s, true
+}
+
+// pruneJobsLocked drops finished jobs older than jobRetention.
+// Caller must hold jobsMu.
+func (f *FaucetService) pruneJobsLocked() {
+	cutoff := time.Now().Add(-jobRetention).Unix()
+	for id, s := range f.jobs {
+		if (s.Status == JobSuccess || s.Status == JobFailed) && s.UpdatedAt < cutoff {
+			delete(f.jobs, id)
+		}
+	}
+}
+
+// Handle job status polling at /status/{id}
+func (f *FaucetService) handleStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	id := strings.TrimPrefix(r.URL.Path, "/status/")
+	status, ok := f.jobStatus(id)
+	if id == "" || !ok {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(DistributionResponse{
+			Success: false,
+			Error:   "Unknown job ID",
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode(status)
+}
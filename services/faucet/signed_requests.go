@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Nonce length bounds for signed requests
+const (
+	minNonceLength = 8
+	maxNonceLength = 128
+)
+
+var (
+	errSignatureMissing = errors.New("Signed request required. Include a signature object with pub_key, signature, nonce and expires_at.")
+	errSignatureInvalid = errors.New("Signature verification failed. Sign the request for this faucet's chain ID and address.")
+	errSignatureExpired = errors.New("Signed request has expired or its expiry is too far in the future")
+	errNonceReused      = errors.New("Nonce has already been used. Sign a new request.")
+	errPersistNonce     = errors.New("failed to persist nonce store")
+)
+
+// RequestSignature proves ownership of the requested address. It is a
+// secp256k1 signature by the address's key over requestSignDoc.
+type RequestSignature struct {
+	PubKey    string `json:"pub_key"`    // base64 compressed secp256k1 public key
+	Signature string `json:"signature"`  // base64 64-byte r||s signature
+	Nonce     string `json:"nonce"`      // single-use, chosen by the client
+	ExpiresAt int64  `json:"expires_at"` // unix seconds
+}
+
+// requestSignDoc is what clients sign. Chain ID and faucet address are filled
+// in by the faucet from its own configuration, so a signature made for another
+// faucet or chain does not verify here. Fields are in alphabetical order so
+// the JSON encoding is canonical.
+type requestSignDoc struct {
+	Address       string `json:"address"`
+	ChainID       string `json:"chain_id"`
+	ExpiresAt     int64  `json:"expires_at"`
+	FaucetAddress string `json:"faucet_address"`
+	Nonce         string `json:"nonce"`
+}
+
+// requestSignBytes returns the bytes a client signs for a request to this faucet
+func (f *FaucetService) requestSignBytes(address string, sig *RequestSignature) ([]byte, error) {
+	return json.Marshal(requestSignDoc{
+		Address:       address,
+		ChainID:       f.config.ChainID,
+		ExpiresAt:     sig.ExpiresAt,
+		FaucetAddress: f.faucetAddr.String(),
+		Nonce:         sig.Nonce,
+	})
+}
+
+// verifySignedRequest checks that sig was made by the key of address for this
+// faucet instance, that it has not expired, and consumes its nonce.
+func (f *FaucetService) verifySignedRequest(address string, sig *RequestSignature, now time.Time) error {
+	if sig == nil {
+		return errSignatureMissing
+	}
+	if len(sig.Nonce) < minNonceLength || len(sig.Nonce) > maxNonceLength {
+		return fmt.Errorf("Nonce must be %d-%d characters", minNonceLength, maxNonceLength)
+	}
+
+	maxValidity := time.Duration(f.config.SignatureMaxValiditySeconds) * time.Second
+	expiresAt := time.Unix(sig.ExpiresAt, 0)
+	if !expiresAt.After(now) || expiresAt.After(now.Add(maxValidity)) {
+		return errSignatureExpired
+	}
+
+	pubKeyBz, err := base64.StdEncoding.DecodeString(sig.PubKey)
+	if err != nil || len(pubKeyBz) != secp256k1.PubKeySize {
+		return errSignatureInvalid
+	}
+	sigBz, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return errSignatureInvalid
+	}
+
+	// The key must belong to the address receiving the tokens
+	pubKey := &secp256k1.PubKey{Key: pubKeyBz}
+	if sdk.AccAddress(pubKey.Address()).String() != address {
+		return errSignatureInvalid
+	}
+
+	signBytes, err := f.requestSignBytes(address, sig)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, sigBz) {
+		return errSignatureInvalid
+	}
+
+	// Only a valid signature may consume a nonce
+	return f.nonces.consume(address+"/"+sig.Nonce, sig.ExpiresAt, now)
+}
+
+// nonceStore records consumed request nonces until their signature expires.
+// When path is set the set is persisted to disk so a restart does not reopen
+// a replay window.
+type nonceStore struct {
+	mu     sync.Mutex
+	path   string
+	nonces map[string]int64 // address/nonce -> expires_at (unix seconds)
+}
+
+// newNonceStore loads consumed nonces from path. An empty path keeps them in
+// memory only; a missing file starts an empty store.
+func newNonceStore(path string) (*nonceStore, error) {
+	s := &nonceStore{
+		path:   path,
+		nonces: make(map[string]int64),
+	}
+	if path == "" {
+		return s, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read nonce store: %w", err)
+	}
+	if err := json.Unmarshal(bz, &s.nonces); err != nil {
+		return nil, fmt.Errorf("failed to decode nonce store %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// consume marks key as used. Expired entries are pruned first: once its
+// signature has expired a nonce can no longer be replayed anyway.
+func (s *nonceStore) consume(key string, expiresAt int64, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, exp := range s.nonces {
+		if exp < now.Unix() {
+			delete(s.nonces, k)
+		}
+	}
+
+	if _, used := s.nonces[key]; used {
+		return errNonceReused
+	}
+	s.nonces[key] = expiresAt
+
+	if err := s.persistLocked(); err != nil {
+		delete(s.nonces, key)
+		return err
+	}
+	return nil
+}
+
+// persistLocked atomically rewrites the store file. Caller must hold mu.
+func (s *nonceStore) persistLocked() error {
+	if s.path == "" {
+		return nil
+	}
+
+	bz, err := json.Marshal(s.nonces)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".nonces-*")
+	if err != nil {
+		return fmt.Errorf("%w: %v", errPersistNonce, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		return fmt.Errorf("%w: %v", errPersistNonce, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("%w: %v", errPersistNonce, err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("%w: %v", errPersistNonce, err)
+	}
+	return nil
+}
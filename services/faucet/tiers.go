@@ -0,0 +1,86 @@
+package main
+
+// Tiered amounts reward returning developers: each prior successful request
+// from an address raises its amount by TierStepPercent of the base amount, up
+// to TierMaxPercent. Requesting again while on cooldown is treated as abuse
+// and resets the address to the base amount. An address's history is
+// forgotten TierHistoryDays after its last successful request.
+
+import "time"
+
+// tierRecord is an address's history of successful requests
+type tierRecord struct {
+	successes   int64
+	lastSuccess time.Time
+}
+
+// tierHistoryTTL is how long a tier record outlives its last success
+func (c *Config) tierHistoryTTL() time.Duration {
+	return time.Duration(c.TierHistoryDays) * 24 * time.Hour
+}
+
+// priorSuccessesLocked returns address's successful requests, or zero once
+// its history has expired. Callers hold mu.
+func (f *FaucetService) priorSuccessesLocked(address string, now time.Time) int64 {
+	record, ok := f.tierHistory[address]
+	if !ok || !now.Before(record.lastSuccess.Add(f.config.tierHistoryTTL())) {
+		return 0
+	}
+	return record.successes
+}
+
+// pruneTierHistoryLocked drops expired tier records. Callers hold mu.
+func (f *FaucetService) pruneTierHistoryLocked(now time.Time) {
+	ttl := f.config.tierHistoryTTL()
+	for addr, record := range f.tierHistory {
+		if !now.Before(record.lastSuccess.Add(ttl)) {
+			delete(f.tierHistory, addr)
+		}
+	}
+}
+
+// forfeitTier resets address to the base amount. Only the request handler
+// calls it, for requests the address itself made during a cooldown.
+func (f *FaucetService) forfeitTier(address string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.tierHistory, address)
+}
+
+// tierPercent returns the percentage of the base amount an address receives
+// after priorSuccesses successful requests
+func (c *Config) tierPercent(priorSuccesses int64) int64 {
+	if !c.TieredAmounts || priorSuccesses <= 0 || c.TierStepPercent <= 0 {
+		return 100
+	}
+
+	maxPercent := c.TierMaxPercent
+	if maxPercent < 100 {
+		maxPercent = 100
+	}
+	// Stop before the step product can overflow; the cap applies anyway
+	if priorSuccesses >= (maxPercent-100)/c.TierStepPercent+1 {
+		return maxPercent
+	}
+	return 100 + priorSuccesses*c.TierStepPercent
+}
+
+// tieredDenoms returns denoms with each amount scaled to address's tier. The
+// input slice is not modified.
+func (f *FaucetService) tieredDenoms(address string, denoms []DenomConfig) []DenomConfig {
+	f.mu.RLock()
+	prior := f.priorSuccessesLocked(address, f.clock.Now())
+	f.mu.RUnlock()
+
+	percent := f.config.tierPercent(prior)
+	if percent == 100 {
+		return denoms
+	}
+
+	scaled := make([]DenomConfig, len(denoms))
+	for i, d := range denoms {
+		d.Amount = d.Amount * percent / 100
+		scaled[i] = d
+	}
+	return scaled
+}